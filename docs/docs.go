@@ -15,24 +15,45 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/loan/": {
+        "/apikeys": {
+            "get": {
+                "description": "Gets every API key, without the keys themselves",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets API Keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.apiKeyResponse"
+                            }
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Creates a Loan associated with a specific borrower",
+                "description": "Creates an API key for another service to send in the ` + "`" + `X-API-Key` + "`" + ` header, with staff roles\nthat give it permissions",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Creates Loan",
+                "summary": "Creates API Key",
                 "parameters": [
                     {
-                        "description": "New Loan Request",
-                        "name": "newLoanRequest",
+                        "description": "API Key Request",
+                        "name": "apiKeyRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.newLoanRequest"
+                            "$ref": "#/definitions/handlers.apiKeyRequest"
                         }
                     }
                 ],
@@ -40,92 +61,176 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.newLoanResponse"
+                            "$ref": "#/definitions/handlers.apiKeyResponse"
                         }
                     }
                 }
             }
         },
-        "/loan/{loanid}": {
-            "get": {
-                "description": "Gets Loan Terms",
+        "/apikeys/{apikeyid}": {
+            "delete": {
+                "description": "Revokes an API key so it no longer authenticates",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Gets Loan Information",
+                "summary": "Revokes API Key",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Loan Id",
-                        "name": "loanid",
+                        "description": "API Key Id",
+                        "name": "apikeyid",
                         "in": "path",
                         "required": true
                     }
                 ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "Ends the session a user signed in to with the identity provider and clears its cookie",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Logs Out",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/auth/oidc/callback": {
+            "get": {
+                "description": "Exchanges the identity provider's authorization code for an ID token and signs in the user\nlinked to its subject, setting the session cookie",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Finishes OIDC Login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization Code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.loanResponse"
+                            "$ref": "#/definitions/handlers.sessionResponse"
                         }
                     }
                 }
             }
         },
-        "/loan/{loanid}/month/{month}": {
+        "/auth/oidc/login": {
             "get": {
-                "description": "Gets aggregate loan data given a particular month",
+                "description": "Redirects to the identity provider to sign in with the authorization code flow and PKCE,\nwhich sends the user back to ` + "`" + `/auth/oidc/callback` + "`" + `.  The login is tied to the browser that\nstarted it with a cookie, which the callback requires",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Starts OIDC Login",
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/auth/token": {
+            "post": {
+                "description": "Signs a user in with their password, returning a bearer token for the ` + "`" + `Authorization` + "`" + ` header",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Gets Loan Month Summary",
+                "summary": "Creates Token",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Loan Id",
-                        "name": "loanid",
-                        "in": "path",
-                        "required": true
-                    },
+                        "description": "Token Request",
+                        "name": "tokenRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.tokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.tokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/collateral": {
+            "post": {
+                "description": "Creates collateral with its first appraisal, securing any number of loans",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Creates Collateral",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Month Number",
-                        "name": "month",
-                        "in": "path",
-                        "required": true
+                        "description": "New Collateral Request",
+                        "name": "newCollateralRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newCollateralRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.loanMonthSummaryResponse"
+                            "$ref": "#/definitions/handlers.newCollateralResponse"
                         }
                     }
                 }
             }
         },
-        "/loan/{loanid}/schedule": {
+        "/collateral/{collateralid}": {
             "get": {
-                "description": "Gets the loans schedule by month",
+                "description": "Gets collateral with its appraisals and the loans it secures",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Gets Loan Schedule",
+                "summary": "Gets Collateral",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Loan Id",
-                        "name": "loanid",
+                        "description": "Collateral Id",
+                        "name": "collateralid",
                         "in": "path",
                         "required": true
                     }
@@ -134,68 +239,75 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handlers.loanMonthResponseItem"
-                            }
+                            "$ref": "#/definitions/handlers.collateralResponse"
                         }
                     }
                 }
             }
         },
-        "/loan/{loanid}/share": {
+        "/collateral/{collateralid}/appraisals": {
             "post": {
-                "description": "Shares loan with another user that is not the borrower",
+                "description": "Records a new appraisal of collateral.  It is the collateral's value from its date.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Shares Loan",
+                "summary": "Appraises Collateral",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Loan Id",
-                        "name": "loanid",
+                        "description": "Collateral Id",
+                        "name": "collateralid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Share User Request",
-                        "name": "loanShareRequest",
+                        "description": "Appraisal Request",
+                        "name": "appraisalRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.loanShareRequest"
+                            "$ref": "#/definitions/handlers.appraisalRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.collateralResponse"
+                        }
                     }
                 }
             }
         },
-        "/user": {
+        "/collateral/{collateralid}/loans": {
             "post": {
-                "description": "Creates User given a ` + "`" + `newUserRequest` + "`" + `",
+                "description": "Links collateral to another loan it secures",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Creates User",
+                "summary": "Secures Loan With Collateral",
                 "parameters": [
                     {
-                        "description": "New User Request",
-                        "name": "newUserRequest",
+                        "type": "integer",
+                        "description": "Collateral Id",
+                        "name": "collateralid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Loan Request",
+                        "name": "collateralLoanRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.newUserRequest"
+                            "$ref": "#/definitions/handlers.collateralLoanRequest"
                         }
                     }
                 ],
@@ -203,143 +315,3579 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.newUserResponse"
+                            "$ref": "#/definitions/handlers.collateralResponse"
                         }
                     }
                 }
             }
         },
-        "/user/{userid}/loans": {
+        "/collateral/{collateralid}/ltv": {
             "get": {
-                "description": "Gets Loans associated with a specific user.  The user may be the borrower\nor the loan may be shared with that user.",
+                "description": "Gets the combined loan-to-value of every loan the collateral secures on a date, using the\nlatest appraisal and each loan's scheduled balance after the payments due by then",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Gets Loans by User",
+                "summary": "Gets Collateral Loan-To-Value",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User Id",
-                        "name": "userid",
+                        "description": "Collateral Id",
+                        "name": "collateralid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As of date, YYYY-MM-DD, defaults to today",
+                        "name": "date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handlers.loanResponse"
-                            }
+                            "$ref": "#/definitions/handlers.collateralLTVResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.loanMonthResponseItem": {
-            "type": "object",
-            "properties": {
-                "month": {
-                    "type": "integer"
-                },
-                "monthlyPayment": {
-                    "type": "number"
-                },
-                "remainingBalance": {
-                    "type": "number"
-                }
-            }
-        },
-        "handlers.loanMonthSummaryResponse": {
-            "type": "object",
-            "properties": {
-                "endingBalance": {
-                    "type": "number"
-                },
-                "totalInterestPaid": {
-                    "type": "number"
-                },
-                "totalPrincipalPaid": {
-                    "type": "number"
-                }
-            }
         },
-        "handlers.loanResponse": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "rate": {
-                    "type": "number"
-                },
-                "term": {
-                    "type": "integer"
+        "/compare": {
+            "post": {
+                "description": "Calculates several hypothetical loans side by side.  The first scenario is the baseline:\nevery other scenario that pays points reports the month its cumulative cost (points plus\ninterest) drops to or below the baseline's, or null if it never does.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Compares Loan Scenarios",
+                "parameters": [
+                    {
+                        "description": "Compare Request",
+                        "name": "compareRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.compareRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.compareResponse"
+                        }
+                    }
                 }
             }
         },
-        "handlers.loanShareRequest": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer"
+        "/creditline": {
+            "post": {
+                "description": "Opens a revolving line of credit for a borrower.  The line can be drawn on up to its limit\nfor drawMonths, then the balance is paid down over repaymentMonths.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Creates Credit Line",
+                "parameters": [
+                    {
+                        "description": "New Credit Line Request",
+                        "name": "newCreditLineRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newCreditLineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newCreditLineResponse"
+                        }
+                    }
                 }
             }
         },
-        "handlers.newLoanRequest": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "borrowerID": {
-                    "type": "integer"
-                },
-                "months": {
-                    "type": "integer"
-                },
+        "/creditline/{creditlineid}": {
+            "get": {
+                "description": "Gets a credit line with its balance and available credit as of a date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Credit Line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Line Id",
+                        "name": "creditlineid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As of date, YYYY-MM-DD, no later than today, defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.creditLineResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/creditline/{creditlineid}/draw": {
+            "post": {
+                "description": "Draws on a credit line during its draw period, up to the available credit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Draws On Credit Line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Line Id",
+                        "name": "creditlineid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Draw Request",
+                        "name": "creditLineTransactionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.creditLineTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.creditLineResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/creditline/{creditlineid}/payment": {
+            "post": {
+                "description": "Makes a payment toward a credit line's balance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Pays Credit Line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Line Id",
+                        "name": "creditlineid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment Request",
+                        "name": "creditLineTransactionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.creditLineTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.creditLineResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/creditline/{creditlineid}/statements": {
+            "get": {
+                "description": "Gets a statement for each billing cycle that has closed.  Interest is charged on the average\ndaily balance and the minimum payment is the interest during the draw period, then enough to\namortize the balance over what is left of the repayment period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Credit Line Statements",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Credit Line Id",
+                        "name": "creditlineid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day to bill, YYYY-MM-DD, no later than today, defaults to today",
+                        "name": "through",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.creditLineStatementResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/invitation/{invitationid}/accept": {
+            "post": {
+                "description": "Accepts an invitation to share a loan before it expires, sharing the loan with the invited user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Accepts Invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation Id",
+                        "name": "invitationid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.invitationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitation/{invitationid}/decline": {
+            "post": {
+                "description": "Declines an invitation to share a loan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Declines Invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation Id",
+                        "name": "invitationid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.invitationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/": {
+            "post": {
+                "description": "Creates a Loan associated with a specific borrower",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Creates Loan",
+                "parameters": [
+                    {
+                        "description": "New Loan Request",
+                        "name": "newLoanRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newLoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newLoanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}": {
+            "get": {
+                "description": "Gets Loan Terms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/conversion": {
+            "post": {
+                "description": "Moves the month a construction loan converts to permanent amortization, for example when\nconstruction finishes early.  The disbursed principal is amortized over the loan's term from\nthe conversion month and any undisbursed commitment is cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Converts Construction Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Conversion Request",
+                        "name": "conversionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.conversionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.constructionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/deferment": {
+            "post": {
+                "description": "Defers payments while the borrower is in school or in their grace period.  Interest on\nunsubsidized loans accrues and is capitalized when repayment begins; interest on subsidized\nloans is not charged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Defers Student Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deferment Request",
+                        "name": "defermentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.defermentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.paymentDeferralResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/deferrals": {
+            "get": {
+                "description": "Gets the forbearances, deferments and skipped payments on a loan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Deferrals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.paymentDeferralResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/disbursements": {
+            "get": {
+                "description": "Gets a construction loan's disbursements, how much of the commitment has been funded and\nthe permanent payment after conversion",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Construction Loan Disbursements",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.constructionResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Funds part of a construction loan's commitment.  Interest is charged on the disbursement\nfrom its month until the loan converts to permanent amortization.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Disburses Construction Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Disbursement Request",
+                        "name": "disbursementRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.disbursementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.constructionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/escrow": {
+            "get": {
+                "description": "Gets a loan's escrow items and the escrow analysis for every year of the loan.  Each analysis\nprojects the account over the year, collects any shortage below a two month cushion over\nthe year and refunds any surplus of $50 or more.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Escrow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.escrowResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/escrow/items": {
+            "post": {
+                "description": "Adds a bill, like property taxes, that is paid from escrow.  Escrow is collected with each\nmonthly payment and the escrow payment is set by an analysis at the start of every loan year.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Adds Escrow Item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Escrow Item Request",
+                        "name": "escrowItemRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.escrowItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.escrowResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/forbearance": {
+            "post": {
+                "description": "Pauses payments for a number of months and extends the maturity by as many months.\nInterest accrued while paused is capitalized when payments resume, deferred until the\nfinal payment, or waived.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Places Loan In Forbearance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Forbearance Request",
+                        "name": "forbearanceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.forbearanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.paymentDeferralResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/income-driven-plan": {
+            "get": {
+                "description": "Gets a loan's income-driven plan, the payment for each certified year and the balance\nprojected to be forgiven",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Income-Driven Plan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.incomeDrivenPlanResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Sets the payment from a month onward to a share of the borrower's discretionary income: income\nabove a multiple of the regional poverty guideline for their family size.  Whatever is owed\nafter the forgiveness period is forgiven.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Enrolls Loan In Income-Driven Repayment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Income-Driven Plan Request",
+                        "name": "incomeDrivenPlanRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.incomeDrivenPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.incomeDrivenPlanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/income-driven-plan/certifications": {
+            "post": {
+                "description": "Certifies the borrower's income and family size for the next year of an income-driven plan.\nThe payment for that year is recalculated from it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Certifies Income",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Income Certification Request",
+                        "name": "incomeCertificationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.incomeCertificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.incomeDrivenPlanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/links": {
+            "get": {
+                "description": "Gets a loan's share links and how many times each was used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Share Links",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.shareLinkResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a token giving read-only access to the loan, its schedule and month summaries\nthrough the ` + "`" + `/shared/{token}` + "`" + ` endpoints, for someone without an account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Creates Share Link",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share Link Request",
+                        "name": "shareLinkRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.shareLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.shareLinkResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/links/{linkid}": {
+            "delete": {
+                "description": "Revokes a share link so its token no longer gives access to the loan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Revokes Share Link",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Share Link Id",
+                        "name": "linkid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/links/{linkid}/accesses": {
+            "get": {
+                "description": "Gets every request made with a share link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Share Link Access Log",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Share Link Id",
+                        "name": "linkid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.shareLinkAccessResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/ltv": {
+            "get": {
+                "description": "Gets a loan's loan-to-value on a date against all of its collateral, and the combined\nloan-to-value of every loan secured by that collateral",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan-To-Value",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As of date, YYYY-MM-DD, defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanLTVResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/modifications": {
+            "get": {
+                "description": "Gets every version of a loan's terms, starting with the original terms at month 1",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Terms History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.loanTermsVersionResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Changes the rate, remaining term or principal of a loan from an effective month onward.\nArrears are capitalized into the principal.  The schedule keeps the earlier terms before\nthe effective month, and every version of the terms is retained.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Modifies Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Loan Modification Request",
+                        "name": "loanModificationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanModificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanTermsVersionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/month/{month}": {
+            "get": {
+                "description": "Gets aggregate loan data given a particular month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Month Summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Month Number",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanMonthSummaryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/obligors": {
+            "post": {
+                "description": "Adds a co-borrower or guarantor to a loan.  Co-borrowers share the debt with the\nborrower, guarantors answer for it if the borrowers default.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Adds Loan Obligor",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Obligor Request",
+                        "name": "obligorRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.obligorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/pmi": {
+            "get": {
+                "description": "Gets a loan's private mortgage insurance premium and the month it is removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Mortgage Insurance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.pmiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/pmi/cancellation": {
+            "post": {
+                "description": "Cancels a loan's private mortgage insurance at the borrower's request from a month in which\nthe balance is at most 80% of the property value.  Without a request it is removed\nautomatically at 78%.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cancels Mortgage Insurance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation Request",
+                        "name": "pmiCancellationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.pmiCancellationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.pmiResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/recast": {
+            "post": {
+                "description": "Applies an optional lump-sum principal curtailment and re-amortizes the remaining balance\nover the remaining term.  Unlike a prepayment, which shortens the term, a recast keeps\nthe maturity and lowers the payment from the following month.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Recasts Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recast Request",
+                        "name": "recastRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.recastRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.recastResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/refinance": {
+            "post": {
+                "description": "Compares keeping a loan against refinancing its remaining balance after a given month\nat a new rate and term.  The break even month is the first month after refinancing in\nwhich the cumulative payment savings cover the closing costs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Analyzes Loan Refinance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refinance Request",
+                        "name": "refinanceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.refinanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.refinanceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/schedule": {
+            "get": {
+                "description": "Gets the loans schedule by month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.loanMonthResponseItem"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/share": {
+            "post": {
+                "description": "Invites another user that is not the borrower to share the loan.  It's shared with them\nonce they accept.  Sharing it with a user who already has it changes their permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Shares Loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share User Request",
+                        "name": "loanShareRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loanShareRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.invitationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/share/{userid}": {
+            "delete": {
+                "description": "Stops sharing a loan with a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Revokes Loan Share",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/shares": {
+            "get": {
+                "description": "Gets the users a loan is shared with and their permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loan Shares",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.loanShareResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loan/{loanid}/skip": {
+            "post": {
+                "description": "Skips a single payment.  Interest for the month is capitalized and the maturity is\nextended by one month.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Skips Loan Payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan Id",
+                        "name": "loanid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skip Payment Request",
+                        "name": "skipPaymentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.skipPaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.paymentDeferralResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/quote": {
+            "post": {
+                "description": "Calculates the payment, totals and full schedule for hypothetical loan terms.\nNothing is persisted, so no user or loan needs to exist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Quotes Loan",
+                "parameters": [
+                    {
+                        "description": "Quote Request",
+                        "name": "quoteRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.quoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.quoteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "description": "Gets the staff roles and the permissions each one gives across the portfolio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Roles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.roleResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "post": {
+                "description": "Creates User given a ` + "`" + `newUserRequest` + "`" + `, whose social must be a valid social security number or ITIN",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Creates User",
+                "parameters": [
+                    {
+                        "description": "New User Request",
+                        "name": "newUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.newUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}": {
+            "get": {
+                "description": "Gets a user, with their social masked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.userResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user along with their shares, invitations, credentials, roles and sign-ins, keeping\ntheir history.  Users who borrow, co-sign or have credit lines can't be deleted, their loans have\nto be kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Deletes User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes a user's name or address, keeping the old values in their history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Updates User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update User Request",
+                        "name": "updateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.updateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.userResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/history": {
+            "get": {
+                "description": "Gets every change to a user's name and address, oldest first.  The history is kept after the user\nis deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets User History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.userChangeResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/identities": {
+            "post": {
+                "description": "Links a user to their subject at the identity provider, so they can sign in with it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Links Identity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Identity Request",
+                        "name": "identityRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.identityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.identityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/invitations": {
+            "get": {
+                "description": "Gets the invitations to share loans a user hasn't responded to yet and that haven't expired",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Pending Invitations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.invitationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/loans": {
+            "get": {
+                "description": "Gets Loans associated with a specific user.  The user may be the borrower, a\nco-borrower or guarantor, or the loan may be shared with that user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Loans by User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.loanResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/password": {
+            "post": {
+                "description": "Sets the password a user signs in with.  Users changing their own password must give their\ncurrent one, only staff who manage staff can set it without.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Sets Password",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Password Request",
+                        "name": "passwordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.passwordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/user/{userid}/roles": {
+            "get": {
+                "description": "Gets the staff roles assigned to a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets User Roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.roleResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Assigns a user a staff role, making them staff if they weren't already",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Assigns Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role Request",
+                        "name": "roleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.roleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.roleResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/roles/{role}": {
+            "delete": {
+                "description": "Removes a staff role from a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Removes Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.roleResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/social": {
+            "get": {
+                "description": "Decrypts a user's full social security number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Gets Social Security Number",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Id",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.socialResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Lists users whose names contain ` + "`" + `name` + "`" + `, at least 3 letters ignoring case, a page at a time in the\norder they were created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Searches Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Part of the name, at least 3 letters",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Users per page, up to 100",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.usersResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.Permission": {
+            "type": "string",
+            "enum": [
+                "view_portfolio",
+                "originate_loans",
+                "post_payments",
+                "service_loans",
+                "manage_staff"
+            ],
+            "x-enum-comments": {
+                "ManageStaff": "assign staff roles and API keys",
+                "OriginateLoans": "create users, loans, credit lines and collateral",
+                "PostPayments": "make any loan's payments and credit line draws",
+                "ServiceLoans": "change any loan's terms and shares",
+                "ViewPortfolio": "see every user and loan"
+            },
+            "x-enum-varnames": [
+                "ViewPortfolio",
+                "OriginateLoans",
+                "PostPayments",
+                "ServiceLoans",
+                "ManageStaff"
+            ]
+        },
+        "handlers.apiKeyRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "what the service may do, like staff roles",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "loan_officer",
+                            "servicer",
+                            "auditor",
+                            "admin"
+                        ]
+                    }
+                }
+            }
+        },
+        "handlers.apiKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "only returned when the key is created",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.appraisalRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "YYYY-MM-DD, defaults to today",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.appraisalResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.collateralLTVResponse": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string"
+                },
+                "collateralID": {
+                    "type": "integer"
+                },
+                "combinedBalance": {
+                    "type": "number"
+                },
+                "combinedLTV": {
+                    "type": "number"
+                },
+                "loans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.loanBalanceResponse"
+                    }
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.collateralLoanRequest": {
+            "type": "object",
+            "properties": {
+                "loanID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.collateralResponse": {
+            "type": "object",
+            "properties": {
+                "appraisals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.appraisalResponse"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "loanIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handlers.compareRequest": {
+            "type": "object",
+            "properties": {
+                "scenarios": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.loanScenario"
+                    }
+                }
+            }
+        },
+        "handlers.compareResponse": {
+            "type": "object",
+            "properties": {
+                "scenarios": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.scenarioComparison"
+                    }
+                }
+            }
+        },
+        "handlers.constructionResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "number"
+                },
+                "conversionMonth": {
+                    "type": "integer"
+                },
+                "disbursed": {
+                    "type": "number"
+                },
+                "disbursements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.disbursementResponse"
+                    }
+                },
+                "maturityMonth": {
+                    "type": "integer"
+                },
+                "permanentPayment": {
+                    "type": "number"
+                },
+                "undisbursed": {
+                    "description": "cancelled if still undisbursed at conversion",
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.conversionRequest": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "description": "the first month of permanent amortization",
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.costCurvePoint": {
+            "type": "object",
+            "properties": {
+                "cumulativeCost": {
+                    "description": "points plus interest paid through this month",
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.creditLineResponse": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string"
+                },
+                "availableCredit": {
+                    "type": "number"
+                },
+                "balance": {
+                    "description": "excludes interest accrued in the open billing cycle",
+                    "type": "number"
+                },
+                "drawPeriodEnds": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "maturity": {
+                    "type": "string"
+                },
+                "opened": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string",
+                    "enum": [
+                        "draw",
+                        "repayment",
+                        "matured"
+                    ]
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.creditLineStatementResponse": {
+            "type": "object",
+            "properties": {
+                "averageDailyBalance": {
+                    "type": "number"
+                },
+                "beginningBalance": {
+                    "type": "number"
+                },
+                "cycle": {
+                    "type": "integer"
+                },
+                "draws": {
+                    "type": "number"
+                },
+                "end": {
+                    "description": "last day of the cycle",
+                    "type": "string"
+                },
+                "endingBalance": {
+                    "type": "number"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "minimumPayment": {
+                    "type": "number"
+                },
+                "payments": {
+                    "type": "number"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.creditLineTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "date": {
+                    "description": "YYYY-MM-DD, no later than today, defaults to today",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.defermentRequest": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "in_school",
+                        "grace"
+                    ]
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "startMonth": {
+                    "type": "integer"
+                },
+                "subsidized": {
+                    "description": "interest is paid on the borrower's behalf while deferred",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.disbursementRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.disbursementResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.escrowAnalysisResponse": {
+            "type": "object",
+            "properties": {
+                "beginningBalance": {
+                    "type": "number"
+                },
+                "cushion": {
+                    "description": "two months of disbursements",
+                    "type": "number"
+                },
+                "lowestBalance": {
+                    "description": "projected at the current payment",
+                    "type": "number"
+                },
+                "month": {
+                    "description": "the first month of the year analyzed",
+                    "type": "integer"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "projectedDisbursements": {
+                    "type": "number"
+                },
+                "refund": {
+                    "type": "number"
+                },
+                "shortage": {
+                    "description": "collected over the year",
+                    "type": "number"
+                },
+                "surplus": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.escrowItemRequest": {
+            "type": "object",
+            "properties": {
+                "annualAmount": {
+                    "type": "number"
+                },
+                "disbursementsPerYear": {
+                    "description": "defaults to 1, must divide 12",
+                    "type": "integer"
+                },
+                "firstDueMonth": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "property_tax",
+                        "hazard_insurance",
+                        "pmi"
+                    ]
+                }
+            }
+        },
+        "handlers.escrowItemResponse": {
+            "type": "object",
+            "properties": {
+                "annualAmount": {
+                    "type": "number"
+                },
+                "disbursementsPerYear": {
+                    "type": "integer"
+                },
+                "firstDueMonth": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.escrowResponse": {
+            "type": "object",
+            "properties": {
+                "analyses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.escrowAnalysisResponse"
+                    }
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.escrowItemResponse"
+                    }
+                }
+            }
+        },
+        "handlers.forbearanceRequest": {
+            "type": "object",
+            "properties": {
+                "interest": {
+                    "type": "string",
+                    "enum": [
+                        "capitalize",
+                        "defer",
+                        "waive"
+                    ]
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "startMonth": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.graduatedPayment": {
+            "type": "object",
+            "properties": {
+                "negativeAmortizationCap": {
+                    "description": "e.g. 1.1 for 110%, 0 for no cap",
+                    "type": "number"
+                },
+                "stepRate": {
+                    "description": "e.g. 0.075 for 7.5% a year",
+                    "type": "number"
+                },
+                "years": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.identityRequest": {
+            "type": "object",
+            "properties": {
+                "subject": {
+                    "description": "the user's subject at the identity provider",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.identityResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.incomeCertificationRequest": {
+            "type": "object",
+            "properties": {
+                "familySize": {
+                    "type": "integer"
+                },
+                "income": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.incomeCertificationResponse": {
+            "type": "object",
+            "properties": {
+                "familySize": {
+                    "type": "integer"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.incomeDrivenPlanRequest": {
+            "type": "object",
+            "properties": {
+                "familySize": {
+                    "type": "integer"
+                },
+                "forgivenessMonths": {
+                    "description": "defaults to 240",
+                    "type": "integer"
+                },
+                "income": {
+                    "description": "annual income for the first year",
+                    "type": "number"
+                },
+                "paymentPercent": {
+                    "description": "defaults to 10% of discretionary income",
+                    "type": "number"
+                },
+                "povertyMultiplier": {
+                    "description": "defaults to 150% of the poverty guideline",
+                    "type": "number"
+                },
+                "region": {
+                    "type": "string",
+                    "enum": [
+                        "contiguous",
+                        "alaska",
+                        "hawaii"
+                    ]
+                },
+                "startMonth": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.incomeDrivenPlanResponse": {
+            "type": "object",
+            "properties": {
+                "certifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.incomeCertificationResponse"
+                    }
+                },
+                "forgivenBalance": {
+                    "description": "projected from the certified incomes",
+                    "type": "number"
+                },
+                "forgivenessMonths": {
+                    "type": "integer"
+                },
+                "maturityMonth": {
+                    "type": "integer"
+                },
+                "paymentPercent": {
+                    "type": "number"
+                },
+                "povertyMultiplier": {
+                    "type": "number"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "startMonth": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.invitationResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "loanID": {
+                    "type": "integer"
+                },
+                "permission": {
+                    "type": "string",
+                    "enum": [
+                        "view_balance",
+                        "view_schedule",
+                        "make_payments",
+                        "manage_shares"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "accepted",
+                        "declined"
+                    ]
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.loanBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "loanID": {
+                    "type": "integer"
+                },
+                "paymentsMade": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.loanLTVResponse": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "number"
+                },
+                "collateralValue": {
+                    "description": "every collateral securing the loan",
+                    "type": "number"
+                },
+                "combinedBalance": {
+                    "description": "CombinedBalance includes every loan secured by the same collateral.",
+                    "type": "number"
+                },
+                "combinedLTV": {
+                    "type": "number"
+                },
+                "loanID": {
+                    "type": "integer"
+                },
+                "ltv": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.loanModificationRequest": {
+            "type": "object",
+            "properties": {
+                "capitalizedArrears": {
+                    "type": "number"
+                },
+                "effectiveMonth": {
+                    "type": "integer"
+                },
+                "months": {
+                    "description": "counted from the effective month, keeps the current maturity when omitted",
+                    "type": "integer"
+                },
+                "rate": {
+                    "description": "keeps the current rate when omitted",
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.loanMonthResponseItem": {
+            "type": "object",
+            "properties": {
+                "capitalized": {
+                    "description": "interest added to the balance this month",
+                    "type": "number"
+                },
+                "deferred": {
+                    "type": "boolean"
+                },
+                "disbursed": {
+                    "type": "number"
+                },
+                "escrow": {
+                    "description": "included in monthlyPayment",
+                    "type": "number"
+                },
+                "forgiven": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "pmi": {
+                    "description": "included in monthlyPayment",
+                    "type": "number"
+                },
+                "pmiRemoved": {
+                    "type": "boolean"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.loanMonthSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "endingBalance": {
+                    "type": "number"
+                },
+                "escrowBalance": {
+                    "type": "number"
+                },
+                "pmiRemovalMonth": {
+                    "description": "PMIRemovalMonth is the first month without mortgage insurance, whether or not it has passed.",
+                    "type": "integer"
+                },
+                "totalInterestPaid": {
+                    "type": "number"
+                },
+                "totalPrincipalPaid": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.loanOptions": {
+            "type": "object",
+            "properties": {
+                "extraMonthlyPayment": {
+                    "description": "paid toward principal every month",
+                    "type": "number"
+                },
+                "graduated": {
+                    "$ref": "#/definitions/handlers.graduatedPayment"
+                },
+                "prepayments": {
+                    "description": "one-off principal curtailments",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.prepayment"
+                    }
+                },
+                "recasts": {
+                    "description": "lower the payment instead of the term",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.recast"
+                    }
+                }
+            }
+        },
+        "handlers.loanResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "constructionMonths": {
+                    "description": "ConstructionMonths precede the term for construction loans.",
+                    "type": "integer"
+                },
+                "graduated": {
+                    "$ref": "#/definitions/handlers.graduatedPayment"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "obligors": {
+                    "description": "Obligors are everyone liable for the loan, primary borrower first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.obligorResponse"
+                    }
+                },
+                "pmiRate": {
+                    "type": "number"
+                },
+                "propertyValue": {
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "role": {
+                    "description": "Role is the user's part in the loan when listing a user's loans.",
+                    "type": "string",
+                    "enum": [
+                        "primary",
+                        "co_borrower",
+                        "guarantor",
+                        "shared"
+                    ]
+                },
+                "term": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.loanScenario": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "$ref": "#/definitions/handlers.loanOptions"
+                },
+                "points": {
+                    "description": "discount points paid up front, as a percent of the amount",
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.loanShareRequest": {
+            "type": "object",
+            "properties": {
+                "expiresInDays": {
+                    "description": "ExpiresInDays is how long the user has to accept the invitation, a week by default.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "permission": {
+                    "description": "defaults to view_schedule",
+                    "type": "string",
+                    "enum": [
+                        "view_balance",
+                        "view_schedule",
+                        "make_payments",
+                        "manage_shares"
+                    ]
+                }
+            }
+        },
+        "handlers.loanShareResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "permission": {
+                    "type": "string",
+                    "enum": [
+                        "view_balance",
+                        "view_schedule",
+                        "make_payments",
+                        "manage_shares"
+                    ]
+                }
+            }
+        },
+        "handlers.loanTermsVersionResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "amortized by these terms, including capitalized arrears",
+                    "type": "number"
+                },
+                "capitalizedArrears": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "effectiveMonth": {
+                    "type": "integer"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "term": {
+                    "description": "In months, counted from the effective month",
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.newCollateralRequest": {
+            "type": "object",
+            "properties": {
+                "appraised": {
+                    "description": "YYYY-MM-DD, defaults to today",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "real_estate",
+                        "vehicle",
+                        "equipment"
+                    ]
+                },
+                "loanIDs": {
+                    "description": "loans the collateral secures",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "value": {
+                    "description": "the first appraisal",
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.newCollateralResponse": {
+            "type": "object",
+            "properties": {
+                "newCollateralId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.newCreditLineRequest": {
+            "type": "object",
+            "properties": {
+                "borrowerID": {
+                    "type": "integer"
+                },
+                "drawMonths": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "number"
+                },
+                "opened": {
+                    "description": "YYYY-MM-DD, defaults to today",
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "repaymentMonths": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.newCreditLineResponse": {
+            "type": "object",
+            "properties": {
+                "newCreditLineId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.newLoanRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "borrowerID": {
+                    "type": "integer"
+                },
+                "constructionMonths": {
+                    "description": "ConstructionMonths makes the loan a construction loan, funded by disbursements with\ninterest only until it converts to amortize over months.",
+                    "type": "integer"
+                },
+                "graduated": {
+                    "$ref": "#/definitions/handlers.graduatedPayment"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "obligors": {
+                    "description": "Obligors are the loan's co-borrowers and guarantors.  The borrower is its primary obligor,\njointly and severally liable unless they are listed with another liability.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.obligorRequest"
+                    }
+                },
+                "pmiRate": {
+                    "type": "number"
+                },
+                "propertyValue": {
+                    "description": "PMIRate charges private mortgage insurance at this fraction of the amount a year until\nthe balance falls to 78% of PropertyValue.",
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.newLoanResponse": {
+            "type": "object",
+            "properties": {
+                "newLoanId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.newUserRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "social": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.newUserResponse": {
+            "type": "object",
+            "properties": {
+                "newUserId": {
+                    "type": "integer"
+                },
+                "social": {
+                    "description": "masked, as ***-**-1234",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.obligorRequest": {
+            "type": "object",
+            "properties": {
+                "liability": {
+                    "description": "defaults to joint_and_several",
+                    "type": "string",
+                    "enum": [
+                        "joint_and_several",
+                        "percentage"
+                    ]
+                },
+                "percent": {
+                    "description": "Percent is the obligor's share of the debt, from 0 to 100, for percentage liability.",
+                    "type": "number"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "primary",
+                        "co_borrower",
+                        "guarantor"
+                    ]
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.obligorResponse": {
+            "type": "object",
+            "properties": {
+                "liability": {
+                    "type": "string",
+                    "enum": [
+                        "joint_and_several",
+                        "percentage"
+                    ]
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "primary",
+                        "co_borrower",
+                        "guarantor"
+                    ]
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.passwordRequest": {
+            "type": "object",
+            "properties": {
+                "currentPassword": {
+                    "description": "required unless staff who manage staff set it",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.paymentDeferralResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "interest": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "maturityMonth": {
+                    "description": "last month of the loan once every deferral is applied",
+                    "type": "integer"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "startMonth": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.pmiCancellationRequest": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "description": "the first month without mortgage insurance",
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.pmiResponse": {
+            "type": "object",
+            "properties": {
+                "monthlyPremium": {
+                    "type": "number"
+                },
+                "propertyValue": {
+                    "type": "number"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "removal": {
+                    "type": "string",
+                    "enum": [
+                        "requested",
+                        "automatic"
+                    ]
+                },
+                "removalMonth": {
+                    "description": "RemovalMonth is the first month without mortgage insurance, zero if it is never removed.",
+                    "type": "integer"
+                },
+                "totalPremiums": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.prepayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.quoteRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "options": {
+                    "$ref": "#/definitions/handlers.loanOptions"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.quoteResponse": {
+            "type": "object",
+            "properties": {
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "payoffMonth": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.scheduleMonthResponseItem"
+                    }
+                },
+                "totalInterest": {
+                    "type": "number"
+                },
+                "totalPaid": {
+                    "type": "number"
+                },
+                "totalPrincipal": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.recast": {
+            "type": "object",
+            "properties": {
+                "curtailment": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.recastRequest": {
+            "type": "object",
+            "properties": {
+                "curtailment": {
+                    "description": "lump sum paid toward principal in the recast month",
+                    "type": "number"
+                },
+                "month": {
+                    "description": "the payment drops from the following month",
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.recastResponse": {
+            "type": "object",
+            "properties": {
+                "curtailment": {
+                    "type": "number"
+                },
+                "maturityMonth": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "newPayment": {
+                    "type": "number"
+                },
+                "previousPayment": {
+                    "type": "number"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.scheduleMonthResponseItem"
+                    }
+                }
+            }
+        },
+        "handlers.refinanceRequest": {
+            "type": "object",
+            "properties": {
+                "closingCosts": {
+                    "type": "number"
+                },
+                "month": {
+                    "description": "refinance after this month's payment",
+                    "type": "integer"
+                },
+                "months": {
+                    "type": "integer"
+                },
                 "rate": {
                     "type": "number"
                 }
             }
         },
-        "handlers.newLoanResponse": {
+        "handlers.refinanceResponse": {
             "type": "object",
             "properties": {
-                "newLoanId": {
+                "breakEvenMonth": {
+                    "description": "months after refinancing, null if never",
+                    "type": "integer"
+                },
+                "closingCosts": {
+                    "type": "number"
+                },
+                "currentPayment": {
+                    "type": "number"
+                },
+                "lifetimeInterestDifference": {
+                    "description": "InterestDifference is the remaining interest on the current loan less the total\ninterest on the new one, so a positive value is interest saved.",
+                    "type": "number"
+                },
+                "month": {
                     "type": "integer"
+                },
+                "monthlySavings": {
+                    "type": "number"
+                },
+                "newPayment": {
+                    "type": "number"
+                },
+                "newTotalInterest": {
+                    "type": "number"
+                },
+                "remainingBalance": {
+                    "type": "number"
+                },
+                "remainingInterest": {
+                    "type": "number"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.scheduleMonthResponseItem"
+                    }
                 }
             }
         },
-        "handlers.newUserRequest": {
+        "handlers.roleRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "loan_officer",
+                        "servicer",
+                        "auditor",
+                        "admin"
+                    ]
+                }
+            }
+        },
+        "handlers.roleResponse": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.Permission"
+                    }
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "loan_officer",
+                        "servicer",
+                        "auditor",
+                        "admin"
+                    ]
+                }
+            }
+        },
+        "handlers.scenarioComparison": {
+            "type": "object",
+            "properties": {
+                "breakEvenMonth": {
+                    "type": "integer"
+                },
+                "costCurve": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.costCurvePoint"
+                    }
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payoffMonth": {
+                    "type": "integer"
+                },
+                "pointsCost": {
+                    "type": "number"
+                },
+                "totalCost": {
+                    "type": "number"
+                },
+                "totalInterest": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.scheduleMonthResponseItem": {
+            "type": "object",
+            "properties": {
+                "beginningBalance": {
+                    "type": "number"
+                },
+                "endingBalance": {
+                    "type": "number"
+                },
+                "extraPrincipal": {
+                    "type": "number"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "monthlyPayment": {
+                    "type": "number"
+                },
+                "negativeAmortization": {
+                    "description": "NegativeAmortization is interest the payment did not cover, added to the balance.",
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "recast": {
+                    "description": "the payment is re-amortized from the next month",
+                    "type": "boolean"
+                },
+                "totalInterestPaid": {
+                    "type": "number"
+                },
+                "totalPrincipalPaid": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.sessionResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.shareLinkAccessResponse": {
+            "type": "object",
+            "properties": {
+                "accessedAt": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.shareLinkRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "who the link is for",
+                    "type": "string"
+                },
+                "expiresInDays": {
+                    "description": "a week by default",
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.shareLinkResponse": {
+            "type": "object",
+            "properties": {
+                "accesses": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "description": "only returned when the link is created",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.skipPaymentRequest": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.socialResponse": {
+            "type": "object",
+            "properties": {
+                "social": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.tokenRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.tokenResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.updateUserRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.userChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
+                    "type": "string"
+                },
+                "changedBy": {
+                    "description": "the user who made the change, zero for services",
+                    "type": "integer"
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "name",
+                        "address"
+                    ]
+                },
+                "newValue": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.userResponse": {
             "type": "object",
             "properties": {
                 "address": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
                 "social": {
+                    "description": "masked, as ***-**-1234",
                     "type": "string"
                 }
             }
         },
-        "handlers.newUserResponse": {
+        "handlers.usersResponse": {
             "type": "object",
             "properties": {
-                "newUserId": {
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
                     "type": "integer"
+                },
+                "total": {
+                    "description": "users matching the search across every page",
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.userResponse"
+                    }
                 }
             }
         }
@@ -15,9 +15,31 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/apikey"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/userchange"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +47,56 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// Collateral is the client for interacting with the Collateral builders.
+	Collateral *CollateralClient
+	// CollateralAppraisal is the client for interacting with the CollateralAppraisal builders.
+	CollateralAppraisal *CollateralAppraisalClient
+	// CreditLine is the client for interacting with the CreditLine builders.
+	CreditLine *CreditLineClient
+	// CreditLineTransaction is the client for interacting with the CreditLineTransaction builders.
+	CreditLineTransaction *CreditLineTransactionClient
+	// EscrowItem is the client for interacting with the EscrowItem builders.
+	EscrowItem *EscrowItemClient
+	// IncomeCertification is the client for interacting with the IncomeCertification builders.
+	IncomeCertification *IncomeCertificationClient
+	// IncomeDrivenPlan is the client for interacting with the IncomeDrivenPlan builders.
+	IncomeDrivenPlan *IncomeDrivenPlanClient
 	// Loan is the client for interacting with the Loan builders.
 	Loan *LoanClient
+	// LoanDisbursement is the client for interacting with the LoanDisbursement builders.
+	LoanDisbursement *LoanDisbursementClient
+	// LoanModification is the client for interacting with the LoanModification builders.
+	LoanModification *LoanModificationClient
+	// LoanObligor is the client for interacting with the LoanObligor builders.
+	LoanObligor *LoanObligorClient
+	// LoanRecast is the client for interacting with the LoanRecast builders.
+	LoanRecast *LoanRecastClient
+	// OidcLogin is the client for interacting with the OidcLogin builders.
+	OidcLogin *OidcLoginClient
+	// PaymentDeferral is the client for interacting with the PaymentDeferral builders.
+	PaymentDeferral *PaymentDeferralClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// ShareInvitation is the client for interacting with the ShareInvitation builders.
+	ShareInvitation *ShareInvitationClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
+	// ShareLinkAccess is the client for interacting with the ShareLinkAccess builders.
+	ShareLinkAccess *ShareLinkAccessClient
 	// SharedLoan is the client for interacting with the SharedLoan builders.
 	SharedLoan *SharedLoanClient
+	// StaffRole is the client for interacting with the StaffRole builders.
+	StaffRole *StaffRoleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserChange is the client for interacting with the UserChange builders.
+	UserChange *UserChangeClient
+	// UserCredential is the client for interacting with the UserCredential builders.
+	UserCredential *UserCredentialClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +108,31 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiKey = NewApiKeyClient(c.config)
+	c.Collateral = NewCollateralClient(c.config)
+	c.CollateralAppraisal = NewCollateralAppraisalClient(c.config)
+	c.CreditLine = NewCreditLineClient(c.config)
+	c.CreditLineTransaction = NewCreditLineTransactionClient(c.config)
+	c.EscrowItem = NewEscrowItemClient(c.config)
+	c.IncomeCertification = NewIncomeCertificationClient(c.config)
+	c.IncomeDrivenPlan = NewIncomeDrivenPlanClient(c.config)
 	c.Loan = NewLoanClient(c.config)
+	c.LoanDisbursement = NewLoanDisbursementClient(c.config)
+	c.LoanModification = NewLoanModificationClient(c.config)
+	c.LoanObligor = NewLoanObligorClient(c.config)
+	c.LoanRecast = NewLoanRecastClient(c.config)
+	c.OidcLogin = NewOidcLoginClient(c.config)
+	c.PaymentDeferral = NewPaymentDeferralClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.ShareInvitation = NewShareInvitationClient(c.config)
+	c.ShareLink = NewShareLinkClient(c.config)
+	c.ShareLinkAccess = NewShareLinkAccessClient(c.config)
 	c.SharedLoan = NewSharedLoanClient(c.config)
+	c.StaffRole = NewStaffRoleClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserChange = NewUserChangeClient(c.config)
+	c.UserCredential = NewUserCredentialClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 }
 
 type (
@@ -135,11 +223,33 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Loan:       NewLoanClient(cfg),
-		SharedLoan: NewSharedLoanClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		ApiKey:                NewApiKeyClient(cfg),
+		Collateral:            NewCollateralClient(cfg),
+		CollateralAppraisal:   NewCollateralAppraisalClient(cfg),
+		CreditLine:            NewCreditLineClient(cfg),
+		CreditLineTransaction: NewCreditLineTransactionClient(cfg),
+		EscrowItem:            NewEscrowItemClient(cfg),
+		IncomeCertification:   NewIncomeCertificationClient(cfg),
+		IncomeDrivenPlan:      NewIncomeDrivenPlanClient(cfg),
+		Loan:                  NewLoanClient(cfg),
+		LoanDisbursement:      NewLoanDisbursementClient(cfg),
+		LoanModification:      NewLoanModificationClient(cfg),
+		LoanObligor:           NewLoanObligorClient(cfg),
+		LoanRecast:            NewLoanRecastClient(cfg),
+		OidcLogin:             NewOidcLoginClient(cfg),
+		PaymentDeferral:       NewPaymentDeferralClient(cfg),
+		Session:               NewSessionClient(cfg),
+		ShareInvitation:       NewShareInvitationClient(cfg),
+		ShareLink:             NewShareLinkClient(cfg),
+		ShareLinkAccess:       NewShareLinkAccessClient(cfg),
+		SharedLoan:            NewSharedLoanClient(cfg),
+		StaffRole:             NewStaffRoleClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserChange:            NewUserChangeClient(cfg),
+		UserCredential:        NewUserCredentialClient(cfg),
+		UserIdentity:          NewUserIdentityClient(cfg),
 	}, nil
 }
 
@@ -157,18 +267,40 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Loan:       NewLoanClient(cfg),
-		SharedLoan: NewSharedLoanClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		ApiKey:                NewApiKeyClient(cfg),
+		Collateral:            NewCollateralClient(cfg),
+		CollateralAppraisal:   NewCollateralAppraisalClient(cfg),
+		CreditLine:            NewCreditLineClient(cfg),
+		CreditLineTransaction: NewCreditLineTransactionClient(cfg),
+		EscrowItem:            NewEscrowItemClient(cfg),
+		IncomeCertification:   NewIncomeCertificationClient(cfg),
+		IncomeDrivenPlan:      NewIncomeDrivenPlanClient(cfg),
+		Loan:                  NewLoanClient(cfg),
+		LoanDisbursement:      NewLoanDisbursementClient(cfg),
+		LoanModification:      NewLoanModificationClient(cfg),
+		LoanObligor:           NewLoanObligorClient(cfg),
+		LoanRecast:            NewLoanRecastClient(cfg),
+		OidcLogin:             NewOidcLoginClient(cfg),
+		PaymentDeferral:       NewPaymentDeferralClient(cfg),
+		Session:               NewSessionClient(cfg),
+		ShareInvitation:       NewShareInvitationClient(cfg),
+		ShareLink:             NewShareLinkClient(cfg),
+		ShareLinkAccess:       NewShareLinkAccessClient(cfg),
+		SharedLoan:            NewSharedLoanClient(cfg),
+		StaffRole:             NewStaffRoleClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserChange:            NewUserChangeClient(cfg),
+		UserCredential:        NewUserCredentialClient(cfg),
+		UserIdentity:          NewUserIdentityClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Loan.
+//		ApiKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,134 +322,192 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Loan.Use(hooks...)
-	c.SharedLoan.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ApiKey, c.Collateral, c.CollateralAppraisal, c.CreditLine,
+		c.CreditLineTransaction, c.EscrowItem, c.IncomeCertification,
+		c.IncomeDrivenPlan, c.Loan, c.LoanDisbursement, c.LoanModification,
+		c.LoanObligor, c.LoanRecast, c.OidcLogin, c.PaymentDeferral, c.Session,
+		c.ShareInvitation, c.ShareLink, c.ShareLinkAccess, c.SharedLoan, c.StaffRole,
+		c.User, c.UserChange, c.UserCredential, c.UserIdentity,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Loan.Intercept(interceptors...)
-	c.SharedLoan.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ApiKey, c.Collateral, c.CollateralAppraisal, c.CreditLine,
+		c.CreditLineTransaction, c.EscrowItem, c.IncomeCertification,
+		c.IncomeDrivenPlan, c.Loan, c.LoanDisbursement, c.LoanModification,
+		c.LoanObligor, c.LoanRecast, c.OidcLogin, c.PaymentDeferral, c.Session,
+		c.ShareInvitation, c.ShareLink, c.ShareLinkAccess, c.SharedLoan, c.StaffRole,
+		c.User, c.UserChange, c.UserCredential, c.UserIdentity,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApiKeyMutation:
+		return c.ApiKey.mutate(ctx, m)
+	case *CollateralMutation:
+		return c.Collateral.mutate(ctx, m)
+	case *CollateralAppraisalMutation:
+		return c.CollateralAppraisal.mutate(ctx, m)
+	case *CreditLineMutation:
+		return c.CreditLine.mutate(ctx, m)
+	case *CreditLineTransactionMutation:
+		return c.CreditLineTransaction.mutate(ctx, m)
+	case *EscrowItemMutation:
+		return c.EscrowItem.mutate(ctx, m)
+	case *IncomeCertificationMutation:
+		return c.IncomeCertification.mutate(ctx, m)
+	case *IncomeDrivenPlanMutation:
+		return c.IncomeDrivenPlan.mutate(ctx, m)
 	case *LoanMutation:
 		return c.Loan.mutate(ctx, m)
+	case *LoanDisbursementMutation:
+		return c.LoanDisbursement.mutate(ctx, m)
+	case *LoanModificationMutation:
+		return c.LoanModification.mutate(ctx, m)
+	case *LoanObligorMutation:
+		return c.LoanObligor.mutate(ctx, m)
+	case *LoanRecastMutation:
+		return c.LoanRecast.mutate(ctx, m)
+	case *OidcLoginMutation:
+		return c.OidcLogin.mutate(ctx, m)
+	case *PaymentDeferralMutation:
+		return c.PaymentDeferral.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *ShareInvitationMutation:
+		return c.ShareInvitation.mutate(ctx, m)
+	case *ShareLinkMutation:
+		return c.ShareLink.mutate(ctx, m)
+	case *ShareLinkAccessMutation:
+		return c.ShareLinkAccess.mutate(ctx, m)
 	case *SharedLoanMutation:
 		return c.SharedLoan.mutate(ctx, m)
+	case *StaffRoleMutation:
+		return c.StaffRole.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserChangeMutation:
+		return c.UserChange.mutate(ctx, m)
+	case *UserCredentialMutation:
+		return c.UserCredential.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// LoanClient is a client for the Loan schema.
-type LoanClient struct {
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
 	config
 }
 
-// NewLoanClient returns a client for the Loan from the given config.
-func NewLoanClient(c config) *LoanClient {
-	return &LoanClient{config: c}
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `loan.Hooks(f(g(h())))`.
-func (c *LoanClient) Use(hooks ...Hook) {
-	c.hooks.Loan = append(c.hooks.Loan, hooks...)
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `loan.Intercept(f(g(h())))`.
-func (c *LoanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Loan = append(c.inters.Loan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *ApiKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiKey = append(c.inters.ApiKey, interceptors...)
 }
 
-// Create returns a builder for creating a Loan entity.
-func (c *LoanClient) Create() *LoanCreate {
-	mutation := newLoanMutation(c.config, OpCreate)
-	return &LoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ApiKey entity.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Loan entities.
-func (c *LoanClient) CreateBulk(builders ...*LoanCreate) *LoanCreateBulk {
-	return &LoanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LoanClient) MapCreateBulk(slice any, setFunc func(*LoanCreate, int)) *LoanCreateBulk {
+func (c *ApiKeyClient) MapCreateBulk(slice any, setFunc func(*ApiKeyCreate, int)) *ApiKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LoanCreateBulk{err: fmt.Errorf("calling to LoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ApiKeyCreateBulk{err: fmt.Errorf("calling to ApiKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LoanCreate, rv.Len())
+	builders := make([]*ApiKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LoanCreateBulk{config: c.config, builders: builders}
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Loan.
-func (c *LoanClient) Update() *LoanUpdate {
-	mutation := newLoanMutation(c.config, OpUpdate)
-	return &LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LoanClient) UpdateOne(l *Loan) *LoanUpdateOne {
-	mutation := newLoanMutation(c.config, OpUpdateOne, withLoan(l))
-	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ApiKeyClient) UpdateOne(ak *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(ak))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LoanClient) UpdateOneID(id int) *LoanUpdateOne {
-	mutation := newLoanMutation(c.config, OpUpdateOne, withLoanID(id))
-	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ApiKeyClient) UpdateOneID(id int) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Loan.
-func (c *LoanClient) Delete() *LoanDelete {
-	mutation := newLoanMutation(c.config, OpDelete)
-	return &LoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LoanClient) DeleteOne(l *Loan) *LoanDeleteOne {
-	return c.DeleteOneID(l.ID)
+func (c *ApiKeyClient) DeleteOne(ak *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LoanClient) DeleteOneID(id int) *LoanDeleteOne {
-	builder := c.Delete().Where(loan.ID(id))
+func (c *ApiKeyClient) DeleteOneID(id int) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LoanDeleteOne{builder}
+	return &ApiKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Loan.
-func (c *LoanClient) Query() *LoanQuery {
-	return &LoanQuery{
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLoan},
+		ctx:    &QueryContext{Type: TypeApiKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Loan entity by its id.
-func (c *LoanClient) Get(ctx context.Context, id int) (*Loan, error) {
-	return c.Query().Where(loan.ID(id)).Only(ctx)
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id int) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LoanClient) GetX(ctx context.Context, id int) *Loan {
+func (c *ApiKeyClient) GetX(ctx context.Context, id int) *ApiKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -325,164 +515,132 @@ func (c *LoanClient) GetX(ctx context.Context, id int) *Loan {
 	return obj
 }
 
-// QueryBorrower queries the borrower edge of a Loan.
-func (c *LoanClient) QueryBorrower(l *Loan) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, loan.BorrowerTable, loan.BorrowerColumn),
-		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySharedLoan queries the shared_loan edge of a Loan.
-func (c *LoanClient) QuerySharedLoan(l *Loan) *SharedLoanQuery {
-	query := (&SharedLoanClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(loan.Table, loan.FieldID, id),
-			sqlgraph.To(sharedloan.Table, sharedloan.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, loan.SharedLoanTable, loan.SharedLoanColumn),
-		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LoanClient) Hooks() []Hook {
-	return c.hooks.Loan
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *LoanClient) Interceptors() []Interceptor {
-	return c.inters.Loan
+func (c *ApiKeyClient) Interceptors() []Interceptor {
+	return c.inters.ApiKey
 }
 
-func (c *LoanClient) mutate(ctx context.Context, m *LoanMutation) (Value, error) {
+func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Loan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ApiKey mutation op: %q", m.Op())
 	}
 }
 
-// SharedLoanClient is a client for the SharedLoan schema.
-type SharedLoanClient struct {
+// CollateralClient is a client for the Collateral schema.
+type CollateralClient struct {
 	config
 }
 
-// NewSharedLoanClient returns a client for the SharedLoan from the given config.
-func NewSharedLoanClient(c config) *SharedLoanClient {
-	return &SharedLoanClient{config: c}
+// NewCollateralClient returns a client for the Collateral from the given config.
+func NewCollateralClient(c config) *CollateralClient {
+	return &CollateralClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `sharedloan.Hooks(f(g(h())))`.
-func (c *SharedLoanClient) Use(hooks ...Hook) {
-	c.hooks.SharedLoan = append(c.hooks.SharedLoan, hooks...)
+// A call to `Use(f, g, h)` equals to `collateral.Hooks(f(g(h())))`.
+func (c *CollateralClient) Use(hooks ...Hook) {
+	c.hooks.Collateral = append(c.hooks.Collateral, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `sharedloan.Intercept(f(g(h())))`.
-func (c *SharedLoanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SharedLoan = append(c.inters.SharedLoan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `collateral.Intercept(f(g(h())))`.
+func (c *CollateralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Collateral = append(c.inters.Collateral, interceptors...)
 }
 
-// Create returns a builder for creating a SharedLoan entity.
-func (c *SharedLoanClient) Create() *SharedLoanCreate {
-	mutation := newSharedLoanMutation(c.config, OpCreate)
-	return &SharedLoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Collateral entity.
+func (c *CollateralClient) Create() *CollateralCreate {
+	mutation := newCollateralMutation(c.config, OpCreate)
+	return &CollateralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SharedLoan entities.
-func (c *SharedLoanClient) CreateBulk(builders ...*SharedLoanCreate) *SharedLoanCreateBulk {
-	return &SharedLoanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Collateral entities.
+func (c *CollateralClient) CreateBulk(builders ...*CollateralCreate) *CollateralCreateBulk {
+	return &CollateralCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SharedLoanClient) MapCreateBulk(slice any, setFunc func(*SharedLoanCreate, int)) *SharedLoanCreateBulk {
+func (c *CollateralClient) MapCreateBulk(slice any, setFunc func(*CollateralCreate, int)) *CollateralCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SharedLoanCreateBulk{err: fmt.Errorf("calling to SharedLoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CollateralCreateBulk{err: fmt.Errorf("calling to CollateralClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SharedLoanCreate, rv.Len())
+	builders := make([]*CollateralCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SharedLoanCreateBulk{config: c.config, builders: builders}
+	return &CollateralCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SharedLoan.
-func (c *SharedLoanClient) Update() *SharedLoanUpdate {
-	mutation := newSharedLoanMutation(c.config, OpUpdate)
-	return &SharedLoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Collateral.
+func (c *CollateralClient) Update() *CollateralUpdate {
+	mutation := newCollateralMutation(c.config, OpUpdate)
+	return &CollateralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SharedLoanClient) UpdateOne(sl *SharedLoan) *SharedLoanUpdateOne {
-	mutation := newSharedLoanMutation(c.config, OpUpdateOne, withSharedLoan(sl))
-	return &SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollateralClient) UpdateOne(co *Collateral) *CollateralUpdateOne {
+	mutation := newCollateralMutation(c.config, OpUpdateOne, withCollateral(co))
+	return &CollateralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SharedLoanClient) UpdateOneID(id int) *SharedLoanUpdateOne {
-	mutation := newSharedLoanMutation(c.config, OpUpdateOne, withSharedLoanID(id))
-	return &SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollateralClient) UpdateOneID(id int) *CollateralUpdateOne {
+	mutation := newCollateralMutation(c.config, OpUpdateOne, withCollateralID(id))
+	return &CollateralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SharedLoan.
-func (c *SharedLoanClient) Delete() *SharedLoanDelete {
-	mutation := newSharedLoanMutation(c.config, OpDelete)
-	return &SharedLoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Collateral.
+func (c *CollateralClient) Delete() *CollateralDelete {
+	mutation := newCollateralMutation(c.config, OpDelete)
+	return &CollateralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SharedLoanClient) DeleteOne(sl *SharedLoan) *SharedLoanDeleteOne {
-	return c.DeleteOneID(sl.ID)
+func (c *CollateralClient) DeleteOne(co *Collateral) *CollateralDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SharedLoanClient) DeleteOneID(id int) *SharedLoanDeleteOne {
-	builder := c.Delete().Where(sharedloan.ID(id))
+func (c *CollateralClient) DeleteOneID(id int) *CollateralDeleteOne {
+	builder := c.Delete().Where(collateral.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SharedLoanDeleteOne{builder}
+	return &CollateralDeleteOne{builder}
 }
 
-// Query returns a query builder for SharedLoan.
-func (c *SharedLoanClient) Query() *SharedLoanQuery {
-	return &SharedLoanQuery{
+// Query returns a query builder for Collateral.
+func (c *CollateralClient) Query() *CollateralQuery {
+	return &CollateralQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSharedLoan},
+		ctx:    &QueryContext{Type: TypeCollateral},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SharedLoan entity by its id.
-func (c *SharedLoanClient) Get(ctx context.Context, id int) (*SharedLoan, error) {
-	return c.Query().Where(sharedloan.ID(id)).Only(ctx)
+// Get returns a Collateral entity by its id.
+func (c *CollateralClient) Get(ctx context.Context, id int) (*Collateral, error) {
+	return c.Query().Where(collateral.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SharedLoanClient) GetX(ctx context.Context, id int) *SharedLoan {
+func (c *CollateralClient) GetX(ctx context.Context, id int) *Collateral {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -490,164 +648,164 @@ func (c *SharedLoanClient) GetX(ctx context.Context, id int) *SharedLoan {
 	return obj
 }
 
-// QueryUser queries the user edge of a SharedLoan.
-func (c *SharedLoanClient) QueryUser(sl *SharedLoan) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryAppraisals queries the appraisals edge of a Collateral.
+func (c *CollateralClient) QueryAppraisals(co *Collateral) *CollateralAppraisalQuery {
+	query := (&CollateralAppraisalClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sl.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(sharedloan.Table, sharedloan.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, sharedloan.UserTable, sharedloan.UserColumn),
+			sqlgraph.From(collateral.Table, collateral.FieldID, id),
+			sqlgraph.To(collateralappraisal.Table, collateralappraisal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collateral.AppraisalsTable, collateral.AppraisalsColumn),
 		)
-		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLoan queries the loan edge of a SharedLoan.
-func (c *SharedLoanClient) QueryLoan(sl *SharedLoan) *LoanQuery {
+// QueryLoans queries the loans edge of a Collateral.
+func (c *CollateralClient) QueryLoans(co *Collateral) *LoanQuery {
 	query := (&LoanClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sl.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(sharedloan.Table, sharedloan.FieldID, id),
+			sqlgraph.From(collateral.Table, collateral.FieldID, id),
 			sqlgraph.To(loan.Table, loan.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, sharedloan.LoanTable, sharedloan.LoanColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, collateral.LoansTable, collateral.LoansPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SharedLoanClient) Hooks() []Hook {
-	return c.hooks.SharedLoan
+func (c *CollateralClient) Hooks() []Hook {
+	return c.hooks.Collateral
 }
 
 // Interceptors returns the client interceptors.
-func (c *SharedLoanClient) Interceptors() []Interceptor {
-	return c.inters.SharedLoan
+func (c *CollateralClient) Interceptors() []Interceptor {
+	return c.inters.Collateral
 }
 
-func (c *SharedLoanClient) mutate(ctx context.Context, m *SharedLoanMutation) (Value, error) {
+func (c *CollateralClient) mutate(ctx context.Context, m *CollateralMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SharedLoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SharedLoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SharedLoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CollateralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SharedLoan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Collateral mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// CollateralAppraisalClient is a client for the CollateralAppraisal schema.
+type CollateralAppraisalClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewCollateralAppraisalClient returns a client for the CollateralAppraisal from the given config.
+func NewCollateralAppraisalClient(c config) *CollateralAppraisalClient {
+	return &CollateralAppraisalClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `collateralappraisal.Hooks(f(g(h())))`.
+func (c *CollateralAppraisalClient) Use(hooks ...Hook) {
+	c.hooks.CollateralAppraisal = append(c.hooks.CollateralAppraisal, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `collateralappraisal.Intercept(f(g(h())))`.
+func (c *CollateralAppraisalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CollateralAppraisal = append(c.inters.CollateralAppraisal, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CollateralAppraisal entity.
+func (c *CollateralAppraisalClient) Create() *CollateralAppraisalCreate {
+	mutation := newCollateralAppraisalMutation(c.config, OpCreate)
+	return &CollateralAppraisalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CollateralAppraisal entities.
+func (c *CollateralAppraisalClient) CreateBulk(builders ...*CollateralAppraisalCreate) *CollateralAppraisalCreateBulk {
+	return &CollateralAppraisalCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *CollateralAppraisalClient) MapCreateBulk(slice any, setFunc func(*CollateralAppraisalCreate, int)) *CollateralAppraisalCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CollateralAppraisalCreateBulk{err: fmt.Errorf("calling to CollateralAppraisalClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*CollateralAppraisalCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &CollateralAppraisalCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CollateralAppraisal.
+func (c *CollateralAppraisalClient) Update() *CollateralAppraisalUpdate {
+	mutation := newCollateralAppraisalMutation(c.config, OpUpdate)
+	return &CollateralAppraisalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollateralAppraisalClient) UpdateOne(ca *CollateralAppraisal) *CollateralAppraisalUpdateOne {
+	mutation := newCollateralAppraisalMutation(c.config, OpUpdateOne, withCollateralAppraisal(ca))
+	return &CollateralAppraisalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CollateralAppraisalClient) UpdateOneID(id int) *CollateralAppraisalUpdateOne {
+	mutation := newCollateralAppraisalMutation(c.config, OpUpdateOne, withCollateralAppraisalID(id))
+	return &CollateralAppraisalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CollateralAppraisal.
+func (c *CollateralAppraisalClient) Delete() *CollateralAppraisalDelete {
+	mutation := newCollateralAppraisalMutation(c.config, OpDelete)
+	return &CollateralAppraisalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *CollateralAppraisalClient) DeleteOne(ca *CollateralAppraisal) *CollateralAppraisalDeleteOne {
+	return c.DeleteOneID(ca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *CollateralAppraisalClient) DeleteOneID(id int) *CollateralAppraisalDeleteOne {
+	builder := c.Delete().Where(collateralappraisal.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &CollateralAppraisalDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for CollateralAppraisal.
+func (c *CollateralAppraisalClient) Query() *CollateralAppraisalQuery {
+	return &CollateralAppraisalQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeCollateralAppraisal},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a CollateralAppraisal entity by its id.
+func (c *CollateralAppraisalClient) Get(ctx context.Context, id int) (*CollateralAppraisal, error) {
+	return c.Query().Where(collateralappraisal.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *CollateralAppraisalClient) GetX(ctx context.Context, id int) *CollateralAppraisal {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -655,69 +813,3710 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryLoans queries the loans edge of a User.
-func (c *UserClient) QueryLoans(u *User) *LoanQuery {
-	query := (&LoanClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(loan.Table, loan.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LoansTable, user.LoansColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySharedLoan queries the shared_loan edge of a User.
-func (c *UserClient) QuerySharedLoan(u *User) *SharedLoanQuery {
-	query := (&SharedLoanClient{config: c.config}).Query()
+// QueryCollateral queries the collateral edge of a CollateralAppraisal.
+func (c *CollateralAppraisalClient) QueryCollateral(ca *CollateralAppraisal) *CollateralQuery {
+	query := (&CollateralClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
+		id := ca.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(sharedloan.Table, sharedloan.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.SharedLoanTable, user.SharedLoanColumn),
+			sqlgraph.From(collateralappraisal.Table, collateralappraisal.FieldID, id),
+			sqlgraph.To(collateral.Table, collateral.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collateralappraisal.CollateralTable, collateralappraisal.CollateralColumn),
 		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *CollateralAppraisalClient) Hooks() []Hook {
+	return c.hooks.CollateralAppraisal
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *CollateralAppraisalClient) Interceptors() []Interceptor {
+	return c.inters.CollateralAppraisal
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *CollateralAppraisalClient) mutate(ctx context.Context, m *CollateralAppraisalMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralAppraisalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralAppraisalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CollateralAppraisalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CollateralAppraisalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CollateralAppraisal mutation op: %q", m.Op())
+	}
+}
+
+// CreditLineClient is a client for the CreditLine schema.
+type CreditLineClient struct {
+	config
+}
+
+// NewCreditLineClient returns a client for the CreditLine from the given config.
+func NewCreditLineClient(c config) *CreditLineClient {
+	return &CreditLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditline.Hooks(f(g(h())))`.
+func (c *CreditLineClient) Use(hooks ...Hook) {
+	c.hooks.CreditLine = append(c.hooks.CreditLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditline.Intercept(f(g(h())))`.
+func (c *CreditLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditLine = append(c.inters.CreditLine, interceptors...)
+}
+
+// Create returns a builder for creating a CreditLine entity.
+func (c *CreditLineClient) Create() *CreditLineCreate {
+	mutation := newCreditLineMutation(c.config, OpCreate)
+	return &CreditLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditLine entities.
+func (c *CreditLineClient) CreateBulk(builders ...*CreditLineCreate) *CreditLineCreateBulk {
+	return &CreditLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditLineClient) MapCreateBulk(slice any, setFunc func(*CreditLineCreate, int)) *CreditLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditLineCreateBulk{err: fmt.Errorf("calling to CreditLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditLine.
+func (c *CreditLineClient) Update() *CreditLineUpdate {
+	mutation := newCreditLineMutation(c.config, OpUpdate)
+	return &CreditLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditLineClient) UpdateOne(cl *CreditLine) *CreditLineUpdateOne {
+	mutation := newCreditLineMutation(c.config, OpUpdateOne, withCreditLine(cl))
+	return &CreditLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditLineClient) UpdateOneID(id int) *CreditLineUpdateOne {
+	mutation := newCreditLineMutation(c.config, OpUpdateOne, withCreditLineID(id))
+	return &CreditLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditLine.
+func (c *CreditLineClient) Delete() *CreditLineDelete {
+	mutation := newCreditLineMutation(c.config, OpDelete)
+	return &CreditLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditLineClient) DeleteOne(cl *CreditLine) *CreditLineDeleteOne {
+	return c.DeleteOneID(cl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditLineClient) DeleteOneID(id int) *CreditLineDeleteOne {
+	builder := c.Delete().Where(creditline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditLineDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditLine.
+func (c *CreditLineClient) Query() *CreditLineQuery {
+	return &CreditLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditLine entity by its id.
+func (c *CreditLineClient) Get(ctx context.Context, id int) (*CreditLine, error) {
+	return c.Query().Where(creditline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditLineClient) GetX(ctx context.Context, id int) *CreditLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBorrower queries the borrower edge of a CreditLine.
+func (c *CreditLineClient) QueryBorrower(cl *CreditLine) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditline.Table, creditline.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditline.BorrowerTable, creditline.BorrowerColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransactions queries the transactions edge of a CreditLine.
+func (c *CreditLineClient) QueryTransactions(cl *CreditLine) *CreditLineTransactionQuery {
+	query := (&CreditLineTransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditline.Table, creditline.FieldID, id),
+			sqlgraph.To(creditlinetransaction.Table, creditlinetransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditline.TransactionsTable, creditline.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CreditLineClient) Hooks() []Hook {
+	return c.hooks.CreditLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditLineClient) Interceptors() []Interceptor {
+	return c.inters.CreditLine
+}
+
+func (c *CreditLineClient) mutate(ctx context.Context, m *CreditLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditLine mutation op: %q", m.Op())
+	}
+}
+
+// CreditLineTransactionClient is a client for the CreditLineTransaction schema.
+type CreditLineTransactionClient struct {
+	config
+}
+
+// NewCreditLineTransactionClient returns a client for the CreditLineTransaction from the given config.
+func NewCreditLineTransactionClient(c config) *CreditLineTransactionClient {
+	return &CreditLineTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditlinetransaction.Hooks(f(g(h())))`.
+func (c *CreditLineTransactionClient) Use(hooks ...Hook) {
+	c.hooks.CreditLineTransaction = append(c.hooks.CreditLineTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditlinetransaction.Intercept(f(g(h())))`.
+func (c *CreditLineTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditLineTransaction = append(c.inters.CreditLineTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a CreditLineTransaction entity.
+func (c *CreditLineTransactionClient) Create() *CreditLineTransactionCreate {
+	mutation := newCreditLineTransactionMutation(c.config, OpCreate)
+	return &CreditLineTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditLineTransaction entities.
+func (c *CreditLineTransactionClient) CreateBulk(builders ...*CreditLineTransactionCreate) *CreditLineTransactionCreateBulk {
+	return &CreditLineTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditLineTransactionClient) MapCreateBulk(slice any, setFunc func(*CreditLineTransactionCreate, int)) *CreditLineTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditLineTransactionCreateBulk{err: fmt.Errorf("calling to CreditLineTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditLineTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditLineTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditLineTransaction.
+func (c *CreditLineTransactionClient) Update() *CreditLineTransactionUpdate {
+	mutation := newCreditLineTransactionMutation(c.config, OpUpdate)
+	return &CreditLineTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditLineTransactionClient) UpdateOne(clt *CreditLineTransaction) *CreditLineTransactionUpdateOne {
+	mutation := newCreditLineTransactionMutation(c.config, OpUpdateOne, withCreditLineTransaction(clt))
+	return &CreditLineTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditLineTransactionClient) UpdateOneID(id int) *CreditLineTransactionUpdateOne {
+	mutation := newCreditLineTransactionMutation(c.config, OpUpdateOne, withCreditLineTransactionID(id))
+	return &CreditLineTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditLineTransaction.
+func (c *CreditLineTransactionClient) Delete() *CreditLineTransactionDelete {
+	mutation := newCreditLineTransactionMutation(c.config, OpDelete)
+	return &CreditLineTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditLineTransactionClient) DeleteOne(clt *CreditLineTransaction) *CreditLineTransactionDeleteOne {
+	return c.DeleteOneID(clt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditLineTransactionClient) DeleteOneID(id int) *CreditLineTransactionDeleteOne {
+	builder := c.Delete().Where(creditlinetransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditLineTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditLineTransaction.
+func (c *CreditLineTransactionClient) Query() *CreditLineTransactionQuery {
+	return &CreditLineTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditLineTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditLineTransaction entity by its id.
+func (c *CreditLineTransactionClient) Get(ctx context.Context, id int) (*CreditLineTransaction, error) {
+	return c.Query().Where(creditlinetransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditLineTransactionClient) GetX(ctx context.Context, id int) *CreditLineTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreditLine queries the credit_line edge of a CreditLineTransaction.
+func (c *CreditLineTransactionClient) QueryCreditLine(clt *CreditLineTransaction) *CreditLineQuery {
+	query := (&CreditLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := clt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditlinetransaction.Table, creditlinetransaction.FieldID, id),
+			sqlgraph.To(creditline.Table, creditline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditlinetransaction.CreditLineTable, creditlinetransaction.CreditLineColumn),
+		)
+		fromV = sqlgraph.Neighbors(clt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CreditLineTransactionClient) Hooks() []Hook {
+	return c.hooks.CreditLineTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditLineTransactionClient) Interceptors() []Interceptor {
+	return c.inters.CreditLineTransaction
+}
+
+func (c *CreditLineTransactionClient) mutate(ctx context.Context, m *CreditLineTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditLineTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditLineTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditLineTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditLineTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditLineTransaction mutation op: %q", m.Op())
+	}
+}
+
+// EscrowItemClient is a client for the EscrowItem schema.
+type EscrowItemClient struct {
+	config
+}
+
+// NewEscrowItemClient returns a client for the EscrowItem from the given config.
+func NewEscrowItemClient(c config) *EscrowItemClient {
+	return &EscrowItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `escrowitem.Hooks(f(g(h())))`.
+func (c *EscrowItemClient) Use(hooks ...Hook) {
+	c.hooks.EscrowItem = append(c.hooks.EscrowItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `escrowitem.Intercept(f(g(h())))`.
+func (c *EscrowItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EscrowItem = append(c.inters.EscrowItem, interceptors...)
+}
+
+// Create returns a builder for creating a EscrowItem entity.
+func (c *EscrowItemClient) Create() *EscrowItemCreate {
+	mutation := newEscrowItemMutation(c.config, OpCreate)
+	return &EscrowItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EscrowItem entities.
+func (c *EscrowItemClient) CreateBulk(builders ...*EscrowItemCreate) *EscrowItemCreateBulk {
+	return &EscrowItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EscrowItemClient) MapCreateBulk(slice any, setFunc func(*EscrowItemCreate, int)) *EscrowItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EscrowItemCreateBulk{err: fmt.Errorf("calling to EscrowItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EscrowItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EscrowItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EscrowItem.
+func (c *EscrowItemClient) Update() *EscrowItemUpdate {
+	mutation := newEscrowItemMutation(c.config, OpUpdate)
+	return &EscrowItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EscrowItemClient) UpdateOne(ei *EscrowItem) *EscrowItemUpdateOne {
+	mutation := newEscrowItemMutation(c.config, OpUpdateOne, withEscrowItem(ei))
+	return &EscrowItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EscrowItemClient) UpdateOneID(id int) *EscrowItemUpdateOne {
+	mutation := newEscrowItemMutation(c.config, OpUpdateOne, withEscrowItemID(id))
+	return &EscrowItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EscrowItem.
+func (c *EscrowItemClient) Delete() *EscrowItemDelete {
+	mutation := newEscrowItemMutation(c.config, OpDelete)
+	return &EscrowItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EscrowItemClient) DeleteOne(ei *EscrowItem) *EscrowItemDeleteOne {
+	return c.DeleteOneID(ei.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EscrowItemClient) DeleteOneID(id int) *EscrowItemDeleteOne {
+	builder := c.Delete().Where(escrowitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EscrowItemDeleteOne{builder}
+}
+
+// Query returns a query builder for EscrowItem.
+func (c *EscrowItemClient) Query() *EscrowItemQuery {
+	return &EscrowItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEscrowItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EscrowItem entity by its id.
+func (c *EscrowItemClient) Get(ctx context.Context, id int) (*EscrowItem, error) {
+	return c.Query().Where(escrowitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EscrowItemClient) GetX(ctx context.Context, id int) *EscrowItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a EscrowItem.
+func (c *EscrowItemClient) QueryLoan(ei *EscrowItem) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ei.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(escrowitem.Table, escrowitem.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, escrowitem.LoanTable, escrowitem.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(ei.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EscrowItemClient) Hooks() []Hook {
+	return c.hooks.EscrowItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *EscrowItemClient) Interceptors() []Interceptor {
+	return c.inters.EscrowItem
+}
+
+func (c *EscrowItemClient) mutate(ctx context.Context, m *EscrowItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EscrowItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EscrowItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EscrowItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EscrowItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EscrowItem mutation op: %q", m.Op())
+	}
+}
+
+// IncomeCertificationClient is a client for the IncomeCertification schema.
+type IncomeCertificationClient struct {
+	config
+}
+
+// NewIncomeCertificationClient returns a client for the IncomeCertification from the given config.
+func NewIncomeCertificationClient(c config) *IncomeCertificationClient {
+	return &IncomeCertificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `incomecertification.Hooks(f(g(h())))`.
+func (c *IncomeCertificationClient) Use(hooks ...Hook) {
+	c.hooks.IncomeCertification = append(c.hooks.IncomeCertification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `incomecertification.Intercept(f(g(h())))`.
+func (c *IncomeCertificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IncomeCertification = append(c.inters.IncomeCertification, interceptors...)
+}
+
+// Create returns a builder for creating a IncomeCertification entity.
+func (c *IncomeCertificationClient) Create() *IncomeCertificationCreate {
+	mutation := newIncomeCertificationMutation(c.config, OpCreate)
+	return &IncomeCertificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IncomeCertification entities.
+func (c *IncomeCertificationClient) CreateBulk(builders ...*IncomeCertificationCreate) *IncomeCertificationCreateBulk {
+	return &IncomeCertificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IncomeCertificationClient) MapCreateBulk(slice any, setFunc func(*IncomeCertificationCreate, int)) *IncomeCertificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IncomeCertificationCreateBulk{err: fmt.Errorf("calling to IncomeCertificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IncomeCertificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IncomeCertificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IncomeCertification.
+func (c *IncomeCertificationClient) Update() *IncomeCertificationUpdate {
+	mutation := newIncomeCertificationMutation(c.config, OpUpdate)
+	return &IncomeCertificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IncomeCertificationClient) UpdateOne(ic *IncomeCertification) *IncomeCertificationUpdateOne {
+	mutation := newIncomeCertificationMutation(c.config, OpUpdateOne, withIncomeCertification(ic))
+	return &IncomeCertificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IncomeCertificationClient) UpdateOneID(id int) *IncomeCertificationUpdateOne {
+	mutation := newIncomeCertificationMutation(c.config, OpUpdateOne, withIncomeCertificationID(id))
+	return &IncomeCertificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IncomeCertification.
+func (c *IncomeCertificationClient) Delete() *IncomeCertificationDelete {
+	mutation := newIncomeCertificationMutation(c.config, OpDelete)
+	return &IncomeCertificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IncomeCertificationClient) DeleteOne(ic *IncomeCertification) *IncomeCertificationDeleteOne {
+	return c.DeleteOneID(ic.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IncomeCertificationClient) DeleteOneID(id int) *IncomeCertificationDeleteOne {
+	builder := c.Delete().Where(incomecertification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IncomeCertificationDeleteOne{builder}
+}
+
+// Query returns a query builder for IncomeCertification.
+func (c *IncomeCertificationClient) Query() *IncomeCertificationQuery {
+	return &IncomeCertificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIncomeCertification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IncomeCertification entity by its id.
+func (c *IncomeCertificationClient) Get(ctx context.Context, id int) (*IncomeCertification, error) {
+	return c.Query().Where(incomecertification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IncomeCertificationClient) GetX(ctx context.Context, id int) *IncomeCertification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a IncomeCertification.
+func (c *IncomeCertificationClient) QueryPlan(ic *IncomeCertification) *IncomeDrivenPlanQuery {
+	query := (&IncomeDrivenPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ic.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incomecertification.Table, incomecertification.FieldID, id),
+			sqlgraph.To(incomedrivenplan.Table, incomedrivenplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, incomecertification.PlanTable, incomecertification.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(ic.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IncomeCertificationClient) Hooks() []Hook {
+	return c.hooks.IncomeCertification
+}
+
+// Interceptors returns the client interceptors.
+func (c *IncomeCertificationClient) Interceptors() []Interceptor {
+	return c.inters.IncomeCertification
+}
+
+func (c *IncomeCertificationClient) mutate(ctx context.Context, m *IncomeCertificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IncomeCertificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IncomeCertificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IncomeCertificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IncomeCertificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IncomeCertification mutation op: %q", m.Op())
+	}
+}
+
+// IncomeDrivenPlanClient is a client for the IncomeDrivenPlan schema.
+type IncomeDrivenPlanClient struct {
+	config
+}
+
+// NewIncomeDrivenPlanClient returns a client for the IncomeDrivenPlan from the given config.
+func NewIncomeDrivenPlanClient(c config) *IncomeDrivenPlanClient {
+	return &IncomeDrivenPlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `incomedrivenplan.Hooks(f(g(h())))`.
+func (c *IncomeDrivenPlanClient) Use(hooks ...Hook) {
+	c.hooks.IncomeDrivenPlan = append(c.hooks.IncomeDrivenPlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `incomedrivenplan.Intercept(f(g(h())))`.
+func (c *IncomeDrivenPlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IncomeDrivenPlan = append(c.inters.IncomeDrivenPlan, interceptors...)
+}
+
+// Create returns a builder for creating a IncomeDrivenPlan entity.
+func (c *IncomeDrivenPlanClient) Create() *IncomeDrivenPlanCreate {
+	mutation := newIncomeDrivenPlanMutation(c.config, OpCreate)
+	return &IncomeDrivenPlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IncomeDrivenPlan entities.
+func (c *IncomeDrivenPlanClient) CreateBulk(builders ...*IncomeDrivenPlanCreate) *IncomeDrivenPlanCreateBulk {
+	return &IncomeDrivenPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IncomeDrivenPlanClient) MapCreateBulk(slice any, setFunc func(*IncomeDrivenPlanCreate, int)) *IncomeDrivenPlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IncomeDrivenPlanCreateBulk{err: fmt.Errorf("calling to IncomeDrivenPlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IncomeDrivenPlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IncomeDrivenPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IncomeDrivenPlan.
+func (c *IncomeDrivenPlanClient) Update() *IncomeDrivenPlanUpdate {
+	mutation := newIncomeDrivenPlanMutation(c.config, OpUpdate)
+	return &IncomeDrivenPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IncomeDrivenPlanClient) UpdateOne(idp *IncomeDrivenPlan) *IncomeDrivenPlanUpdateOne {
+	mutation := newIncomeDrivenPlanMutation(c.config, OpUpdateOne, withIncomeDrivenPlan(idp))
+	return &IncomeDrivenPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IncomeDrivenPlanClient) UpdateOneID(id int) *IncomeDrivenPlanUpdateOne {
+	mutation := newIncomeDrivenPlanMutation(c.config, OpUpdateOne, withIncomeDrivenPlanID(id))
+	return &IncomeDrivenPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IncomeDrivenPlan.
+func (c *IncomeDrivenPlanClient) Delete() *IncomeDrivenPlanDelete {
+	mutation := newIncomeDrivenPlanMutation(c.config, OpDelete)
+	return &IncomeDrivenPlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IncomeDrivenPlanClient) DeleteOne(idp *IncomeDrivenPlan) *IncomeDrivenPlanDeleteOne {
+	return c.DeleteOneID(idp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IncomeDrivenPlanClient) DeleteOneID(id int) *IncomeDrivenPlanDeleteOne {
+	builder := c.Delete().Where(incomedrivenplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IncomeDrivenPlanDeleteOne{builder}
+}
+
+// Query returns a query builder for IncomeDrivenPlan.
+func (c *IncomeDrivenPlanClient) Query() *IncomeDrivenPlanQuery {
+	return &IncomeDrivenPlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIncomeDrivenPlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IncomeDrivenPlan entity by its id.
+func (c *IncomeDrivenPlanClient) Get(ctx context.Context, id int) (*IncomeDrivenPlan, error) {
+	return c.Query().Where(incomedrivenplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IncomeDrivenPlanClient) GetX(ctx context.Context, id int) *IncomeDrivenPlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a IncomeDrivenPlan.
+func (c *IncomeDrivenPlanClient) QueryLoan(idp *IncomeDrivenPlan) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := idp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incomedrivenplan.Table, incomedrivenplan.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, incomedrivenplan.LoanTable, incomedrivenplan.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(idp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCertifications queries the certifications edge of a IncomeDrivenPlan.
+func (c *IncomeDrivenPlanClient) QueryCertifications(idp *IncomeDrivenPlan) *IncomeCertificationQuery {
+	query := (&IncomeCertificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := idp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incomedrivenplan.Table, incomedrivenplan.FieldID, id),
+			sqlgraph.To(incomecertification.Table, incomecertification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, incomedrivenplan.CertificationsTable, incomedrivenplan.CertificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(idp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IncomeDrivenPlanClient) Hooks() []Hook {
+	return c.hooks.IncomeDrivenPlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *IncomeDrivenPlanClient) Interceptors() []Interceptor {
+	return c.inters.IncomeDrivenPlan
+}
+
+func (c *IncomeDrivenPlanClient) mutate(ctx context.Context, m *IncomeDrivenPlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IncomeDrivenPlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IncomeDrivenPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IncomeDrivenPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IncomeDrivenPlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IncomeDrivenPlan mutation op: %q", m.Op())
+	}
+}
+
+// LoanClient is a client for the Loan schema.
+type LoanClient struct {
+	config
+}
+
+// NewLoanClient returns a client for the Loan from the given config.
+func NewLoanClient(c config) *LoanClient {
+	return &LoanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loan.Hooks(f(g(h())))`.
+func (c *LoanClient) Use(hooks ...Hook) {
+	c.hooks.Loan = append(c.hooks.Loan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loan.Intercept(f(g(h())))`.
+func (c *LoanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Loan = append(c.inters.Loan, interceptors...)
+}
+
+// Create returns a builder for creating a Loan entity.
+func (c *LoanClient) Create() *LoanCreate {
+	mutation := newLoanMutation(c.config, OpCreate)
+	return &LoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Loan entities.
+func (c *LoanClient) CreateBulk(builders ...*LoanCreate) *LoanCreateBulk {
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanClient) MapCreateBulk(slice any, setFunc func(*LoanCreate, int)) *LoanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanCreateBulk{err: fmt.Errorf("calling to LoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Loan.
+func (c *LoanClient) Update() *LoanUpdate {
+	mutation := newLoanMutation(c.config, OpUpdate)
+	return &LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanClient) UpdateOne(l *Loan) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoan(l))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanClient) UpdateOneID(id int) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoanID(id))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Loan.
+func (c *LoanClient) Delete() *LoanDelete {
+	mutation := newLoanMutation(c.config, OpDelete)
+	return &LoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanClient) DeleteOne(l *Loan) *LoanDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanClient) DeleteOneID(id int) *LoanDeleteOne {
+	builder := c.Delete().Where(loan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanDeleteOne{builder}
+}
+
+// Query returns a query builder for Loan.
+func (c *LoanClient) Query() *LoanQuery {
+	return &LoanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Loan entity by its id.
+func (c *LoanClient) Get(ctx context.Context, id int) (*Loan, error) {
+	return c.Query().Where(loan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanClient) GetX(ctx context.Context, id int) *Loan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBorrower queries the borrower edge of a Loan.
+func (c *LoanClient) QueryBorrower(l *Loan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.BorrowerTable, loan.BorrowerColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySharedLoan queries the shared_loan edge of a Loan.
+func (c *LoanClient) QuerySharedLoan(l *Loan) *SharedLoanQuery {
+	query := (&SharedLoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(sharedloan.Table, sharedloan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.SharedLoanTable, loan.SharedLoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareInvitations queries the share_invitations edge of a Loan.
+func (c *LoanClient) QueryShareInvitations(l *Loan) *ShareInvitationQuery {
+	query := (&ShareInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(shareinvitation.Table, shareinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ShareInvitationsTable, loan.ShareInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareLinks queries the share_links edge of a Loan.
+func (c *LoanClient) QueryShareLinks(l *Loan) *ShareLinkQuery {
+	query := (&ShareLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ShareLinksTable, loan.ShareLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModifications queries the modifications edge of a Loan.
+func (c *LoanClient) QueryModifications(l *Loan) *LoanModificationQuery {
+	query := (&LoanModificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(loanmodification.Table, loanmodification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ModificationsTable, loan.ModificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeferrals queries the deferrals edge of a Loan.
+func (c *LoanClient) QueryDeferrals(l *Loan) *PaymentDeferralQuery {
+	query := (&PaymentDeferralClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(paymentdeferral.Table, paymentdeferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.DeferralsTable, loan.DeferralsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecasts queries the recasts edge of a Loan.
+func (c *LoanClient) QueryRecasts(l *Loan) *LoanRecastQuery {
+	query := (&LoanRecastClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(loanrecast.Table, loanrecast.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.RecastsTable, loan.RecastsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIncomeDrivenPlan queries the income_driven_plan edge of a Loan.
+func (c *LoanClient) QueryIncomeDrivenPlan(l *Loan) *IncomeDrivenPlanQuery {
+	query := (&IncomeDrivenPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(incomedrivenplan.Table, incomedrivenplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, loan.IncomeDrivenPlanTable, loan.IncomeDrivenPlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDisbursements queries the disbursements edge of a Loan.
+func (c *LoanClient) QueryDisbursements(l *Loan) *LoanDisbursementQuery {
+	query := (&LoanDisbursementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(loandisbursement.Table, loandisbursement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.DisbursementsTable, loan.DisbursementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEscrowItems queries the escrow_items edge of a Loan.
+func (c *LoanClient) QueryEscrowItems(l *Loan) *EscrowItemQuery {
+	query := (&EscrowItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(escrowitem.Table, escrowitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.EscrowItemsTable, loan.EscrowItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryObligors queries the obligors edge of a Loan.
+func (c *LoanClient) QueryObligors(l *Loan) *LoanObligorQuery {
+	query := (&LoanObligorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(loanobligor.Table, loanobligor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ObligorsTable, loan.ObligorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCollateral queries the collateral edge of a Loan.
+func (c *LoanClient) QueryCollateral(l *Loan) *CollateralQuery {
+	query := (&CollateralClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(collateral.Table, collateral.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, loan.CollateralTable, loan.CollateralPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanClient) Hooks() []Hook {
+	hooks := c.hooks.Loan
+	return append(hooks[:len(hooks):len(hooks)], loan.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanClient) Interceptors() []Interceptor {
+	return c.inters.Loan
+}
+
+func (c *LoanClient) mutate(ctx context.Context, m *LoanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Loan mutation op: %q", m.Op())
+	}
+}
+
+// LoanDisbursementClient is a client for the LoanDisbursement schema.
+type LoanDisbursementClient struct {
+	config
+}
+
+// NewLoanDisbursementClient returns a client for the LoanDisbursement from the given config.
+func NewLoanDisbursementClient(c config) *LoanDisbursementClient {
+	return &LoanDisbursementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loandisbursement.Hooks(f(g(h())))`.
+func (c *LoanDisbursementClient) Use(hooks ...Hook) {
+	c.hooks.LoanDisbursement = append(c.hooks.LoanDisbursement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loandisbursement.Intercept(f(g(h())))`.
+func (c *LoanDisbursementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoanDisbursement = append(c.inters.LoanDisbursement, interceptors...)
+}
+
+// Create returns a builder for creating a LoanDisbursement entity.
+func (c *LoanDisbursementClient) Create() *LoanDisbursementCreate {
+	mutation := newLoanDisbursementMutation(c.config, OpCreate)
+	return &LoanDisbursementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoanDisbursement entities.
+func (c *LoanDisbursementClient) CreateBulk(builders ...*LoanDisbursementCreate) *LoanDisbursementCreateBulk {
+	return &LoanDisbursementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanDisbursementClient) MapCreateBulk(slice any, setFunc func(*LoanDisbursementCreate, int)) *LoanDisbursementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanDisbursementCreateBulk{err: fmt.Errorf("calling to LoanDisbursementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanDisbursementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanDisbursementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoanDisbursement.
+func (c *LoanDisbursementClient) Update() *LoanDisbursementUpdate {
+	mutation := newLoanDisbursementMutation(c.config, OpUpdate)
+	return &LoanDisbursementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanDisbursementClient) UpdateOne(ld *LoanDisbursement) *LoanDisbursementUpdateOne {
+	mutation := newLoanDisbursementMutation(c.config, OpUpdateOne, withLoanDisbursement(ld))
+	return &LoanDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanDisbursementClient) UpdateOneID(id int) *LoanDisbursementUpdateOne {
+	mutation := newLoanDisbursementMutation(c.config, OpUpdateOne, withLoanDisbursementID(id))
+	return &LoanDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoanDisbursement.
+func (c *LoanDisbursementClient) Delete() *LoanDisbursementDelete {
+	mutation := newLoanDisbursementMutation(c.config, OpDelete)
+	return &LoanDisbursementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanDisbursementClient) DeleteOne(ld *LoanDisbursement) *LoanDisbursementDeleteOne {
+	return c.DeleteOneID(ld.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanDisbursementClient) DeleteOneID(id int) *LoanDisbursementDeleteOne {
+	builder := c.Delete().Where(loandisbursement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanDisbursementDeleteOne{builder}
+}
+
+// Query returns a query builder for LoanDisbursement.
+func (c *LoanDisbursementClient) Query() *LoanDisbursementQuery {
+	return &LoanDisbursementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoanDisbursement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoanDisbursement entity by its id.
+func (c *LoanDisbursementClient) Get(ctx context.Context, id int) (*LoanDisbursement, error) {
+	return c.Query().Where(loandisbursement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanDisbursementClient) GetX(ctx context.Context, id int) *LoanDisbursement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a LoanDisbursement.
+func (c *LoanDisbursementClient) QueryLoan(ld *LoanDisbursement) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ld.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loandisbursement.Table, loandisbursement.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loandisbursement.LoanTable, loandisbursement.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(ld.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanDisbursementClient) Hooks() []Hook {
+	return c.hooks.LoanDisbursement
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanDisbursementClient) Interceptors() []Interceptor {
+	return c.inters.LoanDisbursement
+}
+
+func (c *LoanDisbursementClient) mutate(ctx context.Context, m *LoanDisbursementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanDisbursementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanDisbursementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanDisbursementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoanDisbursement mutation op: %q", m.Op())
+	}
+}
+
+// LoanModificationClient is a client for the LoanModification schema.
+type LoanModificationClient struct {
+	config
+}
+
+// NewLoanModificationClient returns a client for the LoanModification from the given config.
+func NewLoanModificationClient(c config) *LoanModificationClient {
+	return &LoanModificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loanmodification.Hooks(f(g(h())))`.
+func (c *LoanModificationClient) Use(hooks ...Hook) {
+	c.hooks.LoanModification = append(c.hooks.LoanModification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loanmodification.Intercept(f(g(h())))`.
+func (c *LoanModificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoanModification = append(c.inters.LoanModification, interceptors...)
+}
+
+// Create returns a builder for creating a LoanModification entity.
+func (c *LoanModificationClient) Create() *LoanModificationCreate {
+	mutation := newLoanModificationMutation(c.config, OpCreate)
+	return &LoanModificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoanModification entities.
+func (c *LoanModificationClient) CreateBulk(builders ...*LoanModificationCreate) *LoanModificationCreateBulk {
+	return &LoanModificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanModificationClient) MapCreateBulk(slice any, setFunc func(*LoanModificationCreate, int)) *LoanModificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanModificationCreateBulk{err: fmt.Errorf("calling to LoanModificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanModificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanModificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoanModification.
+func (c *LoanModificationClient) Update() *LoanModificationUpdate {
+	mutation := newLoanModificationMutation(c.config, OpUpdate)
+	return &LoanModificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanModificationClient) UpdateOne(lm *LoanModification) *LoanModificationUpdateOne {
+	mutation := newLoanModificationMutation(c.config, OpUpdateOne, withLoanModification(lm))
+	return &LoanModificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanModificationClient) UpdateOneID(id int) *LoanModificationUpdateOne {
+	mutation := newLoanModificationMutation(c.config, OpUpdateOne, withLoanModificationID(id))
+	return &LoanModificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoanModification.
+func (c *LoanModificationClient) Delete() *LoanModificationDelete {
+	mutation := newLoanModificationMutation(c.config, OpDelete)
+	return &LoanModificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanModificationClient) DeleteOne(lm *LoanModification) *LoanModificationDeleteOne {
+	return c.DeleteOneID(lm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanModificationClient) DeleteOneID(id int) *LoanModificationDeleteOne {
+	builder := c.Delete().Where(loanmodification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanModificationDeleteOne{builder}
+}
+
+// Query returns a query builder for LoanModification.
+func (c *LoanModificationClient) Query() *LoanModificationQuery {
+	return &LoanModificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoanModification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoanModification entity by its id.
+func (c *LoanModificationClient) Get(ctx context.Context, id int) (*LoanModification, error) {
+	return c.Query().Where(loanmodification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanModificationClient) GetX(ctx context.Context, id int) *LoanModification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a LoanModification.
+func (c *LoanModificationClient) QueryLoan(lm *LoanModification) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanmodification.Table, loanmodification.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanmodification.LoanTable, loanmodification.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(lm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanModificationClient) Hooks() []Hook {
+	return c.hooks.LoanModification
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanModificationClient) Interceptors() []Interceptor {
+	return c.inters.LoanModification
+}
+
+func (c *LoanModificationClient) mutate(ctx context.Context, m *LoanModificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanModificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanModificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanModificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanModificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoanModification mutation op: %q", m.Op())
+	}
+}
+
+// LoanObligorClient is a client for the LoanObligor schema.
+type LoanObligorClient struct {
+	config
+}
+
+// NewLoanObligorClient returns a client for the LoanObligor from the given config.
+func NewLoanObligorClient(c config) *LoanObligorClient {
+	return &LoanObligorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loanobligor.Hooks(f(g(h())))`.
+func (c *LoanObligorClient) Use(hooks ...Hook) {
+	c.hooks.LoanObligor = append(c.hooks.LoanObligor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loanobligor.Intercept(f(g(h())))`.
+func (c *LoanObligorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoanObligor = append(c.inters.LoanObligor, interceptors...)
+}
+
+// Create returns a builder for creating a LoanObligor entity.
+func (c *LoanObligorClient) Create() *LoanObligorCreate {
+	mutation := newLoanObligorMutation(c.config, OpCreate)
+	return &LoanObligorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoanObligor entities.
+func (c *LoanObligorClient) CreateBulk(builders ...*LoanObligorCreate) *LoanObligorCreateBulk {
+	return &LoanObligorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanObligorClient) MapCreateBulk(slice any, setFunc func(*LoanObligorCreate, int)) *LoanObligorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanObligorCreateBulk{err: fmt.Errorf("calling to LoanObligorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanObligorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanObligorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoanObligor.
+func (c *LoanObligorClient) Update() *LoanObligorUpdate {
+	mutation := newLoanObligorMutation(c.config, OpUpdate)
+	return &LoanObligorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanObligorClient) UpdateOne(lo *LoanObligor) *LoanObligorUpdateOne {
+	mutation := newLoanObligorMutation(c.config, OpUpdateOne, withLoanObligor(lo))
+	return &LoanObligorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanObligorClient) UpdateOneID(id int) *LoanObligorUpdateOne {
+	mutation := newLoanObligorMutation(c.config, OpUpdateOne, withLoanObligorID(id))
+	return &LoanObligorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoanObligor.
+func (c *LoanObligorClient) Delete() *LoanObligorDelete {
+	mutation := newLoanObligorMutation(c.config, OpDelete)
+	return &LoanObligorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanObligorClient) DeleteOne(lo *LoanObligor) *LoanObligorDeleteOne {
+	return c.DeleteOneID(lo.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanObligorClient) DeleteOneID(id int) *LoanObligorDeleteOne {
+	builder := c.Delete().Where(loanobligor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanObligorDeleteOne{builder}
+}
+
+// Query returns a query builder for LoanObligor.
+func (c *LoanObligorClient) Query() *LoanObligorQuery {
+	return &LoanObligorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoanObligor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoanObligor entity by its id.
+func (c *LoanObligorClient) Get(ctx context.Context, id int) (*LoanObligor, error) {
+	return c.Query().Where(loanobligor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanObligorClient) GetX(ctx context.Context, id int) *LoanObligor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a LoanObligor.
+func (c *LoanObligorClient) QueryLoan(lo *LoanObligor) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanobligor.Table, loanobligor.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanobligor.LoanTable, loanobligor.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(lo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a LoanObligor.
+func (c *LoanObligorClient) QueryUser(lo *LoanObligor) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanobligor.Table, loanobligor.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanobligor.UserTable, loanobligor.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(lo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanObligorClient) Hooks() []Hook {
+	return c.hooks.LoanObligor
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanObligorClient) Interceptors() []Interceptor {
+	return c.inters.LoanObligor
+}
+
+func (c *LoanObligorClient) mutate(ctx context.Context, m *LoanObligorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanObligorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanObligorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanObligorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanObligorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoanObligor mutation op: %q", m.Op())
+	}
+}
+
+// LoanRecastClient is a client for the LoanRecast schema.
+type LoanRecastClient struct {
+	config
+}
+
+// NewLoanRecastClient returns a client for the LoanRecast from the given config.
+func NewLoanRecastClient(c config) *LoanRecastClient {
+	return &LoanRecastClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loanrecast.Hooks(f(g(h())))`.
+func (c *LoanRecastClient) Use(hooks ...Hook) {
+	c.hooks.LoanRecast = append(c.hooks.LoanRecast, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loanrecast.Intercept(f(g(h())))`.
+func (c *LoanRecastClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoanRecast = append(c.inters.LoanRecast, interceptors...)
+}
+
+// Create returns a builder for creating a LoanRecast entity.
+func (c *LoanRecastClient) Create() *LoanRecastCreate {
+	mutation := newLoanRecastMutation(c.config, OpCreate)
+	return &LoanRecastCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoanRecast entities.
+func (c *LoanRecastClient) CreateBulk(builders ...*LoanRecastCreate) *LoanRecastCreateBulk {
+	return &LoanRecastCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanRecastClient) MapCreateBulk(slice any, setFunc func(*LoanRecastCreate, int)) *LoanRecastCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanRecastCreateBulk{err: fmt.Errorf("calling to LoanRecastClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanRecastCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanRecastCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoanRecast.
+func (c *LoanRecastClient) Update() *LoanRecastUpdate {
+	mutation := newLoanRecastMutation(c.config, OpUpdate)
+	return &LoanRecastUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanRecastClient) UpdateOne(lr *LoanRecast) *LoanRecastUpdateOne {
+	mutation := newLoanRecastMutation(c.config, OpUpdateOne, withLoanRecast(lr))
+	return &LoanRecastUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanRecastClient) UpdateOneID(id int) *LoanRecastUpdateOne {
+	mutation := newLoanRecastMutation(c.config, OpUpdateOne, withLoanRecastID(id))
+	return &LoanRecastUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoanRecast.
+func (c *LoanRecastClient) Delete() *LoanRecastDelete {
+	mutation := newLoanRecastMutation(c.config, OpDelete)
+	return &LoanRecastDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanRecastClient) DeleteOne(lr *LoanRecast) *LoanRecastDeleteOne {
+	return c.DeleteOneID(lr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanRecastClient) DeleteOneID(id int) *LoanRecastDeleteOne {
+	builder := c.Delete().Where(loanrecast.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanRecastDeleteOne{builder}
+}
+
+// Query returns a query builder for LoanRecast.
+func (c *LoanRecastClient) Query() *LoanRecastQuery {
+	return &LoanRecastQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoanRecast},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoanRecast entity by its id.
+func (c *LoanRecastClient) Get(ctx context.Context, id int) (*LoanRecast, error) {
+	return c.Query().Where(loanrecast.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanRecastClient) GetX(ctx context.Context, id int) *LoanRecast {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a LoanRecast.
+func (c *LoanRecastClient) QueryLoan(lr *LoanRecast) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanrecast.Table, loanrecast.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanrecast.LoanTable, loanrecast.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanRecastClient) Hooks() []Hook {
+	return c.hooks.LoanRecast
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanRecastClient) Interceptors() []Interceptor {
+	return c.inters.LoanRecast
+}
+
+func (c *LoanRecastClient) mutate(ctx context.Context, m *LoanRecastMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanRecastCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanRecastUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanRecastUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanRecastDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoanRecast mutation op: %q", m.Op())
+	}
+}
+
+// OidcLoginClient is a client for the OidcLogin schema.
+type OidcLoginClient struct {
+	config
+}
+
+// NewOidcLoginClient returns a client for the OidcLogin from the given config.
+func NewOidcLoginClient(c config) *OidcLoginClient {
+	return &OidcLoginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oidclogin.Hooks(f(g(h())))`.
+func (c *OidcLoginClient) Use(hooks ...Hook) {
+	c.hooks.OidcLogin = append(c.hooks.OidcLogin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oidclogin.Intercept(f(g(h())))`.
+func (c *OidcLoginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OidcLogin = append(c.inters.OidcLogin, interceptors...)
+}
+
+// Create returns a builder for creating a OidcLogin entity.
+func (c *OidcLoginClient) Create() *OidcLoginCreate {
+	mutation := newOidcLoginMutation(c.config, OpCreate)
+	return &OidcLoginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OidcLogin entities.
+func (c *OidcLoginClient) CreateBulk(builders ...*OidcLoginCreate) *OidcLoginCreateBulk {
+	return &OidcLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OidcLoginClient) MapCreateBulk(slice any, setFunc func(*OidcLoginCreate, int)) *OidcLoginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OidcLoginCreateBulk{err: fmt.Errorf("calling to OidcLoginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OidcLoginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OidcLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OidcLogin.
+func (c *OidcLoginClient) Update() *OidcLoginUpdate {
+	mutation := newOidcLoginMutation(c.config, OpUpdate)
+	return &OidcLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OidcLoginClient) UpdateOne(ol *OidcLogin) *OidcLoginUpdateOne {
+	mutation := newOidcLoginMutation(c.config, OpUpdateOne, withOidcLogin(ol))
+	return &OidcLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OidcLoginClient) UpdateOneID(id int) *OidcLoginUpdateOne {
+	mutation := newOidcLoginMutation(c.config, OpUpdateOne, withOidcLoginID(id))
+	return &OidcLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OidcLogin.
+func (c *OidcLoginClient) Delete() *OidcLoginDelete {
+	mutation := newOidcLoginMutation(c.config, OpDelete)
+	return &OidcLoginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OidcLoginClient) DeleteOne(ol *OidcLogin) *OidcLoginDeleteOne {
+	return c.DeleteOneID(ol.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OidcLoginClient) DeleteOneID(id int) *OidcLoginDeleteOne {
+	builder := c.Delete().Where(oidclogin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OidcLoginDeleteOne{builder}
+}
+
+// Query returns a query builder for OidcLogin.
+func (c *OidcLoginClient) Query() *OidcLoginQuery {
+	return &OidcLoginQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOidcLogin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OidcLogin entity by its id.
+func (c *OidcLoginClient) Get(ctx context.Context, id int) (*OidcLogin, error) {
+	return c.Query().Where(oidclogin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OidcLoginClient) GetX(ctx context.Context, id int) *OidcLogin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OidcLoginClient) Hooks() []Hook {
+	return c.hooks.OidcLogin
+}
+
+// Interceptors returns the client interceptors.
+func (c *OidcLoginClient) Interceptors() []Interceptor {
+	return c.inters.OidcLogin
+}
+
+func (c *OidcLoginClient) mutate(ctx context.Context, m *OidcLoginMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OidcLoginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OidcLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OidcLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OidcLoginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OidcLogin mutation op: %q", m.Op())
+	}
+}
+
+// PaymentDeferralClient is a client for the PaymentDeferral schema.
+type PaymentDeferralClient struct {
+	config
+}
+
+// NewPaymentDeferralClient returns a client for the PaymentDeferral from the given config.
+func NewPaymentDeferralClient(c config) *PaymentDeferralClient {
+	return &PaymentDeferralClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentdeferral.Hooks(f(g(h())))`.
+func (c *PaymentDeferralClient) Use(hooks ...Hook) {
+	c.hooks.PaymentDeferral = append(c.hooks.PaymentDeferral, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentdeferral.Intercept(f(g(h())))`.
+func (c *PaymentDeferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentDeferral = append(c.inters.PaymentDeferral, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentDeferral entity.
+func (c *PaymentDeferralClient) Create() *PaymentDeferralCreate {
+	mutation := newPaymentDeferralMutation(c.config, OpCreate)
+	return &PaymentDeferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentDeferral entities.
+func (c *PaymentDeferralClient) CreateBulk(builders ...*PaymentDeferralCreate) *PaymentDeferralCreateBulk {
+	return &PaymentDeferralCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentDeferralClient) MapCreateBulk(slice any, setFunc func(*PaymentDeferralCreate, int)) *PaymentDeferralCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentDeferralCreateBulk{err: fmt.Errorf("calling to PaymentDeferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentDeferralCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentDeferralCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentDeferral.
+func (c *PaymentDeferralClient) Update() *PaymentDeferralUpdate {
+	mutation := newPaymentDeferralMutation(c.config, OpUpdate)
+	return &PaymentDeferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentDeferralClient) UpdateOne(pd *PaymentDeferral) *PaymentDeferralUpdateOne {
+	mutation := newPaymentDeferralMutation(c.config, OpUpdateOne, withPaymentDeferral(pd))
+	return &PaymentDeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentDeferralClient) UpdateOneID(id int) *PaymentDeferralUpdateOne {
+	mutation := newPaymentDeferralMutation(c.config, OpUpdateOne, withPaymentDeferralID(id))
+	return &PaymentDeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentDeferral.
+func (c *PaymentDeferralClient) Delete() *PaymentDeferralDelete {
+	mutation := newPaymentDeferralMutation(c.config, OpDelete)
+	return &PaymentDeferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentDeferralClient) DeleteOne(pd *PaymentDeferral) *PaymentDeferralDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentDeferralClient) DeleteOneID(id int) *PaymentDeferralDeleteOne {
+	builder := c.Delete().Where(paymentdeferral.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentDeferralDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentDeferral.
+func (c *PaymentDeferralClient) Query() *PaymentDeferralQuery {
+	return &PaymentDeferralQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentDeferral},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentDeferral entity by its id.
+func (c *PaymentDeferralClient) Get(ctx context.Context, id int) (*PaymentDeferral, error) {
+	return c.Query().Where(paymentdeferral.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentDeferralClient) GetX(ctx context.Context, id int) *PaymentDeferral {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a PaymentDeferral.
+func (c *PaymentDeferralClient) QueryLoan(pd *PaymentDeferral) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentdeferral.Table, paymentdeferral.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentdeferral.LoanTable, paymentdeferral.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(pd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentDeferralClient) Hooks() []Hook {
+	return c.hooks.PaymentDeferral
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentDeferralClient) Interceptors() []Interceptor {
+	return c.inters.PaymentDeferral
+}
+
+func (c *PaymentDeferralClient) mutate(ctx context.Context, m *PaymentDeferralMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentDeferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentDeferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentDeferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentDeferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentDeferral mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// ShareInvitationClient is a client for the ShareInvitation schema.
+type ShareInvitationClient struct {
+	config
+}
+
+// NewShareInvitationClient returns a client for the ShareInvitation from the given config.
+func NewShareInvitationClient(c config) *ShareInvitationClient {
+	return &ShareInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shareinvitation.Hooks(f(g(h())))`.
+func (c *ShareInvitationClient) Use(hooks ...Hook) {
+	c.hooks.ShareInvitation = append(c.hooks.ShareInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shareinvitation.Intercept(f(g(h())))`.
+func (c *ShareInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareInvitation = append(c.inters.ShareInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a ShareInvitation entity.
+func (c *ShareInvitationClient) Create() *ShareInvitationCreate {
+	mutation := newShareInvitationMutation(c.config, OpCreate)
+	return &ShareInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareInvitation entities.
+func (c *ShareInvitationClient) CreateBulk(builders ...*ShareInvitationCreate) *ShareInvitationCreateBulk {
+	return &ShareInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareInvitationClient) MapCreateBulk(slice any, setFunc func(*ShareInvitationCreate, int)) *ShareInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareInvitationCreateBulk{err: fmt.Errorf("calling to ShareInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareInvitation.
+func (c *ShareInvitationClient) Update() *ShareInvitationUpdate {
+	mutation := newShareInvitationMutation(c.config, OpUpdate)
+	return &ShareInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareInvitationClient) UpdateOne(si *ShareInvitation) *ShareInvitationUpdateOne {
+	mutation := newShareInvitationMutation(c.config, OpUpdateOne, withShareInvitation(si))
+	return &ShareInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareInvitationClient) UpdateOneID(id int) *ShareInvitationUpdateOne {
+	mutation := newShareInvitationMutation(c.config, OpUpdateOne, withShareInvitationID(id))
+	return &ShareInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareInvitation.
+func (c *ShareInvitationClient) Delete() *ShareInvitationDelete {
+	mutation := newShareInvitationMutation(c.config, OpDelete)
+	return &ShareInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareInvitationClient) DeleteOne(si *ShareInvitation) *ShareInvitationDeleteOne {
+	return c.DeleteOneID(si.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareInvitationClient) DeleteOneID(id int) *ShareInvitationDeleteOne {
+	builder := c.Delete().Where(shareinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareInvitation.
+func (c *ShareInvitationClient) Query() *ShareInvitationQuery {
+	return &ShareInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShareInvitation entity by its id.
+func (c *ShareInvitationClient) Get(ctx context.Context, id int) (*ShareInvitation, error) {
+	return c.Query().Where(shareinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareInvitationClient) GetX(ctx context.Context, id int) *ShareInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a ShareInvitation.
+func (c *ShareInvitationClient) QueryLoan(si *ShareInvitation) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareinvitation.Table, shareinvitation.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareinvitation.LoanTable, shareinvitation.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ShareInvitation.
+func (c *ShareInvitationClient) QueryUser(si *ShareInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareinvitation.Table, shareinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareinvitation.UserTable, shareinvitation.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareInvitationClient) Hooks() []Hook {
+	return c.hooks.ShareInvitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareInvitationClient) Interceptors() []Interceptor {
+	return c.inters.ShareInvitation
+}
+
+func (c *ShareInvitationClient) mutate(ctx context.Context, m *ShareInvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShareInvitation mutation op: %q", m.Op())
+	}
+}
+
+// ShareLinkClient is a client for the ShareLink schema.
+type ShareLinkClient struct {
+	config
+}
+
+// NewShareLinkClient returns a client for the ShareLink from the given config.
+func NewShareLinkClient(c config) *ShareLinkClient {
+	return &ShareLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharelink.Hooks(f(g(h())))`.
+func (c *ShareLinkClient) Use(hooks ...Hook) {
+	c.hooks.ShareLink = append(c.hooks.ShareLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharelink.Intercept(f(g(h())))`.
+func (c *ShareLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareLink = append(c.inters.ShareLink, interceptors...)
+}
+
+// Create returns a builder for creating a ShareLink entity.
+func (c *ShareLinkClient) Create() *ShareLinkCreate {
+	mutation := newShareLinkMutation(c.config, OpCreate)
+	return &ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareLink entities.
+func (c *ShareLinkClient) CreateBulk(builders ...*ShareLinkCreate) *ShareLinkCreateBulk {
+	return &ShareLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareLinkClient) MapCreateBulk(slice any, setFunc func(*ShareLinkCreate, int)) *ShareLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareLinkCreateBulk{err: fmt.Errorf("calling to ShareLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareLink.
+func (c *ShareLinkClient) Update() *ShareLinkUpdate {
+	mutation := newShareLinkMutation(c.config, OpUpdate)
+	return &ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareLinkClient) UpdateOne(sl *ShareLink) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLink(sl))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareLinkClient) UpdateOneID(id int) *ShareLinkUpdateOne {
+	mutation := newShareLinkMutation(c.config, OpUpdateOne, withShareLinkID(id))
+	return &ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareLink.
+func (c *ShareLinkClient) Delete() *ShareLinkDelete {
+	mutation := newShareLinkMutation(c.config, OpDelete)
+	return &ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareLinkClient) DeleteOne(sl *ShareLink) *ShareLinkDeleteOne {
+	return c.DeleteOneID(sl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareLinkClient) DeleteOneID(id int) *ShareLinkDeleteOne {
+	builder := c.Delete().Where(sharelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareLink.
+func (c *ShareLinkClient) Query() *ShareLinkQuery {
+	return &ShareLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShareLink entity by its id.
+func (c *ShareLinkClient) Get(ctx context.Context, id int) (*ShareLink, error) {
+	return c.Query().Where(sharelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareLinkClient) GetX(ctx context.Context, id int) *ShareLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoan queries the loan edge of a ShareLink.
+func (c *ShareLinkClient) QueryLoan(sl *ShareLink) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelink.LoanTable, sharelink.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccesses queries the accesses edge of a ShareLink.
+func (c *ShareLinkClient) QueryAccesses(sl *ShareLink) *ShareLinkAccessQuery {
+	query := (&ShareLinkAccessClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelink.Table, sharelink.FieldID, id),
+			sqlgraph.To(sharelinkaccess.Table, sharelinkaccess.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sharelink.AccessesTable, sharelink.AccessesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareLinkClient) Hooks() []Hook {
+	return c.hooks.ShareLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareLinkClient) Interceptors() []Interceptor {
+	return c.inters.ShareLink
+}
+
+func (c *ShareLinkClient) mutate(ctx context.Context, m *ShareLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShareLink mutation op: %q", m.Op())
+	}
+}
+
+// ShareLinkAccessClient is a client for the ShareLinkAccess schema.
+type ShareLinkAccessClient struct {
+	config
+}
+
+// NewShareLinkAccessClient returns a client for the ShareLinkAccess from the given config.
+func NewShareLinkAccessClient(c config) *ShareLinkAccessClient {
+	return &ShareLinkAccessClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharelinkaccess.Hooks(f(g(h())))`.
+func (c *ShareLinkAccessClient) Use(hooks ...Hook) {
+	c.hooks.ShareLinkAccess = append(c.hooks.ShareLinkAccess, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharelinkaccess.Intercept(f(g(h())))`.
+func (c *ShareLinkAccessClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShareLinkAccess = append(c.inters.ShareLinkAccess, interceptors...)
+}
+
+// Create returns a builder for creating a ShareLinkAccess entity.
+func (c *ShareLinkAccessClient) Create() *ShareLinkAccessCreate {
+	mutation := newShareLinkAccessMutation(c.config, OpCreate)
+	return &ShareLinkAccessCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShareLinkAccess entities.
+func (c *ShareLinkAccessClient) CreateBulk(builders ...*ShareLinkAccessCreate) *ShareLinkAccessCreateBulk {
+	return &ShareLinkAccessCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareLinkAccessClient) MapCreateBulk(slice any, setFunc func(*ShareLinkAccessCreate, int)) *ShareLinkAccessCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareLinkAccessCreateBulk{err: fmt.Errorf("calling to ShareLinkAccessClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareLinkAccessCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareLinkAccessCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShareLinkAccess.
+func (c *ShareLinkAccessClient) Update() *ShareLinkAccessUpdate {
+	mutation := newShareLinkAccessMutation(c.config, OpUpdate)
+	return &ShareLinkAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareLinkAccessClient) UpdateOne(sla *ShareLinkAccess) *ShareLinkAccessUpdateOne {
+	mutation := newShareLinkAccessMutation(c.config, OpUpdateOne, withShareLinkAccess(sla))
+	return &ShareLinkAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareLinkAccessClient) UpdateOneID(id int) *ShareLinkAccessUpdateOne {
+	mutation := newShareLinkAccessMutation(c.config, OpUpdateOne, withShareLinkAccessID(id))
+	return &ShareLinkAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShareLinkAccess.
+func (c *ShareLinkAccessClient) Delete() *ShareLinkAccessDelete {
+	mutation := newShareLinkAccessMutation(c.config, OpDelete)
+	return &ShareLinkAccessDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareLinkAccessClient) DeleteOne(sla *ShareLinkAccess) *ShareLinkAccessDeleteOne {
+	return c.DeleteOneID(sla.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareLinkAccessClient) DeleteOneID(id int) *ShareLinkAccessDeleteOne {
+	builder := c.Delete().Where(sharelinkaccess.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareLinkAccessDeleteOne{builder}
+}
+
+// Query returns a query builder for ShareLinkAccess.
+func (c *ShareLinkAccessClient) Query() *ShareLinkAccessQuery {
+	return &ShareLinkAccessQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShareLinkAccess},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShareLinkAccess entity by its id.
+func (c *ShareLinkAccessClient) Get(ctx context.Context, id int) (*ShareLinkAccess, error) {
+	return c.Query().Where(sharelinkaccess.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareLinkAccessClient) GetX(ctx context.Context, id int) *ShareLinkAccess {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShareLink queries the share_link edge of a ShareLinkAccess.
+func (c *ShareLinkAccessClient) QueryShareLink(sla *ShareLinkAccess) *ShareLinkQuery {
+	query := (&ShareLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sla.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelinkaccess.Table, sharelinkaccess.FieldID, id),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelinkaccess.ShareLinkTable, sharelinkaccess.ShareLinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(sla.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareLinkAccessClient) Hooks() []Hook {
+	return c.hooks.ShareLinkAccess
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareLinkAccessClient) Interceptors() []Interceptor {
+	return c.inters.ShareLinkAccess
+}
+
+func (c *ShareLinkAccessClient) mutate(ctx context.Context, m *ShareLinkAccessMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareLinkAccessCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareLinkAccessUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareLinkAccessUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareLinkAccessDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShareLinkAccess mutation op: %q", m.Op())
+	}
+}
+
+// SharedLoanClient is a client for the SharedLoan schema.
+type SharedLoanClient struct {
+	config
+}
+
+// NewSharedLoanClient returns a client for the SharedLoan from the given config.
+func NewSharedLoanClient(c config) *SharedLoanClient {
+	return &SharedLoanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedloan.Hooks(f(g(h())))`.
+func (c *SharedLoanClient) Use(hooks ...Hook) {
+	c.hooks.SharedLoan = append(c.hooks.SharedLoan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharedloan.Intercept(f(g(h())))`.
+func (c *SharedLoanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SharedLoan = append(c.inters.SharedLoan, interceptors...)
+}
+
+// Create returns a builder for creating a SharedLoan entity.
+func (c *SharedLoanClient) Create() *SharedLoanCreate {
+	mutation := newSharedLoanMutation(c.config, OpCreate)
+	return &SharedLoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedLoan entities.
+func (c *SharedLoanClient) CreateBulk(builders ...*SharedLoanCreate) *SharedLoanCreateBulk {
+	return &SharedLoanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SharedLoanClient) MapCreateBulk(slice any, setFunc func(*SharedLoanCreate, int)) *SharedLoanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SharedLoanCreateBulk{err: fmt.Errorf("calling to SharedLoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SharedLoanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SharedLoanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedLoan.
+func (c *SharedLoanClient) Update() *SharedLoanUpdate {
+	mutation := newSharedLoanMutation(c.config, OpUpdate)
+	return &SharedLoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedLoanClient) UpdateOne(sl *SharedLoan) *SharedLoanUpdateOne {
+	mutation := newSharedLoanMutation(c.config, OpUpdateOne, withSharedLoan(sl))
+	return &SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedLoanClient) UpdateOneID(id int) *SharedLoanUpdateOne {
+	mutation := newSharedLoanMutation(c.config, OpUpdateOne, withSharedLoanID(id))
+	return &SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedLoan.
+func (c *SharedLoanClient) Delete() *SharedLoanDelete {
+	mutation := newSharedLoanMutation(c.config, OpDelete)
+	return &SharedLoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedLoanClient) DeleteOne(sl *SharedLoan) *SharedLoanDeleteOne {
+	return c.DeleteOneID(sl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedLoanClient) DeleteOneID(id int) *SharedLoanDeleteOne {
+	builder := c.Delete().Where(sharedloan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedLoanDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedLoan.
+func (c *SharedLoanClient) Query() *SharedLoanQuery {
+	return &SharedLoanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSharedLoan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SharedLoan entity by its id.
+func (c *SharedLoanClient) Get(ctx context.Context, id int) (*SharedLoan, error) {
+	return c.Query().Where(sharedloan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedLoanClient) GetX(ctx context.Context, id int) *SharedLoan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a SharedLoan.
+func (c *SharedLoanClient) QueryUser(sl *SharedLoan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharedloan.Table, sharedloan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharedloan.UserTable, sharedloan.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoan queries the loan edge of a SharedLoan.
+func (c *SharedLoanClient) QueryLoan(sl *SharedLoan) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharedloan.Table, sharedloan.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharedloan.LoanTable, sharedloan.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(sl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SharedLoanClient) Hooks() []Hook {
+	hooks := c.hooks.SharedLoan
+	return append(hooks[:len(hooks):len(hooks)], sharedloan.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SharedLoanClient) Interceptors() []Interceptor {
+	return c.inters.SharedLoan
+}
+
+func (c *SharedLoanClient) mutate(ctx context.Context, m *SharedLoanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SharedLoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SharedLoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SharedLoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SharedLoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SharedLoan mutation op: %q", m.Op())
+	}
+}
+
+// StaffRoleClient is a client for the StaffRole schema.
+type StaffRoleClient struct {
+	config
+}
+
+// NewStaffRoleClient returns a client for the StaffRole from the given config.
+func NewStaffRoleClient(c config) *StaffRoleClient {
+	return &StaffRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `staffrole.Hooks(f(g(h())))`.
+func (c *StaffRoleClient) Use(hooks ...Hook) {
+	c.hooks.StaffRole = append(c.hooks.StaffRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `staffrole.Intercept(f(g(h())))`.
+func (c *StaffRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StaffRole = append(c.inters.StaffRole, interceptors...)
+}
+
+// Create returns a builder for creating a StaffRole entity.
+func (c *StaffRoleClient) Create() *StaffRoleCreate {
+	mutation := newStaffRoleMutation(c.config, OpCreate)
+	return &StaffRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StaffRole entities.
+func (c *StaffRoleClient) CreateBulk(builders ...*StaffRoleCreate) *StaffRoleCreateBulk {
+	return &StaffRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StaffRoleClient) MapCreateBulk(slice any, setFunc func(*StaffRoleCreate, int)) *StaffRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StaffRoleCreateBulk{err: fmt.Errorf("calling to StaffRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StaffRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StaffRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StaffRole.
+func (c *StaffRoleClient) Update() *StaffRoleUpdate {
+	mutation := newStaffRoleMutation(c.config, OpUpdate)
+	return &StaffRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StaffRoleClient) UpdateOne(sr *StaffRole) *StaffRoleUpdateOne {
+	mutation := newStaffRoleMutation(c.config, OpUpdateOne, withStaffRole(sr))
+	return &StaffRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StaffRoleClient) UpdateOneID(id int) *StaffRoleUpdateOne {
+	mutation := newStaffRoleMutation(c.config, OpUpdateOne, withStaffRoleID(id))
+	return &StaffRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StaffRole.
+func (c *StaffRoleClient) Delete() *StaffRoleDelete {
+	mutation := newStaffRoleMutation(c.config, OpDelete)
+	return &StaffRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StaffRoleClient) DeleteOne(sr *StaffRole) *StaffRoleDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StaffRoleClient) DeleteOneID(id int) *StaffRoleDeleteOne {
+	builder := c.Delete().Where(staffrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StaffRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for StaffRole.
+func (c *StaffRoleClient) Query() *StaffRoleQuery {
+	return &StaffRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStaffRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StaffRole entity by its id.
+func (c *StaffRoleClient) Get(ctx context.Context, id int) (*StaffRole, error) {
+	return c.Query().Where(staffrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StaffRoleClient) GetX(ctx context.Context, id int) *StaffRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a StaffRole.
+func (c *StaffRoleClient) QueryUser(sr *StaffRole) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staffrole.Table, staffrole.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, staffrole.UserTable, staffrole.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StaffRoleClient) Hooks() []Hook {
+	return c.hooks.StaffRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *StaffRoleClient) Interceptors() []Interceptor {
+	return c.inters.StaffRole
+}
+
+func (c *StaffRoleClient) mutate(ctx context.Context, m *StaffRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StaffRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StaffRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StaffRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StaffRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StaffRole mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLoans queries the loans edge of a User.
+func (c *UserClient) QueryLoans(u *User) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoansTable, user.LoansColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySharedLoan queries the shared_loan edge of a User.
+func (c *UserClient) QuerySharedLoan(u *User) *SharedLoanQuery {
+	query := (&SharedLoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sharedloan.Table, sharedloan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharedLoanTable, user.SharedLoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShareInvitations queries the share_invitations edge of a User.
+func (c *UserClient) QueryShareInvitations(u *User) *ShareInvitationQuery {
+	query := (&ShareInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(shareinvitation.Table, shareinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShareInvitationsTable, user.ShareInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreditLines queries the credit_lines edge of a User.
+func (c *UserClient) QueryCreditLines(u *User) *CreditLineQuery {
+	query := (&CreditLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(creditline.Table, creditline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreditLinesTable, user.CreditLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryObligations queries the obligations edge of a User.
+func (c *UserClient) QueryObligations(u *User) *LoanObligorQuery {
+	query := (&LoanObligorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loanobligor.Table, loanobligor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ObligationsTable, user.ObligationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCredential queries the credential edge of a User.
+func (c *UserClient) QueryCredential(u *User) *UserCredentialQuery {
+	query := (&UserCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usercredential.Table, usercredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.CredentialTable, user.CredentialColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStaffRoles queries the staff_roles edge of a User.
+func (c *UserClient) QueryStaffRoles(u *User) *StaffRoleQuery {
+	query := (&StaffRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(staffrole.Table, staffrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StaffRolesTable, user.StaffRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserChangeClient is a client for the UserChange schema.
+type UserChangeClient struct {
+	config
+}
+
+// NewUserChangeClient returns a client for the UserChange from the given config.
+func NewUserChangeClient(c config) *UserChangeClient {
+	return &UserChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userchange.Hooks(f(g(h())))`.
+func (c *UserChangeClient) Use(hooks ...Hook) {
+	c.hooks.UserChange = append(c.hooks.UserChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userchange.Intercept(f(g(h())))`.
+func (c *UserChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserChange = append(c.inters.UserChange, interceptors...)
+}
+
+// Create returns a builder for creating a UserChange entity.
+func (c *UserChangeClient) Create() *UserChangeCreate {
+	mutation := newUserChangeMutation(c.config, OpCreate)
+	return &UserChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserChange entities.
+func (c *UserChangeClient) CreateBulk(builders ...*UserChangeCreate) *UserChangeCreateBulk {
+	return &UserChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserChangeClient) MapCreateBulk(slice any, setFunc func(*UserChangeCreate, int)) *UserChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserChangeCreateBulk{err: fmt.Errorf("calling to UserChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserChange.
+func (c *UserChangeClient) Update() *UserChangeUpdate {
+	mutation := newUserChangeMutation(c.config, OpUpdate)
+	return &UserChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserChangeClient) UpdateOne(uc *UserChange) *UserChangeUpdateOne {
+	mutation := newUserChangeMutation(c.config, OpUpdateOne, withUserChange(uc))
+	return &UserChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserChangeClient) UpdateOneID(id int) *UserChangeUpdateOne {
+	mutation := newUserChangeMutation(c.config, OpUpdateOne, withUserChangeID(id))
+	return &UserChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserChange.
+func (c *UserChangeClient) Delete() *UserChangeDelete {
+	mutation := newUserChangeMutation(c.config, OpDelete)
+	return &UserChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserChangeClient) DeleteOne(uc *UserChange) *UserChangeDeleteOne {
+	return c.DeleteOneID(uc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserChangeClient) DeleteOneID(id int) *UserChangeDeleteOne {
+	builder := c.Delete().Where(userchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for UserChange.
+func (c *UserChangeClient) Query() *UserChangeQuery {
+	return &UserChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserChange entity by its id.
+func (c *UserChangeClient) Get(ctx context.Context, id int) (*UserChange, error) {
+	return c.Query().Where(userchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserChangeClient) GetX(ctx context.Context, id int) *UserChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserChangeClient) Hooks() []Hook {
+	return c.hooks.UserChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserChangeClient) Interceptors() []Interceptor {
+	return c.inters.UserChange
+}
+
+func (c *UserChangeClient) mutate(ctx context.Context, m *UserChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserChange mutation op: %q", m.Op())
+	}
+}
+
+// UserCredentialClient is a client for the UserCredential schema.
+type UserCredentialClient struct {
+	config
+}
+
+// NewUserCredentialClient returns a client for the UserCredential from the given config.
+func NewUserCredentialClient(c config) *UserCredentialClient {
+	return &UserCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usercredential.Hooks(f(g(h())))`.
+func (c *UserCredentialClient) Use(hooks ...Hook) {
+	c.hooks.UserCredential = append(c.hooks.UserCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usercredential.Intercept(f(g(h())))`.
+func (c *UserCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserCredential = append(c.inters.UserCredential, interceptors...)
+}
+
+// Create returns a builder for creating a UserCredential entity.
+func (c *UserCredentialClient) Create() *UserCredentialCreate {
+	mutation := newUserCredentialMutation(c.config, OpCreate)
+	return &UserCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserCredential entities.
+func (c *UserCredentialClient) CreateBulk(builders ...*UserCredentialCreate) *UserCredentialCreateBulk {
+	return &UserCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserCredentialClient) MapCreateBulk(slice any, setFunc func(*UserCredentialCreate, int)) *UserCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCredentialCreateBulk{err: fmt.Errorf("calling to UserCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserCredential.
+func (c *UserCredentialClient) Update() *UserCredentialUpdate {
+	mutation := newUserCredentialMutation(c.config, OpUpdate)
+	return &UserCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserCredentialClient) UpdateOne(uc *UserCredential) *UserCredentialUpdateOne {
+	mutation := newUserCredentialMutation(c.config, OpUpdateOne, withUserCredential(uc))
+	return &UserCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserCredentialClient) UpdateOneID(id int) *UserCredentialUpdateOne {
+	mutation := newUserCredentialMutation(c.config, OpUpdateOne, withUserCredentialID(id))
+	return &UserCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserCredential.
+func (c *UserCredentialClient) Delete() *UserCredentialDelete {
+	mutation := newUserCredentialMutation(c.config, OpDelete)
+	return &UserCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserCredentialClient) DeleteOne(uc *UserCredential) *UserCredentialDeleteOne {
+	return c.DeleteOneID(uc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserCredentialClient) DeleteOneID(id int) *UserCredentialDeleteOne {
+	builder := c.Delete().Where(usercredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for UserCredential.
+func (c *UserCredentialClient) Query() *UserCredentialQuery {
+	return &UserCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserCredential entity by its id.
+func (c *UserCredentialClient) Get(ctx context.Context, id int) (*UserCredential, error) {
+	return c.Query().Where(usercredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserCredentialClient) GetX(ctx context.Context, id int) *UserCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserCredential.
+func (c *UserCredentialClient) QueryUser(uc *UserCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := uc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usercredential.Table, usercredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, usercredential.UserTable, usercredential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(uc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserCredentialClient) Hooks() []Hook {
+	return c.hooks.UserCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserCredentialClient) Interceptors() []Interceptor {
+	return c.inters.UserCredential
+}
+
+func (c *UserCredentialClient) mutate(ctx context.Context, m *UserCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserCredential mutation op: %q", m.Op())
+	}
+}
+
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(ui *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(ui))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id int) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(ui *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(ui.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id int) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id int) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id int) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserIdentity.
+func (c *UserIdentityClient) QueryUser(ui *UserIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ui.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useridentity.UserTable, useridentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
+}
+
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Loan, SharedLoan, User []ent.Hook
+		ApiKey, Collateral, CollateralAppraisal, CreditLine, CreditLineTransaction,
+		EscrowItem, IncomeCertification, IncomeDrivenPlan, Loan, LoanDisbursement,
+		LoanModification, LoanObligor, LoanRecast, OidcLogin, PaymentDeferral, Session,
+		ShareInvitation, ShareLink, ShareLinkAccess, SharedLoan, StaffRole, User,
+		UserChange, UserCredential, UserIdentity []ent.Hook
 	}
 	inters struct {
-		Loan, SharedLoan, User []ent.Interceptor
+		ApiKey, Collateral, CollateralAppraisal, CreditLine, CreditLineTransaction,
+		EscrowItem, IncomeCertification, IncomeDrivenPlan, Loan, LoanDisbursement,
+		LoanModification, LoanObligor, LoanRecast, OidcLogin, PaymentDeferral, Session,
+		ShareInvitation, ShareLink, ShareLinkAccess, SharedLoan, StaffRole, User,
+		UserChange, UserCredential, UserIdentity []ent.Interceptor
 	}
 )
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/collateral"
+)
+
+// Collateral is the model entity for the Collateral schema.
+type Collateral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind collateral.Kind `json:"kind,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollateralQuery when eager-loading is set.
+	Edges        CollateralEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollateralEdges holds the relations/edges for other nodes in the graph.
+type CollateralEdges struct {
+	// Appraisals holds the value of the appraisals edge.
+	Appraisals []*CollateralAppraisal `json:"appraisals,omitempty"`
+	// Loans holds the value of the loans edge.
+	Loans []*Loan `json:"loans,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AppraisalsOrErr returns the Appraisals value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollateralEdges) AppraisalsOrErr() ([]*CollateralAppraisal, error) {
+	if e.loadedTypes[0] {
+		return e.Appraisals, nil
+	}
+	return nil, &NotLoadedError{edge: "appraisals"}
+}
+
+// LoansOrErr returns the Loans value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollateralEdges) LoansOrErr() ([]*Loan, error) {
+	if e.loadedTypes[1] {
+		return e.Loans, nil
+	}
+	return nil, &NotLoadedError{edge: "loans"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Collateral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collateral.FieldID:
+			values[i] = new(sql.NullInt64)
+		case collateral.FieldKind, collateral.FieldDescription:
+			values[i] = new(sql.NullString)
+		case collateral.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Collateral fields.
+func (c *Collateral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collateral.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case collateral.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				c.Kind = collateral.Kind(value.String)
+			}
+		case collateral.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				c.Description = value.String
+			}
+		case collateral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Collateral.
+// This includes values selected through modifiers, order, etc.
+func (c *Collateral) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryAppraisals queries the "appraisals" edge of the Collateral entity.
+func (c *Collateral) QueryAppraisals() *CollateralAppraisalQuery {
+	return NewCollateralClient(c.config).QueryAppraisals(c)
+}
+
+// QueryLoans queries the "loans" edge of the Collateral entity.
+func (c *Collateral) QueryLoans() *LoanQuery {
+	return NewCollateralClient(c.config).QueryLoans(c)
+}
+
+// Update returns a builder for updating this Collateral.
+// Note that you need to call Collateral.Unwrap() before calling this method if this Collateral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Collateral) Update() *CollateralUpdateOne {
+	return NewCollateralClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Collateral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Collateral) Unwrap() *Collateral {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Collateral is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Collateral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Collateral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", c.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Collaterals is a parsable slice of Collateral.
+type Collaterals []*Collateral
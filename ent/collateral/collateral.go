@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collateral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the collateral type in the database.
+	Label = "collateral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAppraisals holds the string denoting the appraisals edge name in mutations.
+	EdgeAppraisals = "appraisals"
+	// EdgeLoans holds the string denoting the loans edge name in mutations.
+	EdgeLoans = "loans"
+	// Table holds the table name of the collateral in the database.
+	Table = "collaterals"
+	// AppraisalsTable is the table that holds the appraisals relation/edge.
+	AppraisalsTable = "collateral_appraisals"
+	// AppraisalsInverseTable is the table name for the CollateralAppraisal entity.
+	// It exists in this package in order to avoid circular dependency with the "collateralappraisal" package.
+	AppraisalsInverseTable = "collateral_appraisals"
+	// AppraisalsColumn is the table column denoting the appraisals relation/edge.
+	AppraisalsColumn = "collateral_id"
+	// LoansTable is the table that holds the loans relation/edge. The primary key declared below.
+	LoansTable = "collateral_loans"
+	// LoansInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoansInverseTable = "loans"
+)
+
+// Columns holds all SQL columns for collateral fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldDescription,
+	FieldCreatedAt,
+}
+
+var (
+	// LoansPrimaryKey and LoansColumn2 are the table columns denoting the
+	// primary key for the loans relation (M2M).
+	LoansPrimaryKey = []string{"collateral_id", "loan_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindRealEstate Kind = "real_estate"
+	KindVehicle    Kind = "vehicle"
+	KindEquipment  Kind = "equipment"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindRealEstate, KindVehicle, KindEquipment:
+		return nil
+	default:
+		return fmt.Errorf("collateral: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the Collateral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAppraisalsCount orders the results by appraisals count.
+func ByAppraisalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppraisalsStep(), opts...)
+	}
+}
+
+// ByAppraisals orders the results by appraisals terms.
+func ByAppraisals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppraisalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoansCount orders the results by loans count.
+func ByLoansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoansStep(), opts...)
+	}
+}
+
+// ByLoans orders the results by loans terms.
+func ByLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAppraisalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppraisalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppraisalsTable, AppraisalsColumn),
+	)
+}
+func newLoansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, LoansTable, LoansPrimaryKey...),
+	)
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/loan"
+)
+
+// CollateralCreate is the builder for creating a Collateral entity.
+type CollateralCreate struct {
+	config
+	mutation *CollateralMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (cc *CollateralCreate) SetKind(c collateral.Kind) *CollateralCreate {
+	cc.mutation.SetKind(c)
+	return cc
+}
+
+// SetDescription sets the "description" field.
+func (cc *CollateralCreate) SetDescription(s string) *CollateralCreate {
+	cc.mutation.SetDescription(s)
+	return cc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cc *CollateralCreate) SetNillableDescription(s *string) *CollateralCreate {
+	if s != nil {
+		cc.SetDescription(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CollateralCreate) SetCreatedAt(t time.Time) *CollateralCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CollateralCreate) SetNillableCreatedAt(t *time.Time) *CollateralCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// AddAppraisalIDs adds the "appraisals" edge to the CollateralAppraisal entity by IDs.
+func (cc *CollateralCreate) AddAppraisalIDs(ids ...int) *CollateralCreate {
+	cc.mutation.AddAppraisalIDs(ids...)
+	return cc
+}
+
+// AddAppraisals adds the "appraisals" edges to the CollateralAppraisal entity.
+func (cc *CollateralCreate) AddAppraisals(c ...*CollateralAppraisal) *CollateralCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddAppraisalIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (cc *CollateralCreate) AddLoanIDs(ids ...int) *CollateralCreate {
+	cc.mutation.AddLoanIDs(ids...)
+	return cc
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (cc *CollateralCreate) AddLoans(l ...*Loan) *CollateralCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cc.AddLoanIDs(ids...)
+}
+
+// Mutation returns the CollateralMutation object of the builder.
+func (cc *CollateralCreate) Mutation() *CollateralMutation {
+	return cc.mutation
+}
+
+// Save creates the Collateral in the database.
+func (cc *CollateralCreate) Save(ctx context.Context) (*Collateral, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CollateralCreate) SaveX(ctx context.Context) *Collateral {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CollateralCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CollateralCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CollateralCreate) defaults() {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := collateral.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CollateralCreate) check() error {
+	if _, ok := cc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Collateral.kind"`)}
+	}
+	if v, ok := cc.mutation.Kind(); ok {
+		if err := collateral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Collateral.kind": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Collateral.created_at"`)}
+	}
+	return nil
+}
+
+func (cc *CollateralCreate) sqlSave(ctx context.Context) (*Collateral, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CollateralCreate) createSpec() (*Collateral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Collateral{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(collateral.Table, sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Kind(); ok {
+		_spec.SetField(collateral.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cc.mutation.Description(); ok {
+		_spec.SetField(collateral.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(collateral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.AppraisalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CollateralCreateBulk is the builder for creating many Collateral entities in bulk.
+type CollateralCreateBulk struct {
+	config
+	err      error
+	builders []*CollateralCreate
+}
+
+// Save creates the Collateral entities in the database.
+func (ccb *CollateralCreateBulk) Save(ctx context.Context) ([]*Collateral, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Collateral, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollateralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CollateralCreateBulk) SaveX(ctx context.Context) []*Collateral {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CollateralCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CollateralCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
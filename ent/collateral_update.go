@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CollateralUpdate is the builder for updating Collateral entities.
+type CollateralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollateralMutation
+}
+
+// Where appends a list predicates to the CollateralUpdate builder.
+func (cu *CollateralUpdate) Where(ps ...predicate.Collateral) *CollateralUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetKind sets the "kind" field.
+func (cu *CollateralUpdate) SetKind(c collateral.Kind) *CollateralUpdate {
+	cu.mutation.SetKind(c)
+	return cu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cu *CollateralUpdate) SetNillableKind(c *collateral.Kind) *CollateralUpdate {
+	if c != nil {
+		cu.SetKind(*c)
+	}
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CollateralUpdate) SetDescription(s string) *CollateralUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CollateralUpdate) SetNillableDescription(s *string) *CollateralUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cu *CollateralUpdate) ClearDescription() *CollateralUpdate {
+	cu.mutation.ClearDescription()
+	return cu
+}
+
+// AddAppraisalIDs adds the "appraisals" edge to the CollateralAppraisal entity by IDs.
+func (cu *CollateralUpdate) AddAppraisalIDs(ids ...int) *CollateralUpdate {
+	cu.mutation.AddAppraisalIDs(ids...)
+	return cu
+}
+
+// AddAppraisals adds the "appraisals" edges to the CollateralAppraisal entity.
+func (cu *CollateralUpdate) AddAppraisals(c ...*CollateralAppraisal) *CollateralUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddAppraisalIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (cu *CollateralUpdate) AddLoanIDs(ids ...int) *CollateralUpdate {
+	cu.mutation.AddLoanIDs(ids...)
+	return cu
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (cu *CollateralUpdate) AddLoans(l ...*Loan) *CollateralUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.AddLoanIDs(ids...)
+}
+
+// Mutation returns the CollateralMutation object of the builder.
+func (cu *CollateralUpdate) Mutation() *CollateralMutation {
+	return cu.mutation
+}
+
+// ClearAppraisals clears all "appraisals" edges to the CollateralAppraisal entity.
+func (cu *CollateralUpdate) ClearAppraisals() *CollateralUpdate {
+	cu.mutation.ClearAppraisals()
+	return cu
+}
+
+// RemoveAppraisalIDs removes the "appraisals" edge to CollateralAppraisal entities by IDs.
+func (cu *CollateralUpdate) RemoveAppraisalIDs(ids ...int) *CollateralUpdate {
+	cu.mutation.RemoveAppraisalIDs(ids...)
+	return cu
+}
+
+// RemoveAppraisals removes "appraisals" edges to CollateralAppraisal entities.
+func (cu *CollateralUpdate) RemoveAppraisals(c ...*CollateralAppraisal) *CollateralUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveAppraisalIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (cu *CollateralUpdate) ClearLoans() *CollateralUpdate {
+	cu.mutation.ClearLoans()
+	return cu
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (cu *CollateralUpdate) RemoveLoanIDs(ids ...int) *CollateralUpdate {
+	cu.mutation.RemoveLoanIDs(ids...)
+	return cu
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (cu *CollateralUpdate) RemoveLoans(l ...*Loan) *CollateralUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.RemoveLoanIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CollateralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CollateralUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CollateralUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CollateralUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CollateralUpdate) check() error {
+	if v, ok := cu.mutation.Kind(); ok {
+		if err := collateral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Collateral.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CollateralUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collateral.Table, collateral.Columns, sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Kind(); ok {
+		_spec.SetField(collateral.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(collateral.FieldDescription, field.TypeString, value)
+	}
+	if cu.mutation.DescriptionCleared() {
+		_spec.ClearField(collateral.FieldDescription, field.TypeString)
+	}
+	if cu.mutation.AppraisalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedAppraisalsIDs(); len(nodes) > 0 && !cu.mutation.AppraisalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AppraisalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedLoansIDs(); len(nodes) > 0 && !cu.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collateral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CollateralUpdateOne is the builder for updating a single Collateral entity.
+type CollateralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollateralMutation
+}
+
+// SetKind sets the "kind" field.
+func (cuo *CollateralUpdateOne) SetKind(c collateral.Kind) *CollateralUpdateOne {
+	cuo.mutation.SetKind(c)
+	return cuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cuo *CollateralUpdateOne) SetNillableKind(c *collateral.Kind) *CollateralUpdateOne {
+	if c != nil {
+		cuo.SetKind(*c)
+	}
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CollateralUpdateOne) SetDescription(s string) *CollateralUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CollateralUpdateOne) SetNillableDescription(s *string) *CollateralUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cuo *CollateralUpdateOne) ClearDescription() *CollateralUpdateOne {
+	cuo.mutation.ClearDescription()
+	return cuo
+}
+
+// AddAppraisalIDs adds the "appraisals" edge to the CollateralAppraisal entity by IDs.
+func (cuo *CollateralUpdateOne) AddAppraisalIDs(ids ...int) *CollateralUpdateOne {
+	cuo.mutation.AddAppraisalIDs(ids...)
+	return cuo
+}
+
+// AddAppraisals adds the "appraisals" edges to the CollateralAppraisal entity.
+func (cuo *CollateralUpdateOne) AddAppraisals(c ...*CollateralAppraisal) *CollateralUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddAppraisalIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (cuo *CollateralUpdateOne) AddLoanIDs(ids ...int) *CollateralUpdateOne {
+	cuo.mutation.AddLoanIDs(ids...)
+	return cuo
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (cuo *CollateralUpdateOne) AddLoans(l ...*Loan) *CollateralUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.AddLoanIDs(ids...)
+}
+
+// Mutation returns the CollateralMutation object of the builder.
+func (cuo *CollateralUpdateOne) Mutation() *CollateralMutation {
+	return cuo.mutation
+}
+
+// ClearAppraisals clears all "appraisals" edges to the CollateralAppraisal entity.
+func (cuo *CollateralUpdateOne) ClearAppraisals() *CollateralUpdateOne {
+	cuo.mutation.ClearAppraisals()
+	return cuo
+}
+
+// RemoveAppraisalIDs removes the "appraisals" edge to CollateralAppraisal entities by IDs.
+func (cuo *CollateralUpdateOne) RemoveAppraisalIDs(ids ...int) *CollateralUpdateOne {
+	cuo.mutation.RemoveAppraisalIDs(ids...)
+	return cuo
+}
+
+// RemoveAppraisals removes "appraisals" edges to CollateralAppraisal entities.
+func (cuo *CollateralUpdateOne) RemoveAppraisals(c ...*CollateralAppraisal) *CollateralUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveAppraisalIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (cuo *CollateralUpdateOne) ClearLoans() *CollateralUpdateOne {
+	cuo.mutation.ClearLoans()
+	return cuo
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (cuo *CollateralUpdateOne) RemoveLoanIDs(ids ...int) *CollateralUpdateOne {
+	cuo.mutation.RemoveLoanIDs(ids...)
+	return cuo
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (cuo *CollateralUpdateOne) RemoveLoans(l ...*Loan) *CollateralUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.RemoveLoanIDs(ids...)
+}
+
+// Where appends a list predicates to the CollateralUpdate builder.
+func (cuo *CollateralUpdateOne) Where(ps ...predicate.Collateral) *CollateralUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CollateralUpdateOne) Select(field string, fields ...string) *CollateralUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Collateral entity.
+func (cuo *CollateralUpdateOne) Save(ctx context.Context) (*Collateral, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CollateralUpdateOne) SaveX(ctx context.Context) *Collateral {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CollateralUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CollateralUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CollateralUpdateOne) check() error {
+	if v, ok := cuo.mutation.Kind(); ok {
+		if err := collateral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Collateral.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CollateralUpdateOne) sqlSave(ctx context.Context) (_node *Collateral, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collateral.Table, collateral.Columns, sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Collateral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collateral.FieldID)
+		for _, f := range fields {
+			if !collateral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collateral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Kind(); ok {
+		_spec.SetField(collateral.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(collateral.FieldDescription, field.TypeString, value)
+	}
+	if cuo.mutation.DescriptionCleared() {
+		_spec.ClearField(collateral.FieldDescription, field.TypeString)
+	}
+	if cuo.mutation.AppraisalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedAppraisalsIDs(); len(nodes) > 0 && !cuo.mutation.AppraisalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.AppraisalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collateral.AppraisalsTable,
+			Columns: []string{collateral.AppraisalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedLoansIDs(); len(nodes) > 0 && !cuo.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collateral.LoansTable,
+			Columns: collateral.LoansPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Collateral{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collateral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+)
+
+// CollateralAppraisal is the model entity for the CollateralAppraisal schema.
+type CollateralAppraisal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CollateralID holds the value of the "collateral_id" field.
+	CollateralID int `json:"collateral_id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value int `json:"value,omitempty"`
+	// AppraisedAt holds the value of the "appraised_at" field.
+	AppraisedAt time.Time `json:"appraised_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollateralAppraisalQuery when eager-loading is set.
+	Edges        CollateralAppraisalEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CollateralAppraisalEdges holds the relations/edges for other nodes in the graph.
+type CollateralAppraisalEdges struct {
+	// Collateral holds the value of the collateral edge.
+	Collateral *Collateral `json:"collateral,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CollateralOrErr returns the Collateral value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollateralAppraisalEdges) CollateralOrErr() (*Collateral, error) {
+	if e.loadedTypes[0] {
+		if e.Collateral == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: collateral.Label}
+		}
+		return e.Collateral, nil
+	}
+	return nil, &NotLoadedError{edge: "collateral"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CollateralAppraisal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collateralappraisal.FieldID, collateralappraisal.FieldCollateralID, collateralappraisal.FieldValue:
+			values[i] = new(sql.NullInt64)
+		case collateralappraisal.FieldAppraisedAt, collateralappraisal.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CollateralAppraisal fields.
+func (ca *CollateralAppraisal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collateralappraisal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ca.ID = int(value.Int64)
+		case collateralappraisal.FieldCollateralID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field collateral_id", values[i])
+			} else if value.Valid {
+				ca.CollateralID = int(value.Int64)
+			}
+		case collateralappraisal.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				ca.Value = int(value.Int64)
+			}
+		case collateralappraisal.FieldAppraisedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field appraised_at", values[i])
+			} else if value.Valid {
+				ca.AppraisedAt = value.Time
+			}
+		case collateralappraisal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ca.CreatedAt = value.Time
+			}
+		default:
+			ca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the CollateralAppraisal.
+// This includes values selected through modifiers, order, etc.
+func (ca *CollateralAppraisal) GetValue(name string) (ent.Value, error) {
+	return ca.selectValues.Get(name)
+}
+
+// QueryCollateral queries the "collateral" edge of the CollateralAppraisal entity.
+func (ca *CollateralAppraisal) QueryCollateral() *CollateralQuery {
+	return NewCollateralAppraisalClient(ca.config).QueryCollateral(ca)
+}
+
+// Update returns a builder for updating this CollateralAppraisal.
+// Note that you need to call CollateralAppraisal.Unwrap() before calling this method if this CollateralAppraisal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *CollateralAppraisal) Update() *CollateralAppraisalUpdateOne {
+	return NewCollateralAppraisalClient(ca.config).UpdateOne(ca)
+}
+
+// Unwrap unwraps the CollateralAppraisal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *CollateralAppraisal) Unwrap() *CollateralAppraisal {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CollateralAppraisal is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *CollateralAppraisal) String() string {
+	var builder strings.Builder
+	builder.WriteString("CollateralAppraisal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	builder.WriteString("collateral_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.CollateralID))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Value))
+	builder.WriteString(", ")
+	builder.WriteString("appraised_at=")
+	builder.WriteString(ca.AppraisedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ca.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CollateralAppraisals is a parsable slice of CollateralAppraisal.
+type CollateralAppraisals []*CollateralAppraisal
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collateralappraisal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the collateralappraisal type in the database.
+	Label = "collateral_appraisal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCollateralID holds the string denoting the collateral_id field in the database.
+	FieldCollateralID = "collateral_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldAppraisedAt holds the string denoting the appraised_at field in the database.
+	FieldAppraisedAt = "appraised_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCollateral holds the string denoting the collateral edge name in mutations.
+	EdgeCollateral = "collateral"
+	// Table holds the table name of the collateralappraisal in the database.
+	Table = "collateral_appraisals"
+	// CollateralTable is the table that holds the collateral relation/edge.
+	CollateralTable = "collateral_appraisals"
+	// CollateralInverseTable is the table name for the Collateral entity.
+	// It exists in this package in order to avoid circular dependency with the "collateral" package.
+	CollateralInverseTable = "collaterals"
+	// CollateralColumn is the table column denoting the collateral relation/edge.
+	CollateralColumn = "collateral_id"
+)
+
+// Columns holds all SQL columns for collateralappraisal fields.
+var Columns = []string{
+	FieldID,
+	FieldCollateralID,
+	FieldValue,
+	FieldAppraisedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CollateralAppraisal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollateralID orders the results by the collateral_id field.
+func ByCollateralID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollateralID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByAppraisedAt orders the results by the appraised_at field.
+func ByAppraisedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppraisedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCollateralField orders the results by collateral field.
+func ByCollateralField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollateralStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCollateralStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollateralInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CollateralTable, CollateralColumn),
+	)
+}
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package collateralappraisal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLTE(FieldID, id))
+}
+
+// CollateralID applies equality check predicate on the "collateral_id" field. It's identical to CollateralIDEQ.
+func CollateralID(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldCollateralID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldValue, v))
+}
+
+// AppraisedAt applies equality check predicate on the "appraised_at" field. It's identical to AppraisedAtEQ.
+func AppraisedAt(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldAppraisedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CollateralIDEQ applies the EQ predicate on the "collateral_id" field.
+func CollateralIDEQ(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldCollateralID, v))
+}
+
+// CollateralIDNEQ applies the NEQ predicate on the "collateral_id" field.
+func CollateralIDNEQ(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNEQ(FieldCollateralID, v))
+}
+
+// CollateralIDIn applies the In predicate on the "collateral_id" field.
+func CollateralIDIn(vs ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldIn(FieldCollateralID, vs...))
+}
+
+// CollateralIDNotIn applies the NotIn predicate on the "collateral_id" field.
+func CollateralIDNotIn(vs ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNotIn(FieldCollateralID, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLTE(FieldValue, v))
+}
+
+// AppraisedAtEQ applies the EQ predicate on the "appraised_at" field.
+func AppraisedAtEQ(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldAppraisedAt, v))
+}
+
+// AppraisedAtNEQ applies the NEQ predicate on the "appraised_at" field.
+func AppraisedAtNEQ(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNEQ(FieldAppraisedAt, v))
+}
+
+// AppraisedAtIn applies the In predicate on the "appraised_at" field.
+func AppraisedAtIn(vs ...time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldIn(FieldAppraisedAt, vs...))
+}
+
+// AppraisedAtNotIn applies the NotIn predicate on the "appraised_at" field.
+func AppraisedAtNotIn(vs ...time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNotIn(FieldAppraisedAt, vs...))
+}
+
+// AppraisedAtGT applies the GT predicate on the "appraised_at" field.
+func AppraisedAtGT(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGT(FieldAppraisedAt, v))
+}
+
+// AppraisedAtGTE applies the GTE predicate on the "appraised_at" field.
+func AppraisedAtGTE(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGTE(FieldAppraisedAt, v))
+}
+
+// AppraisedAtLT applies the LT predicate on the "appraised_at" field.
+func AppraisedAtLT(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLT(FieldAppraisedAt, v))
+}
+
+// AppraisedAtLTE applies the LTE predicate on the "appraised_at" field.
+func AppraisedAtLTE(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLTE(FieldAppraisedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCollateral applies the HasEdge predicate on the "collateral" edge.
+func HasCollateral() predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CollateralTable, CollateralColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollateralWith applies the HasEdge predicate on the "collateral" edge with a given conditions (other predicates).
+func HasCollateralWith(preds ...predicate.Collateral) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(func(s *sql.Selector) {
+		step := newCollateralStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CollateralAppraisal) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CollateralAppraisal) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CollateralAppraisal) predicate.CollateralAppraisal {
+	return predicate.CollateralAppraisal(sql.NotPredicates(p))
+}
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+)
+
+// CollateralAppraisalCreate is the builder for creating a CollateralAppraisal entity.
+type CollateralAppraisalCreate struct {
+	config
+	mutation *CollateralAppraisalMutation
+	hooks    []Hook
+}
+
+// SetCollateralID sets the "collateral_id" field.
+func (cac *CollateralAppraisalCreate) SetCollateralID(i int) *CollateralAppraisalCreate {
+	cac.mutation.SetCollateralID(i)
+	return cac
+}
+
+// SetValue sets the "value" field.
+func (cac *CollateralAppraisalCreate) SetValue(i int) *CollateralAppraisalCreate {
+	cac.mutation.SetValue(i)
+	return cac
+}
+
+// SetAppraisedAt sets the "appraised_at" field.
+func (cac *CollateralAppraisalCreate) SetAppraisedAt(t time.Time) *CollateralAppraisalCreate {
+	cac.mutation.SetAppraisedAt(t)
+	return cac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cac *CollateralAppraisalCreate) SetCreatedAt(t time.Time) *CollateralAppraisalCreate {
+	cac.mutation.SetCreatedAt(t)
+	return cac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cac *CollateralAppraisalCreate) SetNillableCreatedAt(t *time.Time) *CollateralAppraisalCreate {
+	if t != nil {
+		cac.SetCreatedAt(*t)
+	}
+	return cac
+}
+
+// SetCollateral sets the "collateral" edge to the Collateral entity.
+func (cac *CollateralAppraisalCreate) SetCollateral(c *Collateral) *CollateralAppraisalCreate {
+	return cac.SetCollateralID(c.ID)
+}
+
+// Mutation returns the CollateralAppraisalMutation object of the builder.
+func (cac *CollateralAppraisalCreate) Mutation() *CollateralAppraisalMutation {
+	return cac.mutation
+}
+
+// Save creates the CollateralAppraisal in the database.
+func (cac *CollateralAppraisalCreate) Save(ctx context.Context) (*CollateralAppraisal, error) {
+	cac.defaults()
+	return withHooks(ctx, cac.sqlSave, cac.mutation, cac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *CollateralAppraisalCreate) SaveX(ctx context.Context) *CollateralAppraisal {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *CollateralAppraisalCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *CollateralAppraisalCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cac *CollateralAppraisalCreate) defaults() {
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		v := collateralappraisal.DefaultCreatedAt()
+		cac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *CollateralAppraisalCreate) check() error {
+	if _, ok := cac.mutation.CollateralID(); !ok {
+		return &ValidationError{Name: "collateral_id", err: errors.New(`ent: missing required field "CollateralAppraisal.collateral_id"`)}
+	}
+	if _, ok := cac.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "CollateralAppraisal.value"`)}
+	}
+	if _, ok := cac.mutation.AppraisedAt(); !ok {
+		return &ValidationError{Name: "appraised_at", err: errors.New(`ent: missing required field "CollateralAppraisal.appraised_at"`)}
+	}
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CollateralAppraisal.created_at"`)}
+	}
+	if _, ok := cac.mutation.CollateralID(); !ok {
+		return &ValidationError{Name: "collateral", err: errors.New(`ent: missing required edge "CollateralAppraisal.collateral"`)}
+	}
+	return nil
+}
+
+func (cac *CollateralAppraisalCreate) sqlSave(ctx context.Context) (*CollateralAppraisal, error) {
+	if err := cac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cac.mutation.id = &_node.ID
+	cac.mutation.done = true
+	return _node, nil
+}
+
+func (cac *CollateralAppraisalCreate) createSpec() (*CollateralAppraisal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CollateralAppraisal{config: cac.config}
+		_spec = sqlgraph.NewCreateSpec(collateralappraisal.Table, sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt))
+	)
+	if value, ok := cac.mutation.Value(); ok {
+		_spec.SetField(collateralappraisal.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	if value, ok := cac.mutation.AppraisedAt(); ok {
+		_spec.SetField(collateralappraisal.FieldAppraisedAt, field.TypeTime, value)
+		_node.AppraisedAt = value
+	}
+	if value, ok := cac.mutation.CreatedAt(); ok {
+		_spec.SetField(collateralappraisal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cac.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collateralappraisal.CollateralTable,
+			Columns: []string{collateralappraisal.CollateralColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CollateralID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CollateralAppraisalCreateBulk is the builder for creating many CollateralAppraisal entities in bulk.
+type CollateralAppraisalCreateBulk struct {
+	config
+	err      error
+	builders []*CollateralAppraisalCreate
+}
+
+// Save creates the CollateralAppraisal entities in the database.
+func (cacb *CollateralAppraisalCreateBulk) Save(ctx context.Context) ([]*CollateralAppraisal, error) {
+	if cacb.err != nil {
+		return nil, cacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*CollateralAppraisal, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CollateralAppraisalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *CollateralAppraisalCreateBulk) SaveX(ctx context.Context) []*CollateralAppraisal {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *CollateralAppraisalCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *CollateralAppraisalCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
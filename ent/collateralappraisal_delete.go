@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CollateralAppraisalDelete is the builder for deleting a CollateralAppraisal entity.
+type CollateralAppraisalDelete struct {
+	config
+	hooks    []Hook
+	mutation *CollateralAppraisalMutation
+}
+
+// Where appends a list predicates to the CollateralAppraisalDelete builder.
+func (cad *CollateralAppraisalDelete) Where(ps ...predicate.CollateralAppraisal) *CollateralAppraisalDelete {
+	cad.mutation.Where(ps...)
+	return cad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cad *CollateralAppraisalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cad.sqlExec, cad.mutation, cad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cad *CollateralAppraisalDelete) ExecX(ctx context.Context) int {
+	n, err := cad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cad *CollateralAppraisalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(collateralappraisal.Table, sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt))
+	if ps := cad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cad.mutation.done = true
+	return affected, err
+}
+
+// CollateralAppraisalDeleteOne is the builder for deleting a single CollateralAppraisal entity.
+type CollateralAppraisalDeleteOne struct {
+	cad *CollateralAppraisalDelete
+}
+
+// Where appends a list predicates to the CollateralAppraisalDelete builder.
+func (cado *CollateralAppraisalDeleteOne) Where(ps ...predicate.CollateralAppraisal) *CollateralAppraisalDeleteOne {
+	cado.cad.mutation.Where(ps...)
+	return cado
+}
+
+// Exec executes the deletion query.
+func (cado *CollateralAppraisalDeleteOne) Exec(ctx context.Context) error {
+	n, err := cado.cad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{collateralappraisal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cado *CollateralAppraisalDeleteOne) ExecX(ctx context.Context) {
+	if err := cado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CollateralAppraisalQuery is the builder for querying CollateralAppraisal entities.
+type CollateralAppraisalQuery struct {
+	config
+	ctx            *QueryContext
+	order          []collateralappraisal.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.CollateralAppraisal
+	withCollateral *CollateralQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CollateralAppraisalQuery builder.
+func (caq *CollateralAppraisalQuery) Where(ps ...predicate.CollateralAppraisal) *CollateralAppraisalQuery {
+	caq.predicates = append(caq.predicates, ps...)
+	return caq
+}
+
+// Limit the number of records to be returned by this query.
+func (caq *CollateralAppraisalQuery) Limit(limit int) *CollateralAppraisalQuery {
+	caq.ctx.Limit = &limit
+	return caq
+}
+
+// Offset to start from.
+func (caq *CollateralAppraisalQuery) Offset(offset int) *CollateralAppraisalQuery {
+	caq.ctx.Offset = &offset
+	return caq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (caq *CollateralAppraisalQuery) Unique(unique bool) *CollateralAppraisalQuery {
+	caq.ctx.Unique = &unique
+	return caq
+}
+
+// Order specifies how the records should be ordered.
+func (caq *CollateralAppraisalQuery) Order(o ...collateralappraisal.OrderOption) *CollateralAppraisalQuery {
+	caq.order = append(caq.order, o...)
+	return caq
+}
+
+// QueryCollateral chains the current query on the "collateral" edge.
+func (caq *CollateralAppraisalQuery) QueryCollateral() *CollateralQuery {
+	query := (&CollateralClient{config: caq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := caq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := caq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collateralappraisal.Table, collateralappraisal.FieldID, selector),
+			sqlgraph.To(collateral.Table, collateral.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collateralappraisal.CollateralTable, collateralappraisal.CollateralColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(caq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CollateralAppraisal entity from the query.
+// Returns a *NotFoundError when no CollateralAppraisal was found.
+func (caq *CollateralAppraisalQuery) First(ctx context.Context) (*CollateralAppraisal, error) {
+	nodes, err := caq.Limit(1).All(setContextOp(ctx, caq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{collateralappraisal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) FirstX(ctx context.Context) *CollateralAppraisal {
+	node, err := caq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CollateralAppraisal ID from the query.
+// Returns a *NotFoundError when no CollateralAppraisal ID was found.
+func (caq *CollateralAppraisalQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = caq.Limit(1).IDs(setContextOp(ctx, caq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{collateralappraisal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) FirstIDX(ctx context.Context) int {
+	id, err := caq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CollateralAppraisal entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CollateralAppraisal entity is found.
+// Returns a *NotFoundError when no CollateralAppraisal entities are found.
+func (caq *CollateralAppraisalQuery) Only(ctx context.Context) (*CollateralAppraisal, error) {
+	nodes, err := caq.Limit(2).All(setContextOp(ctx, caq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{collateralappraisal.Label}
+	default:
+		return nil, &NotSingularError{collateralappraisal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) OnlyX(ctx context.Context) *CollateralAppraisal {
+	node, err := caq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CollateralAppraisal ID in the query.
+// Returns a *NotSingularError when more than one CollateralAppraisal ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (caq *CollateralAppraisalQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = caq.Limit(2).IDs(setContextOp(ctx, caq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{collateralappraisal.Label}
+	default:
+		err = &NotSingularError{collateralappraisal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) OnlyIDX(ctx context.Context) int {
+	id, err := caq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CollateralAppraisals.
+func (caq *CollateralAppraisalQuery) All(ctx context.Context) ([]*CollateralAppraisal, error) {
+	ctx = setContextOp(ctx, caq.ctx, "All")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CollateralAppraisal, *CollateralAppraisalQuery]()
+	return withInterceptors[[]*CollateralAppraisal](ctx, caq, qr, caq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) AllX(ctx context.Context) []*CollateralAppraisal {
+	nodes, err := caq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CollateralAppraisal IDs.
+func (caq *CollateralAppraisalQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if caq.ctx.Unique == nil && caq.path != nil {
+		caq.Unique(true)
+	}
+	ctx = setContextOp(ctx, caq.ctx, "IDs")
+	if err = caq.Select(collateralappraisal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) IDsX(ctx context.Context) []int {
+	ids, err := caq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (caq *CollateralAppraisalQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Count")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, caq, querierCount[*CollateralAppraisalQuery](), caq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) CountX(ctx context.Context) int {
+	count, err := caq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (caq *CollateralAppraisalQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Exist")
+	switch _, err := caq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (caq *CollateralAppraisalQuery) ExistX(ctx context.Context) bool {
+	exist, err := caq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CollateralAppraisalQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (caq *CollateralAppraisalQuery) Clone() *CollateralAppraisalQuery {
+	if caq == nil {
+		return nil
+	}
+	return &CollateralAppraisalQuery{
+		config:         caq.config,
+		ctx:            caq.ctx.Clone(),
+		order:          append([]collateralappraisal.OrderOption{}, caq.order...),
+		inters:         append([]Interceptor{}, caq.inters...),
+		predicates:     append([]predicate.CollateralAppraisal{}, caq.predicates...),
+		withCollateral: caq.withCollateral.Clone(),
+		// clone intermediate query.
+		sql:  caq.sql.Clone(),
+		path: caq.path,
+	}
+}
+
+// WithCollateral tells the query-builder to eager-load the nodes that are connected to
+// the "collateral" edge. The optional arguments are used to configure the query builder of the edge.
+func (caq *CollateralAppraisalQuery) WithCollateral(opts ...func(*CollateralQuery)) *CollateralAppraisalQuery {
+	query := (&CollateralClient{config: caq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	caq.withCollateral = query
+	return caq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollateralID int `json:"collateral_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CollateralAppraisal.Query().
+//		GroupBy(collateralappraisal.FieldCollateralID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (caq *CollateralAppraisalQuery) GroupBy(field string, fields ...string) *CollateralAppraisalGroupBy {
+	caq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CollateralAppraisalGroupBy{build: caq}
+	grbuild.flds = &caq.ctx.Fields
+	grbuild.label = collateralappraisal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollateralID int `json:"collateral_id,omitempty"`
+//	}
+//
+//	client.CollateralAppraisal.Query().
+//		Select(collateralappraisal.FieldCollateralID).
+//		Scan(ctx, &v)
+func (caq *CollateralAppraisalQuery) Select(fields ...string) *CollateralAppraisalSelect {
+	caq.ctx.Fields = append(caq.ctx.Fields, fields...)
+	sbuild := &CollateralAppraisalSelect{CollateralAppraisalQuery: caq}
+	sbuild.label = collateralappraisal.Label
+	sbuild.flds, sbuild.scan = &caq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CollateralAppraisalSelect configured with the given aggregations.
+func (caq *CollateralAppraisalQuery) Aggregate(fns ...AggregateFunc) *CollateralAppraisalSelect {
+	return caq.Select().Aggregate(fns...)
+}
+
+func (caq *CollateralAppraisalQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range caq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, caq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range caq.ctx.Fields {
+		if !collateralappraisal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if caq.path != nil {
+		prev, err := caq.path(ctx)
+		if err != nil {
+			return err
+		}
+		caq.sql = prev
+	}
+	return nil
+}
+
+func (caq *CollateralAppraisalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CollateralAppraisal, error) {
+	var (
+		nodes       = []*CollateralAppraisal{}
+		_spec       = caq.querySpec()
+		loadedTypes = [1]bool{
+			caq.withCollateral != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CollateralAppraisal).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CollateralAppraisal{config: caq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, caq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := caq.withCollateral; query != nil {
+		if err := caq.loadCollateral(ctx, query, nodes, nil,
+			func(n *CollateralAppraisal, e *Collateral) { n.Edges.Collateral = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (caq *CollateralAppraisalQuery) loadCollateral(ctx context.Context, query *CollateralQuery, nodes []*CollateralAppraisal, init func(*CollateralAppraisal), assign func(*CollateralAppraisal, *Collateral)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CollateralAppraisal)
+	for i := range nodes {
+		fk := nodes[i].CollateralID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(collateral.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "collateral_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (caq *CollateralAppraisalQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := caq.querySpec()
+	_spec.Node.Columns = caq.ctx.Fields
+	if len(caq.ctx.Fields) > 0 {
+		_spec.Unique = caq.ctx.Unique != nil && *caq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, caq.driver, _spec)
+}
+
+func (caq *CollateralAppraisalQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(collateralappraisal.Table, collateralappraisal.Columns, sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt))
+	_spec.From = caq.sql
+	if unique := caq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if caq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := caq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collateralappraisal.FieldID)
+		for i := range fields {
+			if fields[i] != collateralappraisal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if caq.withCollateral != nil {
+			_spec.Node.AddColumnOnce(collateralappraisal.FieldCollateralID)
+		}
+	}
+	if ps := caq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := caq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (caq *CollateralAppraisalQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(caq.driver.Dialect())
+	t1 := builder.Table(collateralappraisal.Table)
+	columns := caq.ctx.Fields
+	if len(columns) == 0 {
+		columns = collateralappraisal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if caq.sql != nil {
+		selector = caq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if caq.ctx.Unique != nil && *caq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range caq.predicates {
+		p(selector)
+	}
+	for _, p := range caq.order {
+		p(selector)
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CollateralAppraisalGroupBy is the group-by builder for CollateralAppraisal entities.
+type CollateralAppraisalGroupBy struct {
+	selector
+	build *CollateralAppraisalQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cagb *CollateralAppraisalGroupBy) Aggregate(fns ...AggregateFunc) *CollateralAppraisalGroupBy {
+	cagb.fns = append(cagb.fns, fns...)
+	return cagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cagb *CollateralAppraisalGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cagb.build.ctx, "GroupBy")
+	if err := cagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CollateralAppraisalQuery, *CollateralAppraisalGroupBy](ctx, cagb.build, cagb, cagb.build.inters, v)
+}
+
+func (cagb *CollateralAppraisalGroupBy) sqlScan(ctx context.Context, root *CollateralAppraisalQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cagb.fns))
+	for _, fn := range cagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cagb.flds)+len(cagb.fns))
+		for _, f := range *cagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CollateralAppraisalSelect is the builder for selecting fields of CollateralAppraisal entities.
+type CollateralAppraisalSelect struct {
+	*CollateralAppraisalQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cas *CollateralAppraisalSelect) Aggregate(fns ...AggregateFunc) *CollateralAppraisalSelect {
+	cas.fns = append(cas.fns, fns...)
+	return cas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cas *CollateralAppraisalSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cas.ctx, "Select")
+	if err := cas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CollateralAppraisalQuery, *CollateralAppraisalSelect](ctx, cas.CollateralAppraisalQuery, cas, cas.inters, v)
+}
+
+func (cas *CollateralAppraisalSelect) sqlScan(ctx context.Context, root *CollateralAppraisalQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cas.fns))
+	for _, fn := range cas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
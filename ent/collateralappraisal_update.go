@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CollateralAppraisalUpdate is the builder for updating CollateralAppraisal entities.
+type CollateralAppraisalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollateralAppraisalMutation
+}
+
+// Where appends a list predicates to the CollateralAppraisalUpdate builder.
+func (cau *CollateralAppraisalUpdate) Where(ps ...predicate.CollateralAppraisal) *CollateralAppraisalUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// SetCollateralID sets the "collateral_id" field.
+func (cau *CollateralAppraisalUpdate) SetCollateralID(i int) *CollateralAppraisalUpdate {
+	cau.mutation.SetCollateralID(i)
+	return cau
+}
+
+// SetNillableCollateralID sets the "collateral_id" field if the given value is not nil.
+func (cau *CollateralAppraisalUpdate) SetNillableCollateralID(i *int) *CollateralAppraisalUpdate {
+	if i != nil {
+		cau.SetCollateralID(*i)
+	}
+	return cau
+}
+
+// SetValue sets the "value" field.
+func (cau *CollateralAppraisalUpdate) SetValue(i int) *CollateralAppraisalUpdate {
+	cau.mutation.ResetValue()
+	cau.mutation.SetValue(i)
+	return cau
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cau *CollateralAppraisalUpdate) SetNillableValue(i *int) *CollateralAppraisalUpdate {
+	if i != nil {
+		cau.SetValue(*i)
+	}
+	return cau
+}
+
+// AddValue adds i to the "value" field.
+func (cau *CollateralAppraisalUpdate) AddValue(i int) *CollateralAppraisalUpdate {
+	cau.mutation.AddValue(i)
+	return cau
+}
+
+// SetAppraisedAt sets the "appraised_at" field.
+func (cau *CollateralAppraisalUpdate) SetAppraisedAt(t time.Time) *CollateralAppraisalUpdate {
+	cau.mutation.SetAppraisedAt(t)
+	return cau
+}
+
+// SetNillableAppraisedAt sets the "appraised_at" field if the given value is not nil.
+func (cau *CollateralAppraisalUpdate) SetNillableAppraisedAt(t *time.Time) *CollateralAppraisalUpdate {
+	if t != nil {
+		cau.SetAppraisedAt(*t)
+	}
+	return cau
+}
+
+// SetCollateral sets the "collateral" edge to the Collateral entity.
+func (cau *CollateralAppraisalUpdate) SetCollateral(c *Collateral) *CollateralAppraisalUpdate {
+	return cau.SetCollateralID(c.ID)
+}
+
+// Mutation returns the CollateralAppraisalMutation object of the builder.
+func (cau *CollateralAppraisalUpdate) Mutation() *CollateralAppraisalMutation {
+	return cau.mutation
+}
+
+// ClearCollateral clears the "collateral" edge to the Collateral entity.
+func (cau *CollateralAppraisalUpdate) ClearCollateral() *CollateralAppraisalUpdate {
+	cau.mutation.ClearCollateral()
+	return cau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *CollateralAppraisalUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cau.sqlSave, cau.mutation, cau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *CollateralAppraisalUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *CollateralAppraisalUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *CollateralAppraisalUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cau *CollateralAppraisalUpdate) check() error {
+	if _, ok := cau.mutation.CollateralID(); cau.mutation.CollateralCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CollateralAppraisal.collateral"`)
+	}
+	return nil
+}
+
+func (cau *CollateralAppraisalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collateralappraisal.Table, collateralappraisal.Columns, sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt))
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cau.mutation.Value(); ok {
+		_spec.SetField(collateralappraisal.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.AddedValue(); ok {
+		_spec.AddField(collateralappraisal.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.AppraisedAt(); ok {
+		_spec.SetField(collateralappraisal.FieldAppraisedAt, field.TypeTime, value)
+	}
+	if cau.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collateralappraisal.CollateralTable,
+			Columns: []string{collateralappraisal.CollateralColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cau.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collateralappraisal.CollateralTable,
+			Columns: []string{collateralappraisal.CollateralColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collateralappraisal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cau.mutation.done = true
+	return n, nil
+}
+
+// CollateralAppraisalUpdateOne is the builder for updating a single CollateralAppraisal entity.
+type CollateralAppraisalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollateralAppraisalMutation
+}
+
+// SetCollateralID sets the "collateral_id" field.
+func (cauo *CollateralAppraisalUpdateOne) SetCollateralID(i int) *CollateralAppraisalUpdateOne {
+	cauo.mutation.SetCollateralID(i)
+	return cauo
+}
+
+// SetNillableCollateralID sets the "collateral_id" field if the given value is not nil.
+func (cauo *CollateralAppraisalUpdateOne) SetNillableCollateralID(i *int) *CollateralAppraisalUpdateOne {
+	if i != nil {
+		cauo.SetCollateralID(*i)
+	}
+	return cauo
+}
+
+// SetValue sets the "value" field.
+func (cauo *CollateralAppraisalUpdateOne) SetValue(i int) *CollateralAppraisalUpdateOne {
+	cauo.mutation.ResetValue()
+	cauo.mutation.SetValue(i)
+	return cauo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cauo *CollateralAppraisalUpdateOne) SetNillableValue(i *int) *CollateralAppraisalUpdateOne {
+	if i != nil {
+		cauo.SetValue(*i)
+	}
+	return cauo
+}
+
+// AddValue adds i to the "value" field.
+func (cauo *CollateralAppraisalUpdateOne) AddValue(i int) *CollateralAppraisalUpdateOne {
+	cauo.mutation.AddValue(i)
+	return cauo
+}
+
+// SetAppraisedAt sets the "appraised_at" field.
+func (cauo *CollateralAppraisalUpdateOne) SetAppraisedAt(t time.Time) *CollateralAppraisalUpdateOne {
+	cauo.mutation.SetAppraisedAt(t)
+	return cauo
+}
+
+// SetNillableAppraisedAt sets the "appraised_at" field if the given value is not nil.
+func (cauo *CollateralAppraisalUpdateOne) SetNillableAppraisedAt(t *time.Time) *CollateralAppraisalUpdateOne {
+	if t != nil {
+		cauo.SetAppraisedAt(*t)
+	}
+	return cauo
+}
+
+// SetCollateral sets the "collateral" edge to the Collateral entity.
+func (cauo *CollateralAppraisalUpdateOne) SetCollateral(c *Collateral) *CollateralAppraisalUpdateOne {
+	return cauo.SetCollateralID(c.ID)
+}
+
+// Mutation returns the CollateralAppraisalMutation object of the builder.
+func (cauo *CollateralAppraisalUpdateOne) Mutation() *CollateralAppraisalMutation {
+	return cauo.mutation
+}
+
+// ClearCollateral clears the "collateral" edge to the Collateral entity.
+func (cauo *CollateralAppraisalUpdateOne) ClearCollateral() *CollateralAppraisalUpdateOne {
+	cauo.mutation.ClearCollateral()
+	return cauo
+}
+
+// Where appends a list predicates to the CollateralAppraisalUpdate builder.
+func (cauo *CollateralAppraisalUpdateOne) Where(ps ...predicate.CollateralAppraisal) *CollateralAppraisalUpdateOne {
+	cauo.mutation.Where(ps...)
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *CollateralAppraisalUpdateOne) Select(field string, fields ...string) *CollateralAppraisalUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated CollateralAppraisal entity.
+func (cauo *CollateralAppraisalUpdateOne) Save(ctx context.Context) (*CollateralAppraisal, error) {
+	return withHooks(ctx, cauo.sqlSave, cauo.mutation, cauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *CollateralAppraisalUpdateOne) SaveX(ctx context.Context) *CollateralAppraisal {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *CollateralAppraisalUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *CollateralAppraisalUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cauo *CollateralAppraisalUpdateOne) check() error {
+	if _, ok := cauo.mutation.CollateralID(); cauo.mutation.CollateralCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CollateralAppraisal.collateral"`)
+	}
+	return nil
+}
+
+func (cauo *CollateralAppraisalUpdateOne) sqlSave(ctx context.Context) (_node *CollateralAppraisal, err error) {
+	if err := cauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collateralappraisal.Table, collateralappraisal.Columns, sqlgraph.NewFieldSpec(collateralappraisal.FieldID, field.TypeInt))
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CollateralAppraisal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collateralappraisal.FieldID)
+		for _, f := range fields {
+			if !collateralappraisal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collateralappraisal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cauo.mutation.Value(); ok {
+		_spec.SetField(collateralappraisal.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.AddedValue(); ok {
+		_spec.AddField(collateralappraisal.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.AppraisedAt(); ok {
+		_spec.SetField(collateralappraisal.FieldAppraisedAt, field.TypeTime, value)
+	}
+	if cauo.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collateralappraisal.CollateralTable,
+			Columns: []string{collateralappraisal.CollateralColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cauo.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collateralappraisal.CollateralTable,
+			Columns: []string{collateralappraisal.CollateralColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CollateralAppraisal{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collateralappraisal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cauo.mutation.done = true
+	return _node, nil
+}
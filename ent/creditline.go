@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// CreditLine is the model entity for the CreditLine schema.
+type CreditLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreditLimit holds the value of the "credit_limit" field.
+	CreditLimit int `json:"credit_limit,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// DrawMonths holds the value of the "draw_months" field.
+	DrawMonths int `json:"draw_months,omitempty"`
+	// RepaymentMonths holds the value of the "repayment_months" field.
+	RepaymentMonths int `json:"repayment_months,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+	// BorrowerID holds the value of the "borrower_id" field.
+	BorrowerID int `json:"borrower_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CreditLineQuery when eager-loading is set.
+	Edges        CreditLineEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CreditLineEdges holds the relations/edges for other nodes in the graph.
+type CreditLineEdges struct {
+	// Borrower holds the value of the borrower edge.
+	Borrower *User `json:"borrower,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*CreditLineTransaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BorrowerOrErr returns the Borrower value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CreditLineEdges) BorrowerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Borrower == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Borrower, nil
+	}
+	return nil, &NotLoadedError{edge: "borrower"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CreditLineEdges) TransactionsOrErr() ([]*CreditLineTransaction, error) {
+	if e.loadedTypes[1] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case creditline.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case creditline.FieldID, creditline.FieldCreditLimit, creditline.FieldDrawMonths, creditline.FieldRepaymentMonths, creditline.FieldBorrowerID:
+			values[i] = new(sql.NullInt64)
+		case creditline.FieldOpenedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditLine fields.
+func (cl *CreditLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case creditline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cl.ID = int(value.Int64)
+		case creditline.FieldCreditLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_limit", values[i])
+			} else if value.Valid {
+				cl.CreditLimit = int(value.Int64)
+			}
+		case creditline.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				cl.Rate = value.Float64
+			}
+		case creditline.FieldDrawMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field draw_months", values[i])
+			} else if value.Valid {
+				cl.DrawMonths = int(value.Int64)
+			}
+		case creditline.FieldRepaymentMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field repayment_months", values[i])
+			} else if value.Valid {
+				cl.RepaymentMonths = int(value.Int64)
+			}
+		case creditline.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				cl.OpenedAt = value.Time
+			}
+		case creditline.FieldBorrowerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field borrower_id", values[i])
+			} else if value.Valid {
+				cl.BorrowerID = int(value.Int64)
+			}
+		default:
+			cl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditLine.
+// This includes values selected through modifiers, order, etc.
+func (cl *CreditLine) Value(name string) (ent.Value, error) {
+	return cl.selectValues.Get(name)
+}
+
+// QueryBorrower queries the "borrower" edge of the CreditLine entity.
+func (cl *CreditLine) QueryBorrower() *UserQuery {
+	return NewCreditLineClient(cl.config).QueryBorrower(cl)
+}
+
+// QueryTransactions queries the "transactions" edge of the CreditLine entity.
+func (cl *CreditLine) QueryTransactions() *CreditLineTransactionQuery {
+	return NewCreditLineClient(cl.config).QueryTransactions(cl)
+}
+
+// Update returns a builder for updating this CreditLine.
+// Note that you need to call CreditLine.Unwrap() before calling this method if this CreditLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cl *CreditLine) Update() *CreditLineUpdateOne {
+	return NewCreditLineClient(cl.config).UpdateOne(cl)
+}
+
+// Unwrap unwraps the CreditLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cl *CreditLine) Unwrap() *CreditLine {
+	_tx, ok := cl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditLine is not a transactional entity")
+	}
+	cl.config.driver = _tx.drv
+	return cl
+}
+
+// String implements the fmt.Stringer.
+func (cl *CreditLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cl.ID))
+	builder.WriteString("credit_limit=")
+	builder.WriteString(fmt.Sprintf("%v", cl.CreditLimit))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", cl.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("draw_months=")
+	builder.WriteString(fmt.Sprintf("%v", cl.DrawMonths))
+	builder.WriteString(", ")
+	builder.WriteString("repayment_months=")
+	builder.WriteString(fmt.Sprintf("%v", cl.RepaymentMonths))
+	builder.WriteString(", ")
+	builder.WriteString("opened_at=")
+	builder.WriteString(cl.OpenedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("borrower_id=")
+	builder.WriteString(fmt.Sprintf("%v", cl.BorrowerID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditLines is a parsable slice of CreditLine.
+type CreditLines []*CreditLine
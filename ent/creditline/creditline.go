@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package creditline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the creditline type in the database.
+	Label = "credit_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreditLimit holds the string denoting the credit_limit field in the database.
+	FieldCreditLimit = "credit_limit"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldDrawMonths holds the string denoting the draw_months field in the database.
+	FieldDrawMonths = "draw_months"
+	// FieldRepaymentMonths holds the string denoting the repayment_months field in the database.
+	FieldRepaymentMonths = "repayment_months"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldBorrowerID holds the string denoting the borrower_id field in the database.
+	FieldBorrowerID = "borrower_id"
+	// EdgeBorrower holds the string denoting the borrower edge name in mutations.
+	EdgeBorrower = "borrower"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// Table holds the table name of the creditline in the database.
+	Table = "credit_lines"
+	// BorrowerTable is the table that holds the borrower relation/edge.
+	BorrowerTable = "credit_lines"
+	// BorrowerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BorrowerInverseTable = "users"
+	// BorrowerColumn is the table column denoting the borrower relation/edge.
+	BorrowerColumn = "borrower_id"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "credit_line_transactions"
+	// TransactionsInverseTable is the table name for the CreditLineTransaction entity.
+	// It exists in this package in order to avoid circular dependency with the "creditlinetransaction" package.
+	TransactionsInverseTable = "credit_line_transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "credit_line_id"
+)
+
+// Columns holds all SQL columns for creditline fields.
+var Columns = []string{
+	FieldID,
+	FieldCreditLimit,
+	FieldRate,
+	FieldDrawMonths,
+	FieldRepaymentMonths,
+	FieldOpenedAt,
+	FieldBorrowerID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the CreditLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreditLimit orders the results by the credit_limit field.
+func ByCreditLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLimit, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByDrawMonths orders the results by the draw_months field.
+func ByDrawMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrawMonths, opts...).ToFunc()
+}
+
+// ByRepaymentMonths orders the results by the repayment_months field.
+func ByRepaymentMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepaymentMonths, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByBorrowerID orders the results by the borrower_id field.
+func ByBorrowerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBorrowerID, opts...).ToFunc()
+}
+
+// ByBorrowerField orders the results by borrower field.
+func ByBorrowerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBorrowerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBorrowerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BorrowerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BorrowerTable, BorrowerColumn),
+	)
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
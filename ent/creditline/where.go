@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package creditline
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldID, id))
+}
+
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldRate, v))
+}
+
+// DrawMonths applies equality check predicate on the "draw_months" field. It's identical to DrawMonthsEQ.
+func DrawMonths(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldDrawMonths, v))
+}
+
+// RepaymentMonths applies equality check predicate on the "repayment_months" field. It's identical to RepaymentMonthsEQ.
+func RepaymentMonths(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldRepaymentMonths, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// BorrowerID applies equality check predicate on the "borrower_id" field. It's identical to BorrowerIDEQ.
+func BorrowerID(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldBorrowerID, v))
+}
+
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldCreditLimit, v))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldCreditLimit, v))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldCreditLimit, v))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldCreditLimit, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldRate, v))
+}
+
+// DrawMonthsEQ applies the EQ predicate on the "draw_months" field.
+func DrawMonthsEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldDrawMonths, v))
+}
+
+// DrawMonthsNEQ applies the NEQ predicate on the "draw_months" field.
+func DrawMonthsNEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldDrawMonths, v))
+}
+
+// DrawMonthsIn applies the In predicate on the "draw_months" field.
+func DrawMonthsIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldDrawMonths, vs...))
+}
+
+// DrawMonthsNotIn applies the NotIn predicate on the "draw_months" field.
+func DrawMonthsNotIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldDrawMonths, vs...))
+}
+
+// DrawMonthsGT applies the GT predicate on the "draw_months" field.
+func DrawMonthsGT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldDrawMonths, v))
+}
+
+// DrawMonthsGTE applies the GTE predicate on the "draw_months" field.
+func DrawMonthsGTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldDrawMonths, v))
+}
+
+// DrawMonthsLT applies the LT predicate on the "draw_months" field.
+func DrawMonthsLT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldDrawMonths, v))
+}
+
+// DrawMonthsLTE applies the LTE predicate on the "draw_months" field.
+func DrawMonthsLTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldDrawMonths, v))
+}
+
+// RepaymentMonthsEQ applies the EQ predicate on the "repayment_months" field.
+func RepaymentMonthsEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldRepaymentMonths, v))
+}
+
+// RepaymentMonthsNEQ applies the NEQ predicate on the "repayment_months" field.
+func RepaymentMonthsNEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldRepaymentMonths, v))
+}
+
+// RepaymentMonthsIn applies the In predicate on the "repayment_months" field.
+func RepaymentMonthsIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldRepaymentMonths, vs...))
+}
+
+// RepaymentMonthsNotIn applies the NotIn predicate on the "repayment_months" field.
+func RepaymentMonthsNotIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldRepaymentMonths, vs...))
+}
+
+// RepaymentMonthsGT applies the GT predicate on the "repayment_months" field.
+func RepaymentMonthsGT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldRepaymentMonths, v))
+}
+
+// RepaymentMonthsGTE applies the GTE predicate on the "repayment_months" field.
+func RepaymentMonthsGTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldRepaymentMonths, v))
+}
+
+// RepaymentMonthsLT applies the LT predicate on the "repayment_months" field.
+func RepaymentMonthsLT(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldRepaymentMonths, v))
+}
+
+// RepaymentMonthsLTE applies the LTE predicate on the "repayment_months" field.
+func RepaymentMonthsLTE(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldRepaymentMonths, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// BorrowerIDEQ applies the EQ predicate on the "borrower_id" field.
+func BorrowerIDEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldEQ(FieldBorrowerID, v))
+}
+
+// BorrowerIDNEQ applies the NEQ predicate on the "borrower_id" field.
+func BorrowerIDNEQ(v int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNEQ(FieldBorrowerID, v))
+}
+
+// BorrowerIDIn applies the In predicate on the "borrower_id" field.
+func BorrowerIDIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldIn(FieldBorrowerID, vs...))
+}
+
+// BorrowerIDNotIn applies the NotIn predicate on the "borrower_id" field.
+func BorrowerIDNotIn(vs ...int) predicate.CreditLine {
+	return predicate.CreditLine(sql.FieldNotIn(FieldBorrowerID, vs...))
+}
+
+// HasBorrower applies the HasEdge predicate on the "borrower" edge.
+func HasBorrower() predicate.CreditLine {
+	return predicate.CreditLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BorrowerTable, BorrowerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBorrowerWith applies the HasEdge predicate on the "borrower" edge with a given conditions (other predicates).
+func HasBorrowerWith(preds ...predicate.User) predicate.CreditLine {
+	return predicate.CreditLine(func(s *sql.Selector) {
+		step := newBorrowerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.CreditLine {
+	return predicate.CreditLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.CreditLineTransaction) predicate.CreditLine {
+	return predicate.CreditLine(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CreditLine) predicate.CreditLine {
+	return predicate.CreditLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CreditLine) predicate.CreditLine {
+	return predicate.CreditLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CreditLine) predicate.CreditLine {
+	return predicate.CreditLine(sql.NotPredicates(p))
+}
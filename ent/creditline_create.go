@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// CreditLineCreate is the builder for creating a CreditLine entity.
+type CreditLineCreate struct {
+	config
+	mutation *CreditLineMutation
+	hooks    []Hook
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (clc *CreditLineCreate) SetCreditLimit(i int) *CreditLineCreate {
+	clc.mutation.SetCreditLimit(i)
+	return clc
+}
+
+// SetRate sets the "rate" field.
+func (clc *CreditLineCreate) SetRate(f float64) *CreditLineCreate {
+	clc.mutation.SetRate(f)
+	return clc
+}
+
+// SetDrawMonths sets the "draw_months" field.
+func (clc *CreditLineCreate) SetDrawMonths(i int) *CreditLineCreate {
+	clc.mutation.SetDrawMonths(i)
+	return clc
+}
+
+// SetRepaymentMonths sets the "repayment_months" field.
+func (clc *CreditLineCreate) SetRepaymentMonths(i int) *CreditLineCreate {
+	clc.mutation.SetRepaymentMonths(i)
+	return clc
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (clc *CreditLineCreate) SetOpenedAt(t time.Time) *CreditLineCreate {
+	clc.mutation.SetOpenedAt(t)
+	return clc
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (clc *CreditLineCreate) SetBorrowerID(i int) *CreditLineCreate {
+	clc.mutation.SetBorrowerID(i)
+	return clc
+}
+
+// SetBorrower sets the "borrower" edge to the User entity.
+func (clc *CreditLineCreate) SetBorrower(u *User) *CreditLineCreate {
+	return clc.SetBorrowerID(u.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the CreditLineTransaction entity by IDs.
+func (clc *CreditLineCreate) AddTransactionIDs(ids ...int) *CreditLineCreate {
+	clc.mutation.AddTransactionIDs(ids...)
+	return clc
+}
+
+// AddTransactions adds the "transactions" edges to the CreditLineTransaction entity.
+func (clc *CreditLineCreate) AddTransactions(c ...*CreditLineTransaction) *CreditLineCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return clc.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the CreditLineMutation object of the builder.
+func (clc *CreditLineCreate) Mutation() *CreditLineMutation {
+	return clc.mutation
+}
+
+// Save creates the CreditLine in the database.
+func (clc *CreditLineCreate) Save(ctx context.Context) (*CreditLine, error) {
+	return withHooks(ctx, clc.sqlSave, clc.mutation, clc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (clc *CreditLineCreate) SaveX(ctx context.Context) *CreditLine {
+	v, err := clc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clc *CreditLineCreate) Exec(ctx context.Context) error {
+	_, err := clc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clc *CreditLineCreate) ExecX(ctx context.Context) {
+	if err := clc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (clc *CreditLineCreate) check() error {
+	if _, ok := clc.mutation.CreditLimit(); !ok {
+		return &ValidationError{Name: "credit_limit", err: errors.New(`ent: missing required field "CreditLine.credit_limit"`)}
+	}
+	if _, ok := clc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "CreditLine.rate"`)}
+	}
+	if _, ok := clc.mutation.DrawMonths(); !ok {
+		return &ValidationError{Name: "draw_months", err: errors.New(`ent: missing required field "CreditLine.draw_months"`)}
+	}
+	if _, ok := clc.mutation.RepaymentMonths(); !ok {
+		return &ValidationError{Name: "repayment_months", err: errors.New(`ent: missing required field "CreditLine.repayment_months"`)}
+	}
+	if _, ok := clc.mutation.OpenedAt(); !ok {
+		return &ValidationError{Name: "opened_at", err: errors.New(`ent: missing required field "CreditLine.opened_at"`)}
+	}
+	if _, ok := clc.mutation.BorrowerID(); !ok {
+		return &ValidationError{Name: "borrower_id", err: errors.New(`ent: missing required field "CreditLine.borrower_id"`)}
+	}
+	if _, ok := clc.mutation.BorrowerID(); !ok {
+		return &ValidationError{Name: "borrower", err: errors.New(`ent: missing required edge "CreditLine.borrower"`)}
+	}
+	return nil
+}
+
+func (clc *CreditLineCreate) sqlSave(ctx context.Context) (*CreditLine, error) {
+	if err := clc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := clc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, clc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	clc.mutation.id = &_node.ID
+	clc.mutation.done = true
+	return _node, nil
+}
+
+func (clc *CreditLineCreate) createSpec() (*CreditLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditLine{config: clc.config}
+		_spec = sqlgraph.NewCreateSpec(creditline.Table, sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt))
+	)
+	if value, ok := clc.mutation.CreditLimit(); ok {
+		_spec.SetField(creditline.FieldCreditLimit, field.TypeInt, value)
+		_node.CreditLimit = value
+	}
+	if value, ok := clc.mutation.Rate(); ok {
+		_spec.SetField(creditline.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := clc.mutation.DrawMonths(); ok {
+		_spec.SetField(creditline.FieldDrawMonths, field.TypeInt, value)
+		_node.DrawMonths = value
+	}
+	if value, ok := clc.mutation.RepaymentMonths(); ok {
+		_spec.SetField(creditline.FieldRepaymentMonths, field.TypeInt, value)
+		_node.RepaymentMonths = value
+	}
+	if value, ok := clc.mutation.OpenedAt(); ok {
+		_spec.SetField(creditline.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = value
+	}
+	if nodes := clc.mutation.BorrowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditline.BorrowerTable,
+			Columns: []string{creditline.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BorrowerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := clc.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CreditLineCreateBulk is the builder for creating many CreditLine entities in bulk.
+type CreditLineCreateBulk struct {
+	config
+	err      error
+	builders []*CreditLineCreate
+}
+
+// Save creates the CreditLine entities in the database.
+func (clcb *CreditLineCreateBulk) Save(ctx context.Context) ([]*CreditLine, error) {
+	if clcb.err != nil {
+		return nil, clcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(clcb.builders))
+	nodes := make([]*CreditLine, len(clcb.builders))
+	mutators := make([]Mutator, len(clcb.builders))
+	for i := range clcb.builders {
+		func(i int, root context.Context) {
+			builder := clcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, clcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, clcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, clcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (clcb *CreditLineCreateBulk) SaveX(ctx context.Context) []*CreditLine {
+	v, err := clcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clcb *CreditLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := clcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clcb *CreditLineCreateBulk) ExecX(ctx context.Context) {
+	if err := clcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CreditLineDelete is the builder for deleting a CreditLine entity.
+type CreditLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditLineMutation
+}
+
+// Where appends a list predicates to the CreditLineDelete builder.
+func (cld *CreditLineDelete) Where(ps ...predicate.CreditLine) *CreditLineDelete {
+	cld.mutation.Where(ps...)
+	return cld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cld *CreditLineDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cld.sqlExec, cld.mutation, cld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cld *CreditLineDelete) ExecX(ctx context.Context) int {
+	n, err := cld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cld *CreditLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditline.Table, sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt))
+	if ps := cld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cld.mutation.done = true
+	return affected, err
+}
+
+// CreditLineDeleteOne is the builder for deleting a single CreditLine entity.
+type CreditLineDeleteOne struct {
+	cld *CreditLineDelete
+}
+
+// Where appends a list predicates to the CreditLineDelete builder.
+func (cldo *CreditLineDeleteOne) Where(ps ...predicate.CreditLine) *CreditLineDeleteOne {
+	cldo.cld.mutation.Where(ps...)
+	return cldo
+}
+
+// Exec executes the deletion query.
+func (cldo *CreditLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := cldo.cld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cldo *CreditLineDeleteOne) ExecX(ctx context.Context) {
+	if err := cldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
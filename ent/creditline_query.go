@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// CreditLineQuery is the builder for querying CreditLine entities.
+type CreditLineQuery struct {
+	config
+	ctx              *QueryContext
+	order            []creditline.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.CreditLine
+	withBorrower     *UserQuery
+	withTransactions *CreditLineTransactionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditLineQuery builder.
+func (clq *CreditLineQuery) Where(ps ...predicate.CreditLine) *CreditLineQuery {
+	clq.predicates = append(clq.predicates, ps...)
+	return clq
+}
+
+// Limit the number of records to be returned by this query.
+func (clq *CreditLineQuery) Limit(limit int) *CreditLineQuery {
+	clq.ctx.Limit = &limit
+	return clq
+}
+
+// Offset to start from.
+func (clq *CreditLineQuery) Offset(offset int) *CreditLineQuery {
+	clq.ctx.Offset = &offset
+	return clq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (clq *CreditLineQuery) Unique(unique bool) *CreditLineQuery {
+	clq.ctx.Unique = &unique
+	return clq
+}
+
+// Order specifies how the records should be ordered.
+func (clq *CreditLineQuery) Order(o ...creditline.OrderOption) *CreditLineQuery {
+	clq.order = append(clq.order, o...)
+	return clq
+}
+
+// QueryBorrower chains the current query on the "borrower" edge.
+func (clq *CreditLineQuery) QueryBorrower() *UserQuery {
+	query := (&UserClient{config: clq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := clq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := clq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditline.Table, creditline.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditline.BorrowerTable, creditline.BorrowerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(clq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransactions chains the current query on the "transactions" edge.
+func (clq *CreditLineQuery) QueryTransactions() *CreditLineTransactionQuery {
+	query := (&CreditLineTransactionClient{config: clq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := clq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := clq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditline.Table, creditline.FieldID, selector),
+			sqlgraph.To(creditlinetransaction.Table, creditlinetransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditline.TransactionsTable, creditline.TransactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(clq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CreditLine entity from the query.
+// Returns a *NotFoundError when no CreditLine was found.
+func (clq *CreditLineQuery) First(ctx context.Context) (*CreditLine, error) {
+	nodes, err := clq.Limit(1).All(setContextOp(ctx, clq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{creditline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (clq *CreditLineQuery) FirstX(ctx context.Context) *CreditLine {
+	node, err := clq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditLine ID from the query.
+// Returns a *NotFoundError when no CreditLine ID was found.
+func (clq *CreditLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = clq.Limit(1).IDs(setContextOp(ctx, clq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{creditline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (clq *CreditLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := clq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditLine entity is found.
+// Returns a *NotFoundError when no CreditLine entities are found.
+func (clq *CreditLineQuery) Only(ctx context.Context) (*CreditLine, error) {
+	nodes, err := clq.Limit(2).All(setContextOp(ctx, clq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{creditline.Label}
+	default:
+		return nil, &NotSingularError{creditline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (clq *CreditLineQuery) OnlyX(ctx context.Context) *CreditLine {
+	node, err := clq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditLine ID in the query.
+// Returns a *NotSingularError when more than one CreditLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (clq *CreditLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = clq.Limit(2).IDs(setContextOp(ctx, clq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{creditline.Label}
+	default:
+		err = &NotSingularError{creditline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (clq *CreditLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := clq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditLines.
+func (clq *CreditLineQuery) All(ctx context.Context) ([]*CreditLine, error) {
+	ctx = setContextOp(ctx, clq.ctx, "All")
+	if err := clq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditLine, *CreditLineQuery]()
+	return withInterceptors[[]*CreditLine](ctx, clq, qr, clq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (clq *CreditLineQuery) AllX(ctx context.Context) []*CreditLine {
+	nodes, err := clq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditLine IDs.
+func (clq *CreditLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if clq.ctx.Unique == nil && clq.path != nil {
+		clq.Unique(true)
+	}
+	ctx = setContextOp(ctx, clq.ctx, "IDs")
+	if err = clq.Select(creditline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (clq *CreditLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := clq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (clq *CreditLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, clq.ctx, "Count")
+	if err := clq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, clq, querierCount[*CreditLineQuery](), clq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (clq *CreditLineQuery) CountX(ctx context.Context) int {
+	count, err := clq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (clq *CreditLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, clq.ctx, "Exist")
+	switch _, err := clq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (clq *CreditLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := clq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (clq *CreditLineQuery) Clone() *CreditLineQuery {
+	if clq == nil {
+		return nil
+	}
+	return &CreditLineQuery{
+		config:           clq.config,
+		ctx:              clq.ctx.Clone(),
+		order:            append([]creditline.OrderOption{}, clq.order...),
+		inters:           append([]Interceptor{}, clq.inters...),
+		predicates:       append([]predicate.CreditLine{}, clq.predicates...),
+		withBorrower:     clq.withBorrower.Clone(),
+		withTransactions: clq.withTransactions.Clone(),
+		// clone intermediate query.
+		sql:  clq.sql.Clone(),
+		path: clq.path,
+	}
+}
+
+// WithBorrower tells the query-builder to eager-load the nodes that are connected to
+// the "borrower" edge. The optional arguments are used to configure the query builder of the edge.
+func (clq *CreditLineQuery) WithBorrower(opts ...func(*UserQuery)) *CreditLineQuery {
+	query := (&UserClient{config: clq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	clq.withBorrower = query
+	return clq
+}
+
+// WithTransactions tells the query-builder to eager-load the nodes that are connected to
+// the "transactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (clq *CreditLineQuery) WithTransactions(opts ...func(*CreditLineTransactionQuery)) *CreditLineQuery {
+	query := (&CreditLineTransactionClient{config: clq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	clq.withTransactions = query
+	return clq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreditLimit int `json:"credit_limit,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditLine.Query().
+//		GroupBy(creditline.FieldCreditLimit).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (clq *CreditLineQuery) GroupBy(field string, fields ...string) *CreditLineGroupBy {
+	clq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditLineGroupBy{build: clq}
+	grbuild.flds = &clq.ctx.Fields
+	grbuild.label = creditline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreditLimit int `json:"credit_limit,omitempty"`
+//	}
+//
+//	client.CreditLine.Query().
+//		Select(creditline.FieldCreditLimit).
+//		Scan(ctx, &v)
+func (clq *CreditLineQuery) Select(fields ...string) *CreditLineSelect {
+	clq.ctx.Fields = append(clq.ctx.Fields, fields...)
+	sbuild := &CreditLineSelect{CreditLineQuery: clq}
+	sbuild.label = creditline.Label
+	sbuild.flds, sbuild.scan = &clq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditLineSelect configured with the given aggregations.
+func (clq *CreditLineQuery) Aggregate(fns ...AggregateFunc) *CreditLineSelect {
+	return clq.Select().Aggregate(fns...)
+}
+
+func (clq *CreditLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range clq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, clq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range clq.ctx.Fields {
+		if !creditline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if clq.path != nil {
+		prev, err := clq.path(ctx)
+		if err != nil {
+			return err
+		}
+		clq.sql = prev
+	}
+	return nil
+}
+
+func (clq *CreditLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditLine, error) {
+	var (
+		nodes       = []*CreditLine{}
+		_spec       = clq.querySpec()
+		loadedTypes = [2]bool{
+			clq.withBorrower != nil,
+			clq.withTransactions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditLine{config: clq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, clq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := clq.withBorrower; query != nil {
+		if err := clq.loadBorrower(ctx, query, nodes, nil,
+			func(n *CreditLine, e *User) { n.Edges.Borrower = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := clq.withTransactions; query != nil {
+		if err := clq.loadTransactions(ctx, query, nodes,
+			func(n *CreditLine) { n.Edges.Transactions = []*CreditLineTransaction{} },
+			func(n *CreditLine, e *CreditLineTransaction) { n.Edges.Transactions = append(n.Edges.Transactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (clq *CreditLineQuery) loadBorrower(ctx context.Context, query *UserQuery, nodes []*CreditLine, init func(*CreditLine), assign func(*CreditLine, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CreditLine)
+	for i := range nodes {
+		fk := nodes[i].BorrowerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "borrower_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (clq *CreditLineQuery) loadTransactions(ctx context.Context, query *CreditLineTransactionQuery, nodes []*CreditLine, init func(*CreditLine), assign func(*CreditLine, *CreditLineTransaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*CreditLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(creditlinetransaction.FieldCreditLineID)
+	}
+	query.Where(predicate.CreditLineTransaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(creditline.TransactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreditLineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "credit_line_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (clq *CreditLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := clq.querySpec()
+	_spec.Node.Columns = clq.ctx.Fields
+	if len(clq.ctx.Fields) > 0 {
+		_spec.Unique = clq.ctx.Unique != nil && *clq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, clq.driver, _spec)
+}
+
+func (clq *CreditLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(creditline.Table, creditline.Columns, sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt))
+	_spec.From = clq.sql
+	if unique := clq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if clq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := clq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditline.FieldID)
+		for i := range fields {
+			if fields[i] != creditline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if clq.withBorrower != nil {
+			_spec.Node.AddColumnOnce(creditline.FieldBorrowerID)
+		}
+	}
+	if ps := clq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := clq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := clq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := clq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (clq *CreditLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(clq.driver.Dialect())
+	t1 := builder.Table(creditline.Table)
+	columns := clq.ctx.Fields
+	if len(columns) == 0 {
+		columns = creditline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if clq.sql != nil {
+		selector = clq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if clq.ctx.Unique != nil && *clq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range clq.predicates {
+		p(selector)
+	}
+	for _, p := range clq.order {
+		p(selector)
+	}
+	if offset := clq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := clq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CreditLineGroupBy is the group-by builder for CreditLine entities.
+type CreditLineGroupBy struct {
+	selector
+	build *CreditLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (clgb *CreditLineGroupBy) Aggregate(fns ...AggregateFunc) *CreditLineGroupBy {
+	clgb.fns = append(clgb.fns, fns...)
+	return clgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (clgb *CreditLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, clgb.build.ctx, "GroupBy")
+	if err := clgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditLineQuery, *CreditLineGroupBy](ctx, clgb.build, clgb, clgb.build.inters, v)
+}
+
+func (clgb *CreditLineGroupBy) sqlScan(ctx context.Context, root *CreditLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(clgb.fns))
+	for _, fn := range clgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*clgb.flds)+len(clgb.fns))
+		for _, f := range *clgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*clgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := clgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditLineSelect is the builder for selecting fields of CreditLine entities.
+type CreditLineSelect struct {
+	*CreditLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cls *CreditLineSelect) Aggregate(fns ...AggregateFunc) *CreditLineSelect {
+	cls.fns = append(cls.fns, fns...)
+	return cls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cls *CreditLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cls.ctx, "Select")
+	if err := cls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditLineQuery, *CreditLineSelect](ctx, cls.CreditLineQuery, cls, cls.inters, v)
+}
+
+func (cls *CreditLineSelect) sqlScan(ctx context.Context, root *CreditLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cls.fns))
+	for _, fn := range cls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// CreditLineUpdate is the builder for updating CreditLine entities.
+type CreditLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditLineMutation
+}
+
+// Where appends a list predicates to the CreditLineUpdate builder.
+func (clu *CreditLineUpdate) Where(ps ...predicate.CreditLine) *CreditLineUpdate {
+	clu.mutation.Where(ps...)
+	return clu
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (clu *CreditLineUpdate) SetCreditLimit(i int) *CreditLineUpdate {
+	clu.mutation.ResetCreditLimit()
+	clu.mutation.SetCreditLimit(i)
+	return clu
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableCreditLimit(i *int) *CreditLineUpdate {
+	if i != nil {
+		clu.SetCreditLimit(*i)
+	}
+	return clu
+}
+
+// AddCreditLimit adds i to the "credit_limit" field.
+func (clu *CreditLineUpdate) AddCreditLimit(i int) *CreditLineUpdate {
+	clu.mutation.AddCreditLimit(i)
+	return clu
+}
+
+// SetRate sets the "rate" field.
+func (clu *CreditLineUpdate) SetRate(f float64) *CreditLineUpdate {
+	clu.mutation.ResetRate()
+	clu.mutation.SetRate(f)
+	return clu
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableRate(f *float64) *CreditLineUpdate {
+	if f != nil {
+		clu.SetRate(*f)
+	}
+	return clu
+}
+
+// AddRate adds f to the "rate" field.
+func (clu *CreditLineUpdate) AddRate(f float64) *CreditLineUpdate {
+	clu.mutation.AddRate(f)
+	return clu
+}
+
+// SetDrawMonths sets the "draw_months" field.
+func (clu *CreditLineUpdate) SetDrawMonths(i int) *CreditLineUpdate {
+	clu.mutation.ResetDrawMonths()
+	clu.mutation.SetDrawMonths(i)
+	return clu
+}
+
+// SetNillableDrawMonths sets the "draw_months" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableDrawMonths(i *int) *CreditLineUpdate {
+	if i != nil {
+		clu.SetDrawMonths(*i)
+	}
+	return clu
+}
+
+// AddDrawMonths adds i to the "draw_months" field.
+func (clu *CreditLineUpdate) AddDrawMonths(i int) *CreditLineUpdate {
+	clu.mutation.AddDrawMonths(i)
+	return clu
+}
+
+// SetRepaymentMonths sets the "repayment_months" field.
+func (clu *CreditLineUpdate) SetRepaymentMonths(i int) *CreditLineUpdate {
+	clu.mutation.ResetRepaymentMonths()
+	clu.mutation.SetRepaymentMonths(i)
+	return clu
+}
+
+// SetNillableRepaymentMonths sets the "repayment_months" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableRepaymentMonths(i *int) *CreditLineUpdate {
+	if i != nil {
+		clu.SetRepaymentMonths(*i)
+	}
+	return clu
+}
+
+// AddRepaymentMonths adds i to the "repayment_months" field.
+func (clu *CreditLineUpdate) AddRepaymentMonths(i int) *CreditLineUpdate {
+	clu.mutation.AddRepaymentMonths(i)
+	return clu
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (clu *CreditLineUpdate) SetOpenedAt(t time.Time) *CreditLineUpdate {
+	clu.mutation.SetOpenedAt(t)
+	return clu
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableOpenedAt(t *time.Time) *CreditLineUpdate {
+	if t != nil {
+		clu.SetOpenedAt(*t)
+	}
+	return clu
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (clu *CreditLineUpdate) SetBorrowerID(i int) *CreditLineUpdate {
+	clu.mutation.SetBorrowerID(i)
+	return clu
+}
+
+// SetNillableBorrowerID sets the "borrower_id" field if the given value is not nil.
+func (clu *CreditLineUpdate) SetNillableBorrowerID(i *int) *CreditLineUpdate {
+	if i != nil {
+		clu.SetBorrowerID(*i)
+	}
+	return clu
+}
+
+// SetBorrower sets the "borrower" edge to the User entity.
+func (clu *CreditLineUpdate) SetBorrower(u *User) *CreditLineUpdate {
+	return clu.SetBorrowerID(u.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the CreditLineTransaction entity by IDs.
+func (clu *CreditLineUpdate) AddTransactionIDs(ids ...int) *CreditLineUpdate {
+	clu.mutation.AddTransactionIDs(ids...)
+	return clu
+}
+
+// AddTransactions adds the "transactions" edges to the CreditLineTransaction entity.
+func (clu *CreditLineUpdate) AddTransactions(c ...*CreditLineTransaction) *CreditLineUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return clu.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the CreditLineMutation object of the builder.
+func (clu *CreditLineUpdate) Mutation() *CreditLineMutation {
+	return clu.mutation
+}
+
+// ClearBorrower clears the "borrower" edge to the User entity.
+func (clu *CreditLineUpdate) ClearBorrower() *CreditLineUpdate {
+	clu.mutation.ClearBorrower()
+	return clu
+}
+
+// ClearTransactions clears all "transactions" edges to the CreditLineTransaction entity.
+func (clu *CreditLineUpdate) ClearTransactions() *CreditLineUpdate {
+	clu.mutation.ClearTransactions()
+	return clu
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to CreditLineTransaction entities by IDs.
+func (clu *CreditLineUpdate) RemoveTransactionIDs(ids ...int) *CreditLineUpdate {
+	clu.mutation.RemoveTransactionIDs(ids...)
+	return clu
+}
+
+// RemoveTransactions removes "transactions" edges to CreditLineTransaction entities.
+func (clu *CreditLineUpdate) RemoveTransactions(c ...*CreditLineTransaction) *CreditLineUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return clu.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (clu *CreditLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, clu.sqlSave, clu.mutation, clu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (clu *CreditLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := clu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (clu *CreditLineUpdate) Exec(ctx context.Context) error {
+	_, err := clu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clu *CreditLineUpdate) ExecX(ctx context.Context) {
+	if err := clu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (clu *CreditLineUpdate) check() error {
+	if _, ok := clu.mutation.BorrowerID(); clu.mutation.BorrowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CreditLine.borrower"`)
+	}
+	return nil
+}
+
+func (clu *CreditLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := clu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditline.Table, creditline.Columns, sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt))
+	if ps := clu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := clu.mutation.CreditLimit(); ok {
+		_spec.SetField(creditline.FieldCreditLimit, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(creditline.FieldCreditLimit, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.Rate(); ok {
+		_spec.SetField(creditline.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := clu.mutation.AddedRate(); ok {
+		_spec.AddField(creditline.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := clu.mutation.DrawMonths(); ok {
+		_spec.SetField(creditline.FieldDrawMonths, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.AddedDrawMonths(); ok {
+		_spec.AddField(creditline.FieldDrawMonths, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.RepaymentMonths(); ok {
+		_spec.SetField(creditline.FieldRepaymentMonths, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.AddedRepaymentMonths(); ok {
+		_spec.AddField(creditline.FieldRepaymentMonths, field.TypeInt, value)
+	}
+	if value, ok := clu.mutation.OpenedAt(); ok {
+		_spec.SetField(creditline.FieldOpenedAt, field.TypeTime, value)
+	}
+	if clu.mutation.BorrowerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditline.BorrowerTable,
+			Columns: []string{creditline.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := clu.mutation.BorrowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditline.BorrowerTable,
+			Columns: []string{creditline.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if clu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := clu.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !clu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := clu.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, clu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	clu.mutation.done = true
+	return n, nil
+}
+
+// CreditLineUpdateOne is the builder for updating a single CreditLine entity.
+type CreditLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditLineMutation
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (cluo *CreditLineUpdateOne) SetCreditLimit(i int) *CreditLineUpdateOne {
+	cluo.mutation.ResetCreditLimit()
+	cluo.mutation.SetCreditLimit(i)
+	return cluo
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableCreditLimit(i *int) *CreditLineUpdateOne {
+	if i != nil {
+		cluo.SetCreditLimit(*i)
+	}
+	return cluo
+}
+
+// AddCreditLimit adds i to the "credit_limit" field.
+func (cluo *CreditLineUpdateOne) AddCreditLimit(i int) *CreditLineUpdateOne {
+	cluo.mutation.AddCreditLimit(i)
+	return cluo
+}
+
+// SetRate sets the "rate" field.
+func (cluo *CreditLineUpdateOne) SetRate(f float64) *CreditLineUpdateOne {
+	cluo.mutation.ResetRate()
+	cluo.mutation.SetRate(f)
+	return cluo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableRate(f *float64) *CreditLineUpdateOne {
+	if f != nil {
+		cluo.SetRate(*f)
+	}
+	return cluo
+}
+
+// AddRate adds f to the "rate" field.
+func (cluo *CreditLineUpdateOne) AddRate(f float64) *CreditLineUpdateOne {
+	cluo.mutation.AddRate(f)
+	return cluo
+}
+
+// SetDrawMonths sets the "draw_months" field.
+func (cluo *CreditLineUpdateOne) SetDrawMonths(i int) *CreditLineUpdateOne {
+	cluo.mutation.ResetDrawMonths()
+	cluo.mutation.SetDrawMonths(i)
+	return cluo
+}
+
+// SetNillableDrawMonths sets the "draw_months" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableDrawMonths(i *int) *CreditLineUpdateOne {
+	if i != nil {
+		cluo.SetDrawMonths(*i)
+	}
+	return cluo
+}
+
+// AddDrawMonths adds i to the "draw_months" field.
+func (cluo *CreditLineUpdateOne) AddDrawMonths(i int) *CreditLineUpdateOne {
+	cluo.mutation.AddDrawMonths(i)
+	return cluo
+}
+
+// SetRepaymentMonths sets the "repayment_months" field.
+func (cluo *CreditLineUpdateOne) SetRepaymentMonths(i int) *CreditLineUpdateOne {
+	cluo.mutation.ResetRepaymentMonths()
+	cluo.mutation.SetRepaymentMonths(i)
+	return cluo
+}
+
+// SetNillableRepaymentMonths sets the "repayment_months" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableRepaymentMonths(i *int) *CreditLineUpdateOne {
+	if i != nil {
+		cluo.SetRepaymentMonths(*i)
+	}
+	return cluo
+}
+
+// AddRepaymentMonths adds i to the "repayment_months" field.
+func (cluo *CreditLineUpdateOne) AddRepaymentMonths(i int) *CreditLineUpdateOne {
+	cluo.mutation.AddRepaymentMonths(i)
+	return cluo
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (cluo *CreditLineUpdateOne) SetOpenedAt(t time.Time) *CreditLineUpdateOne {
+	cluo.mutation.SetOpenedAt(t)
+	return cluo
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableOpenedAt(t *time.Time) *CreditLineUpdateOne {
+	if t != nil {
+		cluo.SetOpenedAt(*t)
+	}
+	return cluo
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (cluo *CreditLineUpdateOne) SetBorrowerID(i int) *CreditLineUpdateOne {
+	cluo.mutation.SetBorrowerID(i)
+	return cluo
+}
+
+// SetNillableBorrowerID sets the "borrower_id" field if the given value is not nil.
+func (cluo *CreditLineUpdateOne) SetNillableBorrowerID(i *int) *CreditLineUpdateOne {
+	if i != nil {
+		cluo.SetBorrowerID(*i)
+	}
+	return cluo
+}
+
+// SetBorrower sets the "borrower" edge to the User entity.
+func (cluo *CreditLineUpdateOne) SetBorrower(u *User) *CreditLineUpdateOne {
+	return cluo.SetBorrowerID(u.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the CreditLineTransaction entity by IDs.
+func (cluo *CreditLineUpdateOne) AddTransactionIDs(ids ...int) *CreditLineUpdateOne {
+	cluo.mutation.AddTransactionIDs(ids...)
+	return cluo
+}
+
+// AddTransactions adds the "transactions" edges to the CreditLineTransaction entity.
+func (cluo *CreditLineUpdateOne) AddTransactions(c ...*CreditLineTransaction) *CreditLineUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cluo.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the CreditLineMutation object of the builder.
+func (cluo *CreditLineUpdateOne) Mutation() *CreditLineMutation {
+	return cluo.mutation
+}
+
+// ClearBorrower clears the "borrower" edge to the User entity.
+func (cluo *CreditLineUpdateOne) ClearBorrower() *CreditLineUpdateOne {
+	cluo.mutation.ClearBorrower()
+	return cluo
+}
+
+// ClearTransactions clears all "transactions" edges to the CreditLineTransaction entity.
+func (cluo *CreditLineUpdateOne) ClearTransactions() *CreditLineUpdateOne {
+	cluo.mutation.ClearTransactions()
+	return cluo
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to CreditLineTransaction entities by IDs.
+func (cluo *CreditLineUpdateOne) RemoveTransactionIDs(ids ...int) *CreditLineUpdateOne {
+	cluo.mutation.RemoveTransactionIDs(ids...)
+	return cluo
+}
+
+// RemoveTransactions removes "transactions" edges to CreditLineTransaction entities.
+func (cluo *CreditLineUpdateOne) RemoveTransactions(c ...*CreditLineTransaction) *CreditLineUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cluo.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the CreditLineUpdate builder.
+func (cluo *CreditLineUpdateOne) Where(ps ...predicate.CreditLine) *CreditLineUpdateOne {
+	cluo.mutation.Where(ps...)
+	return cluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cluo *CreditLineUpdateOne) Select(field string, fields ...string) *CreditLineUpdateOne {
+	cluo.fields = append([]string{field}, fields...)
+	return cluo
+}
+
+// Save executes the query and returns the updated CreditLine entity.
+func (cluo *CreditLineUpdateOne) Save(ctx context.Context) (*CreditLine, error) {
+	return withHooks(ctx, cluo.sqlSave, cluo.mutation, cluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cluo *CreditLineUpdateOne) SaveX(ctx context.Context) *CreditLine {
+	node, err := cluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cluo *CreditLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := cluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cluo *CreditLineUpdateOne) ExecX(ctx context.Context) {
+	if err := cluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cluo *CreditLineUpdateOne) check() error {
+	if _, ok := cluo.mutation.BorrowerID(); cluo.mutation.BorrowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CreditLine.borrower"`)
+	}
+	return nil
+}
+
+func (cluo *CreditLineUpdateOne) sqlSave(ctx context.Context) (_node *CreditLine, err error) {
+	if err := cluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditline.Table, creditline.Columns, sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt))
+	id, ok := cluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditline.FieldID)
+		for _, f := range fields {
+			if !creditline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != creditline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cluo.mutation.CreditLimit(); ok {
+		_spec.SetField(creditline.FieldCreditLimit, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(creditline.FieldCreditLimit, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.Rate(); ok {
+		_spec.SetField(creditline.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := cluo.mutation.AddedRate(); ok {
+		_spec.AddField(creditline.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := cluo.mutation.DrawMonths(); ok {
+		_spec.SetField(creditline.FieldDrawMonths, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.AddedDrawMonths(); ok {
+		_spec.AddField(creditline.FieldDrawMonths, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.RepaymentMonths(); ok {
+		_spec.SetField(creditline.FieldRepaymentMonths, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.AddedRepaymentMonths(); ok {
+		_spec.AddField(creditline.FieldRepaymentMonths, field.TypeInt, value)
+	}
+	if value, ok := cluo.mutation.OpenedAt(); ok {
+		_spec.SetField(creditline.FieldOpenedAt, field.TypeTime, value)
+	}
+	if cluo.mutation.BorrowerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditline.BorrowerTable,
+			Columns: []string{creditline.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cluo.mutation.BorrowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditline.BorrowerTable,
+			Columns: []string{creditline.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cluo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cluo.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !cluo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cluo.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditline.TransactionsTable,
+			Columns: []string{creditline.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CreditLine{config: cluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cluo.mutation.done = true
+	return _node, nil
+}
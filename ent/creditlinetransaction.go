@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+)
+
+// CreditLineTransaction is the model entity for the CreditLineTransaction schema.
+type CreditLineTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreditLineID holds the value of the "credit_line_id" field.
+	CreditLineID int `json:"credit_line_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind creditlinetransaction.Kind `json:"kind,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int `json:"amount,omitempty"`
+	// PostedAt holds the value of the "posted_at" field.
+	PostedAt time.Time `json:"posted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CreditLineTransactionQuery when eager-loading is set.
+	Edges        CreditLineTransactionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CreditLineTransactionEdges holds the relations/edges for other nodes in the graph.
+type CreditLineTransactionEdges struct {
+	// CreditLine holds the value of the credit_line edge.
+	CreditLine *CreditLine `json:"credit_line,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CreditLineOrErr returns the CreditLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CreditLineTransactionEdges) CreditLineOrErr() (*CreditLine, error) {
+	if e.loadedTypes[0] {
+		if e.CreditLine == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: creditline.Label}
+		}
+		return e.CreditLine, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_line"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditLineTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case creditlinetransaction.FieldID, creditlinetransaction.FieldCreditLineID, creditlinetransaction.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case creditlinetransaction.FieldKind:
+			values[i] = new(sql.NullString)
+		case creditlinetransaction.FieldPostedAt, creditlinetransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditLineTransaction fields.
+func (clt *CreditLineTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case creditlinetransaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			clt.ID = int(value.Int64)
+		case creditlinetransaction.FieldCreditLineID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_line_id", values[i])
+			} else if value.Valid {
+				clt.CreditLineID = int(value.Int64)
+			}
+		case creditlinetransaction.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				clt.Kind = creditlinetransaction.Kind(value.String)
+			}
+		case creditlinetransaction.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				clt.Amount = int(value.Int64)
+			}
+		case creditlinetransaction.FieldPostedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_at", values[i])
+			} else if value.Valid {
+				clt.PostedAt = value.Time
+			}
+		case creditlinetransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				clt.CreatedAt = value.Time
+			}
+		default:
+			clt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditLineTransaction.
+// This includes values selected through modifiers, order, etc.
+func (clt *CreditLineTransaction) Value(name string) (ent.Value, error) {
+	return clt.selectValues.Get(name)
+}
+
+// QueryCreditLine queries the "credit_line" edge of the CreditLineTransaction entity.
+func (clt *CreditLineTransaction) QueryCreditLine() *CreditLineQuery {
+	return NewCreditLineTransactionClient(clt.config).QueryCreditLine(clt)
+}
+
+// Update returns a builder for updating this CreditLineTransaction.
+// Note that you need to call CreditLineTransaction.Unwrap() before calling this method if this CreditLineTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (clt *CreditLineTransaction) Update() *CreditLineTransactionUpdateOne {
+	return NewCreditLineTransactionClient(clt.config).UpdateOne(clt)
+}
+
+// Unwrap unwraps the CreditLineTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (clt *CreditLineTransaction) Unwrap() *CreditLineTransaction {
+	_tx, ok := clt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditLineTransaction is not a transactional entity")
+	}
+	clt.config.driver = _tx.drv
+	return clt
+}
+
+// String implements the fmt.Stringer.
+func (clt *CreditLineTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditLineTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", clt.ID))
+	builder.WriteString("credit_line_id=")
+	builder.WriteString(fmt.Sprintf("%v", clt.CreditLineID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", clt.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", clt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("posted_at=")
+	builder.WriteString(clt.PostedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(clt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditLineTransactions is a parsable slice of CreditLineTransaction.
+type CreditLineTransactions []*CreditLineTransaction
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package creditlinetransaction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the creditlinetransaction type in the database.
+	Label = "credit_line_transaction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreditLineID holds the string denoting the credit_line_id field in the database.
+	FieldCreditLineID = "credit_line_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldPostedAt holds the string denoting the posted_at field in the database.
+	FieldPostedAt = "posted_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCreditLine holds the string denoting the credit_line edge name in mutations.
+	EdgeCreditLine = "credit_line"
+	// Table holds the table name of the creditlinetransaction in the database.
+	Table = "credit_line_transactions"
+	// CreditLineTable is the table that holds the credit_line relation/edge.
+	CreditLineTable = "credit_line_transactions"
+	// CreditLineInverseTable is the table name for the CreditLine entity.
+	// It exists in this package in order to avoid circular dependency with the "creditline" package.
+	CreditLineInverseTable = "credit_lines"
+	// CreditLineColumn is the table column denoting the credit_line relation/edge.
+	CreditLineColumn = "credit_line_id"
+)
+
+// Columns holds all SQL columns for creditlinetransaction fields.
+var Columns = []string{
+	FieldID,
+	FieldCreditLineID,
+	FieldKind,
+	FieldAmount,
+	FieldPostedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindDraw    Kind = "draw"
+	KindPayment Kind = "payment"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindDraw, KindPayment:
+		return nil
+	default:
+		return fmt.Errorf("creditlinetransaction: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the CreditLineTransaction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreditLineID orders the results by the credit_line_id field.
+func ByCreditLineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLineID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByPostedAt orders the results by the posted_at field.
+func ByPostedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCreditLineField orders the results by credit_line field.
+func ByCreditLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditLineStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCreditLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreditLineTable, CreditLineColumn),
+	)
+}
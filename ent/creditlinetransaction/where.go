@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package creditlinetransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// CreditLineID applies equality check predicate on the "credit_line_id" field. It's identical to CreditLineIDEQ.
+func CreditLineID(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldCreditLineID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// PostedAt applies equality check predicate on the "posted_at" field. It's identical to PostedAtEQ.
+func PostedAt(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreditLineIDEQ applies the EQ predicate on the "credit_line_id" field.
+func CreditLineIDEQ(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldCreditLineID, v))
+}
+
+// CreditLineIDNEQ applies the NEQ predicate on the "credit_line_id" field.
+func CreditLineIDNEQ(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldCreditLineID, v))
+}
+
+// CreditLineIDIn applies the In predicate on the "credit_line_id" field.
+func CreditLineIDIn(vs ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldCreditLineID, vs...))
+}
+
+// CreditLineIDNotIn applies the NotIn predicate on the "credit_line_id" field.
+func CreditLineIDNotIn(vs ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldCreditLineID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// PostedAtEQ applies the EQ predicate on the "posted_at" field.
+func PostedAtEQ(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// PostedAtNEQ applies the NEQ predicate on the "posted_at" field.
+func PostedAtNEQ(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldPostedAt, v))
+}
+
+// PostedAtIn applies the In predicate on the "posted_at" field.
+func PostedAtIn(vs ...time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldPostedAt, vs...))
+}
+
+// PostedAtNotIn applies the NotIn predicate on the "posted_at" field.
+func PostedAtNotIn(vs ...time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldPostedAt, vs...))
+}
+
+// PostedAtGT applies the GT predicate on the "posted_at" field.
+func PostedAtGT(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGT(FieldPostedAt, v))
+}
+
+// PostedAtGTE applies the GTE predicate on the "posted_at" field.
+func PostedAtGTE(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGTE(FieldPostedAt, v))
+}
+
+// PostedAtLT applies the LT predicate on the "posted_at" field.
+func PostedAtLT(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLT(FieldPostedAt, v))
+}
+
+// PostedAtLTE applies the LTE predicate on the "posted_at" field.
+func PostedAtLTE(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLTE(FieldPostedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCreditLine applies the HasEdge predicate on the "credit_line" edge.
+func HasCreditLine() predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditLineTable, CreditLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditLineWith applies the HasEdge predicate on the "credit_line" edge with a given conditions (other predicates).
+func HasCreditLineWith(preds ...predicate.CreditLine) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(func(s *sql.Selector) {
+		step := newCreditLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CreditLineTransaction) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CreditLineTransaction) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CreditLineTransaction) predicate.CreditLineTransaction {
+	return predicate.CreditLineTransaction(sql.NotPredicates(p))
+}
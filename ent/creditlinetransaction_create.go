@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+)
+
+// CreditLineTransactionCreate is the builder for creating a CreditLineTransaction entity.
+type CreditLineTransactionCreate struct {
+	config
+	mutation *CreditLineTransactionMutation
+	hooks    []Hook
+}
+
+// SetCreditLineID sets the "credit_line_id" field.
+func (cltc *CreditLineTransactionCreate) SetCreditLineID(i int) *CreditLineTransactionCreate {
+	cltc.mutation.SetCreditLineID(i)
+	return cltc
+}
+
+// SetKind sets the "kind" field.
+func (cltc *CreditLineTransactionCreate) SetKind(c creditlinetransaction.Kind) *CreditLineTransactionCreate {
+	cltc.mutation.SetKind(c)
+	return cltc
+}
+
+// SetAmount sets the "amount" field.
+func (cltc *CreditLineTransactionCreate) SetAmount(i int) *CreditLineTransactionCreate {
+	cltc.mutation.SetAmount(i)
+	return cltc
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (cltc *CreditLineTransactionCreate) SetPostedAt(t time.Time) *CreditLineTransactionCreate {
+	cltc.mutation.SetPostedAt(t)
+	return cltc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cltc *CreditLineTransactionCreate) SetCreatedAt(t time.Time) *CreditLineTransactionCreate {
+	cltc.mutation.SetCreatedAt(t)
+	return cltc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cltc *CreditLineTransactionCreate) SetNillableCreatedAt(t *time.Time) *CreditLineTransactionCreate {
+	if t != nil {
+		cltc.SetCreatedAt(*t)
+	}
+	return cltc
+}
+
+// SetCreditLine sets the "credit_line" edge to the CreditLine entity.
+func (cltc *CreditLineTransactionCreate) SetCreditLine(c *CreditLine) *CreditLineTransactionCreate {
+	return cltc.SetCreditLineID(c.ID)
+}
+
+// Mutation returns the CreditLineTransactionMutation object of the builder.
+func (cltc *CreditLineTransactionCreate) Mutation() *CreditLineTransactionMutation {
+	return cltc.mutation
+}
+
+// Save creates the CreditLineTransaction in the database.
+func (cltc *CreditLineTransactionCreate) Save(ctx context.Context) (*CreditLineTransaction, error) {
+	cltc.defaults()
+	return withHooks(ctx, cltc.sqlSave, cltc.mutation, cltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cltc *CreditLineTransactionCreate) SaveX(ctx context.Context) *CreditLineTransaction {
+	v, err := cltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cltc *CreditLineTransactionCreate) Exec(ctx context.Context) error {
+	_, err := cltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltc *CreditLineTransactionCreate) ExecX(ctx context.Context) {
+	if err := cltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cltc *CreditLineTransactionCreate) defaults() {
+	if _, ok := cltc.mutation.CreatedAt(); !ok {
+		v := creditlinetransaction.DefaultCreatedAt()
+		cltc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cltc *CreditLineTransactionCreate) check() error {
+	if _, ok := cltc.mutation.CreditLineID(); !ok {
+		return &ValidationError{Name: "credit_line_id", err: errors.New(`ent: missing required field "CreditLineTransaction.credit_line_id"`)}
+	}
+	if _, ok := cltc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CreditLineTransaction.kind"`)}
+	}
+	if v, ok := cltc.mutation.Kind(); ok {
+		if err := creditlinetransaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CreditLineTransaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := cltc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CreditLineTransaction.amount"`)}
+	}
+	if _, ok := cltc.mutation.PostedAt(); !ok {
+		return &ValidationError{Name: "posted_at", err: errors.New(`ent: missing required field "CreditLineTransaction.posted_at"`)}
+	}
+	if _, ok := cltc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CreditLineTransaction.created_at"`)}
+	}
+	if _, ok := cltc.mutation.CreditLineID(); !ok {
+		return &ValidationError{Name: "credit_line", err: errors.New(`ent: missing required edge "CreditLineTransaction.credit_line"`)}
+	}
+	return nil
+}
+
+func (cltc *CreditLineTransactionCreate) sqlSave(ctx context.Context) (*CreditLineTransaction, error) {
+	if err := cltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cltc.mutation.id = &_node.ID
+	cltc.mutation.done = true
+	return _node, nil
+}
+
+func (cltc *CreditLineTransactionCreate) createSpec() (*CreditLineTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditLineTransaction{config: cltc.config}
+		_spec = sqlgraph.NewCreateSpec(creditlinetransaction.Table, sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt))
+	)
+	if value, ok := cltc.mutation.Kind(); ok {
+		_spec.SetField(creditlinetransaction.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cltc.mutation.Amount(); ok {
+		_spec.SetField(creditlinetransaction.FieldAmount, field.TypeInt, value)
+		_node.Amount = value
+	}
+	if value, ok := cltc.mutation.PostedAt(); ok {
+		_spec.SetField(creditlinetransaction.FieldPostedAt, field.TypeTime, value)
+		_node.PostedAt = value
+	}
+	if value, ok := cltc.mutation.CreatedAt(); ok {
+		_spec.SetField(creditlinetransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cltc.mutation.CreditLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditlinetransaction.CreditLineTable,
+			Columns: []string{creditlinetransaction.CreditLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreditLineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CreditLineTransactionCreateBulk is the builder for creating many CreditLineTransaction entities in bulk.
+type CreditLineTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*CreditLineTransactionCreate
+}
+
+// Save creates the CreditLineTransaction entities in the database.
+func (cltcb *CreditLineTransactionCreateBulk) Save(ctx context.Context) ([]*CreditLineTransaction, error) {
+	if cltcb.err != nil {
+		return nil, cltcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cltcb.builders))
+	nodes := make([]*CreditLineTransaction, len(cltcb.builders))
+	mutators := make([]Mutator, len(cltcb.builders))
+	for i := range cltcb.builders {
+		func(i int, root context.Context) {
+			builder := cltcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditLineTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cltcb *CreditLineTransactionCreateBulk) SaveX(ctx context.Context) []*CreditLineTransaction {
+	v, err := cltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cltcb *CreditLineTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltcb *CreditLineTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := cltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CreditLineTransactionDelete is the builder for deleting a CreditLineTransaction entity.
+type CreditLineTransactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditLineTransactionMutation
+}
+
+// Where appends a list predicates to the CreditLineTransactionDelete builder.
+func (cltd *CreditLineTransactionDelete) Where(ps ...predicate.CreditLineTransaction) *CreditLineTransactionDelete {
+	cltd.mutation.Where(ps...)
+	return cltd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cltd *CreditLineTransactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cltd.sqlExec, cltd.mutation, cltd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltd *CreditLineTransactionDelete) ExecX(ctx context.Context) int {
+	n, err := cltd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cltd *CreditLineTransactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditlinetransaction.Table, sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt))
+	if ps := cltd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cltd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cltd.mutation.done = true
+	return affected, err
+}
+
+// CreditLineTransactionDeleteOne is the builder for deleting a single CreditLineTransaction entity.
+type CreditLineTransactionDeleteOne struct {
+	cltd *CreditLineTransactionDelete
+}
+
+// Where appends a list predicates to the CreditLineTransactionDelete builder.
+func (cltdo *CreditLineTransactionDeleteOne) Where(ps ...predicate.CreditLineTransaction) *CreditLineTransactionDeleteOne {
+	cltdo.cltd.mutation.Where(ps...)
+	return cltdo
+}
+
+// Exec executes the deletion query.
+func (cltdo *CreditLineTransactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cltdo.cltd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditlinetransaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltdo *CreditLineTransactionDeleteOne) ExecX(ctx context.Context) {
+	if err := cltdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CreditLineTransactionQuery is the builder for querying CreditLineTransaction entities.
+type CreditLineTransactionQuery struct {
+	config
+	ctx            *QueryContext
+	order          []creditlinetransaction.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.CreditLineTransaction
+	withCreditLine *CreditLineQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CreditLineTransactionQuery builder.
+func (cltq *CreditLineTransactionQuery) Where(ps ...predicate.CreditLineTransaction) *CreditLineTransactionQuery {
+	cltq.predicates = append(cltq.predicates, ps...)
+	return cltq
+}
+
+// Limit the number of records to be returned by this query.
+func (cltq *CreditLineTransactionQuery) Limit(limit int) *CreditLineTransactionQuery {
+	cltq.ctx.Limit = &limit
+	return cltq
+}
+
+// Offset to start from.
+func (cltq *CreditLineTransactionQuery) Offset(offset int) *CreditLineTransactionQuery {
+	cltq.ctx.Offset = &offset
+	return cltq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cltq *CreditLineTransactionQuery) Unique(unique bool) *CreditLineTransactionQuery {
+	cltq.ctx.Unique = &unique
+	return cltq
+}
+
+// Order specifies how the records should be ordered.
+func (cltq *CreditLineTransactionQuery) Order(o ...creditlinetransaction.OrderOption) *CreditLineTransactionQuery {
+	cltq.order = append(cltq.order, o...)
+	return cltq
+}
+
+// QueryCreditLine chains the current query on the "credit_line" edge.
+func (cltq *CreditLineTransactionQuery) QueryCreditLine() *CreditLineQuery {
+	query := (&CreditLineClient{config: cltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(creditlinetransaction.Table, creditlinetransaction.FieldID, selector),
+			sqlgraph.To(creditline.Table, creditline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditlinetransaction.CreditLineTable, creditlinetransaction.CreditLineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CreditLineTransaction entity from the query.
+// Returns a *NotFoundError when no CreditLineTransaction was found.
+func (cltq *CreditLineTransactionQuery) First(ctx context.Context) (*CreditLineTransaction, error) {
+	nodes, err := cltq.Limit(1).All(setContextOp(ctx, cltq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{creditlinetransaction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) FirstX(ctx context.Context) *CreditLineTransaction {
+	node, err := cltq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CreditLineTransaction ID from the query.
+// Returns a *NotFoundError when no CreditLineTransaction ID was found.
+func (cltq *CreditLineTransactionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cltq.Limit(1).IDs(setContextOp(ctx, cltq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{creditlinetransaction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) FirstIDX(ctx context.Context) int {
+	id, err := cltq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CreditLineTransaction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CreditLineTransaction entity is found.
+// Returns a *NotFoundError when no CreditLineTransaction entities are found.
+func (cltq *CreditLineTransactionQuery) Only(ctx context.Context) (*CreditLineTransaction, error) {
+	nodes, err := cltq.Limit(2).All(setContextOp(ctx, cltq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{creditlinetransaction.Label}
+	default:
+		return nil, &NotSingularError{creditlinetransaction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) OnlyX(ctx context.Context) *CreditLineTransaction {
+	node, err := cltq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CreditLineTransaction ID in the query.
+// Returns a *NotSingularError when more than one CreditLineTransaction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cltq *CreditLineTransactionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cltq.Limit(2).IDs(setContextOp(ctx, cltq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{creditlinetransaction.Label}
+	default:
+		err = &NotSingularError{creditlinetransaction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cltq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CreditLineTransactions.
+func (cltq *CreditLineTransactionQuery) All(ctx context.Context) ([]*CreditLineTransaction, error) {
+	ctx = setContextOp(ctx, cltq.ctx, "All")
+	if err := cltq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CreditLineTransaction, *CreditLineTransactionQuery]()
+	return withInterceptors[[]*CreditLineTransaction](ctx, cltq, qr, cltq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) AllX(ctx context.Context) []*CreditLineTransaction {
+	nodes, err := cltq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CreditLineTransaction IDs.
+func (cltq *CreditLineTransactionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cltq.ctx.Unique == nil && cltq.path != nil {
+		cltq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cltq.ctx, "IDs")
+	if err = cltq.Select(creditlinetransaction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) IDsX(ctx context.Context) []int {
+	ids, err := cltq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cltq *CreditLineTransactionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cltq.ctx, "Count")
+	if err := cltq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cltq, querierCount[*CreditLineTransactionQuery](), cltq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) CountX(ctx context.Context) int {
+	count, err := cltq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cltq *CreditLineTransactionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cltq.ctx, "Exist")
+	switch _, err := cltq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cltq *CreditLineTransactionQuery) ExistX(ctx context.Context) bool {
+	exist, err := cltq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CreditLineTransactionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cltq *CreditLineTransactionQuery) Clone() *CreditLineTransactionQuery {
+	if cltq == nil {
+		return nil
+	}
+	return &CreditLineTransactionQuery{
+		config:         cltq.config,
+		ctx:            cltq.ctx.Clone(),
+		order:          append([]creditlinetransaction.OrderOption{}, cltq.order...),
+		inters:         append([]Interceptor{}, cltq.inters...),
+		predicates:     append([]predicate.CreditLineTransaction{}, cltq.predicates...),
+		withCreditLine: cltq.withCreditLine.Clone(),
+		// clone intermediate query.
+		sql:  cltq.sql.Clone(),
+		path: cltq.path,
+	}
+}
+
+// WithCreditLine tells the query-builder to eager-load the nodes that are connected to
+// the "credit_line" edge. The optional arguments are used to configure the query builder of the edge.
+func (cltq *CreditLineTransactionQuery) WithCreditLine(opts ...func(*CreditLineQuery)) *CreditLineTransactionQuery {
+	query := (&CreditLineClient{config: cltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cltq.withCreditLine = query
+	return cltq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreditLineID int `json:"credit_line_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CreditLineTransaction.Query().
+//		GroupBy(creditlinetransaction.FieldCreditLineID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cltq *CreditLineTransactionQuery) GroupBy(field string, fields ...string) *CreditLineTransactionGroupBy {
+	cltq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CreditLineTransactionGroupBy{build: cltq}
+	grbuild.flds = &cltq.ctx.Fields
+	grbuild.label = creditlinetransaction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreditLineID int `json:"credit_line_id,omitempty"`
+//	}
+//
+//	client.CreditLineTransaction.Query().
+//		Select(creditlinetransaction.FieldCreditLineID).
+//		Scan(ctx, &v)
+func (cltq *CreditLineTransactionQuery) Select(fields ...string) *CreditLineTransactionSelect {
+	cltq.ctx.Fields = append(cltq.ctx.Fields, fields...)
+	sbuild := &CreditLineTransactionSelect{CreditLineTransactionQuery: cltq}
+	sbuild.label = creditlinetransaction.Label
+	sbuild.flds, sbuild.scan = &cltq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CreditLineTransactionSelect configured with the given aggregations.
+func (cltq *CreditLineTransactionQuery) Aggregate(fns ...AggregateFunc) *CreditLineTransactionSelect {
+	return cltq.Select().Aggregate(fns...)
+}
+
+func (cltq *CreditLineTransactionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cltq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cltq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cltq.ctx.Fields {
+		if !creditlinetransaction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cltq.path != nil {
+		prev, err := cltq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cltq.sql = prev
+	}
+	return nil
+}
+
+func (cltq *CreditLineTransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CreditLineTransaction, error) {
+	var (
+		nodes       = []*CreditLineTransaction{}
+		_spec       = cltq.querySpec()
+		loadedTypes = [1]bool{
+			cltq.withCreditLine != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CreditLineTransaction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CreditLineTransaction{config: cltq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cltq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cltq.withCreditLine; query != nil {
+		if err := cltq.loadCreditLine(ctx, query, nodes, nil,
+			func(n *CreditLineTransaction, e *CreditLine) { n.Edges.CreditLine = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cltq *CreditLineTransactionQuery) loadCreditLine(ctx context.Context, query *CreditLineQuery, nodes []*CreditLineTransaction, init func(*CreditLineTransaction), assign func(*CreditLineTransaction, *CreditLine)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CreditLineTransaction)
+	for i := range nodes {
+		fk := nodes[i].CreditLineID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(creditline.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "credit_line_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cltq *CreditLineTransactionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cltq.querySpec()
+	_spec.Node.Columns = cltq.ctx.Fields
+	if len(cltq.ctx.Fields) > 0 {
+		_spec.Unique = cltq.ctx.Unique != nil && *cltq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cltq.driver, _spec)
+}
+
+func (cltq *CreditLineTransactionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(creditlinetransaction.Table, creditlinetransaction.Columns, sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt))
+	_spec.From = cltq.sql
+	if unique := cltq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cltq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cltq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditlinetransaction.FieldID)
+		for i := range fields {
+			if fields[i] != creditlinetransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cltq.withCreditLine != nil {
+			_spec.Node.AddColumnOnce(creditlinetransaction.FieldCreditLineID)
+		}
+	}
+	if ps := cltq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cltq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cltq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cltq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cltq *CreditLineTransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cltq.driver.Dialect())
+	t1 := builder.Table(creditlinetransaction.Table)
+	columns := cltq.ctx.Fields
+	if len(columns) == 0 {
+		columns = creditlinetransaction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cltq.sql != nil {
+		selector = cltq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cltq.ctx.Unique != nil && *cltq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cltq.predicates {
+		p(selector)
+	}
+	for _, p := range cltq.order {
+		p(selector)
+	}
+	if offset := cltq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cltq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CreditLineTransactionGroupBy is the group-by builder for CreditLineTransaction entities.
+type CreditLineTransactionGroupBy struct {
+	selector
+	build *CreditLineTransactionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cltgb *CreditLineTransactionGroupBy) Aggregate(fns ...AggregateFunc) *CreditLineTransactionGroupBy {
+	cltgb.fns = append(cltgb.fns, fns...)
+	return cltgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cltgb *CreditLineTransactionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cltgb.build.ctx, "GroupBy")
+	if err := cltgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditLineTransactionQuery, *CreditLineTransactionGroupBy](ctx, cltgb.build, cltgb, cltgb.build.inters, v)
+}
+
+func (cltgb *CreditLineTransactionGroupBy) sqlScan(ctx context.Context, root *CreditLineTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cltgb.fns))
+	for _, fn := range cltgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cltgb.flds)+len(cltgb.fns))
+		for _, f := range *cltgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cltgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cltgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CreditLineTransactionSelect is the builder for selecting fields of CreditLineTransaction entities.
+type CreditLineTransactionSelect struct {
+	*CreditLineTransactionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (clts *CreditLineTransactionSelect) Aggregate(fns ...AggregateFunc) *CreditLineTransactionSelect {
+	clts.fns = append(clts.fns, fns...)
+	return clts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (clts *CreditLineTransactionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, clts.ctx, "Select")
+	if err := clts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CreditLineTransactionQuery, *CreditLineTransactionSelect](ctx, clts.CreditLineTransactionQuery, clts, clts.inters, v)
+}
+
+func (clts *CreditLineTransactionSelect) sqlScan(ctx context.Context, root *CreditLineTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(clts.fns))
+	for _, fn := range clts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*clts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := clts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
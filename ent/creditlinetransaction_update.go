@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// CreditLineTransactionUpdate is the builder for updating CreditLineTransaction entities.
+type CreditLineTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditLineTransactionMutation
+}
+
+// Where appends a list predicates to the CreditLineTransactionUpdate builder.
+func (cltu *CreditLineTransactionUpdate) Where(ps ...predicate.CreditLineTransaction) *CreditLineTransactionUpdate {
+	cltu.mutation.Where(ps...)
+	return cltu
+}
+
+// SetCreditLineID sets the "credit_line_id" field.
+func (cltu *CreditLineTransactionUpdate) SetCreditLineID(i int) *CreditLineTransactionUpdate {
+	cltu.mutation.SetCreditLineID(i)
+	return cltu
+}
+
+// SetNillableCreditLineID sets the "credit_line_id" field if the given value is not nil.
+func (cltu *CreditLineTransactionUpdate) SetNillableCreditLineID(i *int) *CreditLineTransactionUpdate {
+	if i != nil {
+		cltu.SetCreditLineID(*i)
+	}
+	return cltu
+}
+
+// SetKind sets the "kind" field.
+func (cltu *CreditLineTransactionUpdate) SetKind(c creditlinetransaction.Kind) *CreditLineTransactionUpdate {
+	cltu.mutation.SetKind(c)
+	return cltu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cltu *CreditLineTransactionUpdate) SetNillableKind(c *creditlinetransaction.Kind) *CreditLineTransactionUpdate {
+	if c != nil {
+		cltu.SetKind(*c)
+	}
+	return cltu
+}
+
+// SetAmount sets the "amount" field.
+func (cltu *CreditLineTransactionUpdate) SetAmount(i int) *CreditLineTransactionUpdate {
+	cltu.mutation.ResetAmount()
+	cltu.mutation.SetAmount(i)
+	return cltu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cltu *CreditLineTransactionUpdate) SetNillableAmount(i *int) *CreditLineTransactionUpdate {
+	if i != nil {
+		cltu.SetAmount(*i)
+	}
+	return cltu
+}
+
+// AddAmount adds i to the "amount" field.
+func (cltu *CreditLineTransactionUpdate) AddAmount(i int) *CreditLineTransactionUpdate {
+	cltu.mutation.AddAmount(i)
+	return cltu
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (cltu *CreditLineTransactionUpdate) SetPostedAt(t time.Time) *CreditLineTransactionUpdate {
+	cltu.mutation.SetPostedAt(t)
+	return cltu
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (cltu *CreditLineTransactionUpdate) SetNillablePostedAt(t *time.Time) *CreditLineTransactionUpdate {
+	if t != nil {
+		cltu.SetPostedAt(*t)
+	}
+	return cltu
+}
+
+// SetCreditLine sets the "credit_line" edge to the CreditLine entity.
+func (cltu *CreditLineTransactionUpdate) SetCreditLine(c *CreditLine) *CreditLineTransactionUpdate {
+	return cltu.SetCreditLineID(c.ID)
+}
+
+// Mutation returns the CreditLineTransactionMutation object of the builder.
+func (cltu *CreditLineTransactionUpdate) Mutation() *CreditLineTransactionMutation {
+	return cltu.mutation
+}
+
+// ClearCreditLine clears the "credit_line" edge to the CreditLine entity.
+func (cltu *CreditLineTransactionUpdate) ClearCreditLine() *CreditLineTransactionUpdate {
+	cltu.mutation.ClearCreditLine()
+	return cltu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cltu *CreditLineTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cltu.sqlSave, cltu.mutation, cltu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cltu *CreditLineTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cltu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cltu *CreditLineTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := cltu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltu *CreditLineTransactionUpdate) ExecX(ctx context.Context) {
+	if err := cltu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cltu *CreditLineTransactionUpdate) check() error {
+	if v, ok := cltu.mutation.Kind(); ok {
+		if err := creditlinetransaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CreditLineTransaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := cltu.mutation.CreditLineID(); cltu.mutation.CreditLineCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CreditLineTransaction.credit_line"`)
+	}
+	return nil
+}
+
+func (cltu *CreditLineTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cltu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditlinetransaction.Table, creditlinetransaction.Columns, sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt))
+	if ps := cltu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cltu.mutation.Kind(); ok {
+		_spec.SetField(creditlinetransaction.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cltu.mutation.Amount(); ok {
+		_spec.SetField(creditlinetransaction.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := cltu.mutation.AddedAmount(); ok {
+		_spec.AddField(creditlinetransaction.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := cltu.mutation.PostedAt(); ok {
+		_spec.SetField(creditlinetransaction.FieldPostedAt, field.TypeTime, value)
+	}
+	if cltu.mutation.CreditLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditlinetransaction.CreditLineTable,
+			Columns: []string{creditlinetransaction.CreditLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cltu.mutation.CreditLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditlinetransaction.CreditLineTable,
+			Columns: []string{creditlinetransaction.CreditLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cltu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditlinetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cltu.mutation.done = true
+	return n, nil
+}
+
+// CreditLineTransactionUpdateOne is the builder for updating a single CreditLineTransaction entity.
+type CreditLineTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditLineTransactionMutation
+}
+
+// SetCreditLineID sets the "credit_line_id" field.
+func (cltuo *CreditLineTransactionUpdateOne) SetCreditLineID(i int) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.SetCreditLineID(i)
+	return cltuo
+}
+
+// SetNillableCreditLineID sets the "credit_line_id" field if the given value is not nil.
+func (cltuo *CreditLineTransactionUpdateOne) SetNillableCreditLineID(i *int) *CreditLineTransactionUpdateOne {
+	if i != nil {
+		cltuo.SetCreditLineID(*i)
+	}
+	return cltuo
+}
+
+// SetKind sets the "kind" field.
+func (cltuo *CreditLineTransactionUpdateOne) SetKind(c creditlinetransaction.Kind) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.SetKind(c)
+	return cltuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cltuo *CreditLineTransactionUpdateOne) SetNillableKind(c *creditlinetransaction.Kind) *CreditLineTransactionUpdateOne {
+	if c != nil {
+		cltuo.SetKind(*c)
+	}
+	return cltuo
+}
+
+// SetAmount sets the "amount" field.
+func (cltuo *CreditLineTransactionUpdateOne) SetAmount(i int) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.ResetAmount()
+	cltuo.mutation.SetAmount(i)
+	return cltuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cltuo *CreditLineTransactionUpdateOne) SetNillableAmount(i *int) *CreditLineTransactionUpdateOne {
+	if i != nil {
+		cltuo.SetAmount(*i)
+	}
+	return cltuo
+}
+
+// AddAmount adds i to the "amount" field.
+func (cltuo *CreditLineTransactionUpdateOne) AddAmount(i int) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.AddAmount(i)
+	return cltuo
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (cltuo *CreditLineTransactionUpdateOne) SetPostedAt(t time.Time) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.SetPostedAt(t)
+	return cltuo
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (cltuo *CreditLineTransactionUpdateOne) SetNillablePostedAt(t *time.Time) *CreditLineTransactionUpdateOne {
+	if t != nil {
+		cltuo.SetPostedAt(*t)
+	}
+	return cltuo
+}
+
+// SetCreditLine sets the "credit_line" edge to the CreditLine entity.
+func (cltuo *CreditLineTransactionUpdateOne) SetCreditLine(c *CreditLine) *CreditLineTransactionUpdateOne {
+	return cltuo.SetCreditLineID(c.ID)
+}
+
+// Mutation returns the CreditLineTransactionMutation object of the builder.
+func (cltuo *CreditLineTransactionUpdateOne) Mutation() *CreditLineTransactionMutation {
+	return cltuo.mutation
+}
+
+// ClearCreditLine clears the "credit_line" edge to the CreditLine entity.
+func (cltuo *CreditLineTransactionUpdateOne) ClearCreditLine() *CreditLineTransactionUpdateOne {
+	cltuo.mutation.ClearCreditLine()
+	return cltuo
+}
+
+// Where appends a list predicates to the CreditLineTransactionUpdate builder.
+func (cltuo *CreditLineTransactionUpdateOne) Where(ps ...predicate.CreditLineTransaction) *CreditLineTransactionUpdateOne {
+	cltuo.mutation.Where(ps...)
+	return cltuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cltuo *CreditLineTransactionUpdateOne) Select(field string, fields ...string) *CreditLineTransactionUpdateOne {
+	cltuo.fields = append([]string{field}, fields...)
+	return cltuo
+}
+
+// Save executes the query and returns the updated CreditLineTransaction entity.
+func (cltuo *CreditLineTransactionUpdateOne) Save(ctx context.Context) (*CreditLineTransaction, error) {
+	return withHooks(ctx, cltuo.sqlSave, cltuo.mutation, cltuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cltuo *CreditLineTransactionUpdateOne) SaveX(ctx context.Context) *CreditLineTransaction {
+	node, err := cltuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cltuo *CreditLineTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cltuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cltuo *CreditLineTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := cltuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cltuo *CreditLineTransactionUpdateOne) check() error {
+	if v, ok := cltuo.mutation.Kind(); ok {
+		if err := creditlinetransaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CreditLineTransaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := cltuo.mutation.CreditLineID(); cltuo.mutation.CreditLineCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CreditLineTransaction.credit_line"`)
+	}
+	return nil
+}
+
+func (cltuo *CreditLineTransactionUpdateOne) sqlSave(ctx context.Context) (_node *CreditLineTransaction, err error) {
+	if err := cltuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditlinetransaction.Table, creditlinetransaction.Columns, sqlgraph.NewFieldSpec(creditlinetransaction.FieldID, field.TypeInt))
+	id, ok := cltuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditLineTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cltuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditlinetransaction.FieldID)
+		for _, f := range fields {
+			if !creditlinetransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != creditlinetransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cltuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cltuo.mutation.Kind(); ok {
+		_spec.SetField(creditlinetransaction.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cltuo.mutation.Amount(); ok {
+		_spec.SetField(creditlinetransaction.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := cltuo.mutation.AddedAmount(); ok {
+		_spec.AddField(creditlinetransaction.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := cltuo.mutation.PostedAt(); ok {
+		_spec.SetField(creditlinetransaction.FieldPostedAt, field.TypeTime, value)
+	}
+	if cltuo.mutation.CreditLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditlinetransaction.CreditLineTable,
+			Columns: []string{creditlinetransaction.CreditLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cltuo.mutation.CreditLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   creditlinetransaction.CreditLineTable,
+			Columns: []string{creditlinetransaction.CreditLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CreditLineTransaction{config: cltuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cltuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditlinetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cltuo.mutation.done = true
+	return _node, nil
+}
@@ -12,9 +12,31 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/apikey"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/userchange"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +97,31 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			loan.Table:       loan.ValidColumn,
-			sharedloan.Table: sharedloan.ValidColumn,
-			user.Table:       user.ValidColumn,
+			apikey.Table:                apikey.ValidColumn,
+			collateral.Table:            collateral.ValidColumn,
+			collateralappraisal.Table:   collateralappraisal.ValidColumn,
+			creditline.Table:            creditline.ValidColumn,
+			creditlinetransaction.Table: creditlinetransaction.ValidColumn,
+			escrowitem.Table:            escrowitem.ValidColumn,
+			incomecertification.Table:   incomecertification.ValidColumn,
+			incomedrivenplan.Table:      incomedrivenplan.ValidColumn,
+			loan.Table:                  loan.ValidColumn,
+			loandisbursement.Table:      loandisbursement.ValidColumn,
+			loanmodification.Table:      loanmodification.ValidColumn,
+			loanobligor.Table:           loanobligor.ValidColumn,
+			loanrecast.Table:            loanrecast.ValidColumn,
+			oidclogin.Table:             oidclogin.ValidColumn,
+			paymentdeferral.Table:       paymentdeferral.ValidColumn,
+			session.Table:               session.ValidColumn,
+			shareinvitation.Table:       shareinvitation.ValidColumn,
+			sharelink.Table:             sharelink.ValidColumn,
+			sharelinkaccess.Table:       sharelinkaccess.ValidColumn,
+			sharedloan.Table:            sharedloan.ValidColumn,
+			staffrole.Table:             staffrole.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			userchange.Table:            userchange.ValidColumn,
+			usercredential.Table:        usercredential.ValidColumn,
+			useridentity.Table:          useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/loan"
+)
+
+// EscrowItem is the model entity for the EscrowItem schema.
+type EscrowItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind escrowitem.Kind `json:"kind,omitempty"`
+	// AnnualAmount holds the value of the "annual_amount" field.
+	AnnualAmount int `json:"annual_amount,omitempty"`
+	// FirstDueMonth holds the value of the "first_due_month" field.
+	FirstDueMonth int `json:"first_due_month,omitempty"`
+	// DisbursementsPerYear holds the value of the "disbursements_per_year" field.
+	DisbursementsPerYear int `json:"disbursements_per_year,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EscrowItemQuery when eager-loading is set.
+	Edges        EscrowItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EscrowItemEdges holds the relations/edges for other nodes in the graph.
+type EscrowItemEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EscrowItemEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EscrowItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case escrowitem.FieldID, escrowitem.FieldLoanID, escrowitem.FieldAnnualAmount, escrowitem.FieldFirstDueMonth, escrowitem.FieldDisbursementsPerYear:
+			values[i] = new(sql.NullInt64)
+		case escrowitem.FieldKind:
+			values[i] = new(sql.NullString)
+		case escrowitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EscrowItem fields.
+func (ei *EscrowItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case escrowitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ei.ID = int(value.Int64)
+		case escrowitem.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				ei.LoanID = int(value.Int64)
+			}
+		case escrowitem.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ei.Kind = escrowitem.Kind(value.String)
+			}
+		case escrowitem.FieldAnnualAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_amount", values[i])
+			} else if value.Valid {
+				ei.AnnualAmount = int(value.Int64)
+			}
+		case escrowitem.FieldFirstDueMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_due_month", values[i])
+			} else if value.Valid {
+				ei.FirstDueMonth = int(value.Int64)
+			}
+		case escrowitem.FieldDisbursementsPerYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disbursements_per_year", values[i])
+			} else if value.Valid {
+				ei.DisbursementsPerYear = int(value.Int64)
+			}
+		case escrowitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ei.CreatedAt = value.Time
+			}
+		default:
+			ei.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EscrowItem.
+// This includes values selected through modifiers, order, etc.
+func (ei *EscrowItem) Value(name string) (ent.Value, error) {
+	return ei.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the EscrowItem entity.
+func (ei *EscrowItem) QueryLoan() *LoanQuery {
+	return NewEscrowItemClient(ei.config).QueryLoan(ei)
+}
+
+// Update returns a builder for updating this EscrowItem.
+// Note that you need to call EscrowItem.Unwrap() before calling this method if this EscrowItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ei *EscrowItem) Update() *EscrowItemUpdateOne {
+	return NewEscrowItemClient(ei.config).UpdateOne(ei)
+}
+
+// Unwrap unwraps the EscrowItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ei *EscrowItem) Unwrap() *EscrowItem {
+	_tx, ok := ei.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EscrowItem is not a transactional entity")
+	}
+	ei.config.driver = _tx.drv
+	return ei
+}
+
+// String implements the fmt.Stringer.
+func (ei *EscrowItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("EscrowItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ei.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", ei.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ei.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("annual_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ei.AnnualAmount))
+	builder.WriteString(", ")
+	builder.WriteString("first_due_month=")
+	builder.WriteString(fmt.Sprintf("%v", ei.FirstDueMonth))
+	builder.WriteString(", ")
+	builder.WriteString("disbursements_per_year=")
+	builder.WriteString(fmt.Sprintf("%v", ei.DisbursementsPerYear))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ei.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EscrowItems is a parsable slice of EscrowItem.
+type EscrowItems []*EscrowItem
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package escrowitem
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the escrowitem type in the database.
+	Label = "escrow_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLoanID holds the string denoting the loan_id field in the database.
+	FieldLoanID = "loan_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAnnualAmount holds the string denoting the annual_amount field in the database.
+	FieldAnnualAmount = "annual_amount"
+	// FieldFirstDueMonth holds the string denoting the first_due_month field in the database.
+	FieldFirstDueMonth = "first_due_month"
+	// FieldDisbursementsPerYear holds the string denoting the disbursements_per_year field in the database.
+	FieldDisbursementsPerYear = "disbursements_per_year"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLoan holds the string denoting the loan edge name in mutations.
+	EdgeLoan = "loan"
+	// Table holds the table name of the escrowitem in the database.
+	Table = "escrow_items"
+	// LoanTable is the table that holds the loan relation/edge.
+	LoanTable = "escrow_items"
+	// LoanInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoanInverseTable = "loans"
+	// LoanColumn is the table column denoting the loan relation/edge.
+	LoanColumn = "loan_id"
+)
+
+// Columns holds all SQL columns for escrowitem fields.
+var Columns = []string{
+	FieldID,
+	FieldLoanID,
+	FieldKind,
+	FieldAnnualAmount,
+	FieldFirstDueMonth,
+	FieldDisbursementsPerYear,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDisbursementsPerYear holds the default value on creation for the "disbursements_per_year" field.
+	DefaultDisbursementsPerYear int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindPropertyTax     Kind = "property_tax"
+	KindHazardInsurance Kind = "hazard_insurance"
+	KindPmi             Kind = "pmi"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindPropertyTax, KindHazardInsurance, KindPmi:
+		return nil
+	default:
+		return fmt.Errorf("escrowitem: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the EscrowItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLoanID orders the results by the loan_id field.
+func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAnnualAmount orders the results by the annual_amount field.
+func ByAnnualAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnualAmount, opts...).ToFunc()
+}
+
+// ByFirstDueMonth orders the results by the first_due_month field.
+func ByFirstDueMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstDueMonth, opts...).ToFunc()
+}
+
+// ByDisbursementsPerYear orders the results by the disbursements_per_year field.
+func ByDisbursementsPerYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisbursementsPerYear, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLoanField orders the results by loan field.
+func ByLoanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLoanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+	)
+}
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package escrowitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldLoanID, v))
+}
+
+// AnnualAmount applies equality check predicate on the "annual_amount" field. It's identical to AnnualAmountEQ.
+func AnnualAmount(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldAnnualAmount, v))
+}
+
+// FirstDueMonth applies equality check predicate on the "first_due_month" field. It's identical to FirstDueMonthEQ.
+func FirstDueMonth(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldFirstDueMonth, v))
+}
+
+// DisbursementsPerYear applies equality check predicate on the "disbursements_per_year" field. It's identical to DisbursementsPerYearEQ.
+func DisbursementsPerYear(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldDisbursementsPerYear, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AnnualAmountEQ applies the EQ predicate on the "annual_amount" field.
+func AnnualAmountEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldAnnualAmount, v))
+}
+
+// AnnualAmountNEQ applies the NEQ predicate on the "annual_amount" field.
+func AnnualAmountNEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldAnnualAmount, v))
+}
+
+// AnnualAmountIn applies the In predicate on the "annual_amount" field.
+func AnnualAmountIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldAnnualAmount, vs...))
+}
+
+// AnnualAmountNotIn applies the NotIn predicate on the "annual_amount" field.
+func AnnualAmountNotIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldAnnualAmount, vs...))
+}
+
+// AnnualAmountGT applies the GT predicate on the "annual_amount" field.
+func AnnualAmountGT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGT(FieldAnnualAmount, v))
+}
+
+// AnnualAmountGTE applies the GTE predicate on the "annual_amount" field.
+func AnnualAmountGTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGTE(FieldAnnualAmount, v))
+}
+
+// AnnualAmountLT applies the LT predicate on the "annual_amount" field.
+func AnnualAmountLT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLT(FieldAnnualAmount, v))
+}
+
+// AnnualAmountLTE applies the LTE predicate on the "annual_amount" field.
+func AnnualAmountLTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLTE(FieldAnnualAmount, v))
+}
+
+// FirstDueMonthEQ applies the EQ predicate on the "first_due_month" field.
+func FirstDueMonthEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldFirstDueMonth, v))
+}
+
+// FirstDueMonthNEQ applies the NEQ predicate on the "first_due_month" field.
+func FirstDueMonthNEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldFirstDueMonth, v))
+}
+
+// FirstDueMonthIn applies the In predicate on the "first_due_month" field.
+func FirstDueMonthIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldFirstDueMonth, vs...))
+}
+
+// FirstDueMonthNotIn applies the NotIn predicate on the "first_due_month" field.
+func FirstDueMonthNotIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldFirstDueMonth, vs...))
+}
+
+// FirstDueMonthGT applies the GT predicate on the "first_due_month" field.
+func FirstDueMonthGT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGT(FieldFirstDueMonth, v))
+}
+
+// FirstDueMonthGTE applies the GTE predicate on the "first_due_month" field.
+func FirstDueMonthGTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGTE(FieldFirstDueMonth, v))
+}
+
+// FirstDueMonthLT applies the LT predicate on the "first_due_month" field.
+func FirstDueMonthLT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLT(FieldFirstDueMonth, v))
+}
+
+// FirstDueMonthLTE applies the LTE predicate on the "first_due_month" field.
+func FirstDueMonthLTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLTE(FieldFirstDueMonth, v))
+}
+
+// DisbursementsPerYearEQ applies the EQ predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldDisbursementsPerYear, v))
+}
+
+// DisbursementsPerYearNEQ applies the NEQ predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearNEQ(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldDisbursementsPerYear, v))
+}
+
+// DisbursementsPerYearIn applies the In predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldDisbursementsPerYear, vs...))
+}
+
+// DisbursementsPerYearNotIn applies the NotIn predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearNotIn(vs ...int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldDisbursementsPerYear, vs...))
+}
+
+// DisbursementsPerYearGT applies the GT predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearGT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGT(FieldDisbursementsPerYear, v))
+}
+
+// DisbursementsPerYearGTE applies the GTE predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearGTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGTE(FieldDisbursementsPerYear, v))
+}
+
+// DisbursementsPerYearLT applies the LT predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearLT(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLT(FieldDisbursementsPerYear, v))
+}
+
+// DisbursementsPerYearLTE applies the LTE predicate on the "disbursements_per_year" field.
+func DisbursementsPerYearLTE(v int) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLTE(FieldDisbursementsPerYear, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.EscrowItem {
+	return predicate.EscrowItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.EscrowItem {
+	return predicate.EscrowItem(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EscrowItem) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EscrowItem) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EscrowItem) predicate.EscrowItem {
+	return predicate.EscrowItem(sql.NotPredicates(p))
+}
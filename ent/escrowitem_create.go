@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/loan"
+)
+
+// EscrowItemCreate is the builder for creating a EscrowItem entity.
+type EscrowItemCreate struct {
+	config
+	mutation *EscrowItemMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (eic *EscrowItemCreate) SetLoanID(i int) *EscrowItemCreate {
+	eic.mutation.SetLoanID(i)
+	return eic
+}
+
+// SetKind sets the "kind" field.
+func (eic *EscrowItemCreate) SetKind(e escrowitem.Kind) *EscrowItemCreate {
+	eic.mutation.SetKind(e)
+	return eic
+}
+
+// SetAnnualAmount sets the "annual_amount" field.
+func (eic *EscrowItemCreate) SetAnnualAmount(i int) *EscrowItemCreate {
+	eic.mutation.SetAnnualAmount(i)
+	return eic
+}
+
+// SetFirstDueMonth sets the "first_due_month" field.
+func (eic *EscrowItemCreate) SetFirstDueMonth(i int) *EscrowItemCreate {
+	eic.mutation.SetFirstDueMonth(i)
+	return eic
+}
+
+// SetDisbursementsPerYear sets the "disbursements_per_year" field.
+func (eic *EscrowItemCreate) SetDisbursementsPerYear(i int) *EscrowItemCreate {
+	eic.mutation.SetDisbursementsPerYear(i)
+	return eic
+}
+
+// SetNillableDisbursementsPerYear sets the "disbursements_per_year" field if the given value is not nil.
+func (eic *EscrowItemCreate) SetNillableDisbursementsPerYear(i *int) *EscrowItemCreate {
+	if i != nil {
+		eic.SetDisbursementsPerYear(*i)
+	}
+	return eic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eic *EscrowItemCreate) SetCreatedAt(t time.Time) *EscrowItemCreate {
+	eic.mutation.SetCreatedAt(t)
+	return eic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eic *EscrowItemCreate) SetNillableCreatedAt(t *time.Time) *EscrowItemCreate {
+	if t != nil {
+		eic.SetCreatedAt(*t)
+	}
+	return eic
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (eic *EscrowItemCreate) SetLoan(l *Loan) *EscrowItemCreate {
+	return eic.SetLoanID(l.ID)
+}
+
+// Mutation returns the EscrowItemMutation object of the builder.
+func (eic *EscrowItemCreate) Mutation() *EscrowItemMutation {
+	return eic.mutation
+}
+
+// Save creates the EscrowItem in the database.
+func (eic *EscrowItemCreate) Save(ctx context.Context) (*EscrowItem, error) {
+	eic.defaults()
+	return withHooks(ctx, eic.sqlSave, eic.mutation, eic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eic *EscrowItemCreate) SaveX(ctx context.Context) *EscrowItem {
+	v, err := eic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eic *EscrowItemCreate) Exec(ctx context.Context) error {
+	_, err := eic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eic *EscrowItemCreate) ExecX(ctx context.Context) {
+	if err := eic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eic *EscrowItemCreate) defaults() {
+	if _, ok := eic.mutation.DisbursementsPerYear(); !ok {
+		v := escrowitem.DefaultDisbursementsPerYear
+		eic.mutation.SetDisbursementsPerYear(v)
+	}
+	if _, ok := eic.mutation.CreatedAt(); !ok {
+		v := escrowitem.DefaultCreatedAt()
+		eic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eic *EscrowItemCreate) check() error {
+	if _, ok := eic.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "EscrowItem.loan_id"`)}
+	}
+	if _, ok := eic.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EscrowItem.kind"`)}
+	}
+	if v, ok := eic.mutation.Kind(); ok {
+		if err := escrowitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EscrowItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := eic.mutation.AnnualAmount(); !ok {
+		return &ValidationError{Name: "annual_amount", err: errors.New(`ent: missing required field "EscrowItem.annual_amount"`)}
+	}
+	if _, ok := eic.mutation.FirstDueMonth(); !ok {
+		return &ValidationError{Name: "first_due_month", err: errors.New(`ent: missing required field "EscrowItem.first_due_month"`)}
+	}
+	if _, ok := eic.mutation.DisbursementsPerYear(); !ok {
+		return &ValidationError{Name: "disbursements_per_year", err: errors.New(`ent: missing required field "EscrowItem.disbursements_per_year"`)}
+	}
+	if _, ok := eic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EscrowItem.created_at"`)}
+	}
+	if _, ok := eic.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "EscrowItem.loan"`)}
+	}
+	return nil
+}
+
+func (eic *EscrowItemCreate) sqlSave(ctx context.Context) (*EscrowItem, error) {
+	if err := eic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eic.mutation.id = &_node.ID
+	eic.mutation.done = true
+	return _node, nil
+}
+
+func (eic *EscrowItemCreate) createSpec() (*EscrowItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EscrowItem{config: eic.config}
+		_spec = sqlgraph.NewCreateSpec(escrowitem.Table, sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt))
+	)
+	if value, ok := eic.mutation.Kind(); ok {
+		_spec.SetField(escrowitem.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := eic.mutation.AnnualAmount(); ok {
+		_spec.SetField(escrowitem.FieldAnnualAmount, field.TypeInt, value)
+		_node.AnnualAmount = value
+	}
+	if value, ok := eic.mutation.FirstDueMonth(); ok {
+		_spec.SetField(escrowitem.FieldFirstDueMonth, field.TypeInt, value)
+		_node.FirstDueMonth = value
+	}
+	if value, ok := eic.mutation.DisbursementsPerYear(); ok {
+		_spec.SetField(escrowitem.FieldDisbursementsPerYear, field.TypeInt, value)
+		_node.DisbursementsPerYear = value
+	}
+	if value, ok := eic.mutation.CreatedAt(); ok {
+		_spec.SetField(escrowitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := eic.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   escrowitem.LoanTable,
+			Columns: []string{escrowitem.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EscrowItemCreateBulk is the builder for creating many EscrowItem entities in bulk.
+type EscrowItemCreateBulk struct {
+	config
+	err      error
+	builders []*EscrowItemCreate
+}
+
+// Save creates the EscrowItem entities in the database.
+func (eicb *EscrowItemCreateBulk) Save(ctx context.Context) ([]*EscrowItem, error) {
+	if eicb.err != nil {
+		return nil, eicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eicb.builders))
+	nodes := make([]*EscrowItem, len(eicb.builders))
+	mutators := make([]Mutator, len(eicb.builders))
+	for i := range eicb.builders {
+		func(i int, root context.Context) {
+			builder := eicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EscrowItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eicb *EscrowItemCreateBulk) SaveX(ctx context.Context) []*EscrowItem {
+	v, err := eicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eicb *EscrowItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := eicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eicb *EscrowItemCreateBulk) ExecX(ctx context.Context) {
+	if err := eicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// EscrowItemDelete is the builder for deleting a EscrowItem entity.
+type EscrowItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *EscrowItemMutation
+}
+
+// Where appends a list predicates to the EscrowItemDelete builder.
+func (eid *EscrowItemDelete) Where(ps ...predicate.EscrowItem) *EscrowItemDelete {
+	eid.mutation.Where(ps...)
+	return eid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eid *EscrowItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eid.sqlExec, eid.mutation, eid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eid *EscrowItemDelete) ExecX(ctx context.Context) int {
+	n, err := eid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eid *EscrowItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(escrowitem.Table, sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt))
+	if ps := eid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eid.mutation.done = true
+	return affected, err
+}
+
+// EscrowItemDeleteOne is the builder for deleting a single EscrowItem entity.
+type EscrowItemDeleteOne struct {
+	eid *EscrowItemDelete
+}
+
+// Where appends a list predicates to the EscrowItemDelete builder.
+func (eido *EscrowItemDeleteOne) Where(ps ...predicate.EscrowItem) *EscrowItemDeleteOne {
+	eido.eid.mutation.Where(ps...)
+	return eido
+}
+
+// Exec executes the deletion query.
+func (eido *EscrowItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := eido.eid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{escrowitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eido *EscrowItemDeleteOne) ExecX(ctx context.Context) {
+	if err := eido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
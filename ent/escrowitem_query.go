@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// EscrowItemQuery is the builder for querying EscrowItem entities.
+type EscrowItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []escrowitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EscrowItem
+	withLoan   *LoanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EscrowItemQuery builder.
+func (eiq *EscrowItemQuery) Where(ps ...predicate.EscrowItem) *EscrowItemQuery {
+	eiq.predicates = append(eiq.predicates, ps...)
+	return eiq
+}
+
+// Limit the number of records to be returned by this query.
+func (eiq *EscrowItemQuery) Limit(limit int) *EscrowItemQuery {
+	eiq.ctx.Limit = &limit
+	return eiq
+}
+
+// Offset to start from.
+func (eiq *EscrowItemQuery) Offset(offset int) *EscrowItemQuery {
+	eiq.ctx.Offset = &offset
+	return eiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eiq *EscrowItemQuery) Unique(unique bool) *EscrowItemQuery {
+	eiq.ctx.Unique = &unique
+	return eiq
+}
+
+// Order specifies how the records should be ordered.
+func (eiq *EscrowItemQuery) Order(o ...escrowitem.OrderOption) *EscrowItemQuery {
+	eiq.order = append(eiq.order, o...)
+	return eiq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (eiq *EscrowItemQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: eiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(escrowitem.Table, escrowitem.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, escrowitem.LoanTable, escrowitem.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EscrowItem entity from the query.
+// Returns a *NotFoundError when no EscrowItem was found.
+func (eiq *EscrowItemQuery) First(ctx context.Context) (*EscrowItem, error) {
+	nodes, err := eiq.Limit(1).All(setContextOp(ctx, eiq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{escrowitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eiq *EscrowItemQuery) FirstX(ctx context.Context) *EscrowItem {
+	node, err := eiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EscrowItem ID from the query.
+// Returns a *NotFoundError when no EscrowItem ID was found.
+func (eiq *EscrowItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eiq.Limit(1).IDs(setContextOp(ctx, eiq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{escrowitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eiq *EscrowItemQuery) FirstIDX(ctx context.Context) int {
+	id, err := eiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EscrowItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EscrowItem entity is found.
+// Returns a *NotFoundError when no EscrowItem entities are found.
+func (eiq *EscrowItemQuery) Only(ctx context.Context) (*EscrowItem, error) {
+	nodes, err := eiq.Limit(2).All(setContextOp(ctx, eiq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{escrowitem.Label}
+	default:
+		return nil, &NotSingularError{escrowitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eiq *EscrowItemQuery) OnlyX(ctx context.Context) *EscrowItem {
+	node, err := eiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EscrowItem ID in the query.
+// Returns a *NotSingularError when more than one EscrowItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eiq *EscrowItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eiq.Limit(2).IDs(setContextOp(ctx, eiq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{escrowitem.Label}
+	default:
+		err = &NotSingularError{escrowitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eiq *EscrowItemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EscrowItems.
+func (eiq *EscrowItemQuery) All(ctx context.Context) ([]*EscrowItem, error) {
+	ctx = setContextOp(ctx, eiq.ctx, "All")
+	if err := eiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EscrowItem, *EscrowItemQuery]()
+	return withInterceptors[[]*EscrowItem](ctx, eiq, qr, eiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eiq *EscrowItemQuery) AllX(ctx context.Context) []*EscrowItem {
+	nodes, err := eiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EscrowItem IDs.
+func (eiq *EscrowItemQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eiq.ctx.Unique == nil && eiq.path != nil {
+		eiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eiq.ctx, "IDs")
+	if err = eiq.Select(escrowitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eiq *EscrowItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := eiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eiq *EscrowItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eiq.ctx, "Count")
+	if err := eiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eiq, querierCount[*EscrowItemQuery](), eiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eiq *EscrowItemQuery) CountX(ctx context.Context) int {
+	count, err := eiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eiq *EscrowItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eiq.ctx, "Exist")
+	switch _, err := eiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eiq *EscrowItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := eiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EscrowItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eiq *EscrowItemQuery) Clone() *EscrowItemQuery {
+	if eiq == nil {
+		return nil
+	}
+	return &EscrowItemQuery{
+		config:     eiq.config,
+		ctx:        eiq.ctx.Clone(),
+		order:      append([]escrowitem.OrderOption{}, eiq.order...),
+		inters:     append([]Interceptor{}, eiq.inters...),
+		predicates: append([]predicate.EscrowItem{}, eiq.predicates...),
+		withLoan:   eiq.withLoan.Clone(),
+		// clone intermediate query.
+		sql:  eiq.sql.Clone(),
+		path: eiq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (eiq *EscrowItemQuery) WithLoan(opts ...func(*LoanQuery)) *EscrowItemQuery {
+	query := (&LoanClient{config: eiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eiq.withLoan = query
+	return eiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EscrowItem.Query().
+//		GroupBy(escrowitem.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eiq *EscrowItemQuery) GroupBy(field string, fields ...string) *EscrowItemGroupBy {
+	eiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EscrowItemGroupBy{build: eiq}
+	grbuild.flds = &eiq.ctx.Fields
+	grbuild.label = escrowitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.EscrowItem.Query().
+//		Select(escrowitem.FieldLoanID).
+//		Scan(ctx, &v)
+func (eiq *EscrowItemQuery) Select(fields ...string) *EscrowItemSelect {
+	eiq.ctx.Fields = append(eiq.ctx.Fields, fields...)
+	sbuild := &EscrowItemSelect{EscrowItemQuery: eiq}
+	sbuild.label = escrowitem.Label
+	sbuild.flds, sbuild.scan = &eiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EscrowItemSelect configured with the given aggregations.
+func (eiq *EscrowItemQuery) Aggregate(fns ...AggregateFunc) *EscrowItemSelect {
+	return eiq.Select().Aggregate(fns...)
+}
+
+func (eiq *EscrowItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eiq.ctx.Fields {
+		if !escrowitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eiq.path != nil {
+		prev, err := eiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eiq.sql = prev
+	}
+	return nil
+}
+
+func (eiq *EscrowItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EscrowItem, error) {
+	var (
+		nodes       = []*EscrowItem{}
+		_spec       = eiq.querySpec()
+		loadedTypes = [1]bool{
+			eiq.withLoan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EscrowItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EscrowItem{config: eiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eiq.withLoan; query != nil {
+		if err := eiq.loadLoan(ctx, query, nodes, nil,
+			func(n *EscrowItem, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eiq *EscrowItemQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*EscrowItem, init func(*EscrowItem), assign func(*EscrowItem, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EscrowItem)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (eiq *EscrowItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eiq.querySpec()
+	_spec.Node.Columns = eiq.ctx.Fields
+	if len(eiq.ctx.Fields) > 0 {
+		_spec.Unique = eiq.ctx.Unique != nil && *eiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eiq.driver, _spec)
+}
+
+func (eiq *EscrowItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(escrowitem.Table, escrowitem.Columns, sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt))
+	_spec.From = eiq.sql
+	if unique := eiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, escrowitem.FieldID)
+		for i := range fields {
+			if fields[i] != escrowitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if eiq.withLoan != nil {
+			_spec.Node.AddColumnOnce(escrowitem.FieldLoanID)
+		}
+	}
+	if ps := eiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eiq *EscrowItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eiq.driver.Dialect())
+	t1 := builder.Table(escrowitem.Table)
+	columns := eiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = escrowitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eiq.sql != nil {
+		selector = eiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eiq.ctx.Unique != nil && *eiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eiq.predicates {
+		p(selector)
+	}
+	for _, p := range eiq.order {
+		p(selector)
+	}
+	if offset := eiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EscrowItemGroupBy is the group-by builder for EscrowItem entities.
+type EscrowItemGroupBy struct {
+	selector
+	build *EscrowItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eigb *EscrowItemGroupBy) Aggregate(fns ...AggregateFunc) *EscrowItemGroupBy {
+	eigb.fns = append(eigb.fns, fns...)
+	return eigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eigb *EscrowItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eigb.build.ctx, "GroupBy")
+	if err := eigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EscrowItemQuery, *EscrowItemGroupBy](ctx, eigb.build, eigb, eigb.build.inters, v)
+}
+
+func (eigb *EscrowItemGroupBy) sqlScan(ctx context.Context, root *EscrowItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eigb.fns))
+	for _, fn := range eigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eigb.flds)+len(eigb.fns))
+		for _, f := range *eigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EscrowItemSelect is the builder for selecting fields of EscrowItem entities.
+type EscrowItemSelect struct {
+	*EscrowItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eis *EscrowItemSelect) Aggregate(fns ...AggregateFunc) *EscrowItemSelect {
+	eis.fns = append(eis.fns, fns...)
+	return eis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eis *EscrowItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eis.ctx, "Select")
+	if err := eis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EscrowItemQuery, *EscrowItemSelect](ctx, eis.EscrowItemQuery, eis, eis.inters, v)
+}
+
+func (eis *EscrowItemSelect) sqlScan(ctx context.Context, root *EscrowItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eis.fns))
+	for _, fn := range eis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
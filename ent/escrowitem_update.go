@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// EscrowItemUpdate is the builder for updating EscrowItem entities.
+type EscrowItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EscrowItemMutation
+}
+
+// Where appends a list predicates to the EscrowItemUpdate builder.
+func (eiu *EscrowItemUpdate) Where(ps ...predicate.EscrowItem) *EscrowItemUpdate {
+	eiu.mutation.Where(ps...)
+	return eiu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (eiu *EscrowItemUpdate) SetLoanID(i int) *EscrowItemUpdate {
+	eiu.mutation.SetLoanID(i)
+	return eiu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (eiu *EscrowItemUpdate) SetNillableLoanID(i *int) *EscrowItemUpdate {
+	if i != nil {
+		eiu.SetLoanID(*i)
+	}
+	return eiu
+}
+
+// SetKind sets the "kind" field.
+func (eiu *EscrowItemUpdate) SetKind(e escrowitem.Kind) *EscrowItemUpdate {
+	eiu.mutation.SetKind(e)
+	return eiu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (eiu *EscrowItemUpdate) SetNillableKind(e *escrowitem.Kind) *EscrowItemUpdate {
+	if e != nil {
+		eiu.SetKind(*e)
+	}
+	return eiu
+}
+
+// SetAnnualAmount sets the "annual_amount" field.
+func (eiu *EscrowItemUpdate) SetAnnualAmount(i int) *EscrowItemUpdate {
+	eiu.mutation.ResetAnnualAmount()
+	eiu.mutation.SetAnnualAmount(i)
+	return eiu
+}
+
+// SetNillableAnnualAmount sets the "annual_amount" field if the given value is not nil.
+func (eiu *EscrowItemUpdate) SetNillableAnnualAmount(i *int) *EscrowItemUpdate {
+	if i != nil {
+		eiu.SetAnnualAmount(*i)
+	}
+	return eiu
+}
+
+// AddAnnualAmount adds i to the "annual_amount" field.
+func (eiu *EscrowItemUpdate) AddAnnualAmount(i int) *EscrowItemUpdate {
+	eiu.mutation.AddAnnualAmount(i)
+	return eiu
+}
+
+// SetFirstDueMonth sets the "first_due_month" field.
+func (eiu *EscrowItemUpdate) SetFirstDueMonth(i int) *EscrowItemUpdate {
+	eiu.mutation.ResetFirstDueMonth()
+	eiu.mutation.SetFirstDueMonth(i)
+	return eiu
+}
+
+// SetNillableFirstDueMonth sets the "first_due_month" field if the given value is not nil.
+func (eiu *EscrowItemUpdate) SetNillableFirstDueMonth(i *int) *EscrowItemUpdate {
+	if i != nil {
+		eiu.SetFirstDueMonth(*i)
+	}
+	return eiu
+}
+
+// AddFirstDueMonth adds i to the "first_due_month" field.
+func (eiu *EscrowItemUpdate) AddFirstDueMonth(i int) *EscrowItemUpdate {
+	eiu.mutation.AddFirstDueMonth(i)
+	return eiu
+}
+
+// SetDisbursementsPerYear sets the "disbursements_per_year" field.
+func (eiu *EscrowItemUpdate) SetDisbursementsPerYear(i int) *EscrowItemUpdate {
+	eiu.mutation.ResetDisbursementsPerYear()
+	eiu.mutation.SetDisbursementsPerYear(i)
+	return eiu
+}
+
+// SetNillableDisbursementsPerYear sets the "disbursements_per_year" field if the given value is not nil.
+func (eiu *EscrowItemUpdate) SetNillableDisbursementsPerYear(i *int) *EscrowItemUpdate {
+	if i != nil {
+		eiu.SetDisbursementsPerYear(*i)
+	}
+	return eiu
+}
+
+// AddDisbursementsPerYear adds i to the "disbursements_per_year" field.
+func (eiu *EscrowItemUpdate) AddDisbursementsPerYear(i int) *EscrowItemUpdate {
+	eiu.mutation.AddDisbursementsPerYear(i)
+	return eiu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (eiu *EscrowItemUpdate) SetLoan(l *Loan) *EscrowItemUpdate {
+	return eiu.SetLoanID(l.ID)
+}
+
+// Mutation returns the EscrowItemMutation object of the builder.
+func (eiu *EscrowItemUpdate) Mutation() *EscrowItemMutation {
+	return eiu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (eiu *EscrowItemUpdate) ClearLoan() *EscrowItemUpdate {
+	eiu.mutation.ClearLoan()
+	return eiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eiu *EscrowItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eiu.sqlSave, eiu.mutation, eiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiu *EscrowItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := eiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eiu *EscrowItemUpdate) Exec(ctx context.Context) error {
+	_, err := eiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiu *EscrowItemUpdate) ExecX(ctx context.Context) {
+	if err := eiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiu *EscrowItemUpdate) check() error {
+	if v, ok := eiu.mutation.Kind(); ok {
+		if err := escrowitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EscrowItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := eiu.mutation.LoanID(); eiu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EscrowItem.loan"`)
+	}
+	return nil
+}
+
+func (eiu *EscrowItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escrowitem.Table, escrowitem.Columns, sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt))
+	if ps := eiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiu.mutation.Kind(); ok {
+		_spec.SetField(escrowitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := eiu.mutation.AnnualAmount(); ok {
+		_spec.SetField(escrowitem.FieldAnnualAmount, field.TypeInt, value)
+	}
+	if value, ok := eiu.mutation.AddedAnnualAmount(); ok {
+		_spec.AddField(escrowitem.FieldAnnualAmount, field.TypeInt, value)
+	}
+	if value, ok := eiu.mutation.FirstDueMonth(); ok {
+		_spec.SetField(escrowitem.FieldFirstDueMonth, field.TypeInt, value)
+	}
+	if value, ok := eiu.mutation.AddedFirstDueMonth(); ok {
+		_spec.AddField(escrowitem.FieldFirstDueMonth, field.TypeInt, value)
+	}
+	if value, ok := eiu.mutation.DisbursementsPerYear(); ok {
+		_spec.SetField(escrowitem.FieldDisbursementsPerYear, field.TypeInt, value)
+	}
+	if value, ok := eiu.mutation.AddedDisbursementsPerYear(); ok {
+		_spec.AddField(escrowitem.FieldDisbursementsPerYear, field.TypeInt, value)
+	}
+	if eiu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   escrowitem.LoanTable,
+			Columns: []string{escrowitem.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eiu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   escrowitem.LoanTable,
+			Columns: []string{escrowitem.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escrowitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eiu.mutation.done = true
+	return n, nil
+}
+
+// EscrowItemUpdateOne is the builder for updating a single EscrowItem entity.
+type EscrowItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EscrowItemMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (eiuo *EscrowItemUpdateOne) SetLoanID(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.SetLoanID(i)
+	return eiuo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (eiuo *EscrowItemUpdateOne) SetNillableLoanID(i *int) *EscrowItemUpdateOne {
+	if i != nil {
+		eiuo.SetLoanID(*i)
+	}
+	return eiuo
+}
+
+// SetKind sets the "kind" field.
+func (eiuo *EscrowItemUpdateOne) SetKind(e escrowitem.Kind) *EscrowItemUpdateOne {
+	eiuo.mutation.SetKind(e)
+	return eiuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (eiuo *EscrowItemUpdateOne) SetNillableKind(e *escrowitem.Kind) *EscrowItemUpdateOne {
+	if e != nil {
+		eiuo.SetKind(*e)
+	}
+	return eiuo
+}
+
+// SetAnnualAmount sets the "annual_amount" field.
+func (eiuo *EscrowItemUpdateOne) SetAnnualAmount(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.ResetAnnualAmount()
+	eiuo.mutation.SetAnnualAmount(i)
+	return eiuo
+}
+
+// SetNillableAnnualAmount sets the "annual_amount" field if the given value is not nil.
+func (eiuo *EscrowItemUpdateOne) SetNillableAnnualAmount(i *int) *EscrowItemUpdateOne {
+	if i != nil {
+		eiuo.SetAnnualAmount(*i)
+	}
+	return eiuo
+}
+
+// AddAnnualAmount adds i to the "annual_amount" field.
+func (eiuo *EscrowItemUpdateOne) AddAnnualAmount(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.AddAnnualAmount(i)
+	return eiuo
+}
+
+// SetFirstDueMonth sets the "first_due_month" field.
+func (eiuo *EscrowItemUpdateOne) SetFirstDueMonth(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.ResetFirstDueMonth()
+	eiuo.mutation.SetFirstDueMonth(i)
+	return eiuo
+}
+
+// SetNillableFirstDueMonth sets the "first_due_month" field if the given value is not nil.
+func (eiuo *EscrowItemUpdateOne) SetNillableFirstDueMonth(i *int) *EscrowItemUpdateOne {
+	if i != nil {
+		eiuo.SetFirstDueMonth(*i)
+	}
+	return eiuo
+}
+
+// AddFirstDueMonth adds i to the "first_due_month" field.
+func (eiuo *EscrowItemUpdateOne) AddFirstDueMonth(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.AddFirstDueMonth(i)
+	return eiuo
+}
+
+// SetDisbursementsPerYear sets the "disbursements_per_year" field.
+func (eiuo *EscrowItemUpdateOne) SetDisbursementsPerYear(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.ResetDisbursementsPerYear()
+	eiuo.mutation.SetDisbursementsPerYear(i)
+	return eiuo
+}
+
+// SetNillableDisbursementsPerYear sets the "disbursements_per_year" field if the given value is not nil.
+func (eiuo *EscrowItemUpdateOne) SetNillableDisbursementsPerYear(i *int) *EscrowItemUpdateOne {
+	if i != nil {
+		eiuo.SetDisbursementsPerYear(*i)
+	}
+	return eiuo
+}
+
+// AddDisbursementsPerYear adds i to the "disbursements_per_year" field.
+func (eiuo *EscrowItemUpdateOne) AddDisbursementsPerYear(i int) *EscrowItemUpdateOne {
+	eiuo.mutation.AddDisbursementsPerYear(i)
+	return eiuo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (eiuo *EscrowItemUpdateOne) SetLoan(l *Loan) *EscrowItemUpdateOne {
+	return eiuo.SetLoanID(l.ID)
+}
+
+// Mutation returns the EscrowItemMutation object of the builder.
+func (eiuo *EscrowItemUpdateOne) Mutation() *EscrowItemMutation {
+	return eiuo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (eiuo *EscrowItemUpdateOne) ClearLoan() *EscrowItemUpdateOne {
+	eiuo.mutation.ClearLoan()
+	return eiuo
+}
+
+// Where appends a list predicates to the EscrowItemUpdate builder.
+func (eiuo *EscrowItemUpdateOne) Where(ps ...predicate.EscrowItem) *EscrowItemUpdateOne {
+	eiuo.mutation.Where(ps...)
+	return eiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eiuo *EscrowItemUpdateOne) Select(field string, fields ...string) *EscrowItemUpdateOne {
+	eiuo.fields = append([]string{field}, fields...)
+	return eiuo
+}
+
+// Save executes the query and returns the updated EscrowItem entity.
+func (eiuo *EscrowItemUpdateOne) Save(ctx context.Context) (*EscrowItem, error) {
+	return withHooks(ctx, eiuo.sqlSave, eiuo.mutation, eiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eiuo *EscrowItemUpdateOne) SaveX(ctx context.Context) *EscrowItem {
+	node, err := eiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eiuo *EscrowItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := eiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eiuo *EscrowItemUpdateOne) ExecX(ctx context.Context) {
+	if err := eiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eiuo *EscrowItemUpdateOne) check() error {
+	if v, ok := eiuo.mutation.Kind(); ok {
+		if err := escrowitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EscrowItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := eiuo.mutation.LoanID(); eiuo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EscrowItem.loan"`)
+	}
+	return nil
+}
+
+func (eiuo *EscrowItemUpdateOne) sqlSave(ctx context.Context) (_node *EscrowItem, err error) {
+	if err := eiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escrowitem.Table, escrowitem.Columns, sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt))
+	id, ok := eiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EscrowItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, escrowitem.FieldID)
+		for _, f := range fields {
+			if !escrowitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != escrowitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eiuo.mutation.Kind(); ok {
+		_spec.SetField(escrowitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := eiuo.mutation.AnnualAmount(); ok {
+		_spec.SetField(escrowitem.FieldAnnualAmount, field.TypeInt, value)
+	}
+	if value, ok := eiuo.mutation.AddedAnnualAmount(); ok {
+		_spec.AddField(escrowitem.FieldAnnualAmount, field.TypeInt, value)
+	}
+	if value, ok := eiuo.mutation.FirstDueMonth(); ok {
+		_spec.SetField(escrowitem.FieldFirstDueMonth, field.TypeInt, value)
+	}
+	if value, ok := eiuo.mutation.AddedFirstDueMonth(); ok {
+		_spec.AddField(escrowitem.FieldFirstDueMonth, field.TypeInt, value)
+	}
+	if value, ok := eiuo.mutation.DisbursementsPerYear(); ok {
+		_spec.SetField(escrowitem.FieldDisbursementsPerYear, field.TypeInt, value)
+	}
+	if value, ok := eiuo.mutation.AddedDisbursementsPerYear(); ok {
+		_spec.AddField(escrowitem.FieldDisbursementsPerYear, field.TypeInt, value)
+	}
+	if eiuo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   escrowitem.LoanTable,
+			Columns: []string{escrowitem.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eiuo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   escrowitem.LoanTable,
+			Columns: []string{escrowitem.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EscrowItem{config: eiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escrowitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eiuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,102 @@ import (
 	"github.com/crusyn/loans/ent"
 )
 
+// The ApiKeyFunc type is an adapter to allow the use of ordinary
+// function as ApiKey mutator.
+type ApiKeyFunc func(context.Context, *ent.ApiKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApiKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiKeyMutation", m)
+}
+
+// The CollateralFunc type is an adapter to allow the use of ordinary
+// function as Collateral mutator.
+type CollateralFunc func(context.Context, *ent.CollateralMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollateralFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollateralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollateralMutation", m)
+}
+
+// The CollateralAppraisalFunc type is an adapter to allow the use of ordinary
+// function as CollateralAppraisal mutator.
+type CollateralAppraisalFunc func(context.Context, *ent.CollateralAppraisalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CollateralAppraisalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CollateralAppraisalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollateralAppraisalMutation", m)
+}
+
+// The CreditLineFunc type is an adapter to allow the use of ordinary
+// function as CreditLine mutator.
+type CreditLineFunc func(context.Context, *ent.CreditLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CreditLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CreditLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditLineMutation", m)
+}
+
+// The CreditLineTransactionFunc type is an adapter to allow the use of ordinary
+// function as CreditLineTransaction mutator.
+type CreditLineTransactionFunc func(context.Context, *ent.CreditLineTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CreditLineTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CreditLineTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditLineTransactionMutation", m)
+}
+
+// The EscrowItemFunc type is an adapter to allow the use of ordinary
+// function as EscrowItem mutator.
+type EscrowItemFunc func(context.Context, *ent.EscrowItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EscrowItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EscrowItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EscrowItemMutation", m)
+}
+
+// The IncomeCertificationFunc type is an adapter to allow the use of ordinary
+// function as IncomeCertification mutator.
+type IncomeCertificationFunc func(context.Context, *ent.IncomeCertificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IncomeCertificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IncomeCertificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IncomeCertificationMutation", m)
+}
+
+// The IncomeDrivenPlanFunc type is an adapter to allow the use of ordinary
+// function as IncomeDrivenPlan mutator.
+type IncomeDrivenPlanFunc func(context.Context, *ent.IncomeDrivenPlanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IncomeDrivenPlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IncomeDrivenPlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IncomeDrivenPlanMutation", m)
+}
+
 // The LoanFunc type is an adapter to allow the use of ordinary
 // function as Loan mutator.
 type LoanFunc func(context.Context, *ent.LoanMutation) (ent.Value, error)
@@ -21,6 +117,126 @@ func (f LoanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanMutation", m)
 }
 
+// The LoanDisbursementFunc type is an adapter to allow the use of ordinary
+// function as LoanDisbursement mutator.
+type LoanDisbursementFunc func(context.Context, *ent.LoanDisbursementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoanDisbursementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoanDisbursementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanDisbursementMutation", m)
+}
+
+// The LoanModificationFunc type is an adapter to allow the use of ordinary
+// function as LoanModification mutator.
+type LoanModificationFunc func(context.Context, *ent.LoanModificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoanModificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoanModificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanModificationMutation", m)
+}
+
+// The LoanObligorFunc type is an adapter to allow the use of ordinary
+// function as LoanObligor mutator.
+type LoanObligorFunc func(context.Context, *ent.LoanObligorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoanObligorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoanObligorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanObligorMutation", m)
+}
+
+// The LoanRecastFunc type is an adapter to allow the use of ordinary
+// function as LoanRecast mutator.
+type LoanRecastFunc func(context.Context, *ent.LoanRecastMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoanRecastFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoanRecastMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanRecastMutation", m)
+}
+
+// The OidcLoginFunc type is an adapter to allow the use of ordinary
+// function as OidcLogin mutator.
+type OidcLoginFunc func(context.Context, *ent.OidcLoginMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OidcLoginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OidcLoginMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OidcLoginMutation", m)
+}
+
+// The PaymentDeferralFunc type is an adapter to allow the use of ordinary
+// function as PaymentDeferral mutator.
+type PaymentDeferralFunc func(context.Context, *ent.PaymentDeferralMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentDeferralFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentDeferralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentDeferralMutation", m)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
+// The ShareInvitationFunc type is an adapter to allow the use of ordinary
+// function as ShareInvitation mutator.
+type ShareInvitationFunc func(context.Context, *ent.ShareInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareInvitationMutation", m)
+}
+
+// The ShareLinkFunc type is an adapter to allow the use of ordinary
+// function as ShareLink mutator.
+type ShareLinkFunc func(context.Context, *ent.ShareLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareLinkMutation", m)
+}
+
+// The ShareLinkAccessFunc type is an adapter to allow the use of ordinary
+// function as ShareLinkAccess mutator.
+type ShareLinkAccessFunc func(context.Context, *ent.ShareLinkAccessMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareLinkAccessFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareLinkAccessMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareLinkAccessMutation", m)
+}
+
 // The SharedLoanFunc type is an adapter to allow the use of ordinary
 // function as SharedLoan mutator.
 type SharedLoanFunc func(context.Context, *ent.SharedLoanMutation) (ent.Value, error)
@@ -33,6 +249,18 @@ func (f SharedLoanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedLoanMutation", m)
 }
 
+// The StaffRoleFunc type is an adapter to allow the use of ordinary
+// function as StaffRole mutator.
+type StaffRoleFunc func(context.Context, *ent.StaffRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StaffRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StaffRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StaffRoleMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -45,6 +273,42 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserChangeFunc type is an adapter to allow the use of ordinary
+// function as UserChange mutator.
+type UserChangeFunc func(context.Context, *ent.UserChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserChangeMutation", m)
+}
+
+// The UserCredentialFunc type is an adapter to allow the use of ordinary
+// function as UserCredential mutator.
+type UserCredentialFunc func(context.Context, *ent.UserCredentialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserCredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserCredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserCredentialMutation", m)
+}
+
+// The UserIdentityFunc type is an adapter to allow the use of ordinary
+// function as UserIdentity mutator.
+type UserIdentityFunc func(context.Context, *ent.UserIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserIdentityMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
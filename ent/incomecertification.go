@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+)
+
+// IncomeCertification is the model entity for the IncomeCertification schema.
+type IncomeCertification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID int `json:"plan_id,omitempty"`
+	// Year holds the value of the "year" field.
+	Year int `json:"year,omitempty"`
+	// Income holds the value of the "income" field.
+	Income int `json:"income,omitempty"`
+	// FamilySize holds the value of the "family_size" field.
+	FamilySize int `json:"family_size,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IncomeCertificationQuery when eager-loading is set.
+	Edges        IncomeCertificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IncomeCertificationEdges holds the relations/edges for other nodes in the graph.
+type IncomeCertificationEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *IncomeDrivenPlan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IncomeCertificationEdges) PlanOrErr() (*IncomeDrivenPlan, error) {
+	if e.loadedTypes[0] {
+		if e.Plan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: incomedrivenplan.Label}
+		}
+		return e.Plan, nil
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IncomeCertification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case incomecertification.FieldID, incomecertification.FieldPlanID, incomecertification.FieldYear, incomecertification.FieldIncome, incomecertification.FieldFamilySize:
+			values[i] = new(sql.NullInt64)
+		case incomecertification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IncomeCertification fields.
+func (ic *IncomeCertification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case incomecertification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ic.ID = int(value.Int64)
+		case incomecertification.FieldPlanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				ic.PlanID = int(value.Int64)
+			}
+		case incomecertification.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				ic.Year = int(value.Int64)
+			}
+		case incomecertification.FieldIncome:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field income", values[i])
+			} else if value.Valid {
+				ic.Income = int(value.Int64)
+			}
+		case incomecertification.FieldFamilySize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field family_size", values[i])
+			} else if value.Valid {
+				ic.FamilySize = int(value.Int64)
+			}
+		case incomecertification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ic.CreatedAt = value.Time
+			}
+		default:
+			ic.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IncomeCertification.
+// This includes values selected through modifiers, order, etc.
+func (ic *IncomeCertification) Value(name string) (ent.Value, error) {
+	return ic.selectValues.Get(name)
+}
+
+// QueryPlan queries the "plan" edge of the IncomeCertification entity.
+func (ic *IncomeCertification) QueryPlan() *IncomeDrivenPlanQuery {
+	return NewIncomeCertificationClient(ic.config).QueryPlan(ic)
+}
+
+// Update returns a builder for updating this IncomeCertification.
+// Note that you need to call IncomeCertification.Unwrap() before calling this method if this IncomeCertification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ic *IncomeCertification) Update() *IncomeCertificationUpdateOne {
+	return NewIncomeCertificationClient(ic.config).UpdateOne(ic)
+}
+
+// Unwrap unwraps the IncomeCertification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ic *IncomeCertification) Unwrap() *IncomeCertification {
+	_tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IncomeCertification is not a transactional entity")
+	}
+	ic.config.driver = _tx.drv
+	return ic
+}
+
+// String implements the fmt.Stringer.
+func (ic *IncomeCertification) String() string {
+	var builder strings.Builder
+	builder.WriteString("IncomeCertification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ic.ID))
+	builder.WriteString("plan_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.PlanID))
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", ic.Year))
+	builder.WriteString(", ")
+	builder.WriteString("income=")
+	builder.WriteString(fmt.Sprintf("%v", ic.Income))
+	builder.WriteString(", ")
+	builder.WriteString("family_size=")
+	builder.WriteString(fmt.Sprintf("%v", ic.FamilySize))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ic.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IncomeCertifications is a parsable slice of IncomeCertification.
+type IncomeCertifications []*IncomeCertification
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incomecertification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the incomecertification type in the database.
+	Label = "income_certification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
+	// FieldYear holds the string denoting the year field in the database.
+	FieldYear = "year"
+	// FieldIncome holds the string denoting the income field in the database.
+	FieldIncome = "income"
+	// FieldFamilySize holds the string denoting the family_size field in the database.
+	FieldFamilySize = "family_size"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePlan holds the string denoting the plan edge name in mutations.
+	EdgePlan = "plan"
+	// Table holds the table name of the incomecertification in the database.
+	Table = "income_certifications"
+	// PlanTable is the table that holds the plan relation/edge.
+	PlanTable = "income_certifications"
+	// PlanInverseTable is the table name for the IncomeDrivenPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "incomedrivenplan" package.
+	PlanInverseTable = "income_driven_plans"
+	// PlanColumn is the table column denoting the plan relation/edge.
+	PlanColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for incomecertification fields.
+var Columns = []string{
+	FieldID,
+	FieldPlanID,
+	FieldYear,
+	FieldIncome,
+	FieldFamilySize,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the IncomeCertification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
+// ByYear orders the results by the year field.
+func ByYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYear, opts...).ToFunc()
+}
+
+// ByIncome orders the results by the income field.
+func ByIncome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncome, opts...).ToFunc()
+}
+
+// ByFamilySize orders the results by the family_size field.
+func ByFamilySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilySize, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPlanField orders the results by plan field.
+func ByPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+	)
+}
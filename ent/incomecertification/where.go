@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incomecertification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLTE(FieldID, id))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldPlanID, v))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldYear, v))
+}
+
+// Income applies equality check predicate on the "income" field. It's identical to IncomeEQ.
+func Income(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldIncome, v))
+}
+
+// FamilySize applies equality check predicate on the "family_size" field. It's identical to FamilySizeEQ.
+func FamilySize(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldFamilySize, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLTE(FieldYear, v))
+}
+
+// IncomeEQ applies the EQ predicate on the "income" field.
+func IncomeEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldIncome, v))
+}
+
+// IncomeNEQ applies the NEQ predicate on the "income" field.
+func IncomeNEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldIncome, v))
+}
+
+// IncomeIn applies the In predicate on the "income" field.
+func IncomeIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldIncome, vs...))
+}
+
+// IncomeNotIn applies the NotIn predicate on the "income" field.
+func IncomeNotIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldIncome, vs...))
+}
+
+// IncomeGT applies the GT predicate on the "income" field.
+func IncomeGT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGT(FieldIncome, v))
+}
+
+// IncomeGTE applies the GTE predicate on the "income" field.
+func IncomeGTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGTE(FieldIncome, v))
+}
+
+// IncomeLT applies the LT predicate on the "income" field.
+func IncomeLT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLT(FieldIncome, v))
+}
+
+// IncomeLTE applies the LTE predicate on the "income" field.
+func IncomeLTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLTE(FieldIncome, v))
+}
+
+// FamilySizeEQ applies the EQ predicate on the "family_size" field.
+func FamilySizeEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldFamilySize, v))
+}
+
+// FamilySizeNEQ applies the NEQ predicate on the "family_size" field.
+func FamilySizeNEQ(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldFamilySize, v))
+}
+
+// FamilySizeIn applies the In predicate on the "family_size" field.
+func FamilySizeIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldFamilySize, vs...))
+}
+
+// FamilySizeNotIn applies the NotIn predicate on the "family_size" field.
+func FamilySizeNotIn(vs ...int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldFamilySize, vs...))
+}
+
+// FamilySizeGT applies the GT predicate on the "family_size" field.
+func FamilySizeGT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGT(FieldFamilySize, v))
+}
+
+// FamilySizeGTE applies the GTE predicate on the "family_size" field.
+func FamilySizeGTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGTE(FieldFamilySize, v))
+}
+
+// FamilySizeLT applies the LT predicate on the "family_size" field.
+func FamilySizeLT(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLT(FieldFamilySize, v))
+}
+
+// FamilySizeLTE applies the LTE predicate on the "family_size" field.
+func FamilySizeLTE(v int) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLTE(FieldFamilySize, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.IncomeCertification {
+	return predicate.IncomeCertification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.IncomeDrivenPlan) predicate.IncomeCertification {
+	return predicate.IncomeCertification(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IncomeCertification) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IncomeCertification) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IncomeCertification) predicate.IncomeCertification {
+	return predicate.IncomeCertification(sql.NotPredicates(p))
+}
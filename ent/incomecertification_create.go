@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+)
+
+// IncomeCertificationCreate is the builder for creating a IncomeCertification entity.
+type IncomeCertificationCreate struct {
+	config
+	mutation *IncomeCertificationMutation
+	hooks    []Hook
+}
+
+// SetPlanID sets the "plan_id" field.
+func (icc *IncomeCertificationCreate) SetPlanID(i int) *IncomeCertificationCreate {
+	icc.mutation.SetPlanID(i)
+	return icc
+}
+
+// SetYear sets the "year" field.
+func (icc *IncomeCertificationCreate) SetYear(i int) *IncomeCertificationCreate {
+	icc.mutation.SetYear(i)
+	return icc
+}
+
+// SetIncome sets the "income" field.
+func (icc *IncomeCertificationCreate) SetIncome(i int) *IncomeCertificationCreate {
+	icc.mutation.SetIncome(i)
+	return icc
+}
+
+// SetFamilySize sets the "family_size" field.
+func (icc *IncomeCertificationCreate) SetFamilySize(i int) *IncomeCertificationCreate {
+	icc.mutation.SetFamilySize(i)
+	return icc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icc *IncomeCertificationCreate) SetCreatedAt(t time.Time) *IncomeCertificationCreate {
+	icc.mutation.SetCreatedAt(t)
+	return icc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icc *IncomeCertificationCreate) SetNillableCreatedAt(t *time.Time) *IncomeCertificationCreate {
+	if t != nil {
+		icc.SetCreatedAt(*t)
+	}
+	return icc
+}
+
+// SetPlan sets the "plan" edge to the IncomeDrivenPlan entity.
+func (icc *IncomeCertificationCreate) SetPlan(i *IncomeDrivenPlan) *IncomeCertificationCreate {
+	return icc.SetPlanID(i.ID)
+}
+
+// Mutation returns the IncomeCertificationMutation object of the builder.
+func (icc *IncomeCertificationCreate) Mutation() *IncomeCertificationMutation {
+	return icc.mutation
+}
+
+// Save creates the IncomeCertification in the database.
+func (icc *IncomeCertificationCreate) Save(ctx context.Context) (*IncomeCertification, error) {
+	icc.defaults()
+	return withHooks(ctx, icc.sqlSave, icc.mutation, icc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *IncomeCertificationCreate) SaveX(ctx context.Context) *IncomeCertification {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *IncomeCertificationCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *IncomeCertificationCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icc *IncomeCertificationCreate) defaults() {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		v := incomecertification.DefaultCreatedAt()
+		icc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *IncomeCertificationCreate) check() error {
+	if _, ok := icc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "IncomeCertification.plan_id"`)}
+	}
+	if _, ok := icc.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "IncomeCertification.year"`)}
+	}
+	if _, ok := icc.mutation.Income(); !ok {
+		return &ValidationError{Name: "income", err: errors.New(`ent: missing required field "IncomeCertification.income"`)}
+	}
+	if _, ok := icc.mutation.FamilySize(); !ok {
+		return &ValidationError{Name: "family_size", err: errors.New(`ent: missing required field "IncomeCertification.family_size"`)}
+	}
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IncomeCertification.created_at"`)}
+	}
+	if _, ok := icc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required edge "IncomeCertification.plan"`)}
+	}
+	return nil
+}
+
+func (icc *IncomeCertificationCreate) sqlSave(ctx context.Context) (*IncomeCertification, error) {
+	if err := icc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	icc.mutation.id = &_node.ID
+	icc.mutation.done = true
+	return _node, nil
+}
+
+func (icc *IncomeCertificationCreate) createSpec() (*IncomeCertification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IncomeCertification{config: icc.config}
+		_spec = sqlgraph.NewCreateSpec(incomecertification.Table, sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt))
+	)
+	if value, ok := icc.mutation.Year(); ok {
+		_spec.SetField(incomecertification.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := icc.mutation.Income(); ok {
+		_spec.SetField(incomecertification.FieldIncome, field.TypeInt, value)
+		_node.Income = value
+	}
+	if value, ok := icc.mutation.FamilySize(); ok {
+		_spec.SetField(incomecertification.FieldFamilySize, field.TypeInt, value)
+		_node.FamilySize = value
+	}
+	if value, ok := icc.mutation.CreatedAt(); ok {
+		_spec.SetField(incomecertification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := icc.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incomecertification.PlanTable,
+			Columns: []string{incomecertification.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IncomeCertificationCreateBulk is the builder for creating many IncomeCertification entities in bulk.
+type IncomeCertificationCreateBulk struct {
+	config
+	err      error
+	builders []*IncomeCertificationCreate
+}
+
+// Save creates the IncomeCertification entities in the database.
+func (iccb *IncomeCertificationCreateBulk) Save(ctx context.Context) ([]*IncomeCertification, error) {
+	if iccb.err != nil {
+		return nil, iccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*IncomeCertification, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IncomeCertificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *IncomeCertificationCreateBulk) SaveX(ctx context.Context) []*IncomeCertification {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *IncomeCertificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *IncomeCertificationCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
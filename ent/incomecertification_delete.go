@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// IncomeCertificationDelete is the builder for deleting a IncomeCertification entity.
+type IncomeCertificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *IncomeCertificationMutation
+}
+
+// Where appends a list predicates to the IncomeCertificationDelete builder.
+func (icd *IncomeCertificationDelete) Where(ps ...predicate.IncomeCertification) *IncomeCertificationDelete {
+	icd.mutation.Where(ps...)
+	return icd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icd *IncomeCertificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icd.sqlExec, icd.mutation, icd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icd *IncomeCertificationDelete) ExecX(ctx context.Context) int {
+	n, err := icd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icd *IncomeCertificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(incomecertification.Table, sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt))
+	if ps := icd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icd.mutation.done = true
+	return affected, err
+}
+
+// IncomeCertificationDeleteOne is the builder for deleting a single IncomeCertification entity.
+type IncomeCertificationDeleteOne struct {
+	icd *IncomeCertificationDelete
+}
+
+// Where appends a list predicates to the IncomeCertificationDelete builder.
+func (icdo *IncomeCertificationDeleteOne) Where(ps ...predicate.IncomeCertification) *IncomeCertificationDeleteOne {
+	icdo.icd.mutation.Where(ps...)
+	return icdo
+}
+
+// Exec executes the deletion query.
+func (icdo *IncomeCertificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := icdo.icd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{incomecertification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icdo *IncomeCertificationDeleteOne) ExecX(ctx context.Context) {
+	if err := icdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
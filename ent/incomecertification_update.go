@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// IncomeCertificationUpdate is the builder for updating IncomeCertification entities.
+type IncomeCertificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IncomeCertificationMutation
+}
+
+// Where appends a list predicates to the IncomeCertificationUpdate builder.
+func (icu *IncomeCertificationUpdate) Where(ps ...predicate.IncomeCertification) *IncomeCertificationUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// SetPlanID sets the "plan_id" field.
+func (icu *IncomeCertificationUpdate) SetPlanID(i int) *IncomeCertificationUpdate {
+	icu.mutation.SetPlanID(i)
+	return icu
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (icu *IncomeCertificationUpdate) SetNillablePlanID(i *int) *IncomeCertificationUpdate {
+	if i != nil {
+		icu.SetPlanID(*i)
+	}
+	return icu
+}
+
+// SetYear sets the "year" field.
+func (icu *IncomeCertificationUpdate) SetYear(i int) *IncomeCertificationUpdate {
+	icu.mutation.ResetYear()
+	icu.mutation.SetYear(i)
+	return icu
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (icu *IncomeCertificationUpdate) SetNillableYear(i *int) *IncomeCertificationUpdate {
+	if i != nil {
+		icu.SetYear(*i)
+	}
+	return icu
+}
+
+// AddYear adds i to the "year" field.
+func (icu *IncomeCertificationUpdate) AddYear(i int) *IncomeCertificationUpdate {
+	icu.mutation.AddYear(i)
+	return icu
+}
+
+// SetIncome sets the "income" field.
+func (icu *IncomeCertificationUpdate) SetIncome(i int) *IncomeCertificationUpdate {
+	icu.mutation.ResetIncome()
+	icu.mutation.SetIncome(i)
+	return icu
+}
+
+// SetNillableIncome sets the "income" field if the given value is not nil.
+func (icu *IncomeCertificationUpdate) SetNillableIncome(i *int) *IncomeCertificationUpdate {
+	if i != nil {
+		icu.SetIncome(*i)
+	}
+	return icu
+}
+
+// AddIncome adds i to the "income" field.
+func (icu *IncomeCertificationUpdate) AddIncome(i int) *IncomeCertificationUpdate {
+	icu.mutation.AddIncome(i)
+	return icu
+}
+
+// SetFamilySize sets the "family_size" field.
+func (icu *IncomeCertificationUpdate) SetFamilySize(i int) *IncomeCertificationUpdate {
+	icu.mutation.ResetFamilySize()
+	icu.mutation.SetFamilySize(i)
+	return icu
+}
+
+// SetNillableFamilySize sets the "family_size" field if the given value is not nil.
+func (icu *IncomeCertificationUpdate) SetNillableFamilySize(i *int) *IncomeCertificationUpdate {
+	if i != nil {
+		icu.SetFamilySize(*i)
+	}
+	return icu
+}
+
+// AddFamilySize adds i to the "family_size" field.
+func (icu *IncomeCertificationUpdate) AddFamilySize(i int) *IncomeCertificationUpdate {
+	icu.mutation.AddFamilySize(i)
+	return icu
+}
+
+// SetPlan sets the "plan" edge to the IncomeDrivenPlan entity.
+func (icu *IncomeCertificationUpdate) SetPlan(i *IncomeDrivenPlan) *IncomeCertificationUpdate {
+	return icu.SetPlanID(i.ID)
+}
+
+// Mutation returns the IncomeCertificationMutation object of the builder.
+func (icu *IncomeCertificationUpdate) Mutation() *IncomeCertificationMutation {
+	return icu.mutation
+}
+
+// ClearPlan clears the "plan" edge to the IncomeDrivenPlan entity.
+func (icu *IncomeCertificationUpdate) ClearPlan() *IncomeCertificationUpdate {
+	icu.mutation.ClearPlan()
+	return icu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *IncomeCertificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, icu.sqlSave, icu.mutation, icu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *IncomeCertificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *IncomeCertificationUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *IncomeCertificationUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icu *IncomeCertificationUpdate) check() error {
+	if _, ok := icu.mutation.PlanID(); icu.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IncomeCertification.plan"`)
+	}
+	return nil
+}
+
+func (icu *IncomeCertificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := icu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(incomecertification.Table, incomecertification.Columns, sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt))
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icu.mutation.Year(); ok {
+		_spec.SetField(incomecertification.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedYear(); ok {
+		_spec.AddField(incomecertification.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.Income(); ok {
+		_spec.SetField(incomecertification.FieldIncome, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedIncome(); ok {
+		_spec.AddField(incomecertification.FieldIncome, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.FamilySize(); ok {
+		_spec.SetField(incomecertification.FieldFamilySize, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedFamilySize(); ok {
+		_spec.AddField(incomecertification.FieldFamilySize, field.TypeInt, value)
+	}
+	if icu.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incomecertification.PlanTable,
+			Columns: []string{incomecertification.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icu.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incomecertification.PlanTable,
+			Columns: []string{incomecertification.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incomecertification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icu.mutation.done = true
+	return n, nil
+}
+
+// IncomeCertificationUpdateOne is the builder for updating a single IncomeCertification entity.
+type IncomeCertificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IncomeCertificationMutation
+}
+
+// SetPlanID sets the "plan_id" field.
+func (icuo *IncomeCertificationUpdateOne) SetPlanID(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.SetPlanID(i)
+	return icuo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (icuo *IncomeCertificationUpdateOne) SetNillablePlanID(i *int) *IncomeCertificationUpdateOne {
+	if i != nil {
+		icuo.SetPlanID(*i)
+	}
+	return icuo
+}
+
+// SetYear sets the "year" field.
+func (icuo *IncomeCertificationUpdateOne) SetYear(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.ResetYear()
+	icuo.mutation.SetYear(i)
+	return icuo
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (icuo *IncomeCertificationUpdateOne) SetNillableYear(i *int) *IncomeCertificationUpdateOne {
+	if i != nil {
+		icuo.SetYear(*i)
+	}
+	return icuo
+}
+
+// AddYear adds i to the "year" field.
+func (icuo *IncomeCertificationUpdateOne) AddYear(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.AddYear(i)
+	return icuo
+}
+
+// SetIncome sets the "income" field.
+func (icuo *IncomeCertificationUpdateOne) SetIncome(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.ResetIncome()
+	icuo.mutation.SetIncome(i)
+	return icuo
+}
+
+// SetNillableIncome sets the "income" field if the given value is not nil.
+func (icuo *IncomeCertificationUpdateOne) SetNillableIncome(i *int) *IncomeCertificationUpdateOne {
+	if i != nil {
+		icuo.SetIncome(*i)
+	}
+	return icuo
+}
+
+// AddIncome adds i to the "income" field.
+func (icuo *IncomeCertificationUpdateOne) AddIncome(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.AddIncome(i)
+	return icuo
+}
+
+// SetFamilySize sets the "family_size" field.
+func (icuo *IncomeCertificationUpdateOne) SetFamilySize(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.ResetFamilySize()
+	icuo.mutation.SetFamilySize(i)
+	return icuo
+}
+
+// SetNillableFamilySize sets the "family_size" field if the given value is not nil.
+func (icuo *IncomeCertificationUpdateOne) SetNillableFamilySize(i *int) *IncomeCertificationUpdateOne {
+	if i != nil {
+		icuo.SetFamilySize(*i)
+	}
+	return icuo
+}
+
+// AddFamilySize adds i to the "family_size" field.
+func (icuo *IncomeCertificationUpdateOne) AddFamilySize(i int) *IncomeCertificationUpdateOne {
+	icuo.mutation.AddFamilySize(i)
+	return icuo
+}
+
+// SetPlan sets the "plan" edge to the IncomeDrivenPlan entity.
+func (icuo *IncomeCertificationUpdateOne) SetPlan(i *IncomeDrivenPlan) *IncomeCertificationUpdateOne {
+	return icuo.SetPlanID(i.ID)
+}
+
+// Mutation returns the IncomeCertificationMutation object of the builder.
+func (icuo *IncomeCertificationUpdateOne) Mutation() *IncomeCertificationMutation {
+	return icuo.mutation
+}
+
+// ClearPlan clears the "plan" edge to the IncomeDrivenPlan entity.
+func (icuo *IncomeCertificationUpdateOne) ClearPlan() *IncomeCertificationUpdateOne {
+	icuo.mutation.ClearPlan()
+	return icuo
+}
+
+// Where appends a list predicates to the IncomeCertificationUpdate builder.
+func (icuo *IncomeCertificationUpdateOne) Where(ps ...predicate.IncomeCertification) *IncomeCertificationUpdateOne {
+	icuo.mutation.Where(ps...)
+	return icuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *IncomeCertificationUpdateOne) Select(field string, fields ...string) *IncomeCertificationUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated IncomeCertification entity.
+func (icuo *IncomeCertificationUpdateOne) Save(ctx context.Context) (*IncomeCertification, error) {
+	return withHooks(ctx, icuo.sqlSave, icuo.mutation, icuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *IncomeCertificationUpdateOne) SaveX(ctx context.Context) *IncomeCertification {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *IncomeCertificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *IncomeCertificationUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icuo *IncomeCertificationUpdateOne) check() error {
+	if _, ok := icuo.mutation.PlanID(); icuo.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IncomeCertification.plan"`)
+	}
+	return nil
+}
+
+func (icuo *IncomeCertificationUpdateOne) sqlSave(ctx context.Context) (_node *IncomeCertification, err error) {
+	if err := icuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(incomecertification.Table, incomecertification.Columns, sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt))
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IncomeCertification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, incomecertification.FieldID)
+		for _, f := range fields {
+			if !incomecertification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != incomecertification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icuo.mutation.Year(); ok {
+		_spec.SetField(incomecertification.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedYear(); ok {
+		_spec.AddField(incomecertification.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.Income(); ok {
+		_spec.SetField(incomecertification.FieldIncome, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedIncome(); ok {
+		_spec.AddField(incomecertification.FieldIncome, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.FamilySize(); ok {
+		_spec.SetField(incomecertification.FieldFamilySize, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedFamilySize(); ok {
+		_spec.AddField(incomecertification.FieldFamilySize, field.TypeInt, value)
+	}
+	if icuo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incomecertification.PlanTable,
+			Columns: []string{incomecertification.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := icuo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   incomecertification.PlanTable,
+			Columns: []string{incomecertification.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IncomeCertification{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incomecertification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icuo.mutation.done = true
+	return _node, nil
+}
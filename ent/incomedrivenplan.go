@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/loan"
+)
+
+// IncomeDrivenPlan is the model entity for the IncomeDrivenPlan schema.
+type IncomeDrivenPlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// StartMonth holds the value of the "start_month" field.
+	StartMonth int `json:"start_month,omitempty"`
+	// PaymentPercent holds the value of the "payment_percent" field.
+	PaymentPercent float64 `json:"payment_percent,omitempty"`
+	// PovertyMultiplier holds the value of the "poverty_multiplier" field.
+	PovertyMultiplier float64 `json:"poverty_multiplier,omitempty"`
+	// Region holds the value of the "region" field.
+	Region incomedrivenplan.Region `json:"region,omitempty"`
+	// ForgivenessMonths holds the value of the "forgiveness_months" field.
+	ForgivenessMonths int `json:"forgiveness_months,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IncomeDrivenPlanQuery when eager-loading is set.
+	Edges        IncomeDrivenPlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IncomeDrivenPlanEdges holds the relations/edges for other nodes in the graph.
+type IncomeDrivenPlanEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// Certifications holds the value of the certifications edge.
+	Certifications []*IncomeCertification `json:"certifications,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IncomeDrivenPlanEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// CertificationsOrErr returns the Certifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e IncomeDrivenPlanEdges) CertificationsOrErr() ([]*IncomeCertification, error) {
+	if e.loadedTypes[1] {
+		return e.Certifications, nil
+	}
+	return nil, &NotLoadedError{edge: "certifications"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IncomeDrivenPlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case incomedrivenplan.FieldPaymentPercent, incomedrivenplan.FieldPovertyMultiplier:
+			values[i] = new(sql.NullFloat64)
+		case incomedrivenplan.FieldID, incomedrivenplan.FieldLoanID, incomedrivenplan.FieldStartMonth, incomedrivenplan.FieldForgivenessMonths:
+			values[i] = new(sql.NullInt64)
+		case incomedrivenplan.FieldRegion:
+			values[i] = new(sql.NullString)
+		case incomedrivenplan.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IncomeDrivenPlan fields.
+func (idp *IncomeDrivenPlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case incomedrivenplan.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			idp.ID = int(value.Int64)
+		case incomedrivenplan.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				idp.LoanID = int(value.Int64)
+			}
+		case incomedrivenplan.FieldStartMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_month", values[i])
+			} else if value.Valid {
+				idp.StartMonth = int(value.Int64)
+			}
+		case incomedrivenplan.FieldPaymentPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_percent", values[i])
+			} else if value.Valid {
+				idp.PaymentPercent = value.Float64
+			}
+		case incomedrivenplan.FieldPovertyMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field poverty_multiplier", values[i])
+			} else if value.Valid {
+				idp.PovertyMultiplier = value.Float64
+			}
+		case incomedrivenplan.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				idp.Region = incomedrivenplan.Region(value.String)
+			}
+		case incomedrivenplan.FieldForgivenessMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field forgiveness_months", values[i])
+			} else if value.Valid {
+				idp.ForgivenessMonths = int(value.Int64)
+			}
+		case incomedrivenplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				idp.CreatedAt = value.Time
+			}
+		default:
+			idp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IncomeDrivenPlan.
+// This includes values selected through modifiers, order, etc.
+func (idp *IncomeDrivenPlan) Value(name string) (ent.Value, error) {
+	return idp.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the IncomeDrivenPlan entity.
+func (idp *IncomeDrivenPlan) QueryLoan() *LoanQuery {
+	return NewIncomeDrivenPlanClient(idp.config).QueryLoan(idp)
+}
+
+// QueryCertifications queries the "certifications" edge of the IncomeDrivenPlan entity.
+func (idp *IncomeDrivenPlan) QueryCertifications() *IncomeCertificationQuery {
+	return NewIncomeDrivenPlanClient(idp.config).QueryCertifications(idp)
+}
+
+// Update returns a builder for updating this IncomeDrivenPlan.
+// Note that you need to call IncomeDrivenPlan.Unwrap() before calling this method if this IncomeDrivenPlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (idp *IncomeDrivenPlan) Update() *IncomeDrivenPlanUpdateOne {
+	return NewIncomeDrivenPlanClient(idp.config).UpdateOne(idp)
+}
+
+// Unwrap unwraps the IncomeDrivenPlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (idp *IncomeDrivenPlan) Unwrap() *IncomeDrivenPlan {
+	_tx, ok := idp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IncomeDrivenPlan is not a transactional entity")
+	}
+	idp.config.driver = _tx.drv
+	return idp
+}
+
+// String implements the fmt.Stringer.
+func (idp *IncomeDrivenPlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("IncomeDrivenPlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", idp.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", idp.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("start_month=")
+	builder.WriteString(fmt.Sprintf("%v", idp.StartMonth))
+	builder.WriteString(", ")
+	builder.WriteString("payment_percent=")
+	builder.WriteString(fmt.Sprintf("%v", idp.PaymentPercent))
+	builder.WriteString(", ")
+	builder.WriteString("poverty_multiplier=")
+	builder.WriteString(fmt.Sprintf("%v", idp.PovertyMultiplier))
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(fmt.Sprintf("%v", idp.Region))
+	builder.WriteString(", ")
+	builder.WriteString("forgiveness_months=")
+	builder.WriteString(fmt.Sprintf("%v", idp.ForgivenessMonths))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(idp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IncomeDrivenPlans is a parsable slice of IncomeDrivenPlan.
+type IncomeDrivenPlans []*IncomeDrivenPlan
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incomedrivenplan
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the incomedrivenplan type in the database.
+	Label = "income_driven_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLoanID holds the string denoting the loan_id field in the database.
+	FieldLoanID = "loan_id"
+	// FieldStartMonth holds the string denoting the start_month field in the database.
+	FieldStartMonth = "start_month"
+	// FieldPaymentPercent holds the string denoting the payment_percent field in the database.
+	FieldPaymentPercent = "payment_percent"
+	// FieldPovertyMultiplier holds the string denoting the poverty_multiplier field in the database.
+	FieldPovertyMultiplier = "poverty_multiplier"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldForgivenessMonths holds the string denoting the forgiveness_months field in the database.
+	FieldForgivenessMonths = "forgiveness_months"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLoan holds the string denoting the loan edge name in mutations.
+	EdgeLoan = "loan"
+	// EdgeCertifications holds the string denoting the certifications edge name in mutations.
+	EdgeCertifications = "certifications"
+	// Table holds the table name of the incomedrivenplan in the database.
+	Table = "income_driven_plans"
+	// LoanTable is the table that holds the loan relation/edge.
+	LoanTable = "income_driven_plans"
+	// LoanInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoanInverseTable = "loans"
+	// LoanColumn is the table column denoting the loan relation/edge.
+	LoanColumn = "loan_id"
+	// CertificationsTable is the table that holds the certifications relation/edge.
+	CertificationsTable = "income_certifications"
+	// CertificationsInverseTable is the table name for the IncomeCertification entity.
+	// It exists in this package in order to avoid circular dependency with the "incomecertification" package.
+	CertificationsInverseTable = "income_certifications"
+	// CertificationsColumn is the table column denoting the certifications relation/edge.
+	CertificationsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for incomedrivenplan fields.
+var Columns = []string{
+	FieldID,
+	FieldLoanID,
+	FieldStartMonth,
+	FieldPaymentPercent,
+	FieldPovertyMultiplier,
+	FieldRegion,
+	FieldForgivenessMonths,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Region defines the type for the "region" enum field.
+type Region string
+
+// Region values.
+const (
+	RegionContiguous Region = "contiguous"
+	RegionAlaska     Region = "alaska"
+	RegionHawaii     Region = "hawaii"
+)
+
+func (r Region) String() string {
+	return string(r)
+}
+
+// RegionValidator is a validator for the "region" field enum values. It is called by the builders before save.
+func RegionValidator(r Region) error {
+	switch r {
+	case RegionContiguous, RegionAlaska, RegionHawaii:
+		return nil
+	default:
+		return fmt.Errorf("incomedrivenplan: invalid enum value for region field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the IncomeDrivenPlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLoanID orders the results by the loan_id field.
+func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
+}
+
+// ByStartMonth orders the results by the start_month field.
+func ByStartMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartMonth, opts...).ToFunc()
+}
+
+// ByPaymentPercent orders the results by the payment_percent field.
+func ByPaymentPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentPercent, opts...).ToFunc()
+}
+
+// ByPovertyMultiplier orders the results by the poverty_multiplier field.
+func ByPovertyMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPovertyMultiplier, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByForgivenessMonths orders the results by the forgiveness_months field.
+func ByForgivenessMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForgivenessMonths, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLoanField orders the results by loan field.
+func ByLoanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoanStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCertificationsCount orders the results by certifications count.
+func ByCertificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCertificationsStep(), opts...)
+	}
+}
+
+// ByCertifications orders the results by certifications terms.
+func ByCertifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLoanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, LoanTable, LoanColumn),
+	)
+}
+func newCertificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CertificationsTable, CertificationsColumn),
+	)
+}
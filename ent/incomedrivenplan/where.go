@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package incomedrivenplan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldLoanID, v))
+}
+
+// StartMonth applies equality check predicate on the "start_month" field. It's identical to StartMonthEQ.
+func StartMonth(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// PaymentPercent applies equality check predicate on the "payment_percent" field. It's identical to PaymentPercentEQ.
+func PaymentPercent(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldPaymentPercent, v))
+}
+
+// PovertyMultiplier applies equality check predicate on the "poverty_multiplier" field. It's identical to PovertyMultiplierEQ.
+func PovertyMultiplier(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldPovertyMultiplier, v))
+}
+
+// ForgivenessMonths applies equality check predicate on the "forgiveness_months" field. It's identical to ForgivenessMonthsEQ.
+func ForgivenessMonths(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldForgivenessMonths, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// StartMonthEQ applies the EQ predicate on the "start_month" field.
+func StartMonthEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// StartMonthNEQ applies the NEQ predicate on the "start_month" field.
+func StartMonthNEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldStartMonth, v))
+}
+
+// StartMonthIn applies the In predicate on the "start_month" field.
+func StartMonthIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldStartMonth, vs...))
+}
+
+// StartMonthNotIn applies the NotIn predicate on the "start_month" field.
+func StartMonthNotIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldStartMonth, vs...))
+}
+
+// StartMonthGT applies the GT predicate on the "start_month" field.
+func StartMonthGT(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldStartMonth, v))
+}
+
+// StartMonthGTE applies the GTE predicate on the "start_month" field.
+func StartMonthGTE(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldStartMonth, v))
+}
+
+// StartMonthLT applies the LT predicate on the "start_month" field.
+func StartMonthLT(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldStartMonth, v))
+}
+
+// StartMonthLTE applies the LTE predicate on the "start_month" field.
+func StartMonthLTE(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldStartMonth, v))
+}
+
+// PaymentPercentEQ applies the EQ predicate on the "payment_percent" field.
+func PaymentPercentEQ(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldPaymentPercent, v))
+}
+
+// PaymentPercentNEQ applies the NEQ predicate on the "payment_percent" field.
+func PaymentPercentNEQ(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldPaymentPercent, v))
+}
+
+// PaymentPercentIn applies the In predicate on the "payment_percent" field.
+func PaymentPercentIn(vs ...float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldPaymentPercent, vs...))
+}
+
+// PaymentPercentNotIn applies the NotIn predicate on the "payment_percent" field.
+func PaymentPercentNotIn(vs ...float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldPaymentPercent, vs...))
+}
+
+// PaymentPercentGT applies the GT predicate on the "payment_percent" field.
+func PaymentPercentGT(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldPaymentPercent, v))
+}
+
+// PaymentPercentGTE applies the GTE predicate on the "payment_percent" field.
+func PaymentPercentGTE(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldPaymentPercent, v))
+}
+
+// PaymentPercentLT applies the LT predicate on the "payment_percent" field.
+func PaymentPercentLT(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldPaymentPercent, v))
+}
+
+// PaymentPercentLTE applies the LTE predicate on the "payment_percent" field.
+func PaymentPercentLTE(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldPaymentPercent, v))
+}
+
+// PovertyMultiplierEQ applies the EQ predicate on the "poverty_multiplier" field.
+func PovertyMultiplierEQ(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldPovertyMultiplier, v))
+}
+
+// PovertyMultiplierNEQ applies the NEQ predicate on the "poverty_multiplier" field.
+func PovertyMultiplierNEQ(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldPovertyMultiplier, v))
+}
+
+// PovertyMultiplierIn applies the In predicate on the "poverty_multiplier" field.
+func PovertyMultiplierIn(vs ...float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldPovertyMultiplier, vs...))
+}
+
+// PovertyMultiplierNotIn applies the NotIn predicate on the "poverty_multiplier" field.
+func PovertyMultiplierNotIn(vs ...float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldPovertyMultiplier, vs...))
+}
+
+// PovertyMultiplierGT applies the GT predicate on the "poverty_multiplier" field.
+func PovertyMultiplierGT(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldPovertyMultiplier, v))
+}
+
+// PovertyMultiplierGTE applies the GTE predicate on the "poverty_multiplier" field.
+func PovertyMultiplierGTE(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldPovertyMultiplier, v))
+}
+
+// PovertyMultiplierLT applies the LT predicate on the "poverty_multiplier" field.
+func PovertyMultiplierLT(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldPovertyMultiplier, v))
+}
+
+// PovertyMultiplierLTE applies the LTE predicate on the "poverty_multiplier" field.
+func PovertyMultiplierLTE(v float64) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldPovertyMultiplier, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v Region) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v Region) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...Region) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...Region) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// ForgivenessMonthsEQ applies the EQ predicate on the "forgiveness_months" field.
+func ForgivenessMonthsEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldForgivenessMonths, v))
+}
+
+// ForgivenessMonthsNEQ applies the NEQ predicate on the "forgiveness_months" field.
+func ForgivenessMonthsNEQ(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldForgivenessMonths, v))
+}
+
+// ForgivenessMonthsIn applies the In predicate on the "forgiveness_months" field.
+func ForgivenessMonthsIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldForgivenessMonths, vs...))
+}
+
+// ForgivenessMonthsNotIn applies the NotIn predicate on the "forgiveness_months" field.
+func ForgivenessMonthsNotIn(vs ...int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldForgivenessMonths, vs...))
+}
+
+// ForgivenessMonthsGT applies the GT predicate on the "forgiveness_months" field.
+func ForgivenessMonthsGT(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldForgivenessMonths, v))
+}
+
+// ForgivenessMonthsGTE applies the GTE predicate on the "forgiveness_months" field.
+func ForgivenessMonthsGTE(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldForgivenessMonths, v))
+}
+
+// ForgivenessMonthsLT applies the LT predicate on the "forgiveness_months" field.
+func ForgivenessMonthsLT(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldForgivenessMonths, v))
+}
+
+// ForgivenessMonthsLTE applies the LTE predicate on the "forgiveness_months" field.
+func ForgivenessMonthsLTE(v int) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldForgivenessMonths, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCertifications applies the HasEdge predicate on the "certifications" edge.
+func HasCertifications() predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CertificationsTable, CertificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificationsWith applies the HasEdge predicate on the "certifications" edge with a given conditions (other predicates).
+func HasCertificationsWith(preds ...predicate.IncomeCertification) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(func(s *sql.Selector) {
+		step := newCertificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IncomeDrivenPlan) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IncomeDrivenPlan) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IncomeDrivenPlan) predicate.IncomeDrivenPlan {
+	return predicate.IncomeDrivenPlan(sql.NotPredicates(p))
+}
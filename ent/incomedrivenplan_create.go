@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/loan"
+)
+
+// IncomeDrivenPlanCreate is the builder for creating a IncomeDrivenPlan entity.
+type IncomeDrivenPlanCreate struct {
+	config
+	mutation *IncomeDrivenPlanMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (idpc *IncomeDrivenPlanCreate) SetLoanID(i int) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetLoanID(i)
+	return idpc
+}
+
+// SetStartMonth sets the "start_month" field.
+func (idpc *IncomeDrivenPlanCreate) SetStartMonth(i int) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetStartMonth(i)
+	return idpc
+}
+
+// SetPaymentPercent sets the "payment_percent" field.
+func (idpc *IncomeDrivenPlanCreate) SetPaymentPercent(f float64) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetPaymentPercent(f)
+	return idpc
+}
+
+// SetPovertyMultiplier sets the "poverty_multiplier" field.
+func (idpc *IncomeDrivenPlanCreate) SetPovertyMultiplier(f float64) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetPovertyMultiplier(f)
+	return idpc
+}
+
+// SetRegion sets the "region" field.
+func (idpc *IncomeDrivenPlanCreate) SetRegion(i incomedrivenplan.Region) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetRegion(i)
+	return idpc
+}
+
+// SetForgivenessMonths sets the "forgiveness_months" field.
+func (idpc *IncomeDrivenPlanCreate) SetForgivenessMonths(i int) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetForgivenessMonths(i)
+	return idpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (idpc *IncomeDrivenPlanCreate) SetCreatedAt(t time.Time) *IncomeDrivenPlanCreate {
+	idpc.mutation.SetCreatedAt(t)
+	return idpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (idpc *IncomeDrivenPlanCreate) SetNillableCreatedAt(t *time.Time) *IncomeDrivenPlanCreate {
+	if t != nil {
+		idpc.SetCreatedAt(*t)
+	}
+	return idpc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (idpc *IncomeDrivenPlanCreate) SetLoan(l *Loan) *IncomeDrivenPlanCreate {
+	return idpc.SetLoanID(l.ID)
+}
+
+// AddCertificationIDs adds the "certifications" edge to the IncomeCertification entity by IDs.
+func (idpc *IncomeDrivenPlanCreate) AddCertificationIDs(ids ...int) *IncomeDrivenPlanCreate {
+	idpc.mutation.AddCertificationIDs(ids...)
+	return idpc
+}
+
+// AddCertifications adds the "certifications" edges to the IncomeCertification entity.
+func (idpc *IncomeDrivenPlanCreate) AddCertifications(i ...*IncomeCertification) *IncomeDrivenPlanCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return idpc.AddCertificationIDs(ids...)
+}
+
+// Mutation returns the IncomeDrivenPlanMutation object of the builder.
+func (idpc *IncomeDrivenPlanCreate) Mutation() *IncomeDrivenPlanMutation {
+	return idpc.mutation
+}
+
+// Save creates the IncomeDrivenPlan in the database.
+func (idpc *IncomeDrivenPlanCreate) Save(ctx context.Context) (*IncomeDrivenPlan, error) {
+	idpc.defaults()
+	return withHooks(ctx, idpc.sqlSave, idpc.mutation, idpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (idpc *IncomeDrivenPlanCreate) SaveX(ctx context.Context) *IncomeDrivenPlan {
+	v, err := idpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (idpc *IncomeDrivenPlanCreate) Exec(ctx context.Context) error {
+	_, err := idpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpc *IncomeDrivenPlanCreate) ExecX(ctx context.Context) {
+	if err := idpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (idpc *IncomeDrivenPlanCreate) defaults() {
+	if _, ok := idpc.mutation.CreatedAt(); !ok {
+		v := incomedrivenplan.DefaultCreatedAt()
+		idpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (idpc *IncomeDrivenPlanCreate) check() error {
+	if _, ok := idpc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "IncomeDrivenPlan.loan_id"`)}
+	}
+	if _, ok := idpc.mutation.StartMonth(); !ok {
+		return &ValidationError{Name: "start_month", err: errors.New(`ent: missing required field "IncomeDrivenPlan.start_month"`)}
+	}
+	if _, ok := idpc.mutation.PaymentPercent(); !ok {
+		return &ValidationError{Name: "payment_percent", err: errors.New(`ent: missing required field "IncomeDrivenPlan.payment_percent"`)}
+	}
+	if _, ok := idpc.mutation.PovertyMultiplier(); !ok {
+		return &ValidationError{Name: "poverty_multiplier", err: errors.New(`ent: missing required field "IncomeDrivenPlan.poverty_multiplier"`)}
+	}
+	if _, ok := idpc.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ent: missing required field "IncomeDrivenPlan.region"`)}
+	}
+	if v, ok := idpc.mutation.Region(); ok {
+		if err := incomedrivenplan.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "IncomeDrivenPlan.region": %w`, err)}
+		}
+	}
+	if _, ok := idpc.mutation.ForgivenessMonths(); !ok {
+		return &ValidationError{Name: "forgiveness_months", err: errors.New(`ent: missing required field "IncomeDrivenPlan.forgiveness_months"`)}
+	}
+	if _, ok := idpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IncomeDrivenPlan.created_at"`)}
+	}
+	if _, ok := idpc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "IncomeDrivenPlan.loan"`)}
+	}
+	return nil
+}
+
+func (idpc *IncomeDrivenPlanCreate) sqlSave(ctx context.Context) (*IncomeDrivenPlan, error) {
+	if err := idpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := idpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, idpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	idpc.mutation.id = &_node.ID
+	idpc.mutation.done = true
+	return _node, nil
+}
+
+func (idpc *IncomeDrivenPlanCreate) createSpec() (*IncomeDrivenPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IncomeDrivenPlan{config: idpc.config}
+		_spec = sqlgraph.NewCreateSpec(incomedrivenplan.Table, sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt))
+	)
+	if value, ok := idpc.mutation.StartMonth(); ok {
+		_spec.SetField(incomedrivenplan.FieldStartMonth, field.TypeInt, value)
+		_node.StartMonth = value
+	}
+	if value, ok := idpc.mutation.PaymentPercent(); ok {
+		_spec.SetField(incomedrivenplan.FieldPaymentPercent, field.TypeFloat64, value)
+		_node.PaymentPercent = value
+	}
+	if value, ok := idpc.mutation.PovertyMultiplier(); ok {
+		_spec.SetField(incomedrivenplan.FieldPovertyMultiplier, field.TypeFloat64, value)
+		_node.PovertyMultiplier = value
+	}
+	if value, ok := idpc.mutation.Region(); ok {
+		_spec.SetField(incomedrivenplan.FieldRegion, field.TypeEnum, value)
+		_node.Region = value
+	}
+	if value, ok := idpc.mutation.ForgivenessMonths(); ok {
+		_spec.SetField(incomedrivenplan.FieldForgivenessMonths, field.TypeInt, value)
+		_node.ForgivenessMonths = value
+	}
+	if value, ok := idpc.mutation.CreatedAt(); ok {
+		_spec.SetField(incomedrivenplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := idpc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   incomedrivenplan.LoanTable,
+			Columns: []string{incomedrivenplan.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := idpc.mutation.CertificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IncomeDrivenPlanCreateBulk is the builder for creating many IncomeDrivenPlan entities in bulk.
+type IncomeDrivenPlanCreateBulk struct {
+	config
+	err      error
+	builders []*IncomeDrivenPlanCreate
+}
+
+// Save creates the IncomeDrivenPlan entities in the database.
+func (idpcb *IncomeDrivenPlanCreateBulk) Save(ctx context.Context) ([]*IncomeDrivenPlan, error) {
+	if idpcb.err != nil {
+		return nil, idpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(idpcb.builders))
+	nodes := make([]*IncomeDrivenPlan, len(idpcb.builders))
+	mutators := make([]Mutator, len(idpcb.builders))
+	for i := range idpcb.builders {
+		func(i int, root context.Context) {
+			builder := idpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IncomeDrivenPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, idpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, idpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, idpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idpcb *IncomeDrivenPlanCreateBulk) SaveX(ctx context.Context) []*IncomeDrivenPlan {
+	v, err := idpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (idpcb *IncomeDrivenPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := idpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpcb *IncomeDrivenPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := idpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
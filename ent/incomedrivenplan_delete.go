@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// IncomeDrivenPlanDelete is the builder for deleting a IncomeDrivenPlan entity.
+type IncomeDrivenPlanDelete struct {
+	config
+	hooks    []Hook
+	mutation *IncomeDrivenPlanMutation
+}
+
+// Where appends a list predicates to the IncomeDrivenPlanDelete builder.
+func (idpd *IncomeDrivenPlanDelete) Where(ps ...predicate.IncomeDrivenPlan) *IncomeDrivenPlanDelete {
+	idpd.mutation.Where(ps...)
+	return idpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (idpd *IncomeDrivenPlanDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, idpd.sqlExec, idpd.mutation, idpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpd *IncomeDrivenPlanDelete) ExecX(ctx context.Context) int {
+	n, err := idpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (idpd *IncomeDrivenPlanDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(incomedrivenplan.Table, sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt))
+	if ps := idpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, idpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	idpd.mutation.done = true
+	return affected, err
+}
+
+// IncomeDrivenPlanDeleteOne is the builder for deleting a single IncomeDrivenPlan entity.
+type IncomeDrivenPlanDeleteOne struct {
+	idpd *IncomeDrivenPlanDelete
+}
+
+// Where appends a list predicates to the IncomeDrivenPlanDelete builder.
+func (idpdo *IncomeDrivenPlanDeleteOne) Where(ps ...predicate.IncomeDrivenPlan) *IncomeDrivenPlanDeleteOne {
+	idpdo.idpd.mutation.Where(ps...)
+	return idpdo
+}
+
+// Exec executes the deletion query.
+func (idpdo *IncomeDrivenPlanDeleteOne) Exec(ctx context.Context) error {
+	n, err := idpdo.idpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{incomedrivenplan.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpdo *IncomeDrivenPlanDeleteOne) ExecX(ctx context.Context) {
+	if err := idpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// IncomeDrivenPlanQuery is the builder for querying IncomeDrivenPlan entities.
+type IncomeDrivenPlanQuery struct {
+	config
+	ctx                *QueryContext
+	order              []incomedrivenplan.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.IncomeDrivenPlan
+	withLoan           *LoanQuery
+	withCertifications *IncomeCertificationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IncomeDrivenPlanQuery builder.
+func (idpq *IncomeDrivenPlanQuery) Where(ps ...predicate.IncomeDrivenPlan) *IncomeDrivenPlanQuery {
+	idpq.predicates = append(idpq.predicates, ps...)
+	return idpq
+}
+
+// Limit the number of records to be returned by this query.
+func (idpq *IncomeDrivenPlanQuery) Limit(limit int) *IncomeDrivenPlanQuery {
+	idpq.ctx.Limit = &limit
+	return idpq
+}
+
+// Offset to start from.
+func (idpq *IncomeDrivenPlanQuery) Offset(offset int) *IncomeDrivenPlanQuery {
+	idpq.ctx.Offset = &offset
+	return idpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (idpq *IncomeDrivenPlanQuery) Unique(unique bool) *IncomeDrivenPlanQuery {
+	idpq.ctx.Unique = &unique
+	return idpq
+}
+
+// Order specifies how the records should be ordered.
+func (idpq *IncomeDrivenPlanQuery) Order(o ...incomedrivenplan.OrderOption) *IncomeDrivenPlanQuery {
+	idpq.order = append(idpq.order, o...)
+	return idpq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (idpq *IncomeDrivenPlanQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: idpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := idpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := idpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incomedrivenplan.Table, incomedrivenplan.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, incomedrivenplan.LoanTable, incomedrivenplan.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(idpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCertifications chains the current query on the "certifications" edge.
+func (idpq *IncomeDrivenPlanQuery) QueryCertifications() *IncomeCertificationQuery {
+	query := (&IncomeCertificationClient{config: idpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := idpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := idpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(incomedrivenplan.Table, incomedrivenplan.FieldID, selector),
+			sqlgraph.To(incomecertification.Table, incomecertification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, incomedrivenplan.CertificationsTable, incomedrivenplan.CertificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(idpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IncomeDrivenPlan entity from the query.
+// Returns a *NotFoundError when no IncomeDrivenPlan was found.
+func (idpq *IncomeDrivenPlanQuery) First(ctx context.Context) (*IncomeDrivenPlan, error) {
+	nodes, err := idpq.Limit(1).All(setContextOp(ctx, idpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{incomedrivenplan.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) FirstX(ctx context.Context) *IncomeDrivenPlan {
+	node, err := idpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IncomeDrivenPlan ID from the query.
+// Returns a *NotFoundError when no IncomeDrivenPlan ID was found.
+func (idpq *IncomeDrivenPlanQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = idpq.Limit(1).IDs(setContextOp(ctx, idpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{incomedrivenplan.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) FirstIDX(ctx context.Context) int {
+	id, err := idpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IncomeDrivenPlan entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IncomeDrivenPlan entity is found.
+// Returns a *NotFoundError when no IncomeDrivenPlan entities are found.
+func (idpq *IncomeDrivenPlanQuery) Only(ctx context.Context) (*IncomeDrivenPlan, error) {
+	nodes, err := idpq.Limit(2).All(setContextOp(ctx, idpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{incomedrivenplan.Label}
+	default:
+		return nil, &NotSingularError{incomedrivenplan.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) OnlyX(ctx context.Context) *IncomeDrivenPlan {
+	node, err := idpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IncomeDrivenPlan ID in the query.
+// Returns a *NotSingularError when more than one IncomeDrivenPlan ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (idpq *IncomeDrivenPlanQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = idpq.Limit(2).IDs(setContextOp(ctx, idpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{incomedrivenplan.Label}
+	default:
+		err = &NotSingularError{incomedrivenplan.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) OnlyIDX(ctx context.Context) int {
+	id, err := idpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IncomeDrivenPlans.
+func (idpq *IncomeDrivenPlanQuery) All(ctx context.Context) ([]*IncomeDrivenPlan, error) {
+	ctx = setContextOp(ctx, idpq.ctx, "All")
+	if err := idpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IncomeDrivenPlan, *IncomeDrivenPlanQuery]()
+	return withInterceptors[[]*IncomeDrivenPlan](ctx, idpq, qr, idpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) AllX(ctx context.Context) []*IncomeDrivenPlan {
+	nodes, err := idpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IncomeDrivenPlan IDs.
+func (idpq *IncomeDrivenPlanQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if idpq.ctx.Unique == nil && idpq.path != nil {
+		idpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, idpq.ctx, "IDs")
+	if err = idpq.Select(incomedrivenplan.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) IDsX(ctx context.Context) []int {
+	ids, err := idpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (idpq *IncomeDrivenPlanQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, idpq.ctx, "Count")
+	if err := idpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, idpq, querierCount[*IncomeDrivenPlanQuery](), idpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) CountX(ctx context.Context) int {
+	count, err := idpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (idpq *IncomeDrivenPlanQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, idpq.ctx, "Exist")
+	switch _, err := idpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (idpq *IncomeDrivenPlanQuery) ExistX(ctx context.Context) bool {
+	exist, err := idpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IncomeDrivenPlanQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (idpq *IncomeDrivenPlanQuery) Clone() *IncomeDrivenPlanQuery {
+	if idpq == nil {
+		return nil
+	}
+	return &IncomeDrivenPlanQuery{
+		config:             idpq.config,
+		ctx:                idpq.ctx.Clone(),
+		order:              append([]incomedrivenplan.OrderOption{}, idpq.order...),
+		inters:             append([]Interceptor{}, idpq.inters...),
+		predicates:         append([]predicate.IncomeDrivenPlan{}, idpq.predicates...),
+		withLoan:           idpq.withLoan.Clone(),
+		withCertifications: idpq.withCertifications.Clone(),
+		// clone intermediate query.
+		sql:  idpq.sql.Clone(),
+		path: idpq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (idpq *IncomeDrivenPlanQuery) WithLoan(opts ...func(*LoanQuery)) *IncomeDrivenPlanQuery {
+	query := (&LoanClient{config: idpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	idpq.withLoan = query
+	return idpq
+}
+
+// WithCertifications tells the query-builder to eager-load the nodes that are connected to
+// the "certifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (idpq *IncomeDrivenPlanQuery) WithCertifications(opts ...func(*IncomeCertificationQuery)) *IncomeDrivenPlanQuery {
+	query := (&IncomeCertificationClient{config: idpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	idpq.withCertifications = query
+	return idpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IncomeDrivenPlan.Query().
+//		GroupBy(incomedrivenplan.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (idpq *IncomeDrivenPlanQuery) GroupBy(field string, fields ...string) *IncomeDrivenPlanGroupBy {
+	idpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IncomeDrivenPlanGroupBy{build: idpq}
+	grbuild.flds = &idpq.ctx.Fields
+	grbuild.label = incomedrivenplan.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.IncomeDrivenPlan.Query().
+//		Select(incomedrivenplan.FieldLoanID).
+//		Scan(ctx, &v)
+func (idpq *IncomeDrivenPlanQuery) Select(fields ...string) *IncomeDrivenPlanSelect {
+	idpq.ctx.Fields = append(idpq.ctx.Fields, fields...)
+	sbuild := &IncomeDrivenPlanSelect{IncomeDrivenPlanQuery: idpq}
+	sbuild.label = incomedrivenplan.Label
+	sbuild.flds, sbuild.scan = &idpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IncomeDrivenPlanSelect configured with the given aggregations.
+func (idpq *IncomeDrivenPlanQuery) Aggregate(fns ...AggregateFunc) *IncomeDrivenPlanSelect {
+	return idpq.Select().Aggregate(fns...)
+}
+
+func (idpq *IncomeDrivenPlanQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range idpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, idpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range idpq.ctx.Fields {
+		if !incomedrivenplan.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if idpq.path != nil {
+		prev, err := idpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		idpq.sql = prev
+	}
+	return nil
+}
+
+func (idpq *IncomeDrivenPlanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IncomeDrivenPlan, error) {
+	var (
+		nodes       = []*IncomeDrivenPlan{}
+		_spec       = idpq.querySpec()
+		loadedTypes = [2]bool{
+			idpq.withLoan != nil,
+			idpq.withCertifications != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IncomeDrivenPlan).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IncomeDrivenPlan{config: idpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, idpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := idpq.withLoan; query != nil {
+		if err := idpq.loadLoan(ctx, query, nodes, nil,
+			func(n *IncomeDrivenPlan, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := idpq.withCertifications; query != nil {
+		if err := idpq.loadCertifications(ctx, query, nodes,
+			func(n *IncomeDrivenPlan) { n.Edges.Certifications = []*IncomeCertification{} },
+			func(n *IncomeDrivenPlan, e *IncomeCertification) {
+				n.Edges.Certifications = append(n.Edges.Certifications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (idpq *IncomeDrivenPlanQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*IncomeDrivenPlan, init func(*IncomeDrivenPlan), assign func(*IncomeDrivenPlan, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*IncomeDrivenPlan)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (idpq *IncomeDrivenPlanQuery) loadCertifications(ctx context.Context, query *IncomeCertificationQuery, nodes []*IncomeDrivenPlan, init func(*IncomeDrivenPlan), assign func(*IncomeDrivenPlan, *IncomeCertification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*IncomeDrivenPlan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(incomecertification.FieldPlanID)
+	}
+	query.Where(predicate.IncomeCertification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(incomedrivenplan.CertificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PlanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "plan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (idpq *IncomeDrivenPlanQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := idpq.querySpec()
+	_spec.Node.Columns = idpq.ctx.Fields
+	if len(idpq.ctx.Fields) > 0 {
+		_spec.Unique = idpq.ctx.Unique != nil && *idpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, idpq.driver, _spec)
+}
+
+func (idpq *IncomeDrivenPlanQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(incomedrivenplan.Table, incomedrivenplan.Columns, sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt))
+	_spec.From = idpq.sql
+	if unique := idpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if idpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := idpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, incomedrivenplan.FieldID)
+		for i := range fields {
+			if fields[i] != incomedrivenplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if idpq.withLoan != nil {
+			_spec.Node.AddColumnOnce(incomedrivenplan.FieldLoanID)
+		}
+	}
+	if ps := idpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := idpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := idpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := idpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (idpq *IncomeDrivenPlanQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(idpq.driver.Dialect())
+	t1 := builder.Table(incomedrivenplan.Table)
+	columns := idpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = incomedrivenplan.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if idpq.sql != nil {
+		selector = idpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if idpq.ctx.Unique != nil && *idpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range idpq.predicates {
+		p(selector)
+	}
+	for _, p := range idpq.order {
+		p(selector)
+	}
+	if offset := idpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := idpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IncomeDrivenPlanGroupBy is the group-by builder for IncomeDrivenPlan entities.
+type IncomeDrivenPlanGroupBy struct {
+	selector
+	build *IncomeDrivenPlanQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (idpgb *IncomeDrivenPlanGroupBy) Aggregate(fns ...AggregateFunc) *IncomeDrivenPlanGroupBy {
+	idpgb.fns = append(idpgb.fns, fns...)
+	return idpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (idpgb *IncomeDrivenPlanGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, idpgb.build.ctx, "GroupBy")
+	if err := idpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IncomeDrivenPlanQuery, *IncomeDrivenPlanGroupBy](ctx, idpgb.build, idpgb, idpgb.build.inters, v)
+}
+
+func (idpgb *IncomeDrivenPlanGroupBy) sqlScan(ctx context.Context, root *IncomeDrivenPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(idpgb.fns))
+	for _, fn := range idpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*idpgb.flds)+len(idpgb.fns))
+		for _, f := range *idpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*idpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := idpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IncomeDrivenPlanSelect is the builder for selecting fields of IncomeDrivenPlan entities.
+type IncomeDrivenPlanSelect struct {
+	*IncomeDrivenPlanQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (idps *IncomeDrivenPlanSelect) Aggregate(fns ...AggregateFunc) *IncomeDrivenPlanSelect {
+	idps.fns = append(idps.fns, fns...)
+	return idps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (idps *IncomeDrivenPlanSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, idps.ctx, "Select")
+	if err := idps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IncomeDrivenPlanQuery, *IncomeDrivenPlanSelect](ctx, idps.IncomeDrivenPlanQuery, idps, idps.inters, v)
+}
+
+func (idps *IncomeDrivenPlanSelect) sqlScan(ctx context.Context, root *IncomeDrivenPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(idps.fns))
+	for _, fn := range idps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*idps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := idps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
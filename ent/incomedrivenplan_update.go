@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// IncomeDrivenPlanUpdate is the builder for updating IncomeDrivenPlan entities.
+type IncomeDrivenPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IncomeDrivenPlanMutation
+}
+
+// Where appends a list predicates to the IncomeDrivenPlanUpdate builder.
+func (idpu *IncomeDrivenPlanUpdate) Where(ps ...predicate.IncomeDrivenPlan) *IncomeDrivenPlanUpdate {
+	idpu.mutation.Where(ps...)
+	return idpu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (idpu *IncomeDrivenPlanUpdate) SetLoanID(i int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.SetLoanID(i)
+	return idpu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillableLoanID(i *int) *IncomeDrivenPlanUpdate {
+	if i != nil {
+		idpu.SetLoanID(*i)
+	}
+	return idpu
+}
+
+// SetStartMonth sets the "start_month" field.
+func (idpu *IncomeDrivenPlanUpdate) SetStartMonth(i int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.ResetStartMonth()
+	idpu.mutation.SetStartMonth(i)
+	return idpu
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillableStartMonth(i *int) *IncomeDrivenPlanUpdate {
+	if i != nil {
+		idpu.SetStartMonth(*i)
+	}
+	return idpu
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (idpu *IncomeDrivenPlanUpdate) AddStartMonth(i int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.AddStartMonth(i)
+	return idpu
+}
+
+// SetPaymentPercent sets the "payment_percent" field.
+func (idpu *IncomeDrivenPlanUpdate) SetPaymentPercent(f float64) *IncomeDrivenPlanUpdate {
+	idpu.mutation.ResetPaymentPercent()
+	idpu.mutation.SetPaymentPercent(f)
+	return idpu
+}
+
+// SetNillablePaymentPercent sets the "payment_percent" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillablePaymentPercent(f *float64) *IncomeDrivenPlanUpdate {
+	if f != nil {
+		idpu.SetPaymentPercent(*f)
+	}
+	return idpu
+}
+
+// AddPaymentPercent adds f to the "payment_percent" field.
+func (idpu *IncomeDrivenPlanUpdate) AddPaymentPercent(f float64) *IncomeDrivenPlanUpdate {
+	idpu.mutation.AddPaymentPercent(f)
+	return idpu
+}
+
+// SetPovertyMultiplier sets the "poverty_multiplier" field.
+func (idpu *IncomeDrivenPlanUpdate) SetPovertyMultiplier(f float64) *IncomeDrivenPlanUpdate {
+	idpu.mutation.ResetPovertyMultiplier()
+	idpu.mutation.SetPovertyMultiplier(f)
+	return idpu
+}
+
+// SetNillablePovertyMultiplier sets the "poverty_multiplier" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillablePovertyMultiplier(f *float64) *IncomeDrivenPlanUpdate {
+	if f != nil {
+		idpu.SetPovertyMultiplier(*f)
+	}
+	return idpu
+}
+
+// AddPovertyMultiplier adds f to the "poverty_multiplier" field.
+func (idpu *IncomeDrivenPlanUpdate) AddPovertyMultiplier(f float64) *IncomeDrivenPlanUpdate {
+	idpu.mutation.AddPovertyMultiplier(f)
+	return idpu
+}
+
+// SetRegion sets the "region" field.
+func (idpu *IncomeDrivenPlanUpdate) SetRegion(i incomedrivenplan.Region) *IncomeDrivenPlanUpdate {
+	idpu.mutation.SetRegion(i)
+	return idpu
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillableRegion(i *incomedrivenplan.Region) *IncomeDrivenPlanUpdate {
+	if i != nil {
+		idpu.SetRegion(*i)
+	}
+	return idpu
+}
+
+// SetForgivenessMonths sets the "forgiveness_months" field.
+func (idpu *IncomeDrivenPlanUpdate) SetForgivenessMonths(i int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.ResetForgivenessMonths()
+	idpu.mutation.SetForgivenessMonths(i)
+	return idpu
+}
+
+// SetNillableForgivenessMonths sets the "forgiveness_months" field if the given value is not nil.
+func (idpu *IncomeDrivenPlanUpdate) SetNillableForgivenessMonths(i *int) *IncomeDrivenPlanUpdate {
+	if i != nil {
+		idpu.SetForgivenessMonths(*i)
+	}
+	return idpu
+}
+
+// AddForgivenessMonths adds i to the "forgiveness_months" field.
+func (idpu *IncomeDrivenPlanUpdate) AddForgivenessMonths(i int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.AddForgivenessMonths(i)
+	return idpu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (idpu *IncomeDrivenPlanUpdate) SetLoan(l *Loan) *IncomeDrivenPlanUpdate {
+	return idpu.SetLoanID(l.ID)
+}
+
+// AddCertificationIDs adds the "certifications" edge to the IncomeCertification entity by IDs.
+func (idpu *IncomeDrivenPlanUpdate) AddCertificationIDs(ids ...int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.AddCertificationIDs(ids...)
+	return idpu
+}
+
+// AddCertifications adds the "certifications" edges to the IncomeCertification entity.
+func (idpu *IncomeDrivenPlanUpdate) AddCertifications(i ...*IncomeCertification) *IncomeDrivenPlanUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return idpu.AddCertificationIDs(ids...)
+}
+
+// Mutation returns the IncomeDrivenPlanMutation object of the builder.
+func (idpu *IncomeDrivenPlanUpdate) Mutation() *IncomeDrivenPlanMutation {
+	return idpu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (idpu *IncomeDrivenPlanUpdate) ClearLoan() *IncomeDrivenPlanUpdate {
+	idpu.mutation.ClearLoan()
+	return idpu
+}
+
+// ClearCertifications clears all "certifications" edges to the IncomeCertification entity.
+func (idpu *IncomeDrivenPlanUpdate) ClearCertifications() *IncomeDrivenPlanUpdate {
+	idpu.mutation.ClearCertifications()
+	return idpu
+}
+
+// RemoveCertificationIDs removes the "certifications" edge to IncomeCertification entities by IDs.
+func (idpu *IncomeDrivenPlanUpdate) RemoveCertificationIDs(ids ...int) *IncomeDrivenPlanUpdate {
+	idpu.mutation.RemoveCertificationIDs(ids...)
+	return idpu
+}
+
+// RemoveCertifications removes "certifications" edges to IncomeCertification entities.
+func (idpu *IncomeDrivenPlanUpdate) RemoveCertifications(i ...*IncomeCertification) *IncomeDrivenPlanUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return idpu.RemoveCertificationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (idpu *IncomeDrivenPlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, idpu.sqlSave, idpu.mutation, idpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idpu *IncomeDrivenPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := idpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (idpu *IncomeDrivenPlanUpdate) Exec(ctx context.Context) error {
+	_, err := idpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpu *IncomeDrivenPlanUpdate) ExecX(ctx context.Context) {
+	if err := idpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (idpu *IncomeDrivenPlanUpdate) check() error {
+	if v, ok := idpu.mutation.Region(); ok {
+		if err := incomedrivenplan.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "IncomeDrivenPlan.region": %w`, err)}
+		}
+	}
+	if _, ok := idpu.mutation.LoanID(); idpu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IncomeDrivenPlan.loan"`)
+	}
+	return nil
+}
+
+func (idpu *IncomeDrivenPlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := idpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(incomedrivenplan.Table, incomedrivenplan.Columns, sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt))
+	if ps := idpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := idpu.mutation.StartMonth(); ok {
+		_spec.SetField(incomedrivenplan.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := idpu.mutation.AddedStartMonth(); ok {
+		_spec.AddField(incomedrivenplan.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := idpu.mutation.PaymentPercent(); ok {
+		_spec.SetField(incomedrivenplan.FieldPaymentPercent, field.TypeFloat64, value)
+	}
+	if value, ok := idpu.mutation.AddedPaymentPercent(); ok {
+		_spec.AddField(incomedrivenplan.FieldPaymentPercent, field.TypeFloat64, value)
+	}
+	if value, ok := idpu.mutation.PovertyMultiplier(); ok {
+		_spec.SetField(incomedrivenplan.FieldPovertyMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := idpu.mutation.AddedPovertyMultiplier(); ok {
+		_spec.AddField(incomedrivenplan.FieldPovertyMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := idpu.mutation.Region(); ok {
+		_spec.SetField(incomedrivenplan.FieldRegion, field.TypeEnum, value)
+	}
+	if value, ok := idpu.mutation.ForgivenessMonths(); ok {
+		_spec.SetField(incomedrivenplan.FieldForgivenessMonths, field.TypeInt, value)
+	}
+	if value, ok := idpu.mutation.AddedForgivenessMonths(); ok {
+		_spec.AddField(incomedrivenplan.FieldForgivenessMonths, field.TypeInt, value)
+	}
+	if idpu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   incomedrivenplan.LoanTable,
+			Columns: []string{incomedrivenplan.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   incomedrivenplan.LoanTable,
+			Columns: []string{incomedrivenplan.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if idpu.mutation.CertificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpu.mutation.RemovedCertificationsIDs(); len(nodes) > 0 && !idpu.mutation.CertificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpu.mutation.CertificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, idpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incomedrivenplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	idpu.mutation.done = true
+	return n, nil
+}
+
+// IncomeDrivenPlanUpdateOne is the builder for updating a single IncomeDrivenPlan entity.
+type IncomeDrivenPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IncomeDrivenPlanMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetLoanID(i int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.SetLoanID(i)
+	return idpuo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillableLoanID(i *int) *IncomeDrivenPlanUpdateOne {
+	if i != nil {
+		idpuo.SetLoanID(*i)
+	}
+	return idpuo
+}
+
+// SetStartMonth sets the "start_month" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetStartMonth(i int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ResetStartMonth()
+	idpuo.mutation.SetStartMonth(i)
+	return idpuo
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillableStartMonth(i *int) *IncomeDrivenPlanUpdateOne {
+	if i != nil {
+		idpuo.SetStartMonth(*i)
+	}
+	return idpuo
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddStartMonth(i int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.AddStartMonth(i)
+	return idpuo
+}
+
+// SetPaymentPercent sets the "payment_percent" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetPaymentPercent(f float64) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ResetPaymentPercent()
+	idpuo.mutation.SetPaymentPercent(f)
+	return idpuo
+}
+
+// SetNillablePaymentPercent sets the "payment_percent" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillablePaymentPercent(f *float64) *IncomeDrivenPlanUpdateOne {
+	if f != nil {
+		idpuo.SetPaymentPercent(*f)
+	}
+	return idpuo
+}
+
+// AddPaymentPercent adds f to the "payment_percent" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddPaymentPercent(f float64) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.AddPaymentPercent(f)
+	return idpuo
+}
+
+// SetPovertyMultiplier sets the "poverty_multiplier" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetPovertyMultiplier(f float64) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ResetPovertyMultiplier()
+	idpuo.mutation.SetPovertyMultiplier(f)
+	return idpuo
+}
+
+// SetNillablePovertyMultiplier sets the "poverty_multiplier" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillablePovertyMultiplier(f *float64) *IncomeDrivenPlanUpdateOne {
+	if f != nil {
+		idpuo.SetPovertyMultiplier(*f)
+	}
+	return idpuo
+}
+
+// AddPovertyMultiplier adds f to the "poverty_multiplier" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddPovertyMultiplier(f float64) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.AddPovertyMultiplier(f)
+	return idpuo
+}
+
+// SetRegion sets the "region" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetRegion(i incomedrivenplan.Region) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.SetRegion(i)
+	return idpuo
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillableRegion(i *incomedrivenplan.Region) *IncomeDrivenPlanUpdateOne {
+	if i != nil {
+		idpuo.SetRegion(*i)
+	}
+	return idpuo
+}
+
+// SetForgivenessMonths sets the "forgiveness_months" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetForgivenessMonths(i int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ResetForgivenessMonths()
+	idpuo.mutation.SetForgivenessMonths(i)
+	return idpuo
+}
+
+// SetNillableForgivenessMonths sets the "forgiveness_months" field if the given value is not nil.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetNillableForgivenessMonths(i *int) *IncomeDrivenPlanUpdateOne {
+	if i != nil {
+		idpuo.SetForgivenessMonths(*i)
+	}
+	return idpuo
+}
+
+// AddForgivenessMonths adds i to the "forgiveness_months" field.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddForgivenessMonths(i int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.AddForgivenessMonths(i)
+	return idpuo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) SetLoan(l *Loan) *IncomeDrivenPlanUpdateOne {
+	return idpuo.SetLoanID(l.ID)
+}
+
+// AddCertificationIDs adds the "certifications" edge to the IncomeCertification entity by IDs.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddCertificationIDs(ids ...int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.AddCertificationIDs(ids...)
+	return idpuo
+}
+
+// AddCertifications adds the "certifications" edges to the IncomeCertification entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) AddCertifications(i ...*IncomeCertification) *IncomeDrivenPlanUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return idpuo.AddCertificationIDs(ids...)
+}
+
+// Mutation returns the IncomeDrivenPlanMutation object of the builder.
+func (idpuo *IncomeDrivenPlanUpdateOne) Mutation() *IncomeDrivenPlanMutation {
+	return idpuo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) ClearLoan() *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ClearLoan()
+	return idpuo
+}
+
+// ClearCertifications clears all "certifications" edges to the IncomeCertification entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) ClearCertifications() *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.ClearCertifications()
+	return idpuo
+}
+
+// RemoveCertificationIDs removes the "certifications" edge to IncomeCertification entities by IDs.
+func (idpuo *IncomeDrivenPlanUpdateOne) RemoveCertificationIDs(ids ...int) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.RemoveCertificationIDs(ids...)
+	return idpuo
+}
+
+// RemoveCertifications removes "certifications" edges to IncomeCertification entities.
+func (idpuo *IncomeDrivenPlanUpdateOne) RemoveCertifications(i ...*IncomeCertification) *IncomeDrivenPlanUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return idpuo.RemoveCertificationIDs(ids...)
+}
+
+// Where appends a list predicates to the IncomeDrivenPlanUpdate builder.
+func (idpuo *IncomeDrivenPlanUpdateOne) Where(ps ...predicate.IncomeDrivenPlan) *IncomeDrivenPlanUpdateOne {
+	idpuo.mutation.Where(ps...)
+	return idpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (idpuo *IncomeDrivenPlanUpdateOne) Select(field string, fields ...string) *IncomeDrivenPlanUpdateOne {
+	idpuo.fields = append([]string{field}, fields...)
+	return idpuo
+}
+
+// Save executes the query and returns the updated IncomeDrivenPlan entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) Save(ctx context.Context) (*IncomeDrivenPlan, error) {
+	return withHooks(ctx, idpuo.sqlSave, idpuo.mutation, idpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idpuo *IncomeDrivenPlanUpdateOne) SaveX(ctx context.Context) *IncomeDrivenPlan {
+	node, err := idpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (idpuo *IncomeDrivenPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := idpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idpuo *IncomeDrivenPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := idpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (idpuo *IncomeDrivenPlanUpdateOne) check() error {
+	if v, ok := idpuo.mutation.Region(); ok {
+		if err := incomedrivenplan.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "IncomeDrivenPlan.region": %w`, err)}
+		}
+	}
+	if _, ok := idpuo.mutation.LoanID(); idpuo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IncomeDrivenPlan.loan"`)
+	}
+	return nil
+}
+
+func (idpuo *IncomeDrivenPlanUpdateOne) sqlSave(ctx context.Context) (_node *IncomeDrivenPlan, err error) {
+	if err := idpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(incomedrivenplan.Table, incomedrivenplan.Columns, sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt))
+	id, ok := idpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IncomeDrivenPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := idpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, incomedrivenplan.FieldID)
+		for _, f := range fields {
+			if !incomedrivenplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != incomedrivenplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := idpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := idpuo.mutation.StartMonth(); ok {
+		_spec.SetField(incomedrivenplan.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := idpuo.mutation.AddedStartMonth(); ok {
+		_spec.AddField(incomedrivenplan.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := idpuo.mutation.PaymentPercent(); ok {
+		_spec.SetField(incomedrivenplan.FieldPaymentPercent, field.TypeFloat64, value)
+	}
+	if value, ok := idpuo.mutation.AddedPaymentPercent(); ok {
+		_spec.AddField(incomedrivenplan.FieldPaymentPercent, field.TypeFloat64, value)
+	}
+	if value, ok := idpuo.mutation.PovertyMultiplier(); ok {
+		_spec.SetField(incomedrivenplan.FieldPovertyMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := idpuo.mutation.AddedPovertyMultiplier(); ok {
+		_spec.AddField(incomedrivenplan.FieldPovertyMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := idpuo.mutation.Region(); ok {
+		_spec.SetField(incomedrivenplan.FieldRegion, field.TypeEnum, value)
+	}
+	if value, ok := idpuo.mutation.ForgivenessMonths(); ok {
+		_spec.SetField(incomedrivenplan.FieldForgivenessMonths, field.TypeInt, value)
+	}
+	if value, ok := idpuo.mutation.AddedForgivenessMonths(); ok {
+		_spec.AddField(incomedrivenplan.FieldForgivenessMonths, field.TypeInt, value)
+	}
+	if idpuo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   incomedrivenplan.LoanTable,
+			Columns: []string{incomedrivenplan.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpuo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   incomedrivenplan.LoanTable,
+			Columns: []string{incomedrivenplan.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if idpuo.mutation.CertificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpuo.mutation.RemovedCertificationsIDs(); len(nodes) > 0 && !idpuo.mutation.CertificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := idpuo.mutation.CertificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   incomedrivenplan.CertificationsTable,
+			Columns: []string{incomedrivenplan.CertificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomecertification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IncomeDrivenPlan{config: idpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, idpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{incomedrivenplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	idpuo.mutation.done = true
+	return _node, nil
+}
@@ -5,9 +5,11 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
 	"github.com/crusyn/loans/ent/user"
 )
@@ -25,6 +27,22 @@ type Loan struct {
 	Term int `json:"term,omitempty"`
 	// BorrowerID holds the value of the "borrower_id" field.
 	BorrowerID int `json:"borrower_id,omitempty"`
+	// GraduatedStepRate holds the value of the "graduated_step_rate" field.
+	GraduatedStepRate float64 `json:"graduated_step_rate,omitempty"`
+	// GraduatedYears holds the value of the "graduated_years" field.
+	GraduatedYears int `json:"graduated_years,omitempty"`
+	// NegativeAmortizationCap holds the value of the "negative_amortization_cap" field.
+	NegativeAmortizationCap float64 `json:"negative_amortization_cap,omitempty"`
+	// ConstructionMonths holds the value of the "construction_months" field.
+	ConstructionMonths int `json:"construction_months,omitempty"`
+	// PropertyValue holds the value of the "property_value" field.
+	PropertyValue int `json:"property_value,omitempty"`
+	// PmiRate holds the value of the "pmi_rate" field.
+	PmiRate float64 `json:"pmi_rate,omitempty"`
+	// PmiCancellationMonth holds the value of the "pmi_cancellation_month" field.
+	PmiCancellationMonth int `json:"pmi_cancellation_month,omitempty"`
+	// OriginatedAt holds the value of the "originated_at" field.
+	OriginatedAt time.Time `json:"originated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LoanQuery when eager-loading is set.
 	Edges        LoanEdges `json:"edges"`
@@ -37,9 +55,29 @@ type LoanEdges struct {
 	Borrower *User `json:"borrower,omitempty"`
 	// SharedLoan holds the value of the shared_loan edge.
 	SharedLoan []*SharedLoan `json:"shared_loan,omitempty"`
+	// ShareInvitations holds the value of the share_invitations edge.
+	ShareInvitations []*ShareInvitation `json:"share_invitations,omitempty"`
+	// ShareLinks holds the value of the share_links edge.
+	ShareLinks []*ShareLink `json:"share_links,omitempty"`
+	// Modifications holds the value of the modifications edge.
+	Modifications []*LoanModification `json:"modifications,omitempty"`
+	// Deferrals holds the value of the deferrals edge.
+	Deferrals []*PaymentDeferral `json:"deferrals,omitempty"`
+	// Recasts holds the value of the recasts edge.
+	Recasts []*LoanRecast `json:"recasts,omitempty"`
+	// IncomeDrivenPlan holds the value of the income_driven_plan edge.
+	IncomeDrivenPlan *IncomeDrivenPlan `json:"income_driven_plan,omitempty"`
+	// Disbursements holds the value of the disbursements edge.
+	Disbursements []*LoanDisbursement `json:"disbursements,omitempty"`
+	// EscrowItems holds the value of the escrow_items edge.
+	EscrowItems []*EscrowItem `json:"escrow_items,omitempty"`
+	// Obligors holds the value of the obligors edge.
+	Obligors []*LoanObligor `json:"obligors,omitempty"`
+	// Collateral holds the value of the collateral edge.
+	Collateral []*Collateral `json:"collateral,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [12]bool
 }
 
 // BorrowerOrErr returns the Borrower value or an error if the edge
@@ -64,15 +102,111 @@ func (e LoanEdges) SharedLoanOrErr() ([]*SharedLoan, error) {
 	return nil, &NotLoadedError{edge: "shared_loan"}
 }
 
+// ShareInvitationsOrErr returns the ShareInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) ShareInvitationsOrErr() ([]*ShareInvitation, error) {
+	if e.loadedTypes[2] {
+		return e.ShareInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "share_invitations"}
+}
+
+// ShareLinksOrErr returns the ShareLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) ShareLinksOrErr() ([]*ShareLink, error) {
+	if e.loadedTypes[3] {
+		return e.ShareLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "share_links"}
+}
+
+// ModificationsOrErr returns the Modifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) ModificationsOrErr() ([]*LoanModification, error) {
+	if e.loadedTypes[4] {
+		return e.Modifications, nil
+	}
+	return nil, &NotLoadedError{edge: "modifications"}
+}
+
+// DeferralsOrErr returns the Deferrals value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) DeferralsOrErr() ([]*PaymentDeferral, error) {
+	if e.loadedTypes[5] {
+		return e.Deferrals, nil
+	}
+	return nil, &NotLoadedError{edge: "deferrals"}
+}
+
+// RecastsOrErr returns the Recasts value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) RecastsOrErr() ([]*LoanRecast, error) {
+	if e.loadedTypes[6] {
+		return e.Recasts, nil
+	}
+	return nil, &NotLoadedError{edge: "recasts"}
+}
+
+// IncomeDrivenPlanOrErr returns the IncomeDrivenPlan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) IncomeDrivenPlanOrErr() (*IncomeDrivenPlan, error) {
+	if e.loadedTypes[7] {
+		if e.IncomeDrivenPlan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: incomedrivenplan.Label}
+		}
+		return e.IncomeDrivenPlan, nil
+	}
+	return nil, &NotLoadedError{edge: "income_driven_plan"}
+}
+
+// DisbursementsOrErr returns the Disbursements value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) DisbursementsOrErr() ([]*LoanDisbursement, error) {
+	if e.loadedTypes[8] {
+		return e.Disbursements, nil
+	}
+	return nil, &NotLoadedError{edge: "disbursements"}
+}
+
+// EscrowItemsOrErr returns the EscrowItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) EscrowItemsOrErr() ([]*EscrowItem, error) {
+	if e.loadedTypes[9] {
+		return e.EscrowItems, nil
+	}
+	return nil, &NotLoadedError{edge: "escrow_items"}
+}
+
+// ObligorsOrErr returns the Obligors value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) ObligorsOrErr() ([]*LoanObligor, error) {
+	if e.loadedTypes[10] {
+		return e.Obligors, nil
+	}
+	return nil, &NotLoadedError{edge: "obligors"}
+}
+
+// CollateralOrErr returns the Collateral value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) CollateralOrErr() ([]*Collateral, error) {
+	if e.loadedTypes[11] {
+		return e.Collateral, nil
+	}
+	return nil, &NotLoadedError{edge: "collateral"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Loan) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case loan.FieldRate:
+		case loan.FieldRate, loan.FieldGraduatedStepRate, loan.FieldNegativeAmortizationCap, loan.FieldPmiRate:
 			values[i] = new(sql.NullFloat64)
-		case loan.FieldID, loan.FieldAmount, loan.FieldTerm, loan.FieldBorrowerID:
+		case loan.FieldID, loan.FieldAmount, loan.FieldTerm, loan.FieldBorrowerID, loan.FieldGraduatedYears, loan.FieldConstructionMonths, loan.FieldPropertyValue, loan.FieldPmiCancellationMonth:
 			values[i] = new(sql.NullInt64)
+		case loan.FieldOriginatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -118,6 +252,54 @@ func (l *Loan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				l.BorrowerID = int(value.Int64)
 			}
+		case loan.FieldGraduatedStepRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field graduated_step_rate", values[i])
+			} else if value.Valid {
+				l.GraduatedStepRate = value.Float64
+			}
+		case loan.FieldGraduatedYears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field graduated_years", values[i])
+			} else if value.Valid {
+				l.GraduatedYears = int(value.Int64)
+			}
+		case loan.FieldNegativeAmortizationCap:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field negative_amortization_cap", values[i])
+			} else if value.Valid {
+				l.NegativeAmortizationCap = value.Float64
+			}
+		case loan.FieldConstructionMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field construction_months", values[i])
+			} else if value.Valid {
+				l.ConstructionMonths = int(value.Int64)
+			}
+		case loan.FieldPropertyValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field property_value", values[i])
+			} else if value.Valid {
+				l.PropertyValue = int(value.Int64)
+			}
+		case loan.FieldPmiRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_rate", values[i])
+			} else if value.Valid {
+				l.PmiRate = value.Float64
+			}
+		case loan.FieldPmiCancellationMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pmi_cancellation_month", values[i])
+			} else if value.Valid {
+				l.PmiCancellationMonth = int(value.Int64)
+			}
+		case loan.FieldOriginatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field originated_at", values[i])
+			} else if value.Valid {
+				l.OriginatedAt = value.Time
+			}
 		default:
 			l.selectValues.Set(columns[i], values[i])
 		}
@@ -141,6 +323,56 @@ func (l *Loan) QuerySharedLoan() *SharedLoanQuery {
 	return NewLoanClient(l.config).QuerySharedLoan(l)
 }
 
+// QueryShareInvitations queries the "share_invitations" edge of the Loan entity.
+func (l *Loan) QueryShareInvitations() *ShareInvitationQuery {
+	return NewLoanClient(l.config).QueryShareInvitations(l)
+}
+
+// QueryShareLinks queries the "share_links" edge of the Loan entity.
+func (l *Loan) QueryShareLinks() *ShareLinkQuery {
+	return NewLoanClient(l.config).QueryShareLinks(l)
+}
+
+// QueryModifications queries the "modifications" edge of the Loan entity.
+func (l *Loan) QueryModifications() *LoanModificationQuery {
+	return NewLoanClient(l.config).QueryModifications(l)
+}
+
+// QueryDeferrals queries the "deferrals" edge of the Loan entity.
+func (l *Loan) QueryDeferrals() *PaymentDeferralQuery {
+	return NewLoanClient(l.config).QueryDeferrals(l)
+}
+
+// QueryRecasts queries the "recasts" edge of the Loan entity.
+func (l *Loan) QueryRecasts() *LoanRecastQuery {
+	return NewLoanClient(l.config).QueryRecasts(l)
+}
+
+// QueryIncomeDrivenPlan queries the "income_driven_plan" edge of the Loan entity.
+func (l *Loan) QueryIncomeDrivenPlan() *IncomeDrivenPlanQuery {
+	return NewLoanClient(l.config).QueryIncomeDrivenPlan(l)
+}
+
+// QueryDisbursements queries the "disbursements" edge of the Loan entity.
+func (l *Loan) QueryDisbursements() *LoanDisbursementQuery {
+	return NewLoanClient(l.config).QueryDisbursements(l)
+}
+
+// QueryEscrowItems queries the "escrow_items" edge of the Loan entity.
+func (l *Loan) QueryEscrowItems() *EscrowItemQuery {
+	return NewLoanClient(l.config).QueryEscrowItems(l)
+}
+
+// QueryObligors queries the "obligors" edge of the Loan entity.
+func (l *Loan) QueryObligors() *LoanObligorQuery {
+	return NewLoanClient(l.config).QueryObligors(l)
+}
+
+// QueryCollateral queries the "collateral" edge of the Loan entity.
+func (l *Loan) QueryCollateral() *CollateralQuery {
+	return NewLoanClient(l.config).QueryCollateral(l)
+}
+
 // Update returns a builder for updating this Loan.
 // Note that you need to call Loan.Unwrap() before calling this method if this Loan
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -175,6 +407,30 @@ func (l *Loan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("borrower_id=")
 	builder.WriteString(fmt.Sprintf("%v", l.BorrowerID))
+	builder.WriteString(", ")
+	builder.WriteString("graduated_step_rate=")
+	builder.WriteString(fmt.Sprintf("%v", l.GraduatedStepRate))
+	builder.WriteString(", ")
+	builder.WriteString("graduated_years=")
+	builder.WriteString(fmt.Sprintf("%v", l.GraduatedYears))
+	builder.WriteString(", ")
+	builder.WriteString("negative_amortization_cap=")
+	builder.WriteString(fmt.Sprintf("%v", l.NegativeAmortizationCap))
+	builder.WriteString(", ")
+	builder.WriteString("construction_months=")
+	builder.WriteString(fmt.Sprintf("%v", l.ConstructionMonths))
+	builder.WriteString(", ")
+	builder.WriteString("property_value=")
+	builder.WriteString(fmt.Sprintf("%v", l.PropertyValue))
+	builder.WriteString(", ")
+	builder.WriteString("pmi_rate=")
+	builder.WriteString(fmt.Sprintf("%v", l.PmiRate))
+	builder.WriteString(", ")
+	builder.WriteString("pmi_cancellation_month=")
+	builder.WriteString(fmt.Sprintf("%v", l.PmiCancellationMonth))
+	builder.WriteString(", ")
+	builder.WriteString("originated_at=")
+	builder.WriteString(l.OriginatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
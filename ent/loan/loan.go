@@ -3,6 +3,9 @@
 package loan
 
 import (
+	"time"
+
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,10 +23,46 @@ const (
 	FieldTerm = "term"
 	// FieldBorrowerID holds the string denoting the borrower_id field in the database.
 	FieldBorrowerID = "borrower_id"
+	// FieldGraduatedStepRate holds the string denoting the graduated_step_rate field in the database.
+	FieldGraduatedStepRate = "graduated_step_rate"
+	// FieldGraduatedYears holds the string denoting the graduated_years field in the database.
+	FieldGraduatedYears = "graduated_years"
+	// FieldNegativeAmortizationCap holds the string denoting the negative_amortization_cap field in the database.
+	FieldNegativeAmortizationCap = "negative_amortization_cap"
+	// FieldConstructionMonths holds the string denoting the construction_months field in the database.
+	FieldConstructionMonths = "construction_months"
+	// FieldPropertyValue holds the string denoting the property_value field in the database.
+	FieldPropertyValue = "property_value"
+	// FieldPmiRate holds the string denoting the pmi_rate field in the database.
+	FieldPmiRate = "pmi_rate"
+	// FieldPmiCancellationMonth holds the string denoting the pmi_cancellation_month field in the database.
+	FieldPmiCancellationMonth = "pmi_cancellation_month"
+	// FieldOriginatedAt holds the string denoting the originated_at field in the database.
+	FieldOriginatedAt = "originated_at"
 	// EdgeBorrower holds the string denoting the borrower edge name in mutations.
 	EdgeBorrower = "borrower"
 	// EdgeSharedLoan holds the string denoting the shared_loan edge name in mutations.
 	EdgeSharedLoan = "shared_loan"
+	// EdgeShareInvitations holds the string denoting the share_invitations edge name in mutations.
+	EdgeShareInvitations = "share_invitations"
+	// EdgeShareLinks holds the string denoting the share_links edge name in mutations.
+	EdgeShareLinks = "share_links"
+	// EdgeModifications holds the string denoting the modifications edge name in mutations.
+	EdgeModifications = "modifications"
+	// EdgeDeferrals holds the string denoting the deferrals edge name in mutations.
+	EdgeDeferrals = "deferrals"
+	// EdgeRecasts holds the string denoting the recasts edge name in mutations.
+	EdgeRecasts = "recasts"
+	// EdgeIncomeDrivenPlan holds the string denoting the income_driven_plan edge name in mutations.
+	EdgeIncomeDrivenPlan = "income_driven_plan"
+	// EdgeDisbursements holds the string denoting the disbursements edge name in mutations.
+	EdgeDisbursements = "disbursements"
+	// EdgeEscrowItems holds the string denoting the escrow_items edge name in mutations.
+	EdgeEscrowItems = "escrow_items"
+	// EdgeObligors holds the string denoting the obligors edge name in mutations.
+	EdgeObligors = "obligors"
+	// EdgeCollateral holds the string denoting the collateral edge name in mutations.
+	EdgeCollateral = "collateral"
 	// Table holds the table name of the loan in the database.
 	Table = "loans"
 	// BorrowerTable is the table that holds the borrower relation/edge.
@@ -40,6 +79,74 @@ const (
 	SharedLoanInverseTable = "shared_loans"
 	// SharedLoanColumn is the table column denoting the shared_loan relation/edge.
 	SharedLoanColumn = "loan_id"
+	// ShareInvitationsTable is the table that holds the share_invitations relation/edge.
+	ShareInvitationsTable = "share_invitations"
+	// ShareInvitationsInverseTable is the table name for the ShareInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "shareinvitation" package.
+	ShareInvitationsInverseTable = "share_invitations"
+	// ShareInvitationsColumn is the table column denoting the share_invitations relation/edge.
+	ShareInvitationsColumn = "loan_id"
+	// ShareLinksTable is the table that holds the share_links relation/edge.
+	ShareLinksTable = "share_links"
+	// ShareLinksInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinksInverseTable = "share_links"
+	// ShareLinksColumn is the table column denoting the share_links relation/edge.
+	ShareLinksColumn = "loan_id"
+	// ModificationsTable is the table that holds the modifications relation/edge.
+	ModificationsTable = "loan_modifications"
+	// ModificationsInverseTable is the table name for the LoanModification entity.
+	// It exists in this package in order to avoid circular dependency with the "loanmodification" package.
+	ModificationsInverseTable = "loan_modifications"
+	// ModificationsColumn is the table column denoting the modifications relation/edge.
+	ModificationsColumn = "loan_id"
+	// DeferralsTable is the table that holds the deferrals relation/edge.
+	DeferralsTable = "payment_deferrals"
+	// DeferralsInverseTable is the table name for the PaymentDeferral entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentdeferral" package.
+	DeferralsInverseTable = "payment_deferrals"
+	// DeferralsColumn is the table column denoting the deferrals relation/edge.
+	DeferralsColumn = "loan_id"
+	// RecastsTable is the table that holds the recasts relation/edge.
+	RecastsTable = "loan_recasts"
+	// RecastsInverseTable is the table name for the LoanRecast entity.
+	// It exists in this package in order to avoid circular dependency with the "loanrecast" package.
+	RecastsInverseTable = "loan_recasts"
+	// RecastsColumn is the table column denoting the recasts relation/edge.
+	RecastsColumn = "loan_id"
+	// IncomeDrivenPlanTable is the table that holds the income_driven_plan relation/edge.
+	IncomeDrivenPlanTable = "income_driven_plans"
+	// IncomeDrivenPlanInverseTable is the table name for the IncomeDrivenPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "incomedrivenplan" package.
+	IncomeDrivenPlanInverseTable = "income_driven_plans"
+	// IncomeDrivenPlanColumn is the table column denoting the income_driven_plan relation/edge.
+	IncomeDrivenPlanColumn = "loan_id"
+	// DisbursementsTable is the table that holds the disbursements relation/edge.
+	DisbursementsTable = "loan_disbursements"
+	// DisbursementsInverseTable is the table name for the LoanDisbursement entity.
+	// It exists in this package in order to avoid circular dependency with the "loandisbursement" package.
+	DisbursementsInverseTable = "loan_disbursements"
+	// DisbursementsColumn is the table column denoting the disbursements relation/edge.
+	DisbursementsColumn = "loan_id"
+	// EscrowItemsTable is the table that holds the escrow_items relation/edge.
+	EscrowItemsTable = "escrow_items"
+	// EscrowItemsInverseTable is the table name for the EscrowItem entity.
+	// It exists in this package in order to avoid circular dependency with the "escrowitem" package.
+	EscrowItemsInverseTable = "escrow_items"
+	// EscrowItemsColumn is the table column denoting the escrow_items relation/edge.
+	EscrowItemsColumn = "loan_id"
+	// ObligorsTable is the table that holds the obligors relation/edge.
+	ObligorsTable = "loan_obligors"
+	// ObligorsInverseTable is the table name for the LoanObligor entity.
+	// It exists in this package in order to avoid circular dependency with the "loanobligor" package.
+	ObligorsInverseTable = "loan_obligors"
+	// ObligorsColumn is the table column denoting the obligors relation/edge.
+	ObligorsColumn = "loan_id"
+	// CollateralTable is the table that holds the collateral relation/edge. The primary key declared below.
+	CollateralTable = "collateral_loans"
+	// CollateralInverseTable is the table name for the Collateral entity.
+	// It exists in this package in order to avoid circular dependency with the "collateral" package.
+	CollateralInverseTable = "collaterals"
 )
 
 // Columns holds all SQL columns for loan fields.
@@ -49,8 +156,22 @@ var Columns = []string{
 	FieldRate,
 	FieldTerm,
 	FieldBorrowerID,
+	FieldGraduatedStepRate,
+	FieldGraduatedYears,
+	FieldNegativeAmortizationCap,
+	FieldConstructionMonths,
+	FieldPropertyValue,
+	FieldPmiRate,
+	FieldPmiCancellationMonth,
+	FieldOriginatedAt,
 }
 
+var (
+	// CollateralPrimaryKey and CollateralColumn2 are the table columns denoting the
+	// primary key for the collateral relation (M2M).
+	CollateralPrimaryKey = []string{"collateral_id", "loan_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -61,6 +182,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/crusyn/loans/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultOriginatedAt holds the default value on creation for the "originated_at" field.
+	DefaultOriginatedAt func() time.Time
+)
+
 // OrderOption defines the ordering options for the Loan queries.
 type OrderOption func(*sql.Selector)
 
@@ -89,6 +222,46 @@ func ByBorrowerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBorrowerID, opts...).ToFunc()
 }
 
+// ByGraduatedStepRate orders the results by the graduated_step_rate field.
+func ByGraduatedStepRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraduatedStepRate, opts...).ToFunc()
+}
+
+// ByGraduatedYears orders the results by the graduated_years field.
+func ByGraduatedYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraduatedYears, opts...).ToFunc()
+}
+
+// ByNegativeAmortizationCap orders the results by the negative_amortization_cap field.
+func ByNegativeAmortizationCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNegativeAmortizationCap, opts...).ToFunc()
+}
+
+// ByConstructionMonths orders the results by the construction_months field.
+func ByConstructionMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConstructionMonths, opts...).ToFunc()
+}
+
+// ByPropertyValue orders the results by the property_value field.
+func ByPropertyValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPropertyValue, opts...).ToFunc()
+}
+
+// ByPmiRate orders the results by the pmi_rate field.
+func ByPmiRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPmiRate, opts...).ToFunc()
+}
+
+// ByPmiCancellationMonth orders the results by the pmi_cancellation_month field.
+func ByPmiCancellationMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPmiCancellationMonth, opts...).ToFunc()
+}
+
+// ByOriginatedAt orders the results by the originated_at field.
+func ByOriginatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginatedAt, opts...).ToFunc()
+}
+
 // ByBorrowerField orders the results by borrower field.
 func ByBorrowerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -109,6 +282,139 @@ func BySharedLoan(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSharedLoanStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByShareInvitationsCount orders the results by share_invitations count.
+func ByShareInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareInvitationsStep(), opts...)
+	}
+}
+
+// ByShareInvitations orders the results by share_invitations terms.
+func ByShareInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareLinksCount orders the results by share_links count.
+func ByShareLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareLinksStep(), opts...)
+	}
+}
+
+// ByShareLinks orders the results by share_links terms.
+func ByShareLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModificationsCount orders the results by modifications count.
+func ByModificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModificationsStep(), opts...)
+	}
+}
+
+// ByModifications orders the results by modifications terms.
+func ByModifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeferralsCount orders the results by deferrals count.
+func ByDeferralsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeferralsStep(), opts...)
+	}
+}
+
+// ByDeferrals orders the results by deferrals terms.
+func ByDeferrals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeferralsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecastsCount orders the results by recasts count.
+func ByRecastsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecastsStep(), opts...)
+	}
+}
+
+// ByRecasts orders the results by recasts terms.
+func ByRecasts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecastsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIncomeDrivenPlanField orders the results by income_driven_plan field.
+func ByIncomeDrivenPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIncomeDrivenPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDisbursementsCount orders the results by disbursements count.
+func ByDisbursementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisbursementsStep(), opts...)
+	}
+}
+
+// ByDisbursements orders the results by disbursements terms.
+func ByDisbursements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisbursementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEscrowItemsCount orders the results by escrow_items count.
+func ByEscrowItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEscrowItemsStep(), opts...)
+	}
+}
+
+// ByEscrowItems orders the results by escrow_items terms.
+func ByEscrowItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEscrowItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByObligorsCount orders the results by obligors count.
+func ByObligorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newObligorsStep(), opts...)
+	}
+}
+
+// ByObligors orders the results by obligors terms.
+func ByObligors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newObligorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCollateralCount orders the results by collateral count.
+func ByCollateralCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollateralStep(), opts...)
+	}
+}
+
+// ByCollateral orders the results by collateral terms.
+func ByCollateral(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollateralStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBorrowerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -123,3 +429,73 @@ func newSharedLoanStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SharedLoanTable, SharedLoanColumn),
 	)
 }
+func newShareInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareInvitationsTable, ShareInvitationsColumn),
+	)
+}
+func newShareLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+	)
+}
+func newModificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModificationsTable, ModificationsColumn),
+	)
+}
+func newDeferralsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeferralsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeferralsTable, DeferralsColumn),
+	)
+}
+func newRecastsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecastsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecastsTable, RecastsColumn),
+	)
+}
+func newIncomeDrivenPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IncomeDrivenPlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, IncomeDrivenPlanTable, IncomeDrivenPlanColumn),
+	)
+}
+func newDisbursementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisbursementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DisbursementsTable, DisbursementsColumn),
+	)
+}
+func newEscrowItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EscrowItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EscrowItemsTable, EscrowItemsColumn),
+	)
+}
+func newObligorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ObligorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ObligorsTable, ObligorsColumn),
+	)
+}
+func newCollateralStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollateralInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CollateralTable, CollateralPrimaryKey...),
+	)
+}
@@ -3,6 +3,8 @@
 package loan
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/crusyn/loans/ent/predicate"
@@ -73,6 +75,46 @@ func BorrowerID(v int) predicate.Loan {
 	return predicate.Loan(sql.FieldEQ(FieldBorrowerID, v))
 }
 
+// GraduatedStepRate applies equality check predicate on the "graduated_step_rate" field. It's identical to GraduatedStepRateEQ.
+func GraduatedStepRate(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldGraduatedStepRate, v))
+}
+
+// GraduatedYears applies equality check predicate on the "graduated_years" field. It's identical to GraduatedYearsEQ.
+func GraduatedYears(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldGraduatedYears, v))
+}
+
+// NegativeAmortizationCap applies equality check predicate on the "negative_amortization_cap" field. It's identical to NegativeAmortizationCapEQ.
+func NegativeAmortizationCap(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNegativeAmortizationCap, v))
+}
+
+// ConstructionMonths applies equality check predicate on the "construction_months" field. It's identical to ConstructionMonthsEQ.
+func ConstructionMonths(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldConstructionMonths, v))
+}
+
+// PropertyValue applies equality check predicate on the "property_value" field. It's identical to PropertyValueEQ.
+func PropertyValue(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPropertyValue, v))
+}
+
+// PmiRate applies equality check predicate on the "pmi_rate" field. It's identical to PmiRateEQ.
+func PmiRate(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPmiRate, v))
+}
+
+// PmiCancellationMonth applies equality check predicate on the "pmi_cancellation_month" field. It's identical to PmiCancellationMonthEQ.
+func PmiCancellationMonth(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPmiCancellationMonth, v))
+}
+
+// OriginatedAt applies equality check predicate on the "originated_at" field. It's identical to OriginatedAtEQ.
+func OriginatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldOriginatedAt, v))
+}
+
 // AmountEQ applies the EQ predicate on the "amount" field.
 func AmountEQ(v int) predicate.Loan {
 	return predicate.Loan(sql.FieldEQ(FieldAmount, v))
@@ -213,6 +255,396 @@ func BorrowerIDNotIn(vs ...int) predicate.Loan {
 	return predicate.Loan(sql.FieldNotIn(FieldBorrowerID, vs...))
 }
 
+// GraduatedStepRateEQ applies the EQ predicate on the "graduated_step_rate" field.
+func GraduatedStepRateEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateNEQ applies the NEQ predicate on the "graduated_step_rate" field.
+func GraduatedStepRateNEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateIn applies the In predicate on the "graduated_step_rate" field.
+func GraduatedStepRateIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldGraduatedStepRate, vs...))
+}
+
+// GraduatedStepRateNotIn applies the NotIn predicate on the "graduated_step_rate" field.
+func GraduatedStepRateNotIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldGraduatedStepRate, vs...))
+}
+
+// GraduatedStepRateGT applies the GT predicate on the "graduated_step_rate" field.
+func GraduatedStepRateGT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateGTE applies the GTE predicate on the "graduated_step_rate" field.
+func GraduatedStepRateGTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateLT applies the LT predicate on the "graduated_step_rate" field.
+func GraduatedStepRateLT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateLTE applies the LTE predicate on the "graduated_step_rate" field.
+func GraduatedStepRateLTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldGraduatedStepRate, v))
+}
+
+// GraduatedStepRateIsNil applies the IsNil predicate on the "graduated_step_rate" field.
+func GraduatedStepRateIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldGraduatedStepRate))
+}
+
+// GraduatedStepRateNotNil applies the NotNil predicate on the "graduated_step_rate" field.
+func GraduatedStepRateNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldGraduatedStepRate))
+}
+
+// GraduatedYearsEQ applies the EQ predicate on the "graduated_years" field.
+func GraduatedYearsEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsNEQ applies the NEQ predicate on the "graduated_years" field.
+func GraduatedYearsNEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsIn applies the In predicate on the "graduated_years" field.
+func GraduatedYearsIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldGraduatedYears, vs...))
+}
+
+// GraduatedYearsNotIn applies the NotIn predicate on the "graduated_years" field.
+func GraduatedYearsNotIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldGraduatedYears, vs...))
+}
+
+// GraduatedYearsGT applies the GT predicate on the "graduated_years" field.
+func GraduatedYearsGT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsGTE applies the GTE predicate on the "graduated_years" field.
+func GraduatedYearsGTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsLT applies the LT predicate on the "graduated_years" field.
+func GraduatedYearsLT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsLTE applies the LTE predicate on the "graduated_years" field.
+func GraduatedYearsLTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldGraduatedYears, v))
+}
+
+// GraduatedYearsIsNil applies the IsNil predicate on the "graduated_years" field.
+func GraduatedYearsIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldGraduatedYears))
+}
+
+// GraduatedYearsNotNil applies the NotNil predicate on the "graduated_years" field.
+func GraduatedYearsNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldGraduatedYears))
+}
+
+// NegativeAmortizationCapEQ applies the EQ predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapNEQ applies the NEQ predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapNEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapIn applies the In predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldNegativeAmortizationCap, vs...))
+}
+
+// NegativeAmortizationCapNotIn applies the NotIn predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapNotIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldNegativeAmortizationCap, vs...))
+}
+
+// NegativeAmortizationCapGT applies the GT predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapGT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapGTE applies the GTE predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapGTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapLT applies the LT predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapLT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapLTE applies the LTE predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapLTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldNegativeAmortizationCap, v))
+}
+
+// NegativeAmortizationCapIsNil applies the IsNil predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldNegativeAmortizationCap))
+}
+
+// NegativeAmortizationCapNotNil applies the NotNil predicate on the "negative_amortization_cap" field.
+func NegativeAmortizationCapNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldNegativeAmortizationCap))
+}
+
+// ConstructionMonthsEQ applies the EQ predicate on the "construction_months" field.
+func ConstructionMonthsEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsNEQ applies the NEQ predicate on the "construction_months" field.
+func ConstructionMonthsNEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsIn applies the In predicate on the "construction_months" field.
+func ConstructionMonthsIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldConstructionMonths, vs...))
+}
+
+// ConstructionMonthsNotIn applies the NotIn predicate on the "construction_months" field.
+func ConstructionMonthsNotIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldConstructionMonths, vs...))
+}
+
+// ConstructionMonthsGT applies the GT predicate on the "construction_months" field.
+func ConstructionMonthsGT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsGTE applies the GTE predicate on the "construction_months" field.
+func ConstructionMonthsGTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsLT applies the LT predicate on the "construction_months" field.
+func ConstructionMonthsLT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsLTE applies the LTE predicate on the "construction_months" field.
+func ConstructionMonthsLTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldConstructionMonths, v))
+}
+
+// ConstructionMonthsIsNil applies the IsNil predicate on the "construction_months" field.
+func ConstructionMonthsIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldConstructionMonths))
+}
+
+// ConstructionMonthsNotNil applies the NotNil predicate on the "construction_months" field.
+func ConstructionMonthsNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldConstructionMonths))
+}
+
+// PropertyValueEQ applies the EQ predicate on the "property_value" field.
+func PropertyValueEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPropertyValue, v))
+}
+
+// PropertyValueNEQ applies the NEQ predicate on the "property_value" field.
+func PropertyValueNEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldPropertyValue, v))
+}
+
+// PropertyValueIn applies the In predicate on the "property_value" field.
+func PropertyValueIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldPropertyValue, vs...))
+}
+
+// PropertyValueNotIn applies the NotIn predicate on the "property_value" field.
+func PropertyValueNotIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldPropertyValue, vs...))
+}
+
+// PropertyValueGT applies the GT predicate on the "property_value" field.
+func PropertyValueGT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldPropertyValue, v))
+}
+
+// PropertyValueGTE applies the GTE predicate on the "property_value" field.
+func PropertyValueGTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldPropertyValue, v))
+}
+
+// PropertyValueLT applies the LT predicate on the "property_value" field.
+func PropertyValueLT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldPropertyValue, v))
+}
+
+// PropertyValueLTE applies the LTE predicate on the "property_value" field.
+func PropertyValueLTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldPropertyValue, v))
+}
+
+// PropertyValueIsNil applies the IsNil predicate on the "property_value" field.
+func PropertyValueIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldPropertyValue))
+}
+
+// PropertyValueNotNil applies the NotNil predicate on the "property_value" field.
+func PropertyValueNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldPropertyValue))
+}
+
+// PmiRateEQ applies the EQ predicate on the "pmi_rate" field.
+func PmiRateEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPmiRate, v))
+}
+
+// PmiRateNEQ applies the NEQ predicate on the "pmi_rate" field.
+func PmiRateNEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldPmiRate, v))
+}
+
+// PmiRateIn applies the In predicate on the "pmi_rate" field.
+func PmiRateIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldPmiRate, vs...))
+}
+
+// PmiRateNotIn applies the NotIn predicate on the "pmi_rate" field.
+func PmiRateNotIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldPmiRate, vs...))
+}
+
+// PmiRateGT applies the GT predicate on the "pmi_rate" field.
+func PmiRateGT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldPmiRate, v))
+}
+
+// PmiRateGTE applies the GTE predicate on the "pmi_rate" field.
+func PmiRateGTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldPmiRate, v))
+}
+
+// PmiRateLT applies the LT predicate on the "pmi_rate" field.
+func PmiRateLT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldPmiRate, v))
+}
+
+// PmiRateLTE applies the LTE predicate on the "pmi_rate" field.
+func PmiRateLTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldPmiRate, v))
+}
+
+// PmiRateIsNil applies the IsNil predicate on the "pmi_rate" field.
+func PmiRateIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldPmiRate))
+}
+
+// PmiRateNotNil applies the NotNil predicate on the "pmi_rate" field.
+func PmiRateNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldPmiRate))
+}
+
+// PmiCancellationMonthEQ applies the EQ predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthNEQ applies the NEQ predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthNEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthIn applies the In predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldPmiCancellationMonth, vs...))
+}
+
+// PmiCancellationMonthNotIn applies the NotIn predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthNotIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldPmiCancellationMonth, vs...))
+}
+
+// PmiCancellationMonthGT applies the GT predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthGT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthGTE applies the GTE predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthGTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthLT applies the LT predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthLT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthLTE applies the LTE predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthLTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldPmiCancellationMonth, v))
+}
+
+// PmiCancellationMonthIsNil applies the IsNil predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldPmiCancellationMonth))
+}
+
+// PmiCancellationMonthNotNil applies the NotNil predicate on the "pmi_cancellation_month" field.
+func PmiCancellationMonthNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldPmiCancellationMonth))
+}
+
+// OriginatedAtEQ applies the EQ predicate on the "originated_at" field.
+func OriginatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldOriginatedAt, v))
+}
+
+// OriginatedAtNEQ applies the NEQ predicate on the "originated_at" field.
+func OriginatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldOriginatedAt, v))
+}
+
+// OriginatedAtIn applies the In predicate on the "originated_at" field.
+func OriginatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldOriginatedAt, vs...))
+}
+
+// OriginatedAtNotIn applies the NotIn predicate on the "originated_at" field.
+func OriginatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldOriginatedAt, vs...))
+}
+
+// OriginatedAtGT applies the GT predicate on the "originated_at" field.
+func OriginatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldOriginatedAt, v))
+}
+
+// OriginatedAtGTE applies the GTE predicate on the "originated_at" field.
+func OriginatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldOriginatedAt, v))
+}
+
+// OriginatedAtLT applies the LT predicate on the "originated_at" field.
+func OriginatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldOriginatedAt, v))
+}
+
+// OriginatedAtLTE applies the LTE predicate on the "originated_at" field.
+func OriginatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldOriginatedAt, v))
+}
+
 // HasBorrower applies the HasEdge predicate on the "borrower" edge.
 func HasBorrower() predicate.Loan {
 	return predicate.Loan(func(s *sql.Selector) {
@@ -259,6 +691,236 @@ func HasSharedLoanWith(preds ...predicate.SharedLoan) predicate.Loan {
 	})
 }
 
+// HasShareInvitations applies the HasEdge predicate on the "share_invitations" edge.
+func HasShareInvitations() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareInvitationsTable, ShareInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareInvitationsWith applies the HasEdge predicate on the "share_invitations" edge with a given conditions (other predicates).
+func HasShareInvitationsWith(preds ...predicate.ShareInvitation) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newShareInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShareLinks applies the HasEdge predicate on the "share_links" edge.
+func HasShareLinks() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareLinksTable, ShareLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinksWith applies the HasEdge predicate on the "share_links" edge with a given conditions (other predicates).
+func HasShareLinksWith(preds ...predicate.ShareLink) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newShareLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModifications applies the HasEdge predicate on the "modifications" edge.
+func HasModifications() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModificationsTable, ModificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModificationsWith applies the HasEdge predicate on the "modifications" edge with a given conditions (other predicates).
+func HasModificationsWith(preds ...predicate.LoanModification) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newModificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeferrals applies the HasEdge predicate on the "deferrals" edge.
+func HasDeferrals() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeferralsTable, DeferralsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeferralsWith applies the HasEdge predicate on the "deferrals" edge with a given conditions (other predicates).
+func HasDeferralsWith(preds ...predicate.PaymentDeferral) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newDeferralsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecasts applies the HasEdge predicate on the "recasts" edge.
+func HasRecasts() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecastsTable, RecastsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecastsWith applies the HasEdge predicate on the "recasts" edge with a given conditions (other predicates).
+func HasRecastsWith(preds ...predicate.LoanRecast) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newRecastsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomeDrivenPlan applies the HasEdge predicate on the "income_driven_plan" edge.
+func HasIncomeDrivenPlan() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, IncomeDrivenPlanTable, IncomeDrivenPlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomeDrivenPlanWith applies the HasEdge predicate on the "income_driven_plan" edge with a given conditions (other predicates).
+func HasIncomeDrivenPlanWith(preds ...predicate.IncomeDrivenPlan) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newIncomeDrivenPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDisbursements applies the HasEdge predicate on the "disbursements" edge.
+func HasDisbursements() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DisbursementsTable, DisbursementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDisbursementsWith applies the HasEdge predicate on the "disbursements" edge with a given conditions (other predicates).
+func HasDisbursementsWith(preds ...predicate.LoanDisbursement) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newDisbursementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEscrowItems applies the HasEdge predicate on the "escrow_items" edge.
+func HasEscrowItems() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EscrowItemsTable, EscrowItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEscrowItemsWith applies the HasEdge predicate on the "escrow_items" edge with a given conditions (other predicates).
+func HasEscrowItemsWith(preds ...predicate.EscrowItem) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newEscrowItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasObligors applies the HasEdge predicate on the "obligors" edge.
+func HasObligors() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ObligorsTable, ObligorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasObligorsWith applies the HasEdge predicate on the "obligors" edge with a given conditions (other predicates).
+func HasObligorsWith(preds ...predicate.LoanObligor) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newObligorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollateral applies the HasEdge predicate on the "collateral" edge.
+func HasCollateral() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CollateralTable, CollateralPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollateralWith applies the HasEdge predicate on the "collateral" edge with a given conditions (other predicates).
+func HasCollateralWith(preds ...predicate.Collateral) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newCollateralStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Loan) predicate.Loan {
 	return predicate.Loan(sql.AndPredicates(predicates...))
@@ -6,11 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/paymentdeferral"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
 	"github.com/crusyn/loans/ent/user"
 )
 
@@ -45,6 +56,118 @@ func (lc *LoanCreate) SetBorrowerID(i int) *LoanCreate {
 	return lc
 }
 
+// SetGraduatedStepRate sets the "graduated_step_rate" field.
+func (lc *LoanCreate) SetGraduatedStepRate(f float64) *LoanCreate {
+	lc.mutation.SetGraduatedStepRate(f)
+	return lc
+}
+
+// SetNillableGraduatedStepRate sets the "graduated_step_rate" field if the given value is not nil.
+func (lc *LoanCreate) SetNillableGraduatedStepRate(f *float64) *LoanCreate {
+	if f != nil {
+		lc.SetGraduatedStepRate(*f)
+	}
+	return lc
+}
+
+// SetGraduatedYears sets the "graduated_years" field.
+func (lc *LoanCreate) SetGraduatedYears(i int) *LoanCreate {
+	lc.mutation.SetGraduatedYears(i)
+	return lc
+}
+
+// SetNillableGraduatedYears sets the "graduated_years" field if the given value is not nil.
+func (lc *LoanCreate) SetNillableGraduatedYears(i *int) *LoanCreate {
+	if i != nil {
+		lc.SetGraduatedYears(*i)
+	}
+	return lc
+}
+
+// SetNegativeAmortizationCap sets the "negative_amortization_cap" field.
+func (lc *LoanCreate) SetNegativeAmortizationCap(f float64) *LoanCreate {
+	lc.mutation.SetNegativeAmortizationCap(f)
+	return lc
+}
+
+// SetNillableNegativeAmortizationCap sets the "negative_amortization_cap" field if the given value is not nil.
+func (lc *LoanCreate) SetNillableNegativeAmortizationCap(f *float64) *LoanCreate {
+	if f != nil {
+		lc.SetNegativeAmortizationCap(*f)
+	}
+	return lc
+}
+
+// SetConstructionMonths sets the "construction_months" field.
+func (lc *LoanCreate) SetConstructionMonths(i int) *LoanCreate {
+	lc.mutation.SetConstructionMonths(i)
+	return lc
+}
+
+// SetNillableConstructionMonths sets the "construction_months" field if the given value is not nil.
+func (lc *LoanCreate) SetNillableConstructionMonths(i *int) *LoanCreate {
+	if i != nil {
+		lc.SetConstructionMonths(*i)
+	}
+	return lc
+}
+
+// SetPropertyValue sets the "property_value" field.
+func (lc *LoanCreate) SetPropertyValue(i int) *LoanCreate {
+	lc.mutation.SetPropertyValue(i)
+	return lc
+}
+
+// SetNillablePropertyValue sets the "property_value" field if the given value is not nil.
+func (lc *LoanCreate) SetNillablePropertyValue(i *int) *LoanCreate {
+	if i != nil {
+		lc.SetPropertyValue(*i)
+	}
+	return lc
+}
+
+// SetPmiRate sets the "pmi_rate" field.
+func (lc *LoanCreate) SetPmiRate(f float64) *LoanCreate {
+	lc.mutation.SetPmiRate(f)
+	return lc
+}
+
+// SetNillablePmiRate sets the "pmi_rate" field if the given value is not nil.
+func (lc *LoanCreate) SetNillablePmiRate(f *float64) *LoanCreate {
+	if f != nil {
+		lc.SetPmiRate(*f)
+	}
+	return lc
+}
+
+// SetPmiCancellationMonth sets the "pmi_cancellation_month" field.
+func (lc *LoanCreate) SetPmiCancellationMonth(i int) *LoanCreate {
+	lc.mutation.SetPmiCancellationMonth(i)
+	return lc
+}
+
+// SetNillablePmiCancellationMonth sets the "pmi_cancellation_month" field if the given value is not nil.
+func (lc *LoanCreate) SetNillablePmiCancellationMonth(i *int) *LoanCreate {
+	if i != nil {
+		lc.SetPmiCancellationMonth(*i)
+	}
+	return lc
+}
+
+// SetOriginatedAt sets the "originated_at" field.
+func (lc *LoanCreate) SetOriginatedAt(t time.Time) *LoanCreate {
+	lc.mutation.SetOriginatedAt(t)
+	return lc
+}
+
+// SetNillableOriginatedAt sets the "originated_at" field if the given value is not nil.
+func (lc *LoanCreate) SetNillableOriginatedAt(t *time.Time) *LoanCreate {
+	if t != nil {
+		lc.SetOriginatedAt(*t)
+	}
+	return lc
+}
+
 // SetBorrower sets the "borrower" edge to the User entity.
 func (lc *LoanCreate) SetBorrower(u *User) *LoanCreate {
 	return lc.SetBorrowerID(u.ID)
@@ -65,6 +188,160 @@ func (lc *LoanCreate) AddSharedLoan(s ...*SharedLoan) *LoanCreate {
 	return lc.AddSharedLoanIDs(ids...)
 }
 
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (lc *LoanCreate) AddShareInvitationIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddShareInvitationIDs(ids...)
+	return lc
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (lc *LoanCreate) AddShareInvitations(s ...*ShareInvitation) *LoanCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lc.AddShareInvitationIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (lc *LoanCreate) AddShareLinkIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddShareLinkIDs(ids...)
+	return lc
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (lc *LoanCreate) AddShareLinks(s ...*ShareLink) *LoanCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lc.AddShareLinkIDs(ids...)
+}
+
+// AddModificationIDs adds the "modifications" edge to the LoanModification entity by IDs.
+func (lc *LoanCreate) AddModificationIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddModificationIDs(ids...)
+	return lc
+}
+
+// AddModifications adds the "modifications" edges to the LoanModification entity.
+func (lc *LoanCreate) AddModifications(l ...*LoanModification) *LoanCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddModificationIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the PaymentDeferral entity by IDs.
+func (lc *LoanCreate) AddDeferralIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddDeferralIDs(ids...)
+	return lc
+}
+
+// AddDeferrals adds the "deferrals" edges to the PaymentDeferral entity.
+func (lc *LoanCreate) AddDeferrals(p ...*PaymentDeferral) *LoanCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lc.AddDeferralIDs(ids...)
+}
+
+// AddRecastIDs adds the "recasts" edge to the LoanRecast entity by IDs.
+func (lc *LoanCreate) AddRecastIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddRecastIDs(ids...)
+	return lc
+}
+
+// AddRecasts adds the "recasts" edges to the LoanRecast entity.
+func (lc *LoanCreate) AddRecasts(l ...*LoanRecast) *LoanCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddRecastIDs(ids...)
+}
+
+// SetIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID.
+func (lc *LoanCreate) SetIncomeDrivenPlanID(id int) *LoanCreate {
+	lc.mutation.SetIncomeDrivenPlanID(id)
+	return lc
+}
+
+// SetNillableIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID if the given value is not nil.
+func (lc *LoanCreate) SetNillableIncomeDrivenPlanID(id *int) *LoanCreate {
+	if id != nil {
+		lc = lc.SetIncomeDrivenPlanID(*id)
+	}
+	return lc
+}
+
+// SetIncomeDrivenPlan sets the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (lc *LoanCreate) SetIncomeDrivenPlan(i *IncomeDrivenPlan) *LoanCreate {
+	return lc.SetIncomeDrivenPlanID(i.ID)
+}
+
+// AddDisbursementIDs adds the "disbursements" edge to the LoanDisbursement entity by IDs.
+func (lc *LoanCreate) AddDisbursementIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddDisbursementIDs(ids...)
+	return lc
+}
+
+// AddDisbursements adds the "disbursements" edges to the LoanDisbursement entity.
+func (lc *LoanCreate) AddDisbursements(l ...*LoanDisbursement) *LoanCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddDisbursementIDs(ids...)
+}
+
+// AddEscrowItemIDs adds the "escrow_items" edge to the EscrowItem entity by IDs.
+func (lc *LoanCreate) AddEscrowItemIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddEscrowItemIDs(ids...)
+	return lc
+}
+
+// AddEscrowItems adds the "escrow_items" edges to the EscrowItem entity.
+func (lc *LoanCreate) AddEscrowItems(e ...*EscrowItem) *LoanCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lc.AddEscrowItemIDs(ids...)
+}
+
+// AddObligorIDs adds the "obligors" edge to the LoanObligor entity by IDs.
+func (lc *LoanCreate) AddObligorIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddObligorIDs(ids...)
+	return lc
+}
+
+// AddObligors adds the "obligors" edges to the LoanObligor entity.
+func (lc *LoanCreate) AddObligors(l ...*LoanObligor) *LoanCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lc.AddObligorIDs(ids...)
+}
+
+// AddCollateralIDs adds the "collateral" edge to the Collateral entity by IDs.
+func (lc *LoanCreate) AddCollateralIDs(ids ...int) *LoanCreate {
+	lc.mutation.AddCollateralIDs(ids...)
+	return lc
+}
+
+// AddCollateral adds the "collateral" edges to the Collateral entity.
+func (lc *LoanCreate) AddCollateral(c ...*Collateral) *LoanCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lc.AddCollateralIDs(ids...)
+}
+
 // Mutation returns the LoanMutation object of the builder.
 func (lc *LoanCreate) Mutation() *LoanMutation {
 	return lc.mutation
@@ -72,6 +349,9 @@ func (lc *LoanCreate) Mutation() *LoanMutation {
 
 // Save creates the Loan in the database.
 func (lc *LoanCreate) Save(ctx context.Context) (*Loan, error) {
+	if err := lc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
 }
 
@@ -97,6 +377,18 @@ func (lc *LoanCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (lc *LoanCreate) defaults() error {
+	if _, ok := lc.mutation.OriginatedAt(); !ok {
+		if loan.DefaultOriginatedAt == nil {
+			return fmt.Errorf("ent: uninitialized loan.DefaultOriginatedAt (forgotten import ent/runtime?)")
+		}
+		v := loan.DefaultOriginatedAt()
+		lc.mutation.SetOriginatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (lc *LoanCreate) check() error {
 	if _, ok := lc.mutation.Amount(); !ok {
@@ -111,6 +403,9 @@ func (lc *LoanCreate) check() error {
 	if _, ok := lc.mutation.BorrowerID(); !ok {
 		return &ValidationError{Name: "borrower_id", err: errors.New(`ent: missing required field "Loan.borrower_id"`)}
 	}
+	if _, ok := lc.mutation.OriginatedAt(); !ok {
+		return &ValidationError{Name: "originated_at", err: errors.New(`ent: missing required field "Loan.originated_at"`)}
+	}
 	if _, ok := lc.mutation.BorrowerID(); !ok {
 		return &ValidationError{Name: "borrower", err: errors.New(`ent: missing required edge "Loan.borrower"`)}
 	}
@@ -152,6 +447,38 @@ func (lc *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
 		_spec.SetField(loan.FieldTerm, field.TypeInt, value)
 		_node.Term = value
 	}
+	if value, ok := lc.mutation.GraduatedStepRate(); ok {
+		_spec.SetField(loan.FieldGraduatedStepRate, field.TypeFloat64, value)
+		_node.GraduatedStepRate = value
+	}
+	if value, ok := lc.mutation.GraduatedYears(); ok {
+		_spec.SetField(loan.FieldGraduatedYears, field.TypeInt, value)
+		_node.GraduatedYears = value
+	}
+	if value, ok := lc.mutation.NegativeAmortizationCap(); ok {
+		_spec.SetField(loan.FieldNegativeAmortizationCap, field.TypeFloat64, value)
+		_node.NegativeAmortizationCap = value
+	}
+	if value, ok := lc.mutation.ConstructionMonths(); ok {
+		_spec.SetField(loan.FieldConstructionMonths, field.TypeInt, value)
+		_node.ConstructionMonths = value
+	}
+	if value, ok := lc.mutation.PropertyValue(); ok {
+		_spec.SetField(loan.FieldPropertyValue, field.TypeInt, value)
+		_node.PropertyValue = value
+	}
+	if value, ok := lc.mutation.PmiRate(); ok {
+		_spec.SetField(loan.FieldPmiRate, field.TypeFloat64, value)
+		_node.PmiRate = value
+	}
+	if value, ok := lc.mutation.PmiCancellationMonth(); ok {
+		_spec.SetField(loan.FieldPmiCancellationMonth, field.TypeInt, value)
+		_node.PmiCancellationMonth = value
+	}
+	if value, ok := lc.mutation.OriginatedAt(); ok {
+		_spec.SetField(loan.FieldOriginatedAt, field.TypeTime, value)
+		_node.OriginatedAt = value
+	}
 	if nodes := lc.mutation.BorrowerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -185,6 +512,166 @@ func (lc *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lc.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ModificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.RecastsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.IncomeDrivenPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   loan.IncomeDrivenPlanTable,
+			Columns: []string{loan.IncomeDrivenPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.DisbursementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.EscrowItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ObligorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -206,6 +693,7 @@ func (lcb *LoanCreateBulk) Save(ctx context.Context) ([]*Loan, error) {
 	for i := range lcb.builders {
 		func(i int, root context.Context) {
 			builder := lcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*LoanMutation)
 				if !ok {
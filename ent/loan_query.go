@@ -5,27 +5,48 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/paymentdeferral"
 	"github.com/crusyn/loans/ent/predicate"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
 	"github.com/crusyn/loans/ent/user"
 )
 
 // LoanQuery is the builder for querying Loan entities.
 type LoanQuery struct {
 	config
-	ctx            *QueryContext
-	order          []loan.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Loan
-	withBorrower   *UserQuery
-	withSharedLoan *SharedLoanQuery
+	ctx                  *QueryContext
+	order                []loan.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Loan
+	withBorrower         *UserQuery
+	withSharedLoan       *SharedLoanQuery
+	withShareInvitations *ShareInvitationQuery
+	withShareLinks       *ShareLinkQuery
+	withModifications    *LoanModificationQuery
+	withDeferrals        *PaymentDeferralQuery
+	withRecasts          *LoanRecastQuery
+	withIncomeDrivenPlan *IncomeDrivenPlanQuery
+	withDisbursements    *LoanDisbursementQuery
+	withEscrowItems      *EscrowItemQuery
+	withObligors         *LoanObligorQuery
+	withCollateral       *CollateralQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +127,226 @@ func (lq *LoanQuery) QuerySharedLoan() *SharedLoanQuery {
 	return query
 }
 
+// QueryShareInvitations chains the current query on the "share_invitations" edge.
+func (lq *LoanQuery) QueryShareInvitations() *ShareInvitationQuery {
+	query := (&ShareInvitationClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(shareinvitation.Table, shareinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ShareInvitationsTable, loan.ShareInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareLinks chains the current query on the "share_links" edge.
+func (lq *LoanQuery) QueryShareLinks() *ShareLinkQuery {
+	query := (&ShareLinkClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ShareLinksTable, loan.ShareLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryModifications chains the current query on the "modifications" edge.
+func (lq *LoanQuery) QueryModifications() *LoanModificationQuery {
+	query := (&LoanModificationClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(loanmodification.Table, loanmodification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ModificationsTable, loan.ModificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeferrals chains the current query on the "deferrals" edge.
+func (lq *LoanQuery) QueryDeferrals() *PaymentDeferralQuery {
+	query := (&PaymentDeferralClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(paymentdeferral.Table, paymentdeferral.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.DeferralsTable, loan.DeferralsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecasts chains the current query on the "recasts" edge.
+func (lq *LoanQuery) QueryRecasts() *LoanRecastQuery {
+	query := (&LoanRecastClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(loanrecast.Table, loanrecast.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.RecastsTable, loan.RecastsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncomeDrivenPlan chains the current query on the "income_driven_plan" edge.
+func (lq *LoanQuery) QueryIncomeDrivenPlan() *IncomeDrivenPlanQuery {
+	query := (&IncomeDrivenPlanClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(incomedrivenplan.Table, incomedrivenplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, loan.IncomeDrivenPlanTable, loan.IncomeDrivenPlanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDisbursements chains the current query on the "disbursements" edge.
+func (lq *LoanQuery) QueryDisbursements() *LoanDisbursementQuery {
+	query := (&LoanDisbursementClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(loandisbursement.Table, loandisbursement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.DisbursementsTable, loan.DisbursementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEscrowItems chains the current query on the "escrow_items" edge.
+func (lq *LoanQuery) QueryEscrowItems() *EscrowItemQuery {
+	query := (&EscrowItemClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(escrowitem.Table, escrowitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.EscrowItemsTable, loan.EscrowItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryObligors chains the current query on the "obligors" edge.
+func (lq *LoanQuery) QueryObligors() *LoanObligorQuery {
+	query := (&LoanObligorClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(loanobligor.Table, loanobligor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, loan.ObligorsTable, loan.ObligorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCollateral chains the current query on the "collateral" edge.
+func (lq *LoanQuery) QueryCollateral() *CollateralQuery {
+	query := (&CollateralClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, selector),
+			sqlgraph.To(collateral.Table, collateral.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, loan.CollateralTable, loan.CollateralPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Loan entity from the query.
 // Returns a *NotFoundError when no Loan was found.
 func (lq *LoanQuery) First(ctx context.Context) (*Loan, error) {
@@ -293,13 +534,23 @@ func (lq *LoanQuery) Clone() *LoanQuery {
 		return nil
 	}
 	return &LoanQuery{
-		config:         lq.config,
-		ctx:            lq.ctx.Clone(),
-		order:          append([]loan.OrderOption{}, lq.order...),
-		inters:         append([]Interceptor{}, lq.inters...),
-		predicates:     append([]predicate.Loan{}, lq.predicates...),
-		withBorrower:   lq.withBorrower.Clone(),
-		withSharedLoan: lq.withSharedLoan.Clone(),
+		config:               lq.config,
+		ctx:                  lq.ctx.Clone(),
+		order:                append([]loan.OrderOption{}, lq.order...),
+		inters:               append([]Interceptor{}, lq.inters...),
+		predicates:           append([]predicate.Loan{}, lq.predicates...),
+		withBorrower:         lq.withBorrower.Clone(),
+		withSharedLoan:       lq.withSharedLoan.Clone(),
+		withShareInvitations: lq.withShareInvitations.Clone(),
+		withShareLinks:       lq.withShareLinks.Clone(),
+		withModifications:    lq.withModifications.Clone(),
+		withDeferrals:        lq.withDeferrals.Clone(),
+		withRecasts:          lq.withRecasts.Clone(),
+		withIncomeDrivenPlan: lq.withIncomeDrivenPlan.Clone(),
+		withDisbursements:    lq.withDisbursements.Clone(),
+		withEscrowItems:      lq.withEscrowItems.Clone(),
+		withObligors:         lq.withObligors.Clone(),
+		withCollateral:       lq.withCollateral.Clone(),
 		// clone intermediate query.
 		sql:  lq.sql.Clone(),
 		path: lq.path,
@@ -328,6 +579,116 @@ func (lq *LoanQuery) WithSharedLoan(opts ...func(*SharedLoanQuery)) *LoanQuery {
 	return lq
 }
 
+// WithShareInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "share_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithShareInvitations(opts ...func(*ShareInvitationQuery)) *LoanQuery {
+	query := (&ShareInvitationClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withShareInvitations = query
+	return lq
+}
+
+// WithShareLinks tells the query-builder to eager-load the nodes that are connected to
+// the "share_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithShareLinks(opts ...func(*ShareLinkQuery)) *LoanQuery {
+	query := (&ShareLinkClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withShareLinks = query
+	return lq
+}
+
+// WithModifications tells the query-builder to eager-load the nodes that are connected to
+// the "modifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithModifications(opts ...func(*LoanModificationQuery)) *LoanQuery {
+	query := (&LoanModificationClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withModifications = query
+	return lq
+}
+
+// WithDeferrals tells the query-builder to eager-load the nodes that are connected to
+// the "deferrals" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithDeferrals(opts ...func(*PaymentDeferralQuery)) *LoanQuery {
+	query := (&PaymentDeferralClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withDeferrals = query
+	return lq
+}
+
+// WithRecasts tells the query-builder to eager-load the nodes that are connected to
+// the "recasts" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithRecasts(opts ...func(*LoanRecastQuery)) *LoanQuery {
+	query := (&LoanRecastClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withRecasts = query
+	return lq
+}
+
+// WithIncomeDrivenPlan tells the query-builder to eager-load the nodes that are connected to
+// the "income_driven_plan" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithIncomeDrivenPlan(opts ...func(*IncomeDrivenPlanQuery)) *LoanQuery {
+	query := (&IncomeDrivenPlanClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withIncomeDrivenPlan = query
+	return lq
+}
+
+// WithDisbursements tells the query-builder to eager-load the nodes that are connected to
+// the "disbursements" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithDisbursements(opts ...func(*LoanDisbursementQuery)) *LoanQuery {
+	query := (&LoanDisbursementClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withDisbursements = query
+	return lq
+}
+
+// WithEscrowItems tells the query-builder to eager-load the nodes that are connected to
+// the "escrow_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithEscrowItems(opts ...func(*EscrowItemQuery)) *LoanQuery {
+	query := (&EscrowItemClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withEscrowItems = query
+	return lq
+}
+
+// WithObligors tells the query-builder to eager-load the nodes that are connected to
+// the "obligors" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithObligors(opts ...func(*LoanObligorQuery)) *LoanQuery {
+	query := (&LoanObligorClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withObligors = query
+	return lq
+}
+
+// WithCollateral tells the query-builder to eager-load the nodes that are connected to
+// the "collateral" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LoanQuery) WithCollateral(opts ...func(*CollateralQuery)) *LoanQuery {
+	query := (&CollateralClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withCollateral = query
+	return lq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -399,6 +760,12 @@ func (lq *LoanQuery) prepareQuery(ctx context.Context) error {
 		}
 		lq.sql = prev
 	}
+	if loan.Policy == nil {
+		return errors.New("ent: uninitialized loan.Policy (forgotten import ent/runtime?)")
+	}
+	if err := loan.Policy.EvalQuery(ctx, lq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -406,9 +773,19 @@ func (lq *LoanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loan, e
 	var (
 		nodes       = []*Loan{}
 		_spec       = lq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [12]bool{
 			lq.withBorrower != nil,
 			lq.withSharedLoan != nil,
+			lq.withShareInvitations != nil,
+			lq.withShareLinks != nil,
+			lq.withModifications != nil,
+			lq.withDeferrals != nil,
+			lq.withRecasts != nil,
+			lq.withIncomeDrivenPlan != nil,
+			lq.withDisbursements != nil,
+			lq.withEscrowItems != nil,
+			lq.withObligors != nil,
+			lq.withCollateral != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -442,6 +819,75 @@ func (lq *LoanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Loan, e
 			return nil, err
 		}
 	}
+	if query := lq.withShareInvitations; query != nil {
+		if err := lq.loadShareInvitations(ctx, query, nodes,
+			func(n *Loan) { n.Edges.ShareInvitations = []*ShareInvitation{} },
+			func(n *Loan, e *ShareInvitation) { n.Edges.ShareInvitations = append(n.Edges.ShareInvitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withShareLinks; query != nil {
+		if err := lq.loadShareLinks(ctx, query, nodes,
+			func(n *Loan) { n.Edges.ShareLinks = []*ShareLink{} },
+			func(n *Loan, e *ShareLink) { n.Edges.ShareLinks = append(n.Edges.ShareLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withModifications; query != nil {
+		if err := lq.loadModifications(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Modifications = []*LoanModification{} },
+			func(n *Loan, e *LoanModification) { n.Edges.Modifications = append(n.Edges.Modifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withDeferrals; query != nil {
+		if err := lq.loadDeferrals(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Deferrals = []*PaymentDeferral{} },
+			func(n *Loan, e *PaymentDeferral) { n.Edges.Deferrals = append(n.Edges.Deferrals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withRecasts; query != nil {
+		if err := lq.loadRecasts(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Recasts = []*LoanRecast{} },
+			func(n *Loan, e *LoanRecast) { n.Edges.Recasts = append(n.Edges.Recasts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withIncomeDrivenPlan; query != nil {
+		if err := lq.loadIncomeDrivenPlan(ctx, query, nodes, nil,
+			func(n *Loan, e *IncomeDrivenPlan) { n.Edges.IncomeDrivenPlan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withDisbursements; query != nil {
+		if err := lq.loadDisbursements(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Disbursements = []*LoanDisbursement{} },
+			func(n *Loan, e *LoanDisbursement) { n.Edges.Disbursements = append(n.Edges.Disbursements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withEscrowItems; query != nil {
+		if err := lq.loadEscrowItems(ctx, query, nodes,
+			func(n *Loan) { n.Edges.EscrowItems = []*EscrowItem{} },
+			func(n *Loan, e *EscrowItem) { n.Edges.EscrowItems = append(n.Edges.EscrowItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withObligors; query != nil {
+		if err := lq.loadObligors(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Obligors = []*LoanObligor{} },
+			func(n *Loan, e *LoanObligor) { n.Edges.Obligors = append(n.Edges.Obligors, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withCollateral; query != nil {
+		if err := lq.loadCollateral(ctx, query, nodes,
+			func(n *Loan) { n.Edges.Collateral = []*Collateral{} },
+			func(n *Loan, e *Collateral) { n.Edges.Collateral = append(n.Edges.Collateral, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -504,6 +950,334 @@ func (lq *LoanQuery) loadSharedLoan(ctx context.Context, query *SharedLoanQuery,
 	}
 	return nil
 }
+func (lq *LoanQuery) loadShareInvitations(ctx context.Context, query *ShareInvitationQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *ShareInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shareinvitation.FieldLoanID)
+	}
+	query.Where(predicate.ShareInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.ShareInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadShareLinks(ctx context.Context, query *ShareLinkQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *ShareLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sharelink.FieldLoanID)
+	}
+	query.Where(predicate.ShareLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.ShareLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadModifications(ctx context.Context, query *LoanModificationQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *LoanModification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loanmodification.FieldLoanID)
+	}
+	query.Where(predicate.LoanModification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.ModificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadDeferrals(ctx context.Context, query *PaymentDeferralQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *PaymentDeferral)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(paymentdeferral.FieldLoanID)
+	}
+	query.Where(predicate.PaymentDeferral(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.DeferralsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadRecasts(ctx context.Context, query *LoanRecastQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *LoanRecast)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loanrecast.FieldLoanID)
+	}
+	query.Where(predicate.LoanRecast(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.RecastsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadIncomeDrivenPlan(ctx context.Context, query *IncomeDrivenPlanQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *IncomeDrivenPlan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(incomedrivenplan.FieldLoanID)
+	}
+	query.Where(predicate.IncomeDrivenPlan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.IncomeDrivenPlanColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadDisbursements(ctx context.Context, query *LoanDisbursementQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *LoanDisbursement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loandisbursement.FieldLoanID)
+	}
+	query.Where(predicate.LoanDisbursement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.DisbursementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadEscrowItems(ctx context.Context, query *EscrowItemQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *EscrowItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(escrowitem.FieldLoanID)
+	}
+	query.Where(predicate.EscrowItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.EscrowItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadObligors(ctx context.Context, query *LoanObligorQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *LoanObligor)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Loan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loanobligor.FieldLoanID)
+	}
+	query.Where(predicate.LoanObligor(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(loan.ObligorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LoanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "loan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LoanQuery) loadCollateral(ctx context.Context, query *CollateralQuery, nodes []*Loan, init func(*Loan), assign func(*Loan, *Collateral)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Loan)
+	nids := make(map[int]map[*Loan]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(loan.CollateralTable)
+		s.Join(joinT).On(s.C(collateral.FieldID), joinT.C(loan.CollateralPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(loan.CollateralPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(loan.CollateralPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Loan]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Collateral](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "collateral" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (lq *LoanQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := lq.querySpec()
@@ -10,9 +10,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/paymentdeferral"
 	"github.com/crusyn/loans/ent/predicate"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
 	"github.com/crusyn/loans/ent/user"
 )
 
@@ -106,6 +116,195 @@ func (lu *LoanUpdate) SetNillableBorrowerID(i *int) *LoanUpdate {
 	return lu
 }
 
+// SetGraduatedStepRate sets the "graduated_step_rate" field.
+func (lu *LoanUpdate) SetGraduatedStepRate(f float64) *LoanUpdate {
+	lu.mutation.ResetGraduatedStepRate()
+	lu.mutation.SetGraduatedStepRate(f)
+	return lu
+}
+
+// SetNillableGraduatedStepRate sets the "graduated_step_rate" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillableGraduatedStepRate(f *float64) *LoanUpdate {
+	if f != nil {
+		lu.SetGraduatedStepRate(*f)
+	}
+	return lu
+}
+
+// AddGraduatedStepRate adds f to the "graduated_step_rate" field.
+func (lu *LoanUpdate) AddGraduatedStepRate(f float64) *LoanUpdate {
+	lu.mutation.AddGraduatedStepRate(f)
+	return lu
+}
+
+// ClearGraduatedStepRate clears the value of the "graduated_step_rate" field.
+func (lu *LoanUpdate) ClearGraduatedStepRate() *LoanUpdate {
+	lu.mutation.ClearGraduatedStepRate()
+	return lu
+}
+
+// SetGraduatedYears sets the "graduated_years" field.
+func (lu *LoanUpdate) SetGraduatedYears(i int) *LoanUpdate {
+	lu.mutation.ResetGraduatedYears()
+	lu.mutation.SetGraduatedYears(i)
+	return lu
+}
+
+// SetNillableGraduatedYears sets the "graduated_years" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillableGraduatedYears(i *int) *LoanUpdate {
+	if i != nil {
+		lu.SetGraduatedYears(*i)
+	}
+	return lu
+}
+
+// AddGraduatedYears adds i to the "graduated_years" field.
+func (lu *LoanUpdate) AddGraduatedYears(i int) *LoanUpdate {
+	lu.mutation.AddGraduatedYears(i)
+	return lu
+}
+
+// ClearGraduatedYears clears the value of the "graduated_years" field.
+func (lu *LoanUpdate) ClearGraduatedYears() *LoanUpdate {
+	lu.mutation.ClearGraduatedYears()
+	return lu
+}
+
+// SetNegativeAmortizationCap sets the "negative_amortization_cap" field.
+func (lu *LoanUpdate) SetNegativeAmortizationCap(f float64) *LoanUpdate {
+	lu.mutation.ResetNegativeAmortizationCap()
+	lu.mutation.SetNegativeAmortizationCap(f)
+	return lu
+}
+
+// SetNillableNegativeAmortizationCap sets the "negative_amortization_cap" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillableNegativeAmortizationCap(f *float64) *LoanUpdate {
+	if f != nil {
+		lu.SetNegativeAmortizationCap(*f)
+	}
+	return lu
+}
+
+// AddNegativeAmortizationCap adds f to the "negative_amortization_cap" field.
+func (lu *LoanUpdate) AddNegativeAmortizationCap(f float64) *LoanUpdate {
+	lu.mutation.AddNegativeAmortizationCap(f)
+	return lu
+}
+
+// ClearNegativeAmortizationCap clears the value of the "negative_amortization_cap" field.
+func (lu *LoanUpdate) ClearNegativeAmortizationCap() *LoanUpdate {
+	lu.mutation.ClearNegativeAmortizationCap()
+	return lu
+}
+
+// SetConstructionMonths sets the "construction_months" field.
+func (lu *LoanUpdate) SetConstructionMonths(i int) *LoanUpdate {
+	lu.mutation.ResetConstructionMonths()
+	lu.mutation.SetConstructionMonths(i)
+	return lu
+}
+
+// SetNillableConstructionMonths sets the "construction_months" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillableConstructionMonths(i *int) *LoanUpdate {
+	if i != nil {
+		lu.SetConstructionMonths(*i)
+	}
+	return lu
+}
+
+// AddConstructionMonths adds i to the "construction_months" field.
+func (lu *LoanUpdate) AddConstructionMonths(i int) *LoanUpdate {
+	lu.mutation.AddConstructionMonths(i)
+	return lu
+}
+
+// ClearConstructionMonths clears the value of the "construction_months" field.
+func (lu *LoanUpdate) ClearConstructionMonths() *LoanUpdate {
+	lu.mutation.ClearConstructionMonths()
+	return lu
+}
+
+// SetPropertyValue sets the "property_value" field.
+func (lu *LoanUpdate) SetPropertyValue(i int) *LoanUpdate {
+	lu.mutation.ResetPropertyValue()
+	lu.mutation.SetPropertyValue(i)
+	return lu
+}
+
+// SetNillablePropertyValue sets the "property_value" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillablePropertyValue(i *int) *LoanUpdate {
+	if i != nil {
+		lu.SetPropertyValue(*i)
+	}
+	return lu
+}
+
+// AddPropertyValue adds i to the "property_value" field.
+func (lu *LoanUpdate) AddPropertyValue(i int) *LoanUpdate {
+	lu.mutation.AddPropertyValue(i)
+	return lu
+}
+
+// ClearPropertyValue clears the value of the "property_value" field.
+func (lu *LoanUpdate) ClearPropertyValue() *LoanUpdate {
+	lu.mutation.ClearPropertyValue()
+	return lu
+}
+
+// SetPmiRate sets the "pmi_rate" field.
+func (lu *LoanUpdate) SetPmiRate(f float64) *LoanUpdate {
+	lu.mutation.ResetPmiRate()
+	lu.mutation.SetPmiRate(f)
+	return lu
+}
+
+// SetNillablePmiRate sets the "pmi_rate" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillablePmiRate(f *float64) *LoanUpdate {
+	if f != nil {
+		lu.SetPmiRate(*f)
+	}
+	return lu
+}
+
+// AddPmiRate adds f to the "pmi_rate" field.
+func (lu *LoanUpdate) AddPmiRate(f float64) *LoanUpdate {
+	lu.mutation.AddPmiRate(f)
+	return lu
+}
+
+// ClearPmiRate clears the value of the "pmi_rate" field.
+func (lu *LoanUpdate) ClearPmiRate() *LoanUpdate {
+	lu.mutation.ClearPmiRate()
+	return lu
+}
+
+// SetPmiCancellationMonth sets the "pmi_cancellation_month" field.
+func (lu *LoanUpdate) SetPmiCancellationMonth(i int) *LoanUpdate {
+	lu.mutation.ResetPmiCancellationMonth()
+	lu.mutation.SetPmiCancellationMonth(i)
+	return lu
+}
+
+// SetNillablePmiCancellationMonth sets the "pmi_cancellation_month" field if the given value is not nil.
+func (lu *LoanUpdate) SetNillablePmiCancellationMonth(i *int) *LoanUpdate {
+	if i != nil {
+		lu.SetPmiCancellationMonth(*i)
+	}
+	return lu
+}
+
+// AddPmiCancellationMonth adds i to the "pmi_cancellation_month" field.
+func (lu *LoanUpdate) AddPmiCancellationMonth(i int) *LoanUpdate {
+	lu.mutation.AddPmiCancellationMonth(i)
+	return lu
+}
+
+// ClearPmiCancellationMonth clears the value of the "pmi_cancellation_month" field.
+func (lu *LoanUpdate) ClearPmiCancellationMonth() *LoanUpdate {
+	lu.mutation.ClearPmiCancellationMonth()
+	return lu
+}
+
 // SetBorrower sets the "borrower" edge to the User entity.
 func (lu *LoanUpdate) SetBorrower(u *User) *LoanUpdate {
 	return lu.SetBorrowerID(u.ID)
@@ -126,6 +325,160 @@ func (lu *LoanUpdate) AddSharedLoan(s ...*SharedLoan) *LoanUpdate {
 	return lu.AddSharedLoanIDs(ids...)
 }
 
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (lu *LoanUpdate) AddShareInvitationIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddShareInvitationIDs(ids...)
+	return lu
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (lu *LoanUpdate) AddShareInvitations(s ...*ShareInvitation) *LoanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.AddShareInvitationIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (lu *LoanUpdate) AddShareLinkIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddShareLinkIDs(ids...)
+	return lu
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (lu *LoanUpdate) AddShareLinks(s ...*ShareLink) *LoanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.AddShareLinkIDs(ids...)
+}
+
+// AddModificationIDs adds the "modifications" edge to the LoanModification entity by IDs.
+func (lu *LoanUpdate) AddModificationIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddModificationIDs(ids...)
+	return lu
+}
+
+// AddModifications adds the "modifications" edges to the LoanModification entity.
+func (lu *LoanUpdate) AddModifications(l ...*LoanModification) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddModificationIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the PaymentDeferral entity by IDs.
+func (lu *LoanUpdate) AddDeferralIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddDeferralIDs(ids...)
+	return lu
+}
+
+// AddDeferrals adds the "deferrals" edges to the PaymentDeferral entity.
+func (lu *LoanUpdate) AddDeferrals(p ...*PaymentDeferral) *LoanUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lu.AddDeferralIDs(ids...)
+}
+
+// AddRecastIDs adds the "recasts" edge to the LoanRecast entity by IDs.
+func (lu *LoanUpdate) AddRecastIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddRecastIDs(ids...)
+	return lu
+}
+
+// AddRecasts adds the "recasts" edges to the LoanRecast entity.
+func (lu *LoanUpdate) AddRecasts(l ...*LoanRecast) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddRecastIDs(ids...)
+}
+
+// SetIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID.
+func (lu *LoanUpdate) SetIncomeDrivenPlanID(id int) *LoanUpdate {
+	lu.mutation.SetIncomeDrivenPlanID(id)
+	return lu
+}
+
+// SetNillableIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID if the given value is not nil.
+func (lu *LoanUpdate) SetNillableIncomeDrivenPlanID(id *int) *LoanUpdate {
+	if id != nil {
+		lu = lu.SetIncomeDrivenPlanID(*id)
+	}
+	return lu
+}
+
+// SetIncomeDrivenPlan sets the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (lu *LoanUpdate) SetIncomeDrivenPlan(i *IncomeDrivenPlan) *LoanUpdate {
+	return lu.SetIncomeDrivenPlanID(i.ID)
+}
+
+// AddDisbursementIDs adds the "disbursements" edge to the LoanDisbursement entity by IDs.
+func (lu *LoanUpdate) AddDisbursementIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddDisbursementIDs(ids...)
+	return lu
+}
+
+// AddDisbursements adds the "disbursements" edges to the LoanDisbursement entity.
+func (lu *LoanUpdate) AddDisbursements(l ...*LoanDisbursement) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddDisbursementIDs(ids...)
+}
+
+// AddEscrowItemIDs adds the "escrow_items" edge to the EscrowItem entity by IDs.
+func (lu *LoanUpdate) AddEscrowItemIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddEscrowItemIDs(ids...)
+	return lu
+}
+
+// AddEscrowItems adds the "escrow_items" edges to the EscrowItem entity.
+func (lu *LoanUpdate) AddEscrowItems(e ...*EscrowItem) *LoanUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lu.AddEscrowItemIDs(ids...)
+}
+
+// AddObligorIDs adds the "obligors" edge to the LoanObligor entity by IDs.
+func (lu *LoanUpdate) AddObligorIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddObligorIDs(ids...)
+	return lu
+}
+
+// AddObligors adds the "obligors" edges to the LoanObligor entity.
+func (lu *LoanUpdate) AddObligors(l ...*LoanObligor) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.AddObligorIDs(ids...)
+}
+
+// AddCollateralIDs adds the "collateral" edge to the Collateral entity by IDs.
+func (lu *LoanUpdate) AddCollateralIDs(ids ...int) *LoanUpdate {
+	lu.mutation.AddCollateralIDs(ids...)
+	return lu
+}
+
+// AddCollateral adds the "collateral" edges to the Collateral entity.
+func (lu *LoanUpdate) AddCollateral(c ...*Collateral) *LoanUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.AddCollateralIDs(ids...)
+}
+
 // Mutation returns the LoanMutation object of the builder.
 func (lu *LoanUpdate) Mutation() *LoanMutation {
 	return lu.mutation
@@ -158,58 +511,253 @@ func (lu *LoanUpdate) RemoveSharedLoan(s ...*SharedLoan) *LoanUpdate {
 	return lu.RemoveSharedLoanIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (lu *LoanUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+// ClearShareInvitations clears all "share_invitations" edges to the ShareInvitation entity.
+func (lu *LoanUpdate) ClearShareInvitations() *LoanUpdate {
+	lu.mutation.ClearShareInvitations()
+	return lu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (lu *LoanUpdate) SaveX(ctx context.Context) int {
-	affected, err := lu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveShareInvitationIDs removes the "share_invitations" edge to ShareInvitation entities by IDs.
+func (lu *LoanUpdate) RemoveShareInvitationIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveShareInvitationIDs(ids...)
+	return lu
+}
+
+// RemoveShareInvitations removes "share_invitations" edges to ShareInvitation entities.
+func (lu *LoanUpdate) RemoveShareInvitations(s ...*ShareInvitation) *LoanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return affected
+	return lu.RemoveShareInvitationIDs(ids...)
 }
 
-// Exec executes the query.
-func (lu *LoanUpdate) Exec(ctx context.Context) error {
-	_, err := lu.Save(ctx)
-	return err
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (lu *LoanUpdate) ClearShareLinks() *LoanUpdate {
+	lu.mutation.ClearShareLinks()
+	return lu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (lu *LoanUpdate) ExecX(ctx context.Context) {
-	if err := lu.Exec(ctx); err != nil {
-		panic(err)
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (lu *LoanUpdate) RemoveShareLinkIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveShareLinkIDs(ids...)
+	return lu
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (lu *LoanUpdate) RemoveShareLinks(s ...*ShareLink) *LoanUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
+	return lu.RemoveShareLinkIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (lu *LoanUpdate) check() error {
-	if _, ok := lu.mutation.BorrowerID(); lu.mutation.BorrowerCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Loan.borrower"`)
+// ClearModifications clears all "modifications" edges to the LoanModification entity.
+func (lu *LoanUpdate) ClearModifications() *LoanUpdate {
+	lu.mutation.ClearModifications()
+	return lu
+}
+
+// RemoveModificationIDs removes the "modifications" edge to LoanModification entities by IDs.
+func (lu *LoanUpdate) RemoveModificationIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveModificationIDs(ids...)
+	return lu
+}
+
+// RemoveModifications removes "modifications" edges to LoanModification entities.
+func (lu *LoanUpdate) RemoveModifications(l ...*LoanModification) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	return nil
+	return lu.RemoveModificationIDs(ids...)
 }
 
-func (lu *LoanUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := lu.check(); err != nil {
-		return n, err
+// ClearDeferrals clears all "deferrals" edges to the PaymentDeferral entity.
+func (lu *LoanUpdate) ClearDeferrals() *LoanUpdate {
+	lu.mutation.ClearDeferrals()
+	return lu
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to PaymentDeferral entities by IDs.
+func (lu *LoanUpdate) RemoveDeferralIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveDeferralIDs(ids...)
+	return lu
+}
+
+// RemoveDeferrals removes "deferrals" edges to PaymentDeferral entities.
+func (lu *LoanUpdate) RemoveDeferrals(p ...*PaymentDeferral) *LoanUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt))
-	if ps := lu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return lu.RemoveDeferralIDs(ids...)
+}
+
+// ClearRecasts clears all "recasts" edges to the LoanRecast entity.
+func (lu *LoanUpdate) ClearRecasts() *LoanUpdate {
+	lu.mutation.ClearRecasts()
+	return lu
+}
+
+// RemoveRecastIDs removes the "recasts" edge to LoanRecast entities by IDs.
+func (lu *LoanUpdate) RemoveRecastIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveRecastIDs(ids...)
+	return lu
+}
+
+// RemoveRecasts removes "recasts" edges to LoanRecast entities.
+func (lu *LoanUpdate) RemoveRecasts(l ...*LoanRecast) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	if value, ok := lu.mutation.Amount(); ok {
-		_spec.SetField(loan.FieldAmount, field.TypeInt, value)
+	return lu.RemoveRecastIDs(ids...)
+}
+
+// ClearIncomeDrivenPlan clears the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (lu *LoanUpdate) ClearIncomeDrivenPlan() *LoanUpdate {
+	lu.mutation.ClearIncomeDrivenPlan()
+	return lu
+}
+
+// ClearDisbursements clears all "disbursements" edges to the LoanDisbursement entity.
+func (lu *LoanUpdate) ClearDisbursements() *LoanUpdate {
+	lu.mutation.ClearDisbursements()
+	return lu
+}
+
+// RemoveDisbursementIDs removes the "disbursements" edge to LoanDisbursement entities by IDs.
+func (lu *LoanUpdate) RemoveDisbursementIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveDisbursementIDs(ids...)
+	return lu
+}
+
+// RemoveDisbursements removes "disbursements" edges to LoanDisbursement entities.
+func (lu *LoanUpdate) RemoveDisbursements(l ...*LoanDisbursement) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	if value, ok := lu.mutation.AddedAmount(); ok {
-		_spec.AddField(loan.FieldAmount, field.TypeInt, value)
+	return lu.RemoveDisbursementIDs(ids...)
+}
+
+// ClearEscrowItems clears all "escrow_items" edges to the EscrowItem entity.
+func (lu *LoanUpdate) ClearEscrowItems() *LoanUpdate {
+	lu.mutation.ClearEscrowItems()
+	return lu
+}
+
+// RemoveEscrowItemIDs removes the "escrow_items" edge to EscrowItem entities by IDs.
+func (lu *LoanUpdate) RemoveEscrowItemIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveEscrowItemIDs(ids...)
+	return lu
+}
+
+// RemoveEscrowItems removes "escrow_items" edges to EscrowItem entities.
+func (lu *LoanUpdate) RemoveEscrowItems(e ...*EscrowItem) *LoanUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return lu.RemoveEscrowItemIDs(ids...)
+}
+
+// ClearObligors clears all "obligors" edges to the LoanObligor entity.
+func (lu *LoanUpdate) ClearObligors() *LoanUpdate {
+	lu.mutation.ClearObligors()
+	return lu
+}
+
+// RemoveObligorIDs removes the "obligors" edge to LoanObligor entities by IDs.
+func (lu *LoanUpdate) RemoveObligorIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveObligorIDs(ids...)
+	return lu
+}
+
+// RemoveObligors removes "obligors" edges to LoanObligor entities.
+func (lu *LoanUpdate) RemoveObligors(l ...*LoanObligor) *LoanUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lu.RemoveObligorIDs(ids...)
+}
+
+// ClearCollateral clears all "collateral" edges to the Collateral entity.
+func (lu *LoanUpdate) ClearCollateral() *LoanUpdate {
+	lu.mutation.ClearCollateral()
+	return lu
+}
+
+// RemoveCollateralIDs removes the "collateral" edge to Collateral entities by IDs.
+func (lu *LoanUpdate) RemoveCollateralIDs(ids ...int) *LoanUpdate {
+	lu.mutation.RemoveCollateralIDs(ids...)
+	return lu
+}
+
+// RemoveCollateral removes "collateral" edges to Collateral entities.
+func (lu *LoanUpdate) RemoveCollateral(c ...*Collateral) *LoanUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.RemoveCollateralIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LoanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LoanUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LoanUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LoanUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LoanUpdate) check() error {
+	if _, ok := lu.mutation.BorrowerID(); lu.mutation.BorrowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Loan.borrower"`)
+	}
+	return nil
+}
+
+func (lu *LoanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Amount(); ok {
+		_spec.SetField(loan.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedAmount(); ok {
+		_spec.AddField(loan.FieldAmount, field.TypeInt, value)
 	}
 	if value, ok := lu.mutation.Rate(); ok {
 		_spec.SetField(loan.FieldRate, field.TypeFloat64, value)
@@ -223,6 +771,69 @@ func (lu *LoanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := lu.mutation.AddedTerm(); ok {
 		_spec.AddField(loan.FieldTerm, field.TypeInt, value)
 	}
+	if value, ok := lu.mutation.GraduatedStepRate(); ok {
+		_spec.SetField(loan.FieldGraduatedStepRate, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedGraduatedStepRate(); ok {
+		_spec.AddField(loan.FieldGraduatedStepRate, field.TypeFloat64, value)
+	}
+	if lu.mutation.GraduatedStepRateCleared() {
+		_spec.ClearField(loan.FieldGraduatedStepRate, field.TypeFloat64)
+	}
+	if value, ok := lu.mutation.GraduatedYears(); ok {
+		_spec.SetField(loan.FieldGraduatedYears, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedGraduatedYears(); ok {
+		_spec.AddField(loan.FieldGraduatedYears, field.TypeInt, value)
+	}
+	if lu.mutation.GraduatedYearsCleared() {
+		_spec.ClearField(loan.FieldGraduatedYears, field.TypeInt)
+	}
+	if value, ok := lu.mutation.NegativeAmortizationCap(); ok {
+		_spec.SetField(loan.FieldNegativeAmortizationCap, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedNegativeAmortizationCap(); ok {
+		_spec.AddField(loan.FieldNegativeAmortizationCap, field.TypeFloat64, value)
+	}
+	if lu.mutation.NegativeAmortizationCapCleared() {
+		_spec.ClearField(loan.FieldNegativeAmortizationCap, field.TypeFloat64)
+	}
+	if value, ok := lu.mutation.ConstructionMonths(); ok {
+		_spec.SetField(loan.FieldConstructionMonths, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedConstructionMonths(); ok {
+		_spec.AddField(loan.FieldConstructionMonths, field.TypeInt, value)
+	}
+	if lu.mutation.ConstructionMonthsCleared() {
+		_spec.ClearField(loan.FieldConstructionMonths, field.TypeInt)
+	}
+	if value, ok := lu.mutation.PropertyValue(); ok {
+		_spec.SetField(loan.FieldPropertyValue, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedPropertyValue(); ok {
+		_spec.AddField(loan.FieldPropertyValue, field.TypeInt, value)
+	}
+	if lu.mutation.PropertyValueCleared() {
+		_spec.ClearField(loan.FieldPropertyValue, field.TypeInt)
+	}
+	if value, ok := lu.mutation.PmiRate(); ok {
+		_spec.SetField(loan.FieldPmiRate, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedPmiRate(); ok {
+		_spec.AddField(loan.FieldPmiRate, field.TypeFloat64, value)
+	}
+	if lu.mutation.PmiRateCleared() {
+		_spec.ClearField(loan.FieldPmiRate, field.TypeFloat64)
+	}
+	if value, ok := lu.mutation.PmiCancellationMonth(); ok {
+		_spec.SetField(loan.FieldPmiCancellationMonth, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedPmiCancellationMonth(); ok {
+		_spec.AddField(loan.FieldPmiCancellationMonth, field.TypeInt, value)
+	}
+	if lu.mutation.PmiCancellationMonthCleared() {
+		_spec.ClearField(loan.FieldPmiCancellationMonth, field.TypeInt)
+	}
 	if lu.mutation.BorrowerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -297,126 +908,903 @@ func (lu *LoanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{loan.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if lu.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	lu.mutation.done = true
-	return n, nil
-}
-
-// LoanUpdateOne is the builder for updating a single Loan entity.
-type LoanUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *LoanMutation
-}
-
-// SetAmount sets the "amount" field.
-func (luo *LoanUpdateOne) SetAmount(i int) *LoanUpdateOne {
-	luo.mutation.ResetAmount()
-	luo.mutation.SetAmount(i)
-	return luo
-}
-
-// SetNillableAmount sets the "amount" field if the given value is not nil.
-func (luo *LoanUpdateOne) SetNillableAmount(i *int) *LoanUpdateOne {
-	if i != nil {
-		luo.SetAmount(*i)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return luo
-}
-
-// AddAmount adds i to the "amount" field.
-func (luo *LoanUpdateOne) AddAmount(i int) *LoanUpdateOne {
-	luo.mutation.AddAmount(i)
-	return luo
-}
-
-// SetRate sets the "rate" field.
-func (luo *LoanUpdateOne) SetRate(f float64) *LoanUpdateOne {
-	luo.mutation.ResetRate()
-	luo.mutation.SetRate(f)
-	return luo
-}
-
-// SetNillableRate sets the "rate" field if the given value is not nil.
-func (luo *LoanUpdateOne) SetNillableRate(f *float64) *LoanUpdateOne {
-	if f != nil {
-		luo.SetRate(*f)
+	if nodes := lu.mutation.RemovedShareInvitationsIDs(); len(nodes) > 0 && !lu.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return luo
-}
-
-// AddRate adds f to the "rate" field.
-func (luo *LoanUpdateOne) AddRate(f float64) *LoanUpdateOne {
-	luo.mutation.AddRate(f)
-	return luo
-}
-
-// SetTerm sets the "term" field.
-func (luo *LoanUpdateOne) SetTerm(i int) *LoanUpdateOne {
-	luo.mutation.ResetTerm()
-	luo.mutation.SetTerm(i)
-	return luo
-}
-
-// SetNillableTerm sets the "term" field if the given value is not nil.
-func (luo *LoanUpdateOne) SetNillableTerm(i *int) *LoanUpdateOne {
-	if i != nil {
-		luo.SetTerm(*i)
+	if nodes := lu.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return luo
-}
-
-// AddTerm adds i to the "term" field.
-func (luo *LoanUpdateOne) AddTerm(i int) *LoanUpdateOne {
-	luo.mutation.AddTerm(i)
-	return luo
-}
-
-// SetBorrowerID sets the "borrower_id" field.
-func (luo *LoanUpdateOne) SetBorrowerID(i int) *LoanUpdateOne {
-	luo.mutation.SetBorrowerID(i)
-	return luo
-}
-
-// SetNillableBorrowerID sets the "borrower_id" field if the given value is not nil.
-func (luo *LoanUpdateOne) SetNillableBorrowerID(i *int) *LoanUpdateOne {
-	if i != nil {
-		luo.SetBorrowerID(*i)
+	if lu.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return luo
-}
-
-// SetBorrower sets the "borrower" edge to the User entity.
-func (luo *LoanUpdateOne) SetBorrower(u *User) *LoanUpdateOne {
-	return luo.SetBorrowerID(u.ID)
-}
-
-// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by IDs.
-func (luo *LoanUpdateOne) AddSharedLoanIDs(ids ...int) *LoanUpdateOne {
-	luo.mutation.AddSharedLoanIDs(ids...)
-	return luo
-}
-
-// AddSharedLoan adds the "shared_loan" edges to the SharedLoan entity.
-func (luo *LoanUpdateOne) AddSharedLoan(s ...*SharedLoan) *LoanUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if nodes := lu.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !lu.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return luo.AddSharedLoanIDs(ids...)
-}
-
-// Mutation returns the LoanMutation object of the builder.
-func (luo *LoanUpdateOne) Mutation() *LoanMutation {
-	return luo.mutation
+	if nodes := lu.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.ModificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedModificationsIDs(); len(nodes) > 0 && !lu.mutation.ModificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ModificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !lu.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.RecastsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedRecastsIDs(); len(nodes) > 0 && !lu.mutation.RecastsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RecastsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.IncomeDrivenPlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   loan.IncomeDrivenPlanTable,
+			Columns: []string{loan.IncomeDrivenPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.IncomeDrivenPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   loan.IncomeDrivenPlanTable,
+			Columns: []string{loan.IncomeDrivenPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.DisbursementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedDisbursementsIDs(); len(nodes) > 0 && !lu.mutation.DisbursementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.DisbursementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.EscrowItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedEscrowItemsIDs(); len(nodes) > 0 && !lu.mutation.EscrowItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.EscrowItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.ObligorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedObligorsIDs(); len(nodes) > 0 && !lu.mutation.ObligorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ObligorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedCollateralIDs(); len(nodes) > 0 && !lu.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LoanUpdateOne is the builder for updating a single Loan entity.
+type LoanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanMutation
+}
+
+// SetAmount sets the "amount" field.
+func (luo *LoanUpdateOne) SetAmount(i int) *LoanUpdateOne {
+	luo.mutation.ResetAmount()
+	luo.mutation.SetAmount(i)
+	return luo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableAmount(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetAmount(*i)
+	}
+	return luo
+}
+
+// AddAmount adds i to the "amount" field.
+func (luo *LoanUpdateOne) AddAmount(i int) *LoanUpdateOne {
+	luo.mutation.AddAmount(i)
+	return luo
+}
+
+// SetRate sets the "rate" field.
+func (luo *LoanUpdateOne) SetRate(f float64) *LoanUpdateOne {
+	luo.mutation.ResetRate()
+	luo.mutation.SetRate(f)
+	return luo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableRate(f *float64) *LoanUpdateOne {
+	if f != nil {
+		luo.SetRate(*f)
+	}
+	return luo
+}
+
+// AddRate adds f to the "rate" field.
+func (luo *LoanUpdateOne) AddRate(f float64) *LoanUpdateOne {
+	luo.mutation.AddRate(f)
+	return luo
+}
+
+// SetTerm sets the "term" field.
+func (luo *LoanUpdateOne) SetTerm(i int) *LoanUpdateOne {
+	luo.mutation.ResetTerm()
+	luo.mutation.SetTerm(i)
+	return luo
+}
+
+// SetNillableTerm sets the "term" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableTerm(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetTerm(*i)
+	}
+	return luo
+}
+
+// AddTerm adds i to the "term" field.
+func (luo *LoanUpdateOne) AddTerm(i int) *LoanUpdateOne {
+	luo.mutation.AddTerm(i)
+	return luo
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (luo *LoanUpdateOne) SetBorrowerID(i int) *LoanUpdateOne {
+	luo.mutation.SetBorrowerID(i)
+	return luo
+}
+
+// SetNillableBorrowerID sets the "borrower_id" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableBorrowerID(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetBorrowerID(*i)
+	}
+	return luo
+}
+
+// SetGraduatedStepRate sets the "graduated_step_rate" field.
+func (luo *LoanUpdateOne) SetGraduatedStepRate(f float64) *LoanUpdateOne {
+	luo.mutation.ResetGraduatedStepRate()
+	luo.mutation.SetGraduatedStepRate(f)
+	return luo
+}
+
+// SetNillableGraduatedStepRate sets the "graduated_step_rate" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableGraduatedStepRate(f *float64) *LoanUpdateOne {
+	if f != nil {
+		luo.SetGraduatedStepRate(*f)
+	}
+	return luo
+}
+
+// AddGraduatedStepRate adds f to the "graduated_step_rate" field.
+func (luo *LoanUpdateOne) AddGraduatedStepRate(f float64) *LoanUpdateOne {
+	luo.mutation.AddGraduatedStepRate(f)
+	return luo
+}
+
+// ClearGraduatedStepRate clears the value of the "graduated_step_rate" field.
+func (luo *LoanUpdateOne) ClearGraduatedStepRate() *LoanUpdateOne {
+	luo.mutation.ClearGraduatedStepRate()
+	return luo
+}
+
+// SetGraduatedYears sets the "graduated_years" field.
+func (luo *LoanUpdateOne) SetGraduatedYears(i int) *LoanUpdateOne {
+	luo.mutation.ResetGraduatedYears()
+	luo.mutation.SetGraduatedYears(i)
+	return luo
+}
+
+// SetNillableGraduatedYears sets the "graduated_years" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableGraduatedYears(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetGraduatedYears(*i)
+	}
+	return luo
+}
+
+// AddGraduatedYears adds i to the "graduated_years" field.
+func (luo *LoanUpdateOne) AddGraduatedYears(i int) *LoanUpdateOne {
+	luo.mutation.AddGraduatedYears(i)
+	return luo
+}
+
+// ClearGraduatedYears clears the value of the "graduated_years" field.
+func (luo *LoanUpdateOne) ClearGraduatedYears() *LoanUpdateOne {
+	luo.mutation.ClearGraduatedYears()
+	return luo
+}
+
+// SetNegativeAmortizationCap sets the "negative_amortization_cap" field.
+func (luo *LoanUpdateOne) SetNegativeAmortizationCap(f float64) *LoanUpdateOne {
+	luo.mutation.ResetNegativeAmortizationCap()
+	luo.mutation.SetNegativeAmortizationCap(f)
+	return luo
+}
+
+// SetNillableNegativeAmortizationCap sets the "negative_amortization_cap" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableNegativeAmortizationCap(f *float64) *LoanUpdateOne {
+	if f != nil {
+		luo.SetNegativeAmortizationCap(*f)
+	}
+	return luo
+}
+
+// AddNegativeAmortizationCap adds f to the "negative_amortization_cap" field.
+func (luo *LoanUpdateOne) AddNegativeAmortizationCap(f float64) *LoanUpdateOne {
+	luo.mutation.AddNegativeAmortizationCap(f)
+	return luo
+}
+
+// ClearNegativeAmortizationCap clears the value of the "negative_amortization_cap" field.
+func (luo *LoanUpdateOne) ClearNegativeAmortizationCap() *LoanUpdateOne {
+	luo.mutation.ClearNegativeAmortizationCap()
+	return luo
+}
+
+// SetConstructionMonths sets the "construction_months" field.
+func (luo *LoanUpdateOne) SetConstructionMonths(i int) *LoanUpdateOne {
+	luo.mutation.ResetConstructionMonths()
+	luo.mutation.SetConstructionMonths(i)
+	return luo
+}
+
+// SetNillableConstructionMonths sets the "construction_months" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableConstructionMonths(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetConstructionMonths(*i)
+	}
+	return luo
+}
+
+// AddConstructionMonths adds i to the "construction_months" field.
+func (luo *LoanUpdateOne) AddConstructionMonths(i int) *LoanUpdateOne {
+	luo.mutation.AddConstructionMonths(i)
+	return luo
+}
+
+// ClearConstructionMonths clears the value of the "construction_months" field.
+func (luo *LoanUpdateOne) ClearConstructionMonths() *LoanUpdateOne {
+	luo.mutation.ClearConstructionMonths()
+	return luo
+}
+
+// SetPropertyValue sets the "property_value" field.
+func (luo *LoanUpdateOne) SetPropertyValue(i int) *LoanUpdateOne {
+	luo.mutation.ResetPropertyValue()
+	luo.mutation.SetPropertyValue(i)
+	return luo
+}
+
+// SetNillablePropertyValue sets the "property_value" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillablePropertyValue(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetPropertyValue(*i)
+	}
+	return luo
+}
+
+// AddPropertyValue adds i to the "property_value" field.
+func (luo *LoanUpdateOne) AddPropertyValue(i int) *LoanUpdateOne {
+	luo.mutation.AddPropertyValue(i)
+	return luo
+}
+
+// ClearPropertyValue clears the value of the "property_value" field.
+func (luo *LoanUpdateOne) ClearPropertyValue() *LoanUpdateOne {
+	luo.mutation.ClearPropertyValue()
+	return luo
+}
+
+// SetPmiRate sets the "pmi_rate" field.
+func (luo *LoanUpdateOne) SetPmiRate(f float64) *LoanUpdateOne {
+	luo.mutation.ResetPmiRate()
+	luo.mutation.SetPmiRate(f)
+	return luo
+}
+
+// SetNillablePmiRate sets the "pmi_rate" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillablePmiRate(f *float64) *LoanUpdateOne {
+	if f != nil {
+		luo.SetPmiRate(*f)
+	}
+	return luo
+}
+
+// AddPmiRate adds f to the "pmi_rate" field.
+func (luo *LoanUpdateOne) AddPmiRate(f float64) *LoanUpdateOne {
+	luo.mutation.AddPmiRate(f)
+	return luo
+}
+
+// ClearPmiRate clears the value of the "pmi_rate" field.
+func (luo *LoanUpdateOne) ClearPmiRate() *LoanUpdateOne {
+	luo.mutation.ClearPmiRate()
+	return luo
+}
+
+// SetPmiCancellationMonth sets the "pmi_cancellation_month" field.
+func (luo *LoanUpdateOne) SetPmiCancellationMonth(i int) *LoanUpdateOne {
+	luo.mutation.ResetPmiCancellationMonth()
+	luo.mutation.SetPmiCancellationMonth(i)
+	return luo
+}
+
+// SetNillablePmiCancellationMonth sets the "pmi_cancellation_month" field if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillablePmiCancellationMonth(i *int) *LoanUpdateOne {
+	if i != nil {
+		luo.SetPmiCancellationMonth(*i)
+	}
+	return luo
+}
+
+// AddPmiCancellationMonth adds i to the "pmi_cancellation_month" field.
+func (luo *LoanUpdateOne) AddPmiCancellationMonth(i int) *LoanUpdateOne {
+	luo.mutation.AddPmiCancellationMonth(i)
+	return luo
+}
+
+// ClearPmiCancellationMonth clears the value of the "pmi_cancellation_month" field.
+func (luo *LoanUpdateOne) ClearPmiCancellationMonth() *LoanUpdateOne {
+	luo.mutation.ClearPmiCancellationMonth()
+	return luo
+}
+
+// SetBorrower sets the "borrower" edge to the User entity.
+func (luo *LoanUpdateOne) SetBorrower(u *User) *LoanUpdateOne {
+	return luo.SetBorrowerID(u.ID)
+}
+
+// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by IDs.
+func (luo *LoanUpdateOne) AddSharedLoanIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddSharedLoanIDs(ids...)
+	return luo
+}
+
+// AddSharedLoan adds the "shared_loan" edges to the SharedLoan entity.
+func (luo *LoanUpdateOne) AddSharedLoan(s ...*SharedLoan) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddSharedLoanIDs(ids...)
+}
+
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (luo *LoanUpdateOne) AddShareInvitationIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddShareInvitationIDs(ids...)
+	return luo
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (luo *LoanUpdateOne) AddShareInvitations(s ...*ShareInvitation) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddShareInvitationIDs(ids...)
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by IDs.
+func (luo *LoanUpdateOne) AddShareLinkIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddShareLinkIDs(ids...)
+	return luo
+}
+
+// AddShareLinks adds the "share_links" edges to the ShareLink entity.
+func (luo *LoanUpdateOne) AddShareLinks(s ...*ShareLink) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddShareLinkIDs(ids...)
+}
+
+// AddModificationIDs adds the "modifications" edge to the LoanModification entity by IDs.
+func (luo *LoanUpdateOne) AddModificationIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddModificationIDs(ids...)
+	return luo
+}
+
+// AddModifications adds the "modifications" edges to the LoanModification entity.
+func (luo *LoanUpdateOne) AddModifications(l ...*LoanModification) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddModificationIDs(ids...)
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the PaymentDeferral entity by IDs.
+func (luo *LoanUpdateOne) AddDeferralIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddDeferralIDs(ids...)
+	return luo
+}
+
+// AddDeferrals adds the "deferrals" edges to the PaymentDeferral entity.
+func (luo *LoanUpdateOne) AddDeferrals(p ...*PaymentDeferral) *LoanUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return luo.AddDeferralIDs(ids...)
+}
+
+// AddRecastIDs adds the "recasts" edge to the LoanRecast entity by IDs.
+func (luo *LoanUpdateOne) AddRecastIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddRecastIDs(ids...)
+	return luo
+}
+
+// AddRecasts adds the "recasts" edges to the LoanRecast entity.
+func (luo *LoanUpdateOne) AddRecasts(l ...*LoanRecast) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddRecastIDs(ids...)
+}
+
+// SetIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID.
+func (luo *LoanUpdateOne) SetIncomeDrivenPlanID(id int) *LoanUpdateOne {
+	luo.mutation.SetIncomeDrivenPlanID(id)
+	return luo
+}
+
+// SetNillableIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by ID if the given value is not nil.
+func (luo *LoanUpdateOne) SetNillableIncomeDrivenPlanID(id *int) *LoanUpdateOne {
+	if id != nil {
+		luo = luo.SetIncomeDrivenPlanID(*id)
+	}
+	return luo
+}
+
+// SetIncomeDrivenPlan sets the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (luo *LoanUpdateOne) SetIncomeDrivenPlan(i *IncomeDrivenPlan) *LoanUpdateOne {
+	return luo.SetIncomeDrivenPlanID(i.ID)
+}
+
+// AddDisbursementIDs adds the "disbursements" edge to the LoanDisbursement entity by IDs.
+func (luo *LoanUpdateOne) AddDisbursementIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddDisbursementIDs(ids...)
+	return luo
+}
+
+// AddDisbursements adds the "disbursements" edges to the LoanDisbursement entity.
+func (luo *LoanUpdateOne) AddDisbursements(l ...*LoanDisbursement) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddDisbursementIDs(ids...)
+}
+
+// AddEscrowItemIDs adds the "escrow_items" edge to the EscrowItem entity by IDs.
+func (luo *LoanUpdateOne) AddEscrowItemIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddEscrowItemIDs(ids...)
+	return luo
+}
+
+// AddEscrowItems adds the "escrow_items" edges to the EscrowItem entity.
+func (luo *LoanUpdateOne) AddEscrowItems(e ...*EscrowItem) *LoanUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return luo.AddEscrowItemIDs(ids...)
+}
+
+// AddObligorIDs adds the "obligors" edge to the LoanObligor entity by IDs.
+func (luo *LoanUpdateOne) AddObligorIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddObligorIDs(ids...)
+	return luo
+}
+
+// AddObligors adds the "obligors" edges to the LoanObligor entity.
+func (luo *LoanUpdateOne) AddObligors(l ...*LoanObligor) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.AddObligorIDs(ids...)
+}
+
+// AddCollateralIDs adds the "collateral" edge to the Collateral entity by IDs.
+func (luo *LoanUpdateOne) AddCollateralIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.AddCollateralIDs(ids...)
+	return luo
+}
+
+// AddCollateral adds the "collateral" edges to the Collateral entity.
+func (luo *LoanUpdateOne) AddCollateral(c ...*Collateral) *LoanUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return luo.AddCollateralIDs(ids...)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (luo *LoanUpdateOne) Mutation() *LoanMutation {
+	return luo.mutation
 }
 
 // ClearBorrower clears the "borrower" edge to the User entity.
@@ -425,25 +1813,220 @@ func (luo *LoanUpdateOne) ClearBorrower() *LoanUpdateOne {
 	return luo
 }
 
-// ClearSharedLoan clears all "shared_loan" edges to the SharedLoan entity.
-func (luo *LoanUpdateOne) ClearSharedLoan() *LoanUpdateOne {
-	luo.mutation.ClearSharedLoan()
+// ClearSharedLoan clears all "shared_loan" edges to the SharedLoan entity.
+func (luo *LoanUpdateOne) ClearSharedLoan() *LoanUpdateOne {
+	luo.mutation.ClearSharedLoan()
+	return luo
+}
+
+// RemoveSharedLoanIDs removes the "shared_loan" edge to SharedLoan entities by IDs.
+func (luo *LoanUpdateOne) RemoveSharedLoanIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveSharedLoanIDs(ids...)
+	return luo
+}
+
+// RemoveSharedLoan removes "shared_loan" edges to SharedLoan entities.
+func (luo *LoanUpdateOne) RemoveSharedLoan(s ...*SharedLoan) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveSharedLoanIDs(ids...)
+}
+
+// ClearShareInvitations clears all "share_invitations" edges to the ShareInvitation entity.
+func (luo *LoanUpdateOne) ClearShareInvitations() *LoanUpdateOne {
+	luo.mutation.ClearShareInvitations()
+	return luo
+}
+
+// RemoveShareInvitationIDs removes the "share_invitations" edge to ShareInvitation entities by IDs.
+func (luo *LoanUpdateOne) RemoveShareInvitationIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveShareInvitationIDs(ids...)
+	return luo
+}
+
+// RemoveShareInvitations removes "share_invitations" edges to ShareInvitation entities.
+func (luo *LoanUpdateOne) RemoveShareInvitations(s ...*ShareInvitation) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveShareInvitationIDs(ids...)
+}
+
+// ClearShareLinks clears all "share_links" edges to the ShareLink entity.
+func (luo *LoanUpdateOne) ClearShareLinks() *LoanUpdateOne {
+	luo.mutation.ClearShareLinks()
+	return luo
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to ShareLink entities by IDs.
+func (luo *LoanUpdateOne) RemoveShareLinkIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveShareLinkIDs(ids...)
+	return luo
+}
+
+// RemoveShareLinks removes "share_links" edges to ShareLink entities.
+func (luo *LoanUpdateOne) RemoveShareLinks(s ...*ShareLink) *LoanUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveShareLinkIDs(ids...)
+}
+
+// ClearModifications clears all "modifications" edges to the LoanModification entity.
+func (luo *LoanUpdateOne) ClearModifications() *LoanUpdateOne {
+	luo.mutation.ClearModifications()
+	return luo
+}
+
+// RemoveModificationIDs removes the "modifications" edge to LoanModification entities by IDs.
+func (luo *LoanUpdateOne) RemoveModificationIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveModificationIDs(ids...)
+	return luo
+}
+
+// RemoveModifications removes "modifications" edges to LoanModification entities.
+func (luo *LoanUpdateOne) RemoveModifications(l ...*LoanModification) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveModificationIDs(ids...)
+}
+
+// ClearDeferrals clears all "deferrals" edges to the PaymentDeferral entity.
+func (luo *LoanUpdateOne) ClearDeferrals() *LoanUpdateOne {
+	luo.mutation.ClearDeferrals()
+	return luo
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to PaymentDeferral entities by IDs.
+func (luo *LoanUpdateOne) RemoveDeferralIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveDeferralIDs(ids...)
+	return luo
+}
+
+// RemoveDeferrals removes "deferrals" edges to PaymentDeferral entities.
+func (luo *LoanUpdateOne) RemoveDeferrals(p ...*PaymentDeferral) *LoanUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return luo.RemoveDeferralIDs(ids...)
+}
+
+// ClearRecasts clears all "recasts" edges to the LoanRecast entity.
+func (luo *LoanUpdateOne) ClearRecasts() *LoanUpdateOne {
+	luo.mutation.ClearRecasts()
+	return luo
+}
+
+// RemoveRecastIDs removes the "recasts" edge to LoanRecast entities by IDs.
+func (luo *LoanUpdateOne) RemoveRecastIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveRecastIDs(ids...)
+	return luo
+}
+
+// RemoveRecasts removes "recasts" edges to LoanRecast entities.
+func (luo *LoanUpdateOne) RemoveRecasts(l ...*LoanRecast) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveRecastIDs(ids...)
+}
+
+// ClearIncomeDrivenPlan clears the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (luo *LoanUpdateOne) ClearIncomeDrivenPlan() *LoanUpdateOne {
+	luo.mutation.ClearIncomeDrivenPlan()
+	return luo
+}
+
+// ClearDisbursements clears all "disbursements" edges to the LoanDisbursement entity.
+func (luo *LoanUpdateOne) ClearDisbursements() *LoanUpdateOne {
+	luo.mutation.ClearDisbursements()
+	return luo
+}
+
+// RemoveDisbursementIDs removes the "disbursements" edge to LoanDisbursement entities by IDs.
+func (luo *LoanUpdateOne) RemoveDisbursementIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveDisbursementIDs(ids...)
+	return luo
+}
+
+// RemoveDisbursements removes "disbursements" edges to LoanDisbursement entities.
+func (luo *LoanUpdateOne) RemoveDisbursements(l ...*LoanDisbursement) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveDisbursementIDs(ids...)
+}
+
+// ClearEscrowItems clears all "escrow_items" edges to the EscrowItem entity.
+func (luo *LoanUpdateOne) ClearEscrowItems() *LoanUpdateOne {
+	luo.mutation.ClearEscrowItems()
+	return luo
+}
+
+// RemoveEscrowItemIDs removes the "escrow_items" edge to EscrowItem entities by IDs.
+func (luo *LoanUpdateOne) RemoveEscrowItemIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveEscrowItemIDs(ids...)
+	return luo
+}
+
+// RemoveEscrowItems removes "escrow_items" edges to EscrowItem entities.
+func (luo *LoanUpdateOne) RemoveEscrowItems(e ...*EscrowItem) *LoanUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return luo.RemoveEscrowItemIDs(ids...)
+}
+
+// ClearObligors clears all "obligors" edges to the LoanObligor entity.
+func (luo *LoanUpdateOne) ClearObligors() *LoanUpdateOne {
+	luo.mutation.ClearObligors()
+	return luo
+}
+
+// RemoveObligorIDs removes the "obligors" edge to LoanObligor entities by IDs.
+func (luo *LoanUpdateOne) RemoveObligorIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveObligorIDs(ids...)
+	return luo
+}
+
+// RemoveObligors removes "obligors" edges to LoanObligor entities.
+func (luo *LoanUpdateOne) RemoveObligors(l ...*LoanObligor) *LoanUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return luo.RemoveObligorIDs(ids...)
+}
+
+// ClearCollateral clears all "collateral" edges to the Collateral entity.
+func (luo *LoanUpdateOne) ClearCollateral() *LoanUpdateOne {
+	luo.mutation.ClearCollateral()
 	return luo
 }
 
-// RemoveSharedLoanIDs removes the "shared_loan" edge to SharedLoan entities by IDs.
-func (luo *LoanUpdateOne) RemoveSharedLoanIDs(ids ...int) *LoanUpdateOne {
-	luo.mutation.RemoveSharedLoanIDs(ids...)
+// RemoveCollateralIDs removes the "collateral" edge to Collateral entities by IDs.
+func (luo *LoanUpdateOne) RemoveCollateralIDs(ids ...int) *LoanUpdateOne {
+	luo.mutation.RemoveCollateralIDs(ids...)
 	return luo
 }
 
-// RemoveSharedLoan removes "shared_loan" edges to SharedLoan entities.
-func (luo *LoanUpdateOne) RemoveSharedLoan(s ...*SharedLoan) *LoanUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveCollateral removes "collateral" edges to Collateral entities.
+func (luo *LoanUpdateOne) RemoveCollateral(c ...*Collateral) *LoanUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return luo.RemoveSharedLoanIDs(ids...)
+	return luo.RemoveCollateralIDs(ids...)
 }
 
 // Where appends a list predicates to the LoanUpdate builder.
@@ -470,99 +2053,551 @@ func (luo *LoanUpdateOne) SaveX(ctx context.Context) *Loan {
 	if err != nil {
 		panic(err)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (luo *LoanUpdateOne) Exec(ctx context.Context) error {
-	_, err := luo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (luo *LoanUpdateOne) ExecX(ctx context.Context) {
-	if err := luo.Exec(ctx); err != nil {
-		panic(err)
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LoanUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LoanUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LoanUpdateOne) check() error {
+	if _, ok := luo.mutation.BorrowerID(); luo.mutation.BorrowerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Loan.borrower"`)
+	}
+	return nil
+}
+
+func (luo *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Loan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loan.FieldID)
+		for _, f := range fields {
+			if !loan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Amount(); ok {
+		_spec.SetField(loan.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedAmount(); ok {
+		_spec.AddField(loan.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.Rate(); ok {
+		_spec.SetField(loan.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedRate(); ok {
+		_spec.AddField(loan.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.Term(); ok {
+		_spec.SetField(loan.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedTerm(); ok {
+		_spec.AddField(loan.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.GraduatedStepRate(); ok {
+		_spec.SetField(loan.FieldGraduatedStepRate, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedGraduatedStepRate(); ok {
+		_spec.AddField(loan.FieldGraduatedStepRate, field.TypeFloat64, value)
+	}
+	if luo.mutation.GraduatedStepRateCleared() {
+		_spec.ClearField(loan.FieldGraduatedStepRate, field.TypeFloat64)
+	}
+	if value, ok := luo.mutation.GraduatedYears(); ok {
+		_spec.SetField(loan.FieldGraduatedYears, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedGraduatedYears(); ok {
+		_spec.AddField(loan.FieldGraduatedYears, field.TypeInt, value)
+	}
+	if luo.mutation.GraduatedYearsCleared() {
+		_spec.ClearField(loan.FieldGraduatedYears, field.TypeInt)
+	}
+	if value, ok := luo.mutation.NegativeAmortizationCap(); ok {
+		_spec.SetField(loan.FieldNegativeAmortizationCap, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedNegativeAmortizationCap(); ok {
+		_spec.AddField(loan.FieldNegativeAmortizationCap, field.TypeFloat64, value)
+	}
+	if luo.mutation.NegativeAmortizationCapCleared() {
+		_spec.ClearField(loan.FieldNegativeAmortizationCap, field.TypeFloat64)
+	}
+	if value, ok := luo.mutation.ConstructionMonths(); ok {
+		_spec.SetField(loan.FieldConstructionMonths, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedConstructionMonths(); ok {
+		_spec.AddField(loan.FieldConstructionMonths, field.TypeInt, value)
+	}
+	if luo.mutation.ConstructionMonthsCleared() {
+		_spec.ClearField(loan.FieldConstructionMonths, field.TypeInt)
+	}
+	if value, ok := luo.mutation.PropertyValue(); ok {
+		_spec.SetField(loan.FieldPropertyValue, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedPropertyValue(); ok {
+		_spec.AddField(loan.FieldPropertyValue, field.TypeInt, value)
+	}
+	if luo.mutation.PropertyValueCleared() {
+		_spec.ClearField(loan.FieldPropertyValue, field.TypeInt)
+	}
+	if value, ok := luo.mutation.PmiRate(); ok {
+		_spec.SetField(loan.FieldPmiRate, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedPmiRate(); ok {
+		_spec.AddField(loan.FieldPmiRate, field.TypeFloat64, value)
+	}
+	if luo.mutation.PmiRateCleared() {
+		_spec.ClearField(loan.FieldPmiRate, field.TypeFloat64)
+	}
+	if value, ok := luo.mutation.PmiCancellationMonth(); ok {
+		_spec.SetField(loan.FieldPmiCancellationMonth, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedPmiCancellationMonth(); ok {
+		_spec.AddField(loan.FieldPmiCancellationMonth, field.TypeInt, value)
+	}
+	if luo.mutation.PmiCancellationMonthCleared() {
+		_spec.ClearField(loan.FieldPmiCancellationMonth, field.TypeInt)
+	}
+	if luo.mutation.BorrowerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BorrowerTable,
+			Columns: []string{loan.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.BorrowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BorrowerTable,
+			Columns: []string{loan.BorrowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.SharedLoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.SharedLoanTable,
+			Columns: []string{loan.SharedLoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedSharedLoanIDs(); len(nodes) > 0 && !luo.mutation.SharedLoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.SharedLoanTable,
+			Columns: []string{loan.SharedLoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.SharedLoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.SharedLoanTable,
+			Columns: []string{loan.SharedLoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedShareInvitationsIDs(); len(nodes) > 0 && !luo.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareInvitationsTable,
+			Columns: []string{loan.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedShareLinksIDs(); len(nodes) > 0 && !luo.mutation.ShareLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ShareLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ShareLinksTable,
+			Columns: []string{loan.ShareLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ModificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedModificationsIDs(); len(nodes) > 0 && !luo.mutation.ModificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (luo *LoanUpdateOne) check() error {
-	if _, ok := luo.mutation.BorrowerID(); luo.mutation.BorrowerCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Loan.borrower"`)
+	if nodes := luo.mutation.ModificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.ModificationsTable,
+			Columns: []string{loan.ModificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (luo *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
-	if err := luo.check(); err != nil {
-		return _node, err
+	if luo.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt))
-	id, ok := luo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Loan.id" for update`)}
+	if nodes := luo.mutation.RemovedDeferralsIDs(); len(nodes) > 0 && !luo.mutation.DeferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := luo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, loan.FieldID)
-		for _, f := range fields {
-			if !loan.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != loan.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := luo.mutation.DeferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DeferralsTable,
+			Columns: []string{loan.DeferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := luo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if luo.mutation.RecastsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := luo.mutation.Amount(); ok {
-		_spec.SetField(loan.FieldAmount, field.TypeInt, value)
+	if nodes := luo.mutation.RemovedRecastsIDs(); len(nodes) > 0 && !luo.mutation.RecastsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := luo.mutation.AddedAmount(); ok {
-		_spec.AddField(loan.FieldAmount, field.TypeInt, value)
+	if nodes := luo.mutation.RecastsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.RecastsTable,
+			Columns: []string{loan.RecastsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := luo.mutation.Rate(); ok {
-		_spec.SetField(loan.FieldRate, field.TypeFloat64, value)
+	if luo.mutation.IncomeDrivenPlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   loan.IncomeDrivenPlanTable,
+			Columns: []string{loan.IncomeDrivenPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := luo.mutation.AddedRate(); ok {
-		_spec.AddField(loan.FieldRate, field.TypeFloat64, value)
+	if nodes := luo.mutation.IncomeDrivenPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   loan.IncomeDrivenPlanTable,
+			Columns: []string{loan.IncomeDrivenPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(incomedrivenplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := luo.mutation.Term(); ok {
-		_spec.SetField(loan.FieldTerm, field.TypeInt, value)
+	if luo.mutation.DisbursementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := luo.mutation.AddedTerm(); ok {
-		_spec.AddField(loan.FieldTerm, field.TypeInt, value)
+	if nodes := luo.mutation.RemovedDisbursementsIDs(); len(nodes) > 0 && !luo.mutation.DisbursementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if luo.mutation.BorrowerCleared() {
+	if nodes := luo.mutation.DisbursementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   loan.BorrowerTable,
-			Columns: []string{loan.BorrowerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.DisbursementsTable,
+			Columns: []string{loan.DisbursementsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.EscrowItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := luo.mutation.BorrowerIDs(); len(nodes) > 0 {
+	if nodes := luo.mutation.RemovedEscrowItemsIDs(); len(nodes) > 0 && !luo.mutation.EscrowItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   loan.BorrowerTable,
-			Columns: []string{loan.BorrowerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.EscrowItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   loan.EscrowItemsTable,
+			Columns: []string{loan.EscrowItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(escrowitem.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -570,28 +2605,28 @@ func (luo *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if luo.mutation.SharedLoanCleared() {
+	if luo.mutation.ObligorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   loan.SharedLoanTable,
-			Columns: []string{loan.SharedLoanColumn},
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := luo.mutation.RemovedSharedLoanIDs(); len(nodes) > 0 && !luo.mutation.SharedLoanCleared() {
+	if nodes := luo.mutation.RemovedObligorsIDs(); len(nodes) > 0 && !luo.mutation.ObligorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   loan.SharedLoanTable,
-			Columns: []string{loan.SharedLoanColumn},
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -599,15 +2634,60 @@ func (luo *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := luo.mutation.SharedLoanIDs(); len(nodes) > 0 {
+	if nodes := luo.mutation.ObligorsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   loan.SharedLoanTable,
-			Columns: []string{loan.SharedLoanColumn},
+			Table:   loan.ObligorsTable,
+			Columns: []string{loan.ObligorsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedCollateralIDs(); len(nodes) > 0 && !luo.mutation.CollateralCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.CollateralIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   loan.CollateralTable,
+			Columns: loan.CollateralPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collateral.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+)
+
+// LoanDisbursement is the model entity for the LoanDisbursement schema.
+type LoanDisbursement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// Month holds the value of the "month" field.
+	Month int `json:"month,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int `json:"amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanDisbursementQuery when eager-loading is set.
+	Edges        LoanDisbursementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoanDisbursementEdges holds the relations/edges for other nodes in the graph.
+type LoanDisbursementEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanDisbursementEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanDisbursement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loandisbursement.FieldID, loandisbursement.FieldLoanID, loandisbursement.FieldMonth, loandisbursement.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case loandisbursement.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanDisbursement fields.
+func (ld *LoanDisbursement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loandisbursement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ld.ID = int(value.Int64)
+		case loandisbursement.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				ld.LoanID = int(value.Int64)
+			}
+		case loandisbursement.FieldMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				ld.Month = int(value.Int64)
+			}
+		case loandisbursement.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ld.Amount = int(value.Int64)
+			}
+		case loandisbursement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ld.CreatedAt = value.Time
+			}
+		default:
+			ld.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanDisbursement.
+// This includes values selected through modifiers, order, etc.
+func (ld *LoanDisbursement) Value(name string) (ent.Value, error) {
+	return ld.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the LoanDisbursement entity.
+func (ld *LoanDisbursement) QueryLoan() *LoanQuery {
+	return NewLoanDisbursementClient(ld.config).QueryLoan(ld)
+}
+
+// Update returns a builder for updating this LoanDisbursement.
+// Note that you need to call LoanDisbursement.Unwrap() before calling this method if this LoanDisbursement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ld *LoanDisbursement) Update() *LoanDisbursementUpdateOne {
+	return NewLoanDisbursementClient(ld.config).UpdateOne(ld)
+}
+
+// Unwrap unwraps the LoanDisbursement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ld *LoanDisbursement) Unwrap() *LoanDisbursement {
+	_tx, ok := ld.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanDisbursement is not a transactional entity")
+	}
+	ld.config.driver = _tx.drv
+	return ld
+}
+
+// String implements the fmt.Stringer.
+func (ld *LoanDisbursement) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanDisbursement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ld.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", ld.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("month=")
+	builder.WriteString(fmt.Sprintf("%v", ld.Month))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ld.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ld.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanDisbursements is a parsable slice of LoanDisbursement.
+type LoanDisbursements []*LoanDisbursement
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loandisbursement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldLoanID, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldMonth, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLTE(FieldMonth, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLTE(FieldAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanDisbursement) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanDisbursement) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanDisbursement) predicate.LoanDisbursement {
+	return predicate.LoanDisbursement(sql.NotPredicates(p))
+}
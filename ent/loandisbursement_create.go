@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+)
+
+// LoanDisbursementCreate is the builder for creating a LoanDisbursement entity.
+type LoanDisbursementCreate struct {
+	config
+	mutation *LoanDisbursementMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (ldc *LoanDisbursementCreate) SetLoanID(i int) *LoanDisbursementCreate {
+	ldc.mutation.SetLoanID(i)
+	return ldc
+}
+
+// SetMonth sets the "month" field.
+func (ldc *LoanDisbursementCreate) SetMonth(i int) *LoanDisbursementCreate {
+	ldc.mutation.SetMonth(i)
+	return ldc
+}
+
+// SetAmount sets the "amount" field.
+func (ldc *LoanDisbursementCreate) SetAmount(i int) *LoanDisbursementCreate {
+	ldc.mutation.SetAmount(i)
+	return ldc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ldc *LoanDisbursementCreate) SetCreatedAt(t time.Time) *LoanDisbursementCreate {
+	ldc.mutation.SetCreatedAt(t)
+	return ldc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ldc *LoanDisbursementCreate) SetNillableCreatedAt(t *time.Time) *LoanDisbursementCreate {
+	if t != nil {
+		ldc.SetCreatedAt(*t)
+	}
+	return ldc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (ldc *LoanDisbursementCreate) SetLoan(l *Loan) *LoanDisbursementCreate {
+	return ldc.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanDisbursementMutation object of the builder.
+func (ldc *LoanDisbursementCreate) Mutation() *LoanDisbursementMutation {
+	return ldc.mutation
+}
+
+// Save creates the LoanDisbursement in the database.
+func (ldc *LoanDisbursementCreate) Save(ctx context.Context) (*LoanDisbursement, error) {
+	ldc.defaults()
+	return withHooks(ctx, ldc.sqlSave, ldc.mutation, ldc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ldc *LoanDisbursementCreate) SaveX(ctx context.Context) *LoanDisbursement {
+	v, err := ldc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ldc *LoanDisbursementCreate) Exec(ctx context.Context) error {
+	_, err := ldc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ldc *LoanDisbursementCreate) ExecX(ctx context.Context) {
+	if err := ldc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ldc *LoanDisbursementCreate) defaults() {
+	if _, ok := ldc.mutation.CreatedAt(); !ok {
+		v := loandisbursement.DefaultCreatedAt()
+		ldc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ldc *LoanDisbursementCreate) check() error {
+	if _, ok := ldc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanDisbursement.loan_id"`)}
+	}
+	if _, ok := ldc.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "LoanDisbursement.month"`)}
+	}
+	if _, ok := ldc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LoanDisbursement.amount"`)}
+	}
+	if _, ok := ldc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanDisbursement.created_at"`)}
+	}
+	if _, ok := ldc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanDisbursement.loan"`)}
+	}
+	return nil
+}
+
+func (ldc *LoanDisbursementCreate) sqlSave(ctx context.Context) (*LoanDisbursement, error) {
+	if err := ldc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ldc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ldc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ldc.mutation.id = &_node.ID
+	ldc.mutation.done = true
+	return _node, nil
+}
+
+func (ldc *LoanDisbursementCreate) createSpec() (*LoanDisbursement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanDisbursement{config: ldc.config}
+		_spec = sqlgraph.NewCreateSpec(loandisbursement.Table, sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt))
+	)
+	if value, ok := ldc.mutation.Month(); ok {
+		_spec.SetField(loandisbursement.FieldMonth, field.TypeInt, value)
+		_node.Month = value
+	}
+	if value, ok := ldc.mutation.Amount(); ok {
+		_spec.SetField(loandisbursement.FieldAmount, field.TypeInt, value)
+		_node.Amount = value
+	}
+	if value, ok := ldc.mutation.CreatedAt(); ok {
+		_spec.SetField(loandisbursement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ldc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loandisbursement.LoanTable,
+			Columns: []string{loandisbursement.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoanDisbursementCreateBulk is the builder for creating many LoanDisbursement entities in bulk.
+type LoanDisbursementCreateBulk struct {
+	config
+	err      error
+	builders []*LoanDisbursementCreate
+}
+
+// Save creates the LoanDisbursement entities in the database.
+func (ldcb *LoanDisbursementCreateBulk) Save(ctx context.Context) ([]*LoanDisbursement, error) {
+	if ldcb.err != nil {
+		return nil, ldcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ldcb.builders))
+	nodes := make([]*LoanDisbursement, len(ldcb.builders))
+	mutators := make([]Mutator, len(ldcb.builders))
+	for i := range ldcb.builders {
+		func(i int, root context.Context) {
+			builder := ldcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanDisbursementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ldcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ldcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ldcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ldcb *LoanDisbursementCreateBulk) SaveX(ctx context.Context) []*LoanDisbursement {
+	v, err := ldcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ldcb *LoanDisbursementCreateBulk) Exec(ctx context.Context) error {
+	_, err := ldcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ldcb *LoanDisbursementCreateBulk) ExecX(ctx context.Context) {
+	if err := ldcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanDisbursementDelete is the builder for deleting a LoanDisbursement entity.
+type LoanDisbursementDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoanDisbursementMutation
+}
+
+// Where appends a list predicates to the LoanDisbursementDelete builder.
+func (ldd *LoanDisbursementDelete) Where(ps ...predicate.LoanDisbursement) *LoanDisbursementDelete {
+	ldd.mutation.Where(ps...)
+	return ldd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ldd *LoanDisbursementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ldd.sqlExec, ldd.mutation, ldd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ldd *LoanDisbursementDelete) ExecX(ctx context.Context) int {
+	n, err := ldd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ldd *LoanDisbursementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loandisbursement.Table, sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt))
+	if ps := ldd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ldd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ldd.mutation.done = true
+	return affected, err
+}
+
+// LoanDisbursementDeleteOne is the builder for deleting a single LoanDisbursement entity.
+type LoanDisbursementDeleteOne struct {
+	ldd *LoanDisbursementDelete
+}
+
+// Where appends a list predicates to the LoanDisbursementDelete builder.
+func (lddo *LoanDisbursementDeleteOne) Where(ps ...predicate.LoanDisbursement) *LoanDisbursementDeleteOne {
+	lddo.ldd.mutation.Where(ps...)
+	return lddo
+}
+
+// Exec executes the deletion query.
+func (lddo *LoanDisbursementDeleteOne) Exec(ctx context.Context) error {
+	n, err := lddo.ldd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loandisbursement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lddo *LoanDisbursementDeleteOne) ExecX(ctx context.Context) {
+	if err := lddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanDisbursementQuery is the builder for querying LoanDisbursement entities.
+type LoanDisbursementQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loandisbursement.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoanDisbursement
+	withLoan   *LoanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoanDisbursementQuery builder.
+func (ldq *LoanDisbursementQuery) Where(ps ...predicate.LoanDisbursement) *LoanDisbursementQuery {
+	ldq.predicates = append(ldq.predicates, ps...)
+	return ldq
+}
+
+// Limit the number of records to be returned by this query.
+func (ldq *LoanDisbursementQuery) Limit(limit int) *LoanDisbursementQuery {
+	ldq.ctx.Limit = &limit
+	return ldq
+}
+
+// Offset to start from.
+func (ldq *LoanDisbursementQuery) Offset(offset int) *LoanDisbursementQuery {
+	ldq.ctx.Offset = &offset
+	return ldq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ldq *LoanDisbursementQuery) Unique(unique bool) *LoanDisbursementQuery {
+	ldq.ctx.Unique = &unique
+	return ldq
+}
+
+// Order specifies how the records should be ordered.
+func (ldq *LoanDisbursementQuery) Order(o ...loandisbursement.OrderOption) *LoanDisbursementQuery {
+	ldq.order = append(ldq.order, o...)
+	return ldq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (ldq *LoanDisbursementQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: ldq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ldq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ldq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loandisbursement.Table, loandisbursement.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loandisbursement.LoanTable, loandisbursement.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ldq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LoanDisbursement entity from the query.
+// Returns a *NotFoundError when no LoanDisbursement was found.
+func (ldq *LoanDisbursementQuery) First(ctx context.Context) (*LoanDisbursement, error) {
+	nodes, err := ldq.Limit(1).All(setContextOp(ctx, ldq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loandisbursement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) FirstX(ctx context.Context) *LoanDisbursement {
+	node, err := ldq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoanDisbursement ID from the query.
+// Returns a *NotFoundError when no LoanDisbursement ID was found.
+func (ldq *LoanDisbursementQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ldq.Limit(1).IDs(setContextOp(ctx, ldq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loandisbursement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) FirstIDX(ctx context.Context) int {
+	id, err := ldq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoanDisbursement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoanDisbursement entity is found.
+// Returns a *NotFoundError when no LoanDisbursement entities are found.
+func (ldq *LoanDisbursementQuery) Only(ctx context.Context) (*LoanDisbursement, error) {
+	nodes, err := ldq.Limit(2).All(setContextOp(ctx, ldq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loandisbursement.Label}
+	default:
+		return nil, &NotSingularError{loandisbursement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) OnlyX(ctx context.Context) *LoanDisbursement {
+	node, err := ldq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoanDisbursement ID in the query.
+// Returns a *NotSingularError when more than one LoanDisbursement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ldq *LoanDisbursementQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ldq.Limit(2).IDs(setContextOp(ctx, ldq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loandisbursement.Label}
+	default:
+		err = &NotSingularError{loandisbursement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ldq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoanDisbursements.
+func (ldq *LoanDisbursementQuery) All(ctx context.Context) ([]*LoanDisbursement, error) {
+	ctx = setContextOp(ctx, ldq.ctx, "All")
+	if err := ldq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoanDisbursement, *LoanDisbursementQuery]()
+	return withInterceptors[[]*LoanDisbursement](ctx, ldq, qr, ldq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) AllX(ctx context.Context) []*LoanDisbursement {
+	nodes, err := ldq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoanDisbursement IDs.
+func (ldq *LoanDisbursementQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ldq.ctx.Unique == nil && ldq.path != nil {
+		ldq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ldq.ctx, "IDs")
+	if err = ldq.Select(loandisbursement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) IDsX(ctx context.Context) []int {
+	ids, err := ldq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ldq *LoanDisbursementQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ldq.ctx, "Count")
+	if err := ldq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ldq, querierCount[*LoanDisbursementQuery](), ldq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) CountX(ctx context.Context) int {
+	count, err := ldq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ldq *LoanDisbursementQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ldq.ctx, "Exist")
+	switch _, err := ldq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ldq *LoanDisbursementQuery) ExistX(ctx context.Context) bool {
+	exist, err := ldq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoanDisbursementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ldq *LoanDisbursementQuery) Clone() *LoanDisbursementQuery {
+	if ldq == nil {
+		return nil
+	}
+	return &LoanDisbursementQuery{
+		config:     ldq.config,
+		ctx:        ldq.ctx.Clone(),
+		order:      append([]loandisbursement.OrderOption{}, ldq.order...),
+		inters:     append([]Interceptor{}, ldq.inters...),
+		predicates: append([]predicate.LoanDisbursement{}, ldq.predicates...),
+		withLoan:   ldq.withLoan.Clone(),
+		// clone intermediate query.
+		sql:  ldq.sql.Clone(),
+		path: ldq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (ldq *LoanDisbursementQuery) WithLoan(opts ...func(*LoanQuery)) *LoanDisbursementQuery {
+	query := (&LoanClient{config: ldq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ldq.withLoan = query
+	return ldq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoanDisbursement.Query().
+//		GroupBy(loandisbursement.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ldq *LoanDisbursementQuery) GroupBy(field string, fields ...string) *LoanDisbursementGroupBy {
+	ldq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoanDisbursementGroupBy{build: ldq}
+	grbuild.flds = &ldq.ctx.Fields
+	grbuild.label = loandisbursement.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.LoanDisbursement.Query().
+//		Select(loandisbursement.FieldLoanID).
+//		Scan(ctx, &v)
+func (ldq *LoanDisbursementQuery) Select(fields ...string) *LoanDisbursementSelect {
+	ldq.ctx.Fields = append(ldq.ctx.Fields, fields...)
+	sbuild := &LoanDisbursementSelect{LoanDisbursementQuery: ldq}
+	sbuild.label = loandisbursement.Label
+	sbuild.flds, sbuild.scan = &ldq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoanDisbursementSelect configured with the given aggregations.
+func (ldq *LoanDisbursementQuery) Aggregate(fns ...AggregateFunc) *LoanDisbursementSelect {
+	return ldq.Select().Aggregate(fns...)
+}
+
+func (ldq *LoanDisbursementQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ldq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ldq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ldq.ctx.Fields {
+		if !loandisbursement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ldq.path != nil {
+		prev, err := ldq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ldq.sql = prev
+	}
+	return nil
+}
+
+func (ldq *LoanDisbursementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoanDisbursement, error) {
+	var (
+		nodes       = []*LoanDisbursement{}
+		_spec       = ldq.querySpec()
+		loadedTypes = [1]bool{
+			ldq.withLoan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoanDisbursement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoanDisbursement{config: ldq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ldq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ldq.withLoan; query != nil {
+		if err := ldq.loadLoan(ctx, query, nodes, nil,
+			func(n *LoanDisbursement, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ldq *LoanDisbursementQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*LoanDisbursement, init func(*LoanDisbursement), assign func(*LoanDisbursement, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LoanDisbursement)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ldq *LoanDisbursementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ldq.querySpec()
+	_spec.Node.Columns = ldq.ctx.Fields
+	if len(ldq.ctx.Fields) > 0 {
+		_spec.Unique = ldq.ctx.Unique != nil && *ldq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ldq.driver, _spec)
+}
+
+func (ldq *LoanDisbursementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loandisbursement.Table, loandisbursement.Columns, sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt))
+	_spec.From = ldq.sql
+	if unique := ldq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ldq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ldq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loandisbursement.FieldID)
+		for i := range fields {
+			if fields[i] != loandisbursement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ldq.withLoan != nil {
+			_spec.Node.AddColumnOnce(loandisbursement.FieldLoanID)
+		}
+	}
+	if ps := ldq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ldq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ldq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ldq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ldq *LoanDisbursementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ldq.driver.Dialect())
+	t1 := builder.Table(loandisbursement.Table)
+	columns := ldq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loandisbursement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ldq.sql != nil {
+		selector = ldq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ldq.ctx.Unique != nil && *ldq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ldq.predicates {
+		p(selector)
+	}
+	for _, p := range ldq.order {
+		p(selector)
+	}
+	if offset := ldq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ldq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoanDisbursementGroupBy is the group-by builder for LoanDisbursement entities.
+type LoanDisbursementGroupBy struct {
+	selector
+	build *LoanDisbursementQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ldgb *LoanDisbursementGroupBy) Aggregate(fns ...AggregateFunc) *LoanDisbursementGroupBy {
+	ldgb.fns = append(ldgb.fns, fns...)
+	return ldgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ldgb *LoanDisbursementGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ldgb.build.ctx, "GroupBy")
+	if err := ldgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanDisbursementQuery, *LoanDisbursementGroupBy](ctx, ldgb.build, ldgb, ldgb.build.inters, v)
+}
+
+func (ldgb *LoanDisbursementGroupBy) sqlScan(ctx context.Context, root *LoanDisbursementQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ldgb.fns))
+	for _, fn := range ldgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ldgb.flds)+len(ldgb.fns))
+		for _, f := range *ldgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ldgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ldgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoanDisbursementSelect is the builder for selecting fields of LoanDisbursement entities.
+type LoanDisbursementSelect struct {
+	*LoanDisbursementQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lds *LoanDisbursementSelect) Aggregate(fns ...AggregateFunc) *LoanDisbursementSelect {
+	lds.fns = append(lds.fns, fns...)
+	return lds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lds *LoanDisbursementSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lds.ctx, "Select")
+	if err := lds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanDisbursementQuery, *LoanDisbursementSelect](ctx, lds.LoanDisbursementQuery, lds, lds.inters, v)
+}
+
+func (lds *LoanDisbursementSelect) sqlScan(ctx context.Context, root *LoanDisbursementQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lds.fns))
+	for _, fn := range lds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanDisbursementUpdate is the builder for updating LoanDisbursement entities.
+type LoanDisbursementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanDisbursementMutation
+}
+
+// Where appends a list predicates to the LoanDisbursementUpdate builder.
+func (ldu *LoanDisbursementUpdate) Where(ps ...predicate.LoanDisbursement) *LoanDisbursementUpdate {
+	ldu.mutation.Where(ps...)
+	return ldu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (ldu *LoanDisbursementUpdate) SetLoanID(i int) *LoanDisbursementUpdate {
+	ldu.mutation.SetLoanID(i)
+	return ldu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (ldu *LoanDisbursementUpdate) SetNillableLoanID(i *int) *LoanDisbursementUpdate {
+	if i != nil {
+		ldu.SetLoanID(*i)
+	}
+	return ldu
+}
+
+// SetMonth sets the "month" field.
+func (ldu *LoanDisbursementUpdate) SetMonth(i int) *LoanDisbursementUpdate {
+	ldu.mutation.ResetMonth()
+	ldu.mutation.SetMonth(i)
+	return ldu
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (ldu *LoanDisbursementUpdate) SetNillableMonth(i *int) *LoanDisbursementUpdate {
+	if i != nil {
+		ldu.SetMonth(*i)
+	}
+	return ldu
+}
+
+// AddMonth adds i to the "month" field.
+func (ldu *LoanDisbursementUpdate) AddMonth(i int) *LoanDisbursementUpdate {
+	ldu.mutation.AddMonth(i)
+	return ldu
+}
+
+// SetAmount sets the "amount" field.
+func (ldu *LoanDisbursementUpdate) SetAmount(i int) *LoanDisbursementUpdate {
+	ldu.mutation.ResetAmount()
+	ldu.mutation.SetAmount(i)
+	return ldu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ldu *LoanDisbursementUpdate) SetNillableAmount(i *int) *LoanDisbursementUpdate {
+	if i != nil {
+		ldu.SetAmount(*i)
+	}
+	return ldu
+}
+
+// AddAmount adds i to the "amount" field.
+func (ldu *LoanDisbursementUpdate) AddAmount(i int) *LoanDisbursementUpdate {
+	ldu.mutation.AddAmount(i)
+	return ldu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (ldu *LoanDisbursementUpdate) SetLoan(l *Loan) *LoanDisbursementUpdate {
+	return ldu.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanDisbursementMutation object of the builder.
+func (ldu *LoanDisbursementUpdate) Mutation() *LoanDisbursementMutation {
+	return ldu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (ldu *LoanDisbursementUpdate) ClearLoan() *LoanDisbursementUpdate {
+	ldu.mutation.ClearLoan()
+	return ldu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ldu *LoanDisbursementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ldu.sqlSave, ldu.mutation, ldu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ldu *LoanDisbursementUpdate) SaveX(ctx context.Context) int {
+	affected, err := ldu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ldu *LoanDisbursementUpdate) Exec(ctx context.Context) error {
+	_, err := ldu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ldu *LoanDisbursementUpdate) ExecX(ctx context.Context) {
+	if err := ldu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ldu *LoanDisbursementUpdate) check() error {
+	if _, ok := ldu.mutation.LoanID(); ldu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanDisbursement.loan"`)
+	}
+	return nil
+}
+
+func (ldu *LoanDisbursementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ldu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loandisbursement.Table, loandisbursement.Columns, sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt))
+	if ps := ldu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ldu.mutation.Month(); ok {
+		_spec.SetField(loandisbursement.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := ldu.mutation.AddedMonth(); ok {
+		_spec.AddField(loandisbursement.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := ldu.mutation.Amount(); ok {
+		_spec.SetField(loandisbursement.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := ldu.mutation.AddedAmount(); ok {
+		_spec.AddField(loandisbursement.FieldAmount, field.TypeInt, value)
+	}
+	if ldu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loandisbursement.LoanTable,
+			Columns: []string{loandisbursement.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ldu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loandisbursement.LoanTable,
+			Columns: []string{loandisbursement.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ldu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loandisbursement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ldu.mutation.done = true
+	return n, nil
+}
+
+// LoanDisbursementUpdateOne is the builder for updating a single LoanDisbursement entity.
+type LoanDisbursementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanDisbursementMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lduo *LoanDisbursementUpdateOne) SetLoanID(i int) *LoanDisbursementUpdateOne {
+	lduo.mutation.SetLoanID(i)
+	return lduo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lduo *LoanDisbursementUpdateOne) SetNillableLoanID(i *int) *LoanDisbursementUpdateOne {
+	if i != nil {
+		lduo.SetLoanID(*i)
+	}
+	return lduo
+}
+
+// SetMonth sets the "month" field.
+func (lduo *LoanDisbursementUpdateOne) SetMonth(i int) *LoanDisbursementUpdateOne {
+	lduo.mutation.ResetMonth()
+	lduo.mutation.SetMonth(i)
+	return lduo
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (lduo *LoanDisbursementUpdateOne) SetNillableMonth(i *int) *LoanDisbursementUpdateOne {
+	if i != nil {
+		lduo.SetMonth(*i)
+	}
+	return lduo
+}
+
+// AddMonth adds i to the "month" field.
+func (lduo *LoanDisbursementUpdateOne) AddMonth(i int) *LoanDisbursementUpdateOne {
+	lduo.mutation.AddMonth(i)
+	return lduo
+}
+
+// SetAmount sets the "amount" field.
+func (lduo *LoanDisbursementUpdateOne) SetAmount(i int) *LoanDisbursementUpdateOne {
+	lduo.mutation.ResetAmount()
+	lduo.mutation.SetAmount(i)
+	return lduo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (lduo *LoanDisbursementUpdateOne) SetNillableAmount(i *int) *LoanDisbursementUpdateOne {
+	if i != nil {
+		lduo.SetAmount(*i)
+	}
+	return lduo
+}
+
+// AddAmount adds i to the "amount" field.
+func (lduo *LoanDisbursementUpdateOne) AddAmount(i int) *LoanDisbursementUpdateOne {
+	lduo.mutation.AddAmount(i)
+	return lduo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lduo *LoanDisbursementUpdateOne) SetLoan(l *Loan) *LoanDisbursementUpdateOne {
+	return lduo.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanDisbursementMutation object of the builder.
+func (lduo *LoanDisbursementUpdateOne) Mutation() *LoanDisbursementMutation {
+	return lduo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lduo *LoanDisbursementUpdateOne) ClearLoan() *LoanDisbursementUpdateOne {
+	lduo.mutation.ClearLoan()
+	return lduo
+}
+
+// Where appends a list predicates to the LoanDisbursementUpdate builder.
+func (lduo *LoanDisbursementUpdateOne) Where(ps ...predicate.LoanDisbursement) *LoanDisbursementUpdateOne {
+	lduo.mutation.Where(ps...)
+	return lduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lduo *LoanDisbursementUpdateOne) Select(field string, fields ...string) *LoanDisbursementUpdateOne {
+	lduo.fields = append([]string{field}, fields...)
+	return lduo
+}
+
+// Save executes the query and returns the updated LoanDisbursement entity.
+func (lduo *LoanDisbursementUpdateOne) Save(ctx context.Context) (*LoanDisbursement, error) {
+	return withHooks(ctx, lduo.sqlSave, lduo.mutation, lduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lduo *LoanDisbursementUpdateOne) SaveX(ctx context.Context) *LoanDisbursement {
+	node, err := lduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lduo *LoanDisbursementUpdateOne) Exec(ctx context.Context) error {
+	_, err := lduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lduo *LoanDisbursementUpdateOne) ExecX(ctx context.Context) {
+	if err := lduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lduo *LoanDisbursementUpdateOne) check() error {
+	if _, ok := lduo.mutation.LoanID(); lduo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanDisbursement.loan"`)
+	}
+	return nil
+}
+
+func (lduo *LoanDisbursementUpdateOne) sqlSave(ctx context.Context) (_node *LoanDisbursement, err error) {
+	if err := lduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loandisbursement.Table, loandisbursement.Columns, sqlgraph.NewFieldSpec(loandisbursement.FieldID, field.TypeInt))
+	id, ok := lduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanDisbursement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loandisbursement.FieldID)
+		for _, f := range fields {
+			if !loandisbursement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loandisbursement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lduo.mutation.Month(); ok {
+		_spec.SetField(loandisbursement.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lduo.mutation.AddedMonth(); ok {
+		_spec.AddField(loandisbursement.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lduo.mutation.Amount(); ok {
+		_spec.SetField(loandisbursement.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := lduo.mutation.AddedAmount(); ok {
+		_spec.AddField(loandisbursement.FieldAmount, field.TypeInt, value)
+	}
+	if lduo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loandisbursement.LoanTable,
+			Columns: []string{loandisbursement.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lduo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loandisbursement.LoanTable,
+			Columns: []string{loandisbursement.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanDisbursement{config: lduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loandisbursement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanmodification"
+)
+
+// LoanModification is the model entity for the LoanModification schema.
+type LoanModification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// EffectiveMonth holds the value of the "effective_month" field.
+	EffectiveMonth int `json:"effective_month,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// Term holds the value of the "term" field.
+	Term int `json:"term,omitempty"`
+	// CapitalizedArrears holds the value of the "capitalized_arrears" field.
+	CapitalizedArrears int `json:"capitalized_arrears,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanModificationQuery when eager-loading is set.
+	Edges        LoanModificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoanModificationEdges holds the relations/edges for other nodes in the graph.
+type LoanModificationEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanModificationEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanModification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loanmodification.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case loanmodification.FieldID, loanmodification.FieldLoanID, loanmodification.FieldEffectiveMonth, loanmodification.FieldTerm, loanmodification.FieldCapitalizedArrears:
+			values[i] = new(sql.NullInt64)
+		case loanmodification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanModification fields.
+func (lm *LoanModification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loanmodification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lm.ID = int(value.Int64)
+		case loanmodification.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				lm.LoanID = int(value.Int64)
+			}
+		case loanmodification.FieldEffectiveMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_month", values[i])
+			} else if value.Valid {
+				lm.EffectiveMonth = int(value.Int64)
+			}
+		case loanmodification.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				lm.Rate = value.Float64
+			}
+		case loanmodification.FieldTerm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field term", values[i])
+			} else if value.Valid {
+				lm.Term = int(value.Int64)
+			}
+		case loanmodification.FieldCapitalizedArrears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capitalized_arrears", values[i])
+			} else if value.Valid {
+				lm.CapitalizedArrears = int(value.Int64)
+			}
+		case loanmodification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lm.CreatedAt = value.Time
+			}
+		default:
+			lm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanModification.
+// This includes values selected through modifiers, order, etc.
+func (lm *LoanModification) Value(name string) (ent.Value, error) {
+	return lm.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the LoanModification entity.
+func (lm *LoanModification) QueryLoan() *LoanQuery {
+	return NewLoanModificationClient(lm.config).QueryLoan(lm)
+}
+
+// Update returns a builder for updating this LoanModification.
+// Note that you need to call LoanModification.Unwrap() before calling this method if this LoanModification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lm *LoanModification) Update() *LoanModificationUpdateOne {
+	return NewLoanModificationClient(lm.config).UpdateOne(lm)
+}
+
+// Unwrap unwraps the LoanModification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lm *LoanModification) Unwrap() *LoanModification {
+	_tx, ok := lm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanModification is not a transactional entity")
+	}
+	lm.config.driver = _tx.drv
+	return lm
+}
+
+// String implements the fmt.Stringer.
+func (lm *LoanModification) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanModification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lm.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", lm.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("effective_month=")
+	builder.WriteString(fmt.Sprintf("%v", lm.EffectiveMonth))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", lm.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("term=")
+	builder.WriteString(fmt.Sprintf("%v", lm.Term))
+	builder.WriteString(", ")
+	builder.WriteString("capitalized_arrears=")
+	builder.WriteString(fmt.Sprintf("%v", lm.CapitalizedArrears))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanModifications is a parsable slice of LoanModification.
+type LoanModifications []*LoanModification
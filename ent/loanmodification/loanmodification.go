@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanmodification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the loanmodification type in the database.
+	Label = "loan_modification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLoanID holds the string denoting the loan_id field in the database.
+	FieldLoanID = "loan_id"
+	// FieldEffectiveMonth holds the string denoting the effective_month field in the database.
+	FieldEffectiveMonth = "effective_month"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldTerm holds the string denoting the term field in the database.
+	FieldTerm = "term"
+	// FieldCapitalizedArrears holds the string denoting the capitalized_arrears field in the database.
+	FieldCapitalizedArrears = "capitalized_arrears"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLoan holds the string denoting the loan edge name in mutations.
+	EdgeLoan = "loan"
+	// Table holds the table name of the loanmodification in the database.
+	Table = "loan_modifications"
+	// LoanTable is the table that holds the loan relation/edge.
+	LoanTable = "loan_modifications"
+	// LoanInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoanInverseTable = "loans"
+	// LoanColumn is the table column denoting the loan relation/edge.
+	LoanColumn = "loan_id"
+)
+
+// Columns holds all SQL columns for loanmodification fields.
+var Columns = []string{
+	FieldID,
+	FieldLoanID,
+	FieldEffectiveMonth,
+	FieldRate,
+	FieldTerm,
+	FieldCapitalizedArrears,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCapitalizedArrears holds the default value on creation for the "capitalized_arrears" field.
+	DefaultCapitalizedArrears int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the LoanModification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLoanID orders the results by the loan_id field.
+func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
+}
+
+// ByEffectiveMonth orders the results by the effective_month field.
+func ByEffectiveMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveMonth, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByTerm orders the results by the term field.
+func ByTerm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerm, opts...).ToFunc()
+}
+
+// ByCapitalizedArrears orders the results by the capitalized_arrears field.
+func ByCapitalizedArrears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapitalizedArrears, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLoanField orders the results by loan field.
+func ByLoanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLoanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+	)
+}
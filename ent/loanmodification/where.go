@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanmodification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldLoanID, v))
+}
+
+// EffectiveMonth applies equality check predicate on the "effective_month" field. It's identical to EffectiveMonthEQ.
+func EffectiveMonth(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldRate, v))
+}
+
+// Term applies equality check predicate on the "term" field. It's identical to TermEQ.
+func Term(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldTerm, v))
+}
+
+// CapitalizedArrears applies equality check predicate on the "capitalized_arrears" field. It's identical to CapitalizedArrearsEQ.
+func CapitalizedArrears(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldCapitalizedArrears, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// EffectiveMonthEQ applies the EQ predicate on the "effective_month" field.
+func EffectiveMonthEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthNEQ applies the NEQ predicate on the "effective_month" field.
+func EffectiveMonthNEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthIn applies the In predicate on the "effective_month" field.
+func EffectiveMonthIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthNotIn applies the NotIn predicate on the "effective_month" field.
+func EffectiveMonthNotIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldEffectiveMonth, vs...))
+}
+
+// EffectiveMonthGT applies the GT predicate on the "effective_month" field.
+func EffectiveMonthGT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthGTE applies the GTE predicate on the "effective_month" field.
+func EffectiveMonthGTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLT applies the LT predicate on the "effective_month" field.
+func EffectiveMonthLT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldEffectiveMonth, v))
+}
+
+// EffectiveMonthLTE applies the LTE predicate on the "effective_month" field.
+func EffectiveMonthLTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldEffectiveMonth, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldRate, v))
+}
+
+// TermEQ applies the EQ predicate on the "term" field.
+func TermEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldTerm, v))
+}
+
+// TermNEQ applies the NEQ predicate on the "term" field.
+func TermNEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldTerm, v))
+}
+
+// TermIn applies the In predicate on the "term" field.
+func TermIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldTerm, vs...))
+}
+
+// TermNotIn applies the NotIn predicate on the "term" field.
+func TermNotIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldTerm, vs...))
+}
+
+// TermGT applies the GT predicate on the "term" field.
+func TermGT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldTerm, v))
+}
+
+// TermGTE applies the GTE predicate on the "term" field.
+func TermGTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldTerm, v))
+}
+
+// TermLT applies the LT predicate on the "term" field.
+func TermLT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldTerm, v))
+}
+
+// TermLTE applies the LTE predicate on the "term" field.
+func TermLTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldTerm, v))
+}
+
+// CapitalizedArrearsEQ applies the EQ predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldCapitalizedArrears, v))
+}
+
+// CapitalizedArrearsNEQ applies the NEQ predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsNEQ(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldCapitalizedArrears, v))
+}
+
+// CapitalizedArrearsIn applies the In predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldCapitalizedArrears, vs...))
+}
+
+// CapitalizedArrearsNotIn applies the NotIn predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsNotIn(vs ...int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldCapitalizedArrears, vs...))
+}
+
+// CapitalizedArrearsGT applies the GT predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsGT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldCapitalizedArrears, v))
+}
+
+// CapitalizedArrearsGTE applies the GTE predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsGTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldCapitalizedArrears, v))
+}
+
+// CapitalizedArrearsLT applies the LT predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsLT(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldCapitalizedArrears, v))
+}
+
+// CapitalizedArrearsLTE applies the LTE predicate on the "capitalized_arrears" field.
+func CapitalizedArrearsLTE(v int) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldCapitalizedArrears, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanModification {
+	return predicate.LoanModification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanModification {
+	return predicate.LoanModification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanModification {
+	return predicate.LoanModification(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanModification) predicate.LoanModification {
+	return predicate.LoanModification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanModification) predicate.LoanModification {
+	return predicate.LoanModification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanModification) predicate.LoanModification {
+	return predicate.LoanModification(sql.NotPredicates(p))
+}
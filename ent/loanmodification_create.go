@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanmodification"
+)
+
+// LoanModificationCreate is the builder for creating a LoanModification entity.
+type LoanModificationCreate struct {
+	config
+	mutation *LoanModificationMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lmc *LoanModificationCreate) SetLoanID(i int) *LoanModificationCreate {
+	lmc.mutation.SetLoanID(i)
+	return lmc
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (lmc *LoanModificationCreate) SetEffectiveMonth(i int) *LoanModificationCreate {
+	lmc.mutation.SetEffectiveMonth(i)
+	return lmc
+}
+
+// SetRate sets the "rate" field.
+func (lmc *LoanModificationCreate) SetRate(f float64) *LoanModificationCreate {
+	lmc.mutation.SetRate(f)
+	return lmc
+}
+
+// SetTerm sets the "term" field.
+func (lmc *LoanModificationCreate) SetTerm(i int) *LoanModificationCreate {
+	lmc.mutation.SetTerm(i)
+	return lmc
+}
+
+// SetCapitalizedArrears sets the "capitalized_arrears" field.
+func (lmc *LoanModificationCreate) SetCapitalizedArrears(i int) *LoanModificationCreate {
+	lmc.mutation.SetCapitalizedArrears(i)
+	return lmc
+}
+
+// SetNillableCapitalizedArrears sets the "capitalized_arrears" field if the given value is not nil.
+func (lmc *LoanModificationCreate) SetNillableCapitalizedArrears(i *int) *LoanModificationCreate {
+	if i != nil {
+		lmc.SetCapitalizedArrears(*i)
+	}
+	return lmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lmc *LoanModificationCreate) SetCreatedAt(t time.Time) *LoanModificationCreate {
+	lmc.mutation.SetCreatedAt(t)
+	return lmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lmc *LoanModificationCreate) SetNillableCreatedAt(t *time.Time) *LoanModificationCreate {
+	if t != nil {
+		lmc.SetCreatedAt(*t)
+	}
+	return lmc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lmc *LoanModificationCreate) SetLoan(l *Loan) *LoanModificationCreate {
+	return lmc.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanModificationMutation object of the builder.
+func (lmc *LoanModificationCreate) Mutation() *LoanModificationMutation {
+	return lmc.mutation
+}
+
+// Save creates the LoanModification in the database.
+func (lmc *LoanModificationCreate) Save(ctx context.Context) (*LoanModification, error) {
+	lmc.defaults()
+	return withHooks(ctx, lmc.sqlSave, lmc.mutation, lmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lmc *LoanModificationCreate) SaveX(ctx context.Context) *LoanModification {
+	v, err := lmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lmc *LoanModificationCreate) Exec(ctx context.Context) error {
+	_, err := lmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmc *LoanModificationCreate) ExecX(ctx context.Context) {
+	if err := lmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lmc *LoanModificationCreate) defaults() {
+	if _, ok := lmc.mutation.CapitalizedArrears(); !ok {
+		v := loanmodification.DefaultCapitalizedArrears
+		lmc.mutation.SetCapitalizedArrears(v)
+	}
+	if _, ok := lmc.mutation.CreatedAt(); !ok {
+		v := loanmodification.DefaultCreatedAt()
+		lmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmc *LoanModificationCreate) check() error {
+	if _, ok := lmc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanModification.loan_id"`)}
+	}
+	if _, ok := lmc.mutation.EffectiveMonth(); !ok {
+		return &ValidationError{Name: "effective_month", err: errors.New(`ent: missing required field "LoanModification.effective_month"`)}
+	}
+	if _, ok := lmc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "LoanModification.rate"`)}
+	}
+	if _, ok := lmc.mutation.Term(); !ok {
+		return &ValidationError{Name: "term", err: errors.New(`ent: missing required field "LoanModification.term"`)}
+	}
+	if _, ok := lmc.mutation.CapitalizedArrears(); !ok {
+		return &ValidationError{Name: "capitalized_arrears", err: errors.New(`ent: missing required field "LoanModification.capitalized_arrears"`)}
+	}
+	if _, ok := lmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanModification.created_at"`)}
+	}
+	if _, ok := lmc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanModification.loan"`)}
+	}
+	return nil
+}
+
+func (lmc *LoanModificationCreate) sqlSave(ctx context.Context) (*LoanModification, error) {
+	if err := lmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lmc.mutation.id = &_node.ID
+	lmc.mutation.done = true
+	return _node, nil
+}
+
+func (lmc *LoanModificationCreate) createSpec() (*LoanModification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanModification{config: lmc.config}
+		_spec = sqlgraph.NewCreateSpec(loanmodification.Table, sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt))
+	)
+	if value, ok := lmc.mutation.EffectiveMonth(); ok {
+		_spec.SetField(loanmodification.FieldEffectiveMonth, field.TypeInt, value)
+		_node.EffectiveMonth = value
+	}
+	if value, ok := lmc.mutation.Rate(); ok {
+		_spec.SetField(loanmodification.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := lmc.mutation.Term(); ok {
+		_spec.SetField(loanmodification.FieldTerm, field.TypeInt, value)
+		_node.Term = value
+	}
+	if value, ok := lmc.mutation.CapitalizedArrears(); ok {
+		_spec.SetField(loanmodification.FieldCapitalizedArrears, field.TypeInt, value)
+		_node.CapitalizedArrears = value
+	}
+	if value, ok := lmc.mutation.CreatedAt(); ok {
+		_spec.SetField(loanmodification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lmc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanmodification.LoanTable,
+			Columns: []string{loanmodification.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoanModificationCreateBulk is the builder for creating many LoanModification entities in bulk.
+type LoanModificationCreateBulk struct {
+	config
+	err      error
+	builders []*LoanModificationCreate
+}
+
+// Save creates the LoanModification entities in the database.
+func (lmcb *LoanModificationCreateBulk) Save(ctx context.Context) ([]*LoanModification, error) {
+	if lmcb.err != nil {
+		return nil, lmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lmcb.builders))
+	nodes := make([]*LoanModification, len(lmcb.builders))
+	mutators := make([]Mutator, len(lmcb.builders))
+	for i := range lmcb.builders {
+		func(i int, root context.Context) {
+			builder := lmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanModificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmcb *LoanModificationCreateBulk) SaveX(ctx context.Context) []*LoanModification {
+	v, err := lmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lmcb *LoanModificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := lmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmcb *LoanModificationCreateBulk) ExecX(ctx context.Context) {
+	if err := lmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanModificationDelete is the builder for deleting a LoanModification entity.
+type LoanModificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoanModificationMutation
+}
+
+// Where appends a list predicates to the LoanModificationDelete builder.
+func (lmd *LoanModificationDelete) Where(ps ...predicate.LoanModification) *LoanModificationDelete {
+	lmd.mutation.Where(ps...)
+	return lmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lmd *LoanModificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lmd.sqlExec, lmd.mutation, lmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmd *LoanModificationDelete) ExecX(ctx context.Context) int {
+	n, err := lmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lmd *LoanModificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loanmodification.Table, sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt))
+	if ps := lmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lmd.mutation.done = true
+	return affected, err
+}
+
+// LoanModificationDeleteOne is the builder for deleting a single LoanModification entity.
+type LoanModificationDeleteOne struct {
+	lmd *LoanModificationDelete
+}
+
+// Where appends a list predicates to the LoanModificationDelete builder.
+func (lmdo *LoanModificationDeleteOne) Where(ps ...predicate.LoanModification) *LoanModificationDeleteOne {
+	lmdo.lmd.mutation.Where(ps...)
+	return lmdo
+}
+
+// Exec executes the deletion query.
+func (lmdo *LoanModificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := lmdo.lmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loanmodification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmdo *LoanModificationDeleteOne) ExecX(ctx context.Context) {
+	if err := lmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanModificationQuery is the builder for querying LoanModification entities.
+type LoanModificationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loanmodification.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoanModification
+	withLoan   *LoanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoanModificationQuery builder.
+func (lmq *LoanModificationQuery) Where(ps ...predicate.LoanModification) *LoanModificationQuery {
+	lmq.predicates = append(lmq.predicates, ps...)
+	return lmq
+}
+
+// Limit the number of records to be returned by this query.
+func (lmq *LoanModificationQuery) Limit(limit int) *LoanModificationQuery {
+	lmq.ctx.Limit = &limit
+	return lmq
+}
+
+// Offset to start from.
+func (lmq *LoanModificationQuery) Offset(offset int) *LoanModificationQuery {
+	lmq.ctx.Offset = &offset
+	return lmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lmq *LoanModificationQuery) Unique(unique bool) *LoanModificationQuery {
+	lmq.ctx.Unique = &unique
+	return lmq
+}
+
+// Order specifies how the records should be ordered.
+func (lmq *LoanModificationQuery) Order(o ...loanmodification.OrderOption) *LoanModificationQuery {
+	lmq.order = append(lmq.order, o...)
+	return lmq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (lmq *LoanModificationQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: lmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanmodification.Table, loanmodification.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanmodification.LoanTable, loanmodification.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LoanModification entity from the query.
+// Returns a *NotFoundError when no LoanModification was found.
+func (lmq *LoanModificationQuery) First(ctx context.Context) (*LoanModification, error) {
+	nodes, err := lmq.Limit(1).All(setContextOp(ctx, lmq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loanmodification.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lmq *LoanModificationQuery) FirstX(ctx context.Context) *LoanModification {
+	node, err := lmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoanModification ID from the query.
+// Returns a *NotFoundError when no LoanModification ID was found.
+func (lmq *LoanModificationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(1).IDs(setContextOp(ctx, lmq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loanmodification.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lmq *LoanModificationQuery) FirstIDX(ctx context.Context) int {
+	id, err := lmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoanModification entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoanModification entity is found.
+// Returns a *NotFoundError when no LoanModification entities are found.
+func (lmq *LoanModificationQuery) Only(ctx context.Context) (*LoanModification, error) {
+	nodes, err := lmq.Limit(2).All(setContextOp(ctx, lmq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loanmodification.Label}
+	default:
+		return nil, &NotSingularError{loanmodification.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lmq *LoanModificationQuery) OnlyX(ctx context.Context) *LoanModification {
+	node, err := lmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoanModification ID in the query.
+// Returns a *NotSingularError when more than one LoanModification ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lmq *LoanModificationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lmq.Limit(2).IDs(setContextOp(ctx, lmq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loanmodification.Label}
+	default:
+		err = &NotSingularError{loanmodification.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lmq *LoanModificationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoanModifications.
+func (lmq *LoanModificationQuery) All(ctx context.Context) ([]*LoanModification, error) {
+	ctx = setContextOp(ctx, lmq.ctx, "All")
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoanModification, *LoanModificationQuery]()
+	return withInterceptors[[]*LoanModification](ctx, lmq, qr, lmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lmq *LoanModificationQuery) AllX(ctx context.Context) []*LoanModification {
+	nodes, err := lmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoanModification IDs.
+func (lmq *LoanModificationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lmq.ctx.Unique == nil && lmq.path != nil {
+		lmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lmq.ctx, "IDs")
+	if err = lmq.Select(loanmodification.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lmq *LoanModificationQuery) IDsX(ctx context.Context) []int {
+	ids, err := lmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lmq *LoanModificationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lmq.ctx, "Count")
+	if err := lmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lmq, querierCount[*LoanModificationQuery](), lmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lmq *LoanModificationQuery) CountX(ctx context.Context) int {
+	count, err := lmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lmq *LoanModificationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lmq.ctx, "Exist")
+	switch _, err := lmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lmq *LoanModificationQuery) ExistX(ctx context.Context) bool {
+	exist, err := lmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoanModificationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lmq *LoanModificationQuery) Clone() *LoanModificationQuery {
+	if lmq == nil {
+		return nil
+	}
+	return &LoanModificationQuery{
+		config:     lmq.config,
+		ctx:        lmq.ctx.Clone(),
+		order:      append([]loanmodification.OrderOption{}, lmq.order...),
+		inters:     append([]Interceptor{}, lmq.inters...),
+		predicates: append([]predicate.LoanModification{}, lmq.predicates...),
+		withLoan:   lmq.withLoan.Clone(),
+		// clone intermediate query.
+		sql:  lmq.sql.Clone(),
+		path: lmq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (lmq *LoanModificationQuery) WithLoan(opts ...func(*LoanQuery)) *LoanModificationQuery {
+	query := (&LoanClient{config: lmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lmq.withLoan = query
+	return lmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoanModification.Query().
+//		GroupBy(loanmodification.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lmq *LoanModificationQuery) GroupBy(field string, fields ...string) *LoanModificationGroupBy {
+	lmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoanModificationGroupBy{build: lmq}
+	grbuild.flds = &lmq.ctx.Fields
+	grbuild.label = loanmodification.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.LoanModification.Query().
+//		Select(loanmodification.FieldLoanID).
+//		Scan(ctx, &v)
+func (lmq *LoanModificationQuery) Select(fields ...string) *LoanModificationSelect {
+	lmq.ctx.Fields = append(lmq.ctx.Fields, fields...)
+	sbuild := &LoanModificationSelect{LoanModificationQuery: lmq}
+	sbuild.label = loanmodification.Label
+	sbuild.flds, sbuild.scan = &lmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoanModificationSelect configured with the given aggregations.
+func (lmq *LoanModificationQuery) Aggregate(fns ...AggregateFunc) *LoanModificationSelect {
+	return lmq.Select().Aggregate(fns...)
+}
+
+func (lmq *LoanModificationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lmq.ctx.Fields {
+		if !loanmodification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lmq.path != nil {
+		prev, err := lmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lmq.sql = prev
+	}
+	return nil
+}
+
+func (lmq *LoanModificationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoanModification, error) {
+	var (
+		nodes       = []*LoanModification{}
+		_spec       = lmq.querySpec()
+		loadedTypes = [1]bool{
+			lmq.withLoan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoanModification).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoanModification{config: lmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lmq.withLoan; query != nil {
+		if err := lmq.loadLoan(ctx, query, nodes, nil,
+			func(n *LoanModification, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lmq *LoanModificationQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*LoanModification, init func(*LoanModification), assign func(*LoanModification, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LoanModification)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lmq *LoanModificationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lmq.querySpec()
+	_spec.Node.Columns = lmq.ctx.Fields
+	if len(lmq.ctx.Fields) > 0 {
+		_spec.Unique = lmq.ctx.Unique != nil && *lmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lmq.driver, _spec)
+}
+
+func (lmq *LoanModificationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loanmodification.Table, loanmodification.Columns, sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt))
+	_spec.From = lmq.sql
+	if unique := lmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanmodification.FieldID)
+		for i := range fields {
+			if fields[i] != loanmodification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if lmq.withLoan != nil {
+			_spec.Node.AddColumnOnce(loanmodification.FieldLoanID)
+		}
+	}
+	if ps := lmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lmq *LoanModificationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lmq.driver.Dialect())
+	t1 := builder.Table(loanmodification.Table)
+	columns := lmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loanmodification.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lmq.sql != nil {
+		selector = lmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lmq.ctx.Unique != nil && *lmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lmq.predicates {
+		p(selector)
+	}
+	for _, p := range lmq.order {
+		p(selector)
+	}
+	if offset := lmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoanModificationGroupBy is the group-by builder for LoanModification entities.
+type LoanModificationGroupBy struct {
+	selector
+	build *LoanModificationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lmgb *LoanModificationGroupBy) Aggregate(fns ...AggregateFunc) *LoanModificationGroupBy {
+	lmgb.fns = append(lmgb.fns, fns...)
+	return lmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lmgb *LoanModificationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lmgb.build.ctx, "GroupBy")
+	if err := lmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanModificationQuery, *LoanModificationGroupBy](ctx, lmgb.build, lmgb, lmgb.build.inters, v)
+}
+
+func (lmgb *LoanModificationGroupBy) sqlScan(ctx context.Context, root *LoanModificationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lmgb.fns))
+	for _, fn := range lmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lmgb.flds)+len(lmgb.fns))
+		for _, f := range *lmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoanModificationSelect is the builder for selecting fields of LoanModification entities.
+type LoanModificationSelect struct {
+	*LoanModificationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lms *LoanModificationSelect) Aggregate(fns ...AggregateFunc) *LoanModificationSelect {
+	lms.fns = append(lms.fns, fns...)
+	return lms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lms *LoanModificationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lms.ctx, "Select")
+	if err := lms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanModificationQuery, *LoanModificationSelect](ctx, lms.LoanModificationQuery, lms, lms.inters, v)
+}
+
+func (lms *LoanModificationSelect) sqlScan(ctx context.Context, root *LoanModificationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lms.fns))
+	for _, fn := range lms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
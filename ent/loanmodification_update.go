@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanModificationUpdate is the builder for updating LoanModification entities.
+type LoanModificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanModificationMutation
+}
+
+// Where appends a list predicates to the LoanModificationUpdate builder.
+func (lmu *LoanModificationUpdate) Where(ps ...predicate.LoanModification) *LoanModificationUpdate {
+	lmu.mutation.Where(ps...)
+	return lmu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lmu *LoanModificationUpdate) SetLoanID(i int) *LoanModificationUpdate {
+	lmu.mutation.SetLoanID(i)
+	return lmu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lmu *LoanModificationUpdate) SetNillableLoanID(i *int) *LoanModificationUpdate {
+	if i != nil {
+		lmu.SetLoanID(*i)
+	}
+	return lmu
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (lmu *LoanModificationUpdate) SetEffectiveMonth(i int) *LoanModificationUpdate {
+	lmu.mutation.ResetEffectiveMonth()
+	lmu.mutation.SetEffectiveMonth(i)
+	return lmu
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (lmu *LoanModificationUpdate) SetNillableEffectiveMonth(i *int) *LoanModificationUpdate {
+	if i != nil {
+		lmu.SetEffectiveMonth(*i)
+	}
+	return lmu
+}
+
+// AddEffectiveMonth adds i to the "effective_month" field.
+func (lmu *LoanModificationUpdate) AddEffectiveMonth(i int) *LoanModificationUpdate {
+	lmu.mutation.AddEffectiveMonth(i)
+	return lmu
+}
+
+// SetRate sets the "rate" field.
+func (lmu *LoanModificationUpdate) SetRate(f float64) *LoanModificationUpdate {
+	lmu.mutation.ResetRate()
+	lmu.mutation.SetRate(f)
+	return lmu
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (lmu *LoanModificationUpdate) SetNillableRate(f *float64) *LoanModificationUpdate {
+	if f != nil {
+		lmu.SetRate(*f)
+	}
+	return lmu
+}
+
+// AddRate adds f to the "rate" field.
+func (lmu *LoanModificationUpdate) AddRate(f float64) *LoanModificationUpdate {
+	lmu.mutation.AddRate(f)
+	return lmu
+}
+
+// SetTerm sets the "term" field.
+func (lmu *LoanModificationUpdate) SetTerm(i int) *LoanModificationUpdate {
+	lmu.mutation.ResetTerm()
+	lmu.mutation.SetTerm(i)
+	return lmu
+}
+
+// SetNillableTerm sets the "term" field if the given value is not nil.
+func (lmu *LoanModificationUpdate) SetNillableTerm(i *int) *LoanModificationUpdate {
+	if i != nil {
+		lmu.SetTerm(*i)
+	}
+	return lmu
+}
+
+// AddTerm adds i to the "term" field.
+func (lmu *LoanModificationUpdate) AddTerm(i int) *LoanModificationUpdate {
+	lmu.mutation.AddTerm(i)
+	return lmu
+}
+
+// SetCapitalizedArrears sets the "capitalized_arrears" field.
+func (lmu *LoanModificationUpdate) SetCapitalizedArrears(i int) *LoanModificationUpdate {
+	lmu.mutation.ResetCapitalizedArrears()
+	lmu.mutation.SetCapitalizedArrears(i)
+	return lmu
+}
+
+// SetNillableCapitalizedArrears sets the "capitalized_arrears" field if the given value is not nil.
+func (lmu *LoanModificationUpdate) SetNillableCapitalizedArrears(i *int) *LoanModificationUpdate {
+	if i != nil {
+		lmu.SetCapitalizedArrears(*i)
+	}
+	return lmu
+}
+
+// AddCapitalizedArrears adds i to the "capitalized_arrears" field.
+func (lmu *LoanModificationUpdate) AddCapitalizedArrears(i int) *LoanModificationUpdate {
+	lmu.mutation.AddCapitalizedArrears(i)
+	return lmu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lmu *LoanModificationUpdate) SetLoan(l *Loan) *LoanModificationUpdate {
+	return lmu.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanModificationMutation object of the builder.
+func (lmu *LoanModificationUpdate) Mutation() *LoanModificationMutation {
+	return lmu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lmu *LoanModificationUpdate) ClearLoan() *LoanModificationUpdate {
+	lmu.mutation.ClearLoan()
+	return lmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lmu *LoanModificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lmu.sqlSave, lmu.mutation, lmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmu *LoanModificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := lmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lmu *LoanModificationUpdate) Exec(ctx context.Context) error {
+	_, err := lmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmu *LoanModificationUpdate) ExecX(ctx context.Context) {
+	if err := lmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmu *LoanModificationUpdate) check() error {
+	if _, ok := lmu.mutation.LoanID(); lmu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanModification.loan"`)
+	}
+	return nil
+}
+
+func (lmu *LoanModificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanmodification.Table, loanmodification.Columns, sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt))
+	if ps := lmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmu.mutation.EffectiveMonth(); ok {
+		_spec.SetField(loanmodification.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := lmu.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(loanmodification.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := lmu.mutation.Rate(); ok {
+		_spec.SetField(loanmodification.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.AddedRate(); ok {
+		_spec.AddField(loanmodification.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := lmu.mutation.Term(); ok {
+		_spec.SetField(loanmodification.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := lmu.mutation.AddedTerm(); ok {
+		_spec.AddField(loanmodification.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := lmu.mutation.CapitalizedArrears(); ok {
+		_spec.SetField(loanmodification.FieldCapitalizedArrears, field.TypeInt, value)
+	}
+	if value, ok := lmu.mutation.AddedCapitalizedArrears(); ok {
+		_spec.AddField(loanmodification.FieldCapitalizedArrears, field.TypeInt, value)
+	}
+	if lmu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanmodification.LoanTable,
+			Columns: []string{loanmodification.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanmodification.LoanTable,
+			Columns: []string{loanmodification.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanmodification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lmu.mutation.done = true
+	return n, nil
+}
+
+// LoanModificationUpdateOne is the builder for updating a single LoanModification entity.
+type LoanModificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanModificationMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lmuo *LoanModificationUpdateOne) SetLoanID(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.SetLoanID(i)
+	return lmuo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lmuo *LoanModificationUpdateOne) SetNillableLoanID(i *int) *LoanModificationUpdateOne {
+	if i != nil {
+		lmuo.SetLoanID(*i)
+	}
+	return lmuo
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (lmuo *LoanModificationUpdateOne) SetEffectiveMonth(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.ResetEffectiveMonth()
+	lmuo.mutation.SetEffectiveMonth(i)
+	return lmuo
+}
+
+// SetNillableEffectiveMonth sets the "effective_month" field if the given value is not nil.
+func (lmuo *LoanModificationUpdateOne) SetNillableEffectiveMonth(i *int) *LoanModificationUpdateOne {
+	if i != nil {
+		lmuo.SetEffectiveMonth(*i)
+	}
+	return lmuo
+}
+
+// AddEffectiveMonth adds i to the "effective_month" field.
+func (lmuo *LoanModificationUpdateOne) AddEffectiveMonth(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.AddEffectiveMonth(i)
+	return lmuo
+}
+
+// SetRate sets the "rate" field.
+func (lmuo *LoanModificationUpdateOne) SetRate(f float64) *LoanModificationUpdateOne {
+	lmuo.mutation.ResetRate()
+	lmuo.mutation.SetRate(f)
+	return lmuo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (lmuo *LoanModificationUpdateOne) SetNillableRate(f *float64) *LoanModificationUpdateOne {
+	if f != nil {
+		lmuo.SetRate(*f)
+	}
+	return lmuo
+}
+
+// AddRate adds f to the "rate" field.
+func (lmuo *LoanModificationUpdateOne) AddRate(f float64) *LoanModificationUpdateOne {
+	lmuo.mutation.AddRate(f)
+	return lmuo
+}
+
+// SetTerm sets the "term" field.
+func (lmuo *LoanModificationUpdateOne) SetTerm(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.ResetTerm()
+	lmuo.mutation.SetTerm(i)
+	return lmuo
+}
+
+// SetNillableTerm sets the "term" field if the given value is not nil.
+func (lmuo *LoanModificationUpdateOne) SetNillableTerm(i *int) *LoanModificationUpdateOne {
+	if i != nil {
+		lmuo.SetTerm(*i)
+	}
+	return lmuo
+}
+
+// AddTerm adds i to the "term" field.
+func (lmuo *LoanModificationUpdateOne) AddTerm(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.AddTerm(i)
+	return lmuo
+}
+
+// SetCapitalizedArrears sets the "capitalized_arrears" field.
+func (lmuo *LoanModificationUpdateOne) SetCapitalizedArrears(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.ResetCapitalizedArrears()
+	lmuo.mutation.SetCapitalizedArrears(i)
+	return lmuo
+}
+
+// SetNillableCapitalizedArrears sets the "capitalized_arrears" field if the given value is not nil.
+func (lmuo *LoanModificationUpdateOne) SetNillableCapitalizedArrears(i *int) *LoanModificationUpdateOne {
+	if i != nil {
+		lmuo.SetCapitalizedArrears(*i)
+	}
+	return lmuo
+}
+
+// AddCapitalizedArrears adds i to the "capitalized_arrears" field.
+func (lmuo *LoanModificationUpdateOne) AddCapitalizedArrears(i int) *LoanModificationUpdateOne {
+	lmuo.mutation.AddCapitalizedArrears(i)
+	return lmuo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lmuo *LoanModificationUpdateOne) SetLoan(l *Loan) *LoanModificationUpdateOne {
+	return lmuo.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanModificationMutation object of the builder.
+func (lmuo *LoanModificationUpdateOne) Mutation() *LoanModificationMutation {
+	return lmuo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lmuo *LoanModificationUpdateOne) ClearLoan() *LoanModificationUpdateOne {
+	lmuo.mutation.ClearLoan()
+	return lmuo
+}
+
+// Where appends a list predicates to the LoanModificationUpdate builder.
+func (lmuo *LoanModificationUpdateOne) Where(ps ...predicate.LoanModification) *LoanModificationUpdateOne {
+	lmuo.mutation.Where(ps...)
+	return lmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lmuo *LoanModificationUpdateOne) Select(field string, fields ...string) *LoanModificationUpdateOne {
+	lmuo.fields = append([]string{field}, fields...)
+	return lmuo
+}
+
+// Save executes the query and returns the updated LoanModification entity.
+func (lmuo *LoanModificationUpdateOne) Save(ctx context.Context) (*LoanModification, error) {
+	return withHooks(ctx, lmuo.sqlSave, lmuo.mutation, lmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lmuo *LoanModificationUpdateOne) SaveX(ctx context.Context) *LoanModification {
+	node, err := lmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lmuo *LoanModificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := lmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lmuo *LoanModificationUpdateOne) ExecX(ctx context.Context) {
+	if err := lmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lmuo *LoanModificationUpdateOne) check() error {
+	if _, ok := lmuo.mutation.LoanID(); lmuo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanModification.loan"`)
+	}
+	return nil
+}
+
+func (lmuo *LoanModificationUpdateOne) sqlSave(ctx context.Context) (_node *LoanModification, err error) {
+	if err := lmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanmodification.Table, loanmodification.Columns, sqlgraph.NewFieldSpec(loanmodification.FieldID, field.TypeInt))
+	id, ok := lmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanModification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanmodification.FieldID)
+		for _, f := range fields {
+			if !loanmodification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loanmodification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lmuo.mutation.EffectiveMonth(); ok {
+		_spec.SetField(loanmodification.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := lmuo.mutation.AddedEffectiveMonth(); ok {
+		_spec.AddField(loanmodification.FieldEffectiveMonth, field.TypeInt, value)
+	}
+	if value, ok := lmuo.mutation.Rate(); ok {
+		_spec.SetField(loanmodification.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.AddedRate(); ok {
+		_spec.AddField(loanmodification.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := lmuo.mutation.Term(); ok {
+		_spec.SetField(loanmodification.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := lmuo.mutation.AddedTerm(); ok {
+		_spec.AddField(loanmodification.FieldTerm, field.TypeInt, value)
+	}
+	if value, ok := lmuo.mutation.CapitalizedArrears(); ok {
+		_spec.SetField(loanmodification.FieldCapitalizedArrears, field.TypeInt, value)
+	}
+	if value, ok := lmuo.mutation.AddedCapitalizedArrears(); ok {
+		_spec.AddField(loanmodification.FieldCapitalizedArrears, field.TypeInt, value)
+	}
+	if lmuo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanmodification.LoanTable,
+			Columns: []string{loanmodification.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lmuo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanmodification.LoanTable,
+			Columns: []string{loanmodification.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanModification{config: lmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanmodification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lmuo.mutation.done = true
+	return _node, nil
+}
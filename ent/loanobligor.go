@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// LoanObligor is the model entity for the LoanObligor schema.
+type LoanObligor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role loanobligor.Role `json:"role,omitempty"`
+	// Liability holds the value of the "liability" field.
+	Liability loanobligor.Liability `json:"liability,omitempty"`
+	// LiabilityPercent holds the value of the "liability_percent" field.
+	LiabilityPercent float64 `json:"liability_percent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanObligorQuery when eager-loading is set.
+	Edges        LoanObligorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoanObligorEdges holds the relations/edges for other nodes in the graph.
+type LoanObligorEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanObligorEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanObligorEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanObligor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loanobligor.FieldLiabilityPercent:
+			values[i] = new(sql.NullFloat64)
+		case loanobligor.FieldID, loanobligor.FieldLoanID, loanobligor.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case loanobligor.FieldRole, loanobligor.FieldLiability:
+			values[i] = new(sql.NullString)
+		case loanobligor.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanObligor fields.
+func (lo *LoanObligor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loanobligor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lo.ID = int(value.Int64)
+		case loanobligor.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				lo.LoanID = int(value.Int64)
+			}
+		case loanobligor.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				lo.UserID = int(value.Int64)
+			}
+		case loanobligor.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				lo.Role = loanobligor.Role(value.String)
+			}
+		case loanobligor.FieldLiability:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field liability", values[i])
+			} else if value.Valid {
+				lo.Liability = loanobligor.Liability(value.String)
+			}
+		case loanobligor.FieldLiabilityPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field liability_percent", values[i])
+			} else if value.Valid {
+				lo.LiabilityPercent = value.Float64
+			}
+		case loanobligor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lo.CreatedAt = value.Time
+			}
+		default:
+			lo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanObligor.
+// This includes values selected through modifiers, order, etc.
+func (lo *LoanObligor) Value(name string) (ent.Value, error) {
+	return lo.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the LoanObligor entity.
+func (lo *LoanObligor) QueryLoan() *LoanQuery {
+	return NewLoanObligorClient(lo.config).QueryLoan(lo)
+}
+
+// QueryUser queries the "user" edge of the LoanObligor entity.
+func (lo *LoanObligor) QueryUser() *UserQuery {
+	return NewLoanObligorClient(lo.config).QueryUser(lo)
+}
+
+// Update returns a builder for updating this LoanObligor.
+// Note that you need to call LoanObligor.Unwrap() before calling this method if this LoanObligor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lo *LoanObligor) Update() *LoanObligorUpdateOne {
+	return NewLoanObligorClient(lo.config).UpdateOne(lo)
+}
+
+// Unwrap unwraps the LoanObligor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lo *LoanObligor) Unwrap() *LoanObligor {
+	_tx, ok := lo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanObligor is not a transactional entity")
+	}
+	lo.config.driver = _tx.drv
+	return lo
+}
+
+// String implements the fmt.Stringer.
+func (lo *LoanObligor) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanObligor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lo.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", lo.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", lo.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", lo.Role))
+	builder.WriteString(", ")
+	builder.WriteString("liability=")
+	builder.WriteString(fmt.Sprintf("%v", lo.Liability))
+	builder.WriteString(", ")
+	builder.WriteString("liability_percent=")
+	builder.WriteString(fmt.Sprintf("%v", lo.LiabilityPercent))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lo.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanObligors is a parsable slice of LoanObligor.
+type LoanObligors []*LoanObligor
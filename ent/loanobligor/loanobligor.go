@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanobligor
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the loanobligor type in the database.
+	Label = "loan_obligor"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLoanID holds the string denoting the loan_id field in the database.
+	FieldLoanID = "loan_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldLiability holds the string denoting the liability field in the database.
+	FieldLiability = "liability"
+	// FieldLiabilityPercent holds the string denoting the liability_percent field in the database.
+	FieldLiabilityPercent = "liability_percent"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLoan holds the string denoting the loan edge name in mutations.
+	EdgeLoan = "loan"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the loanobligor in the database.
+	Table = "loan_obligors"
+	// LoanTable is the table that holds the loan relation/edge.
+	LoanTable = "loan_obligors"
+	// LoanInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoanInverseTable = "loans"
+	// LoanColumn is the table column denoting the loan relation/edge.
+	LoanColumn = "loan_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "loan_obligors"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for loanobligor fields.
+var Columns = []string{
+	FieldID,
+	FieldLoanID,
+	FieldUserID,
+	FieldRole,
+	FieldLiability,
+	FieldLiabilityPercent,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// Role values.
+const (
+	RolePrimary    Role = "primary"
+	RoleCoBorrower Role = "co_borrower"
+	RoleGuarantor  Role = "guarantor"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RolePrimary, RoleCoBorrower, RoleGuarantor:
+		return nil
+	default:
+		return fmt.Errorf("loanobligor: invalid enum value for role field: %q", r)
+	}
+}
+
+// Liability defines the type for the "liability" enum field.
+type Liability string
+
+// LiabilityJointAndSeveral is the default value of the Liability enum.
+const DefaultLiability = LiabilityJointAndSeveral
+
+// Liability values.
+const (
+	LiabilityJointAndSeveral Liability = "joint_and_several"
+	LiabilityPercentage      Liability = "percentage"
+)
+
+func (l Liability) String() string {
+	return string(l)
+}
+
+// LiabilityValidator is a validator for the "liability" field enum values. It is called by the builders before save.
+func LiabilityValidator(l Liability) error {
+	switch l {
+	case LiabilityJointAndSeveral, LiabilityPercentage:
+		return nil
+	default:
+		return fmt.Errorf("loanobligor: invalid enum value for liability field: %q", l)
+	}
+}
+
+// OrderOption defines the ordering options for the LoanObligor queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLoanID orders the results by the loan_id field.
+func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByLiability orders the results by the liability field.
+func ByLiability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiability, opts...).ToFunc()
+}
+
+// ByLiabilityPercent orders the results by the liability_percent field.
+func ByLiabilityPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiabilityPercent, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLoanField orders the results by loan field.
+func ByLoanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoanStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLoanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanobligor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldLoanID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldUserID, v))
+}
+
+// LiabilityPercent applies equality check predicate on the "liability_percent" field. It's identical to LiabilityPercentEQ.
+func LiabilityPercent(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldLiabilityPercent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// LiabilityEQ applies the EQ predicate on the "liability" field.
+func LiabilityEQ(v Liability) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldLiability, v))
+}
+
+// LiabilityNEQ applies the NEQ predicate on the "liability" field.
+func LiabilityNEQ(v Liability) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldLiability, v))
+}
+
+// LiabilityIn applies the In predicate on the "liability" field.
+func LiabilityIn(vs ...Liability) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldLiability, vs...))
+}
+
+// LiabilityNotIn applies the NotIn predicate on the "liability" field.
+func LiabilityNotIn(vs ...Liability) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldLiability, vs...))
+}
+
+// LiabilityPercentEQ applies the EQ predicate on the "liability_percent" field.
+func LiabilityPercentEQ(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentNEQ applies the NEQ predicate on the "liability_percent" field.
+func LiabilityPercentNEQ(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentIn applies the In predicate on the "liability_percent" field.
+func LiabilityPercentIn(vs ...float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldLiabilityPercent, vs...))
+}
+
+// LiabilityPercentNotIn applies the NotIn predicate on the "liability_percent" field.
+func LiabilityPercentNotIn(vs ...float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldLiabilityPercent, vs...))
+}
+
+// LiabilityPercentGT applies the GT predicate on the "liability_percent" field.
+func LiabilityPercentGT(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGT(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentGTE applies the GTE predicate on the "liability_percent" field.
+func LiabilityPercentGTE(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGTE(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentLT applies the LT predicate on the "liability_percent" field.
+func LiabilityPercentLT(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLT(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentLTE applies the LTE predicate on the "liability_percent" field.
+func LiabilityPercentLTE(v float64) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLTE(FieldLiabilityPercent, v))
+}
+
+// LiabilityPercentIsNil applies the IsNil predicate on the "liability_percent" field.
+func LiabilityPercentIsNil() predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIsNull(FieldLiabilityPercent))
+}
+
+// LiabilityPercentNotNil applies the NotNil predicate on the "liability_percent" field.
+func LiabilityPercentNotNil() predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotNull(FieldLiabilityPercent))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanObligor {
+	return predicate.LoanObligor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanObligor {
+	return predicate.LoanObligor(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LoanObligor {
+	return predicate.LoanObligor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LoanObligor {
+	return predicate.LoanObligor(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanObligor) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanObligor) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanObligor) predicate.LoanObligor {
+	return predicate.LoanObligor(sql.NotPredicates(p))
+}
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// LoanObligorCreate is the builder for creating a LoanObligor entity.
+type LoanObligorCreate struct {
+	config
+	mutation *LoanObligorMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (loc *LoanObligorCreate) SetLoanID(i int) *LoanObligorCreate {
+	loc.mutation.SetLoanID(i)
+	return loc
+}
+
+// SetUserID sets the "user_id" field.
+func (loc *LoanObligorCreate) SetUserID(i int) *LoanObligorCreate {
+	loc.mutation.SetUserID(i)
+	return loc
+}
+
+// SetRole sets the "role" field.
+func (loc *LoanObligorCreate) SetRole(l loanobligor.Role) *LoanObligorCreate {
+	loc.mutation.SetRole(l)
+	return loc
+}
+
+// SetLiability sets the "liability" field.
+func (loc *LoanObligorCreate) SetLiability(l loanobligor.Liability) *LoanObligorCreate {
+	loc.mutation.SetLiability(l)
+	return loc
+}
+
+// SetNillableLiability sets the "liability" field if the given value is not nil.
+func (loc *LoanObligorCreate) SetNillableLiability(l *loanobligor.Liability) *LoanObligorCreate {
+	if l != nil {
+		loc.SetLiability(*l)
+	}
+	return loc
+}
+
+// SetLiabilityPercent sets the "liability_percent" field.
+func (loc *LoanObligorCreate) SetLiabilityPercent(f float64) *LoanObligorCreate {
+	loc.mutation.SetLiabilityPercent(f)
+	return loc
+}
+
+// SetNillableLiabilityPercent sets the "liability_percent" field if the given value is not nil.
+func (loc *LoanObligorCreate) SetNillableLiabilityPercent(f *float64) *LoanObligorCreate {
+	if f != nil {
+		loc.SetLiabilityPercent(*f)
+	}
+	return loc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (loc *LoanObligorCreate) SetCreatedAt(t time.Time) *LoanObligorCreate {
+	loc.mutation.SetCreatedAt(t)
+	return loc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (loc *LoanObligorCreate) SetNillableCreatedAt(t *time.Time) *LoanObligorCreate {
+	if t != nil {
+		loc.SetCreatedAt(*t)
+	}
+	return loc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (loc *LoanObligorCreate) SetLoan(l *Loan) *LoanObligorCreate {
+	return loc.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (loc *LoanObligorCreate) SetUser(u *User) *LoanObligorCreate {
+	return loc.SetUserID(u.ID)
+}
+
+// Mutation returns the LoanObligorMutation object of the builder.
+func (loc *LoanObligorCreate) Mutation() *LoanObligorMutation {
+	return loc.mutation
+}
+
+// Save creates the LoanObligor in the database.
+func (loc *LoanObligorCreate) Save(ctx context.Context) (*LoanObligor, error) {
+	loc.defaults()
+	return withHooks(ctx, loc.sqlSave, loc.mutation, loc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (loc *LoanObligorCreate) SaveX(ctx context.Context) *LoanObligor {
+	v, err := loc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (loc *LoanObligorCreate) Exec(ctx context.Context) error {
+	_, err := loc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (loc *LoanObligorCreate) ExecX(ctx context.Context) {
+	if err := loc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (loc *LoanObligorCreate) defaults() {
+	if _, ok := loc.mutation.Liability(); !ok {
+		v := loanobligor.DefaultLiability
+		loc.mutation.SetLiability(v)
+	}
+	if _, ok := loc.mutation.CreatedAt(); !ok {
+		v := loanobligor.DefaultCreatedAt()
+		loc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (loc *LoanObligorCreate) check() error {
+	if _, ok := loc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanObligor.loan_id"`)}
+	}
+	if _, ok := loc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LoanObligor.user_id"`)}
+	}
+	if _, ok := loc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "LoanObligor.role"`)}
+	}
+	if v, ok := loc.mutation.Role(); ok {
+		if err := loanobligor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.role": %w`, err)}
+		}
+	}
+	if _, ok := loc.mutation.Liability(); !ok {
+		return &ValidationError{Name: "liability", err: errors.New(`ent: missing required field "LoanObligor.liability"`)}
+	}
+	if v, ok := loc.mutation.Liability(); ok {
+		if err := loanobligor.LiabilityValidator(v); err != nil {
+			return &ValidationError{Name: "liability", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.liability": %w`, err)}
+		}
+	}
+	if _, ok := loc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanObligor.created_at"`)}
+	}
+	if _, ok := loc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanObligor.loan"`)}
+	}
+	if _, ok := loc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LoanObligor.user"`)}
+	}
+	return nil
+}
+
+func (loc *LoanObligorCreate) sqlSave(ctx context.Context) (*LoanObligor, error) {
+	if err := loc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := loc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, loc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	loc.mutation.id = &_node.ID
+	loc.mutation.done = true
+	return _node, nil
+}
+
+func (loc *LoanObligorCreate) createSpec() (*LoanObligor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanObligor{config: loc.config}
+		_spec = sqlgraph.NewCreateSpec(loanobligor.Table, sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt))
+	)
+	if value, ok := loc.mutation.Role(); ok {
+		_spec.SetField(loanobligor.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := loc.mutation.Liability(); ok {
+		_spec.SetField(loanobligor.FieldLiability, field.TypeEnum, value)
+		_node.Liability = value
+	}
+	if value, ok := loc.mutation.LiabilityPercent(); ok {
+		_spec.SetField(loanobligor.FieldLiabilityPercent, field.TypeFloat64, value)
+		_node.LiabilityPercent = value
+	}
+	if value, ok := loc.mutation.CreatedAt(); ok {
+		_spec.SetField(loanobligor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := loc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.LoanTable,
+			Columns: []string{loanobligor.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := loc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.UserTable,
+			Columns: []string{loanobligor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoanObligorCreateBulk is the builder for creating many LoanObligor entities in bulk.
+type LoanObligorCreateBulk struct {
+	config
+	err      error
+	builders []*LoanObligorCreate
+}
+
+// Save creates the LoanObligor entities in the database.
+func (locb *LoanObligorCreateBulk) Save(ctx context.Context) ([]*LoanObligor, error) {
+	if locb.err != nil {
+		return nil, locb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(locb.builders))
+	nodes := make([]*LoanObligor, len(locb.builders))
+	mutators := make([]Mutator, len(locb.builders))
+	for i := range locb.builders {
+		func(i int, root context.Context) {
+			builder := locb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanObligorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, locb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, locb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, locb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (locb *LoanObligorCreateBulk) SaveX(ctx context.Context) []*LoanObligor {
+	v, err := locb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (locb *LoanObligorCreateBulk) Exec(ctx context.Context) error {
+	_, err := locb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (locb *LoanObligorCreateBulk) ExecX(ctx context.Context) {
+	if err := locb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanObligorDelete is the builder for deleting a LoanObligor entity.
+type LoanObligorDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoanObligorMutation
+}
+
+// Where appends a list predicates to the LoanObligorDelete builder.
+func (lod *LoanObligorDelete) Where(ps ...predicate.LoanObligor) *LoanObligorDelete {
+	lod.mutation.Where(ps...)
+	return lod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lod *LoanObligorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lod.sqlExec, lod.mutation, lod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lod *LoanObligorDelete) ExecX(ctx context.Context) int {
+	n, err := lod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lod *LoanObligorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loanobligor.Table, sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt))
+	if ps := lod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lod.mutation.done = true
+	return affected, err
+}
+
+// LoanObligorDeleteOne is the builder for deleting a single LoanObligor entity.
+type LoanObligorDeleteOne struct {
+	lod *LoanObligorDelete
+}
+
+// Where appends a list predicates to the LoanObligorDelete builder.
+func (lodo *LoanObligorDeleteOne) Where(ps ...predicate.LoanObligor) *LoanObligorDeleteOne {
+	lodo.lod.mutation.Where(ps...)
+	return lodo
+}
+
+// Exec executes the deletion query.
+func (lodo *LoanObligorDeleteOne) Exec(ctx context.Context) error {
+	n, err := lodo.lod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loanobligor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lodo *LoanObligorDeleteOne) ExecX(ctx context.Context) {
+	if err := lodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
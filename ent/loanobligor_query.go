@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// LoanObligorQuery is the builder for querying LoanObligor entities.
+type LoanObligorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loanobligor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoanObligor
+	withLoan   *LoanQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoanObligorQuery builder.
+func (loq *LoanObligorQuery) Where(ps ...predicate.LoanObligor) *LoanObligorQuery {
+	loq.predicates = append(loq.predicates, ps...)
+	return loq
+}
+
+// Limit the number of records to be returned by this query.
+func (loq *LoanObligorQuery) Limit(limit int) *LoanObligorQuery {
+	loq.ctx.Limit = &limit
+	return loq
+}
+
+// Offset to start from.
+func (loq *LoanObligorQuery) Offset(offset int) *LoanObligorQuery {
+	loq.ctx.Offset = &offset
+	return loq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (loq *LoanObligorQuery) Unique(unique bool) *LoanObligorQuery {
+	loq.ctx.Unique = &unique
+	return loq
+}
+
+// Order specifies how the records should be ordered.
+func (loq *LoanObligorQuery) Order(o ...loanobligor.OrderOption) *LoanObligorQuery {
+	loq.order = append(loq.order, o...)
+	return loq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (loq *LoanObligorQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: loq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := loq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := loq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanobligor.Table, loanobligor.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanobligor.LoanTable, loanobligor.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(loq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (loq *LoanObligorQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: loq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := loq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := loq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanobligor.Table, loanobligor.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanobligor.UserTable, loanobligor.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(loq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LoanObligor entity from the query.
+// Returns a *NotFoundError when no LoanObligor was found.
+func (loq *LoanObligorQuery) First(ctx context.Context) (*LoanObligor, error) {
+	nodes, err := loq.Limit(1).All(setContextOp(ctx, loq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loanobligor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (loq *LoanObligorQuery) FirstX(ctx context.Context) *LoanObligor {
+	node, err := loq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoanObligor ID from the query.
+// Returns a *NotFoundError when no LoanObligor ID was found.
+func (loq *LoanObligorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = loq.Limit(1).IDs(setContextOp(ctx, loq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loanobligor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (loq *LoanObligorQuery) FirstIDX(ctx context.Context) int {
+	id, err := loq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoanObligor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoanObligor entity is found.
+// Returns a *NotFoundError when no LoanObligor entities are found.
+func (loq *LoanObligorQuery) Only(ctx context.Context) (*LoanObligor, error) {
+	nodes, err := loq.Limit(2).All(setContextOp(ctx, loq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loanobligor.Label}
+	default:
+		return nil, &NotSingularError{loanobligor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (loq *LoanObligorQuery) OnlyX(ctx context.Context) *LoanObligor {
+	node, err := loq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoanObligor ID in the query.
+// Returns a *NotSingularError when more than one LoanObligor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (loq *LoanObligorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = loq.Limit(2).IDs(setContextOp(ctx, loq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loanobligor.Label}
+	default:
+		err = &NotSingularError{loanobligor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (loq *LoanObligorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := loq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoanObligors.
+func (loq *LoanObligorQuery) All(ctx context.Context) ([]*LoanObligor, error) {
+	ctx = setContextOp(ctx, loq.ctx, "All")
+	if err := loq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoanObligor, *LoanObligorQuery]()
+	return withInterceptors[[]*LoanObligor](ctx, loq, qr, loq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (loq *LoanObligorQuery) AllX(ctx context.Context) []*LoanObligor {
+	nodes, err := loq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoanObligor IDs.
+func (loq *LoanObligorQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if loq.ctx.Unique == nil && loq.path != nil {
+		loq.Unique(true)
+	}
+	ctx = setContextOp(ctx, loq.ctx, "IDs")
+	if err = loq.Select(loanobligor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (loq *LoanObligorQuery) IDsX(ctx context.Context) []int {
+	ids, err := loq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (loq *LoanObligorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, loq.ctx, "Count")
+	if err := loq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, loq, querierCount[*LoanObligorQuery](), loq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (loq *LoanObligorQuery) CountX(ctx context.Context) int {
+	count, err := loq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (loq *LoanObligorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, loq.ctx, "Exist")
+	switch _, err := loq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (loq *LoanObligorQuery) ExistX(ctx context.Context) bool {
+	exist, err := loq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoanObligorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (loq *LoanObligorQuery) Clone() *LoanObligorQuery {
+	if loq == nil {
+		return nil
+	}
+	return &LoanObligorQuery{
+		config:     loq.config,
+		ctx:        loq.ctx.Clone(),
+		order:      append([]loanobligor.OrderOption{}, loq.order...),
+		inters:     append([]Interceptor{}, loq.inters...),
+		predicates: append([]predicate.LoanObligor{}, loq.predicates...),
+		withLoan:   loq.withLoan.Clone(),
+		withUser:   loq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  loq.sql.Clone(),
+		path: loq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (loq *LoanObligorQuery) WithLoan(opts ...func(*LoanQuery)) *LoanObligorQuery {
+	query := (&LoanClient{config: loq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	loq.withLoan = query
+	return loq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (loq *LoanObligorQuery) WithUser(opts ...func(*UserQuery)) *LoanObligorQuery {
+	query := (&UserClient{config: loq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	loq.withUser = query
+	return loq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoanObligor.Query().
+//		GroupBy(loanobligor.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (loq *LoanObligorQuery) GroupBy(field string, fields ...string) *LoanObligorGroupBy {
+	loq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoanObligorGroupBy{build: loq}
+	grbuild.flds = &loq.ctx.Fields
+	grbuild.label = loanobligor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.LoanObligor.Query().
+//		Select(loanobligor.FieldLoanID).
+//		Scan(ctx, &v)
+func (loq *LoanObligorQuery) Select(fields ...string) *LoanObligorSelect {
+	loq.ctx.Fields = append(loq.ctx.Fields, fields...)
+	sbuild := &LoanObligorSelect{LoanObligorQuery: loq}
+	sbuild.label = loanobligor.Label
+	sbuild.flds, sbuild.scan = &loq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoanObligorSelect configured with the given aggregations.
+func (loq *LoanObligorQuery) Aggregate(fns ...AggregateFunc) *LoanObligorSelect {
+	return loq.Select().Aggregate(fns...)
+}
+
+func (loq *LoanObligorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range loq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, loq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range loq.ctx.Fields {
+		if !loanobligor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if loq.path != nil {
+		prev, err := loq.path(ctx)
+		if err != nil {
+			return err
+		}
+		loq.sql = prev
+	}
+	return nil
+}
+
+func (loq *LoanObligorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoanObligor, error) {
+	var (
+		nodes       = []*LoanObligor{}
+		_spec       = loq.querySpec()
+		loadedTypes = [2]bool{
+			loq.withLoan != nil,
+			loq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoanObligor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoanObligor{config: loq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, loq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := loq.withLoan; query != nil {
+		if err := loq.loadLoan(ctx, query, nodes, nil,
+			func(n *LoanObligor, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := loq.withUser; query != nil {
+		if err := loq.loadUser(ctx, query, nodes, nil,
+			func(n *LoanObligor, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (loq *LoanObligorQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*LoanObligor, init func(*LoanObligor), assign func(*LoanObligor, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LoanObligor)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (loq *LoanObligorQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*LoanObligor, init func(*LoanObligor), assign func(*LoanObligor, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LoanObligor)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (loq *LoanObligorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := loq.querySpec()
+	_spec.Node.Columns = loq.ctx.Fields
+	if len(loq.ctx.Fields) > 0 {
+		_spec.Unique = loq.ctx.Unique != nil && *loq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, loq.driver, _spec)
+}
+
+func (loq *LoanObligorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loanobligor.Table, loanobligor.Columns, sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt))
+	_spec.From = loq.sql
+	if unique := loq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if loq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := loq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanobligor.FieldID)
+		for i := range fields {
+			if fields[i] != loanobligor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if loq.withLoan != nil {
+			_spec.Node.AddColumnOnce(loanobligor.FieldLoanID)
+		}
+		if loq.withUser != nil {
+			_spec.Node.AddColumnOnce(loanobligor.FieldUserID)
+		}
+	}
+	if ps := loq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := loq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := loq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := loq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (loq *LoanObligorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(loq.driver.Dialect())
+	t1 := builder.Table(loanobligor.Table)
+	columns := loq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loanobligor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if loq.sql != nil {
+		selector = loq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if loq.ctx.Unique != nil && *loq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range loq.predicates {
+		p(selector)
+	}
+	for _, p := range loq.order {
+		p(selector)
+	}
+	if offset := loq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := loq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoanObligorGroupBy is the group-by builder for LoanObligor entities.
+type LoanObligorGroupBy struct {
+	selector
+	build *LoanObligorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (logb *LoanObligorGroupBy) Aggregate(fns ...AggregateFunc) *LoanObligorGroupBy {
+	logb.fns = append(logb.fns, fns...)
+	return logb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (logb *LoanObligorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, logb.build.ctx, "GroupBy")
+	if err := logb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanObligorQuery, *LoanObligorGroupBy](ctx, logb.build, logb, logb.build.inters, v)
+}
+
+func (logb *LoanObligorGroupBy) sqlScan(ctx context.Context, root *LoanObligorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(logb.fns))
+	for _, fn := range logb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*logb.flds)+len(logb.fns))
+		for _, f := range *logb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*logb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := logb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoanObligorSelect is the builder for selecting fields of LoanObligor entities.
+type LoanObligorSelect struct {
+	*LoanObligorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (los *LoanObligorSelect) Aggregate(fns ...AggregateFunc) *LoanObligorSelect {
+	los.fns = append(los.fns, fns...)
+	return los
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (los *LoanObligorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, los.ctx, "Select")
+	if err := los.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanObligorQuery, *LoanObligorSelect](ctx, los.LoanObligorQuery, los, los.inters, v)
+}
+
+func (los *LoanObligorSelect) sqlScan(ctx context.Context, root *LoanObligorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(los.fns))
+	for _, fn := range los.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*los.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := los.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// LoanObligorUpdate is the builder for updating LoanObligor entities.
+type LoanObligorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanObligorMutation
+}
+
+// Where appends a list predicates to the LoanObligorUpdate builder.
+func (lou *LoanObligorUpdate) Where(ps ...predicate.LoanObligor) *LoanObligorUpdate {
+	lou.mutation.Where(ps...)
+	return lou
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lou *LoanObligorUpdate) SetLoanID(i int) *LoanObligorUpdate {
+	lou.mutation.SetLoanID(i)
+	return lou
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lou *LoanObligorUpdate) SetNillableLoanID(i *int) *LoanObligorUpdate {
+	if i != nil {
+		lou.SetLoanID(*i)
+	}
+	return lou
+}
+
+// SetUserID sets the "user_id" field.
+func (lou *LoanObligorUpdate) SetUserID(i int) *LoanObligorUpdate {
+	lou.mutation.SetUserID(i)
+	return lou
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lou *LoanObligorUpdate) SetNillableUserID(i *int) *LoanObligorUpdate {
+	if i != nil {
+		lou.SetUserID(*i)
+	}
+	return lou
+}
+
+// SetRole sets the "role" field.
+func (lou *LoanObligorUpdate) SetRole(l loanobligor.Role) *LoanObligorUpdate {
+	lou.mutation.SetRole(l)
+	return lou
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (lou *LoanObligorUpdate) SetNillableRole(l *loanobligor.Role) *LoanObligorUpdate {
+	if l != nil {
+		lou.SetRole(*l)
+	}
+	return lou
+}
+
+// SetLiability sets the "liability" field.
+func (lou *LoanObligorUpdate) SetLiability(l loanobligor.Liability) *LoanObligorUpdate {
+	lou.mutation.SetLiability(l)
+	return lou
+}
+
+// SetNillableLiability sets the "liability" field if the given value is not nil.
+func (lou *LoanObligorUpdate) SetNillableLiability(l *loanobligor.Liability) *LoanObligorUpdate {
+	if l != nil {
+		lou.SetLiability(*l)
+	}
+	return lou
+}
+
+// SetLiabilityPercent sets the "liability_percent" field.
+func (lou *LoanObligorUpdate) SetLiabilityPercent(f float64) *LoanObligorUpdate {
+	lou.mutation.ResetLiabilityPercent()
+	lou.mutation.SetLiabilityPercent(f)
+	return lou
+}
+
+// SetNillableLiabilityPercent sets the "liability_percent" field if the given value is not nil.
+func (lou *LoanObligorUpdate) SetNillableLiabilityPercent(f *float64) *LoanObligorUpdate {
+	if f != nil {
+		lou.SetLiabilityPercent(*f)
+	}
+	return lou
+}
+
+// AddLiabilityPercent adds f to the "liability_percent" field.
+func (lou *LoanObligorUpdate) AddLiabilityPercent(f float64) *LoanObligorUpdate {
+	lou.mutation.AddLiabilityPercent(f)
+	return lou
+}
+
+// ClearLiabilityPercent clears the value of the "liability_percent" field.
+func (lou *LoanObligorUpdate) ClearLiabilityPercent() *LoanObligorUpdate {
+	lou.mutation.ClearLiabilityPercent()
+	return lou
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lou *LoanObligorUpdate) SetLoan(l *Loan) *LoanObligorUpdate {
+	return lou.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lou *LoanObligorUpdate) SetUser(u *User) *LoanObligorUpdate {
+	return lou.SetUserID(u.ID)
+}
+
+// Mutation returns the LoanObligorMutation object of the builder.
+func (lou *LoanObligorUpdate) Mutation() *LoanObligorMutation {
+	return lou.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lou *LoanObligorUpdate) ClearLoan() *LoanObligorUpdate {
+	lou.mutation.ClearLoan()
+	return lou
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (lou *LoanObligorUpdate) ClearUser() *LoanObligorUpdate {
+	lou.mutation.ClearUser()
+	return lou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lou *LoanObligorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lou.sqlSave, lou.mutation, lou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lou *LoanObligorUpdate) SaveX(ctx context.Context) int {
+	affected, err := lou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lou *LoanObligorUpdate) Exec(ctx context.Context) error {
+	_, err := lou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lou *LoanObligorUpdate) ExecX(ctx context.Context) {
+	if err := lou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lou *LoanObligorUpdate) check() error {
+	if v, ok := lou.mutation.Role(); ok {
+		if err := loanobligor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.role": %w`, err)}
+		}
+	}
+	if v, ok := lou.mutation.Liability(); ok {
+		if err := loanobligor.LiabilityValidator(v); err != nil {
+			return &ValidationError{Name: "liability", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.liability": %w`, err)}
+		}
+	}
+	if _, ok := lou.mutation.LoanID(); lou.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanObligor.loan"`)
+	}
+	if _, ok := lou.mutation.UserID(); lou.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanObligor.user"`)
+	}
+	return nil
+}
+
+func (lou *LoanObligorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanobligor.Table, loanobligor.Columns, sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt))
+	if ps := lou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lou.mutation.Role(); ok {
+		_spec.SetField(loanobligor.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := lou.mutation.Liability(); ok {
+		_spec.SetField(loanobligor.FieldLiability, field.TypeEnum, value)
+	}
+	if value, ok := lou.mutation.LiabilityPercent(); ok {
+		_spec.SetField(loanobligor.FieldLiabilityPercent, field.TypeFloat64, value)
+	}
+	if value, ok := lou.mutation.AddedLiabilityPercent(); ok {
+		_spec.AddField(loanobligor.FieldLiabilityPercent, field.TypeFloat64, value)
+	}
+	if lou.mutation.LiabilityPercentCleared() {
+		_spec.ClearField(loanobligor.FieldLiabilityPercent, field.TypeFloat64)
+	}
+	if lou.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.LoanTable,
+			Columns: []string{loanobligor.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lou.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.LoanTable,
+			Columns: []string{loanobligor.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lou.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.UserTable,
+			Columns: []string{loanobligor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lou.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.UserTable,
+			Columns: []string{loanobligor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanobligor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lou.mutation.done = true
+	return n, nil
+}
+
+// LoanObligorUpdateOne is the builder for updating a single LoanObligor entity.
+type LoanObligorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanObligorMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (louo *LoanObligorUpdateOne) SetLoanID(i int) *LoanObligorUpdateOne {
+	louo.mutation.SetLoanID(i)
+	return louo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (louo *LoanObligorUpdateOne) SetNillableLoanID(i *int) *LoanObligorUpdateOne {
+	if i != nil {
+		louo.SetLoanID(*i)
+	}
+	return louo
+}
+
+// SetUserID sets the "user_id" field.
+func (louo *LoanObligorUpdateOne) SetUserID(i int) *LoanObligorUpdateOne {
+	louo.mutation.SetUserID(i)
+	return louo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (louo *LoanObligorUpdateOne) SetNillableUserID(i *int) *LoanObligorUpdateOne {
+	if i != nil {
+		louo.SetUserID(*i)
+	}
+	return louo
+}
+
+// SetRole sets the "role" field.
+func (louo *LoanObligorUpdateOne) SetRole(l loanobligor.Role) *LoanObligorUpdateOne {
+	louo.mutation.SetRole(l)
+	return louo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (louo *LoanObligorUpdateOne) SetNillableRole(l *loanobligor.Role) *LoanObligorUpdateOne {
+	if l != nil {
+		louo.SetRole(*l)
+	}
+	return louo
+}
+
+// SetLiability sets the "liability" field.
+func (louo *LoanObligorUpdateOne) SetLiability(l loanobligor.Liability) *LoanObligorUpdateOne {
+	louo.mutation.SetLiability(l)
+	return louo
+}
+
+// SetNillableLiability sets the "liability" field if the given value is not nil.
+func (louo *LoanObligorUpdateOne) SetNillableLiability(l *loanobligor.Liability) *LoanObligorUpdateOne {
+	if l != nil {
+		louo.SetLiability(*l)
+	}
+	return louo
+}
+
+// SetLiabilityPercent sets the "liability_percent" field.
+func (louo *LoanObligorUpdateOne) SetLiabilityPercent(f float64) *LoanObligorUpdateOne {
+	louo.mutation.ResetLiabilityPercent()
+	louo.mutation.SetLiabilityPercent(f)
+	return louo
+}
+
+// SetNillableLiabilityPercent sets the "liability_percent" field if the given value is not nil.
+func (louo *LoanObligorUpdateOne) SetNillableLiabilityPercent(f *float64) *LoanObligorUpdateOne {
+	if f != nil {
+		louo.SetLiabilityPercent(*f)
+	}
+	return louo
+}
+
+// AddLiabilityPercent adds f to the "liability_percent" field.
+func (louo *LoanObligorUpdateOne) AddLiabilityPercent(f float64) *LoanObligorUpdateOne {
+	louo.mutation.AddLiabilityPercent(f)
+	return louo
+}
+
+// ClearLiabilityPercent clears the value of the "liability_percent" field.
+func (louo *LoanObligorUpdateOne) ClearLiabilityPercent() *LoanObligorUpdateOne {
+	louo.mutation.ClearLiabilityPercent()
+	return louo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (louo *LoanObligorUpdateOne) SetLoan(l *Loan) *LoanObligorUpdateOne {
+	return louo.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (louo *LoanObligorUpdateOne) SetUser(u *User) *LoanObligorUpdateOne {
+	return louo.SetUserID(u.ID)
+}
+
+// Mutation returns the LoanObligorMutation object of the builder.
+func (louo *LoanObligorUpdateOne) Mutation() *LoanObligorMutation {
+	return louo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (louo *LoanObligorUpdateOne) ClearLoan() *LoanObligorUpdateOne {
+	louo.mutation.ClearLoan()
+	return louo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (louo *LoanObligorUpdateOne) ClearUser() *LoanObligorUpdateOne {
+	louo.mutation.ClearUser()
+	return louo
+}
+
+// Where appends a list predicates to the LoanObligorUpdate builder.
+func (louo *LoanObligorUpdateOne) Where(ps ...predicate.LoanObligor) *LoanObligorUpdateOne {
+	louo.mutation.Where(ps...)
+	return louo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (louo *LoanObligorUpdateOne) Select(field string, fields ...string) *LoanObligorUpdateOne {
+	louo.fields = append([]string{field}, fields...)
+	return louo
+}
+
+// Save executes the query and returns the updated LoanObligor entity.
+func (louo *LoanObligorUpdateOne) Save(ctx context.Context) (*LoanObligor, error) {
+	return withHooks(ctx, louo.sqlSave, louo.mutation, louo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (louo *LoanObligorUpdateOne) SaveX(ctx context.Context) *LoanObligor {
+	node, err := louo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (louo *LoanObligorUpdateOne) Exec(ctx context.Context) error {
+	_, err := louo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (louo *LoanObligorUpdateOne) ExecX(ctx context.Context) {
+	if err := louo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (louo *LoanObligorUpdateOne) check() error {
+	if v, ok := louo.mutation.Role(); ok {
+		if err := loanobligor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.role": %w`, err)}
+		}
+	}
+	if v, ok := louo.mutation.Liability(); ok {
+		if err := loanobligor.LiabilityValidator(v); err != nil {
+			return &ValidationError{Name: "liability", err: fmt.Errorf(`ent: validator failed for field "LoanObligor.liability": %w`, err)}
+		}
+	}
+	if _, ok := louo.mutation.LoanID(); louo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanObligor.loan"`)
+	}
+	if _, ok := louo.mutation.UserID(); louo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanObligor.user"`)
+	}
+	return nil
+}
+
+func (louo *LoanObligorUpdateOne) sqlSave(ctx context.Context) (_node *LoanObligor, err error) {
+	if err := louo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanobligor.Table, loanobligor.Columns, sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt))
+	id, ok := louo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanObligor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := louo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanobligor.FieldID)
+		for _, f := range fields {
+			if !loanobligor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loanobligor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := louo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := louo.mutation.Role(); ok {
+		_spec.SetField(loanobligor.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := louo.mutation.Liability(); ok {
+		_spec.SetField(loanobligor.FieldLiability, field.TypeEnum, value)
+	}
+	if value, ok := louo.mutation.LiabilityPercent(); ok {
+		_spec.SetField(loanobligor.FieldLiabilityPercent, field.TypeFloat64, value)
+	}
+	if value, ok := louo.mutation.AddedLiabilityPercent(); ok {
+		_spec.AddField(loanobligor.FieldLiabilityPercent, field.TypeFloat64, value)
+	}
+	if louo.mutation.LiabilityPercentCleared() {
+		_spec.ClearField(loanobligor.FieldLiabilityPercent, field.TypeFloat64)
+	}
+	if louo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.LoanTable,
+			Columns: []string{loanobligor.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := louo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.LoanTable,
+			Columns: []string{loanobligor.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if louo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.UserTable,
+			Columns: []string{loanobligor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := louo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanobligor.UserTable,
+			Columns: []string{loanobligor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanObligor{config: louo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, louo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanobligor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	louo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanrecast"
+)
+
+// LoanRecast is the model entity for the LoanRecast schema.
+type LoanRecast struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// Month holds the value of the "month" field.
+	Month int `json:"month,omitempty"`
+	// Curtailment holds the value of the "curtailment" field.
+	Curtailment int `json:"curtailment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanRecastQuery when eager-loading is set.
+	Edges        LoanRecastEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LoanRecastEdges holds the relations/edges for other nodes in the graph.
+type LoanRecastEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanRecastEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanRecast) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loanrecast.FieldID, loanrecast.FieldLoanID, loanrecast.FieldMonth, loanrecast.FieldCurtailment:
+			values[i] = new(sql.NullInt64)
+		case loanrecast.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanRecast fields.
+func (lr *LoanRecast) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loanrecast.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lr.ID = int(value.Int64)
+		case loanrecast.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				lr.LoanID = int(value.Int64)
+			}
+		case loanrecast.FieldMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				lr.Month = int(value.Int64)
+			}
+		case loanrecast.FieldCurtailment:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field curtailment", values[i])
+			} else if value.Valid {
+				lr.Curtailment = int(value.Int64)
+			}
+		case loanrecast.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lr.CreatedAt = value.Time
+			}
+		default:
+			lr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanRecast.
+// This includes values selected through modifiers, order, etc.
+func (lr *LoanRecast) Value(name string) (ent.Value, error) {
+	return lr.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the LoanRecast entity.
+func (lr *LoanRecast) QueryLoan() *LoanQuery {
+	return NewLoanRecastClient(lr.config).QueryLoan(lr)
+}
+
+// Update returns a builder for updating this LoanRecast.
+// Note that you need to call LoanRecast.Unwrap() before calling this method if this LoanRecast
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lr *LoanRecast) Update() *LoanRecastUpdateOne {
+	return NewLoanRecastClient(lr.config).UpdateOne(lr)
+}
+
+// Unwrap unwraps the LoanRecast entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lr *LoanRecast) Unwrap() *LoanRecast {
+	_tx, ok := lr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanRecast is not a transactional entity")
+	}
+	lr.config.driver = _tx.drv
+	return lr
+}
+
+// String implements the fmt.Stringer.
+func (lr *LoanRecast) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanRecast(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lr.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", lr.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("month=")
+	builder.WriteString(fmt.Sprintf("%v", lr.Month))
+	builder.WriteString(", ")
+	builder.WriteString("curtailment=")
+	builder.WriteString(fmt.Sprintf("%v", lr.Curtailment))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanRecasts is a parsable slice of LoanRecast.
+type LoanRecasts []*LoanRecast
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanrecast
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldLoanID, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldMonth, v))
+}
+
+// Curtailment applies equality check predicate on the "curtailment" field. It's identical to CurtailmentEQ.
+func Curtailment(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldCurtailment, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLTE(FieldMonth, v))
+}
+
+// CurtailmentEQ applies the EQ predicate on the "curtailment" field.
+func CurtailmentEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldCurtailment, v))
+}
+
+// CurtailmentNEQ applies the NEQ predicate on the "curtailment" field.
+func CurtailmentNEQ(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNEQ(FieldCurtailment, v))
+}
+
+// CurtailmentIn applies the In predicate on the "curtailment" field.
+func CurtailmentIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldIn(FieldCurtailment, vs...))
+}
+
+// CurtailmentNotIn applies the NotIn predicate on the "curtailment" field.
+func CurtailmentNotIn(vs ...int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNotIn(FieldCurtailment, vs...))
+}
+
+// CurtailmentGT applies the GT predicate on the "curtailment" field.
+func CurtailmentGT(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGT(FieldCurtailment, v))
+}
+
+// CurtailmentGTE applies the GTE predicate on the "curtailment" field.
+func CurtailmentGTE(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGTE(FieldCurtailment, v))
+}
+
+// CurtailmentLT applies the LT predicate on the "curtailment" field.
+func CurtailmentLT(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLT(FieldCurtailment, v))
+}
+
+// CurtailmentLTE applies the LTE predicate on the "curtailment" field.
+func CurtailmentLTE(v int) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLTE(FieldCurtailment, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanRecast {
+	return predicate.LoanRecast(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanRecast {
+	return predicate.LoanRecast(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanRecast) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanRecast) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanRecast) predicate.LoanRecast {
+	return predicate.LoanRecast(sql.NotPredicates(p))
+}
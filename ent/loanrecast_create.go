@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanrecast"
+)
+
+// LoanRecastCreate is the builder for creating a LoanRecast entity.
+type LoanRecastCreate struct {
+	config
+	mutation *LoanRecastMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lrc *LoanRecastCreate) SetLoanID(i int) *LoanRecastCreate {
+	lrc.mutation.SetLoanID(i)
+	return lrc
+}
+
+// SetMonth sets the "month" field.
+func (lrc *LoanRecastCreate) SetMonth(i int) *LoanRecastCreate {
+	lrc.mutation.SetMonth(i)
+	return lrc
+}
+
+// SetCurtailment sets the "curtailment" field.
+func (lrc *LoanRecastCreate) SetCurtailment(i int) *LoanRecastCreate {
+	lrc.mutation.SetCurtailment(i)
+	return lrc
+}
+
+// SetNillableCurtailment sets the "curtailment" field if the given value is not nil.
+func (lrc *LoanRecastCreate) SetNillableCurtailment(i *int) *LoanRecastCreate {
+	if i != nil {
+		lrc.SetCurtailment(*i)
+	}
+	return lrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lrc *LoanRecastCreate) SetCreatedAt(t time.Time) *LoanRecastCreate {
+	lrc.mutation.SetCreatedAt(t)
+	return lrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lrc *LoanRecastCreate) SetNillableCreatedAt(t *time.Time) *LoanRecastCreate {
+	if t != nil {
+		lrc.SetCreatedAt(*t)
+	}
+	return lrc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lrc *LoanRecastCreate) SetLoan(l *Loan) *LoanRecastCreate {
+	return lrc.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanRecastMutation object of the builder.
+func (lrc *LoanRecastCreate) Mutation() *LoanRecastMutation {
+	return lrc.mutation
+}
+
+// Save creates the LoanRecast in the database.
+func (lrc *LoanRecastCreate) Save(ctx context.Context) (*LoanRecast, error) {
+	lrc.defaults()
+	return withHooks(ctx, lrc.sqlSave, lrc.mutation, lrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lrc *LoanRecastCreate) SaveX(ctx context.Context) *LoanRecast {
+	v, err := lrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lrc *LoanRecastCreate) Exec(ctx context.Context) error {
+	_, err := lrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrc *LoanRecastCreate) ExecX(ctx context.Context) {
+	if err := lrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lrc *LoanRecastCreate) defaults() {
+	if _, ok := lrc.mutation.Curtailment(); !ok {
+		v := loanrecast.DefaultCurtailment
+		lrc.mutation.SetCurtailment(v)
+	}
+	if _, ok := lrc.mutation.CreatedAt(); !ok {
+		v := loanrecast.DefaultCreatedAt()
+		lrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lrc *LoanRecastCreate) check() error {
+	if _, ok := lrc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanRecast.loan_id"`)}
+	}
+	if _, ok := lrc.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "LoanRecast.month"`)}
+	}
+	if _, ok := lrc.mutation.Curtailment(); !ok {
+		return &ValidationError{Name: "curtailment", err: errors.New(`ent: missing required field "LoanRecast.curtailment"`)}
+	}
+	if _, ok := lrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanRecast.created_at"`)}
+	}
+	if _, ok := lrc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanRecast.loan"`)}
+	}
+	return nil
+}
+
+func (lrc *LoanRecastCreate) sqlSave(ctx context.Context) (*LoanRecast, error) {
+	if err := lrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lrc.mutation.id = &_node.ID
+	lrc.mutation.done = true
+	return _node, nil
+}
+
+func (lrc *LoanRecastCreate) createSpec() (*LoanRecast, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanRecast{config: lrc.config}
+		_spec = sqlgraph.NewCreateSpec(loanrecast.Table, sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt))
+	)
+	if value, ok := lrc.mutation.Month(); ok {
+		_spec.SetField(loanrecast.FieldMonth, field.TypeInt, value)
+		_node.Month = value
+	}
+	if value, ok := lrc.mutation.Curtailment(); ok {
+		_spec.SetField(loanrecast.FieldCurtailment, field.TypeInt, value)
+		_node.Curtailment = value
+	}
+	if value, ok := lrc.mutation.CreatedAt(); ok {
+		_spec.SetField(loanrecast.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lrc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanrecast.LoanTable,
+			Columns: []string{loanrecast.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoanRecastCreateBulk is the builder for creating many LoanRecast entities in bulk.
+type LoanRecastCreateBulk struct {
+	config
+	err      error
+	builders []*LoanRecastCreate
+}
+
+// Save creates the LoanRecast entities in the database.
+func (lrcb *LoanRecastCreateBulk) Save(ctx context.Context) ([]*LoanRecast, error) {
+	if lrcb.err != nil {
+		return nil, lrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lrcb.builders))
+	nodes := make([]*LoanRecast, len(lrcb.builders))
+	mutators := make([]Mutator, len(lrcb.builders))
+	for i := range lrcb.builders {
+		func(i int, root context.Context) {
+			builder := lrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanRecastMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lrcb *LoanRecastCreateBulk) SaveX(ctx context.Context) []*LoanRecast {
+	v, err := lrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lrcb *LoanRecastCreateBulk) Exec(ctx context.Context) error {
+	_, err := lrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrcb *LoanRecastCreateBulk) ExecX(ctx context.Context) {
+	if err := lrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
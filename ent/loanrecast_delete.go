@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanRecastDelete is the builder for deleting a LoanRecast entity.
+type LoanRecastDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoanRecastMutation
+}
+
+// Where appends a list predicates to the LoanRecastDelete builder.
+func (lrd *LoanRecastDelete) Where(ps ...predicate.LoanRecast) *LoanRecastDelete {
+	lrd.mutation.Where(ps...)
+	return lrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lrd *LoanRecastDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lrd.sqlExec, lrd.mutation, lrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrd *LoanRecastDelete) ExecX(ctx context.Context) int {
+	n, err := lrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lrd *LoanRecastDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loanrecast.Table, sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt))
+	if ps := lrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lrd.mutation.done = true
+	return affected, err
+}
+
+// LoanRecastDeleteOne is the builder for deleting a single LoanRecast entity.
+type LoanRecastDeleteOne struct {
+	lrd *LoanRecastDelete
+}
+
+// Where appends a list predicates to the LoanRecastDelete builder.
+func (lrdo *LoanRecastDeleteOne) Where(ps ...predicate.LoanRecast) *LoanRecastDeleteOne {
+	lrdo.lrd.mutation.Where(ps...)
+	return lrdo
+}
+
+// Exec executes the deletion query.
+func (lrdo *LoanRecastDeleteOne) Exec(ctx context.Context) error {
+	n, err := lrdo.lrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loanrecast.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lrdo *LoanRecastDeleteOne) ExecX(ctx context.Context) {
+	if err := lrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanRecastQuery is the builder for querying LoanRecast entities.
+type LoanRecastQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loanrecast.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoanRecast
+	withLoan   *LoanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoanRecastQuery builder.
+func (lrq *LoanRecastQuery) Where(ps ...predicate.LoanRecast) *LoanRecastQuery {
+	lrq.predicates = append(lrq.predicates, ps...)
+	return lrq
+}
+
+// Limit the number of records to be returned by this query.
+func (lrq *LoanRecastQuery) Limit(limit int) *LoanRecastQuery {
+	lrq.ctx.Limit = &limit
+	return lrq
+}
+
+// Offset to start from.
+func (lrq *LoanRecastQuery) Offset(offset int) *LoanRecastQuery {
+	lrq.ctx.Offset = &offset
+	return lrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lrq *LoanRecastQuery) Unique(unique bool) *LoanRecastQuery {
+	lrq.ctx.Unique = &unique
+	return lrq
+}
+
+// Order specifies how the records should be ordered.
+func (lrq *LoanRecastQuery) Order(o ...loanrecast.OrderOption) *LoanRecastQuery {
+	lrq.order = append(lrq.order, o...)
+	return lrq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (lrq *LoanRecastQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: lrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loanrecast.Table, loanrecast.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loanrecast.LoanTable, loanrecast.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LoanRecast entity from the query.
+// Returns a *NotFoundError when no LoanRecast was found.
+func (lrq *LoanRecastQuery) First(ctx context.Context) (*LoanRecast, error) {
+	nodes, err := lrq.Limit(1).All(setContextOp(ctx, lrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loanrecast.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lrq *LoanRecastQuery) FirstX(ctx context.Context) *LoanRecast {
+	node, err := lrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoanRecast ID from the query.
+// Returns a *NotFoundError when no LoanRecast ID was found.
+func (lrq *LoanRecastQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lrq.Limit(1).IDs(setContextOp(ctx, lrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loanrecast.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lrq *LoanRecastQuery) FirstIDX(ctx context.Context) int {
+	id, err := lrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoanRecast entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoanRecast entity is found.
+// Returns a *NotFoundError when no LoanRecast entities are found.
+func (lrq *LoanRecastQuery) Only(ctx context.Context) (*LoanRecast, error) {
+	nodes, err := lrq.Limit(2).All(setContextOp(ctx, lrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loanrecast.Label}
+	default:
+		return nil, &NotSingularError{loanrecast.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lrq *LoanRecastQuery) OnlyX(ctx context.Context) *LoanRecast {
+	node, err := lrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoanRecast ID in the query.
+// Returns a *NotSingularError when more than one LoanRecast ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lrq *LoanRecastQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lrq.Limit(2).IDs(setContextOp(ctx, lrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loanrecast.Label}
+	default:
+		err = &NotSingularError{loanrecast.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lrq *LoanRecastQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoanRecasts.
+func (lrq *LoanRecastQuery) All(ctx context.Context) ([]*LoanRecast, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "All")
+	if err := lrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoanRecast, *LoanRecastQuery]()
+	return withInterceptors[[]*LoanRecast](ctx, lrq, qr, lrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lrq *LoanRecastQuery) AllX(ctx context.Context) []*LoanRecast {
+	nodes, err := lrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoanRecast IDs.
+func (lrq *LoanRecastQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lrq.ctx.Unique == nil && lrq.path != nil {
+		lrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lrq.ctx, "IDs")
+	if err = lrq.Select(loanrecast.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lrq *LoanRecastQuery) IDsX(ctx context.Context) []int {
+	ids, err := lrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lrq *LoanRecastQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "Count")
+	if err := lrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lrq, querierCount[*LoanRecastQuery](), lrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lrq *LoanRecastQuery) CountX(ctx context.Context) int {
+	count, err := lrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lrq *LoanRecastQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lrq.ctx, "Exist")
+	switch _, err := lrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lrq *LoanRecastQuery) ExistX(ctx context.Context) bool {
+	exist, err := lrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoanRecastQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lrq *LoanRecastQuery) Clone() *LoanRecastQuery {
+	if lrq == nil {
+		return nil
+	}
+	return &LoanRecastQuery{
+		config:     lrq.config,
+		ctx:        lrq.ctx.Clone(),
+		order:      append([]loanrecast.OrderOption{}, lrq.order...),
+		inters:     append([]Interceptor{}, lrq.inters...),
+		predicates: append([]predicate.LoanRecast{}, lrq.predicates...),
+		withLoan:   lrq.withLoan.Clone(),
+		// clone intermediate query.
+		sql:  lrq.sql.Clone(),
+		path: lrq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (lrq *LoanRecastQuery) WithLoan(opts ...func(*LoanQuery)) *LoanRecastQuery {
+	query := (&LoanClient{config: lrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lrq.withLoan = query
+	return lrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoanRecast.Query().
+//		GroupBy(loanrecast.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lrq *LoanRecastQuery) GroupBy(field string, fields ...string) *LoanRecastGroupBy {
+	lrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoanRecastGroupBy{build: lrq}
+	grbuild.flds = &lrq.ctx.Fields
+	grbuild.label = loanrecast.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.LoanRecast.Query().
+//		Select(loanrecast.FieldLoanID).
+//		Scan(ctx, &v)
+func (lrq *LoanRecastQuery) Select(fields ...string) *LoanRecastSelect {
+	lrq.ctx.Fields = append(lrq.ctx.Fields, fields...)
+	sbuild := &LoanRecastSelect{LoanRecastQuery: lrq}
+	sbuild.label = loanrecast.Label
+	sbuild.flds, sbuild.scan = &lrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoanRecastSelect configured with the given aggregations.
+func (lrq *LoanRecastQuery) Aggregate(fns ...AggregateFunc) *LoanRecastSelect {
+	return lrq.Select().Aggregate(fns...)
+}
+
+func (lrq *LoanRecastQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lrq.ctx.Fields {
+		if !loanrecast.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lrq.path != nil {
+		prev, err := lrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lrq.sql = prev
+	}
+	return nil
+}
+
+func (lrq *LoanRecastQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoanRecast, error) {
+	var (
+		nodes       = []*LoanRecast{}
+		_spec       = lrq.querySpec()
+		loadedTypes = [1]bool{
+			lrq.withLoan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoanRecast).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoanRecast{config: lrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lrq.withLoan; query != nil {
+		if err := lrq.loadLoan(ctx, query, nodes, nil,
+			func(n *LoanRecast, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lrq *LoanRecastQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*LoanRecast, init func(*LoanRecast), assign func(*LoanRecast, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LoanRecast)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lrq *LoanRecastQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lrq.querySpec()
+	_spec.Node.Columns = lrq.ctx.Fields
+	if len(lrq.ctx.Fields) > 0 {
+		_spec.Unique = lrq.ctx.Unique != nil && *lrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lrq.driver, _spec)
+}
+
+func (lrq *LoanRecastQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loanrecast.Table, loanrecast.Columns, sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt))
+	_spec.From = lrq.sql
+	if unique := lrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanrecast.FieldID)
+		for i := range fields {
+			if fields[i] != loanrecast.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if lrq.withLoan != nil {
+			_spec.Node.AddColumnOnce(loanrecast.FieldLoanID)
+		}
+	}
+	if ps := lrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lrq *LoanRecastQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lrq.driver.Dialect())
+	t1 := builder.Table(loanrecast.Table)
+	columns := lrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loanrecast.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lrq.sql != nil {
+		selector = lrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lrq.ctx.Unique != nil && *lrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lrq.predicates {
+		p(selector)
+	}
+	for _, p := range lrq.order {
+		p(selector)
+	}
+	if offset := lrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoanRecastGroupBy is the group-by builder for LoanRecast entities.
+type LoanRecastGroupBy struct {
+	selector
+	build *LoanRecastQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lrgb *LoanRecastGroupBy) Aggregate(fns ...AggregateFunc) *LoanRecastGroupBy {
+	lrgb.fns = append(lrgb.fns, fns...)
+	return lrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lrgb *LoanRecastGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lrgb.build.ctx, "GroupBy")
+	if err := lrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanRecastQuery, *LoanRecastGroupBy](ctx, lrgb.build, lrgb, lrgb.build.inters, v)
+}
+
+func (lrgb *LoanRecastGroupBy) sqlScan(ctx context.Context, root *LoanRecastQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lrgb.fns))
+	for _, fn := range lrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lrgb.flds)+len(lrgb.fns))
+		for _, f := range *lrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoanRecastSelect is the builder for selecting fields of LoanRecast entities.
+type LoanRecastSelect struct {
+	*LoanRecastQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lrs *LoanRecastSelect) Aggregate(fns ...AggregateFunc) *LoanRecastSelect {
+	lrs.fns = append(lrs.fns, fns...)
+	return lrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lrs *LoanRecastSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lrs.ctx, "Select")
+	if err := lrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoanRecastQuery, *LoanRecastSelect](ctx, lrs.LoanRecastQuery, lrs, lrs.inters, v)
+}
+
+func (lrs *LoanRecastSelect) sqlScan(ctx context.Context, root *LoanRecastQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lrs.fns))
+	for _, fn := range lrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
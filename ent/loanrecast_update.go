@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// LoanRecastUpdate is the builder for updating LoanRecast entities.
+type LoanRecastUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanRecastMutation
+}
+
+// Where appends a list predicates to the LoanRecastUpdate builder.
+func (lru *LoanRecastUpdate) Where(ps ...predicate.LoanRecast) *LoanRecastUpdate {
+	lru.mutation.Where(ps...)
+	return lru
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lru *LoanRecastUpdate) SetLoanID(i int) *LoanRecastUpdate {
+	lru.mutation.SetLoanID(i)
+	return lru
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lru *LoanRecastUpdate) SetNillableLoanID(i *int) *LoanRecastUpdate {
+	if i != nil {
+		lru.SetLoanID(*i)
+	}
+	return lru
+}
+
+// SetMonth sets the "month" field.
+func (lru *LoanRecastUpdate) SetMonth(i int) *LoanRecastUpdate {
+	lru.mutation.ResetMonth()
+	lru.mutation.SetMonth(i)
+	return lru
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (lru *LoanRecastUpdate) SetNillableMonth(i *int) *LoanRecastUpdate {
+	if i != nil {
+		lru.SetMonth(*i)
+	}
+	return lru
+}
+
+// AddMonth adds i to the "month" field.
+func (lru *LoanRecastUpdate) AddMonth(i int) *LoanRecastUpdate {
+	lru.mutation.AddMonth(i)
+	return lru
+}
+
+// SetCurtailment sets the "curtailment" field.
+func (lru *LoanRecastUpdate) SetCurtailment(i int) *LoanRecastUpdate {
+	lru.mutation.ResetCurtailment()
+	lru.mutation.SetCurtailment(i)
+	return lru
+}
+
+// SetNillableCurtailment sets the "curtailment" field if the given value is not nil.
+func (lru *LoanRecastUpdate) SetNillableCurtailment(i *int) *LoanRecastUpdate {
+	if i != nil {
+		lru.SetCurtailment(*i)
+	}
+	return lru
+}
+
+// AddCurtailment adds i to the "curtailment" field.
+func (lru *LoanRecastUpdate) AddCurtailment(i int) *LoanRecastUpdate {
+	lru.mutation.AddCurtailment(i)
+	return lru
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lru *LoanRecastUpdate) SetLoan(l *Loan) *LoanRecastUpdate {
+	return lru.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanRecastMutation object of the builder.
+func (lru *LoanRecastUpdate) Mutation() *LoanRecastMutation {
+	return lru.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lru *LoanRecastUpdate) ClearLoan() *LoanRecastUpdate {
+	lru.mutation.ClearLoan()
+	return lru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lru *LoanRecastUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lru.sqlSave, lru.mutation, lru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lru *LoanRecastUpdate) SaveX(ctx context.Context) int {
+	affected, err := lru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lru *LoanRecastUpdate) Exec(ctx context.Context) error {
+	_, err := lru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lru *LoanRecastUpdate) ExecX(ctx context.Context) {
+	if err := lru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lru *LoanRecastUpdate) check() error {
+	if _, ok := lru.mutation.LoanID(); lru.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanRecast.loan"`)
+	}
+	return nil
+}
+
+func (lru *LoanRecastUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanrecast.Table, loanrecast.Columns, sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt))
+	if ps := lru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lru.mutation.Month(); ok {
+		_spec.SetField(loanrecast.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.AddedMonth(); ok {
+		_spec.AddField(loanrecast.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.Curtailment(); ok {
+		_spec.SetField(loanrecast.FieldCurtailment, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.AddedCurtailment(); ok {
+		_spec.AddField(loanrecast.FieldCurtailment, field.TypeInt, value)
+	}
+	if lru.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanrecast.LoanTable,
+			Columns: []string{loanrecast.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lru.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanrecast.LoanTable,
+			Columns: []string{loanrecast.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanrecast.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lru.mutation.done = true
+	return n, nil
+}
+
+// LoanRecastUpdateOne is the builder for updating a single LoanRecast entity.
+type LoanRecastUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanRecastMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (lruo *LoanRecastUpdateOne) SetLoanID(i int) *LoanRecastUpdateOne {
+	lruo.mutation.SetLoanID(i)
+	return lruo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (lruo *LoanRecastUpdateOne) SetNillableLoanID(i *int) *LoanRecastUpdateOne {
+	if i != nil {
+		lruo.SetLoanID(*i)
+	}
+	return lruo
+}
+
+// SetMonth sets the "month" field.
+func (lruo *LoanRecastUpdateOne) SetMonth(i int) *LoanRecastUpdateOne {
+	lruo.mutation.ResetMonth()
+	lruo.mutation.SetMonth(i)
+	return lruo
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (lruo *LoanRecastUpdateOne) SetNillableMonth(i *int) *LoanRecastUpdateOne {
+	if i != nil {
+		lruo.SetMonth(*i)
+	}
+	return lruo
+}
+
+// AddMonth adds i to the "month" field.
+func (lruo *LoanRecastUpdateOne) AddMonth(i int) *LoanRecastUpdateOne {
+	lruo.mutation.AddMonth(i)
+	return lruo
+}
+
+// SetCurtailment sets the "curtailment" field.
+func (lruo *LoanRecastUpdateOne) SetCurtailment(i int) *LoanRecastUpdateOne {
+	lruo.mutation.ResetCurtailment()
+	lruo.mutation.SetCurtailment(i)
+	return lruo
+}
+
+// SetNillableCurtailment sets the "curtailment" field if the given value is not nil.
+func (lruo *LoanRecastUpdateOne) SetNillableCurtailment(i *int) *LoanRecastUpdateOne {
+	if i != nil {
+		lruo.SetCurtailment(*i)
+	}
+	return lruo
+}
+
+// AddCurtailment adds i to the "curtailment" field.
+func (lruo *LoanRecastUpdateOne) AddCurtailment(i int) *LoanRecastUpdateOne {
+	lruo.mutation.AddCurtailment(i)
+	return lruo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (lruo *LoanRecastUpdateOne) SetLoan(l *Loan) *LoanRecastUpdateOne {
+	return lruo.SetLoanID(l.ID)
+}
+
+// Mutation returns the LoanRecastMutation object of the builder.
+func (lruo *LoanRecastUpdateOne) Mutation() *LoanRecastMutation {
+	return lruo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (lruo *LoanRecastUpdateOne) ClearLoan() *LoanRecastUpdateOne {
+	lruo.mutation.ClearLoan()
+	return lruo
+}
+
+// Where appends a list predicates to the LoanRecastUpdate builder.
+func (lruo *LoanRecastUpdateOne) Where(ps ...predicate.LoanRecast) *LoanRecastUpdateOne {
+	lruo.mutation.Where(ps...)
+	return lruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lruo *LoanRecastUpdateOne) Select(field string, fields ...string) *LoanRecastUpdateOne {
+	lruo.fields = append([]string{field}, fields...)
+	return lruo
+}
+
+// Save executes the query and returns the updated LoanRecast entity.
+func (lruo *LoanRecastUpdateOne) Save(ctx context.Context) (*LoanRecast, error) {
+	return withHooks(ctx, lruo.sqlSave, lruo.mutation, lruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lruo *LoanRecastUpdateOne) SaveX(ctx context.Context) *LoanRecast {
+	node, err := lruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lruo *LoanRecastUpdateOne) Exec(ctx context.Context) error {
+	_, err := lruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lruo *LoanRecastUpdateOne) ExecX(ctx context.Context) {
+	if err := lruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lruo *LoanRecastUpdateOne) check() error {
+	if _, ok := lruo.mutation.LoanID(); lruo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoanRecast.loan"`)
+	}
+	return nil
+}
+
+func (lruo *LoanRecastUpdateOne) sqlSave(ctx context.Context) (_node *LoanRecast, err error) {
+	if err := lruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanrecast.Table, loanrecast.Columns, sqlgraph.NewFieldSpec(loanrecast.FieldID, field.TypeInt))
+	id, ok := lruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanRecast.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanrecast.FieldID)
+		for _, f := range fields {
+			if !loanrecast.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loanrecast.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lruo.mutation.Month(); ok {
+		_spec.SetField(loanrecast.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.AddedMonth(); ok {
+		_spec.AddField(loanrecast.FieldMonth, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.Curtailment(); ok {
+		_spec.SetField(loanrecast.FieldCurtailment, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.AddedCurtailment(); ok {
+		_spec.AddField(loanrecast.FieldCurtailment, field.TypeInt, value)
+	}
+	if lruo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanrecast.LoanTable,
+			Columns: []string{loanrecast.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lruo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loanrecast.LoanTable,
+			Columns: []string{loanrecast.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanRecast{config: lruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanrecast.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lruo.mutation.done = true
+	return _node, nil
+}
@@ -8,12 +8,189 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "roles", Type: field.TypeJSON},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// CollateralsColumns holds the columns for the "collaterals" table.
+	CollateralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"real_estate", "vehicle", "equipment"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CollateralsTable holds the schema information for the "collaterals" table.
+	CollateralsTable = &schema.Table{
+		Name:       "collaterals",
+		Columns:    CollateralsColumns,
+		PrimaryKey: []*schema.Column{CollateralsColumns[0]},
+	}
+	// CollateralAppraisalsColumns holds the columns for the "collateral_appraisals" table.
+	CollateralAppraisalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "value", Type: field.TypeInt},
+		{Name: "appraised_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "collateral_id", Type: field.TypeInt},
+	}
+	// CollateralAppraisalsTable holds the schema information for the "collateral_appraisals" table.
+	CollateralAppraisalsTable = &schema.Table{
+		Name:       "collateral_appraisals",
+		Columns:    CollateralAppraisalsColumns,
+		PrimaryKey: []*schema.Column{CollateralAppraisalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collateral_appraisals_collaterals_appraisals",
+				Columns:    []*schema.Column{CollateralAppraisalsColumns[4]},
+				RefColumns: []*schema.Column{CollateralsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CreditLinesColumns holds the columns for the "credit_lines" table.
+	CreditLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "credit_limit", Type: field.TypeInt},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "draw_months", Type: field.TypeInt},
+		{Name: "repayment_months", Type: field.TypeInt},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "borrower_id", Type: field.TypeInt},
+	}
+	// CreditLinesTable holds the schema information for the "credit_lines" table.
+	CreditLinesTable = &schema.Table{
+		Name:       "credit_lines",
+		Columns:    CreditLinesColumns,
+		PrimaryKey: []*schema.Column{CreditLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "credit_lines_users_credit_lines",
+				Columns:    []*schema.Column{CreditLinesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CreditLineTransactionsColumns holds the columns for the "credit_line_transactions" table.
+	CreditLineTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"draw", "payment"}},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "posted_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "credit_line_id", Type: field.TypeInt},
+	}
+	// CreditLineTransactionsTable holds the schema information for the "credit_line_transactions" table.
+	CreditLineTransactionsTable = &schema.Table{
+		Name:       "credit_line_transactions",
+		Columns:    CreditLineTransactionsColumns,
+		PrimaryKey: []*schema.Column{CreditLineTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "credit_line_transactions_credit_lines_transactions",
+				Columns:    []*schema.Column{CreditLineTransactionsColumns[5]},
+				RefColumns: []*schema.Column{CreditLinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// EscrowItemsColumns holds the columns for the "escrow_items" table.
+	EscrowItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"property_tax", "hazard_insurance", "pmi"}},
+		{Name: "annual_amount", Type: field.TypeInt},
+		{Name: "first_due_month", Type: field.TypeInt},
+		{Name: "disbursements_per_year", Type: field.TypeInt, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// EscrowItemsTable holds the schema information for the "escrow_items" table.
+	EscrowItemsTable = &schema.Table{
+		Name:       "escrow_items",
+		Columns:    EscrowItemsColumns,
+		PrimaryKey: []*schema.Column{EscrowItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "escrow_items_loans_escrow_items",
+				Columns:    []*schema.Column{EscrowItemsColumns[6]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// IncomeCertificationsColumns holds the columns for the "income_certifications" table.
+	IncomeCertificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "income", Type: field.TypeInt},
+		{Name: "family_size", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "plan_id", Type: field.TypeInt},
+	}
+	// IncomeCertificationsTable holds the schema information for the "income_certifications" table.
+	IncomeCertificationsTable = &schema.Table{
+		Name:       "income_certifications",
+		Columns:    IncomeCertificationsColumns,
+		PrimaryKey: []*schema.Column{IncomeCertificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "income_certifications_income_driven_plans_certifications",
+				Columns:    []*schema.Column{IncomeCertificationsColumns[5]},
+				RefColumns: []*schema.Column{IncomeDrivenPlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// IncomeDrivenPlansColumns holds the columns for the "income_driven_plans" table.
+	IncomeDrivenPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "start_month", Type: field.TypeInt},
+		{Name: "payment_percent", Type: field.TypeFloat64},
+		{Name: "poverty_multiplier", Type: field.TypeFloat64},
+		{Name: "region", Type: field.TypeEnum, Enums: []string{"contiguous", "alaska", "hawaii"}},
+		{Name: "forgiveness_months", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt, Unique: true},
+	}
+	// IncomeDrivenPlansTable holds the schema information for the "income_driven_plans" table.
+	IncomeDrivenPlansTable = &schema.Table{
+		Name:       "income_driven_plans",
+		Columns:    IncomeDrivenPlansColumns,
+		PrimaryKey: []*schema.Column{IncomeDrivenPlansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "income_driven_plans_loans_income_driven_plan",
+				Columns:    []*schema.Column{IncomeDrivenPlansColumns[7]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// LoansColumns holds the columns for the "loans" table.
 	LoansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "amount", Type: field.TypeInt},
 		{Name: "rate", Type: field.TypeFloat64},
 		{Name: "term", Type: field.TypeInt},
+		{Name: "graduated_step_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "graduated_years", Type: field.TypeInt, Nullable: true},
+		{Name: "negative_amortization_cap", Type: field.TypeFloat64, Nullable: true},
+		{Name: "construction_months", Type: field.TypeInt, Nullable: true},
+		{Name: "property_value", Type: field.TypeInt, Nullable: true},
+		{Name: "pmi_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "pmi_cancellation_month", Type: field.TypeInt, Nullable: true},
+		{Name: "originated_at", Type: field.TypeTime},
 		{Name: "borrower_id", Type: field.TypeInt},
 	}
 	// LoansTable holds the schema information for the "loans" table.
@@ -24,15 +201,251 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "loans_users_loans",
-				Columns:    []*schema.Column{LoansColumns[4]},
+				Columns:    []*schema.Column{LoansColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// LoanDisbursementsColumns holds the columns for the "loan_disbursements" table.
+	LoanDisbursementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "month", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// LoanDisbursementsTable holds the schema information for the "loan_disbursements" table.
+	LoanDisbursementsTable = &schema.Table{
+		Name:       "loan_disbursements",
+		Columns:    LoanDisbursementsColumns,
+		PrimaryKey: []*schema.Column{LoanDisbursementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_disbursements_loans_disbursements",
+				Columns:    []*schema.Column{LoanDisbursementsColumns[4]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// LoanModificationsColumns holds the columns for the "loan_modifications" table.
+	LoanModificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "effective_month", Type: field.TypeInt},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "term", Type: field.TypeInt},
+		{Name: "capitalized_arrears", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// LoanModificationsTable holds the schema information for the "loan_modifications" table.
+	LoanModificationsTable = &schema.Table{
+		Name:       "loan_modifications",
+		Columns:    LoanModificationsColumns,
+		PrimaryKey: []*schema.Column{LoanModificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_modifications_loans_modifications",
+				Columns:    []*schema.Column{LoanModificationsColumns[6]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// LoanObligorsColumns holds the columns for the "loan_obligors" table.
+	LoanObligorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"primary", "co_borrower", "guarantor"}},
+		{Name: "liability", Type: field.TypeEnum, Enums: []string{"joint_and_several", "percentage"}, Default: "joint_and_several"},
+		{Name: "liability_percent", Type: field.TypeFloat64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LoanObligorsTable holds the schema information for the "loan_obligors" table.
+	LoanObligorsTable = &schema.Table{
+		Name:       "loan_obligors",
+		Columns:    LoanObligorsColumns,
+		PrimaryKey: []*schema.Column{LoanObligorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_obligors_loans_obligors",
+				Columns:    []*schema.Column{LoanObligorsColumns[5]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "loan_obligors_users_obligations",
+				Columns:    []*schema.Column{LoanObligorsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// LoanRecastsColumns holds the columns for the "loan_recasts" table.
+	LoanRecastsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "month", Type: field.TypeInt},
+		{Name: "curtailment", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// LoanRecastsTable holds the schema information for the "loan_recasts" table.
+	LoanRecastsTable = &schema.Table{
+		Name:       "loan_recasts",
+		Columns:    LoanRecastsColumns,
+		PrimaryKey: []*schema.Column{LoanRecastsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_recasts_loans_recasts",
+				Columns:    []*schema.Column{LoanRecastsColumns[4]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// OidcLoginsColumns holds the columns for the "oidc_logins" table.
+	OidcLoginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state", Type: field.TypeString, Unique: true},
+		{Name: "nonce", Type: field.TypeString},
+		{Name: "code_verifier", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// OidcLoginsTable holds the schema information for the "oidc_logins" table.
+	OidcLoginsTable = &schema.Table{
+		Name:       "oidc_logins",
+		Columns:    OidcLoginsColumns,
+		PrimaryKey: []*schema.Column{OidcLoginsColumns[0]},
+	}
+	// PaymentDeferralsColumns holds the columns for the "payment_deferrals" table.
+	PaymentDeferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"forbearance", "skip_payment", "in_school", "grace"}},
+		{Name: "start_month", Type: field.TypeInt},
+		{Name: "months", Type: field.TypeInt},
+		{Name: "interest", Type: field.TypeEnum, Enums: []string{"capitalize", "defer", "waive"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// PaymentDeferralsTable holds the schema information for the "payment_deferrals" table.
+	PaymentDeferralsTable = &schema.Table{
+		Name:       "payment_deferrals",
+		Columns:    PaymentDeferralsColumns,
+		PrimaryKey: []*schema.Column{PaymentDeferralsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_deferrals_loans_deferrals",
+				Columns:    []*schema.Column{PaymentDeferralsColumns[6]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ShareInvitationsColumns holds the columns for the "share_invitations" table.
+	ShareInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"view_balance", "view_schedule", "make_payments", "manage_shares"}, Default: "view_schedule"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ShareInvitationsTable holds the schema information for the "share_invitations" table.
+	ShareInvitationsTable = &schema.Table{
+		Name:       "share_invitations",
+		Columns:    ShareInvitationsColumns,
+		PrimaryKey: []*schema.Column{ShareInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_invitations_loans_share_invitations",
+				Columns:    []*schema.Column{ShareInvitationsColumns[6]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "share_invitations_users_share_invitations",
+				Columns:    []*schema.Column{ShareInvitationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ShareLinksColumns holds the columns for the "share_links" table.
+	ShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// ShareLinksTable holds the schema information for the "share_links" table.
+	ShareLinksTable = &schema.Table{
+		Name:       "share_links",
+		Columns:    ShareLinksColumns,
+		PrimaryKey: []*schema.Column{ShareLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_links_loans_share_links",
+				Columns:    []*schema.Column{ShareLinksColumns[5]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ShareLinkAccessesColumns holds the columns for the "share_link_accesses" table.
+	ShareLinkAccessesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "ip", Type: field.TypeString},
+		{Name: "accessed_at", Type: field.TypeTime},
+		{Name: "share_link_id", Type: field.TypeInt},
+	}
+	// ShareLinkAccessesTable holds the schema information for the "share_link_accesses" table.
+	ShareLinkAccessesTable = &schema.Table{
+		Name:       "share_link_accesses",
+		Columns:    ShareLinkAccessesColumns,
+		PrimaryKey: []*schema.Column{ShareLinkAccessesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_link_accesses_share_links_accesses",
+				Columns:    []*schema.Column{ShareLinkAccessesColumns[4]},
+				RefColumns: []*schema.Column{ShareLinksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// SharedLoansColumns holds the columns for the "shared_loans" table.
 	SharedLoansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"view_balance", "view_schedule", "make_payments", "manage_shares"}, Default: "view_schedule"},
 		{Name: "loan_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
 	}
@@ -44,13 +457,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "shared_loans_loans_shared_loan",
-				Columns:    []*schema.Column{SharedLoansColumns[1]},
+				Columns:    []*schema.Column{SharedLoansColumns[2]},
 				RefColumns: []*schema.Column{LoansColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "shared_loans_users_shared_loan",
-				Columns:    []*schema.Column{SharedLoansColumns[2]},
+				Columns:    []*schema.Column{SharedLoansColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// StaffRolesColumns holds the columns for the "staff_roles" table.
+	StaffRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"loan_officer", "servicer", "auditor", "admin"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// StaffRolesTable holds the schema information for the "staff_roles" table.
+	StaffRolesTable = &schema.Table{
+		Name:       "staff_roles",
+		Columns:    StaffRolesColumns,
+		PrimaryKey: []*schema.Column{StaffRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "staff_roles_users_staff_roles",
+				Columns:    []*schema.Column{StaffRolesColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -60,7 +494,11 @@ var (
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
-		{Name: "social", Type: field.TypeString, Unique: true},
+		{Name: "name_index", Type: field.TypeString, Nullable: true},
+		{Name: "social", Type: field.TypeString, Nullable: true},
+		{Name: "social_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "social_encrypted", Type: field.TypeString, Nullable: true},
+		{Name: "social_last4", Type: field.TypeString, Nullable: true},
 		{Name: "address", Type: field.TypeString, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -69,16 +507,145 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserChangesColumns holds the columns for the "user_changes" table.
+	UserChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "field", Type: field.TypeEnum, Enums: []string{"name", "address"}},
+		{Name: "old_value", Type: field.TypeString, Nullable: true},
+		{Name: "new_value", Type: field.TypeString, Nullable: true},
+		{Name: "changed_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserChangesTable holds the schema information for the "user_changes" table.
+	UserChangesTable = &schema.Table{
+		Name:       "user_changes",
+		Columns:    UserChangesColumns,
+		PrimaryKey: []*schema.Column{UserChangesColumns[0]},
+	}
+	// UserCredentialsColumns holds the columns for the "user_credentials" table.
+	UserCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Unique: true},
+	}
+	// UserCredentialsTable holds the schema information for the "user_credentials" table.
+	UserCredentialsTable = &schema.Table{
+		Name:       "user_credentials",
+		Columns:    UserCredentialsColumns,
+		PrimaryKey: []*schema.Column{UserCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_credentials_users_credential",
+				Columns:    []*schema.Column{UserCredentialsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CollateralLoansColumns holds the columns for the "collateral_loans" table.
+	CollateralLoansColumns = []*schema.Column{
+		{Name: "collateral_id", Type: field.TypeInt},
+		{Name: "loan_id", Type: field.TypeInt},
+	}
+	// CollateralLoansTable holds the schema information for the "collateral_loans" table.
+	CollateralLoansTable = &schema.Table{
+		Name:       "collateral_loans",
+		Columns:    CollateralLoansColumns,
+		PrimaryKey: []*schema.Column{CollateralLoansColumns[0], CollateralLoansColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collateral_loans_collateral_id",
+				Columns:    []*schema.Column{CollateralLoansColumns[0]},
+				RefColumns: []*schema.Column{CollateralsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "collateral_loans_loan_id",
+				Columns:    []*schema.Column{CollateralLoansColumns[1]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		CollateralsTable,
+		CollateralAppraisalsTable,
+		CreditLinesTable,
+		CreditLineTransactionsTable,
+		EscrowItemsTable,
+		IncomeCertificationsTable,
+		IncomeDrivenPlansTable,
 		LoansTable,
+		LoanDisbursementsTable,
+		LoanModificationsTable,
+		LoanObligorsTable,
+		LoanRecastsTable,
+		OidcLoginsTable,
+		PaymentDeferralsTable,
+		SessionsTable,
+		ShareInvitationsTable,
+		ShareLinksTable,
+		ShareLinkAccessesTable,
 		SharedLoansTable,
+		StaffRolesTable,
 		UsersTable,
+		UserChangesTable,
+		UserCredentialsTable,
+		UserIdentitiesTable,
+		CollateralLoansTable,
 	}
 )
 
 func init() {
+	CollateralAppraisalsTable.ForeignKeys[0].RefTable = CollateralsTable
+	CreditLinesTable.ForeignKeys[0].RefTable = UsersTable
+	CreditLineTransactionsTable.ForeignKeys[0].RefTable = CreditLinesTable
+	EscrowItemsTable.ForeignKeys[0].RefTable = LoansTable
+	IncomeCertificationsTable.ForeignKeys[0].RefTable = IncomeDrivenPlansTable
+	IncomeDrivenPlansTable.ForeignKeys[0].RefTable = LoansTable
 	LoansTable.ForeignKeys[0].RefTable = UsersTable
+	LoanDisbursementsTable.ForeignKeys[0].RefTable = LoansTable
+	LoanModificationsTable.ForeignKeys[0].RefTable = LoansTable
+	LoanObligorsTable.ForeignKeys[0].RefTable = LoansTable
+	LoanObligorsTable.ForeignKeys[1].RefTable = UsersTable
+	LoanRecastsTable.ForeignKeys[0].RefTable = LoansTable
+	PaymentDeferralsTable.ForeignKeys[0].RefTable = LoansTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ShareInvitationsTable.ForeignKeys[0].RefTable = LoansTable
+	ShareInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	ShareLinksTable.ForeignKeys[0].RefTable = LoansTable
+	ShareLinkAccessesTable.ForeignKeys[0].RefTable = ShareLinksTable
 	SharedLoansTable.ForeignKeys[0].RefTable = LoansTable
 	SharedLoansTable.ForeignKeys[1].RefTable = UsersTable
+	StaffRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	CollateralLoansTable.ForeignKeys[0].RefTable = CollateralsTable
+	CollateralLoansTable.ForeignKeys[1].RefTable = LoansTable
 }
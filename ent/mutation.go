@@ -7,13 +7,36 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/apikey"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/paymentdeferral"
 	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/userchange"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 const (
@@ -25,45 +48,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeLoan       = "Loan"
-	TypeSharedLoan = "SharedLoan"
-	TypeUser       = "User"
+	TypeApiKey                = "ApiKey"
+	TypeCollateral            = "Collateral"
+	TypeCollateralAppraisal   = "CollateralAppraisal"
+	TypeCreditLine            = "CreditLine"
+	TypeCreditLineTransaction = "CreditLineTransaction"
+	TypeEscrowItem            = "EscrowItem"
+	TypeIncomeCertification   = "IncomeCertification"
+	TypeIncomeDrivenPlan      = "IncomeDrivenPlan"
+	TypeLoan                  = "Loan"
+	TypeLoanDisbursement      = "LoanDisbursement"
+	TypeLoanModification      = "LoanModification"
+	TypeLoanObligor           = "LoanObligor"
+	TypeLoanRecast            = "LoanRecast"
+	TypeOidcLogin             = "OidcLogin"
+	TypePaymentDeferral       = "PaymentDeferral"
+	TypeSession               = "Session"
+	TypeShareInvitation       = "ShareInvitation"
+	TypeShareLink             = "ShareLink"
+	TypeShareLinkAccess       = "ShareLinkAccess"
+	TypeSharedLoan            = "SharedLoan"
+	TypeStaffRole             = "StaffRole"
+	TypeUser                  = "User"
+	TypeUserChange            = "UserChange"
+	TypeUserCredential        = "UserCredential"
+	TypeUserIdentity          = "UserIdentity"
 )
 
-// LoanMutation represents an operation that mutates the Loan nodes in the graph.
-type LoanMutation struct {
+// ApiKeyMutation represents an operation that mutates the ApiKey nodes in the graph.
+type ApiKeyMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	amount             *int
-	addamount          *int
-	rate               *float64
-	addrate            *float64
-	term               *int
-	addterm            *int
-	clearedFields      map[string]struct{}
-	borrower           *int
-	clearedborrower    bool
-	shared_loan        map[int]struct{}
-	removedshared_loan map[int]struct{}
-	clearedshared_loan bool
-	done               bool
-	oldValue           func(context.Context) (*Loan, error)
-	predicates         []predicate.Loan
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	roles         *[]string
+	appendroles   []string
+	key_hash      *string
+	revoked_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ApiKey, error)
+	predicates    []predicate.ApiKey
 }
 
-var _ ent.Mutation = (*LoanMutation)(nil)
+var _ ent.Mutation = (*ApiKeyMutation)(nil)
 
-// loanOption allows management of the mutation configuration using functional options.
-type loanOption func(*LoanMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*ApiKeyMutation)
 
-// newLoanMutation creates new mutation for the Loan entity.
-func newLoanMutation(c config, op Op, opts ...loanOption) *LoanMutation {
-	m := &LoanMutation{
+// newApiKeyMutation creates new mutation for the ApiKey entity.
+func newApiKeyMutation(c config, op Op, opts ...apikeyOption) *ApiKeyMutation {
+	m := &ApiKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLoan,
+		typ:           TypeApiKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +112,20 @@ func newLoanMutation(c config, op Op, opts ...loanOption) *LoanMutation {
 	return m
 }
 
-// withLoanID sets the ID field of the mutation.
-func withLoanID(id int) loanOption {
-	return func(m *LoanMutation) {
+// withApiKeyID sets the ID field of the mutation.
+func withApiKeyID(id int) apikeyOption {
+	return func(m *ApiKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Loan
+			value *ApiKey
 		)
-		m.oldValue = func(ctx context.Context) (*Loan, error) {
+		m.oldValue = func(ctx context.Context) (*ApiKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Loan.Get(ctx, id)
+					value, err = m.Client().ApiKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +134,10 @@ func withLoanID(id int) loanOption {
 	}
 }
 
-// withLoan sets the old Loan of the mutation.
-func withLoan(node *Loan) loanOption {
-	return func(m *LoanMutation) {
-		m.oldValue = func(context.Context) (*Loan, error) {
+// withApiKey sets the old ApiKey of the mutation.
+func withApiKey(node *ApiKey) apikeyOption {
+	return func(m *ApiKeyMutation) {
+		m.oldValue = func(context.Context) (*ApiKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +146,7 @@ func withLoan(node *Loan) loanOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LoanMutation) Client() *Client {
+func (m ApiKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +154,7 @@ func (m LoanMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LoanMutation) Tx() (*Tx, error) {
+func (m ApiKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -125,7 +165,7 @@ func (m LoanMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LoanMutation) ID() (id int, exists bool) {
+func (m *ApiKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -136,7 +176,7 @@ func (m *LoanMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LoanMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ApiKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -145,306 +185,229 @@ func (m *LoanMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Loan.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApiKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAmount sets the "amount" field.
-func (m *LoanMutation) SetAmount(i int) {
-	m.amount = &i
-	m.addamount = nil
+// SetName sets the "name" field.
+func (m *ApiKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *LoanMutation) Amount() (r int, exists bool) {
-	v := m.amount
+// Name returns the value of the "name" field in the mutation.
+func (m *ApiKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Loan entity.
-// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoanMutation) OldAmount(ctx context.Context) (v int, err error) {
+func (m *ApiKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
-	}
-	return oldValue.Amount, nil
-}
-
-// AddAmount adds i to the "amount" field.
-func (m *LoanMutation) AddAmount(i int) {
-	if m.addamount != nil {
-		*m.addamount += i
-	} else {
-		m.addamount = &i
-	}
-}
-
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *LoanMutation) AddedAmount() (r int, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *LoanMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetName resets all changes to the "name" field.
+func (m *ApiKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetRate sets the "rate" field.
-func (m *LoanMutation) SetRate(f float64) {
-	m.rate = &f
-	m.addrate = nil
+// SetRoles sets the "roles" field.
+func (m *ApiKeyMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
 }
 
-// Rate returns the value of the "rate" field in the mutation.
-func (m *LoanMutation) Rate() (r float64, exists bool) {
-	v := m.rate
+// Roles returns the value of the "roles" field in the mutation.
+func (m *ApiKeyMutation) Roles() (r []string, exists bool) {
+	v := m.roles
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRate returns the old "rate" field's value of the Loan entity.
-// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// OldRoles returns the old "roles" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoanMutation) OldRate(ctx context.Context) (v float64, err error) {
+func (m *ApiKeyMutation) OldRoles(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRate requires an ID field in the mutation")
+		return v, errors.New("OldRoles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
 	}
-	return oldValue.Rate, nil
+	return oldValue.Roles, nil
 }
 
-// AddRate adds f to the "rate" field.
-func (m *LoanMutation) AddRate(f float64) {
-	if m.addrate != nil {
-		*m.addrate += f
-	} else {
-		m.addrate = &f
-	}
+// AppendRoles adds s to the "roles" field.
+func (m *ApiKeyMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
 }
 
-// AddedRate returns the value that was added to the "rate" field in this mutation.
-func (m *LoanMutation) AddedRate() (r float64, exists bool) {
-	v := m.addrate
-	if v == nil {
-		return
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *ApiKeyMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendroles, true
 }
 
-// ResetRate resets all changes to the "rate" field.
-func (m *LoanMutation) ResetRate() {
-	m.rate = nil
-	m.addrate = nil
+// ResetRoles resets all changes to the "roles" field.
+func (m *ApiKeyMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
 }
 
-// SetTerm sets the "term" field.
-func (m *LoanMutation) SetTerm(i int) {
-	m.term = &i
-	m.addterm = nil
+// SetKeyHash sets the "key_hash" field.
+func (m *ApiKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// Term returns the value of the "term" field in the mutation.
-func (m *LoanMutation) Term() (r int, exists bool) {
-	v := m.term
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *ApiKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTerm returns the old "term" field's value of the Loan entity.
-// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoanMutation) OldTerm(ctx context.Context) (v int, err error) {
+func (m *ApiKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTerm is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTerm requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTerm: %w", err)
-	}
-	return oldValue.Term, nil
-}
-
-// AddTerm adds i to the "term" field.
-func (m *LoanMutation) AddTerm(i int) {
-	if m.addterm != nil {
-		*m.addterm += i
-	} else {
-		m.addterm = &i
-	}
-}
-
-// AddedTerm returns the value that was added to the "term" field in this mutation.
-func (m *LoanMutation) AddedTerm() (r int, exists bool) {
-	v := m.addterm
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return *v, true
+	return oldValue.KeyHash, nil
 }
 
-// ResetTerm resets all changes to the "term" field.
-func (m *LoanMutation) ResetTerm() {
-	m.term = nil
-	m.addterm = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *ApiKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetBorrowerID sets the "borrower_id" field.
-func (m *LoanMutation) SetBorrowerID(i int) {
-	m.borrower = &i
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ApiKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// BorrowerID returns the value of the "borrower_id" field in the mutation.
-func (m *LoanMutation) BorrowerID() (r int, exists bool) {
-	v := m.borrower
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ApiKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBorrowerID returns the old "borrower_id" field's value of the Loan entity.
-// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoanMutation) OldBorrowerID(ctx context.Context) (v int, err error) {
+func (m *ApiKeyMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBorrowerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBorrowerID requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBorrowerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.BorrowerID, nil
-}
-
-// ResetBorrowerID resets all changes to the "borrower_id" field.
-func (m *LoanMutation) ResetBorrowerID() {
-	m.borrower = nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearBorrower clears the "borrower" edge to the User entity.
-func (m *LoanMutation) ClearBorrower() {
-	m.clearedborrower = true
-	m.clearedFields[loan.FieldBorrowerID] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ApiKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// BorrowerCleared reports if the "borrower" edge to the User entity was cleared.
-func (m *LoanMutation) BorrowerCleared() bool {
-	return m.clearedborrower
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
 }
 
-// BorrowerIDs returns the "borrower" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BorrowerID instead. It exists only for internal usage by the builders.
-func (m *LoanMutation) BorrowerIDs() (ids []int) {
-	if id := m.borrower; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ApiKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// ResetBorrower resets all changes to the "borrower" edge.
-func (m *LoanMutation) ResetBorrower() {
-	m.borrower = nil
-	m.clearedborrower = false
+// SetCreatedAt sets the "created_at" field.
+func (m *ApiKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by ids.
-func (m *LoanMutation) AddSharedLoanIDs(ids ...int) {
-	if m.shared_loan == nil {
-		m.shared_loan = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.shared_loan[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApiKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSharedLoan clears the "shared_loan" edge to the SharedLoan entity.
-func (m *LoanMutation) ClearSharedLoan() {
-	m.clearedshared_loan = true
-}
-
-// SharedLoanCleared reports if the "shared_loan" edge to the SharedLoan entity was cleared.
-func (m *LoanMutation) SharedLoanCleared() bool {
-	return m.clearedshared_loan
-}
-
-// RemoveSharedLoanIDs removes the "shared_loan" edge to the SharedLoan entity by IDs.
-func (m *LoanMutation) RemoveSharedLoanIDs(ids ...int) {
-	if m.removedshared_loan == nil {
-		m.removedshared_loan = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.shared_loan, ids[i])
-		m.removedshared_loan[ids[i]] = struct{}{}
+// OldCreatedAt returns the old "created_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedSharedLoan returns the removed IDs of the "shared_loan" edge to the SharedLoan entity.
-func (m *LoanMutation) RemovedSharedLoanIDs() (ids []int) {
-	for id := range m.removedshared_loan {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// SharedLoanIDs returns the "shared_loan" edge IDs in the mutation.
-func (m *LoanMutation) SharedLoanIDs() (ids []int) {
-	for id := range m.shared_loan {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSharedLoan resets all changes to the "shared_loan" edge.
-func (m *LoanMutation) ResetSharedLoan() {
-	m.shared_loan = nil
-	m.clearedshared_loan = false
-	m.removedshared_loan = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApiKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the LoanMutation builder.
-func (m *LoanMutation) Where(ps ...predicate.Loan) {
+// Where appends a list predicates to the ApiKeyMutation builder.
+func (m *ApiKeyMutation) Where(ps ...predicate.ApiKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LoanMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApiKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LoanMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Loan, len(ps))
+func (m *ApiKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApiKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -452,36 +415,39 @@ func (m *LoanMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LoanMutation) Op() Op {
+func (m *ApiKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LoanMutation) SetOp(op Op) {
+func (m *ApiKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Loan).
-func (m *LoanMutation) Type() string {
+// Type returns the node type of this mutation (ApiKey).
+func (m *ApiKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LoanMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.amount != nil {
-		fields = append(fields, loan.FieldAmount)
+func (m *ApiKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.rate != nil {
-		fields = append(fields, loan.FieldRate)
+	if m.roles != nil {
+		fields = append(fields, apikey.FieldRoles)
 	}
-	if m.term != nil {
-		fields = append(fields, loan.FieldTerm)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
 	}
-	if m.borrower != nil {
-		fields = append(fields, loan.FieldBorrowerID)
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	return fields
 }
@@ -489,16 +455,18 @@ func (m *LoanMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LoanMutation) Field(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case loan.FieldAmount:
-		return m.Amount()
-	case loan.FieldRate:
-		return m.Rate()
-	case loan.FieldTerm:
-		return m.Term()
-	case loan.FieldBorrowerID:
-		return m.BorrowerID()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldRoles:
+		return m.Roles()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -506,286 +474,219 @@ func (m *LoanMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LoanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApiKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case loan.FieldAmount:
-		return m.OldAmount(ctx)
-	case loan.FieldRate:
-		return m.OldRate(ctx)
-	case loan.FieldTerm:
-		return m.OldTerm(ctx)
-	case loan.FieldBorrowerID:
-		return m.OldBorrowerID(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldRoles:
+		return m.OldRoles(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Loan field %s", name)
+	return nil, fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoanMutation) SetField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case loan.FieldAmount:
-		v, ok := value.(int)
+	case apikey.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetName(v)
 		return nil
-	case loan.FieldRate:
-		v, ok := value.(float64)
+	case apikey.FieldRoles:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRate(v)
+		m.SetRoles(v)
 		return nil
-	case loan.FieldTerm:
-		v, ok := value.(int)
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTerm(v)
+		m.SetKeyHash(v)
 		return nil
-	case loan.FieldBorrowerID:
-		v, ok := value.(int)
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBorrowerID(v)
+		m.SetRevokedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Loan field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LoanMutation) AddedFields() []string {
-	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, loan.FieldAmount)
-	}
-	if m.addrate != nil {
-		fields = append(fields, loan.FieldRate)
-	}
-	if m.addterm != nil {
-		fields = append(fields, loan.FieldTerm)
-	}
-	return fields
+func (m *ApiKeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LoanMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case loan.FieldAmount:
-		return m.AddedAmount()
-	case loan.FieldRate:
-		return m.AddedRate()
-	case loan.FieldTerm:
-		return m.AddedTerm()
-	}
+func (m *ApiKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoanMutation) AddField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case loan.FieldAmount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAmount(v)
-		return nil
-	case loan.FieldRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRate(v)
-		return nil
-	case loan.FieldTerm:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTerm(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Loan numeric field %s", name)
+	return fmt.Errorf("unknown ApiKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LoanMutation) ClearedFields() []string {
-	return nil
+func (m *ApiKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LoanMutation) FieldCleared(name string) bool {
+func (m *ApiKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LoanMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Loan nullable field %s", name)
+func (m *ApiKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ApiKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LoanMutation) ResetField(name string) error {
+func (m *ApiKeyMutation) ResetField(name string) error {
 	switch name {
-	case loan.FieldAmount:
-		m.ResetAmount()
+	case apikey.FieldName:
+		m.ResetName()
 		return nil
-	case loan.FieldRate:
-		m.ResetRate()
+	case apikey.FieldRoles:
+		m.ResetRoles()
 		return nil
-	case loan.FieldTerm:
-		m.ResetTerm()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case loan.FieldBorrowerID:
-		m.ResetBorrowerID()
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Loan field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LoanMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.borrower != nil {
-		edges = append(edges, loan.EdgeBorrower)
-	}
-	if m.shared_loan != nil {
-		edges = append(edges, loan.EdgeSharedLoan)
-	}
+func (m *ApiKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LoanMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case loan.EdgeBorrower:
-		if id := m.borrower; id != nil {
-			return []ent.Value{*id}
-		}
-	case loan.EdgeSharedLoan:
-		ids := make([]ent.Value, 0, len(m.shared_loan))
-		for id := range m.shared_loan {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ApiKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LoanMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedshared_loan != nil {
-		edges = append(edges, loan.EdgeSharedLoan)
-	}
+func (m *ApiKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LoanMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case loan.EdgeSharedLoan:
-		ids := make([]ent.Value, 0, len(m.removedshared_loan))
-		for id := range m.removedshared_loan {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ApiKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LoanMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedborrower {
-		edges = append(edges, loan.EdgeBorrower)
-	}
-	if m.clearedshared_loan {
-		edges = append(edges, loan.EdgeSharedLoan)
-	}
+func (m *ApiKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LoanMutation) EdgeCleared(name string) bool {
-	switch name {
-	case loan.EdgeBorrower:
-		return m.clearedborrower
-	case loan.EdgeSharedLoan:
-		return m.clearedshared_loan
-	}
+func (m *ApiKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LoanMutation) ClearEdge(name string) error {
-	switch name {
-	case loan.EdgeBorrower:
-		m.ClearBorrower()
-		return nil
-	}
-	return fmt.Errorf("unknown Loan unique edge %s", name)
+func (m *ApiKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ApiKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LoanMutation) ResetEdge(name string) error {
-	switch name {
-	case loan.EdgeBorrower:
-		m.ResetBorrower()
-		return nil
-	case loan.EdgeSharedLoan:
-		m.ResetSharedLoan()
-		return nil
-	}
-	return fmt.Errorf("unknown Loan edge %s", name)
+func (m *ApiKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ApiKey edge %s", name)
 }
 
-// SharedLoanMutation represents an operation that mutates the SharedLoan nodes in the graph.
-type SharedLoanMutation struct {
+// CollateralMutation represents an operation that mutates the Collateral nodes in the graph.
+type CollateralMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	loan          *int
-	clearedloan   bool
-	done          bool
-	oldValue      func(context.Context) (*SharedLoan, error)
-	predicates    []predicate.SharedLoan
+	op                Op
+	typ               string
+	id                *int
+	kind              *collateral.Kind
+	description       *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	appraisals        map[int]struct{}
+	removedappraisals map[int]struct{}
+	clearedappraisals bool
+	loans             map[int]struct{}
+	removedloans      map[int]struct{}
+	clearedloans      bool
+	done              bool
+	oldValue          func(context.Context) (*Collateral, error)
+	predicates        []predicate.Collateral
 }
 
-var _ ent.Mutation = (*SharedLoanMutation)(nil)
+var _ ent.Mutation = (*CollateralMutation)(nil)
 
-// sharedloanOption allows management of the mutation configuration using functional options.
-type sharedloanOption func(*SharedLoanMutation)
+// collateralOption allows management of the mutation configuration using functional options.
+type collateralOption func(*CollateralMutation)
 
-// newSharedLoanMutation creates new mutation for the SharedLoan entity.
-func newSharedLoanMutation(c config, op Op, opts ...sharedloanOption) *SharedLoanMutation {
-	m := &SharedLoanMutation{
+// newCollateralMutation creates new mutation for the Collateral entity.
+func newCollateralMutation(c config, op Op, opts ...collateralOption) *CollateralMutation {
+	m := &CollateralMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSharedLoan,
+		typ:           TypeCollateral,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -794,20 +695,20 @@ func newSharedLoanMutation(c config, op Op, opts ...sharedloanOption) *SharedLoa
 	return m
 }
 
-// withSharedLoanID sets the ID field of the mutation.
-func withSharedLoanID(id int) sharedloanOption {
-	return func(m *SharedLoanMutation) {
+// withCollateralID sets the ID field of the mutation.
+func withCollateralID(id int) collateralOption {
+	return func(m *CollateralMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SharedLoan
+			value *Collateral
 		)
-		m.oldValue = func(ctx context.Context) (*SharedLoan, error) {
+		m.oldValue = func(ctx context.Context) (*Collateral, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SharedLoan.Get(ctx, id)
+					value, err = m.Client().Collateral.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -816,10 +717,10 @@ func withSharedLoanID(id int) sharedloanOption {
 	}
 }
 
-// withSharedLoan sets the old SharedLoan of the mutation.
-func withSharedLoan(node *SharedLoan) sharedloanOption {
-	return func(m *SharedLoanMutation) {
-		m.oldValue = func(context.Context) (*SharedLoan, error) {
+// withCollateral sets the old Collateral of the mutation.
+func withCollateral(node *Collateral) collateralOption {
+	return func(m *CollateralMutation) {
+		m.oldValue = func(context.Context) (*Collateral, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -828,7 +729,7 @@ func withSharedLoan(node *SharedLoan) sharedloanOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SharedLoanMutation) Client() *Client {
+func (m CollateralMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -836,7 +737,7 @@ func (m SharedLoanMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SharedLoanMutation) Tx() (*Tx, error) {
+func (m CollateralMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -847,7 +748,7 @@ func (m SharedLoanMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SharedLoanMutation) ID() (id int, exists bool) {
+func (m *CollateralMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -858,7 +759,7 @@ func (m *SharedLoanMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SharedLoanMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CollateralMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -867,147 +768,250 @@ func (m *SharedLoanMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SharedLoan.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Collateral.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *SharedLoanMutation) SetUserID(i int) {
-	m.user = &i
+// SetKind sets the "kind" field.
+func (m *CollateralMutation) SetKind(c collateral.Kind) {
+	m.kind = &c
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *SharedLoanMutation) UserID() (r int, exists bool) {
-	v := m.user
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CollateralMutation) Kind() (r collateral.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the SharedLoan entity.
-// If the SharedLoan object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Collateral entity.
+// If the Collateral object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLoanMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *CollateralMutation) OldKind(ctx context.Context) (v collateral.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Kind, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *SharedLoanMutation) ResetUserID() {
-	m.user = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *CollateralMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetLoanID sets the "loan_id" field.
-func (m *SharedLoanMutation) SetLoanID(i int) {
-	m.loan = &i
+// SetDescription sets the "description" field.
+func (m *CollateralMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// LoanID returns the value of the "loan_id" field in the mutation.
-func (m *SharedLoanMutation) LoanID() (r int, exists bool) {
-	v := m.loan
+// Description returns the value of the "description" field in the mutation.
+func (m *CollateralMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLoanID returns the old "loan_id" field's value of the SharedLoan entity.
-// If the SharedLoan object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Collateral entity.
+// If the Collateral object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SharedLoanMutation) OldLoanID(ctx context.Context) (v int, err error) {
+func (m *CollateralMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLoanID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.LoanID, nil
+	return oldValue.Description, nil
 }
 
-// ResetLoanID resets all changes to the "loan_id" field.
-func (m *SharedLoanMutation) ResetLoanID() {
-	m.loan = nil
+// ClearDescription clears the value of the "description" field.
+func (m *CollateralMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[collateral.FieldDescription] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SharedLoanMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[sharedloan.FieldUserID] = struct{}{}
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CollateralMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[collateral.FieldDescription]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SharedLoanMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetDescription resets all changes to the "description" field.
+func (m *CollateralMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, collateral.FieldDescription)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SharedLoanMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetCreatedAt sets the "created_at" field.
+func (m *CollateralMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CollateralMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Collateral entity.
+// If the Collateral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollateralMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CollateralMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAppraisalIDs adds the "appraisals" edge to the CollateralAppraisal entity by ids.
+func (m *CollateralMutation) AddAppraisalIDs(ids ...int) {
+	if m.appraisals == nil {
+		m.appraisals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.appraisals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAppraisals clears the "appraisals" edge to the CollateralAppraisal entity.
+func (m *CollateralMutation) ClearAppraisals() {
+	m.clearedappraisals = true
+}
+
+// AppraisalsCleared reports if the "appraisals" edge to the CollateralAppraisal entity was cleared.
+func (m *CollateralMutation) AppraisalsCleared() bool {
+	return m.clearedappraisals
+}
+
+// RemoveAppraisalIDs removes the "appraisals" edge to the CollateralAppraisal entity by IDs.
+func (m *CollateralMutation) RemoveAppraisalIDs(ids ...int) {
+	if m.removedappraisals == nil {
+		m.removedappraisals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.appraisals, ids[i])
+		m.removedappraisals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAppraisals returns the removed IDs of the "appraisals" edge to the CollateralAppraisal entity.
+func (m *CollateralMutation) RemovedAppraisalsIDs() (ids []int) {
+	for id := range m.removedappraisals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SharedLoanMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// AppraisalsIDs returns the "appraisals" edge IDs in the mutation.
+func (m *CollateralMutation) AppraisalsIDs() (ids []int) {
+	for id := range m.appraisals {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearLoan clears the "loan" edge to the Loan entity.
-func (m *SharedLoanMutation) ClearLoan() {
-	m.clearedloan = true
-	m.clearedFields[sharedloan.FieldLoanID] = struct{}{}
+// ResetAppraisals resets all changes to the "appraisals" edge.
+func (m *CollateralMutation) ResetAppraisals() {
+	m.appraisals = nil
+	m.clearedappraisals = false
+	m.removedappraisals = nil
 }
 
-// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
-func (m *SharedLoanMutation) LoanCleared() bool {
-	return m.clearedloan
+// AddLoanIDs adds the "loans" edge to the Loan entity by ids.
+func (m *CollateralMutation) AddLoanIDs(ids ...int) {
+	if m.loans == nil {
+		m.loans = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.loans[ids[i]] = struct{}{}
+	}
 }
 
-// LoanIDs returns the "loan" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LoanID instead. It exists only for internal usage by the builders.
-func (m *SharedLoanMutation) LoanIDs() (ids []int) {
-	if id := m.loan; id != nil {
-		ids = append(ids, *id)
+// ClearLoans clears the "loans" edge to the Loan entity.
+func (m *CollateralMutation) ClearLoans() {
+	m.clearedloans = true
+}
+
+// LoansCleared reports if the "loans" edge to the Loan entity was cleared.
+func (m *CollateralMutation) LoansCleared() bool {
+	return m.clearedloans
+}
+
+// RemoveLoanIDs removes the "loans" edge to the Loan entity by IDs.
+func (m *CollateralMutation) RemoveLoanIDs(ids ...int) {
+	if m.removedloans == nil {
+		m.removedloans = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.loans, ids[i])
+		m.removedloans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLoans returns the removed IDs of the "loans" edge to the Loan entity.
+func (m *CollateralMutation) RemovedLoansIDs() (ids []int) {
+	for id := range m.removedloans {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLoan resets all changes to the "loan" edge.
-func (m *SharedLoanMutation) ResetLoan() {
-	m.loan = nil
-	m.clearedloan = false
+// LoansIDs returns the "loans" edge IDs in the mutation.
+func (m *CollateralMutation) LoansIDs() (ids []int) {
+	for id := range m.loans {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the SharedLoanMutation builder.
-func (m *SharedLoanMutation) Where(ps ...predicate.SharedLoan) {
+// ResetLoans resets all changes to the "loans" edge.
+func (m *CollateralMutation) ResetLoans() {
+	m.loans = nil
+	m.clearedloans = false
+	m.removedloans = nil
+}
+
+// Where appends a list predicates to the CollateralMutation builder.
+func (m *CollateralMutation) Where(ps ...predicate.Collateral) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SharedLoanMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CollateralMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SharedLoanMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SharedLoan, len(ps))
+func (m *CollateralMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Collateral, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1015,30 +1019,33 @@ func (m *SharedLoanMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SharedLoanMutation) Op() Op {
+func (m *CollateralMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SharedLoanMutation) SetOp(op Op) {
+func (m *CollateralMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SharedLoan).
-func (m *SharedLoanMutation) Type() string {
+// Type returns the node type of this mutation (Collateral).
+func (m *CollateralMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SharedLoanMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.user != nil {
-		fields = append(fields, sharedloan.FieldUserID)
+func (m *CollateralMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.kind != nil {
+		fields = append(fields, collateral.FieldKind)
 	}
-	if m.loan != nil {
-		fields = append(fields, sharedloan.FieldLoanID)
+	if m.description != nil {
+		fields = append(fields, collateral.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, collateral.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1046,12 +1053,14 @@ func (m *SharedLoanMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SharedLoanMutation) Field(name string) (ent.Value, bool) {
+func (m *CollateralMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sharedloan.FieldUserID:
-		return m.UserID()
-	case sharedloan.FieldLoanID:
-		return m.LoanID()
+	case collateral.FieldKind:
+		return m.Kind()
+	case collateral.FieldDescription:
+		return m.Description()
+	case collateral.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1059,221 +1068,254 @@ func (m *SharedLoanMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SharedLoanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CollateralMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sharedloan.FieldUserID:
-		return m.OldUserID(ctx)
-	case sharedloan.FieldLoanID:
-		return m.OldLoanID(ctx)
+	case collateral.FieldKind:
+		return m.OldKind(ctx)
+	case collateral.FieldDescription:
+		return m.OldDescription(ctx)
+	case collateral.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SharedLoan field %s", name)
+	return nil, fmt.Errorf("unknown Collateral field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharedLoanMutation) SetField(name string, value ent.Value) error {
+func (m *CollateralMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sharedloan.FieldUserID:
-		v, ok := value.(int)
+	case collateral.FieldKind:
+		v, ok := value.(collateral.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetKind(v)
 		return nil
-	case sharedloan.FieldLoanID:
-		v, ok := value.(int)
+	case collateral.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLoanID(v)
+		m.SetDescription(v)
+		return nil
+	case collateral.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLoan field %s", name)
+	return fmt.Errorf("unknown Collateral field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SharedLoanMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *CollateralMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SharedLoanMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *CollateralMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SharedLoanMutation) AddField(name string, value ent.Value) error {
+func (m *CollateralMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown SharedLoan numeric field %s", name)
+	return fmt.Errorf("unknown Collateral numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SharedLoanMutation) ClearedFields() []string {
-	return nil
+func (m *CollateralMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(collateral.FieldDescription) {
+		fields = append(fields, collateral.FieldDescription)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SharedLoanMutation) FieldCleared(name string) bool {
+func (m *CollateralMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SharedLoanMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SharedLoan nullable field %s", name)
+func (m *CollateralMutation) ClearField(name string) error {
+	switch name {
+	case collateral.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Collateral nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SharedLoanMutation) ResetField(name string) error {
+func (m *CollateralMutation) ResetField(name string) error {
 	switch name {
-	case sharedloan.FieldUserID:
-		m.ResetUserID()
+	case collateral.FieldKind:
+		m.ResetKind()
 		return nil
-	case sharedloan.FieldLoanID:
-		m.ResetLoanID()
+	case collateral.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case collateral.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLoan field %s", name)
+	return fmt.Errorf("unknown Collateral field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SharedLoanMutation) AddedEdges() []string {
+func (m *CollateralMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, sharedloan.EdgeUser)
+	if m.appraisals != nil {
+		edges = append(edges, collateral.EdgeAppraisals)
 	}
-	if m.loan != nil {
-		edges = append(edges, sharedloan.EdgeLoan)
+	if m.loans != nil {
+		edges = append(edges, collateral.EdgeLoans)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SharedLoanMutation) AddedIDs(name string) []ent.Value {
+func (m *CollateralMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case sharedloan.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case collateral.EdgeAppraisals:
+		ids := make([]ent.Value, 0, len(m.appraisals))
+		for id := range m.appraisals {
+			ids = append(ids, id)
 		}
-	case sharedloan.EdgeLoan:
-		if id := m.loan; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case collateral.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.loans))
+		for id := range m.loans {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SharedLoanMutation) RemovedEdges() []string {
+func (m *CollateralMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedappraisals != nil {
+		edges = append(edges, collateral.EdgeAppraisals)
+	}
+	if m.removedloans != nil {
+		edges = append(edges, collateral.EdgeLoans)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SharedLoanMutation) RemovedIDs(name string) []ent.Value {
+func (m *CollateralMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case collateral.EdgeAppraisals:
+		ids := make([]ent.Value, 0, len(m.removedappraisals))
+		for id := range m.removedappraisals {
+			ids = append(ids, id)
+		}
+		return ids
+	case collateral.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.removedloans))
+		for id := range m.removedloans {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SharedLoanMutation) ClearedEdges() []string {
+func (m *CollateralMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, sharedloan.EdgeUser)
+	if m.clearedappraisals {
+		edges = append(edges, collateral.EdgeAppraisals)
 	}
-	if m.clearedloan {
-		edges = append(edges, sharedloan.EdgeLoan)
+	if m.clearedloans {
+		edges = append(edges, collateral.EdgeLoans)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SharedLoanMutation) EdgeCleared(name string) bool {
+func (m *CollateralMutation) EdgeCleared(name string) bool {
 	switch name {
-	case sharedloan.EdgeUser:
-		return m.cleareduser
-	case sharedloan.EdgeLoan:
-		return m.clearedloan
+	case collateral.EdgeAppraisals:
+		return m.clearedappraisals
+	case collateral.EdgeLoans:
+		return m.clearedloans
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SharedLoanMutation) ClearEdge(name string) error {
+func (m *CollateralMutation) ClearEdge(name string) error {
 	switch name {
-	case sharedloan.EdgeUser:
-		m.ClearUser()
-		return nil
-	case sharedloan.EdgeLoan:
-		m.ClearLoan()
-		return nil
 	}
-	return fmt.Errorf("unknown SharedLoan unique edge %s", name)
+	return fmt.Errorf("unknown Collateral unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SharedLoanMutation) ResetEdge(name string) error {
+func (m *CollateralMutation) ResetEdge(name string) error {
 	switch name {
-	case sharedloan.EdgeUser:
-		m.ResetUser()
+	case collateral.EdgeAppraisals:
+		m.ResetAppraisals()
 		return nil
-	case sharedloan.EdgeLoan:
-		m.ResetLoan()
+	case collateral.EdgeLoans:
+		m.ResetLoans()
 		return nil
 	}
-	return fmt.Errorf("unknown SharedLoan edge %s", name)
+	return fmt.Errorf("unknown Collateral edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// CollateralAppraisalMutation represents an operation that mutates the CollateralAppraisal nodes in the graph.
+type CollateralAppraisalMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	social             *string
-	address            *string
-	clearedFields      map[string]struct{}
-	loans              map[int]struct{}
-	removedloans       map[int]struct{}
-	clearedloans       bool
-	shared_loan        map[int]struct{}
-	removedshared_loan map[int]struct{}
-	clearedshared_loan bool
-	done               bool
-	oldValue           func(context.Context) (*User, error)
-	predicates         []predicate.User
+	op                Op
+	typ               string
+	id                *int
+	value             *int
+	addvalue          *int
+	appraised_at      *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	collateral        *int
+	clearedcollateral bool
+	done              bool
+	oldValue          func(context.Context) (*CollateralAppraisal, error)
+	predicates        []predicate.CollateralAppraisal
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*CollateralAppraisalMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// collateralappraisalOption allows management of the mutation configuration using functional options.
+type collateralappraisalOption func(*CollateralAppraisalMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newCollateralAppraisalMutation creates new mutation for the CollateralAppraisal entity.
+func newCollateralAppraisalMutation(c config, op Op, opts ...collateralappraisalOption) *CollateralAppraisalMutation {
+	m := &CollateralAppraisalMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeCollateralAppraisal,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1282,20 +1324,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withCollateralAppraisalID sets the ID field of the mutation.
+func withCollateralAppraisalID(id int) collateralappraisalOption {
+	return func(m *CollateralAppraisalMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *CollateralAppraisal
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*CollateralAppraisal, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().CollateralAppraisal.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1304,10 +1346,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withCollateralAppraisal sets the old CollateralAppraisal of the mutation.
+func withCollateralAppraisal(node *CollateralAppraisal) collateralappraisalOption {
+	return func(m *CollateralAppraisalMutation) {
+		m.oldValue = func(context.Context) (*CollateralAppraisal, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1316,7 +1358,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m CollateralAppraisalMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1324,7 +1366,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m CollateralAppraisalMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1335,7 +1377,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *CollateralAppraisalMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1346,7 +1388,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CollateralAppraisalMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1355,250 +1397,212 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CollateralAppraisal.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetCollateralID sets the "collateral_id" field.
+func (m *CollateralAppraisalMutation) SetCollateralID(i int) {
+	m.collateral = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// CollateralID returns the value of the "collateral_id" field in the mutation.
+func (m *CollateralAppraisalMutation) CollateralID() (r int, exists bool) {
+	v := m.collateral
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCollateralID returns the old "collateral_id" field's value of the CollateralAppraisal entity.
+// If the CollateralAppraisal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CollateralAppraisalMutation) OldCollateralID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCollateralID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCollateralID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCollateralID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CollateralID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetCollateralID resets all changes to the "collateral_id" field.
+func (m *CollateralAppraisalMutation) ResetCollateralID() {
+	m.collateral = nil
 }
 
-// SetSocial sets the "social" field.
-func (m *UserMutation) SetSocial(s string) {
-	m.social = &s
+// SetValue sets the "value" field.
+func (m *CollateralAppraisalMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
 }
 
-// Social returns the value of the "social" field in the mutation.
-func (m *UserMutation) Social() (r string, exists bool) {
-	v := m.social
+// Value returns the value of the "value" field in the mutation.
+func (m *CollateralAppraisalMutation) Value() (r int, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSocial returns the old "social" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the CollateralAppraisal entity.
+// If the CollateralAppraisal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSocial(ctx context.Context) (v string, err error) {
+func (m *CollateralAppraisalMutation) OldValue(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSocial is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSocial requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSocial: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Social, nil
+	return oldValue.Value, nil
 }
 
-// ResetSocial resets all changes to the "social" field.
-func (m *UserMutation) ResetSocial() {
-	m.social = nil
+// AddValue adds i to the "value" field.
+func (m *CollateralAppraisalMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
 }
 
-// SetAddress sets the "address" field.
-func (m *UserMutation) SetAddress(s string) {
-	m.address = &s
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *CollateralAppraisalMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *UserMutation) Address() (r string, exists bool) {
-	v := m.address
+// ResetValue resets all changes to the "value" field.
+func (m *CollateralAppraisalMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetAppraisedAt sets the "appraised_at" field.
+func (m *CollateralAppraisalMutation) SetAppraisedAt(t time.Time) {
+	m.appraised_at = &t
+}
+
+// AppraisedAt returns the value of the "appraised_at" field in the mutation.
+func (m *CollateralAppraisalMutation) AppraisedAt() (r time.Time, exists bool) {
+	v := m.appraised_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAddress returns the old "address" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAppraisedAt returns the old "appraised_at" field's value of the CollateralAppraisal entity.
+// If the CollateralAppraisal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAddress(ctx context.Context) (v string, err error) {
+func (m *CollateralAppraisalMutation) OldAppraisedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppraisedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
+		return v, errors.New("OldAppraisedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
-	}
-	return oldValue.Address, nil
-}
-
-// ClearAddress clears the value of the "address" field.
-func (m *UserMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[user.FieldAddress] = struct{}{}
-}
-
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *UserMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[user.FieldAddress]
-	return ok
-}
-
-// ResetAddress resets all changes to the "address" field.
-func (m *UserMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, user.FieldAddress)
-}
-
-// AddLoanIDs adds the "loans" edge to the Loan entity by ids.
-func (m *UserMutation) AddLoanIDs(ids ...int) {
-	if m.loans == nil {
-		m.loans = make(map[int]struct{})
+		return v, fmt.Errorf("querying old value for OldAppraisedAt: %w", err)
 	}
-	for i := range ids {
-		m.loans[ids[i]] = struct{}{}
-	}
-}
-
-// ClearLoans clears the "loans" edge to the Loan entity.
-func (m *UserMutation) ClearLoans() {
-	m.clearedloans = true
+	return oldValue.AppraisedAt, nil
 }
 
-// LoansCleared reports if the "loans" edge to the Loan entity was cleared.
-func (m *UserMutation) LoansCleared() bool {
-	return m.clearedloans
+// ResetAppraisedAt resets all changes to the "appraised_at" field.
+func (m *CollateralAppraisalMutation) ResetAppraisedAt() {
+	m.appraised_at = nil
 }
 
-// RemoveLoanIDs removes the "loans" edge to the Loan entity by IDs.
-func (m *UserMutation) RemoveLoanIDs(ids ...int) {
-	if m.removedloans == nil {
-		m.removedloans = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.loans, ids[i])
-		m.removedloans[ids[i]] = struct{}{}
-	}
+// SetCreatedAt sets the "created_at" field.
+func (m *CollateralAppraisalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedLoans returns the removed IDs of the "loans" edge to the Loan entity.
-func (m *UserMutation) RemovedLoansIDs() (ids []int) {
-	for id := range m.removedloans {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CollateralAppraisalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// LoansIDs returns the "loans" edge IDs in the mutation.
-func (m *UserMutation) LoansIDs() (ids []int) {
-	for id := range m.loans {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the CollateralAppraisal entity.
+// If the CollateralAppraisal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollateralAppraisalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetLoans resets all changes to the "loans" edge.
-func (m *UserMutation) ResetLoans() {
-	m.loans = nil
-	m.clearedloans = false
-	m.removedloans = nil
-}
-
-// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by ids.
-func (m *UserMutation) AddSharedLoanIDs(ids ...int) {
-	if m.shared_loan == nil {
-		m.shared_loan = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.shared_loan[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearSharedLoan clears the "shared_loan" edge to the SharedLoan entity.
-func (m *UserMutation) ClearSharedLoan() {
-	m.clearedshared_loan = true
-}
-
-// SharedLoanCleared reports if the "shared_loan" edge to the SharedLoan entity was cleared.
-func (m *UserMutation) SharedLoanCleared() bool {
-	return m.clearedshared_loan
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CollateralAppraisalMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemoveSharedLoanIDs removes the "shared_loan" edge to the SharedLoan entity by IDs.
-func (m *UserMutation) RemoveSharedLoanIDs(ids ...int) {
-	if m.removedshared_loan == nil {
-		m.removedshared_loan = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.shared_loan, ids[i])
-		m.removedshared_loan[ids[i]] = struct{}{}
-	}
+// ClearCollateral clears the "collateral" edge to the Collateral entity.
+func (m *CollateralAppraisalMutation) ClearCollateral() {
+	m.clearedcollateral = true
+	m.clearedFields[collateralappraisal.FieldCollateralID] = struct{}{}
 }
 
-// RemovedSharedLoan returns the removed IDs of the "shared_loan" edge to the SharedLoan entity.
-func (m *UserMutation) RemovedSharedLoanIDs() (ids []int) {
-	for id := range m.removedshared_loan {
-		ids = append(ids, id)
-	}
-	return
+// CollateralCleared reports if the "collateral" edge to the Collateral entity was cleared.
+func (m *CollateralAppraisalMutation) CollateralCleared() bool {
+	return m.clearedcollateral
 }
 
-// SharedLoanIDs returns the "shared_loan" edge IDs in the mutation.
-func (m *UserMutation) SharedLoanIDs() (ids []int) {
-	for id := range m.shared_loan {
-		ids = append(ids, id)
+// CollateralIDs returns the "collateral" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CollateralID instead. It exists only for internal usage by the builders.
+func (m *CollateralAppraisalMutation) CollateralIDs() (ids []int) {
+	if id := m.collateral; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSharedLoan resets all changes to the "shared_loan" edge.
-func (m *UserMutation) ResetSharedLoan() {
-	m.shared_loan = nil
-	m.clearedshared_loan = false
-	m.removedshared_loan = nil
+// ResetCollateral resets all changes to the "collateral" edge.
+func (m *CollateralAppraisalMutation) ResetCollateral() {
+	m.collateral = nil
+	m.clearedcollateral = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the CollateralAppraisalMutation builder.
+func (m *CollateralAppraisalMutation) Where(ps ...predicate.CollateralAppraisal) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CollateralAppraisalMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *CollateralAppraisalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CollateralAppraisal, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1606,33 +1610,36 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *CollateralAppraisalMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *CollateralAppraisalMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (CollateralAppraisal).
+func (m *CollateralAppraisalMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+func (m *CollateralAppraisalMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.collateral != nil {
+		fields = append(fields, collateralappraisal.FieldCollateralID)
 	}
-	if m.social != nil {
-		fields = append(fields, user.FieldSocial)
+	if m.value != nil {
+		fields = append(fields, collateralappraisal.FieldValue)
 	}
-	if m.address != nil {
-		fields = append(fields, user.FieldAddress)
+	if m.appraised_at != nil {
+		fields = append(fields, collateralappraisal.FieldAppraisedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, collateralappraisal.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1640,14 +1647,16 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *CollateralAppraisalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldName:
-		return m.Name()
-	case user.FieldSocial:
-		return m.Social()
-	case user.FieldAddress:
-		return m.Address()
+	case collateralappraisal.FieldCollateralID:
+		return m.CollateralID()
+	case collateralappraisal.FieldValue:
+		return m.Value()
+	case collateralappraisal.FieldAppraisedAt:
+		return m.AppraisedAt()
+	case collateralappraisal.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1655,221 +1664,17345 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CollateralAppraisalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldSocial:
-		return m.OldSocial(ctx)
-	case user.FieldAddress:
-		return m.OldAddress(ctx)
+	case collateralappraisal.FieldCollateralID:
+		return m.OldCollateralID(ctx)
+	case collateralappraisal.FieldValue:
+		return m.OldValue(ctx)
+	case collateralappraisal.FieldAppraisedAt:
+		return m.OldAppraisedAt(ctx)
+	case collateralappraisal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown CollateralAppraisal field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *CollateralAppraisalMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldName:
-		v, ok := value.(string)
+	case collateralappraisal.FieldCollateralID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCollateralID(v)
 		return nil
-	case user.FieldSocial:
-		v, ok := value.(string)
+	case collateralappraisal.FieldValue:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSocial(v)
+		m.SetValue(v)
 		return nil
-	case user.FieldAddress:
-		v, ok := value.(string)
+	case collateralappraisal.FieldAppraisedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddress(v)
+		m.SetAppraisedAt(v)
+		return nil
+	case collateralappraisal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown CollateralAppraisal field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *CollateralAppraisalMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, collateralappraisal.FieldValue)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CollateralAppraisalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case collateralappraisal.FieldValue:
+		return m.AddedValue()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *CollateralAppraisalMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case collateralappraisal.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown CollateralAppraisal numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldAddress) {
-		fields = append(fields, user.FieldAddress)
-	}
-	return fields
+func (m *CollateralAppraisalMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *CollateralAppraisalMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldAddress:
-		m.ClearAddress()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *CollateralAppraisalMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CollateralAppraisal nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *CollateralAppraisalMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldName:
-		m.ResetName()
+	case collateralappraisal.FieldCollateralID:
+		m.ResetCollateralID()
 		return nil
-	case user.FieldSocial:
+	case collateralappraisal.FieldValue:
+		m.ResetValue()
+		return nil
+	case collateralappraisal.FieldAppraisedAt:
+		m.ResetAppraisedAt()
+		return nil
+	case collateralappraisal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CollateralAppraisal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollateralAppraisalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.collateral != nil {
+		edges = append(edges, collateralappraisal.EdgeCollateral)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollateralAppraisalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case collateralappraisal.EdgeCollateral:
+		if id := m.collateral; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollateralAppraisalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollateralAppraisalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollateralAppraisalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcollateral {
+		edges = append(edges, collateralappraisal.EdgeCollateral)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollateralAppraisalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case collateralappraisal.EdgeCollateral:
+		return m.clearedcollateral
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollateralAppraisalMutation) ClearEdge(name string) error {
+	switch name {
+	case collateralappraisal.EdgeCollateral:
+		m.ClearCollateral()
+		return nil
+	}
+	return fmt.Errorf("unknown CollateralAppraisal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollateralAppraisalMutation) ResetEdge(name string) error {
+	switch name {
+	case collateralappraisal.EdgeCollateral:
+		m.ResetCollateral()
+		return nil
+	}
+	return fmt.Errorf("unknown CollateralAppraisal edge %s", name)
+}
+
+// CreditLineMutation represents an operation that mutates the CreditLine nodes in the graph.
+type CreditLineMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	credit_limit        *int
+	addcredit_limit     *int
+	rate                *float64
+	addrate             *float64
+	draw_months         *int
+	adddraw_months      *int
+	repayment_months    *int
+	addrepayment_months *int
+	opened_at           *time.Time
+	clearedFields       map[string]struct{}
+	borrower            *int
+	clearedborrower     bool
+	transactions        map[int]struct{}
+	removedtransactions map[int]struct{}
+	clearedtransactions bool
+	done                bool
+	oldValue            func(context.Context) (*CreditLine, error)
+	predicates          []predicate.CreditLine
+}
+
+var _ ent.Mutation = (*CreditLineMutation)(nil)
+
+// creditlineOption allows management of the mutation configuration using functional options.
+type creditlineOption func(*CreditLineMutation)
+
+// newCreditLineMutation creates new mutation for the CreditLine entity.
+func newCreditLineMutation(c config, op Op, opts ...creditlineOption) *CreditLineMutation {
+	m := &CreditLineMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCreditLine,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCreditLineID sets the ID field of the mutation.
+func withCreditLineID(id int) creditlineOption {
+	return func(m *CreditLineMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CreditLine
+		)
+		m.oldValue = func(ctx context.Context) (*CreditLine, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CreditLine.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCreditLine sets the old CreditLine of the mutation.
+func withCreditLine(node *CreditLine) creditlineOption {
+	return func(m *CreditLineMutation) {
+		m.oldValue = func(context.Context) (*CreditLine, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CreditLineMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CreditLineMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CreditLineMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CreditLineMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CreditLine.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (m *CreditLineMutation) SetCreditLimit(i int) {
+	m.credit_limit = &i
+	m.addcredit_limit = nil
+}
+
+// CreditLimit returns the value of the "credit_limit" field in the mutation.
+func (m *CreditLineMutation) CreditLimit() (r int, exists bool) {
+	v := m.credit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditLimit returns the old "credit_limit" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldCreditLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditLimit: %w", err)
+	}
+	return oldValue.CreditLimit, nil
+}
+
+// AddCreditLimit adds i to the "credit_limit" field.
+func (m *CreditLineMutation) AddCreditLimit(i int) {
+	if m.addcredit_limit != nil {
+		*m.addcredit_limit += i
+	} else {
+		m.addcredit_limit = &i
+	}
+}
+
+// AddedCreditLimit returns the value that was added to the "credit_limit" field in this mutation.
+func (m *CreditLineMutation) AddedCreditLimit() (r int, exists bool) {
+	v := m.addcredit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreditLimit resets all changes to the "credit_limit" field.
+func (m *CreditLineMutation) ResetCreditLimit() {
+	m.credit_limit = nil
+	m.addcredit_limit = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *CreditLineMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *CreditLineMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *CreditLineMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *CreditLineMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *CreditLineMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetDrawMonths sets the "draw_months" field.
+func (m *CreditLineMutation) SetDrawMonths(i int) {
+	m.draw_months = &i
+	m.adddraw_months = nil
+}
+
+// DrawMonths returns the value of the "draw_months" field in the mutation.
+func (m *CreditLineMutation) DrawMonths() (r int, exists bool) {
+	v := m.draw_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrawMonths returns the old "draw_months" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldDrawMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrawMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrawMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrawMonths: %w", err)
+	}
+	return oldValue.DrawMonths, nil
+}
+
+// AddDrawMonths adds i to the "draw_months" field.
+func (m *CreditLineMutation) AddDrawMonths(i int) {
+	if m.adddraw_months != nil {
+		*m.adddraw_months += i
+	} else {
+		m.adddraw_months = &i
+	}
+}
+
+// AddedDrawMonths returns the value that was added to the "draw_months" field in this mutation.
+func (m *CreditLineMutation) AddedDrawMonths() (r int, exists bool) {
+	v := m.adddraw_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDrawMonths resets all changes to the "draw_months" field.
+func (m *CreditLineMutation) ResetDrawMonths() {
+	m.draw_months = nil
+	m.adddraw_months = nil
+}
+
+// SetRepaymentMonths sets the "repayment_months" field.
+func (m *CreditLineMutation) SetRepaymentMonths(i int) {
+	m.repayment_months = &i
+	m.addrepayment_months = nil
+}
+
+// RepaymentMonths returns the value of the "repayment_months" field in the mutation.
+func (m *CreditLineMutation) RepaymentMonths() (r int, exists bool) {
+	v := m.repayment_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepaymentMonths returns the old "repayment_months" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldRepaymentMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepaymentMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepaymentMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepaymentMonths: %w", err)
+	}
+	return oldValue.RepaymentMonths, nil
+}
+
+// AddRepaymentMonths adds i to the "repayment_months" field.
+func (m *CreditLineMutation) AddRepaymentMonths(i int) {
+	if m.addrepayment_months != nil {
+		*m.addrepayment_months += i
+	} else {
+		m.addrepayment_months = &i
+	}
+}
+
+// AddedRepaymentMonths returns the value that was added to the "repayment_months" field in this mutation.
+func (m *CreditLineMutation) AddedRepaymentMonths() (r int, exists bool) {
+	v := m.addrepayment_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRepaymentMonths resets all changes to the "repayment_months" field.
+func (m *CreditLineMutation) ResetRepaymentMonths() {
+	m.repayment_months = nil
+	m.addrepayment_months = nil
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (m *CreditLineMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *CreditLineMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenedAt returns the old "opened_at" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldOpenedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
+	}
+	return oldValue.OpenedAt, nil
+}
+
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *CreditLineMutation) ResetOpenedAt() {
+	m.opened_at = nil
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (m *CreditLineMutation) SetBorrowerID(i int) {
+	m.borrower = &i
+}
+
+// BorrowerID returns the value of the "borrower_id" field in the mutation.
+func (m *CreditLineMutation) BorrowerID() (r int, exists bool) {
+	v := m.borrower
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorrowerID returns the old "borrower_id" field's value of the CreditLine entity.
+// If the CreditLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineMutation) OldBorrowerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorrowerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorrowerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorrowerID: %w", err)
+	}
+	return oldValue.BorrowerID, nil
+}
+
+// ResetBorrowerID resets all changes to the "borrower_id" field.
+func (m *CreditLineMutation) ResetBorrowerID() {
+	m.borrower = nil
+}
+
+// ClearBorrower clears the "borrower" edge to the User entity.
+func (m *CreditLineMutation) ClearBorrower() {
+	m.clearedborrower = true
+	m.clearedFields[creditline.FieldBorrowerID] = struct{}{}
+}
+
+// BorrowerCleared reports if the "borrower" edge to the User entity was cleared.
+func (m *CreditLineMutation) BorrowerCleared() bool {
+	return m.clearedborrower
+}
+
+// BorrowerIDs returns the "borrower" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BorrowerID instead. It exists only for internal usage by the builders.
+func (m *CreditLineMutation) BorrowerIDs() (ids []int) {
+	if id := m.borrower; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBorrower resets all changes to the "borrower" edge.
+func (m *CreditLineMutation) ResetBorrower() {
+	m.borrower = nil
+	m.clearedborrower = false
+}
+
+// AddTransactionIDs adds the "transactions" edge to the CreditLineTransaction entity by ids.
+func (m *CreditLineMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the CreditLineTransaction entity.
+func (m *CreditLineMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the CreditLineTransaction entity was cleared.
+func (m *CreditLineMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the CreditLineTransaction entity by IDs.
+func (m *CreditLineMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the CreditLineTransaction entity.
+func (m *CreditLineMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *CreditLineMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *CreditLineMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// Where appends a list predicates to the CreditLineMutation builder.
+func (m *CreditLineMutation) Where(ps ...predicate.CreditLine) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CreditLineMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditLineMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditLine, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CreditLineMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CreditLineMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CreditLine).
+func (m *CreditLineMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CreditLineMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.credit_limit != nil {
+		fields = append(fields, creditline.FieldCreditLimit)
+	}
+	if m.rate != nil {
+		fields = append(fields, creditline.FieldRate)
+	}
+	if m.draw_months != nil {
+		fields = append(fields, creditline.FieldDrawMonths)
+	}
+	if m.repayment_months != nil {
+		fields = append(fields, creditline.FieldRepaymentMonths)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, creditline.FieldOpenedAt)
+	}
+	if m.borrower != nil {
+		fields = append(fields, creditline.FieldBorrowerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CreditLineMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case creditline.FieldCreditLimit:
+		return m.CreditLimit()
+	case creditline.FieldRate:
+		return m.Rate()
+	case creditline.FieldDrawMonths:
+		return m.DrawMonths()
+	case creditline.FieldRepaymentMonths:
+		return m.RepaymentMonths()
+	case creditline.FieldOpenedAt:
+		return m.OpenedAt()
+	case creditline.FieldBorrowerID:
+		return m.BorrowerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CreditLineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case creditline.FieldCreditLimit:
+		return m.OldCreditLimit(ctx)
+	case creditline.FieldRate:
+		return m.OldRate(ctx)
+	case creditline.FieldDrawMonths:
+		return m.OldDrawMonths(ctx)
+	case creditline.FieldRepaymentMonths:
+		return m.OldRepaymentMonths(ctx)
+	case creditline.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case creditline.FieldBorrowerID:
+		return m.OldBorrowerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown CreditLine field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditLineMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case creditline.FieldCreditLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditLimit(v)
+		return nil
+	case creditline.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case creditline.FieldDrawMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrawMonths(v)
+		return nil
+	case creditline.FieldRepaymentMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepaymentMonths(v)
+		return nil
+	case creditline.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case creditline.FieldBorrowerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorrowerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLine field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CreditLineMutation) AddedFields() []string {
+	var fields []string
+	if m.addcredit_limit != nil {
+		fields = append(fields, creditline.FieldCreditLimit)
+	}
+	if m.addrate != nil {
+		fields = append(fields, creditline.FieldRate)
+	}
+	if m.adddraw_months != nil {
+		fields = append(fields, creditline.FieldDrawMonths)
+	}
+	if m.addrepayment_months != nil {
+		fields = append(fields, creditline.FieldRepaymentMonths)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CreditLineMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case creditline.FieldCreditLimit:
+		return m.AddedCreditLimit()
+	case creditline.FieldRate:
+		return m.AddedRate()
+	case creditline.FieldDrawMonths:
+		return m.AddedDrawMonths()
+	case creditline.FieldRepaymentMonths:
+		return m.AddedRepaymentMonths()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditLineMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case creditline.FieldCreditLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditLimit(v)
+		return nil
+	case creditline.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case creditline.FieldDrawMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDrawMonths(v)
+		return nil
+	case creditline.FieldRepaymentMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRepaymentMonths(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLine numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CreditLineMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CreditLineMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CreditLineMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CreditLine nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CreditLineMutation) ResetField(name string) error {
+	switch name {
+	case creditline.FieldCreditLimit:
+		m.ResetCreditLimit()
+		return nil
+	case creditline.FieldRate:
+		m.ResetRate()
+		return nil
+	case creditline.FieldDrawMonths:
+		m.ResetDrawMonths()
+		return nil
+	case creditline.FieldRepaymentMonths:
+		m.ResetRepaymentMonths()
+		return nil
+	case creditline.FieldOpenedAt:
+		m.ResetOpenedAt()
+		return nil
+	case creditline.FieldBorrowerID:
+		m.ResetBorrowerID()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLine field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CreditLineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.borrower != nil {
+		edges = append(edges, creditline.EdgeBorrower)
+	}
+	if m.transactions != nil {
+		edges = append(edges, creditline.EdgeTransactions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CreditLineMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case creditline.EdgeBorrower:
+		if id := m.borrower; id != nil {
+			return []ent.Value{*id}
+		}
+	case creditline.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CreditLineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtransactions != nil {
+		edges = append(edges, creditline.EdgeTransactions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CreditLineMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case creditline.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CreditLineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedborrower {
+		edges = append(edges, creditline.EdgeBorrower)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, creditline.EdgeTransactions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CreditLineMutation) EdgeCleared(name string) bool {
+	switch name {
+	case creditline.EdgeBorrower:
+		return m.clearedborrower
+	case creditline.EdgeTransactions:
+		return m.clearedtransactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CreditLineMutation) ClearEdge(name string) error {
+	switch name {
+	case creditline.EdgeBorrower:
+		m.ClearBorrower()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLine unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CreditLineMutation) ResetEdge(name string) error {
+	switch name {
+	case creditline.EdgeBorrower:
+		m.ResetBorrower()
+		return nil
+	case creditline.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLine edge %s", name)
+}
+
+// CreditLineTransactionMutation represents an operation that mutates the CreditLineTransaction nodes in the graph.
+type CreditLineTransactionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	kind               *creditlinetransaction.Kind
+	amount             *int
+	addamount          *int
+	posted_at          *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	credit_line        *int
+	clearedcredit_line bool
+	done               bool
+	oldValue           func(context.Context) (*CreditLineTransaction, error)
+	predicates         []predicate.CreditLineTransaction
+}
+
+var _ ent.Mutation = (*CreditLineTransactionMutation)(nil)
+
+// creditlinetransactionOption allows management of the mutation configuration using functional options.
+type creditlinetransactionOption func(*CreditLineTransactionMutation)
+
+// newCreditLineTransactionMutation creates new mutation for the CreditLineTransaction entity.
+func newCreditLineTransactionMutation(c config, op Op, opts ...creditlinetransactionOption) *CreditLineTransactionMutation {
+	m := &CreditLineTransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCreditLineTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCreditLineTransactionID sets the ID field of the mutation.
+func withCreditLineTransactionID(id int) creditlinetransactionOption {
+	return func(m *CreditLineTransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CreditLineTransaction
+		)
+		m.oldValue = func(ctx context.Context) (*CreditLineTransaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CreditLineTransaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCreditLineTransaction sets the old CreditLineTransaction of the mutation.
+func withCreditLineTransaction(node *CreditLineTransaction) creditlinetransactionOption {
+	return func(m *CreditLineTransactionMutation) {
+		m.oldValue = func(context.Context) (*CreditLineTransaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CreditLineTransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CreditLineTransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CreditLineTransactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CreditLineTransactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CreditLineTransaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreditLineID sets the "credit_line_id" field.
+func (m *CreditLineTransactionMutation) SetCreditLineID(i int) {
+	m.credit_line = &i
+}
+
+// CreditLineID returns the value of the "credit_line_id" field in the mutation.
+func (m *CreditLineTransactionMutation) CreditLineID() (r int, exists bool) {
+	v := m.credit_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditLineID returns the old "credit_line_id" field's value of the CreditLineTransaction entity.
+// If the CreditLineTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineTransactionMutation) OldCreditLineID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditLineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditLineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditLineID: %w", err)
+	}
+	return oldValue.CreditLineID, nil
+}
+
+// ResetCreditLineID resets all changes to the "credit_line_id" field.
+func (m *CreditLineTransactionMutation) ResetCreditLineID() {
+	m.credit_line = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *CreditLineTransactionMutation) SetKind(c creditlinetransaction.Kind) {
+	m.kind = &c
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CreditLineTransactionMutation) Kind() (r creditlinetransaction.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the CreditLineTransaction entity.
+// If the CreditLineTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineTransactionMutation) OldKind(ctx context.Context) (v creditlinetransaction.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *CreditLineTransactionMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *CreditLineTransactionMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditLineTransactionMutation) Amount() (r int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the CreditLineTransaction entity.
+// If the CreditLineTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineTransactionMutation) OldAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *CreditLineTransactionMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditLineTransactionMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditLineTransactionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (m *CreditLineTransactionMutation) SetPostedAt(t time.Time) {
+	m.posted_at = &t
+}
+
+// PostedAt returns the value of the "posted_at" field in the mutation.
+func (m *CreditLineTransactionMutation) PostedAt() (r time.Time, exists bool) {
+	v := m.posted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostedAt returns the old "posted_at" field's value of the CreditLineTransaction entity.
+// If the CreditLineTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineTransactionMutation) OldPostedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostedAt: %w", err)
+	}
+	return oldValue.PostedAt, nil
+}
+
+// ResetPostedAt resets all changes to the "posted_at" field.
+func (m *CreditLineTransactionMutation) ResetPostedAt() {
+	m.posted_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditLineTransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditLineTransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CreditLineTransaction entity.
+// If the CreditLineTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditLineTransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditLineTransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCreditLine clears the "credit_line" edge to the CreditLine entity.
+func (m *CreditLineTransactionMutation) ClearCreditLine() {
+	m.clearedcredit_line = true
+	m.clearedFields[creditlinetransaction.FieldCreditLineID] = struct{}{}
+}
+
+// CreditLineCleared reports if the "credit_line" edge to the CreditLine entity was cleared.
+func (m *CreditLineTransactionMutation) CreditLineCleared() bool {
+	return m.clearedcredit_line
+}
+
+// CreditLineIDs returns the "credit_line" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreditLineID instead. It exists only for internal usage by the builders.
+func (m *CreditLineTransactionMutation) CreditLineIDs() (ids []int) {
+	if id := m.credit_line; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreditLine resets all changes to the "credit_line" edge.
+func (m *CreditLineTransactionMutation) ResetCreditLine() {
+	m.credit_line = nil
+	m.clearedcredit_line = false
+}
+
+// Where appends a list predicates to the CreditLineTransactionMutation builder.
+func (m *CreditLineTransactionMutation) Where(ps ...predicate.CreditLineTransaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CreditLineTransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditLineTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditLineTransaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CreditLineTransactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CreditLineTransactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CreditLineTransaction).
+func (m *CreditLineTransactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CreditLineTransactionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.credit_line != nil {
+		fields = append(fields, creditlinetransaction.FieldCreditLineID)
+	}
+	if m.kind != nil {
+		fields = append(fields, creditlinetransaction.FieldKind)
+	}
+	if m.amount != nil {
+		fields = append(fields, creditlinetransaction.FieldAmount)
+	}
+	if m.posted_at != nil {
+		fields = append(fields, creditlinetransaction.FieldPostedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, creditlinetransaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CreditLineTransactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case creditlinetransaction.FieldCreditLineID:
+		return m.CreditLineID()
+	case creditlinetransaction.FieldKind:
+		return m.Kind()
+	case creditlinetransaction.FieldAmount:
+		return m.Amount()
+	case creditlinetransaction.FieldPostedAt:
+		return m.PostedAt()
+	case creditlinetransaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CreditLineTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case creditlinetransaction.FieldCreditLineID:
+		return m.OldCreditLineID(ctx)
+	case creditlinetransaction.FieldKind:
+		return m.OldKind(ctx)
+	case creditlinetransaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case creditlinetransaction.FieldPostedAt:
+		return m.OldPostedAt(ctx)
+	case creditlinetransaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CreditLineTransaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditLineTransactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case creditlinetransaction.FieldCreditLineID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditLineID(v)
+		return nil
+	case creditlinetransaction.FieldKind:
+		v, ok := value.(creditlinetransaction.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case creditlinetransaction.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case creditlinetransaction.FieldPostedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostedAt(v)
+		return nil
+	case creditlinetransaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLineTransaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CreditLineTransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, creditlinetransaction.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CreditLineTransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case creditlinetransaction.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditLineTransactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case creditlinetransaction.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLineTransaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CreditLineTransactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CreditLineTransactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CreditLineTransactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CreditLineTransaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CreditLineTransactionMutation) ResetField(name string) error {
+	switch name {
+	case creditlinetransaction.FieldCreditLineID:
+		m.ResetCreditLineID()
+		return nil
+	case creditlinetransaction.FieldKind:
+		m.ResetKind()
+		return nil
+	case creditlinetransaction.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case creditlinetransaction.FieldPostedAt:
+		m.ResetPostedAt()
+		return nil
+	case creditlinetransaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLineTransaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CreditLineTransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.credit_line != nil {
+		edges = append(edges, creditlinetransaction.EdgeCreditLine)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CreditLineTransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case creditlinetransaction.EdgeCreditLine:
+		if id := m.credit_line; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CreditLineTransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CreditLineTransactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CreditLineTransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcredit_line {
+		edges = append(edges, creditlinetransaction.EdgeCreditLine)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CreditLineTransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case creditlinetransaction.EdgeCreditLine:
+		return m.clearedcredit_line
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CreditLineTransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case creditlinetransaction.EdgeCreditLine:
+		m.ClearCreditLine()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLineTransaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CreditLineTransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case creditlinetransaction.EdgeCreditLine:
+		m.ResetCreditLine()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditLineTransaction edge %s", name)
+}
+
+// EscrowItemMutation represents an operation that mutates the EscrowItem nodes in the graph.
+type EscrowItemMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	kind                      *escrowitem.Kind
+	annual_amount             *int
+	addannual_amount          *int
+	first_due_month           *int
+	addfirst_due_month        *int
+	disbursements_per_year    *int
+	adddisbursements_per_year *int
+	created_at                *time.Time
+	clearedFields             map[string]struct{}
+	loan                      *int
+	clearedloan               bool
+	done                      bool
+	oldValue                  func(context.Context) (*EscrowItem, error)
+	predicates                []predicate.EscrowItem
+}
+
+var _ ent.Mutation = (*EscrowItemMutation)(nil)
+
+// escrowitemOption allows management of the mutation configuration using functional options.
+type escrowitemOption func(*EscrowItemMutation)
+
+// newEscrowItemMutation creates new mutation for the EscrowItem entity.
+func newEscrowItemMutation(c config, op Op, opts ...escrowitemOption) *EscrowItemMutation {
+	m := &EscrowItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEscrowItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEscrowItemID sets the ID field of the mutation.
+func withEscrowItemID(id int) escrowitemOption {
+	return func(m *EscrowItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EscrowItem
+		)
+		m.oldValue = func(ctx context.Context) (*EscrowItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EscrowItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEscrowItem sets the old EscrowItem of the mutation.
+func withEscrowItem(node *EscrowItem) escrowitemOption {
+	return func(m *EscrowItemMutation) {
+		m.oldValue = func(context.Context) (*EscrowItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EscrowItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EscrowItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EscrowItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EscrowItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EscrowItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *EscrowItemMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *EscrowItemMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *EscrowItemMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *EscrowItemMutation) SetKind(e escrowitem.Kind) {
+	m.kind = &e
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *EscrowItemMutation) Kind() (r escrowitem.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldKind(ctx context.Context) (v escrowitem.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *EscrowItemMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAnnualAmount sets the "annual_amount" field.
+func (m *EscrowItemMutation) SetAnnualAmount(i int) {
+	m.annual_amount = &i
+	m.addannual_amount = nil
+}
+
+// AnnualAmount returns the value of the "annual_amount" field in the mutation.
+func (m *EscrowItemMutation) AnnualAmount() (r int, exists bool) {
+	v := m.annual_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnualAmount returns the old "annual_amount" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldAnnualAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnualAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnualAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnualAmount: %w", err)
+	}
+	return oldValue.AnnualAmount, nil
+}
+
+// AddAnnualAmount adds i to the "annual_amount" field.
+func (m *EscrowItemMutation) AddAnnualAmount(i int) {
+	if m.addannual_amount != nil {
+		*m.addannual_amount += i
+	} else {
+		m.addannual_amount = &i
+	}
+}
+
+// AddedAnnualAmount returns the value that was added to the "annual_amount" field in this mutation.
+func (m *EscrowItemMutation) AddedAnnualAmount() (r int, exists bool) {
+	v := m.addannual_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnnualAmount resets all changes to the "annual_amount" field.
+func (m *EscrowItemMutation) ResetAnnualAmount() {
+	m.annual_amount = nil
+	m.addannual_amount = nil
+}
+
+// SetFirstDueMonth sets the "first_due_month" field.
+func (m *EscrowItemMutation) SetFirstDueMonth(i int) {
+	m.first_due_month = &i
+	m.addfirst_due_month = nil
+}
+
+// FirstDueMonth returns the value of the "first_due_month" field in the mutation.
+func (m *EscrowItemMutation) FirstDueMonth() (r int, exists bool) {
+	v := m.first_due_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstDueMonth returns the old "first_due_month" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldFirstDueMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstDueMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstDueMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstDueMonth: %w", err)
+	}
+	return oldValue.FirstDueMonth, nil
+}
+
+// AddFirstDueMonth adds i to the "first_due_month" field.
+func (m *EscrowItemMutation) AddFirstDueMonth(i int) {
+	if m.addfirst_due_month != nil {
+		*m.addfirst_due_month += i
+	} else {
+		m.addfirst_due_month = &i
+	}
+}
+
+// AddedFirstDueMonth returns the value that was added to the "first_due_month" field in this mutation.
+func (m *EscrowItemMutation) AddedFirstDueMonth() (r int, exists bool) {
+	v := m.addfirst_due_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFirstDueMonth resets all changes to the "first_due_month" field.
+func (m *EscrowItemMutation) ResetFirstDueMonth() {
+	m.first_due_month = nil
+	m.addfirst_due_month = nil
+}
+
+// SetDisbursementsPerYear sets the "disbursements_per_year" field.
+func (m *EscrowItemMutation) SetDisbursementsPerYear(i int) {
+	m.disbursements_per_year = &i
+	m.adddisbursements_per_year = nil
+}
+
+// DisbursementsPerYear returns the value of the "disbursements_per_year" field in the mutation.
+func (m *EscrowItemMutation) DisbursementsPerYear() (r int, exists bool) {
+	v := m.disbursements_per_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisbursementsPerYear returns the old "disbursements_per_year" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldDisbursementsPerYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisbursementsPerYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisbursementsPerYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisbursementsPerYear: %w", err)
+	}
+	return oldValue.DisbursementsPerYear, nil
+}
+
+// AddDisbursementsPerYear adds i to the "disbursements_per_year" field.
+func (m *EscrowItemMutation) AddDisbursementsPerYear(i int) {
+	if m.adddisbursements_per_year != nil {
+		*m.adddisbursements_per_year += i
+	} else {
+		m.adddisbursements_per_year = &i
+	}
+}
+
+// AddedDisbursementsPerYear returns the value that was added to the "disbursements_per_year" field in this mutation.
+func (m *EscrowItemMutation) AddedDisbursementsPerYear() (r int, exists bool) {
+	v := m.adddisbursements_per_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDisbursementsPerYear resets all changes to the "disbursements_per_year" field.
+func (m *EscrowItemMutation) ResetDisbursementsPerYear() {
+	m.disbursements_per_year = nil
+	m.adddisbursements_per_year = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EscrowItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EscrowItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EscrowItem entity.
+// If the EscrowItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EscrowItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EscrowItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *EscrowItemMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[escrowitem.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *EscrowItemMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *EscrowItemMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *EscrowItemMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the EscrowItemMutation builder.
+func (m *EscrowItemMutation) Where(ps ...predicate.EscrowItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EscrowItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EscrowItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EscrowItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EscrowItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EscrowItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EscrowItem).
+func (m *EscrowItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EscrowItemMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.loan != nil {
+		fields = append(fields, escrowitem.FieldLoanID)
+	}
+	if m.kind != nil {
+		fields = append(fields, escrowitem.FieldKind)
+	}
+	if m.annual_amount != nil {
+		fields = append(fields, escrowitem.FieldAnnualAmount)
+	}
+	if m.first_due_month != nil {
+		fields = append(fields, escrowitem.FieldFirstDueMonth)
+	}
+	if m.disbursements_per_year != nil {
+		fields = append(fields, escrowitem.FieldDisbursementsPerYear)
+	}
+	if m.created_at != nil {
+		fields = append(fields, escrowitem.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EscrowItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case escrowitem.FieldLoanID:
+		return m.LoanID()
+	case escrowitem.FieldKind:
+		return m.Kind()
+	case escrowitem.FieldAnnualAmount:
+		return m.AnnualAmount()
+	case escrowitem.FieldFirstDueMonth:
+		return m.FirstDueMonth()
+	case escrowitem.FieldDisbursementsPerYear:
+		return m.DisbursementsPerYear()
+	case escrowitem.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EscrowItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case escrowitem.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case escrowitem.FieldKind:
+		return m.OldKind(ctx)
+	case escrowitem.FieldAnnualAmount:
+		return m.OldAnnualAmount(ctx)
+	case escrowitem.FieldFirstDueMonth:
+		return m.OldFirstDueMonth(ctx)
+	case escrowitem.FieldDisbursementsPerYear:
+		return m.OldDisbursementsPerYear(ctx)
+	case escrowitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EscrowItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EscrowItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case escrowitem.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case escrowitem.FieldKind:
+		v, ok := value.(escrowitem.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case escrowitem.FieldAnnualAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnualAmount(v)
+		return nil
+	case escrowitem.FieldFirstDueMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstDueMonth(v)
+		return nil
+	case escrowitem.FieldDisbursementsPerYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisbursementsPerYear(v)
+		return nil
+	case escrowitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EscrowItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addannual_amount != nil {
+		fields = append(fields, escrowitem.FieldAnnualAmount)
+	}
+	if m.addfirst_due_month != nil {
+		fields = append(fields, escrowitem.FieldFirstDueMonth)
+	}
+	if m.adddisbursements_per_year != nil {
+		fields = append(fields, escrowitem.FieldDisbursementsPerYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EscrowItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case escrowitem.FieldAnnualAmount:
+		return m.AddedAnnualAmount()
+	case escrowitem.FieldFirstDueMonth:
+		return m.AddedFirstDueMonth()
+	case escrowitem.FieldDisbursementsPerYear:
+		return m.AddedDisbursementsPerYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EscrowItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case escrowitem.FieldAnnualAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnnualAmount(v)
+		return nil
+	case escrowitem.FieldFirstDueMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstDueMonth(v)
+		return nil
+	case escrowitem.FieldDisbursementsPerYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDisbursementsPerYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EscrowItemMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EscrowItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EscrowItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EscrowItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EscrowItemMutation) ResetField(name string) error {
+	switch name {
+	case escrowitem.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case escrowitem.FieldKind:
+		m.ResetKind()
+		return nil
+	case escrowitem.FieldAnnualAmount:
+		m.ResetAnnualAmount()
+		return nil
+	case escrowitem.FieldFirstDueMonth:
+		m.ResetFirstDueMonth()
+		return nil
+	case escrowitem.FieldDisbursementsPerYear:
+		m.ResetDisbursementsPerYear()
+		return nil
+	case escrowitem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EscrowItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.loan != nil {
+		edges = append(edges, escrowitem.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EscrowItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case escrowitem.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EscrowItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EscrowItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EscrowItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedloan {
+		edges = append(edges, escrowitem.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EscrowItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case escrowitem.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EscrowItemMutation) ClearEdge(name string) error {
+	switch name {
+	case escrowitem.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EscrowItemMutation) ResetEdge(name string) error {
+	switch name {
+	case escrowitem.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown EscrowItem edge %s", name)
+}
+
+// IncomeCertificationMutation represents an operation that mutates the IncomeCertification nodes in the graph.
+type IncomeCertificationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	year           *int
+	addyear        *int
+	income         *int
+	addincome      *int
+	family_size    *int
+	addfamily_size *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	plan           *int
+	clearedplan    bool
+	done           bool
+	oldValue       func(context.Context) (*IncomeCertification, error)
+	predicates     []predicate.IncomeCertification
+}
+
+var _ ent.Mutation = (*IncomeCertificationMutation)(nil)
+
+// incomecertificationOption allows management of the mutation configuration using functional options.
+type incomecertificationOption func(*IncomeCertificationMutation)
+
+// newIncomeCertificationMutation creates new mutation for the IncomeCertification entity.
+func newIncomeCertificationMutation(c config, op Op, opts ...incomecertificationOption) *IncomeCertificationMutation {
+	m := &IncomeCertificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIncomeCertification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIncomeCertificationID sets the ID field of the mutation.
+func withIncomeCertificationID(id int) incomecertificationOption {
+	return func(m *IncomeCertificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IncomeCertification
+		)
+		m.oldValue = func(ctx context.Context) (*IncomeCertification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IncomeCertification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIncomeCertification sets the old IncomeCertification of the mutation.
+func withIncomeCertification(node *IncomeCertification) incomecertificationOption {
+	return func(m *IncomeCertificationMutation) {
+		m.oldValue = func(context.Context) (*IncomeCertification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IncomeCertificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IncomeCertificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IncomeCertificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IncomeCertificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IncomeCertification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlanID sets the "plan_id" field.
+func (m *IncomeCertificationMutation) SetPlanID(i int) {
+	m.plan = &i
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *IncomeCertificationMutation) PlanID() (r int, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlanID returns the old "plan_id" field's value of the IncomeCertification entity.
+// If the IncomeCertification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeCertificationMutation) OldPlanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
+}
+
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *IncomeCertificationMutation) ResetPlanID() {
+	m.plan = nil
+}
+
+// SetYear sets the "year" field.
+func (m *IncomeCertificationMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *IncomeCertificationMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the IncomeCertification entity.
+// If the IncomeCertification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeCertificationMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *IncomeCertificationMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *IncomeCertificationMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *IncomeCertificationMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+}
+
+// SetIncome sets the "income" field.
+func (m *IncomeCertificationMutation) SetIncome(i int) {
+	m.income = &i
+	m.addincome = nil
+}
+
+// Income returns the value of the "income" field in the mutation.
+func (m *IncomeCertificationMutation) Income() (r int, exists bool) {
+	v := m.income
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncome returns the old "income" field's value of the IncomeCertification entity.
+// If the IncomeCertification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeCertificationMutation) OldIncome(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncome: %w", err)
+	}
+	return oldValue.Income, nil
+}
+
+// AddIncome adds i to the "income" field.
+func (m *IncomeCertificationMutation) AddIncome(i int) {
+	if m.addincome != nil {
+		*m.addincome += i
+	} else {
+		m.addincome = &i
+	}
+}
+
+// AddedIncome returns the value that was added to the "income" field in this mutation.
+func (m *IncomeCertificationMutation) AddedIncome() (r int, exists bool) {
+	v := m.addincome
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIncome resets all changes to the "income" field.
+func (m *IncomeCertificationMutation) ResetIncome() {
+	m.income = nil
+	m.addincome = nil
+}
+
+// SetFamilySize sets the "family_size" field.
+func (m *IncomeCertificationMutation) SetFamilySize(i int) {
+	m.family_size = &i
+	m.addfamily_size = nil
+}
+
+// FamilySize returns the value of the "family_size" field in the mutation.
+func (m *IncomeCertificationMutation) FamilySize() (r int, exists bool) {
+	v := m.family_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilySize returns the old "family_size" field's value of the IncomeCertification entity.
+// If the IncomeCertification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeCertificationMutation) OldFamilySize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilySize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilySize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilySize: %w", err)
+	}
+	return oldValue.FamilySize, nil
+}
+
+// AddFamilySize adds i to the "family_size" field.
+func (m *IncomeCertificationMutation) AddFamilySize(i int) {
+	if m.addfamily_size != nil {
+		*m.addfamily_size += i
+	} else {
+		m.addfamily_size = &i
+	}
+}
+
+// AddedFamilySize returns the value that was added to the "family_size" field in this mutation.
+func (m *IncomeCertificationMutation) AddedFamilySize() (r int, exists bool) {
+	v := m.addfamily_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFamilySize resets all changes to the "family_size" field.
+func (m *IncomeCertificationMutation) ResetFamilySize() {
+	m.family_size = nil
+	m.addfamily_size = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IncomeCertificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IncomeCertificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IncomeCertification entity.
+// If the IncomeCertification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeCertificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IncomeCertificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPlan clears the "plan" edge to the IncomeDrivenPlan entity.
+func (m *IncomeCertificationMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[incomecertification.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the IncomeDrivenPlan entity was cleared.
+func (m *IncomeCertificationMutation) PlanCleared() bool {
+	return m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *IncomeCertificationMutation) PlanIDs() (ids []int) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *IncomeCertificationMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the IncomeCertificationMutation builder.
+func (m *IncomeCertificationMutation) Where(ps ...predicate.IncomeCertification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IncomeCertificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IncomeCertificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IncomeCertification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IncomeCertificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IncomeCertificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IncomeCertification).
+func (m *IncomeCertificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IncomeCertificationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.plan != nil {
+		fields = append(fields, incomecertification.FieldPlanID)
+	}
+	if m.year != nil {
+		fields = append(fields, incomecertification.FieldYear)
+	}
+	if m.income != nil {
+		fields = append(fields, incomecertification.FieldIncome)
+	}
+	if m.family_size != nil {
+		fields = append(fields, incomecertification.FieldFamilySize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, incomecertification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IncomeCertificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case incomecertification.FieldPlanID:
+		return m.PlanID()
+	case incomecertification.FieldYear:
+		return m.Year()
+	case incomecertification.FieldIncome:
+		return m.Income()
+	case incomecertification.FieldFamilySize:
+		return m.FamilySize()
+	case incomecertification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IncomeCertificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case incomecertification.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case incomecertification.FieldYear:
+		return m.OldYear(ctx)
+	case incomecertification.FieldIncome:
+		return m.OldIncome(ctx)
+	case incomecertification.FieldFamilySize:
+		return m.OldFamilySize(ctx)
+	case incomecertification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IncomeCertification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncomeCertificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case incomecertification.FieldPlanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlanID(v)
+		return nil
+	case incomecertification.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case incomecertification.FieldIncome:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncome(v)
+		return nil
+	case incomecertification.FieldFamilySize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilySize(v)
+		return nil
+	case incomecertification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeCertification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IncomeCertificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, incomecertification.FieldYear)
+	}
+	if m.addincome != nil {
+		fields = append(fields, incomecertification.FieldIncome)
+	}
+	if m.addfamily_size != nil {
+		fields = append(fields, incomecertification.FieldFamilySize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IncomeCertificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case incomecertification.FieldYear:
+		return m.AddedYear()
+	case incomecertification.FieldIncome:
+		return m.AddedIncome()
+	case incomecertification.FieldFamilySize:
+		return m.AddedFamilySize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncomeCertificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case incomecertification.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case incomecertification.FieldIncome:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIncome(v)
+		return nil
+	case incomecertification.FieldFamilySize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFamilySize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeCertification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IncomeCertificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IncomeCertificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IncomeCertificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IncomeCertification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IncomeCertificationMutation) ResetField(name string) error {
+	switch name {
+	case incomecertification.FieldPlanID:
+		m.ResetPlanID()
+		return nil
+	case incomecertification.FieldYear:
+		m.ResetYear()
+		return nil
+	case incomecertification.FieldIncome:
+		m.ResetIncome()
+		return nil
+	case incomecertification.FieldFamilySize:
+		m.ResetFamilySize()
+		return nil
+	case incomecertification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeCertification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IncomeCertificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plan != nil {
+		edges = append(edges, incomecertification.EdgePlan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IncomeCertificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case incomecertification.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IncomeCertificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IncomeCertificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IncomeCertificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplan {
+		edges = append(edges, incomecertification.EdgePlan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IncomeCertificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case incomecertification.EdgePlan:
+		return m.clearedplan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IncomeCertificationMutation) ClearEdge(name string) error {
+	switch name {
+	case incomecertification.EdgePlan:
+		m.ClearPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeCertification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IncomeCertificationMutation) ResetEdge(name string) error {
+	switch name {
+	case incomecertification.EdgePlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeCertification edge %s", name)
+}
+
+// IncomeDrivenPlanMutation represents an operation that mutates the IncomeDrivenPlan nodes in the graph.
+type IncomeDrivenPlanMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	start_month           *int
+	addstart_month        *int
+	payment_percent       *float64
+	addpayment_percent    *float64
+	poverty_multiplier    *float64
+	addpoverty_multiplier *float64
+	region                *incomedrivenplan.Region
+	forgiveness_months    *int
+	addforgiveness_months *int
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	loan                  *int
+	clearedloan           bool
+	certifications        map[int]struct{}
+	removedcertifications map[int]struct{}
+	clearedcertifications bool
+	done                  bool
+	oldValue              func(context.Context) (*IncomeDrivenPlan, error)
+	predicates            []predicate.IncomeDrivenPlan
+}
+
+var _ ent.Mutation = (*IncomeDrivenPlanMutation)(nil)
+
+// incomedrivenplanOption allows management of the mutation configuration using functional options.
+type incomedrivenplanOption func(*IncomeDrivenPlanMutation)
+
+// newIncomeDrivenPlanMutation creates new mutation for the IncomeDrivenPlan entity.
+func newIncomeDrivenPlanMutation(c config, op Op, opts ...incomedrivenplanOption) *IncomeDrivenPlanMutation {
+	m := &IncomeDrivenPlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIncomeDrivenPlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIncomeDrivenPlanID sets the ID field of the mutation.
+func withIncomeDrivenPlanID(id int) incomedrivenplanOption {
+	return func(m *IncomeDrivenPlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IncomeDrivenPlan
+		)
+		m.oldValue = func(ctx context.Context) (*IncomeDrivenPlan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IncomeDrivenPlan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIncomeDrivenPlan sets the old IncomeDrivenPlan of the mutation.
+func withIncomeDrivenPlan(node *IncomeDrivenPlan) incomedrivenplanOption {
+	return func(m *IncomeDrivenPlanMutation) {
+		m.oldValue = func(context.Context) (*IncomeDrivenPlan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IncomeDrivenPlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IncomeDrivenPlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IncomeDrivenPlanMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IncomeDrivenPlanMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IncomeDrivenPlan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *IncomeDrivenPlanMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *IncomeDrivenPlanMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *IncomeDrivenPlanMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetStartMonth sets the "start_month" field.
+func (m *IncomeDrivenPlanMutation) SetStartMonth(i int) {
+	m.start_month = &i
+	m.addstart_month = nil
+}
+
+// StartMonth returns the value of the "start_month" field in the mutation.
+func (m *IncomeDrivenPlanMutation) StartMonth() (r int, exists bool) {
+	v := m.start_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartMonth returns the old "start_month" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldStartMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartMonth: %w", err)
+	}
+	return oldValue.StartMonth, nil
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (m *IncomeDrivenPlanMutation) AddStartMonth(i int) {
+	if m.addstart_month != nil {
+		*m.addstart_month += i
+	} else {
+		m.addstart_month = &i
+	}
+}
+
+// AddedStartMonth returns the value that was added to the "start_month" field in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedStartMonth() (r int, exists bool) {
+	v := m.addstart_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartMonth resets all changes to the "start_month" field.
+func (m *IncomeDrivenPlanMutation) ResetStartMonth() {
+	m.start_month = nil
+	m.addstart_month = nil
+}
+
+// SetPaymentPercent sets the "payment_percent" field.
+func (m *IncomeDrivenPlanMutation) SetPaymentPercent(f float64) {
+	m.payment_percent = &f
+	m.addpayment_percent = nil
+}
+
+// PaymentPercent returns the value of the "payment_percent" field in the mutation.
+func (m *IncomeDrivenPlanMutation) PaymentPercent() (r float64, exists bool) {
+	v := m.payment_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentPercent returns the old "payment_percent" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldPaymentPercent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentPercent: %w", err)
+	}
+	return oldValue.PaymentPercent, nil
+}
+
+// AddPaymentPercent adds f to the "payment_percent" field.
+func (m *IncomeDrivenPlanMutation) AddPaymentPercent(f float64) {
+	if m.addpayment_percent != nil {
+		*m.addpayment_percent += f
+	} else {
+		m.addpayment_percent = &f
+	}
+}
+
+// AddedPaymentPercent returns the value that was added to the "payment_percent" field in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedPaymentPercent() (r float64, exists bool) {
+	v := m.addpayment_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPaymentPercent resets all changes to the "payment_percent" field.
+func (m *IncomeDrivenPlanMutation) ResetPaymentPercent() {
+	m.payment_percent = nil
+	m.addpayment_percent = nil
+}
+
+// SetPovertyMultiplier sets the "poverty_multiplier" field.
+func (m *IncomeDrivenPlanMutation) SetPovertyMultiplier(f float64) {
+	m.poverty_multiplier = &f
+	m.addpoverty_multiplier = nil
+}
+
+// PovertyMultiplier returns the value of the "poverty_multiplier" field in the mutation.
+func (m *IncomeDrivenPlanMutation) PovertyMultiplier() (r float64, exists bool) {
+	v := m.poverty_multiplier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPovertyMultiplier returns the old "poverty_multiplier" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldPovertyMultiplier(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPovertyMultiplier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPovertyMultiplier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPovertyMultiplier: %w", err)
+	}
+	return oldValue.PovertyMultiplier, nil
+}
+
+// AddPovertyMultiplier adds f to the "poverty_multiplier" field.
+func (m *IncomeDrivenPlanMutation) AddPovertyMultiplier(f float64) {
+	if m.addpoverty_multiplier != nil {
+		*m.addpoverty_multiplier += f
+	} else {
+		m.addpoverty_multiplier = &f
+	}
+}
+
+// AddedPovertyMultiplier returns the value that was added to the "poverty_multiplier" field in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedPovertyMultiplier() (r float64, exists bool) {
+	v := m.addpoverty_multiplier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPovertyMultiplier resets all changes to the "poverty_multiplier" field.
+func (m *IncomeDrivenPlanMutation) ResetPovertyMultiplier() {
+	m.poverty_multiplier = nil
+	m.addpoverty_multiplier = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *IncomeDrivenPlanMutation) SetRegion(i incomedrivenplan.Region) {
+	m.region = &i
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *IncomeDrivenPlanMutation) Region() (r incomedrivenplan.Region, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldRegion(ctx context.Context) (v incomedrivenplan.Region, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *IncomeDrivenPlanMutation) ResetRegion() {
+	m.region = nil
+}
+
+// SetForgivenessMonths sets the "forgiveness_months" field.
+func (m *IncomeDrivenPlanMutation) SetForgivenessMonths(i int) {
+	m.forgiveness_months = &i
+	m.addforgiveness_months = nil
+}
+
+// ForgivenessMonths returns the value of the "forgiveness_months" field in the mutation.
+func (m *IncomeDrivenPlanMutation) ForgivenessMonths() (r int, exists bool) {
+	v := m.forgiveness_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForgivenessMonths returns the old "forgiveness_months" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldForgivenessMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForgivenessMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForgivenessMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForgivenessMonths: %w", err)
+	}
+	return oldValue.ForgivenessMonths, nil
+}
+
+// AddForgivenessMonths adds i to the "forgiveness_months" field.
+func (m *IncomeDrivenPlanMutation) AddForgivenessMonths(i int) {
+	if m.addforgiveness_months != nil {
+		*m.addforgiveness_months += i
+	} else {
+		m.addforgiveness_months = &i
+	}
+}
+
+// AddedForgivenessMonths returns the value that was added to the "forgiveness_months" field in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedForgivenessMonths() (r int, exists bool) {
+	v := m.addforgiveness_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetForgivenessMonths resets all changes to the "forgiveness_months" field.
+func (m *IncomeDrivenPlanMutation) ResetForgivenessMonths() {
+	m.forgiveness_months = nil
+	m.addforgiveness_months = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IncomeDrivenPlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IncomeDrivenPlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IncomeDrivenPlan entity.
+// If the IncomeDrivenPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IncomeDrivenPlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IncomeDrivenPlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *IncomeDrivenPlanMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[incomedrivenplan.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *IncomeDrivenPlanMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *IncomeDrivenPlanMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *IncomeDrivenPlanMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// AddCertificationIDs adds the "certifications" edge to the IncomeCertification entity by ids.
+func (m *IncomeDrivenPlanMutation) AddCertificationIDs(ids ...int) {
+	if m.certifications == nil {
+		m.certifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.certifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCertifications clears the "certifications" edge to the IncomeCertification entity.
+func (m *IncomeDrivenPlanMutation) ClearCertifications() {
+	m.clearedcertifications = true
+}
+
+// CertificationsCleared reports if the "certifications" edge to the IncomeCertification entity was cleared.
+func (m *IncomeDrivenPlanMutation) CertificationsCleared() bool {
+	return m.clearedcertifications
+}
+
+// RemoveCertificationIDs removes the "certifications" edge to the IncomeCertification entity by IDs.
+func (m *IncomeDrivenPlanMutation) RemoveCertificationIDs(ids ...int) {
+	if m.removedcertifications == nil {
+		m.removedcertifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.certifications, ids[i])
+		m.removedcertifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCertifications returns the removed IDs of the "certifications" edge to the IncomeCertification entity.
+func (m *IncomeDrivenPlanMutation) RemovedCertificationsIDs() (ids []int) {
+	for id := range m.removedcertifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CertificationsIDs returns the "certifications" edge IDs in the mutation.
+func (m *IncomeDrivenPlanMutation) CertificationsIDs() (ids []int) {
+	for id := range m.certifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCertifications resets all changes to the "certifications" edge.
+func (m *IncomeDrivenPlanMutation) ResetCertifications() {
+	m.certifications = nil
+	m.clearedcertifications = false
+	m.removedcertifications = nil
+}
+
+// Where appends a list predicates to the IncomeDrivenPlanMutation builder.
+func (m *IncomeDrivenPlanMutation) Where(ps ...predicate.IncomeDrivenPlan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IncomeDrivenPlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IncomeDrivenPlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IncomeDrivenPlan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IncomeDrivenPlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IncomeDrivenPlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IncomeDrivenPlan).
+func (m *IncomeDrivenPlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IncomeDrivenPlanMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.loan != nil {
+		fields = append(fields, incomedrivenplan.FieldLoanID)
+	}
+	if m.start_month != nil {
+		fields = append(fields, incomedrivenplan.FieldStartMonth)
+	}
+	if m.payment_percent != nil {
+		fields = append(fields, incomedrivenplan.FieldPaymentPercent)
+	}
+	if m.poverty_multiplier != nil {
+		fields = append(fields, incomedrivenplan.FieldPovertyMultiplier)
+	}
+	if m.region != nil {
+		fields = append(fields, incomedrivenplan.FieldRegion)
+	}
+	if m.forgiveness_months != nil {
+		fields = append(fields, incomedrivenplan.FieldForgivenessMonths)
+	}
+	if m.created_at != nil {
+		fields = append(fields, incomedrivenplan.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IncomeDrivenPlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case incomedrivenplan.FieldLoanID:
+		return m.LoanID()
+	case incomedrivenplan.FieldStartMonth:
+		return m.StartMonth()
+	case incomedrivenplan.FieldPaymentPercent:
+		return m.PaymentPercent()
+	case incomedrivenplan.FieldPovertyMultiplier:
+		return m.PovertyMultiplier()
+	case incomedrivenplan.FieldRegion:
+		return m.Region()
+	case incomedrivenplan.FieldForgivenessMonths:
+		return m.ForgivenessMonths()
+	case incomedrivenplan.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IncomeDrivenPlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case incomedrivenplan.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case incomedrivenplan.FieldStartMonth:
+		return m.OldStartMonth(ctx)
+	case incomedrivenplan.FieldPaymentPercent:
+		return m.OldPaymentPercent(ctx)
+	case incomedrivenplan.FieldPovertyMultiplier:
+		return m.OldPovertyMultiplier(ctx)
+	case incomedrivenplan.FieldRegion:
+		return m.OldRegion(ctx)
+	case incomedrivenplan.FieldForgivenessMonths:
+		return m.OldForgivenessMonths(ctx)
+	case incomedrivenplan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IncomeDrivenPlan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncomeDrivenPlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case incomedrivenplan.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case incomedrivenplan.FieldStartMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartMonth(v)
+		return nil
+	case incomedrivenplan.FieldPaymentPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentPercent(v)
+		return nil
+	case incomedrivenplan.FieldPovertyMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPovertyMultiplier(v)
+		return nil
+	case incomedrivenplan.FieldRegion:
+		v, ok := value.(incomedrivenplan.Region)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case incomedrivenplan.FieldForgivenessMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForgivenessMonths(v)
+		return nil
+	case incomedrivenplan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeDrivenPlan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IncomeDrivenPlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_month != nil {
+		fields = append(fields, incomedrivenplan.FieldStartMonth)
+	}
+	if m.addpayment_percent != nil {
+		fields = append(fields, incomedrivenplan.FieldPaymentPercent)
+	}
+	if m.addpoverty_multiplier != nil {
+		fields = append(fields, incomedrivenplan.FieldPovertyMultiplier)
+	}
+	if m.addforgiveness_months != nil {
+		fields = append(fields, incomedrivenplan.FieldForgivenessMonths)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IncomeDrivenPlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case incomedrivenplan.FieldStartMonth:
+		return m.AddedStartMonth()
+	case incomedrivenplan.FieldPaymentPercent:
+		return m.AddedPaymentPercent()
+	case incomedrivenplan.FieldPovertyMultiplier:
+		return m.AddedPovertyMultiplier()
+	case incomedrivenplan.FieldForgivenessMonths:
+		return m.AddedForgivenessMonths()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IncomeDrivenPlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case incomedrivenplan.FieldStartMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartMonth(v)
+		return nil
+	case incomedrivenplan.FieldPaymentPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPaymentPercent(v)
+		return nil
+	case incomedrivenplan.FieldPovertyMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPovertyMultiplier(v)
+		return nil
+	case incomedrivenplan.FieldForgivenessMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForgivenessMonths(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeDrivenPlan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IncomeDrivenPlanMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IncomeDrivenPlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IncomeDrivenPlanMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IncomeDrivenPlan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IncomeDrivenPlanMutation) ResetField(name string) error {
+	switch name {
+	case incomedrivenplan.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case incomedrivenplan.FieldStartMonth:
+		m.ResetStartMonth()
+		return nil
+	case incomedrivenplan.FieldPaymentPercent:
+		m.ResetPaymentPercent()
+		return nil
+	case incomedrivenplan.FieldPovertyMultiplier:
+		m.ResetPovertyMultiplier()
+		return nil
+	case incomedrivenplan.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case incomedrivenplan.FieldForgivenessMonths:
+		m.ResetForgivenessMonths()
+		return nil
+	case incomedrivenplan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeDrivenPlan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.loan != nil {
+		edges = append(edges, incomedrivenplan.EdgeLoan)
+	}
+	if m.certifications != nil {
+		edges = append(edges, incomedrivenplan.EdgeCertifications)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IncomeDrivenPlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case incomedrivenplan.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	case incomedrivenplan.EdgeCertifications:
+		ids := make([]ent.Value, 0, len(m.certifications))
+		for id := range m.certifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IncomeDrivenPlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcertifications != nil {
+		edges = append(edges, incomedrivenplan.EdgeCertifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IncomeDrivenPlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case incomedrivenplan.EdgeCertifications:
+		ids := make([]ent.Value, 0, len(m.removedcertifications))
+		for id := range m.removedcertifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IncomeDrivenPlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedloan {
+		edges = append(edges, incomedrivenplan.EdgeLoan)
+	}
+	if m.clearedcertifications {
+		edges = append(edges, incomedrivenplan.EdgeCertifications)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IncomeDrivenPlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case incomedrivenplan.EdgeLoan:
+		return m.clearedloan
+	case incomedrivenplan.EdgeCertifications:
+		return m.clearedcertifications
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IncomeDrivenPlanMutation) ClearEdge(name string) error {
+	switch name {
+	case incomedrivenplan.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeDrivenPlan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IncomeDrivenPlanMutation) ResetEdge(name string) error {
+	switch name {
+	case incomedrivenplan.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	case incomedrivenplan.EdgeCertifications:
+		m.ResetCertifications()
+		return nil
+	}
+	return fmt.Errorf("unknown IncomeDrivenPlan edge %s", name)
+}
+
+// LoanMutation represents an operation that mutates the Loan nodes in the graph.
+type LoanMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	amount                       *int
+	addamount                    *int
+	rate                         *float64
+	addrate                      *float64
+	term                         *int
+	addterm                      *int
+	graduated_step_rate          *float64
+	addgraduated_step_rate       *float64
+	graduated_years              *int
+	addgraduated_years           *int
+	negative_amortization_cap    *float64
+	addnegative_amortization_cap *float64
+	construction_months          *int
+	addconstruction_months       *int
+	property_value               *int
+	addproperty_value            *int
+	pmi_rate                     *float64
+	addpmi_rate                  *float64
+	pmi_cancellation_month       *int
+	addpmi_cancellation_month    *int
+	originated_at                *time.Time
+	clearedFields                map[string]struct{}
+	borrower                     *int
+	clearedborrower              bool
+	shared_loan                  map[int]struct{}
+	removedshared_loan           map[int]struct{}
+	clearedshared_loan           bool
+	share_invitations            map[int]struct{}
+	removedshare_invitations     map[int]struct{}
+	clearedshare_invitations     bool
+	share_links                  map[int]struct{}
+	removedshare_links           map[int]struct{}
+	clearedshare_links           bool
+	modifications                map[int]struct{}
+	removedmodifications         map[int]struct{}
+	clearedmodifications         bool
+	deferrals                    map[int]struct{}
+	removeddeferrals             map[int]struct{}
+	cleareddeferrals             bool
+	recasts                      map[int]struct{}
+	removedrecasts               map[int]struct{}
+	clearedrecasts               bool
+	income_driven_plan           *int
+	clearedincome_driven_plan    bool
+	disbursements                map[int]struct{}
+	removeddisbursements         map[int]struct{}
+	cleareddisbursements         bool
+	escrow_items                 map[int]struct{}
+	removedescrow_items          map[int]struct{}
+	clearedescrow_items          bool
+	obligors                     map[int]struct{}
+	removedobligors              map[int]struct{}
+	clearedobligors              bool
+	collateral                   map[int]struct{}
+	removedcollateral            map[int]struct{}
+	clearedcollateral            bool
+	done                         bool
+	oldValue                     func(context.Context) (*Loan, error)
+	predicates                   []predicate.Loan
+}
+
+var _ ent.Mutation = (*LoanMutation)(nil)
+
+// loanOption allows management of the mutation configuration using functional options.
+type loanOption func(*LoanMutation)
+
+// newLoanMutation creates new mutation for the Loan entity.
+func newLoanMutation(c config, op Op, opts ...loanOption) *LoanMutation {
+	m := &LoanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanID sets the ID field of the mutation.
+func withLoanID(id int) loanOption {
+	return func(m *LoanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Loan
+		)
+		m.oldValue = func(ctx context.Context) (*Loan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Loan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoan sets the old Loan of the mutation.
+func withLoan(node *Loan) loanOption {
+	return func(m *LoanMutation) {
+		m.oldValue = func(context.Context) (*Loan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Loan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAmount sets the "amount" field.
+func (m *LoanMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *LoanMutation) Amount() (r int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *LoanMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *LoanMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *LoanMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *LoanMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *LoanMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *LoanMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *LoanMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *LoanMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetTerm sets the "term" field.
+func (m *LoanMutation) SetTerm(i int) {
+	m.term = &i
+	m.addterm = nil
+}
+
+// Term returns the value of the "term" field in the mutation.
+func (m *LoanMutation) Term() (r int, exists bool) {
+	v := m.term
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTerm returns the old "term" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldTerm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTerm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTerm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTerm: %w", err)
+	}
+	return oldValue.Term, nil
+}
+
+// AddTerm adds i to the "term" field.
+func (m *LoanMutation) AddTerm(i int) {
+	if m.addterm != nil {
+		*m.addterm += i
+	} else {
+		m.addterm = &i
+	}
+}
+
+// AddedTerm returns the value that was added to the "term" field in this mutation.
+func (m *LoanMutation) AddedTerm() (r int, exists bool) {
+	v := m.addterm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTerm resets all changes to the "term" field.
+func (m *LoanMutation) ResetTerm() {
+	m.term = nil
+	m.addterm = nil
+}
+
+// SetBorrowerID sets the "borrower_id" field.
+func (m *LoanMutation) SetBorrowerID(i int) {
+	m.borrower = &i
+}
+
+// BorrowerID returns the value of the "borrower_id" field in the mutation.
+func (m *LoanMutation) BorrowerID() (r int, exists bool) {
+	v := m.borrower
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorrowerID returns the old "borrower_id" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldBorrowerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorrowerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorrowerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorrowerID: %w", err)
+	}
+	return oldValue.BorrowerID, nil
+}
+
+// ResetBorrowerID resets all changes to the "borrower_id" field.
+func (m *LoanMutation) ResetBorrowerID() {
+	m.borrower = nil
+}
+
+// SetGraduatedStepRate sets the "graduated_step_rate" field.
+func (m *LoanMutation) SetGraduatedStepRate(f float64) {
+	m.graduated_step_rate = &f
+	m.addgraduated_step_rate = nil
+}
+
+// GraduatedStepRate returns the value of the "graduated_step_rate" field in the mutation.
+func (m *LoanMutation) GraduatedStepRate() (r float64, exists bool) {
+	v := m.graduated_step_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGraduatedStepRate returns the old "graduated_step_rate" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldGraduatedStepRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraduatedStepRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraduatedStepRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraduatedStepRate: %w", err)
+	}
+	return oldValue.GraduatedStepRate, nil
+}
+
+// AddGraduatedStepRate adds f to the "graduated_step_rate" field.
+func (m *LoanMutation) AddGraduatedStepRate(f float64) {
+	if m.addgraduated_step_rate != nil {
+		*m.addgraduated_step_rate += f
+	} else {
+		m.addgraduated_step_rate = &f
+	}
+}
+
+// AddedGraduatedStepRate returns the value that was added to the "graduated_step_rate" field in this mutation.
+func (m *LoanMutation) AddedGraduatedStepRate() (r float64, exists bool) {
+	v := m.addgraduated_step_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGraduatedStepRate clears the value of the "graduated_step_rate" field.
+func (m *LoanMutation) ClearGraduatedStepRate() {
+	m.graduated_step_rate = nil
+	m.addgraduated_step_rate = nil
+	m.clearedFields[loan.FieldGraduatedStepRate] = struct{}{}
+}
+
+// GraduatedStepRateCleared returns if the "graduated_step_rate" field was cleared in this mutation.
+func (m *LoanMutation) GraduatedStepRateCleared() bool {
+	_, ok := m.clearedFields[loan.FieldGraduatedStepRate]
+	return ok
+}
+
+// ResetGraduatedStepRate resets all changes to the "graduated_step_rate" field.
+func (m *LoanMutation) ResetGraduatedStepRate() {
+	m.graduated_step_rate = nil
+	m.addgraduated_step_rate = nil
+	delete(m.clearedFields, loan.FieldGraduatedStepRate)
+}
+
+// SetGraduatedYears sets the "graduated_years" field.
+func (m *LoanMutation) SetGraduatedYears(i int) {
+	m.graduated_years = &i
+	m.addgraduated_years = nil
+}
+
+// GraduatedYears returns the value of the "graduated_years" field in the mutation.
+func (m *LoanMutation) GraduatedYears() (r int, exists bool) {
+	v := m.graduated_years
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGraduatedYears returns the old "graduated_years" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldGraduatedYears(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGraduatedYears is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGraduatedYears requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGraduatedYears: %w", err)
+	}
+	return oldValue.GraduatedYears, nil
+}
+
+// AddGraduatedYears adds i to the "graduated_years" field.
+func (m *LoanMutation) AddGraduatedYears(i int) {
+	if m.addgraduated_years != nil {
+		*m.addgraduated_years += i
+	} else {
+		m.addgraduated_years = &i
+	}
+}
+
+// AddedGraduatedYears returns the value that was added to the "graduated_years" field in this mutation.
+func (m *LoanMutation) AddedGraduatedYears() (r int, exists bool) {
+	v := m.addgraduated_years
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGraduatedYears clears the value of the "graduated_years" field.
+func (m *LoanMutation) ClearGraduatedYears() {
+	m.graduated_years = nil
+	m.addgraduated_years = nil
+	m.clearedFields[loan.FieldGraduatedYears] = struct{}{}
+}
+
+// GraduatedYearsCleared returns if the "graduated_years" field was cleared in this mutation.
+func (m *LoanMutation) GraduatedYearsCleared() bool {
+	_, ok := m.clearedFields[loan.FieldGraduatedYears]
+	return ok
+}
+
+// ResetGraduatedYears resets all changes to the "graduated_years" field.
+func (m *LoanMutation) ResetGraduatedYears() {
+	m.graduated_years = nil
+	m.addgraduated_years = nil
+	delete(m.clearedFields, loan.FieldGraduatedYears)
+}
+
+// SetNegativeAmortizationCap sets the "negative_amortization_cap" field.
+func (m *LoanMutation) SetNegativeAmortizationCap(f float64) {
+	m.negative_amortization_cap = &f
+	m.addnegative_amortization_cap = nil
+}
+
+// NegativeAmortizationCap returns the value of the "negative_amortization_cap" field in the mutation.
+func (m *LoanMutation) NegativeAmortizationCap() (r float64, exists bool) {
+	v := m.negative_amortization_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNegativeAmortizationCap returns the old "negative_amortization_cap" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldNegativeAmortizationCap(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNegativeAmortizationCap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNegativeAmortizationCap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNegativeAmortizationCap: %w", err)
+	}
+	return oldValue.NegativeAmortizationCap, nil
+}
+
+// AddNegativeAmortizationCap adds f to the "negative_amortization_cap" field.
+func (m *LoanMutation) AddNegativeAmortizationCap(f float64) {
+	if m.addnegative_amortization_cap != nil {
+		*m.addnegative_amortization_cap += f
+	} else {
+		m.addnegative_amortization_cap = &f
+	}
+}
+
+// AddedNegativeAmortizationCap returns the value that was added to the "negative_amortization_cap" field in this mutation.
+func (m *LoanMutation) AddedNegativeAmortizationCap() (r float64, exists bool) {
+	v := m.addnegative_amortization_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNegativeAmortizationCap clears the value of the "negative_amortization_cap" field.
+func (m *LoanMutation) ClearNegativeAmortizationCap() {
+	m.negative_amortization_cap = nil
+	m.addnegative_amortization_cap = nil
+	m.clearedFields[loan.FieldNegativeAmortizationCap] = struct{}{}
+}
+
+// NegativeAmortizationCapCleared returns if the "negative_amortization_cap" field was cleared in this mutation.
+func (m *LoanMutation) NegativeAmortizationCapCleared() bool {
+	_, ok := m.clearedFields[loan.FieldNegativeAmortizationCap]
+	return ok
+}
+
+// ResetNegativeAmortizationCap resets all changes to the "negative_amortization_cap" field.
+func (m *LoanMutation) ResetNegativeAmortizationCap() {
+	m.negative_amortization_cap = nil
+	m.addnegative_amortization_cap = nil
+	delete(m.clearedFields, loan.FieldNegativeAmortizationCap)
+}
+
+// SetConstructionMonths sets the "construction_months" field.
+func (m *LoanMutation) SetConstructionMonths(i int) {
+	m.construction_months = &i
+	m.addconstruction_months = nil
+}
+
+// ConstructionMonths returns the value of the "construction_months" field in the mutation.
+func (m *LoanMutation) ConstructionMonths() (r int, exists bool) {
+	v := m.construction_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConstructionMonths returns the old "construction_months" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldConstructionMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConstructionMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConstructionMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConstructionMonths: %w", err)
+	}
+	return oldValue.ConstructionMonths, nil
+}
+
+// AddConstructionMonths adds i to the "construction_months" field.
+func (m *LoanMutation) AddConstructionMonths(i int) {
+	if m.addconstruction_months != nil {
+		*m.addconstruction_months += i
+	} else {
+		m.addconstruction_months = &i
+	}
+}
+
+// AddedConstructionMonths returns the value that was added to the "construction_months" field in this mutation.
+func (m *LoanMutation) AddedConstructionMonths() (r int, exists bool) {
+	v := m.addconstruction_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearConstructionMonths clears the value of the "construction_months" field.
+func (m *LoanMutation) ClearConstructionMonths() {
+	m.construction_months = nil
+	m.addconstruction_months = nil
+	m.clearedFields[loan.FieldConstructionMonths] = struct{}{}
+}
+
+// ConstructionMonthsCleared returns if the "construction_months" field was cleared in this mutation.
+func (m *LoanMutation) ConstructionMonthsCleared() bool {
+	_, ok := m.clearedFields[loan.FieldConstructionMonths]
+	return ok
+}
+
+// ResetConstructionMonths resets all changes to the "construction_months" field.
+func (m *LoanMutation) ResetConstructionMonths() {
+	m.construction_months = nil
+	m.addconstruction_months = nil
+	delete(m.clearedFields, loan.FieldConstructionMonths)
+}
+
+// SetPropertyValue sets the "property_value" field.
+func (m *LoanMutation) SetPropertyValue(i int) {
+	m.property_value = &i
+	m.addproperty_value = nil
+}
+
+// PropertyValue returns the value of the "property_value" field in the mutation.
+func (m *LoanMutation) PropertyValue() (r int, exists bool) {
+	v := m.property_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPropertyValue returns the old "property_value" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldPropertyValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPropertyValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPropertyValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPropertyValue: %w", err)
+	}
+	return oldValue.PropertyValue, nil
+}
+
+// AddPropertyValue adds i to the "property_value" field.
+func (m *LoanMutation) AddPropertyValue(i int) {
+	if m.addproperty_value != nil {
+		*m.addproperty_value += i
+	} else {
+		m.addproperty_value = &i
+	}
+}
+
+// AddedPropertyValue returns the value that was added to the "property_value" field in this mutation.
+func (m *LoanMutation) AddedPropertyValue() (r int, exists bool) {
+	v := m.addproperty_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPropertyValue clears the value of the "property_value" field.
+func (m *LoanMutation) ClearPropertyValue() {
+	m.property_value = nil
+	m.addproperty_value = nil
+	m.clearedFields[loan.FieldPropertyValue] = struct{}{}
+}
+
+// PropertyValueCleared returns if the "property_value" field was cleared in this mutation.
+func (m *LoanMutation) PropertyValueCleared() bool {
+	_, ok := m.clearedFields[loan.FieldPropertyValue]
+	return ok
+}
+
+// ResetPropertyValue resets all changes to the "property_value" field.
+func (m *LoanMutation) ResetPropertyValue() {
+	m.property_value = nil
+	m.addproperty_value = nil
+	delete(m.clearedFields, loan.FieldPropertyValue)
+}
+
+// SetPmiRate sets the "pmi_rate" field.
+func (m *LoanMutation) SetPmiRate(f float64) {
+	m.pmi_rate = &f
+	m.addpmi_rate = nil
+}
+
+// PmiRate returns the value of the "pmi_rate" field in the mutation.
+func (m *LoanMutation) PmiRate() (r float64, exists bool) {
+	v := m.pmi_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPmiRate returns the old "pmi_rate" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldPmiRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPmiRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPmiRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPmiRate: %w", err)
+	}
+	return oldValue.PmiRate, nil
+}
+
+// AddPmiRate adds f to the "pmi_rate" field.
+func (m *LoanMutation) AddPmiRate(f float64) {
+	if m.addpmi_rate != nil {
+		*m.addpmi_rate += f
+	} else {
+		m.addpmi_rate = &f
+	}
+}
+
+// AddedPmiRate returns the value that was added to the "pmi_rate" field in this mutation.
+func (m *LoanMutation) AddedPmiRate() (r float64, exists bool) {
+	v := m.addpmi_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPmiRate clears the value of the "pmi_rate" field.
+func (m *LoanMutation) ClearPmiRate() {
+	m.pmi_rate = nil
+	m.addpmi_rate = nil
+	m.clearedFields[loan.FieldPmiRate] = struct{}{}
+}
+
+// PmiRateCleared returns if the "pmi_rate" field was cleared in this mutation.
+func (m *LoanMutation) PmiRateCleared() bool {
+	_, ok := m.clearedFields[loan.FieldPmiRate]
+	return ok
+}
+
+// ResetPmiRate resets all changes to the "pmi_rate" field.
+func (m *LoanMutation) ResetPmiRate() {
+	m.pmi_rate = nil
+	m.addpmi_rate = nil
+	delete(m.clearedFields, loan.FieldPmiRate)
+}
+
+// SetPmiCancellationMonth sets the "pmi_cancellation_month" field.
+func (m *LoanMutation) SetPmiCancellationMonth(i int) {
+	m.pmi_cancellation_month = &i
+	m.addpmi_cancellation_month = nil
+}
+
+// PmiCancellationMonth returns the value of the "pmi_cancellation_month" field in the mutation.
+func (m *LoanMutation) PmiCancellationMonth() (r int, exists bool) {
+	v := m.pmi_cancellation_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPmiCancellationMonth returns the old "pmi_cancellation_month" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldPmiCancellationMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPmiCancellationMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPmiCancellationMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPmiCancellationMonth: %w", err)
+	}
+	return oldValue.PmiCancellationMonth, nil
+}
+
+// AddPmiCancellationMonth adds i to the "pmi_cancellation_month" field.
+func (m *LoanMutation) AddPmiCancellationMonth(i int) {
+	if m.addpmi_cancellation_month != nil {
+		*m.addpmi_cancellation_month += i
+	} else {
+		m.addpmi_cancellation_month = &i
+	}
+}
+
+// AddedPmiCancellationMonth returns the value that was added to the "pmi_cancellation_month" field in this mutation.
+func (m *LoanMutation) AddedPmiCancellationMonth() (r int, exists bool) {
+	v := m.addpmi_cancellation_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPmiCancellationMonth clears the value of the "pmi_cancellation_month" field.
+func (m *LoanMutation) ClearPmiCancellationMonth() {
+	m.pmi_cancellation_month = nil
+	m.addpmi_cancellation_month = nil
+	m.clearedFields[loan.FieldPmiCancellationMonth] = struct{}{}
+}
+
+// PmiCancellationMonthCleared returns if the "pmi_cancellation_month" field was cleared in this mutation.
+func (m *LoanMutation) PmiCancellationMonthCleared() bool {
+	_, ok := m.clearedFields[loan.FieldPmiCancellationMonth]
+	return ok
+}
+
+// ResetPmiCancellationMonth resets all changes to the "pmi_cancellation_month" field.
+func (m *LoanMutation) ResetPmiCancellationMonth() {
+	m.pmi_cancellation_month = nil
+	m.addpmi_cancellation_month = nil
+	delete(m.clearedFields, loan.FieldPmiCancellationMonth)
+}
+
+// SetOriginatedAt sets the "originated_at" field.
+func (m *LoanMutation) SetOriginatedAt(t time.Time) {
+	m.originated_at = &t
+}
+
+// OriginatedAt returns the value of the "originated_at" field in the mutation.
+func (m *LoanMutation) OriginatedAt() (r time.Time, exists bool) {
+	v := m.originated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginatedAt returns the old "originated_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldOriginatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginatedAt: %w", err)
+	}
+	return oldValue.OriginatedAt, nil
+}
+
+// ResetOriginatedAt resets all changes to the "originated_at" field.
+func (m *LoanMutation) ResetOriginatedAt() {
+	m.originated_at = nil
+}
+
+// ClearBorrower clears the "borrower" edge to the User entity.
+func (m *LoanMutation) ClearBorrower() {
+	m.clearedborrower = true
+	m.clearedFields[loan.FieldBorrowerID] = struct{}{}
+}
+
+// BorrowerCleared reports if the "borrower" edge to the User entity was cleared.
+func (m *LoanMutation) BorrowerCleared() bool {
+	return m.clearedborrower
+}
+
+// BorrowerIDs returns the "borrower" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BorrowerID instead. It exists only for internal usage by the builders.
+func (m *LoanMutation) BorrowerIDs() (ids []int) {
+	if id := m.borrower; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBorrower resets all changes to the "borrower" edge.
+func (m *LoanMutation) ResetBorrower() {
+	m.borrower = nil
+	m.clearedborrower = false
+}
+
+// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by ids.
+func (m *LoanMutation) AddSharedLoanIDs(ids ...int) {
+	if m.shared_loan == nil {
+		m.shared_loan = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shared_loan[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSharedLoan clears the "shared_loan" edge to the SharedLoan entity.
+func (m *LoanMutation) ClearSharedLoan() {
+	m.clearedshared_loan = true
+}
+
+// SharedLoanCleared reports if the "shared_loan" edge to the SharedLoan entity was cleared.
+func (m *LoanMutation) SharedLoanCleared() bool {
+	return m.clearedshared_loan
+}
+
+// RemoveSharedLoanIDs removes the "shared_loan" edge to the SharedLoan entity by IDs.
+func (m *LoanMutation) RemoveSharedLoanIDs(ids ...int) {
+	if m.removedshared_loan == nil {
+		m.removedshared_loan = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shared_loan, ids[i])
+		m.removedshared_loan[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSharedLoan returns the removed IDs of the "shared_loan" edge to the SharedLoan entity.
+func (m *LoanMutation) RemovedSharedLoanIDs() (ids []int) {
+	for id := range m.removedshared_loan {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharedLoanIDs returns the "shared_loan" edge IDs in the mutation.
+func (m *LoanMutation) SharedLoanIDs() (ids []int) {
+	for id := range m.shared_loan {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSharedLoan resets all changes to the "shared_loan" edge.
+func (m *LoanMutation) ResetSharedLoan() {
+	m.shared_loan = nil
+	m.clearedshared_loan = false
+	m.removedshared_loan = nil
+}
+
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by ids.
+func (m *LoanMutation) AddShareInvitationIDs(ids ...int) {
+	if m.share_invitations == nil {
+		m.share_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.share_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareInvitations clears the "share_invitations" edge to the ShareInvitation entity.
+func (m *LoanMutation) ClearShareInvitations() {
+	m.clearedshare_invitations = true
+}
+
+// ShareInvitationsCleared reports if the "share_invitations" edge to the ShareInvitation entity was cleared.
+func (m *LoanMutation) ShareInvitationsCleared() bool {
+	return m.clearedshare_invitations
+}
+
+// RemoveShareInvitationIDs removes the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (m *LoanMutation) RemoveShareInvitationIDs(ids ...int) {
+	if m.removedshare_invitations == nil {
+		m.removedshare_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.share_invitations, ids[i])
+		m.removedshare_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareInvitations returns the removed IDs of the "share_invitations" edge to the ShareInvitation entity.
+func (m *LoanMutation) RemovedShareInvitationsIDs() (ids []int) {
+	for id := range m.removedshare_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareInvitationsIDs returns the "share_invitations" edge IDs in the mutation.
+func (m *LoanMutation) ShareInvitationsIDs() (ids []int) {
+	for id := range m.share_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareInvitations resets all changes to the "share_invitations" edge.
+func (m *LoanMutation) ResetShareInvitations() {
+	m.share_invitations = nil
+	m.clearedshare_invitations = false
+	m.removedshare_invitations = nil
+}
+
+// AddShareLinkIDs adds the "share_links" edge to the ShareLink entity by ids.
+func (m *LoanMutation) AddShareLinkIDs(ids ...int) {
+	if m.share_links == nil {
+		m.share_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.share_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareLinks clears the "share_links" edge to the ShareLink entity.
+func (m *LoanMutation) ClearShareLinks() {
+	m.clearedshare_links = true
+}
+
+// ShareLinksCleared reports if the "share_links" edge to the ShareLink entity was cleared.
+func (m *LoanMutation) ShareLinksCleared() bool {
+	return m.clearedshare_links
+}
+
+// RemoveShareLinkIDs removes the "share_links" edge to the ShareLink entity by IDs.
+func (m *LoanMutation) RemoveShareLinkIDs(ids ...int) {
+	if m.removedshare_links == nil {
+		m.removedshare_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.share_links, ids[i])
+		m.removedshare_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareLinks returns the removed IDs of the "share_links" edge to the ShareLink entity.
+func (m *LoanMutation) RemovedShareLinksIDs() (ids []int) {
+	for id := range m.removedshare_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareLinksIDs returns the "share_links" edge IDs in the mutation.
+func (m *LoanMutation) ShareLinksIDs() (ids []int) {
+	for id := range m.share_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareLinks resets all changes to the "share_links" edge.
+func (m *LoanMutation) ResetShareLinks() {
+	m.share_links = nil
+	m.clearedshare_links = false
+	m.removedshare_links = nil
+}
+
+// AddModificationIDs adds the "modifications" edge to the LoanModification entity by ids.
+func (m *LoanMutation) AddModificationIDs(ids ...int) {
+	if m.modifications == nil {
+		m.modifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.modifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModifications clears the "modifications" edge to the LoanModification entity.
+func (m *LoanMutation) ClearModifications() {
+	m.clearedmodifications = true
+}
+
+// ModificationsCleared reports if the "modifications" edge to the LoanModification entity was cleared.
+func (m *LoanMutation) ModificationsCleared() bool {
+	return m.clearedmodifications
+}
+
+// RemoveModificationIDs removes the "modifications" edge to the LoanModification entity by IDs.
+func (m *LoanMutation) RemoveModificationIDs(ids ...int) {
+	if m.removedmodifications == nil {
+		m.removedmodifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.modifications, ids[i])
+		m.removedmodifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModifications returns the removed IDs of the "modifications" edge to the LoanModification entity.
+func (m *LoanMutation) RemovedModificationsIDs() (ids []int) {
+	for id := range m.removedmodifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModificationsIDs returns the "modifications" edge IDs in the mutation.
+func (m *LoanMutation) ModificationsIDs() (ids []int) {
+	for id := range m.modifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModifications resets all changes to the "modifications" edge.
+func (m *LoanMutation) ResetModifications() {
+	m.modifications = nil
+	m.clearedmodifications = false
+	m.removedmodifications = nil
+}
+
+// AddDeferralIDs adds the "deferrals" edge to the PaymentDeferral entity by ids.
+func (m *LoanMutation) AddDeferralIDs(ids ...int) {
+	if m.deferrals == nil {
+		m.deferrals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deferrals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeferrals clears the "deferrals" edge to the PaymentDeferral entity.
+func (m *LoanMutation) ClearDeferrals() {
+	m.cleareddeferrals = true
+}
+
+// DeferralsCleared reports if the "deferrals" edge to the PaymentDeferral entity was cleared.
+func (m *LoanMutation) DeferralsCleared() bool {
+	return m.cleareddeferrals
+}
+
+// RemoveDeferralIDs removes the "deferrals" edge to the PaymentDeferral entity by IDs.
+func (m *LoanMutation) RemoveDeferralIDs(ids ...int) {
+	if m.removeddeferrals == nil {
+		m.removeddeferrals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deferrals, ids[i])
+		m.removeddeferrals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeferrals returns the removed IDs of the "deferrals" edge to the PaymentDeferral entity.
+func (m *LoanMutation) RemovedDeferralsIDs() (ids []int) {
+	for id := range m.removeddeferrals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeferralsIDs returns the "deferrals" edge IDs in the mutation.
+func (m *LoanMutation) DeferralsIDs() (ids []int) {
+	for id := range m.deferrals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeferrals resets all changes to the "deferrals" edge.
+func (m *LoanMutation) ResetDeferrals() {
+	m.deferrals = nil
+	m.cleareddeferrals = false
+	m.removeddeferrals = nil
+}
+
+// AddRecastIDs adds the "recasts" edge to the LoanRecast entity by ids.
+func (m *LoanMutation) AddRecastIDs(ids ...int) {
+	if m.recasts == nil {
+		m.recasts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recasts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecasts clears the "recasts" edge to the LoanRecast entity.
+func (m *LoanMutation) ClearRecasts() {
+	m.clearedrecasts = true
+}
+
+// RecastsCleared reports if the "recasts" edge to the LoanRecast entity was cleared.
+func (m *LoanMutation) RecastsCleared() bool {
+	return m.clearedrecasts
+}
+
+// RemoveRecastIDs removes the "recasts" edge to the LoanRecast entity by IDs.
+func (m *LoanMutation) RemoveRecastIDs(ids ...int) {
+	if m.removedrecasts == nil {
+		m.removedrecasts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recasts, ids[i])
+		m.removedrecasts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecasts returns the removed IDs of the "recasts" edge to the LoanRecast entity.
+func (m *LoanMutation) RemovedRecastsIDs() (ids []int) {
+	for id := range m.removedrecasts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecastsIDs returns the "recasts" edge IDs in the mutation.
+func (m *LoanMutation) RecastsIDs() (ids []int) {
+	for id := range m.recasts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecasts resets all changes to the "recasts" edge.
+func (m *LoanMutation) ResetRecasts() {
+	m.recasts = nil
+	m.clearedrecasts = false
+	m.removedrecasts = nil
+}
+
+// SetIncomeDrivenPlanID sets the "income_driven_plan" edge to the IncomeDrivenPlan entity by id.
+func (m *LoanMutation) SetIncomeDrivenPlanID(id int) {
+	m.income_driven_plan = &id
+}
+
+// ClearIncomeDrivenPlan clears the "income_driven_plan" edge to the IncomeDrivenPlan entity.
+func (m *LoanMutation) ClearIncomeDrivenPlan() {
+	m.clearedincome_driven_plan = true
+}
+
+// IncomeDrivenPlanCleared reports if the "income_driven_plan" edge to the IncomeDrivenPlan entity was cleared.
+func (m *LoanMutation) IncomeDrivenPlanCleared() bool {
+	return m.clearedincome_driven_plan
+}
+
+// IncomeDrivenPlanID returns the "income_driven_plan" edge ID in the mutation.
+func (m *LoanMutation) IncomeDrivenPlanID() (id int, exists bool) {
+	if m.income_driven_plan != nil {
+		return *m.income_driven_plan, true
+	}
+	return
+}
+
+// IncomeDrivenPlanIDs returns the "income_driven_plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IncomeDrivenPlanID instead. It exists only for internal usage by the builders.
+func (m *LoanMutation) IncomeDrivenPlanIDs() (ids []int) {
+	if id := m.income_driven_plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIncomeDrivenPlan resets all changes to the "income_driven_plan" edge.
+func (m *LoanMutation) ResetIncomeDrivenPlan() {
+	m.income_driven_plan = nil
+	m.clearedincome_driven_plan = false
+}
+
+// AddDisbursementIDs adds the "disbursements" edge to the LoanDisbursement entity by ids.
+func (m *LoanMutation) AddDisbursementIDs(ids ...int) {
+	if m.disbursements == nil {
+		m.disbursements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.disbursements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDisbursements clears the "disbursements" edge to the LoanDisbursement entity.
+func (m *LoanMutation) ClearDisbursements() {
+	m.cleareddisbursements = true
+}
+
+// DisbursementsCleared reports if the "disbursements" edge to the LoanDisbursement entity was cleared.
+func (m *LoanMutation) DisbursementsCleared() bool {
+	return m.cleareddisbursements
+}
+
+// RemoveDisbursementIDs removes the "disbursements" edge to the LoanDisbursement entity by IDs.
+func (m *LoanMutation) RemoveDisbursementIDs(ids ...int) {
+	if m.removeddisbursements == nil {
+		m.removeddisbursements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.disbursements, ids[i])
+		m.removeddisbursements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDisbursements returns the removed IDs of the "disbursements" edge to the LoanDisbursement entity.
+func (m *LoanMutation) RemovedDisbursementsIDs() (ids []int) {
+	for id := range m.removeddisbursements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DisbursementsIDs returns the "disbursements" edge IDs in the mutation.
+func (m *LoanMutation) DisbursementsIDs() (ids []int) {
+	for id := range m.disbursements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDisbursements resets all changes to the "disbursements" edge.
+func (m *LoanMutation) ResetDisbursements() {
+	m.disbursements = nil
+	m.cleareddisbursements = false
+	m.removeddisbursements = nil
+}
+
+// AddEscrowItemIDs adds the "escrow_items" edge to the EscrowItem entity by ids.
+func (m *LoanMutation) AddEscrowItemIDs(ids ...int) {
+	if m.escrow_items == nil {
+		m.escrow_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.escrow_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEscrowItems clears the "escrow_items" edge to the EscrowItem entity.
+func (m *LoanMutation) ClearEscrowItems() {
+	m.clearedescrow_items = true
+}
+
+// EscrowItemsCleared reports if the "escrow_items" edge to the EscrowItem entity was cleared.
+func (m *LoanMutation) EscrowItemsCleared() bool {
+	return m.clearedescrow_items
+}
+
+// RemoveEscrowItemIDs removes the "escrow_items" edge to the EscrowItem entity by IDs.
+func (m *LoanMutation) RemoveEscrowItemIDs(ids ...int) {
+	if m.removedescrow_items == nil {
+		m.removedescrow_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.escrow_items, ids[i])
+		m.removedescrow_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEscrowItems returns the removed IDs of the "escrow_items" edge to the EscrowItem entity.
+func (m *LoanMutation) RemovedEscrowItemsIDs() (ids []int) {
+	for id := range m.removedescrow_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EscrowItemsIDs returns the "escrow_items" edge IDs in the mutation.
+func (m *LoanMutation) EscrowItemsIDs() (ids []int) {
+	for id := range m.escrow_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEscrowItems resets all changes to the "escrow_items" edge.
+func (m *LoanMutation) ResetEscrowItems() {
+	m.escrow_items = nil
+	m.clearedescrow_items = false
+	m.removedescrow_items = nil
+}
+
+// AddObligorIDs adds the "obligors" edge to the LoanObligor entity by ids.
+func (m *LoanMutation) AddObligorIDs(ids ...int) {
+	if m.obligors == nil {
+		m.obligors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.obligors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearObligors clears the "obligors" edge to the LoanObligor entity.
+func (m *LoanMutation) ClearObligors() {
+	m.clearedobligors = true
+}
+
+// ObligorsCleared reports if the "obligors" edge to the LoanObligor entity was cleared.
+func (m *LoanMutation) ObligorsCleared() bool {
+	return m.clearedobligors
+}
+
+// RemoveObligorIDs removes the "obligors" edge to the LoanObligor entity by IDs.
+func (m *LoanMutation) RemoveObligorIDs(ids ...int) {
+	if m.removedobligors == nil {
+		m.removedobligors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.obligors, ids[i])
+		m.removedobligors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedObligors returns the removed IDs of the "obligors" edge to the LoanObligor entity.
+func (m *LoanMutation) RemovedObligorsIDs() (ids []int) {
+	for id := range m.removedobligors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ObligorsIDs returns the "obligors" edge IDs in the mutation.
+func (m *LoanMutation) ObligorsIDs() (ids []int) {
+	for id := range m.obligors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetObligors resets all changes to the "obligors" edge.
+func (m *LoanMutation) ResetObligors() {
+	m.obligors = nil
+	m.clearedobligors = false
+	m.removedobligors = nil
+}
+
+// AddCollateralIDs adds the "collateral" edge to the Collateral entity by ids.
+func (m *LoanMutation) AddCollateralIDs(ids ...int) {
+	if m.collateral == nil {
+		m.collateral = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.collateral[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCollateral clears the "collateral" edge to the Collateral entity.
+func (m *LoanMutation) ClearCollateral() {
+	m.clearedcollateral = true
+}
+
+// CollateralCleared reports if the "collateral" edge to the Collateral entity was cleared.
+func (m *LoanMutation) CollateralCleared() bool {
+	return m.clearedcollateral
+}
+
+// RemoveCollateralIDs removes the "collateral" edge to the Collateral entity by IDs.
+func (m *LoanMutation) RemoveCollateralIDs(ids ...int) {
+	if m.removedcollateral == nil {
+		m.removedcollateral = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.collateral, ids[i])
+		m.removedcollateral[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCollateral returns the removed IDs of the "collateral" edge to the Collateral entity.
+func (m *LoanMutation) RemovedCollateralIDs() (ids []int) {
+	for id := range m.removedcollateral {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CollateralIDs returns the "collateral" edge IDs in the mutation.
+func (m *LoanMutation) CollateralIDs() (ids []int) {
+	for id := range m.collateral {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCollateral resets all changes to the "collateral" edge.
+func (m *LoanMutation) ResetCollateral() {
+	m.collateral = nil
+	m.clearedcollateral = false
+	m.removedcollateral = nil
+}
+
+// Where appends a list predicates to the LoanMutation builder.
+func (m *LoanMutation) Where(ps ...predicate.Loan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Loan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Loan).
+func (m *LoanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.amount != nil {
+		fields = append(fields, loan.FieldAmount)
+	}
+	if m.rate != nil {
+		fields = append(fields, loan.FieldRate)
+	}
+	if m.term != nil {
+		fields = append(fields, loan.FieldTerm)
+	}
+	if m.borrower != nil {
+		fields = append(fields, loan.FieldBorrowerID)
+	}
+	if m.graduated_step_rate != nil {
+		fields = append(fields, loan.FieldGraduatedStepRate)
+	}
+	if m.graduated_years != nil {
+		fields = append(fields, loan.FieldGraduatedYears)
+	}
+	if m.negative_amortization_cap != nil {
+		fields = append(fields, loan.FieldNegativeAmortizationCap)
+	}
+	if m.construction_months != nil {
+		fields = append(fields, loan.FieldConstructionMonths)
+	}
+	if m.property_value != nil {
+		fields = append(fields, loan.FieldPropertyValue)
+	}
+	if m.pmi_rate != nil {
+		fields = append(fields, loan.FieldPmiRate)
+	}
+	if m.pmi_cancellation_month != nil {
+		fields = append(fields, loan.FieldPmiCancellationMonth)
+	}
+	if m.originated_at != nil {
+		fields = append(fields, loan.FieldOriginatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loan.FieldAmount:
+		return m.Amount()
+	case loan.FieldRate:
+		return m.Rate()
+	case loan.FieldTerm:
+		return m.Term()
+	case loan.FieldBorrowerID:
+		return m.BorrowerID()
+	case loan.FieldGraduatedStepRate:
+		return m.GraduatedStepRate()
+	case loan.FieldGraduatedYears:
+		return m.GraduatedYears()
+	case loan.FieldNegativeAmortizationCap:
+		return m.NegativeAmortizationCap()
+	case loan.FieldConstructionMonths:
+		return m.ConstructionMonths()
+	case loan.FieldPropertyValue:
+		return m.PropertyValue()
+	case loan.FieldPmiRate:
+		return m.PmiRate()
+	case loan.FieldPmiCancellationMonth:
+		return m.PmiCancellationMonth()
+	case loan.FieldOriginatedAt:
+		return m.OriginatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loan.FieldAmount:
+		return m.OldAmount(ctx)
+	case loan.FieldRate:
+		return m.OldRate(ctx)
+	case loan.FieldTerm:
+		return m.OldTerm(ctx)
+	case loan.FieldBorrowerID:
+		return m.OldBorrowerID(ctx)
+	case loan.FieldGraduatedStepRate:
+		return m.OldGraduatedStepRate(ctx)
+	case loan.FieldGraduatedYears:
+		return m.OldGraduatedYears(ctx)
+	case loan.FieldNegativeAmortizationCap:
+		return m.OldNegativeAmortizationCap(ctx)
+	case loan.FieldConstructionMonths:
+		return m.OldConstructionMonths(ctx)
+	case loan.FieldPropertyValue:
+		return m.OldPropertyValue(ctx)
+	case loan.FieldPmiRate:
+		return m.OldPmiRate(ctx)
+	case loan.FieldPmiCancellationMonth:
+		return m.OldPmiCancellationMonth(ctx)
+	case loan.FieldOriginatedAt:
+		return m.OldOriginatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Loan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loan.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case loan.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case loan.FieldTerm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTerm(v)
+		return nil
+	case loan.FieldBorrowerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorrowerID(v)
+		return nil
+	case loan.FieldGraduatedStepRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraduatedStepRate(v)
+		return nil
+	case loan.FieldGraduatedYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGraduatedYears(v)
+		return nil
+	case loan.FieldNegativeAmortizationCap:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNegativeAmortizationCap(v)
+		return nil
+	case loan.FieldConstructionMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConstructionMonths(v)
+		return nil
+	case loan.FieldPropertyValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPropertyValue(v)
+		return nil
+	case loan.FieldPmiRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPmiRate(v)
+		return nil
+	case loan.FieldPmiCancellationMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPmiCancellationMonth(v)
+		return nil
+	case loan.FieldOriginatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Loan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, loan.FieldAmount)
+	}
+	if m.addrate != nil {
+		fields = append(fields, loan.FieldRate)
+	}
+	if m.addterm != nil {
+		fields = append(fields, loan.FieldTerm)
+	}
+	if m.addgraduated_step_rate != nil {
+		fields = append(fields, loan.FieldGraduatedStepRate)
+	}
+	if m.addgraduated_years != nil {
+		fields = append(fields, loan.FieldGraduatedYears)
+	}
+	if m.addnegative_amortization_cap != nil {
+		fields = append(fields, loan.FieldNegativeAmortizationCap)
+	}
+	if m.addconstruction_months != nil {
+		fields = append(fields, loan.FieldConstructionMonths)
+	}
+	if m.addproperty_value != nil {
+		fields = append(fields, loan.FieldPropertyValue)
+	}
+	if m.addpmi_rate != nil {
+		fields = append(fields, loan.FieldPmiRate)
+	}
+	if m.addpmi_cancellation_month != nil {
+		fields = append(fields, loan.FieldPmiCancellationMonth)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loan.FieldAmount:
+		return m.AddedAmount()
+	case loan.FieldRate:
+		return m.AddedRate()
+	case loan.FieldTerm:
+		return m.AddedTerm()
+	case loan.FieldGraduatedStepRate:
+		return m.AddedGraduatedStepRate()
+	case loan.FieldGraduatedYears:
+		return m.AddedGraduatedYears()
+	case loan.FieldNegativeAmortizationCap:
+		return m.AddedNegativeAmortizationCap()
+	case loan.FieldConstructionMonths:
+		return m.AddedConstructionMonths()
+	case loan.FieldPropertyValue:
+		return m.AddedPropertyValue()
+	case loan.FieldPmiRate:
+		return m.AddedPmiRate()
+	case loan.FieldPmiCancellationMonth:
+		return m.AddedPmiCancellationMonth()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loan.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case loan.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case loan.FieldTerm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTerm(v)
+		return nil
+	case loan.FieldGraduatedStepRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGraduatedStepRate(v)
+		return nil
+	case loan.FieldGraduatedYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGraduatedYears(v)
+		return nil
+	case loan.FieldNegativeAmortizationCap:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNegativeAmortizationCap(v)
+		return nil
+	case loan.FieldConstructionMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConstructionMonths(v)
+		return nil
+	case loan.FieldPropertyValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPropertyValue(v)
+		return nil
+	case loan.FieldPmiRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPmiRate(v)
+		return nil
+	case loan.FieldPmiCancellationMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPmiCancellationMonth(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Loan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loan.FieldGraduatedStepRate) {
+		fields = append(fields, loan.FieldGraduatedStepRate)
+	}
+	if m.FieldCleared(loan.FieldGraduatedYears) {
+		fields = append(fields, loan.FieldGraduatedYears)
+	}
+	if m.FieldCleared(loan.FieldNegativeAmortizationCap) {
+		fields = append(fields, loan.FieldNegativeAmortizationCap)
+	}
+	if m.FieldCleared(loan.FieldConstructionMonths) {
+		fields = append(fields, loan.FieldConstructionMonths)
+	}
+	if m.FieldCleared(loan.FieldPropertyValue) {
+		fields = append(fields, loan.FieldPropertyValue)
+	}
+	if m.FieldCleared(loan.FieldPmiRate) {
+		fields = append(fields, loan.FieldPmiRate)
+	}
+	if m.FieldCleared(loan.FieldPmiCancellationMonth) {
+		fields = append(fields, loan.FieldPmiCancellationMonth)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanMutation) ClearField(name string) error {
+	switch name {
+	case loan.FieldGraduatedStepRate:
+		m.ClearGraduatedStepRate()
+		return nil
+	case loan.FieldGraduatedYears:
+		m.ClearGraduatedYears()
+		return nil
+	case loan.FieldNegativeAmortizationCap:
+		m.ClearNegativeAmortizationCap()
+		return nil
+	case loan.FieldConstructionMonths:
+		m.ClearConstructionMonths()
+		return nil
+	case loan.FieldPropertyValue:
+		m.ClearPropertyValue()
+		return nil
+	case loan.FieldPmiRate:
+		m.ClearPmiRate()
+		return nil
+	case loan.FieldPmiCancellationMonth:
+		m.ClearPmiCancellationMonth()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanMutation) ResetField(name string) error {
+	switch name {
+	case loan.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case loan.FieldRate:
+		m.ResetRate()
+		return nil
+	case loan.FieldTerm:
+		m.ResetTerm()
+		return nil
+	case loan.FieldBorrowerID:
+		m.ResetBorrowerID()
+		return nil
+	case loan.FieldGraduatedStepRate:
+		m.ResetGraduatedStepRate()
+		return nil
+	case loan.FieldGraduatedYears:
+		m.ResetGraduatedYears()
+		return nil
+	case loan.FieldNegativeAmortizationCap:
+		m.ResetNegativeAmortizationCap()
+		return nil
+	case loan.FieldConstructionMonths:
+		m.ResetConstructionMonths()
+		return nil
+	case loan.FieldPropertyValue:
+		m.ResetPropertyValue()
+		return nil
+	case loan.FieldPmiRate:
+		m.ResetPmiRate()
+		return nil
+	case loan.FieldPmiCancellationMonth:
+		m.ResetPmiCancellationMonth()
+		return nil
+	case loan.FieldOriginatedAt:
+		m.ResetOriginatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.borrower != nil {
+		edges = append(edges, loan.EdgeBorrower)
+	}
+	if m.shared_loan != nil {
+		edges = append(edges, loan.EdgeSharedLoan)
+	}
+	if m.share_invitations != nil {
+		edges = append(edges, loan.EdgeShareInvitations)
+	}
+	if m.share_links != nil {
+		edges = append(edges, loan.EdgeShareLinks)
+	}
+	if m.modifications != nil {
+		edges = append(edges, loan.EdgeModifications)
+	}
+	if m.deferrals != nil {
+		edges = append(edges, loan.EdgeDeferrals)
+	}
+	if m.recasts != nil {
+		edges = append(edges, loan.EdgeRecasts)
+	}
+	if m.income_driven_plan != nil {
+		edges = append(edges, loan.EdgeIncomeDrivenPlan)
+	}
+	if m.disbursements != nil {
+		edges = append(edges, loan.EdgeDisbursements)
+	}
+	if m.escrow_items != nil {
+		edges = append(edges, loan.EdgeEscrowItems)
+	}
+	if m.obligors != nil {
+		edges = append(edges, loan.EdgeObligors)
+	}
+	if m.collateral != nil {
+		edges = append(edges, loan.EdgeCollateral)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loan.EdgeBorrower:
+		if id := m.borrower; id != nil {
+			return []ent.Value{*id}
+		}
+	case loan.EdgeSharedLoan:
+		ids := make([]ent.Value, 0, len(m.shared_loan))
+		for id := range m.shared_loan {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeShareInvitations:
+		ids := make([]ent.Value, 0, len(m.share_invitations))
+		for id := range m.share_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeShareLinks:
+		ids := make([]ent.Value, 0, len(m.share_links))
+		for id := range m.share_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeModifications:
+		ids := make([]ent.Value, 0, len(m.modifications))
+		for id := range m.modifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeDeferrals:
+		ids := make([]ent.Value, 0, len(m.deferrals))
+		for id := range m.deferrals {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeRecasts:
+		ids := make([]ent.Value, 0, len(m.recasts))
+		for id := range m.recasts {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeIncomeDrivenPlan:
+		if id := m.income_driven_plan; id != nil {
+			return []ent.Value{*id}
+		}
+	case loan.EdgeDisbursements:
+		ids := make([]ent.Value, 0, len(m.disbursements))
+		for id := range m.disbursements {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeEscrowItems:
+		ids := make([]ent.Value, 0, len(m.escrow_items))
+		for id := range m.escrow_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeObligors:
+		ids := make([]ent.Value, 0, len(m.obligors))
+		for id := range m.obligors {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeCollateral:
+		ids := make([]ent.Value, 0, len(m.collateral))
+		for id := range m.collateral {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removedshared_loan != nil {
+		edges = append(edges, loan.EdgeSharedLoan)
+	}
+	if m.removedshare_invitations != nil {
+		edges = append(edges, loan.EdgeShareInvitations)
+	}
+	if m.removedshare_links != nil {
+		edges = append(edges, loan.EdgeShareLinks)
+	}
+	if m.removedmodifications != nil {
+		edges = append(edges, loan.EdgeModifications)
+	}
+	if m.removeddeferrals != nil {
+		edges = append(edges, loan.EdgeDeferrals)
+	}
+	if m.removedrecasts != nil {
+		edges = append(edges, loan.EdgeRecasts)
+	}
+	if m.removeddisbursements != nil {
+		edges = append(edges, loan.EdgeDisbursements)
+	}
+	if m.removedescrow_items != nil {
+		edges = append(edges, loan.EdgeEscrowItems)
+	}
+	if m.removedobligors != nil {
+		edges = append(edges, loan.EdgeObligors)
+	}
+	if m.removedcollateral != nil {
+		edges = append(edges, loan.EdgeCollateral)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case loan.EdgeSharedLoan:
+		ids := make([]ent.Value, 0, len(m.removedshared_loan))
+		for id := range m.removedshared_loan {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeShareInvitations:
+		ids := make([]ent.Value, 0, len(m.removedshare_invitations))
+		for id := range m.removedshare_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeShareLinks:
+		ids := make([]ent.Value, 0, len(m.removedshare_links))
+		for id := range m.removedshare_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeModifications:
+		ids := make([]ent.Value, 0, len(m.removedmodifications))
+		for id := range m.removedmodifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeDeferrals:
+		ids := make([]ent.Value, 0, len(m.removeddeferrals))
+		for id := range m.removeddeferrals {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeRecasts:
+		ids := make([]ent.Value, 0, len(m.removedrecasts))
+		for id := range m.removedrecasts {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeDisbursements:
+		ids := make([]ent.Value, 0, len(m.removeddisbursements))
+		for id := range m.removeddisbursements {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeEscrowItems:
+		ids := make([]ent.Value, 0, len(m.removedescrow_items))
+		for id := range m.removedescrow_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeObligors:
+		ids := make([]ent.Value, 0, len(m.removedobligors))
+		for id := range m.removedobligors {
+			ids = append(ids, id)
+		}
+		return ids
+	case loan.EdgeCollateral:
+		ids := make([]ent.Value, 0, len(m.removedcollateral))
+		for id := range m.removedcollateral {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.clearedborrower {
+		edges = append(edges, loan.EdgeBorrower)
+	}
+	if m.clearedshared_loan {
+		edges = append(edges, loan.EdgeSharedLoan)
+	}
+	if m.clearedshare_invitations {
+		edges = append(edges, loan.EdgeShareInvitations)
+	}
+	if m.clearedshare_links {
+		edges = append(edges, loan.EdgeShareLinks)
+	}
+	if m.clearedmodifications {
+		edges = append(edges, loan.EdgeModifications)
+	}
+	if m.cleareddeferrals {
+		edges = append(edges, loan.EdgeDeferrals)
+	}
+	if m.clearedrecasts {
+		edges = append(edges, loan.EdgeRecasts)
+	}
+	if m.clearedincome_driven_plan {
+		edges = append(edges, loan.EdgeIncomeDrivenPlan)
+	}
+	if m.cleareddisbursements {
+		edges = append(edges, loan.EdgeDisbursements)
+	}
+	if m.clearedescrow_items {
+		edges = append(edges, loan.EdgeEscrowItems)
+	}
+	if m.clearedobligors {
+		edges = append(edges, loan.EdgeObligors)
+	}
+	if m.clearedcollateral {
+		edges = append(edges, loan.EdgeCollateral)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loan.EdgeBorrower:
+		return m.clearedborrower
+	case loan.EdgeSharedLoan:
+		return m.clearedshared_loan
+	case loan.EdgeShareInvitations:
+		return m.clearedshare_invitations
+	case loan.EdgeShareLinks:
+		return m.clearedshare_links
+	case loan.EdgeModifications:
+		return m.clearedmodifications
+	case loan.EdgeDeferrals:
+		return m.cleareddeferrals
+	case loan.EdgeRecasts:
+		return m.clearedrecasts
+	case loan.EdgeIncomeDrivenPlan:
+		return m.clearedincome_driven_plan
+	case loan.EdgeDisbursements:
+		return m.cleareddisbursements
+	case loan.EdgeEscrowItems:
+		return m.clearedescrow_items
+	case loan.EdgeObligors:
+		return m.clearedobligors
+	case loan.EdgeCollateral:
+		return m.clearedcollateral
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanMutation) ClearEdge(name string) error {
+	switch name {
+	case loan.EdgeBorrower:
+		m.ClearBorrower()
+		return nil
+	case loan.EdgeIncomeDrivenPlan:
+		m.ClearIncomeDrivenPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanMutation) ResetEdge(name string) error {
+	switch name {
+	case loan.EdgeBorrower:
+		m.ResetBorrower()
+		return nil
+	case loan.EdgeSharedLoan:
+		m.ResetSharedLoan()
+		return nil
+	case loan.EdgeShareInvitations:
+		m.ResetShareInvitations()
+		return nil
+	case loan.EdgeShareLinks:
+		m.ResetShareLinks()
+		return nil
+	case loan.EdgeModifications:
+		m.ResetModifications()
+		return nil
+	case loan.EdgeDeferrals:
+		m.ResetDeferrals()
+		return nil
+	case loan.EdgeRecasts:
+		m.ResetRecasts()
+		return nil
+	case loan.EdgeIncomeDrivenPlan:
+		m.ResetIncomeDrivenPlan()
+		return nil
+	case loan.EdgeDisbursements:
+		m.ResetDisbursements()
+		return nil
+	case loan.EdgeEscrowItems:
+		m.ResetEscrowItems()
+		return nil
+	case loan.EdgeObligors:
+		m.ResetObligors()
+		return nil
+	case loan.EdgeCollateral:
+		m.ResetCollateral()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan edge %s", name)
+}
+
+// LoanDisbursementMutation represents an operation that mutates the LoanDisbursement nodes in the graph.
+type LoanDisbursementMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	month         *int
+	addmonth      *int
+	amount        *int
+	addamount     *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	loan          *int
+	clearedloan   bool
+	done          bool
+	oldValue      func(context.Context) (*LoanDisbursement, error)
+	predicates    []predicate.LoanDisbursement
+}
+
+var _ ent.Mutation = (*LoanDisbursementMutation)(nil)
+
+// loandisbursementOption allows management of the mutation configuration using functional options.
+type loandisbursementOption func(*LoanDisbursementMutation)
+
+// newLoanDisbursementMutation creates new mutation for the LoanDisbursement entity.
+func newLoanDisbursementMutation(c config, op Op, opts ...loandisbursementOption) *LoanDisbursementMutation {
+	m := &LoanDisbursementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoanDisbursement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanDisbursementID sets the ID field of the mutation.
+func withLoanDisbursementID(id int) loandisbursementOption {
+	return func(m *LoanDisbursementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoanDisbursement
+		)
+		m.oldValue = func(ctx context.Context) (*LoanDisbursement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoanDisbursement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoanDisbursement sets the old LoanDisbursement of the mutation.
+func withLoanDisbursement(node *LoanDisbursement) loandisbursementOption {
+	return func(m *LoanDisbursementMutation) {
+		m.oldValue = func(context.Context) (*LoanDisbursement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanDisbursementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanDisbursementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanDisbursementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanDisbursementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoanDisbursement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *LoanDisbursementMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *LoanDisbursementMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the LoanDisbursement entity.
+// If the LoanDisbursement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanDisbursementMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *LoanDisbursementMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetMonth sets the "month" field.
+func (m *LoanDisbursementMutation) SetMonth(i int) {
+	m.month = &i
+	m.addmonth = nil
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *LoanDisbursementMutation) Month() (r int, exists bool) {
+	v := m.month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonth returns the old "month" field's value of the LoanDisbursement entity.
+// If the LoanDisbursement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanDisbursementMutation) OldMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+	}
+	return oldValue.Month, nil
+}
+
+// AddMonth adds i to the "month" field.
+func (m *LoanDisbursementMutation) AddMonth(i int) {
+	if m.addmonth != nil {
+		*m.addmonth += i
+	} else {
+		m.addmonth = &i
+	}
+}
+
+// AddedMonth returns the value that was added to the "month" field in this mutation.
+func (m *LoanDisbursementMutation) AddedMonth() (r int, exists bool) {
+	v := m.addmonth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonth resets all changes to the "month" field.
+func (m *LoanDisbursementMutation) ResetMonth() {
+	m.month = nil
+	m.addmonth = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *LoanDisbursementMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *LoanDisbursementMutation) Amount() (r int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the LoanDisbursement entity.
+// If the LoanDisbursement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanDisbursementMutation) OldAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *LoanDisbursementMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *LoanDisbursementMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *LoanDisbursementMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoanDisbursementMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoanDisbursementMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoanDisbursement entity.
+// If the LoanDisbursement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanDisbursementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoanDisbursementMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *LoanDisbursementMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[loandisbursement.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *LoanDisbursementMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *LoanDisbursementMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *LoanDisbursementMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the LoanDisbursementMutation builder.
+func (m *LoanDisbursementMutation) Where(ps ...predicate.LoanDisbursement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanDisbursementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanDisbursementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoanDisbursement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanDisbursementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanDisbursementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoanDisbursement).
+func (m *LoanDisbursementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanDisbursementMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.loan != nil {
+		fields = append(fields, loandisbursement.FieldLoanID)
+	}
+	if m.month != nil {
+		fields = append(fields, loandisbursement.FieldMonth)
+	}
+	if m.amount != nil {
+		fields = append(fields, loandisbursement.FieldAmount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loandisbursement.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanDisbursementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loandisbursement.FieldLoanID:
+		return m.LoanID()
+	case loandisbursement.FieldMonth:
+		return m.Month()
+	case loandisbursement.FieldAmount:
+		return m.Amount()
+	case loandisbursement.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanDisbursementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loandisbursement.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case loandisbursement.FieldMonth:
+		return m.OldMonth(ctx)
+	case loandisbursement.FieldAmount:
+		return m.OldAmount(ctx)
+	case loandisbursement.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoanDisbursement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanDisbursementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loandisbursement.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case loandisbursement.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonth(v)
+		return nil
+	case loandisbursement.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case loandisbursement.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanDisbursement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanDisbursementMutation) AddedFields() []string {
+	var fields []string
+	if m.addmonth != nil {
+		fields = append(fields, loandisbursement.FieldMonth)
+	}
+	if m.addamount != nil {
+		fields = append(fields, loandisbursement.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanDisbursementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loandisbursement.FieldMonth:
+		return m.AddedMonth()
+	case loandisbursement.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanDisbursementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loandisbursement.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonth(v)
+		return nil
+	case loandisbursement.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanDisbursement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanDisbursementMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanDisbursementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanDisbursementMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoanDisbursement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanDisbursementMutation) ResetField(name string) error {
+	switch name {
+	case loandisbursement.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case loandisbursement.FieldMonth:
+		m.ResetMonth()
+		return nil
+	case loandisbursement.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case loandisbursement.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanDisbursement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanDisbursementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.loan != nil {
+		edges = append(edges, loandisbursement.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanDisbursementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loandisbursement.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanDisbursementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanDisbursementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanDisbursementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedloan {
+		edges = append(edges, loandisbursement.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanDisbursementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loandisbursement.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanDisbursementMutation) ClearEdge(name string) error {
+	switch name {
+	case loandisbursement.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanDisbursement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanDisbursementMutation) ResetEdge(name string) error {
+	switch name {
+	case loandisbursement.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanDisbursement edge %s", name)
+}
+
+// LoanModificationMutation represents an operation that mutates the LoanModification nodes in the graph.
+type LoanModificationMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	effective_month        *int
+	addeffective_month     *int
+	rate                   *float64
+	addrate                *float64
+	term                   *int
+	addterm                *int
+	capitalized_arrears    *int
+	addcapitalized_arrears *int
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	loan                   *int
+	clearedloan            bool
+	done                   bool
+	oldValue               func(context.Context) (*LoanModification, error)
+	predicates             []predicate.LoanModification
+}
+
+var _ ent.Mutation = (*LoanModificationMutation)(nil)
+
+// loanmodificationOption allows management of the mutation configuration using functional options.
+type loanmodificationOption func(*LoanModificationMutation)
+
+// newLoanModificationMutation creates new mutation for the LoanModification entity.
+func newLoanModificationMutation(c config, op Op, opts ...loanmodificationOption) *LoanModificationMutation {
+	m := &LoanModificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoanModification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanModificationID sets the ID field of the mutation.
+func withLoanModificationID(id int) loanmodificationOption {
+	return func(m *LoanModificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoanModification
+		)
+		m.oldValue = func(ctx context.Context) (*LoanModification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoanModification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoanModification sets the old LoanModification of the mutation.
+func withLoanModification(node *LoanModification) loanmodificationOption {
+	return func(m *LoanModificationMutation) {
+		m.oldValue = func(context.Context) (*LoanModification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanModificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanModificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanModificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanModificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoanModification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *LoanModificationMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *LoanModificationMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *LoanModificationMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetEffectiveMonth sets the "effective_month" field.
+func (m *LoanModificationMutation) SetEffectiveMonth(i int) {
+	m.effective_month = &i
+	m.addeffective_month = nil
+}
+
+// EffectiveMonth returns the value of the "effective_month" field in the mutation.
+func (m *LoanModificationMutation) EffectiveMonth() (r int, exists bool) {
+	v := m.effective_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveMonth returns the old "effective_month" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldEffectiveMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveMonth: %w", err)
+	}
+	return oldValue.EffectiveMonth, nil
+}
+
+// AddEffectiveMonth adds i to the "effective_month" field.
+func (m *LoanModificationMutation) AddEffectiveMonth(i int) {
+	if m.addeffective_month != nil {
+		*m.addeffective_month += i
+	} else {
+		m.addeffective_month = &i
+	}
+}
+
+// AddedEffectiveMonth returns the value that was added to the "effective_month" field in this mutation.
+func (m *LoanModificationMutation) AddedEffectiveMonth() (r int, exists bool) {
+	v := m.addeffective_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEffectiveMonth resets all changes to the "effective_month" field.
+func (m *LoanModificationMutation) ResetEffectiveMonth() {
+	m.effective_month = nil
+	m.addeffective_month = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *LoanModificationMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *LoanModificationMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *LoanModificationMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *LoanModificationMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *LoanModificationMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetTerm sets the "term" field.
+func (m *LoanModificationMutation) SetTerm(i int) {
+	m.term = &i
+	m.addterm = nil
+}
+
+// Term returns the value of the "term" field in the mutation.
+func (m *LoanModificationMutation) Term() (r int, exists bool) {
+	v := m.term
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTerm returns the old "term" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldTerm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTerm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTerm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTerm: %w", err)
+	}
+	return oldValue.Term, nil
+}
+
+// AddTerm adds i to the "term" field.
+func (m *LoanModificationMutation) AddTerm(i int) {
+	if m.addterm != nil {
+		*m.addterm += i
+	} else {
+		m.addterm = &i
+	}
+}
+
+// AddedTerm returns the value that was added to the "term" field in this mutation.
+func (m *LoanModificationMutation) AddedTerm() (r int, exists bool) {
+	v := m.addterm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTerm resets all changes to the "term" field.
+func (m *LoanModificationMutation) ResetTerm() {
+	m.term = nil
+	m.addterm = nil
+}
+
+// SetCapitalizedArrears sets the "capitalized_arrears" field.
+func (m *LoanModificationMutation) SetCapitalizedArrears(i int) {
+	m.capitalized_arrears = &i
+	m.addcapitalized_arrears = nil
+}
+
+// CapitalizedArrears returns the value of the "capitalized_arrears" field in the mutation.
+func (m *LoanModificationMutation) CapitalizedArrears() (r int, exists bool) {
+	v := m.capitalized_arrears
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapitalizedArrears returns the old "capitalized_arrears" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldCapitalizedArrears(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapitalizedArrears is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapitalizedArrears requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapitalizedArrears: %w", err)
+	}
+	return oldValue.CapitalizedArrears, nil
+}
+
+// AddCapitalizedArrears adds i to the "capitalized_arrears" field.
+func (m *LoanModificationMutation) AddCapitalizedArrears(i int) {
+	if m.addcapitalized_arrears != nil {
+		*m.addcapitalized_arrears += i
+	} else {
+		m.addcapitalized_arrears = &i
+	}
+}
+
+// AddedCapitalizedArrears returns the value that was added to the "capitalized_arrears" field in this mutation.
+func (m *LoanModificationMutation) AddedCapitalizedArrears() (r int, exists bool) {
+	v := m.addcapitalized_arrears
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCapitalizedArrears resets all changes to the "capitalized_arrears" field.
+func (m *LoanModificationMutation) ResetCapitalizedArrears() {
+	m.capitalized_arrears = nil
+	m.addcapitalized_arrears = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoanModificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoanModificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoanModification entity.
+// If the LoanModification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanModificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoanModificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *LoanModificationMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[loanmodification.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *LoanModificationMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *LoanModificationMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *LoanModificationMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the LoanModificationMutation builder.
+func (m *LoanModificationMutation) Where(ps ...predicate.LoanModification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanModificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanModificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoanModification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanModificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanModificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoanModification).
+func (m *LoanModificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanModificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.loan != nil {
+		fields = append(fields, loanmodification.FieldLoanID)
+	}
+	if m.effective_month != nil {
+		fields = append(fields, loanmodification.FieldEffectiveMonth)
+	}
+	if m.rate != nil {
+		fields = append(fields, loanmodification.FieldRate)
+	}
+	if m.term != nil {
+		fields = append(fields, loanmodification.FieldTerm)
+	}
+	if m.capitalized_arrears != nil {
+		fields = append(fields, loanmodification.FieldCapitalizedArrears)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loanmodification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanModificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loanmodification.FieldLoanID:
+		return m.LoanID()
+	case loanmodification.FieldEffectiveMonth:
+		return m.EffectiveMonth()
+	case loanmodification.FieldRate:
+		return m.Rate()
+	case loanmodification.FieldTerm:
+		return m.Term()
+	case loanmodification.FieldCapitalizedArrears:
+		return m.CapitalizedArrears()
+	case loanmodification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanModificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loanmodification.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case loanmodification.FieldEffectiveMonth:
+		return m.OldEffectiveMonth(ctx)
+	case loanmodification.FieldRate:
+		return m.OldRate(ctx)
+	case loanmodification.FieldTerm:
+		return m.OldTerm(ctx)
+	case loanmodification.FieldCapitalizedArrears:
+		return m.OldCapitalizedArrears(ctx)
+	case loanmodification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoanModification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanModificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loanmodification.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case loanmodification.FieldEffectiveMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveMonth(v)
+		return nil
+	case loanmodification.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case loanmodification.FieldTerm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTerm(v)
+		return nil
+	case loanmodification.FieldCapitalizedArrears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapitalizedArrears(v)
+		return nil
+	case loanmodification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanModification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanModificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addeffective_month != nil {
+		fields = append(fields, loanmodification.FieldEffectiveMonth)
+	}
+	if m.addrate != nil {
+		fields = append(fields, loanmodification.FieldRate)
+	}
+	if m.addterm != nil {
+		fields = append(fields, loanmodification.FieldTerm)
+	}
+	if m.addcapitalized_arrears != nil {
+		fields = append(fields, loanmodification.FieldCapitalizedArrears)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanModificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loanmodification.FieldEffectiveMonth:
+		return m.AddedEffectiveMonth()
+	case loanmodification.FieldRate:
+		return m.AddedRate()
+	case loanmodification.FieldTerm:
+		return m.AddedTerm()
+	case loanmodification.FieldCapitalizedArrears:
+		return m.AddedCapitalizedArrears()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanModificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loanmodification.FieldEffectiveMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEffectiveMonth(v)
+		return nil
+	case loanmodification.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case loanmodification.FieldTerm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTerm(v)
+		return nil
+	case loanmodification.FieldCapitalizedArrears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapitalizedArrears(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanModification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanModificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanModificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanModificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoanModification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanModificationMutation) ResetField(name string) error {
+	switch name {
+	case loanmodification.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case loanmodification.FieldEffectiveMonth:
+		m.ResetEffectiveMonth()
+		return nil
+	case loanmodification.FieldRate:
+		m.ResetRate()
+		return nil
+	case loanmodification.FieldTerm:
+		m.ResetTerm()
+		return nil
+	case loanmodification.FieldCapitalizedArrears:
+		m.ResetCapitalizedArrears()
+		return nil
+	case loanmodification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanModification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanModificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.loan != nil {
+		edges = append(edges, loanmodification.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanModificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loanmodification.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanModificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanModificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanModificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedloan {
+		edges = append(edges, loanmodification.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanModificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loanmodification.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanModificationMutation) ClearEdge(name string) error {
+	switch name {
+	case loanmodification.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanModification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanModificationMutation) ResetEdge(name string) error {
+	switch name {
+	case loanmodification.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanModification edge %s", name)
+}
+
+// LoanObligorMutation represents an operation that mutates the LoanObligor nodes in the graph.
+type LoanObligorMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	role                 *loanobligor.Role
+	liability            *loanobligor.Liability
+	liability_percent    *float64
+	addliability_percent *float64
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	loan                 *int
+	clearedloan          bool
+	user                 *int
+	cleareduser          bool
+	done                 bool
+	oldValue             func(context.Context) (*LoanObligor, error)
+	predicates           []predicate.LoanObligor
+}
+
+var _ ent.Mutation = (*LoanObligorMutation)(nil)
+
+// loanobligorOption allows management of the mutation configuration using functional options.
+type loanobligorOption func(*LoanObligorMutation)
+
+// newLoanObligorMutation creates new mutation for the LoanObligor entity.
+func newLoanObligorMutation(c config, op Op, opts ...loanobligorOption) *LoanObligorMutation {
+	m := &LoanObligorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoanObligor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanObligorID sets the ID field of the mutation.
+func withLoanObligorID(id int) loanobligorOption {
+	return func(m *LoanObligorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoanObligor
+		)
+		m.oldValue = func(ctx context.Context) (*LoanObligor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoanObligor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoanObligor sets the old LoanObligor of the mutation.
+func withLoanObligor(node *LoanObligor) loanobligorOption {
+	return func(m *LoanObligorMutation) {
+		m.oldValue = func(context.Context) (*LoanObligor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanObligorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanObligorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanObligorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanObligorMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoanObligor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *LoanObligorMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *LoanObligorMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *LoanObligorMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LoanObligorMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LoanObligorMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LoanObligorMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *LoanObligorMutation) SetRole(l loanobligor.Role) {
+	m.role = &l
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *LoanObligorMutation) Role() (r loanobligor.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldRole(ctx context.Context) (v loanobligor.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *LoanObligorMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetLiability sets the "liability" field.
+func (m *LoanObligorMutation) SetLiability(l loanobligor.Liability) {
+	m.liability = &l
+}
+
+// Liability returns the value of the "liability" field in the mutation.
+func (m *LoanObligorMutation) Liability() (r loanobligor.Liability, exists bool) {
+	v := m.liability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiability returns the old "liability" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldLiability(ctx context.Context) (v loanobligor.Liability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiability: %w", err)
+	}
+	return oldValue.Liability, nil
+}
+
+// ResetLiability resets all changes to the "liability" field.
+func (m *LoanObligorMutation) ResetLiability() {
+	m.liability = nil
+}
+
+// SetLiabilityPercent sets the "liability_percent" field.
+func (m *LoanObligorMutation) SetLiabilityPercent(f float64) {
+	m.liability_percent = &f
+	m.addliability_percent = nil
+}
+
+// LiabilityPercent returns the value of the "liability_percent" field in the mutation.
+func (m *LoanObligorMutation) LiabilityPercent() (r float64, exists bool) {
+	v := m.liability_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiabilityPercent returns the old "liability_percent" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldLiabilityPercent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiabilityPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiabilityPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiabilityPercent: %w", err)
+	}
+	return oldValue.LiabilityPercent, nil
+}
+
+// AddLiabilityPercent adds f to the "liability_percent" field.
+func (m *LoanObligorMutation) AddLiabilityPercent(f float64) {
+	if m.addliability_percent != nil {
+		*m.addliability_percent += f
+	} else {
+		m.addliability_percent = &f
+	}
+}
+
+// AddedLiabilityPercent returns the value that was added to the "liability_percent" field in this mutation.
+func (m *LoanObligorMutation) AddedLiabilityPercent() (r float64, exists bool) {
+	v := m.addliability_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLiabilityPercent clears the value of the "liability_percent" field.
+func (m *LoanObligorMutation) ClearLiabilityPercent() {
+	m.liability_percent = nil
+	m.addliability_percent = nil
+	m.clearedFields[loanobligor.FieldLiabilityPercent] = struct{}{}
+}
+
+// LiabilityPercentCleared returns if the "liability_percent" field was cleared in this mutation.
+func (m *LoanObligorMutation) LiabilityPercentCleared() bool {
+	_, ok := m.clearedFields[loanobligor.FieldLiabilityPercent]
+	return ok
+}
+
+// ResetLiabilityPercent resets all changes to the "liability_percent" field.
+func (m *LoanObligorMutation) ResetLiabilityPercent() {
+	m.liability_percent = nil
+	m.addliability_percent = nil
+	delete(m.clearedFields, loanobligor.FieldLiabilityPercent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoanObligorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoanObligorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoanObligor entity.
+// If the LoanObligor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanObligorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoanObligorMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *LoanObligorMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[loanobligor.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *LoanObligorMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *LoanObligorMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *LoanObligorMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LoanObligorMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[loanobligor.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LoanObligorMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LoanObligorMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LoanObligorMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LoanObligorMutation builder.
+func (m *LoanObligorMutation) Where(ps ...predicate.LoanObligor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanObligorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanObligorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoanObligor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanObligorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanObligorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoanObligor).
+func (m *LoanObligorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanObligorMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.loan != nil {
+		fields = append(fields, loanobligor.FieldLoanID)
+	}
+	if m.user != nil {
+		fields = append(fields, loanobligor.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, loanobligor.FieldRole)
+	}
+	if m.liability != nil {
+		fields = append(fields, loanobligor.FieldLiability)
+	}
+	if m.liability_percent != nil {
+		fields = append(fields, loanobligor.FieldLiabilityPercent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loanobligor.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanObligorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loanobligor.FieldLoanID:
+		return m.LoanID()
+	case loanobligor.FieldUserID:
+		return m.UserID()
+	case loanobligor.FieldRole:
+		return m.Role()
+	case loanobligor.FieldLiability:
+		return m.Liability()
+	case loanobligor.FieldLiabilityPercent:
+		return m.LiabilityPercent()
+	case loanobligor.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanObligorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loanobligor.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case loanobligor.FieldUserID:
+		return m.OldUserID(ctx)
+	case loanobligor.FieldRole:
+		return m.OldRole(ctx)
+	case loanobligor.FieldLiability:
+		return m.OldLiability(ctx)
+	case loanobligor.FieldLiabilityPercent:
+		return m.OldLiabilityPercent(ctx)
+	case loanobligor.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoanObligor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanObligorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loanobligor.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case loanobligor.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case loanobligor.FieldRole:
+		v, ok := value.(loanobligor.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case loanobligor.FieldLiability:
+		v, ok := value.(loanobligor.Liability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiability(v)
+		return nil
+	case loanobligor.FieldLiabilityPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiabilityPercent(v)
+		return nil
+	case loanobligor.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanObligorMutation) AddedFields() []string {
+	var fields []string
+	if m.addliability_percent != nil {
+		fields = append(fields, loanobligor.FieldLiabilityPercent)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanObligorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loanobligor.FieldLiabilityPercent:
+		return m.AddedLiabilityPercent()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanObligorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loanobligor.FieldLiabilityPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLiabilityPercent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanObligorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loanobligor.FieldLiabilityPercent) {
+		fields = append(fields, loanobligor.FieldLiabilityPercent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanObligorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanObligorMutation) ClearField(name string) error {
+	switch name {
+	case loanobligor.FieldLiabilityPercent:
+		m.ClearLiabilityPercent()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanObligorMutation) ResetField(name string) error {
+	switch name {
+	case loanobligor.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case loanobligor.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case loanobligor.FieldRole:
+		m.ResetRole()
+		return nil
+	case loanobligor.FieldLiability:
+		m.ResetLiability()
+		return nil
+	case loanobligor.FieldLiabilityPercent:
+		m.ResetLiabilityPercent()
+		return nil
+	case loanobligor.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanObligorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.loan != nil {
+		edges = append(edges, loanobligor.EdgeLoan)
+	}
+	if m.user != nil {
+		edges = append(edges, loanobligor.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanObligorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loanobligor.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	case loanobligor.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanObligorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanObligorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanObligorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedloan {
+		edges = append(edges, loanobligor.EdgeLoan)
+	}
+	if m.cleareduser {
+		edges = append(edges, loanobligor.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanObligorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loanobligor.EdgeLoan:
+		return m.clearedloan
+	case loanobligor.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanObligorMutation) ClearEdge(name string) error {
+	switch name {
+	case loanobligor.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	case loanobligor.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanObligorMutation) ResetEdge(name string) error {
+	switch name {
+	case loanobligor.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	case loanobligor.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanObligor edge %s", name)
+}
+
+// LoanRecastMutation represents an operation that mutates the LoanRecast nodes in the graph.
+type LoanRecastMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	month          *int
+	addmonth       *int
+	curtailment    *int
+	addcurtailment *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	loan           *int
+	clearedloan    bool
+	done           bool
+	oldValue       func(context.Context) (*LoanRecast, error)
+	predicates     []predicate.LoanRecast
+}
+
+var _ ent.Mutation = (*LoanRecastMutation)(nil)
+
+// loanrecastOption allows management of the mutation configuration using functional options.
+type loanrecastOption func(*LoanRecastMutation)
+
+// newLoanRecastMutation creates new mutation for the LoanRecast entity.
+func newLoanRecastMutation(c config, op Op, opts ...loanrecastOption) *LoanRecastMutation {
+	m := &LoanRecastMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoanRecast,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanRecastID sets the ID field of the mutation.
+func withLoanRecastID(id int) loanrecastOption {
+	return func(m *LoanRecastMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoanRecast
+		)
+		m.oldValue = func(ctx context.Context) (*LoanRecast, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoanRecast.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoanRecast sets the old LoanRecast of the mutation.
+func withLoanRecast(node *LoanRecast) loanrecastOption {
+	return func(m *LoanRecastMutation) {
+		m.oldValue = func(context.Context) (*LoanRecast, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanRecastMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanRecastMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanRecastMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanRecastMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoanRecast.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *LoanRecastMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *LoanRecastMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the LoanRecast entity.
+// If the LoanRecast object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanRecastMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *LoanRecastMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetMonth sets the "month" field.
+func (m *LoanRecastMutation) SetMonth(i int) {
+	m.month = &i
+	m.addmonth = nil
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *LoanRecastMutation) Month() (r int, exists bool) {
+	v := m.month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonth returns the old "month" field's value of the LoanRecast entity.
+// If the LoanRecast object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanRecastMutation) OldMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+	}
+	return oldValue.Month, nil
+}
+
+// AddMonth adds i to the "month" field.
+func (m *LoanRecastMutation) AddMonth(i int) {
+	if m.addmonth != nil {
+		*m.addmonth += i
+	} else {
+		m.addmonth = &i
+	}
+}
+
+// AddedMonth returns the value that was added to the "month" field in this mutation.
+func (m *LoanRecastMutation) AddedMonth() (r int, exists bool) {
+	v := m.addmonth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonth resets all changes to the "month" field.
+func (m *LoanRecastMutation) ResetMonth() {
+	m.month = nil
+	m.addmonth = nil
+}
+
+// SetCurtailment sets the "curtailment" field.
+func (m *LoanRecastMutation) SetCurtailment(i int) {
+	m.curtailment = &i
+	m.addcurtailment = nil
+}
+
+// Curtailment returns the value of the "curtailment" field in the mutation.
+func (m *LoanRecastMutation) Curtailment() (r int, exists bool) {
+	v := m.curtailment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurtailment returns the old "curtailment" field's value of the LoanRecast entity.
+// If the LoanRecast object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanRecastMutation) OldCurtailment(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurtailment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurtailment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurtailment: %w", err)
+	}
+	return oldValue.Curtailment, nil
+}
+
+// AddCurtailment adds i to the "curtailment" field.
+func (m *LoanRecastMutation) AddCurtailment(i int) {
+	if m.addcurtailment != nil {
+		*m.addcurtailment += i
+	} else {
+		m.addcurtailment = &i
+	}
+}
+
+// AddedCurtailment returns the value that was added to the "curtailment" field in this mutation.
+func (m *LoanRecastMutation) AddedCurtailment() (r int, exists bool) {
+	v := m.addcurtailment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurtailment resets all changes to the "curtailment" field.
+func (m *LoanRecastMutation) ResetCurtailment() {
+	m.curtailment = nil
+	m.addcurtailment = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoanRecastMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoanRecastMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoanRecast entity.
+// If the LoanRecast object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanRecastMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoanRecastMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *LoanRecastMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[loanrecast.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *LoanRecastMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *LoanRecastMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *LoanRecastMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the LoanRecastMutation builder.
+func (m *LoanRecastMutation) Where(ps ...predicate.LoanRecast) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanRecastMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanRecastMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoanRecast, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanRecastMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanRecastMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoanRecast).
+func (m *LoanRecastMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanRecastMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.loan != nil {
+		fields = append(fields, loanrecast.FieldLoanID)
+	}
+	if m.month != nil {
+		fields = append(fields, loanrecast.FieldMonth)
+	}
+	if m.curtailment != nil {
+		fields = append(fields, loanrecast.FieldCurtailment)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loanrecast.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanRecastMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loanrecast.FieldLoanID:
+		return m.LoanID()
+	case loanrecast.FieldMonth:
+		return m.Month()
+	case loanrecast.FieldCurtailment:
+		return m.Curtailment()
+	case loanrecast.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanRecastMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loanrecast.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case loanrecast.FieldMonth:
+		return m.OldMonth(ctx)
+	case loanrecast.FieldCurtailment:
+		return m.OldCurtailment(ctx)
+	case loanrecast.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoanRecast field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanRecastMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loanrecast.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case loanrecast.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonth(v)
+		return nil
+	case loanrecast.FieldCurtailment:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurtailment(v)
+		return nil
+	case loanrecast.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanRecast field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanRecastMutation) AddedFields() []string {
+	var fields []string
+	if m.addmonth != nil {
+		fields = append(fields, loanrecast.FieldMonth)
+	}
+	if m.addcurtailment != nil {
+		fields = append(fields, loanrecast.FieldCurtailment)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanRecastMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loanrecast.FieldMonth:
+		return m.AddedMonth()
+	case loanrecast.FieldCurtailment:
+		return m.AddedCurtailment()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanRecastMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loanrecast.FieldMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonth(v)
+		return nil
+	case loanrecast.FieldCurtailment:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurtailment(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoanRecast numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanRecastMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanRecastMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanRecastMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoanRecast nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanRecastMutation) ResetField(name string) error {
+	switch name {
+	case loanrecast.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case loanrecast.FieldMonth:
+		m.ResetMonth()
+		return nil
+	case loanrecast.FieldCurtailment:
+		m.ResetCurtailment()
+		return nil
+	case loanrecast.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanRecast field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanRecastMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.loan != nil {
+		edges = append(edges, loanrecast.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanRecastMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loanrecast.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanRecastMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanRecastMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanRecastMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedloan {
+		edges = append(edges, loanrecast.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanRecastMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loanrecast.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanRecastMutation) ClearEdge(name string) error {
+	switch name {
+	case loanrecast.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanRecast unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanRecastMutation) ResetEdge(name string) error {
+	switch name {
+	case loanrecast.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown LoanRecast edge %s", name)
+}
+
+// OidcLoginMutation represents an operation that mutates the OidcLogin nodes in the graph.
+type OidcLoginMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	state         *string
+	nonce         *string
+	code_verifier *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*OidcLogin, error)
+	predicates    []predicate.OidcLogin
+}
+
+var _ ent.Mutation = (*OidcLoginMutation)(nil)
+
+// oidcloginOption allows management of the mutation configuration using functional options.
+type oidcloginOption func(*OidcLoginMutation)
+
+// newOidcLoginMutation creates new mutation for the OidcLogin entity.
+func newOidcLoginMutation(c config, op Op, opts ...oidcloginOption) *OidcLoginMutation {
+	m := &OidcLoginMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOidcLogin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOidcLoginID sets the ID field of the mutation.
+func withOidcLoginID(id int) oidcloginOption {
+	return func(m *OidcLoginMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OidcLogin
+		)
+		m.oldValue = func(ctx context.Context) (*OidcLogin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OidcLogin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOidcLogin sets the old OidcLogin of the mutation.
+func withOidcLogin(node *OidcLogin) oidcloginOption {
+	return func(m *OidcLoginMutation) {
+		m.oldValue = func(context.Context) (*OidcLogin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OidcLoginMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OidcLoginMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OidcLoginMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OidcLoginMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OidcLogin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *OidcLoginMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *OidcLoginMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the OidcLogin entity.
+// If the OidcLogin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcLoginMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *OidcLoginMutation) ResetState() {
+	m.state = nil
+}
+
+// SetNonce sets the "nonce" field.
+func (m *OidcLoginMutation) SetNonce(s string) {
+	m.nonce = &s
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *OidcLoginMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonce returns the old "nonce" field's value of the OidcLogin entity.
+// If the OidcLogin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcLoginMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
+}
+
+// ResetNonce resets all changes to the "nonce" field.
+func (m *OidcLoginMutation) ResetNonce() {
+	m.nonce = nil
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (m *OidcLoginMutation) SetCodeVerifier(s string) {
+	m.code_verifier = &s
+}
+
+// CodeVerifier returns the value of the "code_verifier" field in the mutation.
+func (m *OidcLoginMutation) CodeVerifier() (r string, exists bool) {
+	v := m.code_verifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeVerifier returns the old "code_verifier" field's value of the OidcLogin entity.
+// If the OidcLogin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcLoginMutation) OldCodeVerifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeVerifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeVerifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeVerifier: %w", err)
+	}
+	return oldValue.CodeVerifier, nil
+}
+
+// ResetCodeVerifier resets all changes to the "code_verifier" field.
+func (m *OidcLoginMutation) ResetCodeVerifier() {
+	m.code_verifier = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *OidcLoginMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *OidcLoginMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the OidcLogin entity.
+// If the OidcLogin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OidcLoginMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *OidcLoginMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the OidcLoginMutation builder.
+func (m *OidcLoginMutation) Where(ps ...predicate.OidcLogin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OidcLoginMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OidcLoginMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OidcLogin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OidcLoginMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OidcLoginMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OidcLogin).
+func (m *OidcLoginMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OidcLoginMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.state != nil {
+		fields = append(fields, oidclogin.FieldState)
+	}
+	if m.nonce != nil {
+		fields = append(fields, oidclogin.FieldNonce)
+	}
+	if m.code_verifier != nil {
+		fields = append(fields, oidclogin.FieldCodeVerifier)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, oidclogin.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OidcLoginMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oidclogin.FieldState:
+		return m.State()
+	case oidclogin.FieldNonce:
+		return m.Nonce()
+	case oidclogin.FieldCodeVerifier:
+		return m.CodeVerifier()
+	case oidclogin.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OidcLoginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oidclogin.FieldState:
+		return m.OldState(ctx)
+	case oidclogin.FieldNonce:
+		return m.OldNonce(ctx)
+	case oidclogin.FieldCodeVerifier:
+		return m.OldCodeVerifier(ctx)
+	case oidclogin.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OidcLogin field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcLoginMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oidclogin.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case oidclogin.FieldNonce:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case oidclogin.FieldCodeVerifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeVerifier(v)
+		return nil
+	case oidclogin.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OidcLogin field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OidcLoginMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OidcLoginMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OidcLoginMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OidcLogin numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OidcLoginMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OidcLoginMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OidcLoginMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OidcLogin nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OidcLoginMutation) ResetField(name string) error {
+	switch name {
+	case oidclogin.FieldState:
+		m.ResetState()
+		return nil
+	case oidclogin.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case oidclogin.FieldCodeVerifier:
+		m.ResetCodeVerifier()
+		return nil
+	case oidclogin.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OidcLogin field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OidcLoginMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OidcLoginMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OidcLoginMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OidcLoginMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OidcLoginMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OidcLoginMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OidcLoginMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OidcLogin unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OidcLoginMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OidcLogin edge %s", name)
+}
+
+// PaymentDeferralMutation represents an operation that mutates the PaymentDeferral nodes in the graph.
+type PaymentDeferralMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	kind           *paymentdeferral.Kind
+	start_month    *int
+	addstart_month *int
+	months         *int
+	addmonths      *int
+	interest       *paymentdeferral.Interest
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	loan           *int
+	clearedloan    bool
+	done           bool
+	oldValue       func(context.Context) (*PaymentDeferral, error)
+	predicates     []predicate.PaymentDeferral
+}
+
+var _ ent.Mutation = (*PaymentDeferralMutation)(nil)
+
+// paymentdeferralOption allows management of the mutation configuration using functional options.
+type paymentdeferralOption func(*PaymentDeferralMutation)
+
+// newPaymentDeferralMutation creates new mutation for the PaymentDeferral entity.
+func newPaymentDeferralMutation(c config, op Op, opts ...paymentdeferralOption) *PaymentDeferralMutation {
+	m := &PaymentDeferralMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaymentDeferral,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentDeferralID sets the ID field of the mutation.
+func withPaymentDeferralID(id int) paymentdeferralOption {
+	return func(m *PaymentDeferralMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaymentDeferral
+		)
+		m.oldValue = func(ctx context.Context) (*PaymentDeferral, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaymentDeferral.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaymentDeferral sets the old PaymentDeferral of the mutation.
+func withPaymentDeferral(node *PaymentDeferral) paymentdeferralOption {
+	return func(m *PaymentDeferralMutation) {
+		m.oldValue = func(context.Context) (*PaymentDeferral, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentDeferralMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentDeferralMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentDeferralMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentDeferralMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaymentDeferral.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *PaymentDeferralMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *PaymentDeferralMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *PaymentDeferralMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *PaymentDeferralMutation) SetKind(pa paymentdeferral.Kind) {
+	m.kind = &pa
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *PaymentDeferralMutation) Kind() (r paymentdeferral.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldKind(ctx context.Context) (v paymentdeferral.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *PaymentDeferralMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetStartMonth sets the "start_month" field.
+func (m *PaymentDeferralMutation) SetStartMonth(i int) {
+	m.start_month = &i
+	m.addstart_month = nil
+}
+
+// StartMonth returns the value of the "start_month" field in the mutation.
+func (m *PaymentDeferralMutation) StartMonth() (r int, exists bool) {
+	v := m.start_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartMonth returns the old "start_month" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldStartMonth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartMonth: %w", err)
+	}
+	return oldValue.StartMonth, nil
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (m *PaymentDeferralMutation) AddStartMonth(i int) {
+	if m.addstart_month != nil {
+		*m.addstart_month += i
+	} else {
+		m.addstart_month = &i
+	}
+}
+
+// AddedStartMonth returns the value that was added to the "start_month" field in this mutation.
+func (m *PaymentDeferralMutation) AddedStartMonth() (r int, exists bool) {
+	v := m.addstart_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartMonth resets all changes to the "start_month" field.
+func (m *PaymentDeferralMutation) ResetStartMonth() {
+	m.start_month = nil
+	m.addstart_month = nil
+}
+
+// SetMonths sets the "months" field.
+func (m *PaymentDeferralMutation) SetMonths(i int) {
+	m.months = &i
+	m.addmonths = nil
+}
+
+// Months returns the value of the "months" field in the mutation.
+func (m *PaymentDeferralMutation) Months() (r int, exists bool) {
+	v := m.months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonths returns the old "months" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonths: %w", err)
+	}
+	return oldValue.Months, nil
+}
+
+// AddMonths adds i to the "months" field.
+func (m *PaymentDeferralMutation) AddMonths(i int) {
+	if m.addmonths != nil {
+		*m.addmonths += i
+	} else {
+		m.addmonths = &i
+	}
+}
+
+// AddedMonths returns the value that was added to the "months" field in this mutation.
+func (m *PaymentDeferralMutation) AddedMonths() (r int, exists bool) {
+	v := m.addmonths
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonths resets all changes to the "months" field.
+func (m *PaymentDeferralMutation) ResetMonths() {
+	m.months = nil
+	m.addmonths = nil
+}
+
+// SetInterest sets the "interest" field.
+func (m *PaymentDeferralMutation) SetInterest(pa paymentdeferral.Interest) {
+	m.interest = &pa
+}
+
+// Interest returns the value of the "interest" field in the mutation.
+func (m *PaymentDeferralMutation) Interest() (r paymentdeferral.Interest, exists bool) {
+	v := m.interest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterest returns the old "interest" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldInterest(ctx context.Context) (v paymentdeferral.Interest, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterest: %w", err)
+	}
+	return oldValue.Interest, nil
+}
+
+// ResetInterest resets all changes to the "interest" field.
+func (m *PaymentDeferralMutation) ResetInterest() {
+	m.interest = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentDeferralMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentDeferralMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PaymentDeferral entity.
+// If the PaymentDeferral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentDeferralMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentDeferralMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *PaymentDeferralMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[paymentdeferral.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *PaymentDeferralMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *PaymentDeferralMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *PaymentDeferralMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the PaymentDeferralMutation builder.
+func (m *PaymentDeferralMutation) Where(ps ...predicate.PaymentDeferral) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentDeferralMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentDeferralMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentDeferral, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentDeferralMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaymentDeferralMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaymentDeferral).
+func (m *PaymentDeferralMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentDeferralMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.loan != nil {
+		fields = append(fields, paymentdeferral.FieldLoanID)
+	}
+	if m.kind != nil {
+		fields = append(fields, paymentdeferral.FieldKind)
+	}
+	if m.start_month != nil {
+		fields = append(fields, paymentdeferral.FieldStartMonth)
+	}
+	if m.months != nil {
+		fields = append(fields, paymentdeferral.FieldMonths)
+	}
+	if m.interest != nil {
+		fields = append(fields, paymentdeferral.FieldInterest)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paymentdeferral.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentDeferralMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case paymentdeferral.FieldLoanID:
+		return m.LoanID()
+	case paymentdeferral.FieldKind:
+		return m.Kind()
+	case paymentdeferral.FieldStartMonth:
+		return m.StartMonth()
+	case paymentdeferral.FieldMonths:
+		return m.Months()
+	case paymentdeferral.FieldInterest:
+		return m.Interest()
+	case paymentdeferral.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentDeferralMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case paymentdeferral.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case paymentdeferral.FieldKind:
+		return m.OldKind(ctx)
+	case paymentdeferral.FieldStartMonth:
+		return m.OldStartMonth(ctx)
+	case paymentdeferral.FieldMonths:
+		return m.OldMonths(ctx)
+	case paymentdeferral.FieldInterest:
+		return m.OldInterest(ctx)
+	case paymentdeferral.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaymentDeferral field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentDeferralMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case paymentdeferral.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case paymentdeferral.FieldKind:
+		v, ok := value.(paymentdeferral.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case paymentdeferral.FieldStartMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartMonth(v)
+		return nil
+	case paymentdeferral.FieldMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonths(v)
+		return nil
+	case paymentdeferral.FieldInterest:
+		v, ok := value.(paymentdeferral.Interest)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterest(v)
+		return nil
+	case paymentdeferral.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentDeferral field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentDeferralMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_month != nil {
+		fields = append(fields, paymentdeferral.FieldStartMonth)
+	}
+	if m.addmonths != nil {
+		fields = append(fields, paymentdeferral.FieldMonths)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentDeferralMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case paymentdeferral.FieldStartMonth:
+		return m.AddedStartMonth()
+	case paymentdeferral.FieldMonths:
+		return m.AddedMonths()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentDeferralMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case paymentdeferral.FieldStartMonth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartMonth(v)
+		return nil
+	case paymentdeferral.FieldMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonths(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentDeferral numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentDeferralMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentDeferralMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentDeferralMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PaymentDeferral nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentDeferralMutation) ResetField(name string) error {
+	switch name {
+	case paymentdeferral.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case paymentdeferral.FieldKind:
+		m.ResetKind()
+		return nil
+	case paymentdeferral.FieldStartMonth:
+		m.ResetStartMonth()
+		return nil
+	case paymentdeferral.FieldMonths:
+		m.ResetMonths()
+		return nil
+	case paymentdeferral.FieldInterest:
+		m.ResetInterest()
+		return nil
+	case paymentdeferral.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentDeferral field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentDeferralMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.loan != nil {
+		edges = append(edges, paymentdeferral.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentDeferralMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case paymentdeferral.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentDeferralMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentDeferralMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentDeferralMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedloan {
+		edges = append(edges, paymentdeferral.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentDeferralMutation) EdgeCleared(name string) bool {
+	switch name {
+	case paymentdeferral.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentDeferralMutation) ClearEdge(name string) error {
+	switch name {
+	case paymentdeferral.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentDeferral unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentDeferralMutation) ResetEdge(name string) error {
+	switch name {
+	case paymentdeferral.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown PaymentDeferral edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	expires_at    *time.Time
+	revoked_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// ShareInvitationMutation represents an operation that mutates the ShareInvitation nodes in the graph.
+type ShareInvitationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	permission    *shareinvitation.Permission
+	status        *shareinvitation.Status
+	expires_at    *time.Time
+	responded_at  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	loan          *int
+	clearedloan   bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ShareInvitation, error)
+	predicates    []predicate.ShareInvitation
+}
+
+var _ ent.Mutation = (*ShareInvitationMutation)(nil)
+
+// shareinvitationOption allows management of the mutation configuration using functional options.
+type shareinvitationOption func(*ShareInvitationMutation)
+
+// newShareInvitationMutation creates new mutation for the ShareInvitation entity.
+func newShareInvitationMutation(c config, op Op, opts ...shareinvitationOption) *ShareInvitationMutation {
+	m := &ShareInvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareInvitationID sets the ID field of the mutation.
+func withShareInvitationID(id int) shareinvitationOption {
+	return func(m *ShareInvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareInvitation
+		)
+		m.oldValue = func(ctx context.Context) (*ShareInvitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareInvitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareInvitation sets the old ShareInvitation of the mutation.
+func withShareInvitation(node *ShareInvitation) shareinvitationOption {
+	return func(m *ShareInvitationMutation) {
+		m.oldValue = func(context.Context) (*ShareInvitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareInvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareInvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareInvitationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareInvitationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareInvitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *ShareInvitationMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *ShareInvitationMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *ShareInvitationMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ShareInvitationMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ShareInvitationMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ShareInvitationMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *ShareInvitationMutation) SetPermission(s shareinvitation.Permission) {
+	m.permission = &s
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *ShareInvitationMutation) Permission() (r shareinvitation.Permission, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldPermission(ctx context.Context) (v shareinvitation.Permission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *ShareInvitationMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ShareInvitationMutation) SetStatus(s shareinvitation.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ShareInvitationMutation) Status() (r shareinvitation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldStatus(ctx context.Context) (v shareinvitation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ShareInvitationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ShareInvitationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ShareInvitationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ShareInvitationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *ShareInvitationMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *ShareInvitationMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldRespondedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *ShareInvitationMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[shareinvitation.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *ShareInvitationMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[shareinvitation.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *ShareInvitationMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, shareinvitation.FieldRespondedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShareInvitationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShareInvitationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ShareInvitation entity.
+// If the ShareInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareInvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShareInvitationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *ShareInvitationMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[shareinvitation.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *ShareInvitationMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *ShareInvitationMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *ShareInvitationMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ShareInvitationMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[shareinvitation.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ShareInvitationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ShareInvitationMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ShareInvitationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ShareInvitationMutation builder.
+func (m *ShareInvitationMutation) Where(ps ...predicate.ShareInvitation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareInvitationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareInvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareInvitation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareInvitationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareInvitationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareInvitation).
+func (m *ShareInvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareInvitationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.loan != nil {
+		fields = append(fields, shareinvitation.FieldLoanID)
+	}
+	if m.user != nil {
+		fields = append(fields, shareinvitation.FieldUserID)
+	}
+	if m.permission != nil {
+		fields = append(fields, shareinvitation.FieldPermission)
+	}
+	if m.status != nil {
+		fields = append(fields, shareinvitation.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, shareinvitation.FieldExpiresAt)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, shareinvitation.FieldRespondedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, shareinvitation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareInvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shareinvitation.FieldLoanID:
+		return m.LoanID()
+	case shareinvitation.FieldUserID:
+		return m.UserID()
+	case shareinvitation.FieldPermission:
+		return m.Permission()
+	case shareinvitation.FieldStatus:
+		return m.Status()
+	case shareinvitation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case shareinvitation.FieldRespondedAt:
+		return m.RespondedAt()
+	case shareinvitation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareInvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shareinvitation.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case shareinvitation.FieldUserID:
+		return m.OldUserID(ctx)
+	case shareinvitation.FieldPermission:
+		return m.OldPermission(ctx)
+	case shareinvitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case shareinvitation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case shareinvitation.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case shareinvitation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareInvitation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareInvitationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shareinvitation.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case shareinvitation.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case shareinvitation.FieldPermission:
+		v, ok := value.(shareinvitation.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	case shareinvitation.FieldStatus:
+		v, ok := value.(shareinvitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case shareinvitation.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case shareinvitation.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case shareinvitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareInvitation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareInvitationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareInvitationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareInvitationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ShareInvitation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareInvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shareinvitation.FieldRespondedAt) {
+		fields = append(fields, shareinvitation.FieldRespondedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareInvitationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareInvitationMutation) ClearField(name string) error {
+	switch name {
+	case shareinvitation.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareInvitation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareInvitationMutation) ResetField(name string) error {
+	switch name {
+	case shareinvitation.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case shareinvitation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case shareinvitation.FieldPermission:
+		m.ResetPermission()
+		return nil
+	case shareinvitation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case shareinvitation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case shareinvitation.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case shareinvitation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareInvitation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareInvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.loan != nil {
+		edges = append(edges, shareinvitation.EdgeLoan)
+	}
+	if m.user != nil {
+		edges = append(edges, shareinvitation.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareInvitationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case shareinvitation.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	case shareinvitation.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareInvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareInvitationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareInvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedloan {
+		edges = append(edges, shareinvitation.EdgeLoan)
+	}
+	if m.cleareduser {
+		edges = append(edges, shareinvitation.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareInvitationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case shareinvitation.EdgeLoan:
+		return m.clearedloan
+	case shareinvitation.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareInvitationMutation) ClearEdge(name string) error {
+	switch name {
+	case shareinvitation.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	case shareinvitation.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareInvitation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareInvitationMutation) ResetEdge(name string) error {
+	switch name {
+	case shareinvitation.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	case shareinvitation.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareInvitation edge %s", name)
+}
+
+// ShareLinkMutation represents an operation that mutates the ShareLink nodes in the graph.
+type ShareLinkMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	description     *string
+	expires_at      *time.Time
+	revoked_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	loan            *int
+	clearedloan     bool
+	accesses        map[int]struct{}
+	removedaccesses map[int]struct{}
+	clearedaccesses bool
+	done            bool
+	oldValue        func(context.Context) (*ShareLink, error)
+	predicates      []predicate.ShareLink
+}
+
+var _ ent.Mutation = (*ShareLinkMutation)(nil)
+
+// sharelinkOption allows management of the mutation configuration using functional options.
+type sharelinkOption func(*ShareLinkMutation)
+
+// newShareLinkMutation creates new mutation for the ShareLink entity.
+func newShareLinkMutation(c config, op Op, opts ...sharelinkOption) *ShareLinkMutation {
+	m := &ShareLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareLinkID sets the ID field of the mutation.
+func withShareLinkID(id int) sharelinkOption {
+	return func(m *ShareLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareLink
+		)
+		m.oldValue = func(ctx context.Context) (*ShareLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareLink sets the old ShareLink of the mutation.
+func withShareLink(node *ShareLink) sharelinkOption {
+	return func(m *ShareLinkMutation) {
+		m.oldValue = func(context.Context) (*ShareLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareLinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareLinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *ShareLinkMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *ShareLinkMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *ShareLinkMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ShareLinkMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ShareLinkMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ShareLinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[sharelink.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ShareLinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ShareLinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, sharelink.FieldDescription)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ShareLinkMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ShareLinkMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ShareLinkMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ShareLinkMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ShareLinkMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ShareLinkMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[sharelink.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ShareLinkMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[sharelink.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ShareLinkMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, sharelink.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShareLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShareLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ShareLink entity.
+// If the ShareLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShareLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *ShareLinkMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[sharelink.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *ShareLinkMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *ShareLinkMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *ShareLinkMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// AddAccessIDs adds the "accesses" edge to the ShareLinkAccess entity by ids.
+func (m *ShareLinkMutation) AddAccessIDs(ids ...int) {
+	if m.accesses == nil {
+		m.accesses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.accesses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccesses clears the "accesses" edge to the ShareLinkAccess entity.
+func (m *ShareLinkMutation) ClearAccesses() {
+	m.clearedaccesses = true
+}
+
+// AccessesCleared reports if the "accesses" edge to the ShareLinkAccess entity was cleared.
+func (m *ShareLinkMutation) AccessesCleared() bool {
+	return m.clearedaccesses
+}
+
+// RemoveAccessIDs removes the "accesses" edge to the ShareLinkAccess entity by IDs.
+func (m *ShareLinkMutation) RemoveAccessIDs(ids ...int) {
+	if m.removedaccesses == nil {
+		m.removedaccesses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.accesses, ids[i])
+		m.removedaccesses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccesses returns the removed IDs of the "accesses" edge to the ShareLinkAccess entity.
+func (m *ShareLinkMutation) RemovedAccessesIDs() (ids []int) {
+	for id := range m.removedaccesses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessesIDs returns the "accesses" edge IDs in the mutation.
+func (m *ShareLinkMutation) AccessesIDs() (ids []int) {
+	for id := range m.accesses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccesses resets all changes to the "accesses" edge.
+func (m *ShareLinkMutation) ResetAccesses() {
+	m.accesses = nil
+	m.clearedaccesses = false
+	m.removedaccesses = nil
+}
+
+// Where appends a list predicates to the ShareLinkMutation builder.
+func (m *ShareLinkMutation) Where(ps ...predicate.ShareLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareLink).
+func (m *ShareLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareLinkMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.loan != nil {
+		fields = append(fields, sharelink.FieldLoanID)
+	}
+	if m.description != nil {
+		fields = append(fields, sharelink.FieldDescription)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, sharelink.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, sharelink.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sharelink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharelink.FieldLoanID:
+		return m.LoanID()
+	case sharelink.FieldDescription:
+		return m.Description()
+	case sharelink.FieldExpiresAt:
+		return m.ExpiresAt()
+	case sharelink.FieldRevokedAt:
+		return m.RevokedAt()
+	case sharelink.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharelink.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case sharelink.FieldDescription:
+		return m.OldDescription(ctx)
+	case sharelink.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case sharelink.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case sharelink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharelink.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case sharelink.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case sharelink.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case sharelink.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case sharelink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareLinkMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ShareLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sharelink.FieldDescription) {
+		fields = append(fields, sharelink.FieldDescription)
+	}
+	if m.FieldCleared(sharelink.FieldRevokedAt) {
+		fields = append(fields, sharelink.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareLinkMutation) ClearField(name string) error {
+	switch name {
+	case sharelink.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case sharelink.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareLinkMutation) ResetField(name string) error {
+	switch name {
+	case sharelink.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case sharelink.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case sharelink.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case sharelink.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case sharelink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.loan != nil {
+		edges = append(edges, sharelink.EdgeLoan)
+	}
+	if m.accesses != nil {
+		edges = append(edges, sharelink.EdgeAccesses)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sharelink.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	case sharelink.EdgeAccesses:
+		ids := make([]ent.Value, 0, len(m.accesses))
+		for id := range m.accesses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedaccesses != nil {
+		edges = append(edges, sharelink.EdgeAccesses)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareLinkMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sharelink.EdgeAccesses:
+		ids := make([]ent.Value, 0, len(m.removedaccesses))
+		for id := range m.removedaccesses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedloan {
+		edges = append(edges, sharelink.EdgeLoan)
+	}
+	if m.clearedaccesses {
+		edges = append(edges, sharelink.EdgeAccesses)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sharelink.EdgeLoan:
+		return m.clearedloan
+	case sharelink.EdgeAccesses:
+		return m.clearedaccesses
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case sharelink.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case sharelink.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	case sharelink.EdgeAccesses:
+		m.ResetAccesses()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLink edge %s", name)
+}
+
+// ShareLinkAccessMutation represents an operation that mutates the ShareLinkAccess nodes in the graph.
+type ShareLinkAccessMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	_path             *string
+	ip                *string
+	accessed_at       *time.Time
+	clearedFields     map[string]struct{}
+	share_link        *int
+	clearedshare_link bool
+	done              bool
+	oldValue          func(context.Context) (*ShareLinkAccess, error)
+	predicates        []predicate.ShareLinkAccess
+}
+
+var _ ent.Mutation = (*ShareLinkAccessMutation)(nil)
+
+// sharelinkaccessOption allows management of the mutation configuration using functional options.
+type sharelinkaccessOption func(*ShareLinkAccessMutation)
+
+// newShareLinkAccessMutation creates new mutation for the ShareLinkAccess entity.
+func newShareLinkAccessMutation(c config, op Op, opts ...sharelinkaccessOption) *ShareLinkAccessMutation {
+	m := &ShareLinkAccessMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShareLinkAccess,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShareLinkAccessID sets the ID field of the mutation.
+func withShareLinkAccessID(id int) sharelinkaccessOption {
+	return func(m *ShareLinkAccessMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShareLinkAccess
+		)
+		m.oldValue = func(ctx context.Context) (*ShareLinkAccess, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShareLinkAccess.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShareLinkAccess sets the old ShareLinkAccess of the mutation.
+func withShareLinkAccess(node *ShareLinkAccess) sharelinkaccessOption {
+	return func(m *ShareLinkAccessMutation) {
+		m.oldValue = func(context.Context) (*ShareLinkAccess, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareLinkAccessMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareLinkAccessMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShareLinkAccessMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShareLinkAccessMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShareLinkAccess.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (m *ShareLinkAccessMutation) SetShareLinkID(i int) {
+	m.share_link = &i
+}
+
+// ShareLinkID returns the value of the "share_link_id" field in the mutation.
+func (m *ShareLinkAccessMutation) ShareLinkID() (r int, exists bool) {
+	v := m.share_link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareLinkID returns the old "share_link_id" field's value of the ShareLinkAccess entity.
+// If the ShareLinkAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkAccessMutation) OldShareLinkID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareLinkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareLinkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareLinkID: %w", err)
+	}
+	return oldValue.ShareLinkID, nil
+}
+
+// ResetShareLinkID resets all changes to the "share_link_id" field.
+func (m *ShareLinkAccessMutation) ResetShareLinkID() {
+	m.share_link = nil
+}
+
+// SetPath sets the "path" field.
+func (m *ShareLinkAccessMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ShareLinkAccessMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the ShareLinkAccess entity.
+// If the ShareLinkAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkAccessMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ShareLinkAccessMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetIP sets the "ip" field.
+func (m *ShareLinkAccessMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *ShareLinkAccessMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the ShareLinkAccess entity.
+// If the ShareLinkAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkAccessMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *ShareLinkAccessMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (m *ShareLinkAccessMutation) SetAccessedAt(t time.Time) {
+	m.accessed_at = &t
+}
+
+// AccessedAt returns the value of the "accessed_at" field in the mutation.
+func (m *ShareLinkAccessMutation) AccessedAt() (r time.Time, exists bool) {
+	v := m.accessed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessedAt returns the old "accessed_at" field's value of the ShareLinkAccess entity.
+// If the ShareLinkAccess object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareLinkAccessMutation) OldAccessedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessedAt: %w", err)
+	}
+	return oldValue.AccessedAt, nil
+}
+
+// ResetAccessedAt resets all changes to the "accessed_at" field.
+func (m *ShareLinkAccessMutation) ResetAccessedAt() {
+	m.accessed_at = nil
+}
+
+// ClearShareLink clears the "share_link" edge to the ShareLink entity.
+func (m *ShareLinkAccessMutation) ClearShareLink() {
+	m.clearedshare_link = true
+	m.clearedFields[sharelinkaccess.FieldShareLinkID] = struct{}{}
+}
+
+// ShareLinkCleared reports if the "share_link" edge to the ShareLink entity was cleared.
+func (m *ShareLinkAccessMutation) ShareLinkCleared() bool {
+	return m.clearedshare_link
+}
+
+// ShareLinkIDs returns the "share_link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShareLinkID instead. It exists only for internal usage by the builders.
+func (m *ShareLinkAccessMutation) ShareLinkIDs() (ids []int) {
+	if id := m.share_link; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShareLink resets all changes to the "share_link" edge.
+func (m *ShareLinkAccessMutation) ResetShareLink() {
+	m.share_link = nil
+	m.clearedshare_link = false
+}
+
+// Where appends a list predicates to the ShareLinkAccessMutation builder.
+func (m *ShareLinkAccessMutation) Where(ps ...predicate.ShareLinkAccess) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShareLinkAccessMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShareLinkAccessMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShareLinkAccess, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShareLinkAccessMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShareLinkAccessMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShareLinkAccess).
+func (m *ShareLinkAccessMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShareLinkAccessMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.share_link != nil {
+		fields = append(fields, sharelinkaccess.FieldShareLinkID)
+	}
+	if m._path != nil {
+		fields = append(fields, sharelinkaccess.FieldPath)
+	}
+	if m.ip != nil {
+		fields = append(fields, sharelinkaccess.FieldIP)
+	}
+	if m.accessed_at != nil {
+		fields = append(fields, sharelinkaccess.FieldAccessedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShareLinkAccessMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharelinkaccess.FieldShareLinkID:
+		return m.ShareLinkID()
+	case sharelinkaccess.FieldPath:
+		return m.Path()
+	case sharelinkaccess.FieldIP:
+		return m.IP()
+	case sharelinkaccess.FieldAccessedAt:
+		return m.AccessedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShareLinkAccessMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharelinkaccess.FieldShareLinkID:
+		return m.OldShareLinkID(ctx)
+	case sharelinkaccess.FieldPath:
+		return m.OldPath(ctx)
+	case sharelinkaccess.FieldIP:
+		return m.OldIP(ctx)
+	case sharelinkaccess.FieldAccessedAt:
+		return m.OldAccessedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShareLinkAccess field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareLinkAccessMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharelinkaccess.FieldShareLinkID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareLinkID(v)
+		return nil
+	case sharelinkaccess.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case sharelinkaccess.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case sharelinkaccess.FieldAccessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLinkAccess field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShareLinkAccessMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShareLinkAccessMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShareLinkAccessMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ShareLinkAccess numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShareLinkAccessMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShareLinkAccessMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareLinkAccessMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ShareLinkAccess nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShareLinkAccessMutation) ResetField(name string) error {
+	switch name {
+	case sharelinkaccess.FieldShareLinkID:
+		m.ResetShareLinkID()
+		return nil
+	case sharelinkaccess.FieldPath:
+		m.ResetPath()
+		return nil
+	case sharelinkaccess.FieldIP:
+		m.ResetIP()
+		return nil
+	case sharelinkaccess.FieldAccessedAt:
+		m.ResetAccessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLinkAccess field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShareLinkAccessMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.share_link != nil {
+		edges = append(edges, sharelinkaccess.EdgeShareLink)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShareLinkAccessMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sharelinkaccess.EdgeShareLink:
+		if id := m.share_link; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShareLinkAccessMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShareLinkAccessMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShareLinkAccessMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedshare_link {
+		edges = append(edges, sharelinkaccess.EdgeShareLink)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShareLinkAccessMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sharelinkaccess.EdgeShareLink:
+		return m.clearedshare_link
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShareLinkAccessMutation) ClearEdge(name string) error {
+	switch name {
+	case sharelinkaccess.EdgeShareLink:
+		m.ClearShareLink()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLinkAccess unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShareLinkAccessMutation) ResetEdge(name string) error {
+	switch name {
+	case sharelinkaccess.EdgeShareLink:
+		m.ResetShareLink()
+		return nil
+	}
+	return fmt.Errorf("unknown ShareLinkAccess edge %s", name)
+}
+
+// SharedLoanMutation represents an operation that mutates the SharedLoan nodes in the graph.
+type SharedLoanMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	permission    *sharedloan.Permission
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	loan          *int
+	clearedloan   bool
+	done          bool
+	oldValue      func(context.Context) (*SharedLoan, error)
+	predicates    []predicate.SharedLoan
+}
+
+var _ ent.Mutation = (*SharedLoanMutation)(nil)
+
+// sharedloanOption allows management of the mutation configuration using functional options.
+type sharedloanOption func(*SharedLoanMutation)
+
+// newSharedLoanMutation creates new mutation for the SharedLoan entity.
+func newSharedLoanMutation(c config, op Op, opts ...sharedloanOption) *SharedLoanMutation {
+	m := &SharedLoanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSharedLoan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSharedLoanID sets the ID field of the mutation.
+func withSharedLoanID(id int) sharedloanOption {
+	return func(m *SharedLoanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SharedLoan
+		)
+		m.oldValue = func(ctx context.Context) (*SharedLoan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SharedLoan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSharedLoan sets the old SharedLoan of the mutation.
+func withSharedLoan(node *SharedLoan) sharedloanOption {
+	return func(m *SharedLoanMutation) {
+		m.oldValue = func(context.Context) (*SharedLoan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SharedLoanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SharedLoanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SharedLoanMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SharedLoanMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SharedLoan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SharedLoanMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SharedLoanMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SharedLoan entity.
+// If the SharedLoan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLoanMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SharedLoanMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetLoanID sets the "loan_id" field.
+func (m *SharedLoanMutation) SetLoanID(i int) {
+	m.loan = &i
+}
+
+// LoanID returns the value of the "loan_id" field in the mutation.
+func (m *SharedLoanMutation) LoanID() (r int, exists bool) {
+	v := m.loan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoanID returns the old "loan_id" field's value of the SharedLoan entity.
+// If the SharedLoan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLoanMutation) OldLoanID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoanID: %w", err)
+	}
+	return oldValue.LoanID, nil
+}
+
+// ResetLoanID resets all changes to the "loan_id" field.
+func (m *SharedLoanMutation) ResetLoanID() {
+	m.loan = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *SharedLoanMutation) SetPermission(s sharedloan.Permission) {
+	m.permission = &s
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *SharedLoanMutation) Permission() (r sharedloan.Permission, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the SharedLoan entity.
+// If the SharedLoan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLoanMutation) OldPermission(ctx context.Context) (v sharedloan.Permission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *SharedLoanMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SharedLoanMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[sharedloan.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SharedLoanMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SharedLoanMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SharedLoanMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *SharedLoanMutation) ClearLoan() {
+	m.clearedloan = true
+	m.clearedFields[sharedloan.FieldLoanID] = struct{}{}
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *SharedLoanMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *SharedLoanMutation) LoanIDs() (ids []int) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *SharedLoanMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the SharedLoanMutation builder.
+func (m *SharedLoanMutation) Where(ps ...predicate.SharedLoan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SharedLoanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SharedLoanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SharedLoan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SharedLoanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SharedLoanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SharedLoan).
+func (m *SharedLoanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SharedLoanMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, sharedloan.FieldUserID)
+	}
+	if m.loan != nil {
+		fields = append(fields, sharedloan.FieldLoanID)
+	}
+	if m.permission != nil {
+		fields = append(fields, sharedloan.FieldPermission)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SharedLoanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedloan.FieldUserID:
+		return m.UserID()
+	case sharedloan.FieldLoanID:
+		return m.LoanID()
+	case sharedloan.FieldPermission:
+		return m.Permission()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SharedLoanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedloan.FieldUserID:
+		return m.OldUserID(ctx)
+	case sharedloan.FieldLoanID:
+		return m.OldLoanID(ctx)
+	case sharedloan.FieldPermission:
+		return m.OldPermission(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedLoan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLoanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharedloan.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case sharedloan.FieldLoanID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoanID(v)
+		return nil
+	case sharedloan.FieldPermission:
+		v, ok := value.(sharedloan.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLoan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SharedLoanMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SharedLoanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLoanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SharedLoan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SharedLoanMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SharedLoanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SharedLoanMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SharedLoan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SharedLoanMutation) ResetField(name string) error {
+	switch name {
+	case sharedloan.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case sharedloan.FieldLoanID:
+		m.ResetLoanID()
+		return nil
+	case sharedloan.FieldPermission:
+		m.ResetPermission()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLoan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SharedLoanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, sharedloan.EdgeUser)
+	}
+	if m.loan != nil {
+		edges = append(edges, sharedloan.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SharedLoanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sharedloan.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case sharedloan.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SharedLoanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SharedLoanMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SharedLoanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, sharedloan.EdgeUser)
+	}
+	if m.clearedloan {
+		edges = append(edges, sharedloan.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SharedLoanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sharedloan.EdgeUser:
+		return m.cleareduser
+	case sharedloan.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SharedLoanMutation) ClearEdge(name string) error {
+	switch name {
+	case sharedloan.EdgeUser:
+		m.ClearUser()
+		return nil
+	case sharedloan.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLoan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SharedLoanMutation) ResetEdge(name string) error {
+	switch name {
+	case sharedloan.EdgeUser:
+		m.ResetUser()
+		return nil
+	case sharedloan.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLoan edge %s", name)
+}
+
+// StaffRoleMutation represents an operation that mutates the StaffRole nodes in the graph.
+type StaffRoleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	role          *staffrole.Role
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*StaffRole, error)
+	predicates    []predicate.StaffRole
+}
+
+var _ ent.Mutation = (*StaffRoleMutation)(nil)
+
+// staffroleOption allows management of the mutation configuration using functional options.
+type staffroleOption func(*StaffRoleMutation)
+
+// newStaffRoleMutation creates new mutation for the StaffRole entity.
+func newStaffRoleMutation(c config, op Op, opts ...staffroleOption) *StaffRoleMutation {
+	m := &StaffRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStaffRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStaffRoleID sets the ID field of the mutation.
+func withStaffRoleID(id int) staffroleOption {
+	return func(m *StaffRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StaffRole
+		)
+		m.oldValue = func(ctx context.Context) (*StaffRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StaffRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStaffRole sets the old StaffRole of the mutation.
+func withStaffRole(node *StaffRole) staffroleOption {
+	return func(m *StaffRoleMutation) {
+		m.oldValue = func(context.Context) (*StaffRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StaffRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StaffRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StaffRoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StaffRoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StaffRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StaffRoleMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StaffRoleMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the StaffRole entity.
+// If the StaffRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffRoleMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StaffRoleMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *StaffRoleMutation) SetRole(s staffrole.Role) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *StaffRoleMutation) Role() (r staffrole.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the StaffRole entity.
+// If the StaffRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffRoleMutation) OldRole(ctx context.Context) (v staffrole.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *StaffRoleMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StaffRoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StaffRoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StaffRole entity.
+// If the StaffRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffRoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StaffRoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *StaffRoleMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[staffrole.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *StaffRoleMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *StaffRoleMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *StaffRoleMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the StaffRoleMutation builder.
+func (m *StaffRoleMutation) Where(ps ...predicate.StaffRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StaffRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StaffRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StaffRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StaffRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StaffRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StaffRole).
+func (m *StaffRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StaffRoleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, staffrole.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, staffrole.FieldRole)
+	}
+	if m.created_at != nil {
+		fields = append(fields, staffrole.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StaffRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case staffrole.FieldUserID:
+		return m.UserID()
+	case staffrole.FieldRole:
+		return m.Role()
+	case staffrole.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StaffRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case staffrole.FieldUserID:
+		return m.OldUserID(ctx)
+	case staffrole.FieldRole:
+		return m.OldRole(ctx)
+	case staffrole.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StaffRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaffRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case staffrole.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case staffrole.FieldRole:
+		v, ok := value.(staffrole.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case staffrole.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StaffRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StaffRoleMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StaffRoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaffRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown StaffRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StaffRoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StaffRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StaffRoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StaffRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StaffRoleMutation) ResetField(name string) error {
+	switch name {
+	case staffrole.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case staffrole.FieldRole:
+		m.ResetRole()
+		return nil
+	case staffrole.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StaffRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StaffRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, staffrole.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StaffRoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case staffrole.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StaffRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StaffRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StaffRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, staffrole.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StaffRoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case staffrole.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StaffRoleMutation) ClearEdge(name string) error {
+	switch name {
+	case staffrole.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown StaffRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StaffRoleMutation) ResetEdge(name string) error {
+	switch name {
+	case staffrole.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown StaffRole edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	name_index               *string
+	social                   *string
+	social_hash              *string
+	social_encrypted         *string
+	social_last4             *string
+	address                  *string
+	clearedFields            map[string]struct{}
+	loans                    map[int]struct{}
+	removedloans             map[int]struct{}
+	clearedloans             bool
+	shared_loan              map[int]struct{}
+	removedshared_loan       map[int]struct{}
+	clearedshared_loan       bool
+	share_invitations        map[int]struct{}
+	removedshare_invitations map[int]struct{}
+	clearedshare_invitations bool
+	credit_lines             map[int]struct{}
+	removedcredit_lines      map[int]struct{}
+	clearedcredit_lines      bool
+	obligations              map[int]struct{}
+	removedobligations       map[int]struct{}
+	clearedobligations       bool
+	credential               *int
+	clearedcredential        bool
+	staff_roles              map[int]struct{}
+	removedstaff_roles       map[int]struct{}
+	clearedstaff_roles       bool
+	identities               map[int]struct{}
+	removedidentities        map[int]struct{}
+	clearedidentities        bool
+	sessions                 map[int]struct{}
+	removedsessions          map[int]struct{}
+	clearedsessions          bool
+	done                     bool
+	oldValue                 func(context.Context) (*User, error)
+	predicates               []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetNameIndex sets the "name_index" field.
+func (m *UserMutation) SetNameIndex(s string) {
+	m.name_index = &s
+}
+
+// NameIndex returns the value of the "name_index" field in the mutation.
+func (m *UserMutation) NameIndex() (r string, exists bool) {
+	v := m.name_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameIndex returns the old "name_index" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNameIndex(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameIndex: %w", err)
+	}
+	return oldValue.NameIndex, nil
+}
+
+// ClearNameIndex clears the value of the "name_index" field.
+func (m *UserMutation) ClearNameIndex() {
+	m.name_index = nil
+	m.clearedFields[user.FieldNameIndex] = struct{}{}
+}
+
+// NameIndexCleared returns if the "name_index" field was cleared in this mutation.
+func (m *UserMutation) NameIndexCleared() bool {
+	_, ok := m.clearedFields[user.FieldNameIndex]
+	return ok
+}
+
+// ResetNameIndex resets all changes to the "name_index" field.
+func (m *UserMutation) ResetNameIndex() {
+	m.name_index = nil
+	delete(m.clearedFields, user.FieldNameIndex)
+}
+
+// SetSocial sets the "social" field.
+func (m *UserMutation) SetSocial(s string) {
+	m.social = &s
+}
+
+// Social returns the value of the "social" field in the mutation.
+func (m *UserMutation) Social() (r string, exists bool) {
+	v := m.social
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocial returns the old "social" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSocial(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocial: %w", err)
+	}
+	return oldValue.Social, nil
+}
+
+// ClearSocial clears the value of the "social" field.
+func (m *UserMutation) ClearSocial() {
+	m.social = nil
+	m.clearedFields[user.FieldSocial] = struct{}{}
+}
+
+// SocialCleared returns if the "social" field was cleared in this mutation.
+func (m *UserMutation) SocialCleared() bool {
+	_, ok := m.clearedFields[user.FieldSocial]
+	return ok
+}
+
+// ResetSocial resets all changes to the "social" field.
+func (m *UserMutation) ResetSocial() {
+	m.social = nil
+	delete(m.clearedFields, user.FieldSocial)
+}
+
+// SetSocialHash sets the "social_hash" field.
+func (m *UserMutation) SetSocialHash(s string) {
+	m.social_hash = &s
+}
+
+// SocialHash returns the value of the "social_hash" field in the mutation.
+func (m *UserMutation) SocialHash() (r string, exists bool) {
+	v := m.social_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocialHash returns the old "social_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSocialHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocialHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocialHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocialHash: %w", err)
+	}
+	return oldValue.SocialHash, nil
+}
+
+// ClearSocialHash clears the value of the "social_hash" field.
+func (m *UserMutation) ClearSocialHash() {
+	m.social_hash = nil
+	m.clearedFields[user.FieldSocialHash] = struct{}{}
+}
+
+// SocialHashCleared returns if the "social_hash" field was cleared in this mutation.
+func (m *UserMutation) SocialHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldSocialHash]
+	return ok
+}
+
+// ResetSocialHash resets all changes to the "social_hash" field.
+func (m *UserMutation) ResetSocialHash() {
+	m.social_hash = nil
+	delete(m.clearedFields, user.FieldSocialHash)
+}
+
+// SetSocialEncrypted sets the "social_encrypted" field.
+func (m *UserMutation) SetSocialEncrypted(s string) {
+	m.social_encrypted = &s
+}
+
+// SocialEncrypted returns the value of the "social_encrypted" field in the mutation.
+func (m *UserMutation) SocialEncrypted() (r string, exists bool) {
+	v := m.social_encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocialEncrypted returns the old "social_encrypted" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSocialEncrypted(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocialEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocialEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocialEncrypted: %w", err)
+	}
+	return oldValue.SocialEncrypted, nil
+}
+
+// ClearSocialEncrypted clears the value of the "social_encrypted" field.
+func (m *UserMutation) ClearSocialEncrypted() {
+	m.social_encrypted = nil
+	m.clearedFields[user.FieldSocialEncrypted] = struct{}{}
+}
+
+// SocialEncryptedCleared returns if the "social_encrypted" field was cleared in this mutation.
+func (m *UserMutation) SocialEncryptedCleared() bool {
+	_, ok := m.clearedFields[user.FieldSocialEncrypted]
+	return ok
+}
+
+// ResetSocialEncrypted resets all changes to the "social_encrypted" field.
+func (m *UserMutation) ResetSocialEncrypted() {
+	m.social_encrypted = nil
+	delete(m.clearedFields, user.FieldSocialEncrypted)
+}
+
+// SetSocialLast4 sets the "social_last4" field.
+func (m *UserMutation) SetSocialLast4(s string) {
+	m.social_last4 = &s
+}
+
+// SocialLast4 returns the value of the "social_last4" field in the mutation.
+func (m *UserMutation) SocialLast4() (r string, exists bool) {
+	v := m.social_last4
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocialLast4 returns the old "social_last4" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSocialLast4(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocialLast4 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocialLast4 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocialLast4: %w", err)
+	}
+	return oldValue.SocialLast4, nil
+}
+
+// ClearSocialLast4 clears the value of the "social_last4" field.
+func (m *UserMutation) ClearSocialLast4() {
+	m.social_last4 = nil
+	m.clearedFields[user.FieldSocialLast4] = struct{}{}
+}
+
+// SocialLast4Cleared returns if the "social_last4" field was cleared in this mutation.
+func (m *UserMutation) SocialLast4Cleared() bool {
+	_, ok := m.clearedFields[user.FieldSocialLast4]
+	return ok
+}
+
+// ResetSocialLast4 resets all changes to the "social_last4" field.
+func (m *UserMutation) ResetSocialLast4() {
+	m.social_last4 = nil
+	delete(m.clearedFields, user.FieldSocialLast4)
+}
+
+// SetAddress sets the "address" field.
+func (m *UserMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *UserMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *UserMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[user.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *UserMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *UserMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, user.FieldAddress)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by ids.
+func (m *UserMutation) AddLoanIDs(ids ...int) {
+	if m.loans == nil {
+		m.loans = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.loans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLoans clears the "loans" edge to the Loan entity.
+func (m *UserMutation) ClearLoans() {
+	m.clearedloans = true
+}
+
+// LoansCleared reports if the "loans" edge to the Loan entity was cleared.
+func (m *UserMutation) LoansCleared() bool {
+	return m.clearedloans
+}
+
+// RemoveLoanIDs removes the "loans" edge to the Loan entity by IDs.
+func (m *UserMutation) RemoveLoanIDs(ids ...int) {
+	if m.removedloans == nil {
+		m.removedloans = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.loans, ids[i])
+		m.removedloans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLoans returns the removed IDs of the "loans" edge to the Loan entity.
+func (m *UserMutation) RemovedLoansIDs() (ids []int) {
+	for id := range m.removedloans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LoansIDs returns the "loans" edge IDs in the mutation.
+func (m *UserMutation) LoansIDs() (ids []int) {
+	for id := range m.loans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLoans resets all changes to the "loans" edge.
+func (m *UserMutation) ResetLoans() {
+	m.loans = nil
+	m.clearedloans = false
+	m.removedloans = nil
+}
+
+// AddSharedLoanIDs adds the "shared_loan" edge to the SharedLoan entity by ids.
+func (m *UserMutation) AddSharedLoanIDs(ids ...int) {
+	if m.shared_loan == nil {
+		m.shared_loan = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shared_loan[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSharedLoan clears the "shared_loan" edge to the SharedLoan entity.
+func (m *UserMutation) ClearSharedLoan() {
+	m.clearedshared_loan = true
+}
+
+// SharedLoanCleared reports if the "shared_loan" edge to the SharedLoan entity was cleared.
+func (m *UserMutation) SharedLoanCleared() bool {
+	return m.clearedshared_loan
+}
+
+// RemoveSharedLoanIDs removes the "shared_loan" edge to the SharedLoan entity by IDs.
+func (m *UserMutation) RemoveSharedLoanIDs(ids ...int) {
+	if m.removedshared_loan == nil {
+		m.removedshared_loan = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shared_loan, ids[i])
+		m.removedshared_loan[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSharedLoan returns the removed IDs of the "shared_loan" edge to the SharedLoan entity.
+func (m *UserMutation) RemovedSharedLoanIDs() (ids []int) {
+	for id := range m.removedshared_loan {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharedLoanIDs returns the "shared_loan" edge IDs in the mutation.
+func (m *UserMutation) SharedLoanIDs() (ids []int) {
+	for id := range m.shared_loan {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSharedLoan resets all changes to the "shared_loan" edge.
+func (m *UserMutation) ResetSharedLoan() {
+	m.shared_loan = nil
+	m.clearedshared_loan = false
+	m.removedshared_loan = nil
+}
+
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by ids.
+func (m *UserMutation) AddShareInvitationIDs(ids ...int) {
+	if m.share_invitations == nil {
+		m.share_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.share_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShareInvitations clears the "share_invitations" edge to the ShareInvitation entity.
+func (m *UserMutation) ClearShareInvitations() {
+	m.clearedshare_invitations = true
+}
+
+// ShareInvitationsCleared reports if the "share_invitations" edge to the ShareInvitation entity was cleared.
+func (m *UserMutation) ShareInvitationsCleared() bool {
+	return m.clearedshare_invitations
+}
+
+// RemoveShareInvitationIDs removes the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (m *UserMutation) RemoveShareInvitationIDs(ids ...int) {
+	if m.removedshare_invitations == nil {
+		m.removedshare_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.share_invitations, ids[i])
+		m.removedshare_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShareInvitations returns the removed IDs of the "share_invitations" edge to the ShareInvitation entity.
+func (m *UserMutation) RemovedShareInvitationsIDs() (ids []int) {
+	for id := range m.removedshare_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShareInvitationsIDs returns the "share_invitations" edge IDs in the mutation.
+func (m *UserMutation) ShareInvitationsIDs() (ids []int) {
+	for id := range m.share_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShareInvitations resets all changes to the "share_invitations" edge.
+func (m *UserMutation) ResetShareInvitations() {
+	m.share_invitations = nil
+	m.clearedshare_invitations = false
+	m.removedshare_invitations = nil
+}
+
+// AddCreditLineIDs adds the "credit_lines" edge to the CreditLine entity by ids.
+func (m *UserMutation) AddCreditLineIDs(ids ...int) {
+	if m.credit_lines == nil {
+		m.credit_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.credit_lines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreditLines clears the "credit_lines" edge to the CreditLine entity.
+func (m *UserMutation) ClearCreditLines() {
+	m.clearedcredit_lines = true
+}
+
+// CreditLinesCleared reports if the "credit_lines" edge to the CreditLine entity was cleared.
+func (m *UserMutation) CreditLinesCleared() bool {
+	return m.clearedcredit_lines
+}
+
+// RemoveCreditLineIDs removes the "credit_lines" edge to the CreditLine entity by IDs.
+func (m *UserMutation) RemoveCreditLineIDs(ids ...int) {
+	if m.removedcredit_lines == nil {
+		m.removedcredit_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.credit_lines, ids[i])
+		m.removedcredit_lines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreditLines returns the removed IDs of the "credit_lines" edge to the CreditLine entity.
+func (m *UserMutation) RemovedCreditLinesIDs() (ids []int) {
+	for id := range m.removedcredit_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreditLinesIDs returns the "credit_lines" edge IDs in the mutation.
+func (m *UserMutation) CreditLinesIDs() (ids []int) {
+	for id := range m.credit_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreditLines resets all changes to the "credit_lines" edge.
+func (m *UserMutation) ResetCreditLines() {
+	m.credit_lines = nil
+	m.clearedcredit_lines = false
+	m.removedcredit_lines = nil
+}
+
+// AddObligationIDs adds the "obligations" edge to the LoanObligor entity by ids.
+func (m *UserMutation) AddObligationIDs(ids ...int) {
+	if m.obligations == nil {
+		m.obligations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.obligations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearObligations clears the "obligations" edge to the LoanObligor entity.
+func (m *UserMutation) ClearObligations() {
+	m.clearedobligations = true
+}
+
+// ObligationsCleared reports if the "obligations" edge to the LoanObligor entity was cleared.
+func (m *UserMutation) ObligationsCleared() bool {
+	return m.clearedobligations
+}
+
+// RemoveObligationIDs removes the "obligations" edge to the LoanObligor entity by IDs.
+func (m *UserMutation) RemoveObligationIDs(ids ...int) {
+	if m.removedobligations == nil {
+		m.removedobligations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.obligations, ids[i])
+		m.removedobligations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedObligations returns the removed IDs of the "obligations" edge to the LoanObligor entity.
+func (m *UserMutation) RemovedObligationsIDs() (ids []int) {
+	for id := range m.removedobligations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ObligationsIDs returns the "obligations" edge IDs in the mutation.
+func (m *UserMutation) ObligationsIDs() (ids []int) {
+	for id := range m.obligations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetObligations resets all changes to the "obligations" edge.
+func (m *UserMutation) ResetObligations() {
+	m.obligations = nil
+	m.clearedobligations = false
+	m.removedobligations = nil
+}
+
+// SetCredentialID sets the "credential" edge to the UserCredential entity by id.
+func (m *UserMutation) SetCredentialID(id int) {
+	m.credential = &id
+}
+
+// ClearCredential clears the "credential" edge to the UserCredential entity.
+func (m *UserMutation) ClearCredential() {
+	m.clearedcredential = true
+}
+
+// CredentialCleared reports if the "credential" edge to the UserCredential entity was cleared.
+func (m *UserMutation) CredentialCleared() bool {
+	return m.clearedcredential
+}
+
+// CredentialID returns the "credential" edge ID in the mutation.
+func (m *UserMutation) CredentialID() (id int, exists bool) {
+	if m.credential != nil {
+		return *m.credential, true
+	}
+	return
+}
+
+// CredentialIDs returns the "credential" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CredentialID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) CredentialIDs() (ids []int) {
+	if id := m.credential; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCredential resets all changes to the "credential" edge.
+func (m *UserMutation) ResetCredential() {
+	m.credential = nil
+	m.clearedcredential = false
+}
+
+// AddStaffRoleIDs adds the "staff_roles" edge to the StaffRole entity by ids.
+func (m *UserMutation) AddStaffRoleIDs(ids ...int) {
+	if m.staff_roles == nil {
+		m.staff_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.staff_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStaffRoles clears the "staff_roles" edge to the StaffRole entity.
+func (m *UserMutation) ClearStaffRoles() {
+	m.clearedstaff_roles = true
+}
+
+// StaffRolesCleared reports if the "staff_roles" edge to the StaffRole entity was cleared.
+func (m *UserMutation) StaffRolesCleared() bool {
+	return m.clearedstaff_roles
+}
+
+// RemoveStaffRoleIDs removes the "staff_roles" edge to the StaffRole entity by IDs.
+func (m *UserMutation) RemoveStaffRoleIDs(ids ...int) {
+	if m.removedstaff_roles == nil {
+		m.removedstaff_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.staff_roles, ids[i])
+		m.removedstaff_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStaffRoles returns the removed IDs of the "staff_roles" edge to the StaffRole entity.
+func (m *UserMutation) RemovedStaffRolesIDs() (ids []int) {
+	for id := range m.removedstaff_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StaffRolesIDs returns the "staff_roles" edge IDs in the mutation.
+func (m *UserMutation) StaffRolesIDs() (ids []int) {
+	for id := range m.staff_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStaffRoles resets all changes to the "staff_roles" edge.
+func (m *UserMutation) ResetStaffRoles() {
+	m.staff_roles = nil
+	m.clearedstaff_roles = false
+	m.removedstaff_roles = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.name_index != nil {
+		fields = append(fields, user.FieldNameIndex)
+	}
+	if m.social != nil {
+		fields = append(fields, user.FieldSocial)
+	}
+	if m.social_hash != nil {
+		fields = append(fields, user.FieldSocialHash)
+	}
+	if m.social_encrypted != nil {
+		fields = append(fields, user.FieldSocialEncrypted)
+	}
+	if m.social_last4 != nil {
+		fields = append(fields, user.FieldSocialLast4)
+	}
+	if m.address != nil {
+		fields = append(fields, user.FieldAddress)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldName:
+		return m.Name()
+	case user.FieldNameIndex:
+		return m.NameIndex()
+	case user.FieldSocial:
+		return m.Social()
+	case user.FieldSocialHash:
+		return m.SocialHash()
+	case user.FieldSocialEncrypted:
+		return m.SocialEncrypted()
+	case user.FieldSocialLast4:
+		return m.SocialLast4()
+	case user.FieldAddress:
+		return m.Address()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldNameIndex:
+		return m.OldNameIndex(ctx)
+	case user.FieldSocial:
+		return m.OldSocial(ctx)
+	case user.FieldSocialHash:
+		return m.OldSocialHash(ctx)
+	case user.FieldSocialEncrypted:
+		return m.OldSocialEncrypted(ctx)
+	case user.FieldSocialLast4:
+		return m.OldSocialLast4(ctx)
+	case user.FieldAddress:
+		return m.OldAddress(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldNameIndex:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameIndex(v)
+		return nil
+	case user.FieldSocial:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocial(v)
+		return nil
+	case user.FieldSocialHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocialHash(v)
+		return nil
+	case user.FieldSocialEncrypted:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocialEncrypted(v)
+		return nil
+	case user.FieldSocialLast4:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocialLast4(v)
+		return nil
+	case user.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldNameIndex) {
+		fields = append(fields, user.FieldNameIndex)
+	}
+	if m.FieldCleared(user.FieldSocial) {
+		fields = append(fields, user.FieldSocial)
+	}
+	if m.FieldCleared(user.FieldSocialHash) {
+		fields = append(fields, user.FieldSocialHash)
+	}
+	if m.FieldCleared(user.FieldSocialEncrypted) {
+		fields = append(fields, user.FieldSocialEncrypted)
+	}
+	if m.FieldCleared(user.FieldSocialLast4) {
+		fields = append(fields, user.FieldSocialLast4)
+	}
+	if m.FieldCleared(user.FieldAddress) {
+		fields = append(fields, user.FieldAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldNameIndex:
+		m.ClearNameIndex()
+		return nil
+	case user.FieldSocial:
+		m.ClearSocial()
+		return nil
+	case user.FieldSocialHash:
+		m.ClearSocialHash()
+		return nil
+	case user.FieldSocialEncrypted:
+		m.ClearSocialEncrypted()
+		return nil
+	case user.FieldSocialLast4:
+		m.ClearSocialLast4()
+		return nil
+	case user.FieldAddress:
+		m.ClearAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldNameIndex:
+		m.ResetNameIndex()
+		return nil
+	case user.FieldSocial:
 		m.ResetSocial()
 		return nil
-	case user.FieldAddress:
-		m.ResetAddress()
+	case user.FieldSocialHash:
+		m.ResetSocialHash()
+		return nil
+	case user.FieldSocialEncrypted:
+		m.ResetSocialEncrypted()
+		return nil
+	case user.FieldSocialLast4:
+		m.ResetSocialLast4()
+		return nil
+	case user.FieldAddress:
+		m.ResetAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.loans != nil {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.shared_loan != nil {
+		edges = append(edges, user.EdgeSharedLoan)
+	}
+	if m.share_invitations != nil {
+		edges = append(edges, user.EdgeShareInvitations)
+	}
+	if m.credit_lines != nil {
+		edges = append(edges, user.EdgeCreditLines)
+	}
+	if m.obligations != nil {
+		edges = append(edges, user.EdgeObligations)
+	}
+	if m.credential != nil {
+		edges = append(edges, user.EdgeCredential)
+	}
+	if m.staff_roles != nil {
+		edges = append(edges, user.EdgeStaffRoles)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.loans))
+		for id := range m.loans {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSharedLoan:
+		ids := make([]ent.Value, 0, len(m.shared_loan))
+		for id := range m.shared_loan {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShareInvitations:
+		ids := make([]ent.Value, 0, len(m.share_invitations))
+		for id := range m.share_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreditLines:
+		ids := make([]ent.Value, 0, len(m.credit_lines))
+		for id := range m.credit_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeObligations:
+		ids := make([]ent.Value, 0, len(m.obligations))
+		for id := range m.obligations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCredential:
+		if id := m.credential; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeStaffRoles:
+		ids := make([]ent.Value, 0, len(m.staff_roles))
+		for id := range m.staff_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedloans != nil {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.removedshared_loan != nil {
+		edges = append(edges, user.EdgeSharedLoan)
+	}
+	if m.removedshare_invitations != nil {
+		edges = append(edges, user.EdgeShareInvitations)
+	}
+	if m.removedcredit_lines != nil {
+		edges = append(edges, user.EdgeCreditLines)
+	}
+	if m.removedobligations != nil {
+		edges = append(edges, user.EdgeObligations)
+	}
+	if m.removedstaff_roles != nil {
+		edges = append(edges, user.EdgeStaffRoles)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.removedloans))
+		for id := range m.removedloans {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSharedLoan:
+		ids := make([]ent.Value, 0, len(m.removedshared_loan))
+		for id := range m.removedshared_loan {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShareInvitations:
+		ids := make([]ent.Value, 0, len(m.removedshare_invitations))
+		for id := range m.removedshare_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreditLines:
+		ids := make([]ent.Value, 0, len(m.removedcredit_lines))
+		for id := range m.removedcredit_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeObligations:
+		ids := make([]ent.Value, 0, len(m.removedobligations))
+		for id := range m.removedobligations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeStaffRoles:
+		ids := make([]ent.Value, 0, len(m.removedstaff_roles))
+		for id := range m.removedstaff_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedloans {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.clearedshared_loan {
+		edges = append(edges, user.EdgeSharedLoan)
+	}
+	if m.clearedshare_invitations {
+		edges = append(edges, user.EdgeShareInvitations)
+	}
+	if m.clearedcredit_lines {
+		edges = append(edges, user.EdgeCreditLines)
+	}
+	if m.clearedobligations {
+		edges = append(edges, user.EdgeObligations)
+	}
+	if m.clearedcredential {
+		edges = append(edges, user.EdgeCredential)
+	}
+	if m.clearedstaff_roles {
+		edges = append(edges, user.EdgeStaffRoles)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeLoans:
+		return m.clearedloans
+	case user.EdgeSharedLoan:
+		return m.clearedshared_loan
+	case user.EdgeShareInvitations:
+		return m.clearedshare_invitations
+	case user.EdgeCreditLines:
+		return m.clearedcredit_lines
+	case user.EdgeObligations:
+		return m.clearedobligations
+	case user.EdgeCredential:
+		return m.clearedcredential
+	case user.EdgeStaffRoles:
+		return m.clearedstaff_roles
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeSessions:
+		return m.clearedsessions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeCredential:
+		m.ClearCredential()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeLoans:
+		m.ResetLoans()
+		return nil
+	case user.EdgeSharedLoan:
+		m.ResetSharedLoan()
+		return nil
+	case user.EdgeShareInvitations:
+		m.ResetShareInvitations()
+		return nil
+	case user.EdgeCreditLines:
+		m.ResetCreditLines()
+		return nil
+	case user.EdgeObligations:
+		m.ResetObligations()
+		return nil
+	case user.EdgeCredential:
+		m.ResetCredential()
+		return nil
+	case user.EdgeStaffRoles:
+		m.ResetStaffRoles()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserChangeMutation represents an operation that mutates the UserChange nodes in the graph.
+type UserChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int
+	adduser_id    *int
+	field         *userchange.Field
+	old_value     *string
+	new_value     *string
+	changed_by    *int
+	addchanged_by *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserChange, error)
+	predicates    []predicate.UserChange
+}
+
+var _ ent.Mutation = (*UserChangeMutation)(nil)
+
+// userchangeOption allows management of the mutation configuration using functional options.
+type userchangeOption func(*UserChangeMutation)
+
+// newUserChangeMutation creates new mutation for the UserChange entity.
+func newUserChangeMutation(c config, op Op, opts ...userchangeOption) *UserChangeMutation {
+	m := &UserChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserChangeID sets the ID field of the mutation.
+func withUserChangeID(id int) userchangeOption {
+	return func(m *UserChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserChange
+		)
+		m.oldValue = func(ctx context.Context) (*UserChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserChange sets the old UserChange of the mutation.
+func withUserChange(node *UserChange) userchangeOption {
+	return func(m *UserChangeMutation) {
+		m.oldValue = func(context.Context) (*UserChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserChangeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserChangeMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserChangeMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *UserChangeMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *UserChangeMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserChangeMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetFieldField sets the "field" field.
+func (m *UserChangeMutation) SetFieldField(u userchange.Field) {
+	m.field = &u
+}
+
+// GetField returns the value of the "field" field in the mutation.
+func (m *UserChangeMutation) GetField() (r userchange.Field, exists bool) {
+	v := m.field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// GetOldField returns the old "field" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) GetOldField(ctx context.Context) (v userchange.Field, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("GetOldField is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("GetOldField requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for GetOldField: %w", err)
+	}
+	return oldValue.Field, nil
+}
+
+// ResetFieldField resets all changes to the "field" field.
+func (m *UserChangeMutation) ResetFieldField() {
+	m.field = nil
+}
+
+// SetOldValue sets the "old_value" field.
+func (m *UserChangeMutation) SetOldValue(s string) {
+	m.old_value = &s
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *UserChangeMutation) OldValue() (r string, exists bool) {
+	v := m.old_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValue returns the old "old_value" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) OldOldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
+	}
+	return oldValue.OldValue, nil
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (m *UserChangeMutation) ClearOldValue() {
+	m.old_value = nil
+	m.clearedFields[userchange.FieldOldValue] = struct{}{}
+}
+
+// OldValueCleared returns if the "old_value" field was cleared in this mutation.
+func (m *UserChangeMutation) OldValueCleared() bool {
+	_, ok := m.clearedFields[userchange.FieldOldValue]
+	return ok
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *UserChangeMutation) ResetOldValue() {
+	m.old_value = nil
+	delete(m.clearedFields, userchange.FieldOldValue)
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *UserChangeMutation) SetNewValue(s string) {
+	m.new_value = &s
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *UserChangeMutation) NewValue() (r string, exists bool) {
+	v := m.new_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewValue returns the old "new_value" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) OldNewValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
+	}
+	return oldValue.NewValue, nil
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (m *UserChangeMutation) ClearNewValue() {
+	m.new_value = nil
+	m.clearedFields[userchange.FieldNewValue] = struct{}{}
+}
+
+// NewValueCleared returns if the "new_value" field was cleared in this mutation.
+func (m *UserChangeMutation) NewValueCleared() bool {
+	_, ok := m.clearedFields[userchange.FieldNewValue]
+	return ok
+}
+
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *UserChangeMutation) ResetNewValue() {
+	m.new_value = nil
+	delete(m.clearedFields, userchange.FieldNewValue)
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (m *UserChangeMutation) SetChangedBy(i int) {
+	m.changed_by = &i
+	m.addchanged_by = nil
+}
+
+// ChangedBy returns the value of the "changed_by" field in the mutation.
+func (m *UserChangeMutation) ChangedBy() (r int, exists bool) {
+	v := m.changed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedBy returns the old "changed_by" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) OldChangedBy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedBy: %w", err)
+	}
+	return oldValue.ChangedBy, nil
+}
+
+// AddChangedBy adds i to the "changed_by" field.
+func (m *UserChangeMutation) AddChangedBy(i int) {
+	if m.addchanged_by != nil {
+		*m.addchanged_by += i
+	} else {
+		m.addchanged_by = &i
+	}
+}
+
+// AddedChangedBy returns the value that was added to the "changed_by" field in this mutation.
+func (m *UserChangeMutation) AddedChangedBy() (r int, exists bool) {
+	v := m.addchanged_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (m *UserChangeMutation) ClearChangedBy() {
+	m.changed_by = nil
+	m.addchanged_by = nil
+	m.clearedFields[userchange.FieldChangedBy] = struct{}{}
+}
+
+// ChangedByCleared returns if the "changed_by" field was cleared in this mutation.
+func (m *UserChangeMutation) ChangedByCleared() bool {
+	_, ok := m.clearedFields[userchange.FieldChangedBy]
+	return ok
+}
+
+// ResetChangedBy resets all changes to the "changed_by" field.
+func (m *UserChangeMutation) ResetChangedBy() {
+	m.changed_by = nil
+	m.addchanged_by = nil
+	delete(m.clearedFields, userchange.FieldChangedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserChange entity.
+// If the UserChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the UserChangeMutation builder.
+func (m *UserChangeMutation) Where(ps ...predicate.UserChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserChange).
+func (m *UserChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserChangeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, userchange.FieldUserID)
+	}
+	if m.field != nil {
+		fields = append(fields, userchange.FieldField)
+	}
+	if m.old_value != nil {
+		fields = append(fields, userchange.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, userchange.FieldNewValue)
+	}
+	if m.changed_by != nil {
+		fields = append(fields, userchange.FieldChangedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, userchange.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userchange.FieldUserID:
+		return m.UserID()
+	case userchange.FieldField:
+		return m.GetField()
+	case userchange.FieldOldValue:
+		return m.OldValue()
+	case userchange.FieldNewValue:
+		return m.NewValue()
+	case userchange.FieldChangedBy:
+		return m.ChangedBy()
+	case userchange.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userchange.FieldUserID:
+		return m.OldUserID(ctx)
+	case userchange.FieldField:
+		return m.GetOldField(ctx)
+	case userchange.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case userchange.FieldNewValue:
+		return m.OldNewValue(ctx)
+	case userchange.FieldChangedBy:
+		return m.OldChangedBy(ctx)
+	case userchange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userchange.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userchange.FieldField:
+		v, ok := value.(userchange.Field)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldField(v)
+		return nil
+	case userchange.FieldOldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case userchange.FieldNewValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	case userchange.FieldChangedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedBy(v)
+		return nil
+	case userchange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserChangeMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, userchange.FieldUserID)
+	}
+	if m.addchanged_by != nil {
+		fields = append(fields, userchange.FieldChangedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserChangeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userchange.FieldUserID:
+		return m.AddedUserID()
+	case userchange.FieldChangedBy:
+		return m.AddedChangedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userchange.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case userchange.FieldChangedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChangedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userchange.FieldOldValue) {
+		fields = append(fields, userchange.FieldOldValue)
+	}
+	if m.FieldCleared(userchange.FieldNewValue) {
+		fields = append(fields, userchange.FieldNewValue)
+	}
+	if m.FieldCleared(userchange.FieldChangedBy) {
+		fields = append(fields, userchange.FieldChangedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserChangeMutation) ClearField(name string) error {
+	switch name {
+	case userchange.FieldOldValue:
+		m.ClearOldValue()
+		return nil
+	case userchange.FieldNewValue:
+		m.ClearNewValue()
+		return nil
+	case userchange.FieldChangedBy:
+		m.ClearChangedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown UserChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserChangeMutation) ResetField(name string) error {
+	switch name {
+	case userchange.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userchange.FieldField:
+		m.ResetFieldField()
+		return nil
+	case userchange.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case userchange.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	case userchange.FieldChangedBy:
+		m.ResetChangedBy()
+		return nil
+	case userchange.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserChangeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserChangeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserChangeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserChangeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserChange edge %s", name)
+}
+
+// UserCredentialMutation represents an operation that mutates the UserCredential nodes in the graph.
+type UserCredentialMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	password_hash *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UserCredential, error)
+	predicates    []predicate.UserCredential
+}
+
+var _ ent.Mutation = (*UserCredentialMutation)(nil)
+
+// usercredentialOption allows management of the mutation configuration using functional options.
+type usercredentialOption func(*UserCredentialMutation)
+
+// newUserCredentialMutation creates new mutation for the UserCredential entity.
+func newUserCredentialMutation(c config, op Op, opts ...usercredentialOption) *UserCredentialMutation {
+	m := &UserCredentialMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserCredential,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserCredentialID sets the ID field of the mutation.
+func withUserCredentialID(id int) usercredentialOption {
+	return func(m *UserCredentialMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserCredential
+		)
+		m.oldValue = func(ctx context.Context) (*UserCredential, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserCredential.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserCredential sets the old UserCredential of the mutation.
+func withUserCredential(node *UserCredential) usercredentialOption {
+	return func(m *UserCredentialMutation) {
+		m.oldValue = func(context.Context) (*UserCredential, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserCredentialMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserCredentialMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserCredentialMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserCredentialMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserCredential.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserCredentialMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserCredentialMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserCredential entity.
+// If the UserCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserCredentialMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserCredentialMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserCredentialMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserCredentialMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the UserCredential entity.
+// If the UserCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserCredentialMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserCredentialMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserCredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the UserCredential entity.
+// If the UserCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserCredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserCredentialMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[usercredential.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserCredentialMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserCredentialMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserCredentialMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserCredentialMutation builder.
+func (m *UserCredentialMutation) Where(ps ...predicate.UserCredential) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserCredentialMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserCredential, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserCredentialMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserCredentialMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserCredential).
+func (m *UserCredentialMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, usercredential.FieldUserID)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, usercredential.FieldPasswordHash)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, usercredential.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserCredentialMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case usercredential.FieldUserID:
+		return m.UserID()
+	case usercredential.FieldPasswordHash:
+		return m.PasswordHash()
+	case usercredential.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case usercredential.FieldUserID:
+		return m.OldUserID(ctx)
+	case usercredential.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case usercredential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserCredential field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserCredentialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case usercredential.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case usercredential.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case usercredential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserCredential field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserCredentialMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserCredentialMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UserCredential numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserCredentialMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserCredentialMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserCredential nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserCredentialMutation) ResetField(name string) error {
+	switch name {
+	case usercredential.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case usercredential.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case usercredential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserCredential field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, usercredential.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserCredentialMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case usercredential.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserCredentialMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, usercredential.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserCredentialMutation) EdgeCleared(name string) bool {
+	switch name {
+	case usercredential.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserCredentialMutation) ClearEdge(name string) error {
+	switch name {
+	case usercredential.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserCredential unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserCredentialMutation) ResetEdge(name string) error {
+	switch name {
+	case usercredential.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserCredential edge %s", name)
+}
+
+// UserIdentityMutation represents an operation that mutates the UserIdentity nodes in the graph.
+type UserIdentityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	issuer        *string
+	subject       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UserIdentity, error)
+	predicates    []predicate.UserIdentity
+}
+
+var _ ent.Mutation = (*UserIdentityMutation)(nil)
+
+// useridentityOption allows management of the mutation configuration using functional options.
+type useridentityOption func(*UserIdentityMutation)
+
+// newUserIdentityMutation creates new mutation for the UserIdentity entity.
+func newUserIdentityMutation(c config, op Op, opts ...useridentityOption) *UserIdentityMutation {
+	m := &UserIdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserIdentity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserIdentityID sets the ID field of the mutation.
+func withUserIdentityID(id int) useridentityOption {
+	return func(m *UserIdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserIdentity
+		)
+		m.oldValue = func(ctx context.Context) (*UserIdentity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserIdentity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserIdentity sets the old UserIdentity of the mutation.
+func withUserIdentity(node *UserIdentity) useridentityOption {
+	return func(m *UserIdentityMutation) {
+		m.oldValue = func(context.Context) (*UserIdentity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserIdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserIdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserIdentityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserIdentityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserIdentity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserIdentityMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserIdentityMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserIdentityMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetIssuer sets the "issuer" field.
+func (m *UserIdentityMutation) SetIssuer(s string) {
+	m.issuer = &s
+}
+
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *UserIdentityMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuer returns the old "issuer" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
+	}
+	return oldValue.Issuer, nil
+}
+
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *UserIdentityMutation) ResetIssuer() {
+	m.issuer = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *UserIdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *UserIdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *UserIdentityMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserIdentityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserIdentityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserIdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserIdentityMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[useridentity.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserIdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserIdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserIdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserIdentityMutation builder.
+func (m *UserIdentityMutation) Where(ps ...predicate.UserIdentity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserIdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserIdentity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserIdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserIdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserIdentity).
+func (m *UserIdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, useridentity.FieldUserID)
+	}
+	if m.issuer != nil {
+		fields = append(fields, useridentity.FieldIssuer)
+	}
+	if m.subject != nil {
+		fields = append(fields, useridentity.FieldSubject)
+	}
+	if m.created_at != nil {
+		fields = append(fields, useridentity.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserIdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case useridentity.FieldUserID:
+		return m.UserID()
+	case useridentity.FieldIssuer:
+		return m.Issuer()
+	case useridentity.FieldSubject:
+		return m.Subject()
+	case useridentity.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case useridentity.FieldUserID:
+		return m.OldUserID(ctx)
+	case useridentity.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case useridentity.FieldSubject:
+		return m.OldSubject(ctx)
+	case useridentity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserIdentity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserIdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case useridentity.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case useridentity.FieldIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuer(v)
+		return nil
+	case useridentity.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case useridentity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.loans != nil {
-		edges = append(edges, user.EdgeLoans)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserIdentityMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserIdentityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	if m.shared_loan != nil {
-		edges = append(edges, user.EdgeSharedLoan)
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserIdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UserIdentity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserIdentityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserIdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserIdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserIdentity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserIdentityMutation) ResetField(name string) error {
+	switch name {
+	case useridentity.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case useridentity.FieldIssuer:
+		m.ResetIssuer()
+		return nil
+	case useridentity.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case useridentity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserIdentity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserIdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserIdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeLoans:
-		ids := make([]ent.Value, 0, len(m.loans))
-		for id := range m.loans {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSharedLoan:
-		ids := make([]ent.Value, 0, len(m.shared_loan))
-		for id := range m.shared_loan {
-			ids = append(ids, id)
+	case useridentity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedloans != nil {
-		edges = append(edges, user.EdgeLoans)
-	}
-	if m.removedshared_loan != nil {
-		edges = append(edges, user.EdgeSharedLoan)
-	}
+func (m *UserIdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeLoans:
-		ids := make([]ent.Value, 0, len(m.removedloans))
-		for id := range m.removedloans {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSharedLoan:
-		ids := make([]ent.Value, 0, len(m.removedshared_loan))
-		for id := range m.removedshared_loan {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserIdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedloans {
-		edges = append(edges, user.EdgeLoans)
-	}
-	if m.clearedshared_loan {
-		edges = append(edges, user.EdgeSharedLoan)
+func (m *UserIdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserIdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeLoans:
-		return m.clearedloans
-	case user.EdgeSharedLoan:
-		return m.clearedshared_loan
+	case useridentity.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserIdentityMutation) ClearEdge(name string) error {
 	switch name {
+	case useridentity.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserIdentity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserIdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeLoans:
-		m.ResetLoans()
-		return nil
-	case user.EdgeSharedLoan:
-		m.ResetSharedLoan()
+	case useridentity.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserIdentity edge %s", name)
 }
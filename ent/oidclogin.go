@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/oidclogin"
+)
+
+// OidcLogin is the model entity for the OidcLogin schema.
+type OidcLogin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// CodeVerifier holds the value of the "code_verifier" field.
+	CodeVerifier string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OidcLogin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oidclogin.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oidclogin.FieldState, oidclogin.FieldNonce, oidclogin.FieldCodeVerifier:
+			values[i] = new(sql.NullString)
+		case oidclogin.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OidcLogin fields.
+func (ol *OidcLogin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oidclogin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ol.ID = int(value.Int64)
+		case oidclogin.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ol.State = value.String
+			}
+		case oidclogin.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				ol.Nonce = value.String
+			}
+		case oidclogin.FieldCodeVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_verifier", values[i])
+			} else if value.Valid {
+				ol.CodeVerifier = value.String
+			}
+		case oidclogin.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ol.ExpiresAt = value.Time
+			}
+		default:
+			ol.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OidcLogin.
+// This includes values selected through modifiers, order, etc.
+func (ol *OidcLogin) Value(name string) (ent.Value, error) {
+	return ol.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OidcLogin.
+// Note that you need to call OidcLogin.Unwrap() before calling this method if this OidcLogin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ol *OidcLogin) Update() *OidcLoginUpdateOne {
+	return NewOidcLoginClient(ol.config).UpdateOne(ol)
+}
+
+// Unwrap unwraps the OidcLogin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ol *OidcLogin) Unwrap() *OidcLogin {
+	_tx, ok := ol.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OidcLogin is not a transactional entity")
+	}
+	ol.config.driver = _tx.drv
+	return ol
+}
+
+// String implements the fmt.Stringer.
+func (ol *OidcLogin) String() string {
+	var builder strings.Builder
+	builder.WriteString("OidcLogin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ol.ID))
+	builder.WriteString("state=")
+	builder.WriteString(ol.State)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(ol.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("code_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ol.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OidcLogins is a parsable slice of OidcLogin.
+type OidcLogins []*OidcLogin
@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidclogin
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the oidclogin type in the database.
+	Label = "oidc_login"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
+	// FieldCodeVerifier holds the string denoting the code_verifier field in the database.
+	FieldCodeVerifier = "code_verifier"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the oidclogin in the database.
+	Table = "oidc_logins"
+)
+
+// Columns holds all SQL columns for oidclogin fields.
+var Columns = []string{
+	FieldID,
+	FieldState,
+	FieldNonce,
+	FieldCodeVerifier,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the OidcLogin queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByNonce orders the results by the nonce field.
+func ByNonce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNonce, opts...).ToFunc()
+}
+
+// ByCodeVerifier orders the results by the code_verifier field.
+func ByCodeVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeVerifier, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidclogin
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLTE(FieldID, id))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldState, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldNonce, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContainsFold(FieldState, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OidcLogin) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OidcLogin) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OidcLogin) predicate.OidcLogin {
+	return predicate.OidcLogin(sql.NotPredicates(p))
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/oidclogin"
+)
+
+// OidcLoginCreate is the builder for creating a OidcLogin entity.
+type OidcLoginCreate struct {
+	config
+	mutation *OidcLoginMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (olc *OidcLoginCreate) SetState(s string) *OidcLoginCreate {
+	olc.mutation.SetState(s)
+	return olc
+}
+
+// SetNonce sets the "nonce" field.
+func (olc *OidcLoginCreate) SetNonce(s string) *OidcLoginCreate {
+	olc.mutation.SetNonce(s)
+	return olc
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (olc *OidcLoginCreate) SetCodeVerifier(s string) *OidcLoginCreate {
+	olc.mutation.SetCodeVerifier(s)
+	return olc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (olc *OidcLoginCreate) SetExpiresAt(t time.Time) *OidcLoginCreate {
+	olc.mutation.SetExpiresAt(t)
+	return olc
+}
+
+// Mutation returns the OidcLoginMutation object of the builder.
+func (olc *OidcLoginCreate) Mutation() *OidcLoginMutation {
+	return olc.mutation
+}
+
+// Save creates the OidcLogin in the database.
+func (olc *OidcLoginCreate) Save(ctx context.Context) (*OidcLogin, error) {
+	return withHooks(ctx, olc.sqlSave, olc.mutation, olc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (olc *OidcLoginCreate) SaveX(ctx context.Context) *OidcLogin {
+	v, err := olc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (olc *OidcLoginCreate) Exec(ctx context.Context) error {
+	_, err := olc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olc *OidcLoginCreate) ExecX(ctx context.Context) {
+	if err := olc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (olc *OidcLoginCreate) check() error {
+	if _, ok := olc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "OidcLogin.state"`)}
+	}
+	if _, ok := olc.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "OidcLogin.nonce"`)}
+	}
+	if _, ok := olc.mutation.CodeVerifier(); !ok {
+		return &ValidationError{Name: "code_verifier", err: errors.New(`ent: missing required field "OidcLogin.code_verifier"`)}
+	}
+	if _, ok := olc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OidcLogin.expires_at"`)}
+	}
+	return nil
+}
+
+func (olc *OidcLoginCreate) sqlSave(ctx context.Context) (*OidcLogin, error) {
+	if err := olc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := olc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, olc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	olc.mutation.id = &_node.ID
+	olc.mutation.done = true
+	return _node, nil
+}
+
+func (olc *OidcLoginCreate) createSpec() (*OidcLogin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OidcLogin{config: olc.config}
+		_spec = sqlgraph.NewCreateSpec(oidclogin.Table, sqlgraph.NewFieldSpec(oidclogin.FieldID, field.TypeInt))
+	)
+	if value, ok := olc.mutation.State(); ok {
+		_spec.SetField(oidclogin.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := olc.mutation.Nonce(); ok {
+		_spec.SetField(oidclogin.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := olc.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidclogin.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := olc.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidclogin.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OidcLoginCreateBulk is the builder for creating many OidcLogin entities in bulk.
+type OidcLoginCreateBulk struct {
+	config
+	err      error
+	builders []*OidcLoginCreate
+}
+
+// Save creates the OidcLogin entities in the database.
+func (olcb *OidcLoginCreateBulk) Save(ctx context.Context) ([]*OidcLogin, error) {
+	if olcb.err != nil {
+		return nil, olcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(olcb.builders))
+	nodes := make([]*OidcLogin, len(olcb.builders))
+	mutators := make([]Mutator, len(olcb.builders))
+	for i := range olcb.builders {
+		func(i int, root context.Context) {
+			builder := olcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OidcLoginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, olcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, olcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, olcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (olcb *OidcLoginCreateBulk) SaveX(ctx context.Context) []*OidcLogin {
+	v, err := olcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (olcb *OidcLoginCreateBulk) Exec(ctx context.Context) error {
+	_, err := olcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olcb *OidcLoginCreateBulk) ExecX(ctx context.Context) {
+	if err := olcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// OidcLoginDelete is the builder for deleting a OidcLogin entity.
+type OidcLoginDelete struct {
+	config
+	hooks    []Hook
+	mutation *OidcLoginMutation
+}
+
+// Where appends a list predicates to the OidcLoginDelete builder.
+func (old *OidcLoginDelete) Where(ps ...predicate.OidcLogin) *OidcLoginDelete {
+	old.mutation.Where(ps...)
+	return old
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (old *OidcLoginDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, old.sqlExec, old.mutation, old.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (old *OidcLoginDelete) ExecX(ctx context.Context) int {
+	n, err := old.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (old *OidcLoginDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oidclogin.Table, sqlgraph.NewFieldSpec(oidclogin.FieldID, field.TypeInt))
+	if ps := old.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, old.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	old.mutation.done = true
+	return affected, err
+}
+
+// OidcLoginDeleteOne is the builder for deleting a single OidcLogin entity.
+type OidcLoginDeleteOne struct {
+	old *OidcLoginDelete
+}
+
+// Where appends a list predicates to the OidcLoginDelete builder.
+func (oldo *OidcLoginDeleteOne) Where(ps ...predicate.OidcLogin) *OidcLoginDeleteOne {
+	oldo.old.mutation.Where(ps...)
+	return oldo
+}
+
+// Exec executes the deletion query.
+func (oldo *OidcLoginDeleteOne) Exec(ctx context.Context) error {
+	n, err := oldo.old.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oidclogin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oldo *OidcLoginDeleteOne) ExecX(ctx context.Context) {
+	if err := oldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
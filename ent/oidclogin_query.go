@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// OidcLoginQuery is the builder for querying OidcLogin entities.
+type OidcLoginQuery struct {
+	config
+	ctx        *QueryContext
+	order      []oidclogin.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OidcLogin
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OidcLoginQuery builder.
+func (olq *OidcLoginQuery) Where(ps ...predicate.OidcLogin) *OidcLoginQuery {
+	olq.predicates = append(olq.predicates, ps...)
+	return olq
+}
+
+// Limit the number of records to be returned by this query.
+func (olq *OidcLoginQuery) Limit(limit int) *OidcLoginQuery {
+	olq.ctx.Limit = &limit
+	return olq
+}
+
+// Offset to start from.
+func (olq *OidcLoginQuery) Offset(offset int) *OidcLoginQuery {
+	olq.ctx.Offset = &offset
+	return olq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (olq *OidcLoginQuery) Unique(unique bool) *OidcLoginQuery {
+	olq.ctx.Unique = &unique
+	return olq
+}
+
+// Order specifies how the records should be ordered.
+func (olq *OidcLoginQuery) Order(o ...oidclogin.OrderOption) *OidcLoginQuery {
+	olq.order = append(olq.order, o...)
+	return olq
+}
+
+// First returns the first OidcLogin entity from the query.
+// Returns a *NotFoundError when no OidcLogin was found.
+func (olq *OidcLoginQuery) First(ctx context.Context) (*OidcLogin, error) {
+	nodes, err := olq.Limit(1).All(setContextOp(ctx, olq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oidclogin.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (olq *OidcLoginQuery) FirstX(ctx context.Context) *OidcLogin {
+	node, err := olq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OidcLogin ID from the query.
+// Returns a *NotFoundError when no OidcLogin ID was found.
+func (olq *OidcLoginQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = olq.Limit(1).IDs(setContextOp(ctx, olq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oidclogin.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (olq *OidcLoginQuery) FirstIDX(ctx context.Context) int {
+	id, err := olq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OidcLogin entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OidcLogin entity is found.
+// Returns a *NotFoundError when no OidcLogin entities are found.
+func (olq *OidcLoginQuery) Only(ctx context.Context) (*OidcLogin, error) {
+	nodes, err := olq.Limit(2).All(setContextOp(ctx, olq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oidclogin.Label}
+	default:
+		return nil, &NotSingularError{oidclogin.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (olq *OidcLoginQuery) OnlyX(ctx context.Context) *OidcLogin {
+	node, err := olq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OidcLogin ID in the query.
+// Returns a *NotSingularError when more than one OidcLogin ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (olq *OidcLoginQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = olq.Limit(2).IDs(setContextOp(ctx, olq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oidclogin.Label}
+	default:
+		err = &NotSingularError{oidclogin.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (olq *OidcLoginQuery) OnlyIDX(ctx context.Context) int {
+	id, err := olq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OidcLogins.
+func (olq *OidcLoginQuery) All(ctx context.Context) ([]*OidcLogin, error) {
+	ctx = setContextOp(ctx, olq.ctx, "All")
+	if err := olq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OidcLogin, *OidcLoginQuery]()
+	return withInterceptors[[]*OidcLogin](ctx, olq, qr, olq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (olq *OidcLoginQuery) AllX(ctx context.Context) []*OidcLogin {
+	nodes, err := olq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OidcLogin IDs.
+func (olq *OidcLoginQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if olq.ctx.Unique == nil && olq.path != nil {
+		olq.Unique(true)
+	}
+	ctx = setContextOp(ctx, olq.ctx, "IDs")
+	if err = olq.Select(oidclogin.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (olq *OidcLoginQuery) IDsX(ctx context.Context) []int {
+	ids, err := olq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (olq *OidcLoginQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, olq.ctx, "Count")
+	if err := olq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, olq, querierCount[*OidcLoginQuery](), olq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (olq *OidcLoginQuery) CountX(ctx context.Context) int {
+	count, err := olq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (olq *OidcLoginQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, olq.ctx, "Exist")
+	switch _, err := olq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (olq *OidcLoginQuery) ExistX(ctx context.Context) bool {
+	exist, err := olq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OidcLoginQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (olq *OidcLoginQuery) Clone() *OidcLoginQuery {
+	if olq == nil {
+		return nil
+	}
+	return &OidcLoginQuery{
+		config:     olq.config,
+		ctx:        olq.ctx.Clone(),
+		order:      append([]oidclogin.OrderOption{}, olq.order...),
+		inters:     append([]Interceptor{}, olq.inters...),
+		predicates: append([]predicate.OidcLogin{}, olq.predicates...),
+		// clone intermediate query.
+		sql:  olq.sql.Clone(),
+		path: olq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OidcLogin.Query().
+//		GroupBy(oidclogin.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (olq *OidcLoginQuery) GroupBy(field string, fields ...string) *OidcLoginGroupBy {
+	olq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OidcLoginGroupBy{build: olq}
+	grbuild.flds = &olq.ctx.Fields
+	grbuild.label = oidclogin.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//	}
+//
+//	client.OidcLogin.Query().
+//		Select(oidclogin.FieldState).
+//		Scan(ctx, &v)
+func (olq *OidcLoginQuery) Select(fields ...string) *OidcLoginSelect {
+	olq.ctx.Fields = append(olq.ctx.Fields, fields...)
+	sbuild := &OidcLoginSelect{OidcLoginQuery: olq}
+	sbuild.label = oidclogin.Label
+	sbuild.flds, sbuild.scan = &olq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OidcLoginSelect configured with the given aggregations.
+func (olq *OidcLoginQuery) Aggregate(fns ...AggregateFunc) *OidcLoginSelect {
+	return olq.Select().Aggregate(fns...)
+}
+
+func (olq *OidcLoginQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range olq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, olq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range olq.ctx.Fields {
+		if !oidclogin.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if olq.path != nil {
+		prev, err := olq.path(ctx)
+		if err != nil {
+			return err
+		}
+		olq.sql = prev
+	}
+	return nil
+}
+
+func (olq *OidcLoginQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OidcLogin, error) {
+	var (
+		nodes = []*OidcLogin{}
+		_spec = olq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OidcLogin).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OidcLogin{config: olq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, olq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (olq *OidcLoginQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := olq.querySpec()
+	_spec.Node.Columns = olq.ctx.Fields
+	if len(olq.ctx.Fields) > 0 {
+		_spec.Unique = olq.ctx.Unique != nil && *olq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, olq.driver, _spec)
+}
+
+func (olq *OidcLoginQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(oidclogin.Table, oidclogin.Columns, sqlgraph.NewFieldSpec(oidclogin.FieldID, field.TypeInt))
+	_spec.From = olq.sql
+	if unique := olq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if olq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := olq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidclogin.FieldID)
+		for i := range fields {
+			if fields[i] != oidclogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := olq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := olq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := olq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := olq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (olq *OidcLoginQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(olq.driver.Dialect())
+	t1 := builder.Table(oidclogin.Table)
+	columns := olq.ctx.Fields
+	if len(columns) == 0 {
+		columns = oidclogin.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if olq.sql != nil {
+		selector = olq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if olq.ctx.Unique != nil && *olq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range olq.predicates {
+		p(selector)
+	}
+	for _, p := range olq.order {
+		p(selector)
+	}
+	if offset := olq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := olq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OidcLoginGroupBy is the group-by builder for OidcLogin entities.
+type OidcLoginGroupBy struct {
+	selector
+	build *OidcLoginQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (olgb *OidcLoginGroupBy) Aggregate(fns ...AggregateFunc) *OidcLoginGroupBy {
+	olgb.fns = append(olgb.fns, fns...)
+	return olgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (olgb *OidcLoginGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, olgb.build.ctx, "GroupBy")
+	if err := olgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OidcLoginQuery, *OidcLoginGroupBy](ctx, olgb.build, olgb, olgb.build.inters, v)
+}
+
+func (olgb *OidcLoginGroupBy) sqlScan(ctx context.Context, root *OidcLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(olgb.fns))
+	for _, fn := range olgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*olgb.flds)+len(olgb.fns))
+		for _, f := range *olgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*olgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := olgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OidcLoginSelect is the builder for selecting fields of OidcLogin entities.
+type OidcLoginSelect struct {
+	*OidcLoginQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ols *OidcLoginSelect) Aggregate(fns ...AggregateFunc) *OidcLoginSelect {
+	ols.fns = append(ols.fns, fns...)
+	return ols
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ols *OidcLoginSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ols.ctx, "Select")
+	if err := ols.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OidcLoginQuery, *OidcLoginSelect](ctx, ols.OidcLoginQuery, ols, ols.inters, v)
+}
+
+func (ols *OidcLoginSelect) sqlScan(ctx context.Context, root *OidcLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ols.fns))
+	for _, fn := range ols.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ols.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ols.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
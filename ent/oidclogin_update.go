@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// OidcLoginUpdate is the builder for updating OidcLogin entities.
+type OidcLoginUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OidcLoginMutation
+}
+
+// Where appends a list predicates to the OidcLoginUpdate builder.
+func (olu *OidcLoginUpdate) Where(ps ...predicate.OidcLogin) *OidcLoginUpdate {
+	olu.mutation.Where(ps...)
+	return olu
+}
+
+// SetState sets the "state" field.
+func (olu *OidcLoginUpdate) SetState(s string) *OidcLoginUpdate {
+	olu.mutation.SetState(s)
+	return olu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (olu *OidcLoginUpdate) SetNillableState(s *string) *OidcLoginUpdate {
+	if s != nil {
+		olu.SetState(*s)
+	}
+	return olu
+}
+
+// SetNonce sets the "nonce" field.
+func (olu *OidcLoginUpdate) SetNonce(s string) *OidcLoginUpdate {
+	olu.mutation.SetNonce(s)
+	return olu
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (olu *OidcLoginUpdate) SetNillableNonce(s *string) *OidcLoginUpdate {
+	if s != nil {
+		olu.SetNonce(*s)
+	}
+	return olu
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (olu *OidcLoginUpdate) SetCodeVerifier(s string) *OidcLoginUpdate {
+	olu.mutation.SetCodeVerifier(s)
+	return olu
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (olu *OidcLoginUpdate) SetNillableCodeVerifier(s *string) *OidcLoginUpdate {
+	if s != nil {
+		olu.SetCodeVerifier(*s)
+	}
+	return olu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (olu *OidcLoginUpdate) SetExpiresAt(t time.Time) *OidcLoginUpdate {
+	olu.mutation.SetExpiresAt(t)
+	return olu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (olu *OidcLoginUpdate) SetNillableExpiresAt(t *time.Time) *OidcLoginUpdate {
+	if t != nil {
+		olu.SetExpiresAt(*t)
+	}
+	return olu
+}
+
+// Mutation returns the OidcLoginMutation object of the builder.
+func (olu *OidcLoginUpdate) Mutation() *OidcLoginMutation {
+	return olu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (olu *OidcLoginUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, olu.sqlSave, olu.mutation, olu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (olu *OidcLoginUpdate) SaveX(ctx context.Context) int {
+	affected, err := olu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (olu *OidcLoginUpdate) Exec(ctx context.Context) error {
+	_, err := olu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (olu *OidcLoginUpdate) ExecX(ctx context.Context) {
+	if err := olu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (olu *OidcLoginUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidclogin.Table, oidclogin.Columns, sqlgraph.NewFieldSpec(oidclogin.FieldID, field.TypeInt))
+	if ps := olu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := olu.mutation.State(); ok {
+		_spec.SetField(oidclogin.FieldState, field.TypeString, value)
+	}
+	if value, ok := olu.mutation.Nonce(); ok {
+		_spec.SetField(oidclogin.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := olu.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidclogin.FieldCodeVerifier, field.TypeString, value)
+	}
+	if value, ok := olu.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidclogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, olu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidclogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	olu.mutation.done = true
+	return n, nil
+}
+
+// OidcLoginUpdateOne is the builder for updating a single OidcLogin entity.
+type OidcLoginUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OidcLoginMutation
+}
+
+// SetState sets the "state" field.
+func (oluo *OidcLoginUpdateOne) SetState(s string) *OidcLoginUpdateOne {
+	oluo.mutation.SetState(s)
+	return oluo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (oluo *OidcLoginUpdateOne) SetNillableState(s *string) *OidcLoginUpdateOne {
+	if s != nil {
+		oluo.SetState(*s)
+	}
+	return oluo
+}
+
+// SetNonce sets the "nonce" field.
+func (oluo *OidcLoginUpdateOne) SetNonce(s string) *OidcLoginUpdateOne {
+	oluo.mutation.SetNonce(s)
+	return oluo
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oluo *OidcLoginUpdateOne) SetNillableNonce(s *string) *OidcLoginUpdateOne {
+	if s != nil {
+		oluo.SetNonce(*s)
+	}
+	return oluo
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (oluo *OidcLoginUpdateOne) SetCodeVerifier(s string) *OidcLoginUpdateOne {
+	oluo.mutation.SetCodeVerifier(s)
+	return oluo
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (oluo *OidcLoginUpdateOne) SetNillableCodeVerifier(s *string) *OidcLoginUpdateOne {
+	if s != nil {
+		oluo.SetCodeVerifier(*s)
+	}
+	return oluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oluo *OidcLoginUpdateOne) SetExpiresAt(t time.Time) *OidcLoginUpdateOne {
+	oluo.mutation.SetExpiresAt(t)
+	return oluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (oluo *OidcLoginUpdateOne) SetNillableExpiresAt(t *time.Time) *OidcLoginUpdateOne {
+	if t != nil {
+		oluo.SetExpiresAt(*t)
+	}
+	return oluo
+}
+
+// Mutation returns the OidcLoginMutation object of the builder.
+func (oluo *OidcLoginUpdateOne) Mutation() *OidcLoginMutation {
+	return oluo.mutation
+}
+
+// Where appends a list predicates to the OidcLoginUpdate builder.
+func (oluo *OidcLoginUpdateOne) Where(ps ...predicate.OidcLogin) *OidcLoginUpdateOne {
+	oluo.mutation.Where(ps...)
+	return oluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oluo *OidcLoginUpdateOne) Select(field string, fields ...string) *OidcLoginUpdateOne {
+	oluo.fields = append([]string{field}, fields...)
+	return oluo
+}
+
+// Save executes the query and returns the updated OidcLogin entity.
+func (oluo *OidcLoginUpdateOne) Save(ctx context.Context) (*OidcLogin, error) {
+	return withHooks(ctx, oluo.sqlSave, oluo.mutation, oluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oluo *OidcLoginUpdateOne) SaveX(ctx context.Context) *OidcLogin {
+	node, err := oluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oluo *OidcLoginUpdateOne) Exec(ctx context.Context) error {
+	_, err := oluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oluo *OidcLoginUpdateOne) ExecX(ctx context.Context) {
+	if err := oluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oluo *OidcLoginUpdateOne) sqlSave(ctx context.Context) (_node *OidcLogin, err error) {
+	_spec := sqlgraph.NewUpdateSpec(oidclogin.Table, oidclogin.Columns, sqlgraph.NewFieldSpec(oidclogin.FieldID, field.TypeInt))
+	id, ok := oluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OidcLogin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidclogin.FieldID)
+		for _, f := range fields {
+			if !oidclogin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidclogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oluo.mutation.State(); ok {
+		_spec.SetField(oidclogin.FieldState, field.TypeString, value)
+	}
+	if value, ok := oluo.mutation.Nonce(); ok {
+		_spec.SetField(oidclogin.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := oluo.mutation.CodeVerifier(); ok {
+		_spec.SetField(oidclogin.FieldCodeVerifier, field.TypeString, value)
+	}
+	if value, ok := oluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(oidclogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &OidcLogin{config: oluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidclogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oluo.mutation.done = true
+	return _node, nil
+}
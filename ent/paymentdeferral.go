@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+)
+
+// PaymentDeferral is the model entity for the PaymentDeferral schema.
+type PaymentDeferral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind paymentdeferral.Kind `json:"kind,omitempty"`
+	// StartMonth holds the value of the "start_month" field.
+	StartMonth int `json:"start_month,omitempty"`
+	// Months holds the value of the "months" field.
+	Months int `json:"months,omitempty"`
+	// Interest holds the value of the "interest" field.
+	Interest paymentdeferral.Interest `json:"interest,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentDeferralQuery when eager-loading is set.
+	Edges        PaymentDeferralEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentDeferralEdges holds the relations/edges for other nodes in the graph.
+type PaymentDeferralEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentDeferralEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentDeferral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentdeferral.FieldID, paymentdeferral.FieldLoanID, paymentdeferral.FieldStartMonth, paymentdeferral.FieldMonths:
+			values[i] = new(sql.NullInt64)
+		case paymentdeferral.FieldKind, paymentdeferral.FieldInterest:
+			values[i] = new(sql.NullString)
+		case paymentdeferral.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentDeferral fields.
+func (pd *PaymentDeferral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentdeferral.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pd.ID = int(value.Int64)
+		case paymentdeferral.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				pd.LoanID = int(value.Int64)
+			}
+		case paymentdeferral.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pd.Kind = paymentdeferral.Kind(value.String)
+			}
+		case paymentdeferral.FieldStartMonth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_month", values[i])
+			} else if value.Valid {
+				pd.StartMonth = int(value.Int64)
+			}
+		case paymentdeferral.FieldMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field months", values[i])
+			} else if value.Valid {
+				pd.Months = int(value.Int64)
+			}
+		case paymentdeferral.FieldInterest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interest", values[i])
+			} else if value.Valid {
+				pd.Interest = paymentdeferral.Interest(value.String)
+			}
+		case paymentdeferral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pd.CreatedAt = value.Time
+			}
+		default:
+			pd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentDeferral.
+// This includes values selected through modifiers, order, etc.
+func (pd *PaymentDeferral) Value(name string) (ent.Value, error) {
+	return pd.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the PaymentDeferral entity.
+func (pd *PaymentDeferral) QueryLoan() *LoanQuery {
+	return NewPaymentDeferralClient(pd.config).QueryLoan(pd)
+}
+
+// Update returns a builder for updating this PaymentDeferral.
+// Note that you need to call PaymentDeferral.Unwrap() before calling this method if this PaymentDeferral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pd *PaymentDeferral) Update() *PaymentDeferralUpdateOne {
+	return NewPaymentDeferralClient(pd.config).UpdateOne(pd)
+}
+
+// Unwrap unwraps the PaymentDeferral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pd *PaymentDeferral) Unwrap() *PaymentDeferral {
+	_tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentDeferral is not a transactional entity")
+	}
+	pd.config.driver = _tx.drv
+	return pd
+}
+
+// String implements the fmt.Stringer.
+func (pd *PaymentDeferral) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentDeferral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pd.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", pd.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("start_month=")
+	builder.WriteString(fmt.Sprintf("%v", pd.StartMonth))
+	builder.WriteString(", ")
+	builder.WriteString("months=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Months))
+	builder.WriteString(", ")
+	builder.WriteString("interest=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Interest))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentDeferrals is a parsable slice of PaymentDeferral.
+type PaymentDeferrals []*PaymentDeferral
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentdeferral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the paymentdeferral type in the database.
+	Label = "payment_deferral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLoanID holds the string denoting the loan_id field in the database.
+	FieldLoanID = "loan_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldStartMonth holds the string denoting the start_month field in the database.
+	FieldStartMonth = "start_month"
+	// FieldMonths holds the string denoting the months field in the database.
+	FieldMonths = "months"
+	// FieldInterest holds the string denoting the interest field in the database.
+	FieldInterest = "interest"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLoan holds the string denoting the loan edge name in mutations.
+	EdgeLoan = "loan"
+	// Table holds the table name of the paymentdeferral in the database.
+	Table = "payment_deferrals"
+	// LoanTable is the table that holds the loan relation/edge.
+	LoanTable = "payment_deferrals"
+	// LoanInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoanInverseTable = "loans"
+	// LoanColumn is the table column denoting the loan relation/edge.
+	LoanColumn = "loan_id"
+)
+
+// Columns holds all SQL columns for paymentdeferral fields.
+var Columns = []string{
+	FieldID,
+	FieldLoanID,
+	FieldKind,
+	FieldStartMonth,
+	FieldMonths,
+	FieldInterest,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindForbearance Kind = "forbearance"
+	KindSkipPayment Kind = "skip_payment"
+	KindInSchool    Kind = "in_school"
+	KindGrace       Kind = "grace"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindForbearance, KindSkipPayment, KindInSchool, KindGrace:
+		return nil
+	default:
+		return fmt.Errorf("paymentdeferral: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Interest defines the type for the "interest" enum field.
+type Interest string
+
+// Interest values.
+const (
+	InterestCapitalize Interest = "capitalize"
+	InterestDefer      Interest = "defer"
+	InterestWaive      Interest = "waive"
+)
+
+func (i Interest) String() string {
+	return string(i)
+}
+
+// InterestValidator is a validator for the "interest" field enum values. It is called by the builders before save.
+func InterestValidator(i Interest) error {
+	switch i {
+	case InterestCapitalize, InterestDefer, InterestWaive:
+		return nil
+	default:
+		return fmt.Errorf("paymentdeferral: invalid enum value for interest field: %q", i)
+	}
+}
+
+// OrderOption defines the ordering options for the PaymentDeferral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLoanID orders the results by the loan_id field.
+func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByStartMonth orders the results by the start_month field.
+func ByStartMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartMonth, opts...).ToFunc()
+}
+
+// ByMonths orders the results by the months field.
+func ByMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonths, opts...).ToFunc()
+}
+
+// ByInterest orders the results by the interest field.
+func ByInterest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterest, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLoanField orders the results by loan field.
+func ByLoanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLoanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+	)
+}
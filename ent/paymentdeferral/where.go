@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentdeferral
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLTE(FieldID, id))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldLoanID, v))
+}
+
+// StartMonth applies equality check predicate on the "start_month" field. It's identical to StartMonthEQ.
+func StartMonth(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// Months applies equality check predicate on the "months" field. It's identical to MonthsEQ.
+func Months(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldMonths, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// StartMonthEQ applies the EQ predicate on the "start_month" field.
+func StartMonthEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldStartMonth, v))
+}
+
+// StartMonthNEQ applies the NEQ predicate on the "start_month" field.
+func StartMonthNEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldStartMonth, v))
+}
+
+// StartMonthIn applies the In predicate on the "start_month" field.
+func StartMonthIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldStartMonth, vs...))
+}
+
+// StartMonthNotIn applies the NotIn predicate on the "start_month" field.
+func StartMonthNotIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldStartMonth, vs...))
+}
+
+// StartMonthGT applies the GT predicate on the "start_month" field.
+func StartMonthGT(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGT(FieldStartMonth, v))
+}
+
+// StartMonthGTE applies the GTE predicate on the "start_month" field.
+func StartMonthGTE(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGTE(FieldStartMonth, v))
+}
+
+// StartMonthLT applies the LT predicate on the "start_month" field.
+func StartMonthLT(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLT(FieldStartMonth, v))
+}
+
+// StartMonthLTE applies the LTE predicate on the "start_month" field.
+func StartMonthLTE(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLTE(FieldStartMonth, v))
+}
+
+// MonthsEQ applies the EQ predicate on the "months" field.
+func MonthsEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldMonths, v))
+}
+
+// MonthsNEQ applies the NEQ predicate on the "months" field.
+func MonthsNEQ(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldMonths, v))
+}
+
+// MonthsIn applies the In predicate on the "months" field.
+func MonthsIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldMonths, vs...))
+}
+
+// MonthsNotIn applies the NotIn predicate on the "months" field.
+func MonthsNotIn(vs ...int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldMonths, vs...))
+}
+
+// MonthsGT applies the GT predicate on the "months" field.
+func MonthsGT(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGT(FieldMonths, v))
+}
+
+// MonthsGTE applies the GTE predicate on the "months" field.
+func MonthsGTE(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGTE(FieldMonths, v))
+}
+
+// MonthsLT applies the LT predicate on the "months" field.
+func MonthsLT(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLT(FieldMonths, v))
+}
+
+// MonthsLTE applies the LTE predicate on the "months" field.
+func MonthsLTE(v int) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLTE(FieldMonths, v))
+}
+
+// InterestEQ applies the EQ predicate on the "interest" field.
+func InterestEQ(v Interest) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldInterest, v))
+}
+
+// InterestNEQ applies the NEQ predicate on the "interest" field.
+func InterestNEQ(v Interest) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldInterest, v))
+}
+
+// InterestIn applies the In predicate on the "interest" field.
+func InterestIn(vs ...Interest) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldInterest, vs...))
+}
+
+// InterestNotIn applies the NotIn predicate on the "interest" field.
+func InterestNotIn(vs ...Interest) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldInterest, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentDeferral) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentDeferral) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentDeferral) predicate.PaymentDeferral {
+	return predicate.PaymentDeferral(sql.NotPredicates(p))
+}
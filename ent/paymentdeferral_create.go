@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+)
+
+// PaymentDeferralCreate is the builder for creating a PaymentDeferral entity.
+type PaymentDeferralCreate struct {
+	config
+	mutation *PaymentDeferralMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (pdc *PaymentDeferralCreate) SetLoanID(i int) *PaymentDeferralCreate {
+	pdc.mutation.SetLoanID(i)
+	return pdc
+}
+
+// SetKind sets the "kind" field.
+func (pdc *PaymentDeferralCreate) SetKind(pa paymentdeferral.Kind) *PaymentDeferralCreate {
+	pdc.mutation.SetKind(pa)
+	return pdc
+}
+
+// SetStartMonth sets the "start_month" field.
+func (pdc *PaymentDeferralCreate) SetStartMonth(i int) *PaymentDeferralCreate {
+	pdc.mutation.SetStartMonth(i)
+	return pdc
+}
+
+// SetMonths sets the "months" field.
+func (pdc *PaymentDeferralCreate) SetMonths(i int) *PaymentDeferralCreate {
+	pdc.mutation.SetMonths(i)
+	return pdc
+}
+
+// SetInterest sets the "interest" field.
+func (pdc *PaymentDeferralCreate) SetInterest(pa paymentdeferral.Interest) *PaymentDeferralCreate {
+	pdc.mutation.SetInterest(pa)
+	return pdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pdc *PaymentDeferralCreate) SetCreatedAt(t time.Time) *PaymentDeferralCreate {
+	pdc.mutation.SetCreatedAt(t)
+	return pdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pdc *PaymentDeferralCreate) SetNillableCreatedAt(t *time.Time) *PaymentDeferralCreate {
+	if t != nil {
+		pdc.SetCreatedAt(*t)
+	}
+	return pdc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (pdc *PaymentDeferralCreate) SetLoan(l *Loan) *PaymentDeferralCreate {
+	return pdc.SetLoanID(l.ID)
+}
+
+// Mutation returns the PaymentDeferralMutation object of the builder.
+func (pdc *PaymentDeferralCreate) Mutation() *PaymentDeferralMutation {
+	return pdc.mutation
+}
+
+// Save creates the PaymentDeferral in the database.
+func (pdc *PaymentDeferralCreate) Save(ctx context.Context) (*PaymentDeferral, error) {
+	pdc.defaults()
+	return withHooks(ctx, pdc.sqlSave, pdc.mutation, pdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pdc *PaymentDeferralCreate) SaveX(ctx context.Context) *PaymentDeferral {
+	v, err := pdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdc *PaymentDeferralCreate) Exec(ctx context.Context) error {
+	_, err := pdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdc *PaymentDeferralCreate) ExecX(ctx context.Context) {
+	if err := pdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pdc *PaymentDeferralCreate) defaults() {
+	if _, ok := pdc.mutation.CreatedAt(); !ok {
+		v := paymentdeferral.DefaultCreatedAt()
+		pdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdc *PaymentDeferralCreate) check() error {
+	if _, ok := pdc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "PaymentDeferral.loan_id"`)}
+	}
+	if _, ok := pdc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "PaymentDeferral.kind"`)}
+	}
+	if v, ok := pdc.mutation.Kind(); ok {
+		if err := paymentdeferral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.kind": %w`, err)}
+		}
+	}
+	if _, ok := pdc.mutation.StartMonth(); !ok {
+		return &ValidationError{Name: "start_month", err: errors.New(`ent: missing required field "PaymentDeferral.start_month"`)}
+	}
+	if _, ok := pdc.mutation.Months(); !ok {
+		return &ValidationError{Name: "months", err: errors.New(`ent: missing required field "PaymentDeferral.months"`)}
+	}
+	if _, ok := pdc.mutation.Interest(); !ok {
+		return &ValidationError{Name: "interest", err: errors.New(`ent: missing required field "PaymentDeferral.interest"`)}
+	}
+	if v, ok := pdc.mutation.Interest(); ok {
+		if err := paymentdeferral.InterestValidator(v); err != nil {
+			return &ValidationError{Name: "interest", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.interest": %w`, err)}
+		}
+	}
+	if _, ok := pdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentDeferral.created_at"`)}
+	}
+	if _, ok := pdc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "PaymentDeferral.loan"`)}
+	}
+	return nil
+}
+
+func (pdc *PaymentDeferralCreate) sqlSave(ctx context.Context) (*PaymentDeferral, error) {
+	if err := pdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pdc.mutation.id = &_node.ID
+	pdc.mutation.done = true
+	return _node, nil
+}
+
+func (pdc *PaymentDeferralCreate) createSpec() (*PaymentDeferral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentDeferral{config: pdc.config}
+		_spec = sqlgraph.NewCreateSpec(paymentdeferral.Table, sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt))
+	)
+	if value, ok := pdc.mutation.Kind(); ok {
+		_spec.SetField(paymentdeferral.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := pdc.mutation.StartMonth(); ok {
+		_spec.SetField(paymentdeferral.FieldStartMonth, field.TypeInt, value)
+		_node.StartMonth = value
+	}
+	if value, ok := pdc.mutation.Months(); ok {
+		_spec.SetField(paymentdeferral.FieldMonths, field.TypeInt, value)
+		_node.Months = value
+	}
+	if value, ok := pdc.mutation.Interest(); ok {
+		_spec.SetField(paymentdeferral.FieldInterest, field.TypeEnum, value)
+		_node.Interest = value
+	}
+	if value, ok := pdc.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentdeferral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pdc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdeferral.LoanTable,
+			Columns: []string{paymentdeferral.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentDeferralCreateBulk is the builder for creating many PaymentDeferral entities in bulk.
+type PaymentDeferralCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentDeferralCreate
+}
+
+// Save creates the PaymentDeferral entities in the database.
+func (pdcb *PaymentDeferralCreateBulk) Save(ctx context.Context) ([]*PaymentDeferral, error) {
+	if pdcb.err != nil {
+		return nil, pdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pdcb.builders))
+	nodes := make([]*PaymentDeferral, len(pdcb.builders))
+	mutators := make([]Mutator, len(pdcb.builders))
+	for i := range pdcb.builders {
+		func(i int, root context.Context) {
+			builder := pdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentDeferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdcb *PaymentDeferralCreateBulk) SaveX(ctx context.Context) []*PaymentDeferral {
+	v, err := pdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdcb *PaymentDeferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := pdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdcb *PaymentDeferralCreateBulk) ExecX(ctx context.Context) {
+	if err := pdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
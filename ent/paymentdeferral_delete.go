@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// PaymentDeferralDelete is the builder for deleting a PaymentDeferral entity.
+type PaymentDeferralDelete struct {
+	config
+	hooks    []Hook
+	mutation *PaymentDeferralMutation
+}
+
+// Where appends a list predicates to the PaymentDeferralDelete builder.
+func (pdd *PaymentDeferralDelete) Where(ps ...predicate.PaymentDeferral) *PaymentDeferralDelete {
+	pdd.mutation.Where(ps...)
+	return pdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pdd *PaymentDeferralDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdd.sqlExec, pdd.mutation, pdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdd *PaymentDeferralDelete) ExecX(ctx context.Context) int {
+	n, err := pdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pdd *PaymentDeferralDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(paymentdeferral.Table, sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt))
+	if ps := pdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pdd.mutation.done = true
+	return affected, err
+}
+
+// PaymentDeferralDeleteOne is the builder for deleting a single PaymentDeferral entity.
+type PaymentDeferralDeleteOne struct {
+	pdd *PaymentDeferralDelete
+}
+
+// Where appends a list predicates to the PaymentDeferralDelete builder.
+func (pddo *PaymentDeferralDeleteOne) Where(ps ...predicate.PaymentDeferral) *PaymentDeferralDeleteOne {
+	pddo.pdd.mutation.Where(ps...)
+	return pddo
+}
+
+// Exec executes the deletion query.
+func (pddo *PaymentDeferralDeleteOne) Exec(ctx context.Context) error {
+	n, err := pddo.pdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{paymentdeferral.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pddo *PaymentDeferralDeleteOne) ExecX(ctx context.Context) {
+	if err := pddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// PaymentDeferralQuery is the builder for querying PaymentDeferral entities.
+type PaymentDeferralQuery struct {
+	config
+	ctx        *QueryContext
+	order      []paymentdeferral.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PaymentDeferral
+	withLoan   *LoanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PaymentDeferralQuery builder.
+func (pdq *PaymentDeferralQuery) Where(ps ...predicate.PaymentDeferral) *PaymentDeferralQuery {
+	pdq.predicates = append(pdq.predicates, ps...)
+	return pdq
+}
+
+// Limit the number of records to be returned by this query.
+func (pdq *PaymentDeferralQuery) Limit(limit int) *PaymentDeferralQuery {
+	pdq.ctx.Limit = &limit
+	return pdq
+}
+
+// Offset to start from.
+func (pdq *PaymentDeferralQuery) Offset(offset int) *PaymentDeferralQuery {
+	pdq.ctx.Offset = &offset
+	return pdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pdq *PaymentDeferralQuery) Unique(unique bool) *PaymentDeferralQuery {
+	pdq.ctx.Unique = &unique
+	return pdq
+}
+
+// Order specifies how the records should be ordered.
+func (pdq *PaymentDeferralQuery) Order(o ...paymentdeferral.OrderOption) *PaymentDeferralQuery {
+	pdq.order = append(pdq.order, o...)
+	return pdq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (pdq *PaymentDeferralQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: pdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentdeferral.Table, paymentdeferral.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentdeferral.LoanTable, paymentdeferral.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PaymentDeferral entity from the query.
+// Returns a *NotFoundError when no PaymentDeferral was found.
+func (pdq *PaymentDeferralQuery) First(ctx context.Context) (*PaymentDeferral, error) {
+	nodes, err := pdq.Limit(1).All(setContextOp(ctx, pdq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{paymentdeferral.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) FirstX(ctx context.Context) *PaymentDeferral {
+	node, err := pdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PaymentDeferral ID from the query.
+// Returns a *NotFoundError when no PaymentDeferral ID was found.
+func (pdq *PaymentDeferralQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pdq.Limit(1).IDs(setContextOp(ctx, pdq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{paymentdeferral.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) FirstIDX(ctx context.Context) int {
+	id, err := pdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PaymentDeferral entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PaymentDeferral entity is found.
+// Returns a *NotFoundError when no PaymentDeferral entities are found.
+func (pdq *PaymentDeferralQuery) Only(ctx context.Context) (*PaymentDeferral, error) {
+	nodes, err := pdq.Limit(2).All(setContextOp(ctx, pdq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{paymentdeferral.Label}
+	default:
+		return nil, &NotSingularError{paymentdeferral.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) OnlyX(ctx context.Context) *PaymentDeferral {
+	node, err := pdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PaymentDeferral ID in the query.
+// Returns a *NotSingularError when more than one PaymentDeferral ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pdq *PaymentDeferralQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pdq.Limit(2).IDs(setContextOp(ctx, pdq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{paymentdeferral.Label}
+	default:
+		err = &NotSingularError{paymentdeferral.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PaymentDeferrals.
+func (pdq *PaymentDeferralQuery) All(ctx context.Context) ([]*PaymentDeferral, error) {
+	ctx = setContextOp(ctx, pdq.ctx, "All")
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PaymentDeferral, *PaymentDeferralQuery]()
+	return withInterceptors[[]*PaymentDeferral](ctx, pdq, qr, pdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) AllX(ctx context.Context) []*PaymentDeferral {
+	nodes, err := pdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PaymentDeferral IDs.
+func (pdq *PaymentDeferralQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pdq.ctx.Unique == nil && pdq.path != nil {
+		pdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pdq.ctx, "IDs")
+	if err = pdq.Select(paymentdeferral.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) IDsX(ctx context.Context) []int {
+	ids, err := pdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pdq *PaymentDeferralQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pdq.ctx, "Count")
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pdq, querierCount[*PaymentDeferralQuery](), pdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) CountX(ctx context.Context) int {
+	count, err := pdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pdq *PaymentDeferralQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pdq.ctx, "Exist")
+	switch _, err := pdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pdq *PaymentDeferralQuery) ExistX(ctx context.Context) bool {
+	exist, err := pdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PaymentDeferralQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pdq *PaymentDeferralQuery) Clone() *PaymentDeferralQuery {
+	if pdq == nil {
+		return nil
+	}
+	return &PaymentDeferralQuery{
+		config:     pdq.config,
+		ctx:        pdq.ctx.Clone(),
+		order:      append([]paymentdeferral.OrderOption{}, pdq.order...),
+		inters:     append([]Interceptor{}, pdq.inters...),
+		predicates: append([]predicate.PaymentDeferral{}, pdq.predicates...),
+		withLoan:   pdq.withLoan.Clone(),
+		// clone intermediate query.
+		sql:  pdq.sql.Clone(),
+		path: pdq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (pdq *PaymentDeferralQuery) WithLoan(opts ...func(*LoanQuery)) *PaymentDeferralQuery {
+	query := (&LoanClient{config: pdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pdq.withLoan = query
+	return pdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PaymentDeferral.Query().
+//		GroupBy(paymentdeferral.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pdq *PaymentDeferralQuery) GroupBy(field string, fields ...string) *PaymentDeferralGroupBy {
+	pdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PaymentDeferralGroupBy{build: pdq}
+	grbuild.flds = &pdq.ctx.Fields
+	grbuild.label = paymentdeferral.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.PaymentDeferral.Query().
+//		Select(paymentdeferral.FieldLoanID).
+//		Scan(ctx, &v)
+func (pdq *PaymentDeferralQuery) Select(fields ...string) *PaymentDeferralSelect {
+	pdq.ctx.Fields = append(pdq.ctx.Fields, fields...)
+	sbuild := &PaymentDeferralSelect{PaymentDeferralQuery: pdq}
+	sbuild.label = paymentdeferral.Label
+	sbuild.flds, sbuild.scan = &pdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PaymentDeferralSelect configured with the given aggregations.
+func (pdq *PaymentDeferralQuery) Aggregate(fns ...AggregateFunc) *PaymentDeferralSelect {
+	return pdq.Select().Aggregate(fns...)
+}
+
+func (pdq *PaymentDeferralQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pdq.ctx.Fields {
+		if !paymentdeferral.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pdq.path != nil {
+		prev, err := pdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pdq.sql = prev
+	}
+	return nil
+}
+
+func (pdq *PaymentDeferralQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PaymentDeferral, error) {
+	var (
+		nodes       = []*PaymentDeferral{}
+		_spec       = pdq.querySpec()
+		loadedTypes = [1]bool{
+			pdq.withLoan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PaymentDeferral).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PaymentDeferral{config: pdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pdq.withLoan; query != nil {
+		if err := pdq.loadLoan(ctx, query, nodes, nil,
+			func(n *PaymentDeferral, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pdq *PaymentDeferralQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*PaymentDeferral, init func(*PaymentDeferral), assign func(*PaymentDeferral, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PaymentDeferral)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pdq *PaymentDeferralQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pdq.querySpec()
+	_spec.Node.Columns = pdq.ctx.Fields
+	if len(pdq.ctx.Fields) > 0 {
+		_spec.Unique = pdq.ctx.Unique != nil && *pdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pdq.driver, _spec)
+}
+
+func (pdq *PaymentDeferralQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(paymentdeferral.Table, paymentdeferral.Columns, sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt))
+	_spec.From = pdq.sql
+	if unique := pdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentdeferral.FieldID)
+		for i := range fields {
+			if fields[i] != paymentdeferral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pdq.withLoan != nil {
+			_spec.Node.AddColumnOnce(paymentdeferral.FieldLoanID)
+		}
+	}
+	if ps := pdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pdq *PaymentDeferralQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pdq.driver.Dialect())
+	t1 := builder.Table(paymentdeferral.Table)
+	columns := pdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = paymentdeferral.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pdq.sql != nil {
+		selector = pdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pdq.ctx.Unique != nil && *pdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pdq.predicates {
+		p(selector)
+	}
+	for _, p := range pdq.order {
+		p(selector)
+	}
+	if offset := pdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PaymentDeferralGroupBy is the group-by builder for PaymentDeferral entities.
+type PaymentDeferralGroupBy struct {
+	selector
+	build *PaymentDeferralQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pdgb *PaymentDeferralGroupBy) Aggregate(fns ...AggregateFunc) *PaymentDeferralGroupBy {
+	pdgb.fns = append(pdgb.fns, fns...)
+	return pdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pdgb *PaymentDeferralGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pdgb.build.ctx, "GroupBy")
+	if err := pdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentDeferralQuery, *PaymentDeferralGroupBy](ctx, pdgb.build, pdgb, pdgb.build.inters, v)
+}
+
+func (pdgb *PaymentDeferralGroupBy) sqlScan(ctx context.Context, root *PaymentDeferralQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pdgb.fns))
+	for _, fn := range pdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pdgb.flds)+len(pdgb.fns))
+		for _, f := range *pdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PaymentDeferralSelect is the builder for selecting fields of PaymentDeferral entities.
+type PaymentDeferralSelect struct {
+	*PaymentDeferralQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pds *PaymentDeferralSelect) Aggregate(fns ...AggregateFunc) *PaymentDeferralSelect {
+	pds.fns = append(pds.fns, fns...)
+	return pds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pds *PaymentDeferralSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pds.ctx, "Select")
+	if err := pds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PaymentDeferralQuery, *PaymentDeferralSelect](ctx, pds.PaymentDeferralQuery, pds, pds.inters, v)
+}
+
+func (pds *PaymentDeferralSelect) sqlScan(ctx context.Context, root *PaymentDeferralQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pds.fns))
+	for _, fn := range pds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
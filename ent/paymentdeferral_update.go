@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// PaymentDeferralUpdate is the builder for updating PaymentDeferral entities.
+type PaymentDeferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentDeferralMutation
+}
+
+// Where appends a list predicates to the PaymentDeferralUpdate builder.
+func (pdu *PaymentDeferralUpdate) Where(ps ...predicate.PaymentDeferral) *PaymentDeferralUpdate {
+	pdu.mutation.Where(ps...)
+	return pdu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (pdu *PaymentDeferralUpdate) SetLoanID(i int) *PaymentDeferralUpdate {
+	pdu.mutation.SetLoanID(i)
+	return pdu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (pdu *PaymentDeferralUpdate) SetNillableLoanID(i *int) *PaymentDeferralUpdate {
+	if i != nil {
+		pdu.SetLoanID(*i)
+	}
+	return pdu
+}
+
+// SetKind sets the "kind" field.
+func (pdu *PaymentDeferralUpdate) SetKind(pa paymentdeferral.Kind) *PaymentDeferralUpdate {
+	pdu.mutation.SetKind(pa)
+	return pdu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pdu *PaymentDeferralUpdate) SetNillableKind(pa *paymentdeferral.Kind) *PaymentDeferralUpdate {
+	if pa != nil {
+		pdu.SetKind(*pa)
+	}
+	return pdu
+}
+
+// SetStartMonth sets the "start_month" field.
+func (pdu *PaymentDeferralUpdate) SetStartMonth(i int) *PaymentDeferralUpdate {
+	pdu.mutation.ResetStartMonth()
+	pdu.mutation.SetStartMonth(i)
+	return pdu
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (pdu *PaymentDeferralUpdate) SetNillableStartMonth(i *int) *PaymentDeferralUpdate {
+	if i != nil {
+		pdu.SetStartMonth(*i)
+	}
+	return pdu
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (pdu *PaymentDeferralUpdate) AddStartMonth(i int) *PaymentDeferralUpdate {
+	pdu.mutation.AddStartMonth(i)
+	return pdu
+}
+
+// SetMonths sets the "months" field.
+func (pdu *PaymentDeferralUpdate) SetMonths(i int) *PaymentDeferralUpdate {
+	pdu.mutation.ResetMonths()
+	pdu.mutation.SetMonths(i)
+	return pdu
+}
+
+// SetNillableMonths sets the "months" field if the given value is not nil.
+func (pdu *PaymentDeferralUpdate) SetNillableMonths(i *int) *PaymentDeferralUpdate {
+	if i != nil {
+		pdu.SetMonths(*i)
+	}
+	return pdu
+}
+
+// AddMonths adds i to the "months" field.
+func (pdu *PaymentDeferralUpdate) AddMonths(i int) *PaymentDeferralUpdate {
+	pdu.mutation.AddMonths(i)
+	return pdu
+}
+
+// SetInterest sets the "interest" field.
+func (pdu *PaymentDeferralUpdate) SetInterest(pa paymentdeferral.Interest) *PaymentDeferralUpdate {
+	pdu.mutation.SetInterest(pa)
+	return pdu
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (pdu *PaymentDeferralUpdate) SetNillableInterest(pa *paymentdeferral.Interest) *PaymentDeferralUpdate {
+	if pa != nil {
+		pdu.SetInterest(*pa)
+	}
+	return pdu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (pdu *PaymentDeferralUpdate) SetLoan(l *Loan) *PaymentDeferralUpdate {
+	return pdu.SetLoanID(l.ID)
+}
+
+// Mutation returns the PaymentDeferralMutation object of the builder.
+func (pdu *PaymentDeferralUpdate) Mutation() *PaymentDeferralMutation {
+	return pdu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (pdu *PaymentDeferralUpdate) ClearLoan() *PaymentDeferralUpdate {
+	pdu.mutation.ClearLoan()
+	return pdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pdu *PaymentDeferralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdu.sqlSave, pdu.mutation, pdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdu *PaymentDeferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := pdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pdu *PaymentDeferralUpdate) Exec(ctx context.Context) error {
+	_, err := pdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdu *PaymentDeferralUpdate) ExecX(ctx context.Context) {
+	if err := pdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdu *PaymentDeferralUpdate) check() error {
+	if v, ok := pdu.mutation.Kind(); ok {
+		if err := paymentdeferral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.kind": %w`, err)}
+		}
+	}
+	if v, ok := pdu.mutation.Interest(); ok {
+		if err := paymentdeferral.InterestValidator(v); err != nil {
+			return &ValidationError{Name: "interest", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.interest": %w`, err)}
+		}
+	}
+	if _, ok := pdu.mutation.LoanID(); pdu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PaymentDeferral.loan"`)
+	}
+	return nil
+}
+
+func (pdu *PaymentDeferralUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentdeferral.Table, paymentdeferral.Columns, sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt))
+	if ps := pdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pdu.mutation.Kind(); ok {
+		_spec.SetField(paymentdeferral.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pdu.mutation.StartMonth(); ok {
+		_spec.SetField(paymentdeferral.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := pdu.mutation.AddedStartMonth(); ok {
+		_spec.AddField(paymentdeferral.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := pdu.mutation.Months(); ok {
+		_spec.SetField(paymentdeferral.FieldMonths, field.TypeInt, value)
+	}
+	if value, ok := pdu.mutation.AddedMonths(); ok {
+		_spec.AddField(paymentdeferral.FieldMonths, field.TypeInt, value)
+	}
+	if value, ok := pdu.mutation.Interest(); ok {
+		_spec.SetField(paymentdeferral.FieldInterest, field.TypeEnum, value)
+	}
+	if pdu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdeferral.LoanTable,
+			Columns: []string{paymentdeferral.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pdu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdeferral.LoanTable,
+			Columns: []string{paymentdeferral.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentdeferral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pdu.mutation.done = true
+	return n, nil
+}
+
+// PaymentDeferralUpdateOne is the builder for updating a single PaymentDeferral entity.
+type PaymentDeferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentDeferralMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (pduo *PaymentDeferralUpdateOne) SetLoanID(i int) *PaymentDeferralUpdateOne {
+	pduo.mutation.SetLoanID(i)
+	return pduo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (pduo *PaymentDeferralUpdateOne) SetNillableLoanID(i *int) *PaymentDeferralUpdateOne {
+	if i != nil {
+		pduo.SetLoanID(*i)
+	}
+	return pduo
+}
+
+// SetKind sets the "kind" field.
+func (pduo *PaymentDeferralUpdateOne) SetKind(pa paymentdeferral.Kind) *PaymentDeferralUpdateOne {
+	pduo.mutation.SetKind(pa)
+	return pduo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pduo *PaymentDeferralUpdateOne) SetNillableKind(pa *paymentdeferral.Kind) *PaymentDeferralUpdateOne {
+	if pa != nil {
+		pduo.SetKind(*pa)
+	}
+	return pduo
+}
+
+// SetStartMonth sets the "start_month" field.
+func (pduo *PaymentDeferralUpdateOne) SetStartMonth(i int) *PaymentDeferralUpdateOne {
+	pduo.mutation.ResetStartMonth()
+	pduo.mutation.SetStartMonth(i)
+	return pduo
+}
+
+// SetNillableStartMonth sets the "start_month" field if the given value is not nil.
+func (pduo *PaymentDeferralUpdateOne) SetNillableStartMonth(i *int) *PaymentDeferralUpdateOne {
+	if i != nil {
+		pduo.SetStartMonth(*i)
+	}
+	return pduo
+}
+
+// AddStartMonth adds i to the "start_month" field.
+func (pduo *PaymentDeferralUpdateOne) AddStartMonth(i int) *PaymentDeferralUpdateOne {
+	pduo.mutation.AddStartMonth(i)
+	return pduo
+}
+
+// SetMonths sets the "months" field.
+func (pduo *PaymentDeferralUpdateOne) SetMonths(i int) *PaymentDeferralUpdateOne {
+	pduo.mutation.ResetMonths()
+	pduo.mutation.SetMonths(i)
+	return pduo
+}
+
+// SetNillableMonths sets the "months" field if the given value is not nil.
+func (pduo *PaymentDeferralUpdateOne) SetNillableMonths(i *int) *PaymentDeferralUpdateOne {
+	if i != nil {
+		pduo.SetMonths(*i)
+	}
+	return pduo
+}
+
+// AddMonths adds i to the "months" field.
+func (pduo *PaymentDeferralUpdateOne) AddMonths(i int) *PaymentDeferralUpdateOne {
+	pduo.mutation.AddMonths(i)
+	return pduo
+}
+
+// SetInterest sets the "interest" field.
+func (pduo *PaymentDeferralUpdateOne) SetInterest(pa paymentdeferral.Interest) *PaymentDeferralUpdateOne {
+	pduo.mutation.SetInterest(pa)
+	return pduo
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (pduo *PaymentDeferralUpdateOne) SetNillableInterest(pa *paymentdeferral.Interest) *PaymentDeferralUpdateOne {
+	if pa != nil {
+		pduo.SetInterest(*pa)
+	}
+	return pduo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (pduo *PaymentDeferralUpdateOne) SetLoan(l *Loan) *PaymentDeferralUpdateOne {
+	return pduo.SetLoanID(l.ID)
+}
+
+// Mutation returns the PaymentDeferralMutation object of the builder.
+func (pduo *PaymentDeferralUpdateOne) Mutation() *PaymentDeferralMutation {
+	return pduo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (pduo *PaymentDeferralUpdateOne) ClearLoan() *PaymentDeferralUpdateOne {
+	pduo.mutation.ClearLoan()
+	return pduo
+}
+
+// Where appends a list predicates to the PaymentDeferralUpdate builder.
+func (pduo *PaymentDeferralUpdateOne) Where(ps ...predicate.PaymentDeferral) *PaymentDeferralUpdateOne {
+	pduo.mutation.Where(ps...)
+	return pduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pduo *PaymentDeferralUpdateOne) Select(field string, fields ...string) *PaymentDeferralUpdateOne {
+	pduo.fields = append([]string{field}, fields...)
+	return pduo
+}
+
+// Save executes the query and returns the updated PaymentDeferral entity.
+func (pduo *PaymentDeferralUpdateOne) Save(ctx context.Context) (*PaymentDeferral, error) {
+	return withHooks(ctx, pduo.sqlSave, pduo.mutation, pduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pduo *PaymentDeferralUpdateOne) SaveX(ctx context.Context) *PaymentDeferral {
+	node, err := pduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pduo *PaymentDeferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := pduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pduo *PaymentDeferralUpdateOne) ExecX(ctx context.Context) {
+	if err := pduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pduo *PaymentDeferralUpdateOne) check() error {
+	if v, ok := pduo.mutation.Kind(); ok {
+		if err := paymentdeferral.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.kind": %w`, err)}
+		}
+	}
+	if v, ok := pduo.mutation.Interest(); ok {
+		if err := paymentdeferral.InterestValidator(v); err != nil {
+			return &ValidationError{Name: "interest", err: fmt.Errorf(`ent: validator failed for field "PaymentDeferral.interest": %w`, err)}
+		}
+	}
+	if _, ok := pduo.mutation.LoanID(); pduo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PaymentDeferral.loan"`)
+	}
+	return nil
+}
+
+func (pduo *PaymentDeferralUpdateOne) sqlSave(ctx context.Context) (_node *PaymentDeferral, err error) {
+	if err := pduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentdeferral.Table, paymentdeferral.Columns, sqlgraph.NewFieldSpec(paymentdeferral.FieldID, field.TypeInt))
+	id, ok := pduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentDeferral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentdeferral.FieldID)
+		for _, f := range fields {
+			if !paymentdeferral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentdeferral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pduo.mutation.Kind(); ok {
+		_spec.SetField(paymentdeferral.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pduo.mutation.StartMonth(); ok {
+		_spec.SetField(paymentdeferral.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := pduo.mutation.AddedStartMonth(); ok {
+		_spec.AddField(paymentdeferral.FieldStartMonth, field.TypeInt, value)
+	}
+	if value, ok := pduo.mutation.Months(); ok {
+		_spec.SetField(paymentdeferral.FieldMonths, field.TypeInt, value)
+	}
+	if value, ok := pduo.mutation.AddedMonths(); ok {
+		_spec.AddField(paymentdeferral.FieldMonths, field.TypeInt, value)
+	}
+	if value, ok := pduo.mutation.Interest(); ok {
+		_spec.SetField(paymentdeferral.FieldInterest, field.TypeEnum, value)
+	}
+	if pduo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdeferral.LoanTable,
+			Columns: []string{paymentdeferral.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pduo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentdeferral.LoanTable,
+			Columns: []string{paymentdeferral.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentDeferral{config: pduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentdeferral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pduo.mutation.done = true
+	return _node, nil
+}
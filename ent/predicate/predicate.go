@@ -6,11 +6,99 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// Collateral is the predicate function for collateral builders.
+type Collateral func(*sql.Selector)
+
+// CollateralAppraisal is the predicate function for collateralappraisal builders.
+type CollateralAppraisal func(*sql.Selector)
+
+// CreditLine is the predicate function for creditline builders.
+type CreditLine func(*sql.Selector)
+
+// CreditLineTransaction is the predicate function for creditlinetransaction builders.
+type CreditLineTransaction func(*sql.Selector)
+
+// EscrowItem is the predicate function for escrowitem builders.
+type EscrowItem func(*sql.Selector)
+
+// IncomeCertification is the predicate function for incomecertification builders.
+type IncomeCertification func(*sql.Selector)
+
+// IncomeDrivenPlan is the predicate function for incomedrivenplan builders.
+type IncomeDrivenPlan func(*sql.Selector)
+
 // Loan is the predicate function for loan builders.
 type Loan func(*sql.Selector)
 
+// LoanDisbursement is the predicate function for loandisbursement builders.
+type LoanDisbursement func(*sql.Selector)
+
+// LoanModification is the predicate function for loanmodification builders.
+type LoanModification func(*sql.Selector)
+
+// LoanObligor is the predicate function for loanobligor builders.
+type LoanObligor func(*sql.Selector)
+
+// LoanRecast is the predicate function for loanrecast builders.
+type LoanRecast func(*sql.Selector)
+
+// OidcLogin is the predicate function for oidclogin builders.
+type OidcLogin func(*sql.Selector)
+
+// PaymentDeferral is the predicate function for paymentdeferral builders.
+type PaymentDeferral func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// ShareInvitation is the predicate function for shareinvitation builders.
+type ShareInvitation func(*sql.Selector)
+
+// ShareLink is the predicate function for sharelink builders.
+type ShareLink func(*sql.Selector)
+
+// ShareLinkAccess is the predicate function for sharelinkaccess builders.
+type ShareLinkAccess func(*sql.Selector)
+
 // SharedLoan is the predicate function for sharedloan builders.
 type SharedLoan func(*sql.Selector)
 
+// StaffRole is the predicate function for staffrole builders.
+type StaffRole func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserOrErr calls the predicate only if the error is not nit.
+func UserOrErr(p User, err error) User {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// UserChange is the predicate function for userchange builders.
+type UserChange func(*sql.Selector)
+
+// UserChangeOrErr calls the predicate only if the error is not nit.
+func UserChangeOrErr(p UserChange, err error) UserChange {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// UserCredential is the predicate function for usercredential builders.
+type UserCredential func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
@@ -0,0 +1,711 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacy
+
+import (
+	"context"
+
+	"github.com/crusyn/loans/ent"
+
+	"entgo.io/ent/privacy"
+)
+
+var (
+	// Allow may be returned by rules to indicate that the policy
+	// evaluation should terminate with allow decision.
+	Allow = privacy.Allow
+
+	// Deny may be returned by rules to indicate that the policy
+	// evaluation should terminate with deny decision.
+	Deny = privacy.Deny
+
+	// Skip may be returned by rules to indicate that the policy
+	// evaluation should continue to the next rule.
+	Skip = privacy.Skip
+)
+
+// Allowf returns a formatted wrapped Allow decision.
+func Allowf(format string, a ...any) error {
+	return privacy.Allowf(format, a...)
+}
+
+// Denyf returns a formatted wrapped Deny decision.
+func Denyf(format string, a ...any) error {
+	return privacy.Denyf(format, a...)
+}
+
+// Skipf returns a formatted wrapped Skip decision.
+func Skipf(format string, a ...any) error {
+	return privacy.Skipf(format, a...)
+}
+
+// DecisionContext creates a new context from the given parent context with
+// a policy decision attach to it.
+func DecisionContext(parent context.Context, decision error) context.Context {
+	return privacy.DecisionContext(parent, decision)
+}
+
+// DecisionFromContext retrieves the policy decision from the context.
+func DecisionFromContext(ctx context.Context) (error, bool) {
+	return privacy.DecisionFromContext(ctx)
+}
+
+type (
+	// Policy groups query and mutation policies.
+	Policy = privacy.Policy
+
+	// QueryRule defines the interface deciding whether a
+	// query is allowed and optionally modify it.
+	QueryRule = privacy.QueryRule
+	// QueryPolicy combines multiple query rules into a single policy.
+	QueryPolicy = privacy.QueryPolicy
+
+	// MutationRule defines the interface which decides whether a
+	// mutation is allowed and optionally modifies it.
+	MutationRule = privacy.MutationRule
+	// MutationPolicy combines multiple mutation rules into a single policy.
+	MutationPolicy = privacy.MutationPolicy
+	// MutationRuleFunc type is an adapter which allows the use of
+	// ordinary functions as mutation rules.
+	MutationRuleFunc = privacy.MutationRuleFunc
+
+	// QueryMutationRule is an interface which groups query and mutation rules.
+	QueryMutationRule = privacy.QueryMutationRule
+)
+
+// QueryRuleFunc type is an adapter to allow the use of
+// ordinary functions as query rules.
+type QueryRuleFunc func(context.Context, ent.Query) error
+
+// Eval returns f(ctx, q).
+func (f QueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	return f(ctx, q)
+}
+
+// AlwaysAllowRule returns a rule that returns an allow decision.
+func AlwaysAllowRule() QueryMutationRule {
+	return privacy.AlwaysAllowRule()
+}
+
+// AlwaysDenyRule returns a rule that returns a deny decision.
+func AlwaysDenyRule() QueryMutationRule {
+	return privacy.AlwaysDenyRule()
+}
+
+// ContextQueryMutationRule creates a query/mutation rule from a context eval func.
+func ContextQueryMutationRule(eval func(context.Context) error) QueryMutationRule {
+	return privacy.ContextQueryMutationRule(eval)
+}
+
+// OnMutationOperation evaluates the given rule only on a given mutation operation.
+func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
+	return privacy.OnMutationOperation(rule, op)
+}
+
+// DenyMutationOperationRule returns a rule denying specified mutation operation.
+func DenyMutationOperationRule(op ent.Op) MutationRule {
+	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
+		return Denyf("ent/privacy: operation %s is not allowed", m.Op())
+	})
+	return OnMutationOperation(rule, op)
+}
+
+// The ApiKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ApiKeyQueryRuleFunc func(context.Context, *ent.ApiKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ApiKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ApiKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ApiKeyQuery", q)
+}
+
+// The ApiKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ApiKeyMutationRuleFunc func(context.Context, *ent.ApiKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ApiKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ApiKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ApiKeyMutation", m)
+}
+
+// The CollateralQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CollateralQueryRuleFunc func(context.Context, *ent.CollateralQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CollateralQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CollateralQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CollateralQuery", q)
+}
+
+// The CollateralMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CollateralMutationRuleFunc func(context.Context, *ent.CollateralMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CollateralMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CollateralMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CollateralMutation", m)
+}
+
+// The CollateralAppraisalQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CollateralAppraisalQueryRuleFunc func(context.Context, *ent.CollateralAppraisalQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CollateralAppraisalQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CollateralAppraisalQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CollateralAppraisalQuery", q)
+}
+
+// The CollateralAppraisalMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CollateralAppraisalMutationRuleFunc func(context.Context, *ent.CollateralAppraisalMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CollateralAppraisalMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CollateralAppraisalMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CollateralAppraisalMutation", m)
+}
+
+// The CreditLineQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CreditLineQueryRuleFunc func(context.Context, *ent.CreditLineQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CreditLineQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CreditLineQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CreditLineQuery", q)
+}
+
+// The CreditLineMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CreditLineMutationRuleFunc func(context.Context, *ent.CreditLineMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CreditLineMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CreditLineMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CreditLineMutation", m)
+}
+
+// The CreditLineTransactionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CreditLineTransactionQueryRuleFunc func(context.Context, *ent.CreditLineTransactionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CreditLineTransactionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CreditLineTransactionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CreditLineTransactionQuery", q)
+}
+
+// The CreditLineTransactionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CreditLineTransactionMutationRuleFunc func(context.Context, *ent.CreditLineTransactionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CreditLineTransactionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CreditLineTransactionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CreditLineTransactionMutation", m)
+}
+
+// The EscrowItemQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type EscrowItemQueryRuleFunc func(context.Context, *ent.EscrowItemQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f EscrowItemQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EscrowItemQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.EscrowItemQuery", q)
+}
+
+// The EscrowItemMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type EscrowItemMutationRuleFunc func(context.Context, *ent.EscrowItemMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f EscrowItemMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.EscrowItemMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.EscrowItemMutation", m)
+}
+
+// The IncomeCertificationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type IncomeCertificationQueryRuleFunc func(context.Context, *ent.IncomeCertificationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f IncomeCertificationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.IncomeCertificationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.IncomeCertificationQuery", q)
+}
+
+// The IncomeCertificationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type IncomeCertificationMutationRuleFunc func(context.Context, *ent.IncomeCertificationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f IncomeCertificationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.IncomeCertificationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.IncomeCertificationMutation", m)
+}
+
+// The IncomeDrivenPlanQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type IncomeDrivenPlanQueryRuleFunc func(context.Context, *ent.IncomeDrivenPlanQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f IncomeDrivenPlanQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.IncomeDrivenPlanQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.IncomeDrivenPlanQuery", q)
+}
+
+// The IncomeDrivenPlanMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type IncomeDrivenPlanMutationRuleFunc func(context.Context, *ent.IncomeDrivenPlanMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f IncomeDrivenPlanMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.IncomeDrivenPlanMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.IncomeDrivenPlanMutation", m)
+}
+
+// The LoanQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoanQueryRuleFunc func(context.Context, *ent.LoanQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoanQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoanQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoanQuery", q)
+}
+
+// The LoanMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoanMutationRuleFunc func(context.Context, *ent.LoanMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoanMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoanMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoanMutation", m)
+}
+
+// The LoanDisbursementQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoanDisbursementQueryRuleFunc func(context.Context, *ent.LoanDisbursementQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoanDisbursementQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoanDisbursementQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoanDisbursementQuery", q)
+}
+
+// The LoanDisbursementMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoanDisbursementMutationRuleFunc func(context.Context, *ent.LoanDisbursementMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoanDisbursementMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoanDisbursementMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoanDisbursementMutation", m)
+}
+
+// The LoanModificationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoanModificationQueryRuleFunc func(context.Context, *ent.LoanModificationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoanModificationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoanModificationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoanModificationQuery", q)
+}
+
+// The LoanModificationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoanModificationMutationRuleFunc func(context.Context, *ent.LoanModificationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoanModificationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoanModificationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoanModificationMutation", m)
+}
+
+// The LoanObligorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoanObligorQueryRuleFunc func(context.Context, *ent.LoanObligorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoanObligorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoanObligorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoanObligorQuery", q)
+}
+
+// The LoanObligorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoanObligorMutationRuleFunc func(context.Context, *ent.LoanObligorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoanObligorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoanObligorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoanObligorMutation", m)
+}
+
+// The LoanRecastQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LoanRecastQueryRuleFunc func(context.Context, *ent.LoanRecastQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LoanRecastQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LoanRecastQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LoanRecastQuery", q)
+}
+
+// The LoanRecastMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LoanRecastMutationRuleFunc func(context.Context, *ent.LoanRecastMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LoanRecastMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LoanRecastMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LoanRecastMutation", m)
+}
+
+// The OidcLoginQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OidcLoginQueryRuleFunc func(context.Context, *ent.OidcLoginQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OidcLoginQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OidcLoginQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OidcLoginQuery", q)
+}
+
+// The OidcLoginMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OidcLoginMutationRuleFunc func(context.Context, *ent.OidcLoginMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OidcLoginMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OidcLoginMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OidcLoginMutation", m)
+}
+
+// The PaymentDeferralQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PaymentDeferralQueryRuleFunc func(context.Context, *ent.PaymentDeferralQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PaymentDeferralQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PaymentDeferralQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PaymentDeferralQuery", q)
+}
+
+// The PaymentDeferralMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PaymentDeferralMutationRuleFunc func(context.Context, *ent.PaymentDeferralMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PaymentDeferralMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PaymentDeferralMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PaymentDeferralMutation", m)
+}
+
+// The SessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SessionQueryRuleFunc func(context.Context, *ent.SessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SessionQuery", q)
+}
+
+// The SessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SessionMutationRuleFunc func(context.Context, *ent.SessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SessionMutation", m)
+}
+
+// The ShareInvitationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareInvitationQueryRuleFunc func(context.Context, *ent.ShareInvitationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareInvitationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareInvitationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareInvitationQuery", q)
+}
+
+// The ShareInvitationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareInvitationMutationRuleFunc func(context.Context, *ent.ShareInvitationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareInvitationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareInvitationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareInvitationMutation", m)
+}
+
+// The ShareLinkQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareLinkQueryRuleFunc func(context.Context, *ent.ShareLinkQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareLinkQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareLinkQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareLinkQuery", q)
+}
+
+// The ShareLinkMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareLinkMutationRuleFunc func(context.Context, *ent.ShareLinkMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareLinkMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareLinkMutation", m)
+}
+
+// The ShareLinkAccessQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareLinkAccessQueryRuleFunc func(context.Context, *ent.ShareLinkAccessQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareLinkAccessQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareLinkAccessQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareLinkAccessQuery", q)
+}
+
+// The ShareLinkAccessMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareLinkAccessMutationRuleFunc func(context.Context, *ent.ShareLinkAccessMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareLinkAccessMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareLinkAccessMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareLinkAccessMutation", m)
+}
+
+// The SharedLoanQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SharedLoanQueryRuleFunc func(context.Context, *ent.SharedLoanQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SharedLoanQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SharedLoanQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SharedLoanQuery", q)
+}
+
+// The SharedLoanMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SharedLoanMutationRuleFunc func(context.Context, *ent.SharedLoanMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SharedLoanMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SharedLoanMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SharedLoanMutation", m)
+}
+
+// The StaffRoleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StaffRoleQueryRuleFunc func(context.Context, *ent.StaffRoleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StaffRoleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StaffRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StaffRoleQuery", q)
+}
+
+// The StaffRoleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StaffRoleMutationRuleFunc func(context.Context, *ent.StaffRoleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StaffRoleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StaffRoleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StaffRoleMutation", m)
+}
+
+// The UserQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserQuery", q)
+}
+
+// The UserMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserMutationRuleFunc func(context.Context, *ent.UserMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
+}
+
+// The UserChangeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserChangeQueryRuleFunc func(context.Context, *ent.UserChangeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserChangeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserChangeQuery", q)
+}
+
+// The UserChangeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserChangeMutationRuleFunc func(context.Context, *ent.UserChangeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserChangeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserChangeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserChangeMutation", m)
+}
+
+// The UserCredentialQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserCredentialQueryRuleFunc func(context.Context, *ent.UserCredentialQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserCredentialQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserCredentialQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserCredentialQuery", q)
+}
+
+// The UserCredentialMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserCredentialMutationRuleFunc func(context.Context, *ent.UserCredentialMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserCredentialMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserCredentialMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserCredentialMutation", m)
+}
+
+// The UserIdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserIdentityQueryRuleFunc func(context.Context, *ent.UserIdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserIdentityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserIdentityQuery", q)
+}
+
+// The UserIdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserIdentityMutationRuleFunc func(context.Context, *ent.UserIdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserIdentityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserIdentityMutation", m)
+}
@@ -2,7 +2,231 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/crusyn/loans/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/crusyn/loans/ent/apikey"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/crusyn/loans/ent/schema"
+	"github.com/crusyn/loans/ent/session"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/userchange"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+	"entgo.io/ent/schema/field"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[4].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	collateralFields := schema.Collateral{}.Fields()
+	_ = collateralFields
+	// collateralDescCreatedAt is the schema descriptor for created_at field.
+	collateralDescCreatedAt := collateralFields[2].Descriptor()
+	// collateral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collateral.DefaultCreatedAt = collateralDescCreatedAt.Default.(func() time.Time)
+	collateralappraisalFields := schema.CollateralAppraisal{}.Fields()
+	_ = collateralappraisalFields
+	// collateralappraisalDescCreatedAt is the schema descriptor for created_at field.
+	collateralappraisalDescCreatedAt := collateralappraisalFields[3].Descriptor()
+	// collateralappraisal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collateralappraisal.DefaultCreatedAt = collateralappraisalDescCreatedAt.Default.(func() time.Time)
+	creditlinetransactionFields := schema.CreditLineTransaction{}.Fields()
+	_ = creditlinetransactionFields
+	// creditlinetransactionDescCreatedAt is the schema descriptor for created_at field.
+	creditlinetransactionDescCreatedAt := creditlinetransactionFields[4].Descriptor()
+	// creditlinetransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	creditlinetransaction.DefaultCreatedAt = creditlinetransactionDescCreatedAt.Default.(func() time.Time)
+	escrowitemFields := schema.EscrowItem{}.Fields()
+	_ = escrowitemFields
+	// escrowitemDescDisbursementsPerYear is the schema descriptor for disbursements_per_year field.
+	escrowitemDescDisbursementsPerYear := escrowitemFields[4].Descriptor()
+	// escrowitem.DefaultDisbursementsPerYear holds the default value on creation for the disbursements_per_year field.
+	escrowitem.DefaultDisbursementsPerYear = escrowitemDescDisbursementsPerYear.Default.(int)
+	// escrowitemDescCreatedAt is the schema descriptor for created_at field.
+	escrowitemDescCreatedAt := escrowitemFields[5].Descriptor()
+	// escrowitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	escrowitem.DefaultCreatedAt = escrowitemDescCreatedAt.Default.(func() time.Time)
+	incomecertificationFields := schema.IncomeCertification{}.Fields()
+	_ = incomecertificationFields
+	// incomecertificationDescCreatedAt is the schema descriptor for created_at field.
+	incomecertificationDescCreatedAt := incomecertificationFields[4].Descriptor()
+	// incomecertification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	incomecertification.DefaultCreatedAt = incomecertificationDescCreatedAt.Default.(func() time.Time)
+	incomedrivenplanFields := schema.IncomeDrivenPlan{}.Fields()
+	_ = incomedrivenplanFields
+	// incomedrivenplanDescCreatedAt is the schema descriptor for created_at field.
+	incomedrivenplanDescCreatedAt := incomedrivenplanFields[6].Descriptor()
+	// incomedrivenplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	incomedrivenplan.DefaultCreatedAt = incomedrivenplanDescCreatedAt.Default.(func() time.Time)
+	loan.Policy = privacy.NewPolicies(schema.Loan{})
+	loan.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := loan.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	loanFields := schema.Loan{}.Fields()
+	_ = loanFields
+	// loanDescOriginatedAt is the schema descriptor for originated_at field.
+	loanDescOriginatedAt := loanFields[11].Descriptor()
+	// loan.DefaultOriginatedAt holds the default value on creation for the originated_at field.
+	loan.DefaultOriginatedAt = loanDescOriginatedAt.Default.(func() time.Time)
+	loandisbursementFields := schema.LoanDisbursement{}.Fields()
+	_ = loandisbursementFields
+	// loandisbursementDescCreatedAt is the schema descriptor for created_at field.
+	loandisbursementDescCreatedAt := loandisbursementFields[3].Descriptor()
+	// loandisbursement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loandisbursement.DefaultCreatedAt = loandisbursementDescCreatedAt.Default.(func() time.Time)
+	loanmodificationFields := schema.LoanModification{}.Fields()
+	_ = loanmodificationFields
+	// loanmodificationDescCapitalizedArrears is the schema descriptor for capitalized_arrears field.
+	loanmodificationDescCapitalizedArrears := loanmodificationFields[4].Descriptor()
+	// loanmodification.DefaultCapitalizedArrears holds the default value on creation for the capitalized_arrears field.
+	loanmodification.DefaultCapitalizedArrears = loanmodificationDescCapitalizedArrears.Default.(int)
+	// loanmodificationDescCreatedAt is the schema descriptor for created_at field.
+	loanmodificationDescCreatedAt := loanmodificationFields[5].Descriptor()
+	// loanmodification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loanmodification.DefaultCreatedAt = loanmodificationDescCreatedAt.Default.(func() time.Time)
+	loanobligorFields := schema.LoanObligor{}.Fields()
+	_ = loanobligorFields
+	// loanobligorDescCreatedAt is the schema descriptor for created_at field.
+	loanobligorDescCreatedAt := loanobligorFields[5].Descriptor()
+	// loanobligor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loanobligor.DefaultCreatedAt = loanobligorDescCreatedAt.Default.(func() time.Time)
+	loanrecastFields := schema.LoanRecast{}.Fields()
+	_ = loanrecastFields
+	// loanrecastDescCurtailment is the schema descriptor for curtailment field.
+	loanrecastDescCurtailment := loanrecastFields[2].Descriptor()
+	// loanrecast.DefaultCurtailment holds the default value on creation for the curtailment field.
+	loanrecast.DefaultCurtailment = loanrecastDescCurtailment.Default.(int)
+	// loanrecastDescCreatedAt is the schema descriptor for created_at field.
+	loanrecastDescCreatedAt := loanrecastFields[3].Descriptor()
+	// loanrecast.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loanrecast.DefaultCreatedAt = loanrecastDescCreatedAt.Default.(func() time.Time)
+	paymentdeferralFields := schema.PaymentDeferral{}.Fields()
+	_ = paymentdeferralFields
+	// paymentdeferralDescCreatedAt is the schema descriptor for created_at field.
+	paymentdeferralDescCreatedAt := paymentdeferralFields[5].Descriptor()
+	// paymentdeferral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentdeferral.DefaultCreatedAt = paymentdeferralDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[4].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	shareinvitationFields := schema.ShareInvitation{}.Fields()
+	_ = shareinvitationFields
+	// shareinvitationDescCreatedAt is the schema descriptor for created_at field.
+	shareinvitationDescCreatedAt := shareinvitationFields[6].Descriptor()
+	// shareinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shareinvitation.DefaultCreatedAt = shareinvitationDescCreatedAt.Default.(func() time.Time)
+	sharelinkFields := schema.ShareLink{}.Fields()
+	_ = sharelinkFields
+	// sharelinkDescCreatedAt is the schema descriptor for created_at field.
+	sharelinkDescCreatedAt := sharelinkFields[4].Descriptor()
+	// sharelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharelink.DefaultCreatedAt = sharelinkDescCreatedAt.Default.(func() time.Time)
+	sharelinkaccessFields := schema.ShareLinkAccess{}.Fields()
+	_ = sharelinkaccessFields
+	// sharelinkaccessDescAccessedAt is the schema descriptor for accessed_at field.
+	sharelinkaccessDescAccessedAt := sharelinkaccessFields[3].Descriptor()
+	// sharelinkaccess.DefaultAccessedAt holds the default value on creation for the accessed_at field.
+	sharelinkaccess.DefaultAccessedAt = sharelinkaccessDescAccessedAt.Default.(func() time.Time)
+	sharedloan.Policy = privacy.NewPolicies(schema.SharedLoan{})
+	sharedloan.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := sharedloan.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	sharedloanFields := schema.SharedLoan{}.Fields()
+	_ = sharedloanFields
+	staffroleFields := schema.StaffRole{}.Fields()
+	_ = staffroleFields
+	// staffroleDescCreatedAt is the schema descriptor for created_at field.
+	staffroleDescCreatedAt := staffroleFields[2].Descriptor()
+	// staffrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	staffrole.DefaultCreatedAt = staffroleDescCreatedAt.Default.(func() time.Time)
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userHooks := schema.User{}.Hooks()
+
+	user.Hooks[1] = userHooks[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[0].Descriptor()
+	user.ValueScanner.Name = userDescName.ValueScanner.(field.TypeValueScanner[string])
+	// userDescAddress is the schema descriptor for address field.
+	userDescAddress := userFields[6].Descriptor()
+	user.ValueScanner.Address = userDescAddress.ValueScanner.(field.TypeValueScanner[string])
+	userchangeFields := schema.UserChange{}.Fields()
+	_ = userchangeFields
+	// userchangeDescOldValue is the schema descriptor for old_value field.
+	userchangeDescOldValue := userchangeFields[2].Descriptor()
+	userchange.ValueScanner.OldValue = userchangeDescOldValue.ValueScanner.(field.TypeValueScanner[string])
+	// userchangeDescNewValue is the schema descriptor for new_value field.
+	userchangeDescNewValue := userchangeFields[3].Descriptor()
+	userchange.ValueScanner.NewValue = userchangeDescNewValue.ValueScanner.(field.TypeValueScanner[string])
+	// userchangeDescCreatedAt is the schema descriptor for created_at field.
+	userchangeDescCreatedAt := userchangeFields[5].Descriptor()
+	// userchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userchange.DefaultCreatedAt = userchangeDescCreatedAt.Default.(func() time.Time)
+	usercredentialFields := schema.UserCredential{}.Fields()
+	_ = usercredentialFields
+	// usercredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	usercredentialDescUpdatedAt := usercredentialFields[2].Descriptor()
+	// usercredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usercredential.DefaultUpdatedAt = usercredentialDescUpdatedAt.Default.(func() time.Time)
+	// usercredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usercredential.UpdateDefaultUpdatedAt = usercredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[3].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.12.5"                                         // Version of ent codegen.
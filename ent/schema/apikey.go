@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity.
+// API keys authenticate other services, only a hash of the key is stored.  Like staff, each key's
+// roles give it permissions across the portfolio.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),   // the service using the key
+		field.Strings("roles"), // staff roles, loan_officer, servicer, auditor or admin
+		field.String("key_hash").
+			Unique().
+			Sensitive(), // hex SHA-256 of the key
+		field.Time("revoked_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return nil
+}
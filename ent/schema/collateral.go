@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Collateral holds the schema definition for the Collateral entity.
+// Collateral secures one or more loans and is valued by appraisals over time.
+type Collateral struct {
+	ent.Schema
+}
+
+// Fields of the Collateral.
+func (Collateral) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("real_estate", "vehicle", "equipment"),
+		field.String("description").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Collateral.
+func (Collateral) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("appraisals", CollateralAppraisal.Type),
+		edge.To("loans", Loan.Type),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CollateralAppraisal holds the schema definition for the CollateralAppraisal entity.
+// The latest appraisal on a date is the collateral's value on that date.
+type CollateralAppraisal struct {
+	ent.Schema
+}
+
+// Fields of the CollateralAppraisal.
+func (CollateralAppraisal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("collateral_id"),
+		field.Int("value"), // in cents
+		field.Time("appraised_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the CollateralAppraisal.
+func (CollateralAppraisal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collateral", Collateral.Type).
+			Ref("appraisals").
+			Field("collateral_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CreditLine holds the schema definition for the CreditLine entity.
+// A revolving line of credit can be drawn on up to its limit during the
+// draw period and is then paid down over the repayment period.
+type CreditLine struct {
+	ent.Schema
+}
+
+// Fields of the CreditLine.
+func (CreditLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("credit_limit"), // in cents
+		field.Float("rate"),
+		field.Int("draw_months"),
+		field.Int("repayment_months"),
+		field.Time("opened_at"), // billing cycles start on this day each month
+		field.Int("borrower_id"),
+	}
+}
+
+// Edges of the CreditLine.
+func (CreditLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("borrower", User.Type).
+			Ref("credit_lines").
+			Field("borrower_id").
+			Required().
+			Unique(),
+		edge.To("transactions", CreditLineTransaction.Type),
+	}
+}
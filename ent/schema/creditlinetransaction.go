@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CreditLineTransaction holds the schema definition for the CreditLineTransaction entity.
+// Draws add to the balance of a credit line and payments reduce it.
+type CreditLineTransaction struct {
+	ent.Schema
+}
+
+// Fields of the CreditLineTransaction.
+func (CreditLineTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("credit_line_id"),
+		field.Enum("kind").
+			Values("draw", "payment"),
+		field.Int("amount"),     // in cents
+		field.Time("posted_at"), // the day the balance changes
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the CreditLineTransaction.
+func (CreditLineTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("credit_line", CreditLine.Type).
+			Ref("transactions").
+			Field("credit_line_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EscrowItem holds the schema definition for the EscrowItem entity.
+// Escrow items are bills, like property taxes, that the servicer pays from the
+// escrow collected with each monthly payment.
+type EscrowItem struct {
+	ent.Schema
+}
+
+// Fields of the EscrowItem.
+func (EscrowItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Enum("kind").
+			Values("property_tax", "hazard_insurance", "pmi"),
+		field.Int("annual_amount"),   // in cents
+		field.Int("first_due_month"), // the loan month of the first disbursement
+		field.Int("disbursements_per_year").
+			Default(1), // the annual amount is split evenly between them
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the EscrowItem.
+func (EscrowItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("escrow_items").
+			Field("loan_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// IncomeCertification holds the schema definition for the IncomeCertification entity.
+// Each certification sets the income-driven payment for one year of repayment.
+type IncomeCertification struct {
+	ent.Schema
+}
+
+// Fields of the IncomeCertification.
+func (IncomeCertification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("plan_id"),
+		field.Int("year"),   // year of repayment on the plan, starting at 1
+		field.Int("income"), // in cents, annual
+		field.Int("family_size"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the IncomeCertification.
+func (IncomeCertification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plan", IncomeDrivenPlan.Type).
+			Ref("certifications").
+			Field("plan_id").
+			Required().
+			Unique(),
+	}
+}
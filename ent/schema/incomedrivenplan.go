@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// IncomeDrivenPlan holds the schema definition for the IncomeDrivenPlan entity.
+// The payment on an income-driven plan is a share of the borrower's discretionary
+// income, recalculated every year from an IncomeCertification.  Whatever is left
+// after forgiveness_months of repayment is forgiven.
+type IncomeDrivenPlan struct {
+	ent.Schema
+}
+
+// Fields of the IncomeDrivenPlan.
+func (IncomeDrivenPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id").
+			Unique(),
+		field.Int("start_month"),
+		field.Float("payment_percent"),    // share of discretionary income paid each year
+		field.Float("poverty_multiplier"), // multiple of the poverty guideline protected from payments
+		field.Enum("region").
+			Values("contiguous", "alaska", "hawaii"), // selects the poverty guideline table
+		field.Int("forgiveness_months"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the IncomeDrivenPlan.
+func (IncomeDrivenPlan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("income_driven_plan").
+			Field("loan_id").
+			Required().
+			Unique(),
+		edge.To("certifications", IncomeCertification.Type),
+	}
+}
@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/rule"
 )
 
 // Loan holds the schema definition for the Loan entity.
@@ -18,6 +22,29 @@ func (Loan) Fields() []ent.Field {
 		field.Float("rate"),
 		field.Int("term"), // In months
 		field.Int("borrower_id"),
+		// Graduated payment loans step the payment up every year for graduated_years
+		// years, a zero value means the loan has level payments.
+		field.Float("graduated_step_rate").
+			Optional(),
+		field.Int("graduated_years").
+			Optional(),
+		field.Float("negative_amortization_cap").
+			Optional(), // multiple of amount that forces a recast, zero for no cap
+		// Construction loans fund in disbursements and pay interest only on the disbursed
+		// principal for construction_months months, then amortize it over term months.
+		field.Int("construction_months").
+			Optional(),
+		field.Int("property_value").
+			Optional(), // in cents, the appraised value at origination
+		// Private mortgage insurance is charged at pmi_rate of amount a year until the
+		// balance reaches 78% of property_value, or 80% from a requested cancellation month.
+		field.Float("pmi_rate").
+			Optional(),
+		field.Int("pmi_cancellation_month").
+			Optional(),
+		field.Time("originated_at").
+			Default(time.Now).
+			Immutable(), // the first payment is due a month later
 	}
 }
 
@@ -30,5 +57,37 @@ func (Loan) Edges() []ent.Edge {
 			Required().
 			Unique(),
 		edge.To("shared_loan", SharedLoan.Type),
+		edge.To("share_invitations", ShareInvitation.Type),
+		edge.To("share_links", ShareLink.Type),
+		edge.To("modifications", LoanModification.Type),
+		edge.To("deferrals", PaymentDeferral.Type),
+		edge.To("recasts", LoanRecast.Type),
+		edge.To("income_driven_plan", IncomeDrivenPlan.Type).
+			Unique(),
+		edge.To("disbursements", LoanDisbursement.Type),
+		edge.To("escrow_items", EscrowItem.Type),
+		edge.To("obligors", LoanObligor.Type),
+		edge.From("collateral", Collateral.Type).
+			Ref("loans"),
+	}
+}
+
+// Policy of the Loan: borrowers and sharees can read a loan and only its borrowers can change it.
+// Staff see every loan, loan officers can create them and servicers change them.
+func (Loan) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfOriginating(),
+			rule.AllowIfServicing(ent.OpUpdate | ent.OpUpdateOne),
+			rule.FilterLoanMutations(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfStaff(),
+			rule.FilterLoans(),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
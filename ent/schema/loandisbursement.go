@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LoanDisbursement holds the schema definition for the LoanDisbursement entity.
+// A construction loan's amount is funded by its disbursements rather than at origination.
+type LoanDisbursement struct {
+	ent.Schema
+}
+
+// Fields of the LoanDisbursement.
+func (LoanDisbursement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Int("month"),  // interest accrues on the disbursement from this month
+		field.Int("amount"), // in cents
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoanDisbursement.
+func (LoanDisbursement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("disbursements").
+			Field("loan_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LoanModification holds the schema definition for the LoanModification entity.
+// Each modification is a new version of a loan's terms; the loan itself keeps the
+// original terms and modifications are never updated once saved.
+type LoanModification struct {
+	ent.Schema
+}
+
+// Fields of the LoanModification.
+func (LoanModification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Int("effective_month"), // first month the new terms apply to
+		field.Float("rate"),
+		field.Int("term"), // In months, counted from the effective month
+		field.Int("capitalized_arrears").
+			Default(0), // in cents, added to the balance at the effective month
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoanModification.
+func (LoanModification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("modifications").
+			Field("loan_id").
+			Required().
+			Unique(),
+	}
+}
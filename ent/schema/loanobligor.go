@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LoanObligor holds the schema definition for the LoanObligor entity.
+// Obligors are the users liable for a loan: its primary borrower, co-borrowers who
+// share the debt and guarantors who answer for it if the borrowers default.
+type LoanObligor struct {
+	ent.Schema
+}
+
+// Fields of the LoanObligor.
+func (LoanObligor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Int("user_id"),
+		field.Enum("role").
+			Values("primary", "co_borrower", "guarantor"),
+		field.Enum("liability").
+			Values("joint_and_several", "percentage").
+			Default("joint_and_several"),
+		field.Float("liability_percent").
+			Optional(), // share of the debt for percentage liability
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoanObligor.
+func (LoanObligor) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("obligors").
+			Field("loan_id").
+			Required().
+			Unique(),
+		edge.From("user", User.Type).
+			Ref("obligations").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
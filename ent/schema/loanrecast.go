@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LoanRecast holds the schema definition for the LoanRecast entity.
+// A recast re-amortizes the balance over the rest of the term after an
+// optional principal curtailment, lowering the payment but keeping the maturity.
+type LoanRecast struct {
+	ent.Schema
+}
+
+// Fields of the LoanRecast.
+func (LoanRecast) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Int("month"), // the payment drops from the following month
+		field.Int("curtailment").
+			Default(0), // in cents, paid toward principal in the recast month
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LoanRecast.
+func (LoanRecast) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("recasts").
+			Field("loan_id").
+			Required().
+			Unique(),
+	}
+}
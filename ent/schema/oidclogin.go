@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// OidcLogin holds the schema definition for the OidcLogin entity.
+// A login started with the identity provider, until it redirects back with the state.
+type OidcLogin struct {
+	ent.Schema
+}
+
+// Fields of the OidcLogin.
+func (OidcLogin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("state").
+			Unique(),
+		field.String("nonce"),
+		field.String("code_verifier").
+			Sensitive(), // PKCE, only the challenge is sent to the identity provider
+		field.Time("expires_at"),
+	}
+}
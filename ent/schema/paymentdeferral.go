@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PaymentDeferral holds the schema definition for the PaymentDeferral entity.
+// A deferral pauses payments for a number of months and pushes the maturity
+// of the loan back by the same number of months.
+type PaymentDeferral struct {
+	ent.Schema
+}
+
+// Fields of the PaymentDeferral.
+func (PaymentDeferral) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Enum("kind").
+			Values("forbearance", "skip_payment", "in_school", "grace"),
+		field.Int("start_month"),
+		field.Int("months"),
+		// What happens to the interest that accrues while payments are paused:
+		// capitalize adds it to the balance when payments resume, defer makes it
+		// due with the final payment and waive never charges it.
+		field.Enum("interest").
+			Values("capitalize", "defer", "waive"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PaymentDeferral.
+func (PaymentDeferral) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("deferrals").
+			Field("loan_id").
+			Required().
+			Unique(),
+	}
+}
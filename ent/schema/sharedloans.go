@@ -4,6 +4,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/rule"
 )
 
 // SharedLoan holds the schema definition for the SharedLoan entity.
@@ -16,6 +18,10 @@ func (SharedLoan) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("user_id"),
 		field.Int("loan_id"),
+		// Each permission includes the ones before it.
+		field.Enum("permission").
+			Values("view_balance", "view_schedule", "make_payments", "manage_shares").
+			Default("view_schedule"),
 	}
 }
 
@@ -34,3 +40,22 @@ func (SharedLoan) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Policy of the SharedLoan: only a loan's borrowers, or sharees they let manage shares, can share
+// it, besides servicers.  Users see their own shares and the shares of the loans they manage.
+func (SharedLoan) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfServicing(ent.OpCreate | ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne),
+			rule.FilterSharedLoanMutations(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfStaff(),
+			rule.FilterSharedLoans(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
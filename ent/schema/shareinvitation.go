@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareInvitation holds the schema definition for the ShareInvitation entity.
+// A loan is only shared with a user once they accept an invitation to it, before
+// it expires.
+type ShareInvitation struct {
+	ent.Schema
+}
+
+// Fields of the ShareInvitation.
+func (ShareInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.Int("user_id"), // the invited user
+		field.Enum("permission").
+			Values("view_balance", "view_schedule", "make_payments", "manage_shares").
+			Default("view_schedule"), // granted on acceptance
+		field.Enum("status").
+			Values("pending", "accepted", "declined").
+			Default("pending"),
+		field.Time("expires_at"),
+		field.Time("responded_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ShareInvitation.
+func (ShareInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("share_invitations").
+			Field("loan_id").
+			Required().
+			Unique(),
+		edge.From("user", User.Type).
+			Ref("share_invitations").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareLink holds the schema definition for the ShareLink entity.
+// Share links give people without an account read-only access to one loan
+// through a signed token until it expires or is revoked.
+type ShareLink struct {
+	ent.Schema
+}
+
+// Fields of the ShareLink.
+func (ShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("loan_id"),
+		field.String("description").
+			Optional(), // who the link was sent to
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ShareLink.
+func (ShareLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("loan", Loan.Type).
+			Ref("share_links").
+			Field("loan_id").
+			Required().
+			Unique(),
+		edge.To("accesses", ShareLinkAccess.Type),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareLinkAccess holds the schema definition for the ShareLinkAccess entity.
+// Every request made with a share link is logged.
+type ShareLinkAccess struct {
+	ent.Schema
+}
+
+// Fields of the ShareLinkAccess.
+func (ShareLinkAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("share_link_id"),
+		field.String("path"),
+		field.String("ip"),
+		field.Time("accessed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ShareLinkAccess.
+func (ShareLinkAccess) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("share_link", ShareLink.Type).
+			Ref("accesses").
+			Field("share_link_id").
+			Required().
+			Unique(),
+	}
+}
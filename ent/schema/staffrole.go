@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// StaffRole holds the schema definition for the StaffRole entity.
+// Staff are users with roles, which let them work across every loan rather than just their own.
+type StaffRole struct {
+	ent.Schema
+}
+
+// Fields of the StaffRole.
+func (StaffRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("role").
+			Values("loan_officer", "servicer", "auditor", "admin"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the StaffRole.
+func (StaffRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("staff_roles").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
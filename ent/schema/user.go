@@ -4,6 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/pii"
+	"github.com/crusyn/loans/rule"
 )
 
 // User holds the schema definition for the User entity.
@@ -11,16 +14,35 @@ type User struct {
 	ent.Schema
 }
 
+// userName is how names are encrypted, with a blind index so they can still be searched.
+var userName = pii.EncryptedString{Column: "users.name", Index: "name_index"}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name"),
-		// TODO: Having a unique social security number ensures we don't insert the same user twice.
-		// We should remember to hash the social and only store the last 4 digits
-		// before we take this to production.
-		field.String("social").Unique(),
+		field.String("name").
+			ValueScanner(userName),
+		field.String("name_index").
+			Optional().
+			Sensitive(), // kept up to date by the name's index hook
+		// Socials were saved in plaintext before they were protected, social.Migrate moves
+		// them to the columns below and clears this one.
+		field.String("social").
+			Optional().
+			Nillable().
+			Sensitive(),
+		// A keyed hash of the social, unique so we don't insert the same user twice.
+		field.String("social_hash").
+			Optional().
+			Unique(),
+		field.String("social_encrypted").
+			Optional().
+			Sensitive(),
+		field.String("social_last4").
+			Optional(), // for showing which social a user has
 		field.String("address").
-			Optional(),
+			Optional().
+			ValueScanner(pii.EncryptedString{Column: "users.address"}),
 	}
 }
 
@@ -29,5 +51,40 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("loans", Loan.Type),
 		edge.To("shared_loan", SharedLoan.Type),
+		edge.To("share_invitations", ShareInvitation.Type),
+		edge.To("credit_lines", CreditLine.Type),
+		edge.To("obligations", LoanObligor.Type),
+		edge.To("credential", UserCredential.Type).
+			Unique(),
+		edge.To("staff_roles", StaffRole.Type),
+		edge.To("identities", UserIdentity.Type),
+		edge.To("sessions", Session.Type),
+	}
+}
+
+// Hooks of the User.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		userName.IndexHook("name"),
+	}
+}
+
+// Policy of the User: users can only see and change themselves.  Staff see every user, loan
+// officers can create them and servicers change them.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfOriginating(),
+			rule.AllowIfServicing(ent.OpUpdate | ent.OpUpdateOne),
+			rule.FilterUserMutations(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.AllowIfAdmin(),
+			rule.AllowIfStaff(),
+			rule.FilterUsers(),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
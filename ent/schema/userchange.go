@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/pii"
+)
+
+// UserChange holds the schema definition for the UserChange entity.
+// Each change is a field of a user's personal information being changed, kept as its history;
+// changes are never updated once saved.  They aren't an edge of the user so they're kept after the
+// user is deleted.
+type UserChange struct {
+	ent.Schema
+}
+
+// Fields of the UserChange.
+func (UserChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("field").
+			Values("name", "address"),
+		field.String("old_value").
+			Optional().
+			ValueScanner(pii.EncryptedString{Column: "user_changes.old_value"}),
+		field.String("new_value").
+			Optional().
+			ValueScanner(pii.EncryptedString{Column: "user_changes.new_value"}),
+		field.Int("changed_by").
+			Optional(), // the user who made the change, zero for services
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the UserChange.
+func (UserChange) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// UserCredential holds the schema definition for the UserCredential entity.
+// Users sign in with their password to get a bearer token.
+type UserCredential struct {
+	ent.Schema
+}
+
+// Fields of the UserCredential.
+func (UserCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Unique(),
+		field.String("password_hash").
+			Sensitive(), // bcrypt
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the UserCredential.
+func (UserCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("credential").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
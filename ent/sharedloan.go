@@ -22,6 +22,8 @@ type SharedLoan struct {
 	UserID int `json:"user_id,omitempty"`
 	// LoanID holds the value of the "loan_id" field.
 	LoanID int `json:"loan_id,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission sharedloan.Permission `json:"permission,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SharedLoanQuery when eager-loading is set.
 	Edges        SharedLoanEdges `json:"edges"`
@@ -72,6 +74,8 @@ func (*SharedLoan) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case sharedloan.FieldID, sharedloan.FieldUserID, sharedloan.FieldLoanID:
 			values[i] = new(sql.NullInt64)
+		case sharedloan.FieldPermission:
+			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -105,6 +109,12 @@ func (sl *SharedLoan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sl.LoanID = int(value.Int64)
 			}
+		case sharedloan.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				sl.Permission = sharedloan.Permission(value.String)
+			}
 		default:
 			sl.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +166,9 @@ func (sl *SharedLoan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("loan_id=")
 	builder.WriteString(fmt.Sprintf("%v", sl.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", sl.Permission))
 	builder.WriteByte(')')
 	return builder.String()
 }
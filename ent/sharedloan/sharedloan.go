@@ -3,6 +3,9 @@
 package sharedloan
 
 import (
+	"fmt"
+
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,6 +19,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldLoanID holds the string denoting the loan_id field in the database.
 	FieldLoanID = "loan_id"
+	// FieldPermission holds the string denoting the permission field in the database.
+	FieldPermission = "permission"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeLoan holds the string denoting the loan edge name in mutations.
@@ -43,6 +48,7 @@ var Columns = []string{
 	FieldID,
 	FieldUserID,
 	FieldLoanID,
+	FieldPermission,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -55,6 +61,44 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/crusyn/loans/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+)
+
+// Permission defines the type for the "permission" enum field.
+type Permission string
+
+// PermissionViewSchedule is the default value of the Permission enum.
+const DefaultPermission = PermissionViewSchedule
+
+// Permission values.
+const (
+	PermissionViewBalance  Permission = "view_balance"
+	PermissionViewSchedule Permission = "view_schedule"
+	PermissionMakePayments Permission = "make_payments"
+	PermissionManageShares Permission = "manage_shares"
+)
+
+func (pe Permission) String() string {
+	return string(pe)
+}
+
+// PermissionValidator is a validator for the "permission" field enum values. It is called by the builders before save.
+func PermissionValidator(pe Permission) error {
+	switch pe {
+	case PermissionViewBalance, PermissionViewSchedule, PermissionMakePayments, PermissionManageShares:
+		return nil
+	default:
+		return fmt.Errorf("sharedloan: invalid enum value for permission field: %q", pe)
+	}
+}
+
 // OrderOption defines the ordering options for the SharedLoan queries.
 type OrderOption func(*sql.Selector)
 
@@ -73,6 +117,11 @@ func ByLoanID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLoanID, opts...).ToFunc()
 }
 
+// ByPermission orders the results by the permission field.
+func ByPermission(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermission, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -103,6 +103,26 @@ func LoanIDNotIn(vs ...int) predicate.SharedLoan {
 	return predicate.SharedLoan(sql.FieldNotIn(FieldLoanID, vs...))
 }
 
+// PermissionEQ applies the EQ predicate on the "permission" field.
+func PermissionEQ(v Permission) predicate.SharedLoan {
+	return predicate.SharedLoan(sql.FieldEQ(FieldPermission, v))
+}
+
+// PermissionNEQ applies the NEQ predicate on the "permission" field.
+func PermissionNEQ(v Permission) predicate.SharedLoan {
+	return predicate.SharedLoan(sql.FieldNEQ(FieldPermission, v))
+}
+
+// PermissionIn applies the In predicate on the "permission" field.
+func PermissionIn(vs ...Permission) predicate.SharedLoan {
+	return predicate.SharedLoan(sql.FieldIn(FieldPermission, vs...))
+}
+
+// PermissionNotIn applies the NotIn predicate on the "permission" field.
+func PermissionNotIn(vs ...Permission) predicate.SharedLoan {
+	return predicate.SharedLoan(sql.FieldNotIn(FieldPermission, vs...))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.SharedLoan {
 	return predicate.SharedLoan(func(s *sql.Selector) {
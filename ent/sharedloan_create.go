@@ -33,6 +33,20 @@ func (slc *SharedLoanCreate) SetLoanID(i int) *SharedLoanCreate {
 	return slc
 }
 
+// SetPermission sets the "permission" field.
+func (slc *SharedLoanCreate) SetPermission(s sharedloan.Permission) *SharedLoanCreate {
+	slc.mutation.SetPermission(s)
+	return slc
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (slc *SharedLoanCreate) SetNillablePermission(s *sharedloan.Permission) *SharedLoanCreate {
+	if s != nil {
+		slc.SetPermission(*s)
+	}
+	return slc
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (slc *SharedLoanCreate) SetUser(u *User) *SharedLoanCreate {
 	return slc.SetUserID(u.ID)
@@ -50,6 +64,9 @@ func (slc *SharedLoanCreate) Mutation() *SharedLoanMutation {
 
 // Save creates the SharedLoan in the database.
 func (slc *SharedLoanCreate) Save(ctx context.Context) (*SharedLoan, error) {
+	if err := slc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, slc.sqlSave, slc.mutation, slc.hooks)
 }
 
@@ -75,6 +92,15 @@ func (slc *SharedLoanCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (slc *SharedLoanCreate) defaults() error {
+	if _, ok := slc.mutation.Permission(); !ok {
+		v := sharedloan.DefaultPermission
+		slc.mutation.SetPermission(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (slc *SharedLoanCreate) check() error {
 	if _, ok := slc.mutation.UserID(); !ok {
@@ -83,6 +109,14 @@ func (slc *SharedLoanCreate) check() error {
 	if _, ok := slc.mutation.LoanID(); !ok {
 		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "SharedLoan.loan_id"`)}
 	}
+	if _, ok := slc.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "SharedLoan.permission"`)}
+	}
+	if v, ok := slc.mutation.Permission(); ok {
+		if err := sharedloan.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "SharedLoan.permission": %w`, err)}
+		}
+	}
 	if _, ok := slc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SharedLoan.user"`)}
 	}
@@ -115,6 +149,10 @@ func (slc *SharedLoanCreate) createSpec() (*SharedLoan, *sqlgraph.CreateSpec) {
 		_node = &SharedLoan{config: slc.config}
 		_spec = sqlgraph.NewCreateSpec(sharedloan.Table, sqlgraph.NewFieldSpec(sharedloan.FieldID, field.TypeInt))
 	)
+	if value, ok := slc.mutation.Permission(); ok {
+		_spec.SetField(sharedloan.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
 	if nodes := slc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -170,6 +208,7 @@ func (slcb *SharedLoanCreateBulk) Save(ctx context.Context) ([]*SharedLoan, erro
 	for i := range slcb.builders {
 		func(i int, root context.Context) {
 			builder := slcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SharedLoanMutation)
 				if !ok {
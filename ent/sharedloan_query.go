@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -398,6 +399,12 @@ func (slq *SharedLoanQuery) prepareQuery(ctx context.Context) error {
 		}
 		slq.sql = prev
 	}
+	if sharedloan.Policy == nil {
+		return errors.New("ent: uninitialized sharedloan.Policy (forgotten import ent/runtime?)")
+	}
+	if err := sharedloan.Policy.EvalQuery(ctx, slq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -57,6 +57,20 @@ func (slu *SharedLoanUpdate) SetNillableLoanID(i *int) *SharedLoanUpdate {
 	return slu
 }
 
+// SetPermission sets the "permission" field.
+func (slu *SharedLoanUpdate) SetPermission(s sharedloan.Permission) *SharedLoanUpdate {
+	slu.mutation.SetPermission(s)
+	return slu
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (slu *SharedLoanUpdate) SetNillablePermission(s *sharedloan.Permission) *SharedLoanUpdate {
+	if s != nil {
+		slu.SetPermission(*s)
+	}
+	return slu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (slu *SharedLoanUpdate) SetUser(u *User) *SharedLoanUpdate {
 	return slu.SetUserID(u.ID)
@@ -113,6 +127,11 @@ func (slu *SharedLoanUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (slu *SharedLoanUpdate) check() error {
+	if v, ok := slu.mutation.Permission(); ok {
+		if err := sharedloan.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "SharedLoan.permission": %w`, err)}
+		}
+	}
 	if _, ok := slu.mutation.UserID(); slu.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "SharedLoan.user"`)
 	}
@@ -134,6 +153,9 @@ func (slu *SharedLoanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := slu.mutation.Permission(); ok {
+		_spec.SetField(sharedloan.FieldPermission, field.TypeEnum, value)
+	}
 	if slu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -240,6 +262,20 @@ func (sluo *SharedLoanUpdateOne) SetNillableLoanID(i *int) *SharedLoanUpdateOne
 	return sluo
 }
 
+// SetPermission sets the "permission" field.
+func (sluo *SharedLoanUpdateOne) SetPermission(s sharedloan.Permission) *SharedLoanUpdateOne {
+	sluo.mutation.SetPermission(s)
+	return sluo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (sluo *SharedLoanUpdateOne) SetNillablePermission(s *sharedloan.Permission) *SharedLoanUpdateOne {
+	if s != nil {
+		sluo.SetPermission(*s)
+	}
+	return sluo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (sluo *SharedLoanUpdateOne) SetUser(u *User) *SharedLoanUpdateOne {
 	return sluo.SetUserID(u.ID)
@@ -309,6 +345,11 @@ func (sluo *SharedLoanUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (sluo *SharedLoanUpdateOne) check() error {
+	if v, ok := sluo.mutation.Permission(); ok {
+		if err := sharedloan.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "SharedLoan.permission": %w`, err)}
+		}
+	}
 	if _, ok := sluo.mutation.UserID(); sluo.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "SharedLoan.user"`)
 	}
@@ -347,6 +388,9 @@ func (sluo *SharedLoanUpdateOne) sqlSave(ctx context.Context) (_node *SharedLoan
 			}
 		}
 	}
+	if value, ok := sluo.mutation.Permission(); ok {
+		_spec.SetField(sharedloan.FieldPermission, field.TypeEnum, value)
+	}
 	if sluo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// ShareInvitation is the model entity for the ShareInvitation schema.
+type ShareInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission shareinvitation.Permission `json:"permission,omitempty"`
+	// Status holds the value of the "status" field.
+	Status shareinvitation.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareInvitationQuery when eager-loading is set.
+	Edges        ShareInvitationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShareInvitationEdges holds the relations/edges for other nodes in the graph.
+type ShareInvitationEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareInvitationEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareInvitationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shareinvitation.FieldID, shareinvitation.FieldLoanID, shareinvitation.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case shareinvitation.FieldPermission, shareinvitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case shareinvitation.FieldExpiresAt, shareinvitation.FieldRespondedAt, shareinvitation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareInvitation fields.
+func (si *ShareInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shareinvitation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			si.ID = int(value.Int64)
+		case shareinvitation.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				si.LoanID = int(value.Int64)
+			}
+		case shareinvitation.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				si.UserID = int(value.Int64)
+			}
+		case shareinvitation.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				si.Permission = shareinvitation.Permission(value.String)
+			}
+		case shareinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				si.Status = shareinvitation.Status(value.String)
+			}
+		case shareinvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				si.ExpiresAt = value.Time
+			}
+		case shareinvitation.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				si.RespondedAt = value.Time
+			}
+		case shareinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				si.CreatedAt = value.Time
+			}
+		default:
+			si.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareInvitation.
+// This includes values selected through modifiers, order, etc.
+func (si *ShareInvitation) Value(name string) (ent.Value, error) {
+	return si.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the ShareInvitation entity.
+func (si *ShareInvitation) QueryLoan() *LoanQuery {
+	return NewShareInvitationClient(si.config).QueryLoan(si)
+}
+
+// QueryUser queries the "user" edge of the ShareInvitation entity.
+func (si *ShareInvitation) QueryUser() *UserQuery {
+	return NewShareInvitationClient(si.config).QueryUser(si)
+}
+
+// Update returns a builder for updating this ShareInvitation.
+// Note that you need to call ShareInvitation.Unwrap() before calling this method if this ShareInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *ShareInvitation) Update() *ShareInvitationUpdateOne {
+	return NewShareInvitationClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the ShareInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *ShareInvitation) Unwrap() *ShareInvitation {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareInvitation is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *ShareInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", si.Permission))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", si.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(si.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("responded_at=")
+	builder.WriteString(si.RespondedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(si.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareInvitations is a parsable slice of ShareInvitation.
+type ShareInvitations []*ShareInvitation
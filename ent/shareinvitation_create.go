@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// ShareInvitationCreate is the builder for creating a ShareInvitation entity.
+type ShareInvitationCreate struct {
+	config
+	mutation *ShareInvitationMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (sic *ShareInvitationCreate) SetLoanID(i int) *ShareInvitationCreate {
+	sic.mutation.SetLoanID(i)
+	return sic
+}
+
+// SetUserID sets the "user_id" field.
+func (sic *ShareInvitationCreate) SetUserID(i int) *ShareInvitationCreate {
+	sic.mutation.SetUserID(i)
+	return sic
+}
+
+// SetPermission sets the "permission" field.
+func (sic *ShareInvitationCreate) SetPermission(s shareinvitation.Permission) *ShareInvitationCreate {
+	sic.mutation.SetPermission(s)
+	return sic
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (sic *ShareInvitationCreate) SetNillablePermission(s *shareinvitation.Permission) *ShareInvitationCreate {
+	if s != nil {
+		sic.SetPermission(*s)
+	}
+	return sic
+}
+
+// SetStatus sets the "status" field.
+func (sic *ShareInvitationCreate) SetStatus(s shareinvitation.Status) *ShareInvitationCreate {
+	sic.mutation.SetStatus(s)
+	return sic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sic *ShareInvitationCreate) SetNillableStatus(s *shareinvitation.Status) *ShareInvitationCreate {
+	if s != nil {
+		sic.SetStatus(*s)
+	}
+	return sic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sic *ShareInvitationCreate) SetExpiresAt(t time.Time) *ShareInvitationCreate {
+	sic.mutation.SetExpiresAt(t)
+	return sic
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (sic *ShareInvitationCreate) SetRespondedAt(t time.Time) *ShareInvitationCreate {
+	sic.mutation.SetRespondedAt(t)
+	return sic
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (sic *ShareInvitationCreate) SetNillableRespondedAt(t *time.Time) *ShareInvitationCreate {
+	if t != nil {
+		sic.SetRespondedAt(*t)
+	}
+	return sic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sic *ShareInvitationCreate) SetCreatedAt(t time.Time) *ShareInvitationCreate {
+	sic.mutation.SetCreatedAt(t)
+	return sic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sic *ShareInvitationCreate) SetNillableCreatedAt(t *time.Time) *ShareInvitationCreate {
+	if t != nil {
+		sic.SetCreatedAt(*t)
+	}
+	return sic
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (sic *ShareInvitationCreate) SetLoan(l *Loan) *ShareInvitationCreate {
+	return sic.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sic *ShareInvitationCreate) SetUser(u *User) *ShareInvitationCreate {
+	return sic.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareInvitationMutation object of the builder.
+func (sic *ShareInvitationCreate) Mutation() *ShareInvitationMutation {
+	return sic.mutation
+}
+
+// Save creates the ShareInvitation in the database.
+func (sic *ShareInvitationCreate) Save(ctx context.Context) (*ShareInvitation, error) {
+	sic.defaults()
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *ShareInvitationCreate) SaveX(ctx context.Context) *ShareInvitation {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *ShareInvitationCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *ShareInvitationCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *ShareInvitationCreate) defaults() {
+	if _, ok := sic.mutation.Permission(); !ok {
+		v := shareinvitation.DefaultPermission
+		sic.mutation.SetPermission(v)
+	}
+	if _, ok := sic.mutation.Status(); !ok {
+		v := shareinvitation.DefaultStatus
+		sic.mutation.SetStatus(v)
+	}
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		v := shareinvitation.DefaultCreatedAt()
+		sic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *ShareInvitationCreate) check() error {
+	if _, ok := sic.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "ShareInvitation.loan_id"`)}
+	}
+	if _, ok := sic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ShareInvitation.user_id"`)}
+	}
+	if _, ok := sic.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "ShareInvitation.permission"`)}
+	}
+	if v, ok := sic.mutation.Permission(); ok {
+		if err := shareinvitation.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.permission": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ShareInvitation.status"`)}
+	}
+	if v, ok := sic.mutation.Status(); ok {
+		if err := shareinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ShareInvitation.expires_at"`)}
+	}
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareInvitation.created_at"`)}
+	}
+	if _, ok := sic.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "ShareInvitation.loan"`)}
+	}
+	if _, ok := sic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ShareInvitation.user"`)}
+	}
+	return nil
+}
+
+func (sic *ShareInvitationCreate) sqlSave(ctx context.Context) (*ShareInvitation, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *ShareInvitationCreate) createSpec() (*ShareInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareInvitation{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(shareinvitation.Table, sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt))
+	)
+	if value, ok := sic.mutation.Permission(); ok {
+		_spec.SetField(shareinvitation.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
+	if value, ok := sic.mutation.Status(); ok {
+		_spec.SetField(shareinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sic.mutation.ExpiresAt(); ok {
+		_spec.SetField(shareinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := sic.mutation.RespondedAt(); ok {
+		_spec.SetField(shareinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = value
+	}
+	if value, ok := sic.mutation.CreatedAt(); ok {
+		_spec.SetField(shareinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sic.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.LoanTable,
+			Columns: []string{shareinvitation.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.UserTable,
+			Columns: []string{shareinvitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareInvitationCreateBulk is the builder for creating many ShareInvitation entities in bulk.
+type ShareInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*ShareInvitationCreate
+}
+
+// Save creates the ShareInvitation entities in the database.
+func (sicb *ShareInvitationCreateBulk) Save(ctx context.Context) ([]*ShareInvitation, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*ShareInvitation, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *ShareInvitationCreateBulk) SaveX(ctx context.Context) []*ShareInvitation {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *ShareInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *ShareInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/shareinvitation"
+)
+
+// ShareInvitationDelete is the builder for deleting a ShareInvitation entity.
+type ShareInvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareInvitationMutation
+}
+
+// Where appends a list predicates to the ShareInvitationDelete builder.
+func (sid *ShareInvitationDelete) Where(ps ...predicate.ShareInvitation) *ShareInvitationDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *ShareInvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sid.sqlExec, sid.mutation, sid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *ShareInvitationDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *ShareInvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shareinvitation.Table, sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt))
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sid.mutation.done = true
+	return affected, err
+}
+
+// ShareInvitationDeleteOne is the builder for deleting a single ShareInvitation entity.
+type ShareInvitationDeleteOne struct {
+	sid *ShareInvitationDelete
+}
+
+// Where appends a list predicates to the ShareInvitationDelete builder.
+func (sido *ShareInvitationDeleteOne) Where(ps ...predicate.ShareInvitation) *ShareInvitationDeleteOne {
+	sido.sid.mutation.Where(ps...)
+	return sido
+}
+
+// Exec executes the deletion query.
+func (sido *ShareInvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shareinvitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *ShareInvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := sido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// ShareInvitationQuery is the builder for querying ShareInvitation entities.
+type ShareInvitationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []shareinvitation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ShareInvitation
+	withLoan   *LoanQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareInvitationQuery builder.
+func (siq *ShareInvitationQuery) Where(ps ...predicate.ShareInvitation) *ShareInvitationQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *ShareInvitationQuery) Limit(limit int) *ShareInvitationQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *ShareInvitationQuery) Offset(offset int) *ShareInvitationQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *ShareInvitationQuery) Unique(unique bool) *ShareInvitationQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *ShareInvitationQuery) Order(o ...shareinvitation.OrderOption) *ShareInvitationQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// QueryLoan chains the current query on the "loan" edge.
+func (siq *ShareInvitationQuery) QueryLoan() *LoanQuery {
+	query := (&LoanClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareinvitation.Table, shareinvitation.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareinvitation.LoanTable, shareinvitation.LoanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (siq *ShareInvitationQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareinvitation.Table, shareinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareinvitation.UserTable, shareinvitation.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShareInvitation entity from the query.
+// Returns a *NotFoundError when no ShareInvitation was found.
+func (siq *ShareInvitationQuery) First(ctx context.Context) (*ShareInvitation, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shareinvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *ShareInvitationQuery) FirstX(ctx context.Context) *ShareInvitation {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareInvitation ID from the query.
+// Returns a *NotFoundError when no ShareInvitation ID was found.
+func (siq *ShareInvitationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shareinvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *ShareInvitationQuery) FirstIDX(ctx context.Context) int {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShareInvitation entity is found.
+// Returns a *NotFoundError when no ShareInvitation entities are found.
+func (siq *ShareInvitationQuery) Only(ctx context.Context) (*ShareInvitation, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shareinvitation.Label}
+	default:
+		return nil, &NotSingularError{shareinvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *ShareInvitationQuery) OnlyX(ctx context.Context) *ShareInvitation {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareInvitation ID in the query.
+// Returns a *NotSingularError when more than one ShareInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *ShareInvitationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shareinvitation.Label}
+	default:
+		err = &NotSingularError{shareinvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *ShareInvitationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareInvitations.
+func (siq *ShareInvitationQuery) All(ctx context.Context) ([]*ShareInvitation, error) {
+	ctx = setContextOp(ctx, siq.ctx, "All")
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShareInvitation, *ShareInvitationQuery]()
+	return withInterceptors[[]*ShareInvitation](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *ShareInvitationQuery) AllX(ctx context.Context) []*ShareInvitation {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareInvitation IDs.
+func (siq *ShareInvitationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, "IDs")
+	if err = siq.Select(shareinvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *ShareInvitationQuery) IDsX(ctx context.Context) []int {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *ShareInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, "Count")
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*ShareInvitationQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *ShareInvitationQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *ShareInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, "Exist")
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *ShareInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *ShareInvitationQuery) Clone() *ShareInvitationQuery {
+	if siq == nil {
+		return nil
+	}
+	return &ShareInvitationQuery{
+		config:     siq.config,
+		ctx:        siq.ctx.Clone(),
+		order:      append([]shareinvitation.OrderOption{}, siq.order...),
+		inters:     append([]Interceptor{}, siq.inters...),
+		predicates: append([]predicate.ShareInvitation{}, siq.predicates...),
+		withLoan:   siq.withLoan.Clone(),
+		withUser:   siq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// WithLoan tells the query-builder to eager-load the nodes that are connected to
+// the "loan" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *ShareInvitationQuery) WithLoan(opts ...func(*LoanQuery)) *ShareInvitationQuery {
+	query := (&LoanClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withLoan = query
+	return siq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *ShareInvitationQuery) WithUser(opts ...func(*UserQuery)) *ShareInvitationQuery {
+	query := (&UserClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withUser = query
+	return siq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareInvitation.Query().
+//		GroupBy(shareinvitation.FieldLoanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *ShareInvitationQuery) GroupBy(field string, fields ...string) *ShareInvitationGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShareInvitationGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = shareinvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LoanID int `json:"loan_id,omitempty"`
+//	}
+//
+//	client.ShareInvitation.Query().
+//		Select(shareinvitation.FieldLoanID).
+//		Scan(ctx, &v)
+func (siq *ShareInvitationQuery) Select(fields ...string) *ShareInvitationSelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &ShareInvitationSelect{ShareInvitationQuery: siq}
+	sbuild.label = shareinvitation.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShareInvitationSelect configured with the given aggregations.
+func (siq *ShareInvitationQuery) Aggregate(fns ...AggregateFunc) *ShareInvitationSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *ShareInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !shareinvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *ShareInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShareInvitation, error) {
+	var (
+		nodes       = []*ShareInvitation{}
+		_spec       = siq.querySpec()
+		loadedTypes = [2]bool{
+			siq.withLoan != nil,
+			siq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShareInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShareInvitation{config: siq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := siq.withLoan; query != nil {
+		if err := siq.loadLoan(ctx, query, nodes, nil,
+			func(n *ShareInvitation, e *Loan) { n.Edges.Loan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := siq.withUser; query != nil {
+		if err := siq.loadUser(ctx, query, nodes, nil,
+			func(n *ShareInvitation, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (siq *ShareInvitationQuery) loadLoan(ctx context.Context, query *LoanQuery, nodes []*ShareInvitation, init func(*ShareInvitation), assign func(*ShareInvitation, *Loan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareInvitation)
+	for i := range nodes {
+		fk := nodes[i].LoanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(loan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "loan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (siq *ShareInvitationQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ShareInvitation, init func(*ShareInvitation), assign func(*ShareInvitation, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareInvitation)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (siq *ShareInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *ShareInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shareinvitation.Table, shareinvitation.Columns, sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareinvitation.FieldID)
+		for i := range fields {
+			if fields[i] != shareinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if siq.withLoan != nil {
+			_spec.Node.AddColumnOnce(shareinvitation.FieldLoanID)
+		}
+		if siq.withUser != nil {
+			_spec.Node.AddColumnOnce(shareinvitation.FieldUserID)
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *ShareInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(shareinvitation.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shareinvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShareInvitationGroupBy is the group-by builder for ShareInvitation entities.
+type ShareInvitationGroupBy struct {
+	selector
+	build *ShareInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *ShareInvitationGroupBy) Aggregate(fns ...AggregateFunc) *ShareInvitationGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *ShareInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, "GroupBy")
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareInvitationQuery, *ShareInvitationGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *ShareInvitationGroupBy) sqlScan(ctx context.Context, root *ShareInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShareInvitationSelect is the builder for selecting fields of ShareInvitation entities.
+type ShareInvitationSelect struct {
+	*ShareInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *ShareInvitationSelect) Aggregate(fns ...AggregateFunc) *ShareInvitationSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *ShareInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, "Select")
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareInvitationQuery, *ShareInvitationSelect](ctx, sis.ShareInvitationQuery, sis, sis.inters, v)
+}
+
+func (sis *ShareInvitationSelect) sqlScan(ctx context.Context, root *ShareInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// ShareInvitationUpdate is the builder for updating ShareInvitation entities.
+type ShareInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareInvitationMutation
+}
+
+// Where appends a list predicates to the ShareInvitationUpdate builder.
+func (siu *ShareInvitationUpdate) Where(ps ...predicate.ShareInvitation) *ShareInvitationUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (siu *ShareInvitationUpdate) SetLoanID(i int) *ShareInvitationUpdate {
+	siu.mutation.SetLoanID(i)
+	return siu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillableLoanID(i *int) *ShareInvitationUpdate {
+	if i != nil {
+		siu.SetLoanID(*i)
+	}
+	return siu
+}
+
+// SetUserID sets the "user_id" field.
+func (siu *ShareInvitationUpdate) SetUserID(i int) *ShareInvitationUpdate {
+	siu.mutation.SetUserID(i)
+	return siu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillableUserID(i *int) *ShareInvitationUpdate {
+	if i != nil {
+		siu.SetUserID(*i)
+	}
+	return siu
+}
+
+// SetPermission sets the "permission" field.
+func (siu *ShareInvitationUpdate) SetPermission(s shareinvitation.Permission) *ShareInvitationUpdate {
+	siu.mutation.SetPermission(s)
+	return siu
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillablePermission(s *shareinvitation.Permission) *ShareInvitationUpdate {
+	if s != nil {
+		siu.SetPermission(*s)
+	}
+	return siu
+}
+
+// SetStatus sets the "status" field.
+func (siu *ShareInvitationUpdate) SetStatus(s shareinvitation.Status) *ShareInvitationUpdate {
+	siu.mutation.SetStatus(s)
+	return siu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillableStatus(s *shareinvitation.Status) *ShareInvitationUpdate {
+	if s != nil {
+		siu.SetStatus(*s)
+	}
+	return siu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (siu *ShareInvitationUpdate) SetExpiresAt(t time.Time) *ShareInvitationUpdate {
+	siu.mutation.SetExpiresAt(t)
+	return siu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillableExpiresAt(t *time.Time) *ShareInvitationUpdate {
+	if t != nil {
+		siu.SetExpiresAt(*t)
+	}
+	return siu
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (siu *ShareInvitationUpdate) SetRespondedAt(t time.Time) *ShareInvitationUpdate {
+	siu.mutation.SetRespondedAt(t)
+	return siu
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (siu *ShareInvitationUpdate) SetNillableRespondedAt(t *time.Time) *ShareInvitationUpdate {
+	if t != nil {
+		siu.SetRespondedAt(*t)
+	}
+	return siu
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (siu *ShareInvitationUpdate) ClearRespondedAt() *ShareInvitationUpdate {
+	siu.mutation.ClearRespondedAt()
+	return siu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (siu *ShareInvitationUpdate) SetLoan(l *Loan) *ShareInvitationUpdate {
+	return siu.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (siu *ShareInvitationUpdate) SetUser(u *User) *ShareInvitationUpdate {
+	return siu.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareInvitationMutation object of the builder.
+func (siu *ShareInvitationUpdate) Mutation() *ShareInvitationMutation {
+	return siu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (siu *ShareInvitationUpdate) ClearLoan() *ShareInvitationUpdate {
+	siu.mutation.ClearLoan()
+	return siu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (siu *ShareInvitationUpdate) ClearUser() *ShareInvitationUpdate {
+	siu.mutation.ClearUser()
+	return siu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *ShareInvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *ShareInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *ShareInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *ShareInvitationUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *ShareInvitationUpdate) check() error {
+	if v, ok := siu.mutation.Permission(); ok {
+		if err := shareinvitation.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.permission": %w`, err)}
+		}
+	}
+	if v, ok := siu.mutation.Status(); ok {
+		if err := shareinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := siu.mutation.LoanID(); siu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareInvitation.loan"`)
+	}
+	if _, ok := siu.mutation.UserID(); siu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareInvitation.user"`)
+	}
+	return nil
+}
+
+func (siu *ShareInvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareinvitation.Table, shareinvitation.Columns, sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.Permission(); ok {
+		_spec.SetField(shareinvitation.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := siu.mutation.Status(); ok {
+		_spec.SetField(shareinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := siu.mutation.ExpiresAt(); ok {
+		_spec.SetField(shareinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := siu.mutation.RespondedAt(); ok {
+		_spec.SetField(shareinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if siu.mutation.RespondedAtCleared() {
+		_spec.ClearField(shareinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if siu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.LoanTable,
+			Columns: []string{shareinvitation.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.LoanTable,
+			Columns: []string{shareinvitation.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.UserTable,
+			Columns: []string{shareinvitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.UserTable,
+			Columns: []string{shareinvitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// ShareInvitationUpdateOne is the builder for updating a single ShareInvitation entity.
+type ShareInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareInvitationMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (siuo *ShareInvitationUpdateOne) SetLoanID(i int) *ShareInvitationUpdateOne {
+	siuo.mutation.SetLoanID(i)
+	return siuo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillableLoanID(i *int) *ShareInvitationUpdateOne {
+	if i != nil {
+		siuo.SetLoanID(*i)
+	}
+	return siuo
+}
+
+// SetUserID sets the "user_id" field.
+func (siuo *ShareInvitationUpdateOne) SetUserID(i int) *ShareInvitationUpdateOne {
+	siuo.mutation.SetUserID(i)
+	return siuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillableUserID(i *int) *ShareInvitationUpdateOne {
+	if i != nil {
+		siuo.SetUserID(*i)
+	}
+	return siuo
+}
+
+// SetPermission sets the "permission" field.
+func (siuo *ShareInvitationUpdateOne) SetPermission(s shareinvitation.Permission) *ShareInvitationUpdateOne {
+	siuo.mutation.SetPermission(s)
+	return siuo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillablePermission(s *shareinvitation.Permission) *ShareInvitationUpdateOne {
+	if s != nil {
+		siuo.SetPermission(*s)
+	}
+	return siuo
+}
+
+// SetStatus sets the "status" field.
+func (siuo *ShareInvitationUpdateOne) SetStatus(s shareinvitation.Status) *ShareInvitationUpdateOne {
+	siuo.mutation.SetStatus(s)
+	return siuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillableStatus(s *shareinvitation.Status) *ShareInvitationUpdateOne {
+	if s != nil {
+		siuo.SetStatus(*s)
+	}
+	return siuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (siuo *ShareInvitationUpdateOne) SetExpiresAt(t time.Time) *ShareInvitationUpdateOne {
+	siuo.mutation.SetExpiresAt(t)
+	return siuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *ShareInvitationUpdateOne {
+	if t != nil {
+		siuo.SetExpiresAt(*t)
+	}
+	return siuo
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (siuo *ShareInvitationUpdateOne) SetRespondedAt(t time.Time) *ShareInvitationUpdateOne {
+	siuo.mutation.SetRespondedAt(t)
+	return siuo
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (siuo *ShareInvitationUpdateOne) SetNillableRespondedAt(t *time.Time) *ShareInvitationUpdateOne {
+	if t != nil {
+		siuo.SetRespondedAt(*t)
+	}
+	return siuo
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (siuo *ShareInvitationUpdateOne) ClearRespondedAt() *ShareInvitationUpdateOne {
+	siuo.mutation.ClearRespondedAt()
+	return siuo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (siuo *ShareInvitationUpdateOne) SetLoan(l *Loan) *ShareInvitationUpdateOne {
+	return siuo.SetLoanID(l.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (siuo *ShareInvitationUpdateOne) SetUser(u *User) *ShareInvitationUpdateOne {
+	return siuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareInvitationMutation object of the builder.
+func (siuo *ShareInvitationUpdateOne) Mutation() *ShareInvitationMutation {
+	return siuo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (siuo *ShareInvitationUpdateOne) ClearLoan() *ShareInvitationUpdateOne {
+	siuo.mutation.ClearLoan()
+	return siuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (siuo *ShareInvitationUpdateOne) ClearUser() *ShareInvitationUpdateOne {
+	siuo.mutation.ClearUser()
+	return siuo
+}
+
+// Where appends a list predicates to the ShareInvitationUpdate builder.
+func (siuo *ShareInvitationUpdateOne) Where(ps ...predicate.ShareInvitation) *ShareInvitationUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *ShareInvitationUpdateOne) Select(field string, fields ...string) *ShareInvitationUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated ShareInvitation entity.
+func (siuo *ShareInvitationUpdateOne) Save(ctx context.Context) (*ShareInvitation, error) {
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *ShareInvitationUpdateOne) SaveX(ctx context.Context) *ShareInvitation {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *ShareInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *ShareInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *ShareInvitationUpdateOne) check() error {
+	if v, ok := siuo.mutation.Permission(); ok {
+		if err := shareinvitation.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.permission": %w`, err)}
+		}
+	}
+	if v, ok := siuo.mutation.Status(); ok {
+		if err := shareinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShareInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := siuo.mutation.LoanID(); siuo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareInvitation.loan"`)
+	}
+	if _, ok := siuo.mutation.UserID(); siuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareInvitation.user"`)
+	}
+	return nil
+}
+
+func (siuo *ShareInvitationUpdateOne) sqlSave(ctx context.Context) (_node *ShareInvitation, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareinvitation.Table, shareinvitation.Columns, sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareinvitation.FieldID)
+		for _, f := range fields {
+			if !shareinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shareinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.Permission(); ok {
+		_spec.SetField(shareinvitation.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := siuo.mutation.Status(); ok {
+		_spec.SetField(shareinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := siuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(shareinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := siuo.mutation.RespondedAt(); ok {
+		_spec.SetField(shareinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if siuo.mutation.RespondedAtCleared() {
+		_spec.ClearField(shareinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if siuo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.LoanTable,
+			Columns: []string{shareinvitation.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.LoanTable,
+			Columns: []string{shareinvitation.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.UserTable,
+			Columns: []string{shareinvitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareinvitation.UserTable,
+			Columns: []string{shareinvitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareInvitation{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/sharelink"
+)
+
+// ShareLink is the model entity for the ShareLink schema.
+type ShareLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID int `json:"loan_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareLinkQuery when eager-loading is set.
+	Edges        ShareLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShareLinkEdges holds the relations/edges for other nodes in the graph.
+type ShareLinkEdges struct {
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// Accesses holds the value of the accesses edge.
+	Accesses []*ShareLinkAccess `json:"accesses,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkEdges) LoanOrErr() (*Loan, error) {
+	if e.loadedTypes[0] {
+		if e.Loan == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: loan.Label}
+		}
+		return e.Loan, nil
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// AccessesOrErr returns the Accesses value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShareLinkEdges) AccessesOrErr() ([]*ShareLinkAccess, error) {
+	if e.loadedTypes[1] {
+		return e.Accesses, nil
+	}
+	return nil, &NotLoadedError{edge: "accesses"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldID, sharelink.FieldLoanID:
+			values[i] = new(sql.NullInt64)
+		case sharelink.FieldDescription:
+			values[i] = new(sql.NullString)
+		case sharelink.FieldExpiresAt, sharelink.FieldRevokedAt, sharelink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareLink fields.
+func (sl *ShareLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharelink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sl.ID = int(value.Int64)
+		case sharelink.FieldLoanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value.Valid {
+				sl.LoanID = int(value.Int64)
+			}
+		case sharelink.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sl.Description = value.String
+			}
+		case sharelink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sl.ExpiresAt = value.Time
+			}
+		case sharelink.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				sl.RevokedAt = value.Time
+			}
+		case sharelink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sl.CreatedAt = value.Time
+			}
+		default:
+			sl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareLink.
+// This includes values selected through modifiers, order, etc.
+func (sl *ShareLink) Value(name string) (ent.Value, error) {
+	return sl.selectValues.Get(name)
+}
+
+// QueryLoan queries the "loan" edge of the ShareLink entity.
+func (sl *ShareLink) QueryLoan() *LoanQuery {
+	return NewShareLinkClient(sl.config).QueryLoan(sl)
+}
+
+// QueryAccesses queries the "accesses" edge of the ShareLink entity.
+func (sl *ShareLink) QueryAccesses() *ShareLinkAccessQuery {
+	return NewShareLinkClient(sl.config).QueryAccesses(sl)
+}
+
+// Update returns a builder for updating this ShareLink.
+// Note that you need to call ShareLink.Unwrap() before calling this method if this ShareLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sl *ShareLink) Update() *ShareLinkUpdateOne {
+	return NewShareLinkClient(sl.config).UpdateOne(sl)
+}
+
+// Unwrap unwraps the ShareLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sl *ShareLink) Unwrap() *ShareLink {
+	_tx, ok := sl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareLink is not a transactional entity")
+	}
+	sl.config.driver = _tx.drv
+	return sl
+}
+
+// String implements the fmt.Stringer.
+func (sl *ShareLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sl.ID))
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", sl.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sl.Description)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(sl.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revoked_at=")
+	builder.WriteString(sl.RevokedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareLinks is a parsable slice of ShareLink.
+type ShareLinks []*ShareLink
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkCreate is the builder for creating a ShareLink entity.
+type ShareLinkCreate struct {
+	config
+	mutation *ShareLinkMutation
+	hooks    []Hook
+}
+
+// SetLoanID sets the "loan_id" field.
+func (slc *ShareLinkCreate) SetLoanID(i int) *ShareLinkCreate {
+	slc.mutation.SetLoanID(i)
+	return slc
+}
+
+// SetDescription sets the "description" field.
+func (slc *ShareLinkCreate) SetDescription(s string) *ShareLinkCreate {
+	slc.mutation.SetDescription(s)
+	return slc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableDescription(s *string) *ShareLinkCreate {
+	if s != nil {
+		slc.SetDescription(*s)
+	}
+	return slc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (slc *ShareLinkCreate) SetExpiresAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetExpiresAt(t)
+	return slc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (slc *ShareLinkCreate) SetRevokedAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetRevokedAt(t)
+	return slc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableRevokedAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetRevokedAt(*t)
+	}
+	return slc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (slc *ShareLinkCreate) SetCreatedAt(t time.Time) *ShareLinkCreate {
+	slc.mutation.SetCreatedAt(t)
+	return slc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (slc *ShareLinkCreate) SetNillableCreatedAt(t *time.Time) *ShareLinkCreate {
+	if t != nil {
+		slc.SetCreatedAt(*t)
+	}
+	return slc
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (slc *ShareLinkCreate) SetLoan(l *Loan) *ShareLinkCreate {
+	return slc.SetLoanID(l.ID)
+}
+
+// AddAccessIDs adds the "accesses" edge to the ShareLinkAccess entity by IDs.
+func (slc *ShareLinkCreate) AddAccessIDs(ids ...int) *ShareLinkCreate {
+	slc.mutation.AddAccessIDs(ids...)
+	return slc
+}
+
+// AddAccesses adds the "accesses" edges to the ShareLinkAccess entity.
+func (slc *ShareLinkCreate) AddAccesses(s ...*ShareLinkAccess) *ShareLinkCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return slc.AddAccessIDs(ids...)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (slc *ShareLinkCreate) Mutation() *ShareLinkMutation {
+	return slc.mutation
+}
+
+// Save creates the ShareLink in the database.
+func (slc *ShareLinkCreate) Save(ctx context.Context) (*ShareLink, error) {
+	slc.defaults()
+	return withHooks(ctx, slc.sqlSave, slc.mutation, slc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (slc *ShareLinkCreate) SaveX(ctx context.Context) *ShareLink {
+	v, err := slc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slc *ShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := slc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slc *ShareLinkCreate) ExecX(ctx context.Context) {
+	if err := slc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (slc *ShareLinkCreate) defaults() {
+	if _, ok := slc.mutation.CreatedAt(); !ok {
+		v := sharelink.DefaultCreatedAt()
+		slc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slc *ShareLinkCreate) check() error {
+	if _, ok := slc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "ShareLink.loan_id"`)}
+	}
+	if _, ok := slc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ShareLink.expires_at"`)}
+	}
+	if _, ok := slc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareLink.created_at"`)}
+	}
+	if _, ok := slc.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "ShareLink.loan"`)}
+	}
+	return nil
+}
+
+func (slc *ShareLinkCreate) sqlSave(ctx context.Context) (*ShareLink, error) {
+	if err := slc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := slc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, slc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	slc.mutation.id = &_node.ID
+	slc.mutation.done = true
+	return _node, nil
+}
+
+func (slc *ShareLinkCreate) createSpec() (*ShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLink{config: slc.config}
+		_spec = sqlgraph.NewCreateSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	)
+	if value, ok := slc.mutation.Description(); ok {
+		_spec.SetField(sharelink.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := slc.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := slc.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = value
+	}
+	if value, ok := slc.mutation.CreatedAt(); ok {
+		_spec.SetField(sharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := slc.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.LoanTable,
+			Columns: []string{sharelink.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := slc.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkCreateBulk is the builder for creating many ShareLink entities in bulk.
+type ShareLinkCreateBulk struct {
+	config
+	err      error
+	builders []*ShareLinkCreate
+}
+
+// Save creates the ShareLink entities in the database.
+func (slcb *ShareLinkCreateBulk) Save(ctx context.Context) ([]*ShareLink, error) {
+	if slcb.err != nil {
+		return nil, slcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(slcb.builders))
+	nodes := make([]*ShareLink, len(slcb.builders))
+	mutators := make([]Mutator, len(slcb.builders))
+	for i := range slcb.builders {
+		func(i int, root context.Context) {
+			builder := slcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, slcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, slcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, slcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slcb *ShareLinkCreateBulk) SaveX(ctx context.Context) []*ShareLink {
+	v, err := slcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slcb *ShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := slcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slcb *ShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := slcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
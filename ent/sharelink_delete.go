@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/sharelink"
+)
+
+// ShareLinkDelete is the builder for deleting a ShareLink entity.
+type ShareLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// Where appends a list predicates to the ShareLinkDelete builder.
+func (sld *ShareLinkDelete) Where(ps ...predicate.ShareLink) *ShareLinkDelete {
+	sld.mutation.Where(ps...)
+	return sld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sld *ShareLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sld.sqlExec, sld.mutation, sld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sld *ShareLinkDelete) ExecX(ctx context.Context) int {
+	n, err := sld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sld *ShareLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	if ps := sld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sld.mutation.done = true
+	return affected, err
+}
+
+// ShareLinkDeleteOne is the builder for deleting a single ShareLink entity.
+type ShareLinkDeleteOne struct {
+	sld *ShareLinkDelete
+}
+
+// Where appends a list predicates to the ShareLinkDelete builder.
+func (sldo *ShareLinkDeleteOne) Where(ps ...predicate.ShareLink) *ShareLinkDeleteOne {
+	sldo.sld.mutation.Where(ps...)
+	return sldo
+}
+
+// Exec executes the deletion query.
+func (sldo *ShareLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := sldo.sld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharelink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sldo *ShareLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := sldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
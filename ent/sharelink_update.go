@@ -0,0 +1,608 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkUpdate is the builder for updating ShareLink entities.
+type ShareLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (slu *ShareLinkUpdate) Where(ps ...predicate.ShareLink) *ShareLinkUpdate {
+	slu.mutation.Where(ps...)
+	return slu
+}
+
+// SetLoanID sets the "loan_id" field.
+func (slu *ShareLinkUpdate) SetLoanID(i int) *ShareLinkUpdate {
+	slu.mutation.SetLoanID(i)
+	return slu
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableLoanID(i *int) *ShareLinkUpdate {
+	if i != nil {
+		slu.SetLoanID(*i)
+	}
+	return slu
+}
+
+// SetDescription sets the "description" field.
+func (slu *ShareLinkUpdate) SetDescription(s string) *ShareLinkUpdate {
+	slu.mutation.SetDescription(s)
+	return slu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableDescription(s *string) *ShareLinkUpdate {
+	if s != nil {
+		slu.SetDescription(*s)
+	}
+	return slu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (slu *ShareLinkUpdate) ClearDescription() *ShareLinkUpdate {
+	slu.mutation.ClearDescription()
+	return slu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (slu *ShareLinkUpdate) SetExpiresAt(t time.Time) *ShareLinkUpdate {
+	slu.mutation.SetExpiresAt(t)
+	return slu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableExpiresAt(t *time.Time) *ShareLinkUpdate {
+	if t != nil {
+		slu.SetExpiresAt(*t)
+	}
+	return slu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (slu *ShareLinkUpdate) SetRevokedAt(t time.Time) *ShareLinkUpdate {
+	slu.mutation.SetRevokedAt(t)
+	return slu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (slu *ShareLinkUpdate) SetNillableRevokedAt(t *time.Time) *ShareLinkUpdate {
+	if t != nil {
+		slu.SetRevokedAt(*t)
+	}
+	return slu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (slu *ShareLinkUpdate) ClearRevokedAt() *ShareLinkUpdate {
+	slu.mutation.ClearRevokedAt()
+	return slu
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (slu *ShareLinkUpdate) SetLoan(l *Loan) *ShareLinkUpdate {
+	return slu.SetLoanID(l.ID)
+}
+
+// AddAccessIDs adds the "accesses" edge to the ShareLinkAccess entity by IDs.
+func (slu *ShareLinkUpdate) AddAccessIDs(ids ...int) *ShareLinkUpdate {
+	slu.mutation.AddAccessIDs(ids...)
+	return slu
+}
+
+// AddAccesses adds the "accesses" edges to the ShareLinkAccess entity.
+func (slu *ShareLinkUpdate) AddAccesses(s ...*ShareLinkAccess) *ShareLinkUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return slu.AddAccessIDs(ids...)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (slu *ShareLinkUpdate) Mutation() *ShareLinkMutation {
+	return slu.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (slu *ShareLinkUpdate) ClearLoan() *ShareLinkUpdate {
+	slu.mutation.ClearLoan()
+	return slu
+}
+
+// ClearAccesses clears all "accesses" edges to the ShareLinkAccess entity.
+func (slu *ShareLinkUpdate) ClearAccesses() *ShareLinkUpdate {
+	slu.mutation.ClearAccesses()
+	return slu
+}
+
+// RemoveAccessIDs removes the "accesses" edge to ShareLinkAccess entities by IDs.
+func (slu *ShareLinkUpdate) RemoveAccessIDs(ids ...int) *ShareLinkUpdate {
+	slu.mutation.RemoveAccessIDs(ids...)
+	return slu
+}
+
+// RemoveAccesses removes "accesses" edges to ShareLinkAccess entities.
+func (slu *ShareLinkUpdate) RemoveAccesses(s ...*ShareLinkAccess) *ShareLinkUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return slu.RemoveAccessIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (slu *ShareLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, slu.sqlSave, slu.mutation, slu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slu *ShareLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := slu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (slu *ShareLinkUpdate) Exec(ctx context.Context) error {
+	_, err := slu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slu *ShareLinkUpdate) ExecX(ctx context.Context) {
+	if err := slu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slu *ShareLinkUpdate) check() error {
+	if _, ok := slu.mutation.LoanID(); slu.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.loan"`)
+	}
+	return nil
+}
+
+func (slu *ShareLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := slu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	if ps := slu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := slu.mutation.Description(); ok {
+		_spec.SetField(sharelink.FieldDescription, field.TypeString, value)
+	}
+	if slu.mutation.DescriptionCleared() {
+		_spec.ClearField(sharelink.FieldDescription, field.TypeString)
+	}
+	if value, ok := slu.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := slu.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if slu.mutation.RevokedAtCleared() {
+		_spec.ClearField(sharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if slu.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.LoanTable,
+			Columns: []string{sharelink.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.LoanTable,
+			Columns: []string{sharelink.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if slu.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.RemovedAccessesIDs(); len(nodes) > 0 && !slu.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slu.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, slu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	slu.mutation.done = true
+	return n, nil
+}
+
+// ShareLinkUpdateOne is the builder for updating a single ShareLink entity.
+type ShareLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// SetLoanID sets the "loan_id" field.
+func (sluo *ShareLinkUpdateOne) SetLoanID(i int) *ShareLinkUpdateOne {
+	sluo.mutation.SetLoanID(i)
+	return sluo
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableLoanID(i *int) *ShareLinkUpdateOne {
+	if i != nil {
+		sluo.SetLoanID(*i)
+	}
+	return sluo
+}
+
+// SetDescription sets the "description" field.
+func (sluo *ShareLinkUpdateOne) SetDescription(s string) *ShareLinkUpdateOne {
+	sluo.mutation.SetDescription(s)
+	return sluo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableDescription(s *string) *ShareLinkUpdateOne {
+	if s != nil {
+		sluo.SetDescription(*s)
+	}
+	return sluo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sluo *ShareLinkUpdateOne) ClearDescription() *ShareLinkUpdateOne {
+	sluo.mutation.ClearDescription()
+	return sluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sluo *ShareLinkUpdateOne) SetExpiresAt(t time.Time) *ShareLinkUpdateOne {
+	sluo.mutation.SetExpiresAt(t)
+	return sluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableExpiresAt(t *time.Time) *ShareLinkUpdateOne {
+	if t != nil {
+		sluo.SetExpiresAt(*t)
+	}
+	return sluo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sluo *ShareLinkUpdateOne) SetRevokedAt(t time.Time) *ShareLinkUpdateOne {
+	sluo.mutation.SetRevokedAt(t)
+	return sluo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sluo *ShareLinkUpdateOne) SetNillableRevokedAt(t *time.Time) *ShareLinkUpdateOne {
+	if t != nil {
+		sluo.SetRevokedAt(*t)
+	}
+	return sluo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sluo *ShareLinkUpdateOne) ClearRevokedAt() *ShareLinkUpdateOne {
+	sluo.mutation.ClearRevokedAt()
+	return sluo
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (sluo *ShareLinkUpdateOne) SetLoan(l *Loan) *ShareLinkUpdateOne {
+	return sluo.SetLoanID(l.ID)
+}
+
+// AddAccessIDs adds the "accesses" edge to the ShareLinkAccess entity by IDs.
+func (sluo *ShareLinkUpdateOne) AddAccessIDs(ids ...int) *ShareLinkUpdateOne {
+	sluo.mutation.AddAccessIDs(ids...)
+	return sluo
+}
+
+// AddAccesses adds the "accesses" edges to the ShareLinkAccess entity.
+func (sluo *ShareLinkUpdateOne) AddAccesses(s ...*ShareLinkAccess) *ShareLinkUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sluo.AddAccessIDs(ids...)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (sluo *ShareLinkUpdateOne) Mutation() *ShareLinkMutation {
+	return sluo.mutation
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (sluo *ShareLinkUpdateOne) ClearLoan() *ShareLinkUpdateOne {
+	sluo.mutation.ClearLoan()
+	return sluo
+}
+
+// ClearAccesses clears all "accesses" edges to the ShareLinkAccess entity.
+func (sluo *ShareLinkUpdateOne) ClearAccesses() *ShareLinkUpdateOne {
+	sluo.mutation.ClearAccesses()
+	return sluo
+}
+
+// RemoveAccessIDs removes the "accesses" edge to ShareLinkAccess entities by IDs.
+func (sluo *ShareLinkUpdateOne) RemoveAccessIDs(ids ...int) *ShareLinkUpdateOne {
+	sluo.mutation.RemoveAccessIDs(ids...)
+	return sluo
+}
+
+// RemoveAccesses removes "accesses" edges to ShareLinkAccess entities.
+func (sluo *ShareLinkUpdateOne) RemoveAccesses(s ...*ShareLinkAccess) *ShareLinkUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sluo.RemoveAccessIDs(ids...)
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (sluo *ShareLinkUpdateOne) Where(ps ...predicate.ShareLink) *ShareLinkUpdateOne {
+	sluo.mutation.Where(ps...)
+	return sluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sluo *ShareLinkUpdateOne) Select(field string, fields ...string) *ShareLinkUpdateOne {
+	sluo.fields = append([]string{field}, fields...)
+	return sluo
+}
+
+// Save executes the query and returns the updated ShareLink entity.
+func (sluo *ShareLinkUpdateOne) Save(ctx context.Context) (*ShareLink, error) {
+	return withHooks(ctx, sluo.sqlSave, sluo.mutation, sluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sluo *ShareLinkUpdateOne) SaveX(ctx context.Context) *ShareLink {
+	node, err := sluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sluo *ShareLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := sluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sluo *ShareLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := sluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sluo *ShareLinkUpdateOne) check() error {
+	if _, ok := sluo.mutation.LoanID(); sluo.mutation.LoanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.loan"`)
+	}
+	return nil
+}
+
+func (sluo *ShareLinkUpdateOne) sqlSave(ctx context.Context) (_node *ShareLink, err error) {
+	if err := sluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt))
+	id, ok := sluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelink.FieldID)
+		for _, f := range fields {
+			if !sharelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sluo.mutation.Description(); ok {
+		_spec.SetField(sharelink.FieldDescription, field.TypeString, value)
+	}
+	if sluo.mutation.DescriptionCleared() {
+		_spec.ClearField(sharelink.FieldDescription, field.TypeString)
+	}
+	if value, ok := sluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(sharelink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := sluo.mutation.RevokedAt(); ok {
+		_spec.SetField(sharelink.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sluo.mutation.RevokedAtCleared() {
+		_spec.ClearField(sharelink.FieldRevokedAt, field.TypeTime)
+	}
+	if sluo.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.LoanTable,
+			Columns: []string{sharelink.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelink.LoanTable,
+			Columns: []string{sharelink.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sluo.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.RemovedAccessesIDs(); len(nodes) > 0 && !sluo.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sluo.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sharelink.AccessesTable,
+			Columns: []string{sharelink.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareLink{config: sluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sluo.mutation.done = true
+	return _node, nil
+}
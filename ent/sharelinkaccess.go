@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkAccess is the model entity for the ShareLinkAccess schema.
+type ShareLinkAccess struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ShareLinkID holds the value of the "share_link_id" field.
+	ShareLinkID int `json:"share_link_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// AccessedAt holds the value of the "accessed_at" field.
+	AccessedAt time.Time `json:"accessed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareLinkAccessQuery when eager-loading is set.
+	Edges        ShareLinkAccessEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShareLinkAccessEdges holds the relations/edges for other nodes in the graph.
+type ShareLinkAccessEdges struct {
+	// ShareLink holds the value of the share_link edge.
+	ShareLink *ShareLink `json:"share_link,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ShareLinkOrErr returns the ShareLink value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareLinkAccessEdges) ShareLinkOrErr() (*ShareLink, error) {
+	if e.loadedTypes[0] {
+		if e.ShareLink == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: sharelink.Label}
+		}
+		return e.ShareLink, nil
+	}
+	return nil, &NotLoadedError{edge: "share_link"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareLinkAccess) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sharelinkaccess.FieldID, sharelinkaccess.FieldShareLinkID:
+			values[i] = new(sql.NullInt64)
+		case sharelinkaccess.FieldPath, sharelinkaccess.FieldIP:
+			values[i] = new(sql.NullString)
+		case sharelinkaccess.FieldAccessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareLinkAccess fields.
+func (sla *ShareLinkAccess) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sharelinkaccess.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sla.ID = int(value.Int64)
+		case sharelinkaccess.FieldShareLinkID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_link_id", values[i])
+			} else if value.Valid {
+				sla.ShareLinkID = int(value.Int64)
+			}
+		case sharelinkaccess.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				sla.Path = value.String
+			}
+		case sharelinkaccess.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				sla.IP = value.String
+			}
+		case sharelinkaccess.FieldAccessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accessed_at", values[i])
+			} else if value.Valid {
+				sla.AccessedAt = value.Time
+			}
+		default:
+			sla.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareLinkAccess.
+// This includes values selected through modifiers, order, etc.
+func (sla *ShareLinkAccess) Value(name string) (ent.Value, error) {
+	return sla.selectValues.Get(name)
+}
+
+// QueryShareLink queries the "share_link" edge of the ShareLinkAccess entity.
+func (sla *ShareLinkAccess) QueryShareLink() *ShareLinkQuery {
+	return NewShareLinkAccessClient(sla.config).QueryShareLink(sla)
+}
+
+// Update returns a builder for updating this ShareLinkAccess.
+// Note that you need to call ShareLinkAccess.Unwrap() before calling this method if this ShareLinkAccess
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sla *ShareLinkAccess) Update() *ShareLinkAccessUpdateOne {
+	return NewShareLinkAccessClient(sla.config).UpdateOne(sla)
+}
+
+// Unwrap unwraps the ShareLinkAccess entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sla *ShareLinkAccess) Unwrap() *ShareLinkAccess {
+	_tx, ok := sla.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareLinkAccess is not a transactional entity")
+	}
+	sla.config.driver = _tx.drv
+	return sla
+}
+
+// String implements the fmt.Stringer.
+func (sla *ShareLinkAccess) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareLinkAccess(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sla.ID))
+	builder.WriteString("share_link_id=")
+	builder.WriteString(fmt.Sprintf("%v", sla.ShareLinkID))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(sla.Path)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(sla.IP)
+	builder.WriteString(", ")
+	builder.WriteString("accessed_at=")
+	builder.WriteString(sla.AccessedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareLinkAccesses is a parsable slice of ShareLinkAccess.
+type ShareLinkAccesses []*ShareLinkAccess
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharelinkaccess
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sharelinkaccess type in the database.
+	Label = "share_link_access"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldShareLinkID holds the string denoting the share_link_id field in the database.
+	FieldShareLinkID = "share_link_id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldAccessedAt holds the string denoting the accessed_at field in the database.
+	FieldAccessedAt = "accessed_at"
+	// EdgeShareLink holds the string denoting the share_link edge name in mutations.
+	EdgeShareLink = "share_link"
+	// Table holds the table name of the sharelinkaccess in the database.
+	Table = "share_link_accesses"
+	// ShareLinkTable is the table that holds the share_link relation/edge.
+	ShareLinkTable = "share_link_accesses"
+	// ShareLinkInverseTable is the table name for the ShareLink entity.
+	// It exists in this package in order to avoid circular dependency with the "sharelink" package.
+	ShareLinkInverseTable = "share_links"
+	// ShareLinkColumn is the table column denoting the share_link relation/edge.
+	ShareLinkColumn = "share_link_id"
+)
+
+// Columns holds all SQL columns for sharelinkaccess fields.
+var Columns = []string{
+	FieldID,
+	FieldShareLinkID,
+	FieldPath,
+	FieldIP,
+	FieldAccessedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAccessedAt holds the default value on creation for the "accessed_at" field.
+	DefaultAccessedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ShareLinkAccess queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByShareLinkID orders the results by the share_link_id field.
+func ByShareLinkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareLinkID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByAccessedAt orders the results by the accessed_at field.
+func ByAccessedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessedAt, opts...).ToFunc()
+}
+
+// ByShareLinkField orders the results by share_link field.
+func ByShareLinkField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareLinkStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShareLinkStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareLinkInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShareLinkTable, ShareLinkColumn),
+	)
+}
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sharelinkaccess
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLTE(FieldID, id))
+}
+
+// ShareLinkID applies equality check predicate on the "share_link_id" field. It's identical to ShareLinkIDEQ.
+func ShareLinkID(v int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldPath, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldIP, v))
+}
+
+// AccessedAt applies equality check predicate on the "accessed_at" field. It's identical to AccessedAtEQ.
+func AccessedAt(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldAccessedAt, v))
+}
+
+// ShareLinkIDEQ applies the EQ predicate on the "share_link_id" field.
+func ShareLinkIDEQ(v int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDNEQ applies the NEQ predicate on the "share_link_id" field.
+func ShareLinkIDNEQ(v int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNEQ(FieldShareLinkID, v))
+}
+
+// ShareLinkIDIn applies the In predicate on the "share_link_id" field.
+func ShareLinkIDIn(vs ...int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldIn(FieldShareLinkID, vs...))
+}
+
+// ShareLinkIDNotIn applies the NotIn predicate on the "share_link_id" field.
+func ShareLinkIDNotIn(vs ...int) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNotIn(FieldShareLinkID, vs...))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldContainsFold(FieldPath, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldContainsFold(FieldIP, v))
+}
+
+// AccessedAtEQ applies the EQ predicate on the "accessed_at" field.
+func AccessedAtEQ(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldEQ(FieldAccessedAt, v))
+}
+
+// AccessedAtNEQ applies the NEQ predicate on the "accessed_at" field.
+func AccessedAtNEQ(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNEQ(FieldAccessedAt, v))
+}
+
+// AccessedAtIn applies the In predicate on the "accessed_at" field.
+func AccessedAtIn(vs ...time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldIn(FieldAccessedAt, vs...))
+}
+
+// AccessedAtNotIn applies the NotIn predicate on the "accessed_at" field.
+func AccessedAtNotIn(vs ...time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldNotIn(FieldAccessedAt, vs...))
+}
+
+// AccessedAtGT applies the GT predicate on the "accessed_at" field.
+func AccessedAtGT(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGT(FieldAccessedAt, v))
+}
+
+// AccessedAtGTE applies the GTE predicate on the "accessed_at" field.
+func AccessedAtGTE(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldGTE(FieldAccessedAt, v))
+}
+
+// AccessedAtLT applies the LT predicate on the "accessed_at" field.
+func AccessedAtLT(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLT(FieldAccessedAt, v))
+}
+
+// AccessedAtLTE applies the LTE predicate on the "accessed_at" field.
+func AccessedAtLTE(v time.Time) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.FieldLTE(FieldAccessedAt, v))
+}
+
+// HasShareLink applies the HasEdge predicate on the "share_link" edge.
+func HasShareLink() predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ShareLinkTable, ShareLinkColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareLinkWith applies the HasEdge predicate on the "share_link" edge with a given conditions (other predicates).
+func HasShareLinkWith(preds ...predicate.ShareLink) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(func(s *sql.Selector) {
+		step := newShareLinkStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShareLinkAccess) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShareLinkAccess) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShareLinkAccess) predicate.ShareLinkAccess {
+	return predicate.ShareLinkAccess(sql.NotPredicates(p))
+}
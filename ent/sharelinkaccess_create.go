@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkAccessCreate is the builder for creating a ShareLinkAccess entity.
+type ShareLinkAccessCreate struct {
+	config
+	mutation *ShareLinkAccessMutation
+	hooks    []Hook
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (slac *ShareLinkAccessCreate) SetShareLinkID(i int) *ShareLinkAccessCreate {
+	slac.mutation.SetShareLinkID(i)
+	return slac
+}
+
+// SetPath sets the "path" field.
+func (slac *ShareLinkAccessCreate) SetPath(s string) *ShareLinkAccessCreate {
+	slac.mutation.SetPath(s)
+	return slac
+}
+
+// SetIP sets the "ip" field.
+func (slac *ShareLinkAccessCreate) SetIP(s string) *ShareLinkAccessCreate {
+	slac.mutation.SetIP(s)
+	return slac
+}
+
+// SetAccessedAt sets the "accessed_at" field.
+func (slac *ShareLinkAccessCreate) SetAccessedAt(t time.Time) *ShareLinkAccessCreate {
+	slac.mutation.SetAccessedAt(t)
+	return slac
+}
+
+// SetNillableAccessedAt sets the "accessed_at" field if the given value is not nil.
+func (slac *ShareLinkAccessCreate) SetNillableAccessedAt(t *time.Time) *ShareLinkAccessCreate {
+	if t != nil {
+		slac.SetAccessedAt(*t)
+	}
+	return slac
+}
+
+// SetShareLink sets the "share_link" edge to the ShareLink entity.
+func (slac *ShareLinkAccessCreate) SetShareLink(s *ShareLink) *ShareLinkAccessCreate {
+	return slac.SetShareLinkID(s.ID)
+}
+
+// Mutation returns the ShareLinkAccessMutation object of the builder.
+func (slac *ShareLinkAccessCreate) Mutation() *ShareLinkAccessMutation {
+	return slac.mutation
+}
+
+// Save creates the ShareLinkAccess in the database.
+func (slac *ShareLinkAccessCreate) Save(ctx context.Context) (*ShareLinkAccess, error) {
+	slac.defaults()
+	return withHooks(ctx, slac.sqlSave, slac.mutation, slac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (slac *ShareLinkAccessCreate) SaveX(ctx context.Context) *ShareLinkAccess {
+	v, err := slac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slac *ShareLinkAccessCreate) Exec(ctx context.Context) error {
+	_, err := slac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slac *ShareLinkAccessCreate) ExecX(ctx context.Context) {
+	if err := slac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (slac *ShareLinkAccessCreate) defaults() {
+	if _, ok := slac.mutation.AccessedAt(); !ok {
+		v := sharelinkaccess.DefaultAccessedAt()
+		slac.mutation.SetAccessedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slac *ShareLinkAccessCreate) check() error {
+	if _, ok := slac.mutation.ShareLinkID(); !ok {
+		return &ValidationError{Name: "share_link_id", err: errors.New(`ent: missing required field "ShareLinkAccess.share_link_id"`)}
+	}
+	if _, ok := slac.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "ShareLinkAccess.path"`)}
+	}
+	if _, ok := slac.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "ShareLinkAccess.ip"`)}
+	}
+	if _, ok := slac.mutation.AccessedAt(); !ok {
+		return &ValidationError{Name: "accessed_at", err: errors.New(`ent: missing required field "ShareLinkAccess.accessed_at"`)}
+	}
+	if _, ok := slac.mutation.ShareLinkID(); !ok {
+		return &ValidationError{Name: "share_link", err: errors.New(`ent: missing required edge "ShareLinkAccess.share_link"`)}
+	}
+	return nil
+}
+
+func (slac *ShareLinkAccessCreate) sqlSave(ctx context.Context) (*ShareLinkAccess, error) {
+	if err := slac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := slac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, slac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	slac.mutation.id = &_node.ID
+	slac.mutation.done = true
+	return _node, nil
+}
+
+func (slac *ShareLinkAccessCreate) createSpec() (*ShareLinkAccess, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLinkAccess{config: slac.config}
+		_spec = sqlgraph.NewCreateSpec(sharelinkaccess.Table, sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt))
+	)
+	if value, ok := slac.mutation.Path(); ok {
+		_spec.SetField(sharelinkaccess.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := slac.mutation.IP(); ok {
+		_spec.SetField(sharelinkaccess.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := slac.mutation.AccessedAt(); ok {
+		_spec.SetField(sharelinkaccess.FieldAccessedAt, field.TypeTime, value)
+		_node.AccessedAt = value
+	}
+	if nodes := slac.mutation.ShareLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelinkaccess.ShareLinkTable,
+			Columns: []string{sharelinkaccess.ShareLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShareLinkID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkAccessCreateBulk is the builder for creating many ShareLinkAccess entities in bulk.
+type ShareLinkAccessCreateBulk struct {
+	config
+	err      error
+	builders []*ShareLinkAccessCreate
+}
+
+// Save creates the ShareLinkAccess entities in the database.
+func (slacb *ShareLinkAccessCreateBulk) Save(ctx context.Context) ([]*ShareLinkAccess, error) {
+	if slacb.err != nil {
+		return nil, slacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(slacb.builders))
+	nodes := make([]*ShareLinkAccess, len(slacb.builders))
+	mutators := make([]Mutator, len(slacb.builders))
+	for i := range slacb.builders {
+		func(i int, root context.Context) {
+			builder := slacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkAccessMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, slacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, slacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, slacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slacb *ShareLinkAccessCreateBulk) SaveX(ctx context.Context) []*ShareLinkAccess {
+	v, err := slacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (slacb *ShareLinkAccessCreateBulk) Exec(ctx context.Context) error {
+	_, err := slacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slacb *ShareLinkAccessCreateBulk) ExecX(ctx context.Context) {
+	if err := slacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkAccessDelete is the builder for deleting a ShareLinkAccess entity.
+type ShareLinkAccessDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkAccessMutation
+}
+
+// Where appends a list predicates to the ShareLinkAccessDelete builder.
+func (slad *ShareLinkAccessDelete) Where(ps ...predicate.ShareLinkAccess) *ShareLinkAccessDelete {
+	slad.mutation.Where(ps...)
+	return slad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (slad *ShareLinkAccessDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, slad.sqlExec, slad.mutation, slad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slad *ShareLinkAccessDelete) ExecX(ctx context.Context) int {
+	n, err := slad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (slad *ShareLinkAccessDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sharelinkaccess.Table, sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt))
+	if ps := slad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, slad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	slad.mutation.done = true
+	return affected, err
+}
+
+// ShareLinkAccessDeleteOne is the builder for deleting a single ShareLinkAccess entity.
+type ShareLinkAccessDeleteOne struct {
+	slad *ShareLinkAccessDelete
+}
+
+// Where appends a list predicates to the ShareLinkAccessDelete builder.
+func (slado *ShareLinkAccessDeleteOne) Where(ps ...predicate.ShareLinkAccess) *ShareLinkAccessDeleteOne {
+	slado.slad.mutation.Where(ps...)
+	return slado
+}
+
+// Exec executes the deletion query.
+func (slado *ShareLinkAccessDeleteOne) Exec(ctx context.Context) error {
+	n, err := slado.slad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sharelinkaccess.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slado *ShareLinkAccessDeleteOne) ExecX(ctx context.Context) {
+	if err := slado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkAccessQuery is the builder for querying ShareLinkAccess entities.
+type ShareLinkAccessQuery struct {
+	config
+	ctx           *QueryContext
+	order         []sharelinkaccess.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.ShareLinkAccess
+	withShareLink *ShareLinkQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareLinkAccessQuery builder.
+func (slaq *ShareLinkAccessQuery) Where(ps ...predicate.ShareLinkAccess) *ShareLinkAccessQuery {
+	slaq.predicates = append(slaq.predicates, ps...)
+	return slaq
+}
+
+// Limit the number of records to be returned by this query.
+func (slaq *ShareLinkAccessQuery) Limit(limit int) *ShareLinkAccessQuery {
+	slaq.ctx.Limit = &limit
+	return slaq
+}
+
+// Offset to start from.
+func (slaq *ShareLinkAccessQuery) Offset(offset int) *ShareLinkAccessQuery {
+	slaq.ctx.Offset = &offset
+	return slaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (slaq *ShareLinkAccessQuery) Unique(unique bool) *ShareLinkAccessQuery {
+	slaq.ctx.Unique = &unique
+	return slaq
+}
+
+// Order specifies how the records should be ordered.
+func (slaq *ShareLinkAccessQuery) Order(o ...sharelinkaccess.OrderOption) *ShareLinkAccessQuery {
+	slaq.order = append(slaq.order, o...)
+	return slaq
+}
+
+// QueryShareLink chains the current query on the "share_link" edge.
+func (slaq *ShareLinkAccessQuery) QueryShareLink() *ShareLinkQuery {
+	query := (&ShareLinkClient{config: slaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := slaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := slaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sharelinkaccess.Table, sharelinkaccess.FieldID, selector),
+			sqlgraph.To(sharelink.Table, sharelink.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sharelinkaccess.ShareLinkTable, sharelinkaccess.ShareLinkColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(slaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShareLinkAccess entity from the query.
+// Returns a *NotFoundError when no ShareLinkAccess was found.
+func (slaq *ShareLinkAccessQuery) First(ctx context.Context) (*ShareLinkAccess, error) {
+	nodes, err := slaq.Limit(1).All(setContextOp(ctx, slaq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sharelinkaccess.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) FirstX(ctx context.Context) *ShareLinkAccess {
+	node, err := slaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareLinkAccess ID from the query.
+// Returns a *NotFoundError when no ShareLinkAccess ID was found.
+func (slaq *ShareLinkAccessQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slaq.Limit(1).IDs(setContextOp(ctx, slaq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sharelinkaccess.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) FirstIDX(ctx context.Context) int {
+	id, err := slaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareLinkAccess entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShareLinkAccess entity is found.
+// Returns a *NotFoundError when no ShareLinkAccess entities are found.
+func (slaq *ShareLinkAccessQuery) Only(ctx context.Context) (*ShareLinkAccess, error) {
+	nodes, err := slaq.Limit(2).All(setContextOp(ctx, slaq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sharelinkaccess.Label}
+	default:
+		return nil, &NotSingularError{sharelinkaccess.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) OnlyX(ctx context.Context) *ShareLinkAccess {
+	node, err := slaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareLinkAccess ID in the query.
+// Returns a *NotSingularError when more than one ShareLinkAccess ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (slaq *ShareLinkAccessQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slaq.Limit(2).IDs(setContextOp(ctx, slaq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sharelinkaccess.Label}
+	default:
+		err = &NotSingularError{sharelinkaccess.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) OnlyIDX(ctx context.Context) int {
+	id, err := slaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareLinkAccesses.
+func (slaq *ShareLinkAccessQuery) All(ctx context.Context) ([]*ShareLinkAccess, error) {
+	ctx = setContextOp(ctx, slaq.ctx, "All")
+	if err := slaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShareLinkAccess, *ShareLinkAccessQuery]()
+	return withInterceptors[[]*ShareLinkAccess](ctx, slaq, qr, slaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) AllX(ctx context.Context) []*ShareLinkAccess {
+	nodes, err := slaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareLinkAccess IDs.
+func (slaq *ShareLinkAccessQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if slaq.ctx.Unique == nil && slaq.path != nil {
+		slaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, slaq.ctx, "IDs")
+	if err = slaq.Select(sharelinkaccess.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) IDsX(ctx context.Context) []int {
+	ids, err := slaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (slaq *ShareLinkAccessQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, slaq.ctx, "Count")
+	if err := slaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, slaq, querierCount[*ShareLinkAccessQuery](), slaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) CountX(ctx context.Context) int {
+	count, err := slaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (slaq *ShareLinkAccessQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, slaq.ctx, "Exist")
+	switch _, err := slaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (slaq *ShareLinkAccessQuery) ExistX(ctx context.Context) bool {
+	exist, err := slaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareLinkAccessQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (slaq *ShareLinkAccessQuery) Clone() *ShareLinkAccessQuery {
+	if slaq == nil {
+		return nil
+	}
+	return &ShareLinkAccessQuery{
+		config:        slaq.config,
+		ctx:           slaq.ctx.Clone(),
+		order:         append([]sharelinkaccess.OrderOption{}, slaq.order...),
+		inters:        append([]Interceptor{}, slaq.inters...),
+		predicates:    append([]predicate.ShareLinkAccess{}, slaq.predicates...),
+		withShareLink: slaq.withShareLink.Clone(),
+		// clone intermediate query.
+		sql:  slaq.sql.Clone(),
+		path: slaq.path,
+	}
+}
+
+// WithShareLink tells the query-builder to eager-load the nodes that are connected to
+// the "share_link" edge. The optional arguments are used to configure the query builder of the edge.
+func (slaq *ShareLinkAccessQuery) WithShareLink(opts ...func(*ShareLinkQuery)) *ShareLinkAccessQuery {
+	query := (&ShareLinkClient{config: slaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	slaq.withShareLink = query
+	return slaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareLinkID int `json:"share_link_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareLinkAccess.Query().
+//		GroupBy(sharelinkaccess.FieldShareLinkID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (slaq *ShareLinkAccessQuery) GroupBy(field string, fields ...string) *ShareLinkAccessGroupBy {
+	slaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShareLinkAccessGroupBy{build: slaq}
+	grbuild.flds = &slaq.ctx.Fields
+	grbuild.label = sharelinkaccess.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareLinkID int `json:"share_link_id,omitempty"`
+//	}
+//
+//	client.ShareLinkAccess.Query().
+//		Select(sharelinkaccess.FieldShareLinkID).
+//		Scan(ctx, &v)
+func (slaq *ShareLinkAccessQuery) Select(fields ...string) *ShareLinkAccessSelect {
+	slaq.ctx.Fields = append(slaq.ctx.Fields, fields...)
+	sbuild := &ShareLinkAccessSelect{ShareLinkAccessQuery: slaq}
+	sbuild.label = sharelinkaccess.Label
+	sbuild.flds, sbuild.scan = &slaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShareLinkAccessSelect configured with the given aggregations.
+func (slaq *ShareLinkAccessQuery) Aggregate(fns ...AggregateFunc) *ShareLinkAccessSelect {
+	return slaq.Select().Aggregate(fns...)
+}
+
+func (slaq *ShareLinkAccessQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range slaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, slaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range slaq.ctx.Fields {
+		if !sharelinkaccess.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if slaq.path != nil {
+		prev, err := slaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		slaq.sql = prev
+	}
+	return nil
+}
+
+func (slaq *ShareLinkAccessQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShareLinkAccess, error) {
+	var (
+		nodes       = []*ShareLinkAccess{}
+		_spec       = slaq.querySpec()
+		loadedTypes = [1]bool{
+			slaq.withShareLink != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShareLinkAccess).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShareLinkAccess{config: slaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, slaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := slaq.withShareLink; query != nil {
+		if err := slaq.loadShareLink(ctx, query, nodes, nil,
+			func(n *ShareLinkAccess, e *ShareLink) { n.Edges.ShareLink = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (slaq *ShareLinkAccessQuery) loadShareLink(ctx context.Context, query *ShareLinkQuery, nodes []*ShareLinkAccess, init func(*ShareLinkAccess), assign func(*ShareLinkAccess, *ShareLink)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareLinkAccess)
+	for i := range nodes {
+		fk := nodes[i].ShareLinkID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sharelink.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "share_link_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (slaq *ShareLinkAccessQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := slaq.querySpec()
+	_spec.Node.Columns = slaq.ctx.Fields
+	if len(slaq.ctx.Fields) > 0 {
+		_spec.Unique = slaq.ctx.Unique != nil && *slaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, slaq.driver, _spec)
+}
+
+func (slaq *ShareLinkAccessQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sharelinkaccess.Table, sharelinkaccess.Columns, sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt))
+	_spec.From = slaq.sql
+	if unique := slaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if slaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := slaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelinkaccess.FieldID)
+		for i := range fields {
+			if fields[i] != sharelinkaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if slaq.withShareLink != nil {
+			_spec.Node.AddColumnOnce(sharelinkaccess.FieldShareLinkID)
+		}
+	}
+	if ps := slaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := slaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := slaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := slaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (slaq *ShareLinkAccessQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(slaq.driver.Dialect())
+	t1 := builder.Table(sharelinkaccess.Table)
+	columns := slaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sharelinkaccess.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if slaq.sql != nil {
+		selector = slaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if slaq.ctx.Unique != nil && *slaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range slaq.predicates {
+		p(selector)
+	}
+	for _, p := range slaq.order {
+		p(selector)
+	}
+	if offset := slaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := slaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShareLinkAccessGroupBy is the group-by builder for ShareLinkAccess entities.
+type ShareLinkAccessGroupBy struct {
+	selector
+	build *ShareLinkAccessQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (slagb *ShareLinkAccessGroupBy) Aggregate(fns ...AggregateFunc) *ShareLinkAccessGroupBy {
+	slagb.fns = append(slagb.fns, fns...)
+	return slagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (slagb *ShareLinkAccessGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, slagb.build.ctx, "GroupBy")
+	if err := slagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareLinkAccessQuery, *ShareLinkAccessGroupBy](ctx, slagb.build, slagb, slagb.build.inters, v)
+}
+
+func (slagb *ShareLinkAccessGroupBy) sqlScan(ctx context.Context, root *ShareLinkAccessQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(slagb.fns))
+	for _, fn := range slagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*slagb.flds)+len(slagb.fns))
+		for _, f := range *slagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*slagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := slagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShareLinkAccessSelect is the builder for selecting fields of ShareLinkAccess entities.
+type ShareLinkAccessSelect struct {
+	*ShareLinkAccessQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (slas *ShareLinkAccessSelect) Aggregate(fns ...AggregateFunc) *ShareLinkAccessSelect {
+	slas.fns = append(slas.fns, fns...)
+	return slas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (slas *ShareLinkAccessSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, slas.ctx, "Select")
+	if err := slas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareLinkAccessQuery, *ShareLinkAccessSelect](ctx, slas.ShareLinkAccessQuery, slas, slas.inters, v)
+}
+
+func (slas *ShareLinkAccessSelect) sqlScan(ctx context.Context, root *ShareLinkAccessQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(slas.fns))
+	for _, fn := range slas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*slas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := slas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+)
+
+// ShareLinkAccessUpdate is the builder for updating ShareLinkAccess entities.
+type ShareLinkAccessUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkAccessMutation
+}
+
+// Where appends a list predicates to the ShareLinkAccessUpdate builder.
+func (slau *ShareLinkAccessUpdate) Where(ps ...predicate.ShareLinkAccess) *ShareLinkAccessUpdate {
+	slau.mutation.Where(ps...)
+	return slau
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (slau *ShareLinkAccessUpdate) SetShareLinkID(i int) *ShareLinkAccessUpdate {
+	slau.mutation.SetShareLinkID(i)
+	return slau
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (slau *ShareLinkAccessUpdate) SetNillableShareLinkID(i *int) *ShareLinkAccessUpdate {
+	if i != nil {
+		slau.SetShareLinkID(*i)
+	}
+	return slau
+}
+
+// SetPath sets the "path" field.
+func (slau *ShareLinkAccessUpdate) SetPath(s string) *ShareLinkAccessUpdate {
+	slau.mutation.SetPath(s)
+	return slau
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (slau *ShareLinkAccessUpdate) SetNillablePath(s *string) *ShareLinkAccessUpdate {
+	if s != nil {
+		slau.SetPath(*s)
+	}
+	return slau
+}
+
+// SetIP sets the "ip" field.
+func (slau *ShareLinkAccessUpdate) SetIP(s string) *ShareLinkAccessUpdate {
+	slau.mutation.SetIP(s)
+	return slau
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (slau *ShareLinkAccessUpdate) SetNillableIP(s *string) *ShareLinkAccessUpdate {
+	if s != nil {
+		slau.SetIP(*s)
+	}
+	return slau
+}
+
+// SetShareLink sets the "share_link" edge to the ShareLink entity.
+func (slau *ShareLinkAccessUpdate) SetShareLink(s *ShareLink) *ShareLinkAccessUpdate {
+	return slau.SetShareLinkID(s.ID)
+}
+
+// Mutation returns the ShareLinkAccessMutation object of the builder.
+func (slau *ShareLinkAccessUpdate) Mutation() *ShareLinkAccessMutation {
+	return slau.mutation
+}
+
+// ClearShareLink clears the "share_link" edge to the ShareLink entity.
+func (slau *ShareLinkAccessUpdate) ClearShareLink() *ShareLinkAccessUpdate {
+	slau.mutation.ClearShareLink()
+	return slau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (slau *ShareLinkAccessUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, slau.sqlSave, slau.mutation, slau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slau *ShareLinkAccessUpdate) SaveX(ctx context.Context) int {
+	affected, err := slau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (slau *ShareLinkAccessUpdate) Exec(ctx context.Context) error {
+	_, err := slau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slau *ShareLinkAccessUpdate) ExecX(ctx context.Context) {
+	if err := slau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slau *ShareLinkAccessUpdate) check() error {
+	if _, ok := slau.mutation.ShareLinkID(); slau.mutation.ShareLinkCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLinkAccess.share_link"`)
+	}
+	return nil
+}
+
+func (slau *ShareLinkAccessUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := slau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelinkaccess.Table, sharelinkaccess.Columns, sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt))
+	if ps := slau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := slau.mutation.Path(); ok {
+		_spec.SetField(sharelinkaccess.FieldPath, field.TypeString, value)
+	}
+	if value, ok := slau.mutation.IP(); ok {
+		_spec.SetField(sharelinkaccess.FieldIP, field.TypeString, value)
+	}
+	if slau.mutation.ShareLinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelinkaccess.ShareLinkTable,
+			Columns: []string{sharelinkaccess.ShareLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slau.mutation.ShareLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelinkaccess.ShareLinkTable,
+			Columns: []string{sharelinkaccess.ShareLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, slau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelinkaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	slau.mutation.done = true
+	return n, nil
+}
+
+// ShareLinkAccessUpdateOne is the builder for updating a single ShareLinkAccess entity.
+type ShareLinkAccessUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareLinkAccessMutation
+}
+
+// SetShareLinkID sets the "share_link_id" field.
+func (slauo *ShareLinkAccessUpdateOne) SetShareLinkID(i int) *ShareLinkAccessUpdateOne {
+	slauo.mutation.SetShareLinkID(i)
+	return slauo
+}
+
+// SetNillableShareLinkID sets the "share_link_id" field if the given value is not nil.
+func (slauo *ShareLinkAccessUpdateOne) SetNillableShareLinkID(i *int) *ShareLinkAccessUpdateOne {
+	if i != nil {
+		slauo.SetShareLinkID(*i)
+	}
+	return slauo
+}
+
+// SetPath sets the "path" field.
+func (slauo *ShareLinkAccessUpdateOne) SetPath(s string) *ShareLinkAccessUpdateOne {
+	slauo.mutation.SetPath(s)
+	return slauo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (slauo *ShareLinkAccessUpdateOne) SetNillablePath(s *string) *ShareLinkAccessUpdateOne {
+	if s != nil {
+		slauo.SetPath(*s)
+	}
+	return slauo
+}
+
+// SetIP sets the "ip" field.
+func (slauo *ShareLinkAccessUpdateOne) SetIP(s string) *ShareLinkAccessUpdateOne {
+	slauo.mutation.SetIP(s)
+	return slauo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (slauo *ShareLinkAccessUpdateOne) SetNillableIP(s *string) *ShareLinkAccessUpdateOne {
+	if s != nil {
+		slauo.SetIP(*s)
+	}
+	return slauo
+}
+
+// SetShareLink sets the "share_link" edge to the ShareLink entity.
+func (slauo *ShareLinkAccessUpdateOne) SetShareLink(s *ShareLink) *ShareLinkAccessUpdateOne {
+	return slauo.SetShareLinkID(s.ID)
+}
+
+// Mutation returns the ShareLinkAccessMutation object of the builder.
+func (slauo *ShareLinkAccessUpdateOne) Mutation() *ShareLinkAccessMutation {
+	return slauo.mutation
+}
+
+// ClearShareLink clears the "share_link" edge to the ShareLink entity.
+func (slauo *ShareLinkAccessUpdateOne) ClearShareLink() *ShareLinkAccessUpdateOne {
+	slauo.mutation.ClearShareLink()
+	return slauo
+}
+
+// Where appends a list predicates to the ShareLinkAccessUpdate builder.
+func (slauo *ShareLinkAccessUpdateOne) Where(ps ...predicate.ShareLinkAccess) *ShareLinkAccessUpdateOne {
+	slauo.mutation.Where(ps...)
+	return slauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (slauo *ShareLinkAccessUpdateOne) Select(field string, fields ...string) *ShareLinkAccessUpdateOne {
+	slauo.fields = append([]string{field}, fields...)
+	return slauo
+}
+
+// Save executes the query and returns the updated ShareLinkAccess entity.
+func (slauo *ShareLinkAccessUpdateOne) Save(ctx context.Context) (*ShareLinkAccess, error) {
+	return withHooks(ctx, slauo.sqlSave, slauo.mutation, slauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (slauo *ShareLinkAccessUpdateOne) SaveX(ctx context.Context) *ShareLinkAccess {
+	node, err := slauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (slauo *ShareLinkAccessUpdateOne) Exec(ctx context.Context) error {
+	_, err := slauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (slauo *ShareLinkAccessUpdateOne) ExecX(ctx context.Context) {
+	if err := slauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (slauo *ShareLinkAccessUpdateOne) check() error {
+	if _, ok := slauo.mutation.ShareLinkID(); slauo.mutation.ShareLinkCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShareLinkAccess.share_link"`)
+	}
+	return nil
+}
+
+func (slauo *ShareLinkAccessUpdateOne) sqlSave(ctx context.Context) (_node *ShareLinkAccess, err error) {
+	if err := slauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelinkaccess.Table, sharelinkaccess.Columns, sqlgraph.NewFieldSpec(sharelinkaccess.FieldID, field.TypeInt))
+	id, ok := slauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareLinkAccess.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := slauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelinkaccess.FieldID)
+		for _, f := range fields {
+			if !sharelinkaccess.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharelinkaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := slauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := slauo.mutation.Path(); ok {
+		_spec.SetField(sharelinkaccess.FieldPath, field.TypeString, value)
+	}
+	if value, ok := slauo.mutation.IP(); ok {
+		_spec.SetField(sharelinkaccess.FieldIP, field.TypeString, value)
+	}
+	if slauo.mutation.ShareLinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelinkaccess.ShareLinkTable,
+			Columns: []string{sharelinkaccess.ShareLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := slauo.mutation.ShareLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sharelinkaccess.ShareLinkTable,
+			Columns: []string{sharelinkaccess.ShareLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareLinkAccess{config: slauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, slauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelinkaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	slauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// StaffRole is the model entity for the StaffRole schema.
+type StaffRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role staffrole.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StaffRoleQuery when eager-loading is set.
+	Edges        StaffRoleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StaffRoleEdges holds the relations/edges for other nodes in the graph.
+type StaffRoleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StaffRoleEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StaffRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case staffrole.FieldID, staffrole.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case staffrole.FieldRole:
+			values[i] = new(sql.NullString)
+		case staffrole.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StaffRole fields.
+func (sr *StaffRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case staffrole.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case staffrole.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sr.UserID = int(value.Int64)
+			}
+		case staffrole.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				sr.Role = staffrole.Role(value.String)
+			}
+		case staffrole.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StaffRole.
+// This includes values selected through modifiers, order, etc.
+func (sr *StaffRole) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the StaffRole entity.
+func (sr *StaffRole) QueryUser() *UserQuery {
+	return NewStaffRoleClient(sr.config).QueryUser(sr)
+}
+
+// Update returns a builder for updating this StaffRole.
+// Note that you need to call StaffRole.Unwrap() before calling this method if this StaffRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *StaffRole) Update() *StaffRoleUpdateOne {
+	return NewStaffRoleClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the StaffRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *StaffRole) Unwrap() *StaffRole {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StaffRole is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *StaffRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("StaffRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Role))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StaffRoles is a parsable slice of StaffRole.
+type StaffRoles []*StaffRole
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// StaffRoleCreate is the builder for creating a StaffRole entity.
+type StaffRoleCreate struct {
+	config
+	mutation *StaffRoleMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (src *StaffRoleCreate) SetUserID(i int) *StaffRoleCreate {
+	src.mutation.SetUserID(i)
+	return src
+}
+
+// SetRole sets the "role" field.
+func (src *StaffRoleCreate) SetRole(s staffrole.Role) *StaffRoleCreate {
+	src.mutation.SetRole(s)
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *StaffRoleCreate) SetCreatedAt(t time.Time) *StaffRoleCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *StaffRoleCreate) SetNillableCreatedAt(t *time.Time) *StaffRoleCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (src *StaffRoleCreate) SetUser(u *User) *StaffRoleCreate {
+	return src.SetUserID(u.ID)
+}
+
+// Mutation returns the StaffRoleMutation object of the builder.
+func (src *StaffRoleCreate) Mutation() *StaffRoleMutation {
+	return src.mutation
+}
+
+// Save creates the StaffRole in the database.
+func (src *StaffRoleCreate) Save(ctx context.Context) (*StaffRole, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *StaffRoleCreate) SaveX(ctx context.Context) *StaffRole {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *StaffRoleCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *StaffRoleCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *StaffRoleCreate) defaults() {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := staffrole.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *StaffRoleCreate) check() error {
+	if _, ok := src.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "StaffRole.user_id"`)}
+	}
+	if _, ok := src.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "StaffRole.role"`)}
+	}
+	if v, ok := src.mutation.Role(); ok {
+		if err := staffrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "StaffRole.role": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StaffRole.created_at"`)}
+	}
+	if _, ok := src.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "StaffRole.user"`)}
+	}
+	return nil
+}
+
+func (src *StaffRoleCreate) sqlSave(ctx context.Context) (*StaffRole, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *StaffRoleCreate) createSpec() (*StaffRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StaffRole{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(staffrole.Table, sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt))
+	)
+	if value, ok := src.mutation.Role(); ok {
+		_spec.SetField(staffrole.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(staffrole.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := src.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staffrole.UserTable,
+			Columns: []string{staffrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StaffRoleCreateBulk is the builder for creating many StaffRole entities in bulk.
+type StaffRoleCreateBulk struct {
+	config
+	err      error
+	builders []*StaffRoleCreate
+}
+
+// Save creates the StaffRole entities in the database.
+func (srcb *StaffRoleCreateBulk) Save(ctx context.Context) ([]*StaffRole, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*StaffRole, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StaffRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *StaffRoleCreateBulk) SaveX(ctx context.Context) []*StaffRole {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *StaffRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *StaffRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/staffrole"
+)
+
+// StaffRoleDelete is the builder for deleting a StaffRole entity.
+type StaffRoleDelete struct {
+	config
+	hooks    []Hook
+	mutation *StaffRoleMutation
+}
+
+// Where appends a list predicates to the StaffRoleDelete builder.
+func (srd *StaffRoleDelete) Where(ps ...predicate.StaffRole) *StaffRoleDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *StaffRoleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *StaffRoleDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *StaffRoleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(staffrole.Table, sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// StaffRoleDeleteOne is the builder for deleting a single StaffRole entity.
+type StaffRoleDeleteOne struct {
+	srd *StaffRoleDelete
+}
+
+// Where appends a list predicates to the StaffRoleDelete builder.
+func (srdo *StaffRoleDeleteOne) Where(ps ...predicate.StaffRole) *StaffRoleDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *StaffRoleDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{staffrole.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *StaffRoleDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
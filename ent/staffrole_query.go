@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// StaffRoleQuery is the builder for querying StaffRole entities.
+type StaffRoleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []staffrole.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StaffRole
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StaffRoleQuery builder.
+func (srq *StaffRoleQuery) Where(ps ...predicate.StaffRole) *StaffRoleQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *StaffRoleQuery) Limit(limit int) *StaffRoleQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *StaffRoleQuery) Offset(offset int) *StaffRoleQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *StaffRoleQuery) Unique(unique bool) *StaffRoleQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *StaffRoleQuery) Order(o ...staffrole.OrderOption) *StaffRoleQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (srq *StaffRoleQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: srq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staffrole.Table, staffrole.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, staffrole.UserTable, staffrole.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StaffRole entity from the query.
+// Returns a *NotFoundError when no StaffRole was found.
+func (srq *StaffRoleQuery) First(ctx context.Context) (*StaffRole, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{staffrole.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *StaffRoleQuery) FirstX(ctx context.Context) *StaffRole {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StaffRole ID from the query.
+// Returns a *NotFoundError when no StaffRole ID was found.
+func (srq *StaffRoleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{staffrole.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *StaffRoleQuery) FirstIDX(ctx context.Context) int {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StaffRole entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StaffRole entity is found.
+// Returns a *NotFoundError when no StaffRole entities are found.
+func (srq *StaffRoleQuery) Only(ctx context.Context) (*StaffRole, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{staffrole.Label}
+	default:
+		return nil, &NotSingularError{staffrole.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *StaffRoleQuery) OnlyX(ctx context.Context) *StaffRole {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StaffRole ID in the query.
+// Returns a *NotSingularError when more than one StaffRole ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *StaffRoleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{staffrole.Label}
+	default:
+		err = &NotSingularError{staffrole.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *StaffRoleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StaffRoles.
+func (srq *StaffRoleQuery) All(ctx context.Context) ([]*StaffRole, error) {
+	ctx = setContextOp(ctx, srq.ctx, "All")
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StaffRole, *StaffRoleQuery]()
+	return withInterceptors[[]*StaffRole](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *StaffRoleQuery) AllX(ctx context.Context) []*StaffRole {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StaffRole IDs.
+func (srq *StaffRoleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, "IDs")
+	if err = srq.Select(staffrole.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *StaffRoleQuery) IDsX(ctx context.Context) []int {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *StaffRoleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, "Count")
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*StaffRoleQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *StaffRoleQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *StaffRoleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, "Exist")
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *StaffRoleQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StaffRoleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *StaffRoleQuery) Clone() *StaffRoleQuery {
+	if srq == nil {
+		return nil
+	}
+	return &StaffRoleQuery{
+		config:     srq.config,
+		ctx:        srq.ctx.Clone(),
+		order:      append([]staffrole.OrderOption{}, srq.order...),
+		inters:     append([]Interceptor{}, srq.inters...),
+		predicates: append([]predicate.StaffRole{}, srq.predicates...),
+		withUser:   srq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (srq *StaffRoleQuery) WithUser(opts ...func(*UserQuery)) *StaffRoleQuery {
+	query := (&UserClient{config: srq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srq.withUser = query
+	return srq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StaffRole.Query().
+//		GroupBy(staffrole.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *StaffRoleQuery) GroupBy(field string, fields ...string) *StaffRoleGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StaffRoleGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = staffrole.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.StaffRole.Query().
+//		Select(staffrole.FieldUserID).
+//		Scan(ctx, &v)
+func (srq *StaffRoleQuery) Select(fields ...string) *StaffRoleSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &StaffRoleSelect{StaffRoleQuery: srq}
+	sbuild.label = staffrole.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StaffRoleSelect configured with the given aggregations.
+func (srq *StaffRoleQuery) Aggregate(fns ...AggregateFunc) *StaffRoleSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *StaffRoleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !staffrole.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *StaffRoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StaffRole, error) {
+	var (
+		nodes       = []*StaffRole{}
+		_spec       = srq.querySpec()
+		loadedTypes = [1]bool{
+			srq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StaffRole).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StaffRole{config: srq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srq.withUser; query != nil {
+		if err := srq.loadUser(ctx, query, nodes, nil,
+			func(n *StaffRole, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srq *StaffRoleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*StaffRole, init func(*StaffRole), assign func(*StaffRole, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StaffRole)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (srq *StaffRoleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *StaffRoleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(staffrole.Table, staffrole.Columns, sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, staffrole.FieldID)
+		for i := range fields {
+			if fields[i] != staffrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if srq.withUser != nil {
+			_spec.Node.AddColumnOnce(staffrole.FieldUserID)
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *StaffRoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(staffrole.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = staffrole.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StaffRoleGroupBy is the group-by builder for StaffRole entities.
+type StaffRoleGroupBy struct {
+	selector
+	build *StaffRoleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *StaffRoleGroupBy) Aggregate(fns ...AggregateFunc) *StaffRoleGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *StaffRoleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, "GroupBy")
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StaffRoleQuery, *StaffRoleGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *StaffRoleGroupBy) sqlScan(ctx context.Context, root *StaffRoleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StaffRoleSelect is the builder for selecting fields of StaffRole entities.
+type StaffRoleSelect struct {
+	*StaffRoleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *StaffRoleSelect) Aggregate(fns ...AggregateFunc) *StaffRoleSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *StaffRoleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, "Select")
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StaffRoleQuery, *StaffRoleSelect](ctx, srs.StaffRoleQuery, srs, srs.inters, v)
+}
+
+func (srs *StaffRoleSelect) sqlScan(ctx context.Context, root *StaffRoleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// StaffRoleUpdate is the builder for updating StaffRole entities.
+type StaffRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StaffRoleMutation
+}
+
+// Where appends a list predicates to the StaffRoleUpdate builder.
+func (sru *StaffRoleUpdate) Where(ps ...predicate.StaffRole) *StaffRoleUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetUserID sets the "user_id" field.
+func (sru *StaffRoleUpdate) SetUserID(i int) *StaffRoleUpdate {
+	sru.mutation.SetUserID(i)
+	return sru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sru *StaffRoleUpdate) SetNillableUserID(i *int) *StaffRoleUpdate {
+	if i != nil {
+		sru.SetUserID(*i)
+	}
+	return sru
+}
+
+// SetRole sets the "role" field.
+func (sru *StaffRoleUpdate) SetRole(s staffrole.Role) *StaffRoleUpdate {
+	sru.mutation.SetRole(s)
+	return sru
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (sru *StaffRoleUpdate) SetNillableRole(s *staffrole.Role) *StaffRoleUpdate {
+	if s != nil {
+		sru.SetRole(*s)
+	}
+	return sru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sru *StaffRoleUpdate) SetUser(u *User) *StaffRoleUpdate {
+	return sru.SetUserID(u.ID)
+}
+
+// Mutation returns the StaffRoleMutation object of the builder.
+func (sru *StaffRoleUpdate) Mutation() *StaffRoleMutation {
+	return sru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sru *StaffRoleUpdate) ClearUser() *StaffRoleUpdate {
+	sru.mutation.ClearUser()
+	return sru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *StaffRoleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *StaffRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *StaffRoleUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *StaffRoleUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *StaffRoleUpdate) check() error {
+	if v, ok := sru.mutation.Role(); ok {
+		if err := staffrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "StaffRole.role": %w`, err)}
+		}
+	}
+	if _, ok := sru.mutation.UserID(); sru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "StaffRole.user"`)
+	}
+	return nil
+}
+
+func (sru *StaffRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(staffrole.Table, staffrole.Columns, sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.Role(); ok {
+		_spec.SetField(staffrole.FieldRole, field.TypeEnum, value)
+	}
+	if sru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staffrole.UserTable,
+			Columns: []string{staffrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staffrole.UserTable,
+			Columns: []string{staffrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{staffrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// StaffRoleUpdateOne is the builder for updating a single StaffRole entity.
+type StaffRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StaffRoleMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (sruo *StaffRoleUpdateOne) SetUserID(i int) *StaffRoleUpdateOne {
+	sruo.mutation.SetUserID(i)
+	return sruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sruo *StaffRoleUpdateOne) SetNillableUserID(i *int) *StaffRoleUpdateOne {
+	if i != nil {
+		sruo.SetUserID(*i)
+	}
+	return sruo
+}
+
+// SetRole sets the "role" field.
+func (sruo *StaffRoleUpdateOne) SetRole(s staffrole.Role) *StaffRoleUpdateOne {
+	sruo.mutation.SetRole(s)
+	return sruo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (sruo *StaffRoleUpdateOne) SetNillableRole(s *staffrole.Role) *StaffRoleUpdateOne {
+	if s != nil {
+		sruo.SetRole(*s)
+	}
+	return sruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sruo *StaffRoleUpdateOne) SetUser(u *User) *StaffRoleUpdateOne {
+	return sruo.SetUserID(u.ID)
+}
+
+// Mutation returns the StaffRoleMutation object of the builder.
+func (sruo *StaffRoleUpdateOne) Mutation() *StaffRoleMutation {
+	return sruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sruo *StaffRoleUpdateOne) ClearUser() *StaffRoleUpdateOne {
+	sruo.mutation.ClearUser()
+	return sruo
+}
+
+// Where appends a list predicates to the StaffRoleUpdate builder.
+func (sruo *StaffRoleUpdateOne) Where(ps ...predicate.StaffRole) *StaffRoleUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *StaffRoleUpdateOne) Select(field string, fields ...string) *StaffRoleUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated StaffRole entity.
+func (sruo *StaffRoleUpdateOne) Save(ctx context.Context) (*StaffRole, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *StaffRoleUpdateOne) SaveX(ctx context.Context) *StaffRole {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *StaffRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *StaffRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *StaffRoleUpdateOne) check() error {
+	if v, ok := sruo.mutation.Role(); ok {
+		if err := staffrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "StaffRole.role": %w`, err)}
+		}
+	}
+	if _, ok := sruo.mutation.UserID(); sruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "StaffRole.user"`)
+	}
+	return nil
+}
+
+func (sruo *StaffRoleUpdateOne) sqlSave(ctx context.Context) (_node *StaffRole, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(staffrole.Table, staffrole.Columns, sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StaffRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, staffrole.FieldID)
+		for _, f := range fields {
+			if !staffrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != staffrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.Role(); ok {
+		_spec.SetField(staffrole.FieldRole, field.TypeEnum, value)
+	}
+	if sruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staffrole.UserTable,
+			Columns: []string{staffrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staffrole.UserTable,
+			Columns: []string{staffrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StaffRole{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{staffrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
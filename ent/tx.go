@@ -12,12 +12,56 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// Collateral is the client for interacting with the Collateral builders.
+	Collateral *CollateralClient
+	// CollateralAppraisal is the client for interacting with the CollateralAppraisal builders.
+	CollateralAppraisal *CollateralAppraisalClient
+	// CreditLine is the client for interacting with the CreditLine builders.
+	CreditLine *CreditLineClient
+	// CreditLineTransaction is the client for interacting with the CreditLineTransaction builders.
+	CreditLineTransaction *CreditLineTransactionClient
+	// EscrowItem is the client for interacting with the EscrowItem builders.
+	EscrowItem *EscrowItemClient
+	// IncomeCertification is the client for interacting with the IncomeCertification builders.
+	IncomeCertification *IncomeCertificationClient
+	// IncomeDrivenPlan is the client for interacting with the IncomeDrivenPlan builders.
+	IncomeDrivenPlan *IncomeDrivenPlanClient
 	// Loan is the client for interacting with the Loan builders.
 	Loan *LoanClient
+	// LoanDisbursement is the client for interacting with the LoanDisbursement builders.
+	LoanDisbursement *LoanDisbursementClient
+	// LoanModification is the client for interacting with the LoanModification builders.
+	LoanModification *LoanModificationClient
+	// LoanObligor is the client for interacting with the LoanObligor builders.
+	LoanObligor *LoanObligorClient
+	// LoanRecast is the client for interacting with the LoanRecast builders.
+	LoanRecast *LoanRecastClient
+	// OidcLogin is the client for interacting with the OidcLogin builders.
+	OidcLogin *OidcLoginClient
+	// PaymentDeferral is the client for interacting with the PaymentDeferral builders.
+	PaymentDeferral *PaymentDeferralClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// ShareInvitation is the client for interacting with the ShareInvitation builders.
+	ShareInvitation *ShareInvitationClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
+	// ShareLinkAccess is the client for interacting with the ShareLinkAccess builders.
+	ShareLinkAccess *ShareLinkAccessClient
 	// SharedLoan is the client for interacting with the SharedLoan builders.
 	SharedLoan *SharedLoanClient
+	// StaffRole is the client for interacting with the StaffRole builders.
+	StaffRole *StaffRoleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserChange is the client for interacting with the UserChange builders.
+	UserChange *UserChangeClient
+	// UserCredential is the client for interacting with the UserCredential builders.
+	UserCredential *UserCredentialClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +193,31 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.Collateral = NewCollateralClient(tx.config)
+	tx.CollateralAppraisal = NewCollateralAppraisalClient(tx.config)
+	tx.CreditLine = NewCreditLineClient(tx.config)
+	tx.CreditLineTransaction = NewCreditLineTransactionClient(tx.config)
+	tx.EscrowItem = NewEscrowItemClient(tx.config)
+	tx.IncomeCertification = NewIncomeCertificationClient(tx.config)
+	tx.IncomeDrivenPlan = NewIncomeDrivenPlanClient(tx.config)
 	tx.Loan = NewLoanClient(tx.config)
+	tx.LoanDisbursement = NewLoanDisbursementClient(tx.config)
+	tx.LoanModification = NewLoanModificationClient(tx.config)
+	tx.LoanObligor = NewLoanObligorClient(tx.config)
+	tx.LoanRecast = NewLoanRecastClient(tx.config)
+	tx.OidcLogin = NewOidcLoginClient(tx.config)
+	tx.PaymentDeferral = NewPaymentDeferralClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.ShareInvitation = NewShareInvitationClient(tx.config)
+	tx.ShareLink = NewShareLinkClient(tx.config)
+	tx.ShareLinkAccess = NewShareLinkAccessClient(tx.config)
 	tx.SharedLoan = NewSharedLoanClient(tx.config)
+	tx.StaffRole = NewStaffRoleClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserChange = NewUserChangeClient(tx.config)
+	tx.UserCredential = NewUserCredentialClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +227,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Loan.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
 )
 
 // User is the model entity for the User schema.
@@ -18,8 +19,16 @@ type User struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// NameIndex holds the value of the "name_index" field.
+	NameIndex string `json:"-"`
 	// Social holds the value of the "social" field.
-	Social string `json:"social,omitempty"`
+	Social *string `json:"-"`
+	// SocialHash holds the value of the "social_hash" field.
+	SocialHash string `json:"social_hash,omitempty"`
+	// SocialEncrypted holds the value of the "social_encrypted" field.
+	SocialEncrypted string `json:"-"`
+	// SocialLast4 holds the value of the "social_last4" field.
+	SocialLast4 string `json:"social_last4,omitempty"`
 	// Address holds the value of the "address" field.
 	Address string `json:"address,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -34,9 +43,23 @@ type UserEdges struct {
 	Loans []*Loan `json:"loans,omitempty"`
 	// SharedLoan holds the value of the shared_loan edge.
 	SharedLoan []*SharedLoan `json:"shared_loan,omitempty"`
+	// ShareInvitations holds the value of the share_invitations edge.
+	ShareInvitations []*ShareInvitation `json:"share_invitations,omitempty"`
+	// CreditLines holds the value of the credit_lines edge.
+	CreditLines []*CreditLine `json:"credit_lines,omitempty"`
+	// Obligations holds the value of the obligations edge.
+	Obligations []*LoanObligor `json:"obligations,omitempty"`
+	// Credential holds the value of the credential edge.
+	Credential *UserCredential `json:"credential,omitempty"`
+	// StaffRoles holds the value of the staff_roles edge.
+	StaffRoles []*StaffRole `json:"staff_roles,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // LoansOrErr returns the Loans value or an error if the edge
@@ -57,6 +80,73 @@ func (e UserEdges) SharedLoanOrErr() ([]*SharedLoan, error) {
 	return nil, &NotLoadedError{edge: "shared_loan"}
 }
 
+// ShareInvitationsOrErr returns the ShareInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ShareInvitationsOrErr() ([]*ShareInvitation, error) {
+	if e.loadedTypes[2] {
+		return e.ShareInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "share_invitations"}
+}
+
+// CreditLinesOrErr returns the CreditLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreditLinesOrErr() ([]*CreditLine, error) {
+	if e.loadedTypes[3] {
+		return e.CreditLines, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_lines"}
+}
+
+// ObligationsOrErr returns the Obligations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ObligationsOrErr() ([]*LoanObligor, error) {
+	if e.loadedTypes[4] {
+		return e.Obligations, nil
+	}
+	return nil, &NotLoadedError{edge: "obligations"}
+}
+
+// CredentialOrErr returns the Credential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) CredentialOrErr() (*UserCredential, error) {
+	if e.loadedTypes[5] {
+		if e.Credential == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: usercredential.Label}
+		}
+		return e.Credential, nil
+	}
+	return nil, &NotLoadedError{edge: "credential"}
+}
+
+// StaffRolesOrErr returns the StaffRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StaffRolesOrErr() ([]*StaffRole, error) {
+	if e.loadedTypes[6] {
+		return e.StaffRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "staff_roles"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[7] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[8] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -64,8 +154,12 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldSocial, user.FieldAddress:
+		case user.FieldNameIndex, user.FieldSocial, user.FieldSocialHash, user.FieldSocialEncrypted, user.FieldSocialLast4:
 			values[i] = new(sql.NullString)
+		case user.FieldName:
+			values[i] = user.ValueScanner.Name.ScanValue()
+		case user.FieldAddress:
+			values[i] = user.ValueScanner.Address.ScanValue()
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -88,22 +182,47 @@ func (u *User) assignValues(columns []string, values []any) error {
 			}
 			u.ID = int(value.Int64)
 		case user.FieldName:
+			if value, err := user.ValueScanner.Name.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				u.Name = value
+			}
+		case user.FieldNameIndex:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field name", values[i])
+				return fmt.Errorf("unexpected type %T for field name_index", values[i])
 			} else if value.Valid {
-				u.Name = value.String
+				u.NameIndex = value.String
 			}
 		case user.FieldSocial:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field social", values[i])
 			} else if value.Valid {
-				u.Social = value.String
+				u.Social = new(string)
+				*u.Social = value.String
 			}
-		case user.FieldAddress:
+		case user.FieldSocialHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field social_hash", values[i])
+			} else if value.Valid {
+				u.SocialHash = value.String
+			}
+		case user.FieldSocialEncrypted:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field address", values[i])
+				return fmt.Errorf("unexpected type %T for field social_encrypted", values[i])
 			} else if value.Valid {
-				u.Address = value.String
+				u.SocialEncrypted = value.String
+			}
+		case user.FieldSocialLast4:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field social_last4", values[i])
+			} else if value.Valid {
+				u.SocialLast4 = value.String
+			}
+		case user.FieldAddress:
+			if value, err := user.ValueScanner.Address.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				u.Address = value
 			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
@@ -128,6 +247,41 @@ func (u *User) QuerySharedLoan() *SharedLoanQuery {
 	return NewUserClient(u.config).QuerySharedLoan(u)
 }
 
+// QueryShareInvitations queries the "share_invitations" edge of the User entity.
+func (u *User) QueryShareInvitations() *ShareInvitationQuery {
+	return NewUserClient(u.config).QueryShareInvitations(u)
+}
+
+// QueryCreditLines queries the "credit_lines" edge of the User entity.
+func (u *User) QueryCreditLines() *CreditLineQuery {
+	return NewUserClient(u.config).QueryCreditLines(u)
+}
+
+// QueryObligations queries the "obligations" edge of the User entity.
+func (u *User) QueryObligations() *LoanObligorQuery {
+	return NewUserClient(u.config).QueryObligations(u)
+}
+
+// QueryCredential queries the "credential" edge of the User entity.
+func (u *User) QueryCredential() *UserCredentialQuery {
+	return NewUserClient(u.config).QueryCredential(u)
+}
+
+// QueryStaffRoles queries the "staff_roles" edge of the User entity.
+func (u *User) QueryStaffRoles() *StaffRoleQuery {
+	return NewUserClient(u.config).QueryStaffRoles(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -154,8 +308,17 @@ func (u *User) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(u.Name)
 	builder.WriteString(", ")
-	builder.WriteString("social=")
-	builder.WriteString(u.Social)
+	builder.WriteString("name_index=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("social=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("social_hash=")
+	builder.WriteString(u.SocialHash)
+	builder.WriteString(", ")
+	builder.WriteString("social_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("social_last4=")
+	builder.WriteString(u.SocialLast4)
 	builder.WriteString(", ")
 	builder.WriteString("address=")
 	builder.WriteString(u.Address)
@@ -3,8 +3,10 @@
 package user
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -14,14 +16,36 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldNameIndex holds the string denoting the name_index field in the database.
+	FieldNameIndex = "name_index"
 	// FieldSocial holds the string denoting the social field in the database.
 	FieldSocial = "social"
+	// FieldSocialHash holds the string denoting the social_hash field in the database.
+	FieldSocialHash = "social_hash"
+	// FieldSocialEncrypted holds the string denoting the social_encrypted field in the database.
+	FieldSocialEncrypted = "social_encrypted"
+	// FieldSocialLast4 holds the string denoting the social_last4 field in the database.
+	FieldSocialLast4 = "social_last4"
 	// FieldAddress holds the string denoting the address field in the database.
 	FieldAddress = "address"
 	// EdgeLoans holds the string denoting the loans edge name in mutations.
 	EdgeLoans = "loans"
 	// EdgeSharedLoan holds the string denoting the shared_loan edge name in mutations.
 	EdgeSharedLoan = "shared_loan"
+	// EdgeShareInvitations holds the string denoting the share_invitations edge name in mutations.
+	EdgeShareInvitations = "share_invitations"
+	// EdgeCreditLines holds the string denoting the credit_lines edge name in mutations.
+	EdgeCreditLines = "credit_lines"
+	// EdgeObligations holds the string denoting the obligations edge name in mutations.
+	EdgeObligations = "obligations"
+	// EdgeCredential holds the string denoting the credential edge name in mutations.
+	EdgeCredential = "credential"
+	// EdgeStaffRoles holds the string denoting the staff_roles edge name in mutations.
+	EdgeStaffRoles = "staff_roles"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// LoansTable is the table that holds the loans relation/edge.
@@ -38,13 +62,66 @@ const (
 	SharedLoanInverseTable = "shared_loans"
 	// SharedLoanColumn is the table column denoting the shared_loan relation/edge.
 	SharedLoanColumn = "user_id"
+	// ShareInvitationsTable is the table that holds the share_invitations relation/edge.
+	ShareInvitationsTable = "share_invitations"
+	// ShareInvitationsInverseTable is the table name for the ShareInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "shareinvitation" package.
+	ShareInvitationsInverseTable = "share_invitations"
+	// ShareInvitationsColumn is the table column denoting the share_invitations relation/edge.
+	ShareInvitationsColumn = "user_id"
+	// CreditLinesTable is the table that holds the credit_lines relation/edge.
+	CreditLinesTable = "credit_lines"
+	// CreditLinesInverseTable is the table name for the CreditLine entity.
+	// It exists in this package in order to avoid circular dependency with the "creditline" package.
+	CreditLinesInverseTable = "credit_lines"
+	// CreditLinesColumn is the table column denoting the credit_lines relation/edge.
+	CreditLinesColumn = "borrower_id"
+	// ObligationsTable is the table that holds the obligations relation/edge.
+	ObligationsTable = "loan_obligors"
+	// ObligationsInverseTable is the table name for the LoanObligor entity.
+	// It exists in this package in order to avoid circular dependency with the "loanobligor" package.
+	ObligationsInverseTable = "loan_obligors"
+	// ObligationsColumn is the table column denoting the obligations relation/edge.
+	ObligationsColumn = "user_id"
+	// CredentialTable is the table that holds the credential relation/edge.
+	CredentialTable = "user_credentials"
+	// CredentialInverseTable is the table name for the UserCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "usercredential" package.
+	CredentialInverseTable = "user_credentials"
+	// CredentialColumn is the table column denoting the credential relation/edge.
+	CredentialColumn = "user_id"
+	// StaffRolesTable is the table that holds the staff_roles relation/edge.
+	StaffRolesTable = "staff_roles"
+	// StaffRolesInverseTable is the table name for the StaffRole entity.
+	// It exists in this package in order to avoid circular dependency with the "staffrole" package.
+	StaffRolesInverseTable = "staff_roles"
+	// StaffRolesColumn is the table column denoting the staff_roles relation/edge.
+	StaffRolesColumn = "user_id"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "user_identities"
+	// IdentitiesInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentitiesInverseTable = "user_identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldNameIndex,
 	FieldSocial,
+	FieldSocialHash,
+	FieldSocialEncrypted,
+	FieldSocialLast4,
 	FieldAddress,
 }
 
@@ -58,6 +135,21 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/crusyn/loans/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// ValueScanner of all User fields.
+	ValueScanner struct {
+		Name    field.TypeValueScanner[string]
+		Address field.TypeValueScanner[string]
+	}
+)
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -71,11 +163,31 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByNameIndex orders the results by the name_index field.
+func ByNameIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNameIndex, opts...).ToFunc()
+}
+
 // BySocial orders the results by the social field.
 func BySocial(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSocial, opts...).ToFunc()
 }
 
+// BySocialHash orders the results by the social_hash field.
+func BySocialHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSocialHash, opts...).ToFunc()
+}
+
+// BySocialEncrypted orders the results by the social_encrypted field.
+func BySocialEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSocialEncrypted, opts...).ToFunc()
+}
+
+// BySocialLast4 orders the results by the social_last4 field.
+func BySocialLast4(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSocialLast4, opts...).ToFunc()
+}
+
 // ByAddress orders the results by the address field.
 func ByAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddress, opts...).ToFunc()
@@ -108,6 +220,97 @@ func BySharedLoan(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSharedLoanStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByShareInvitationsCount orders the results by share_invitations count.
+func ByShareInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareInvitationsStep(), opts...)
+	}
+}
+
+// ByShareInvitations orders the results by share_invitations terms.
+func ByShareInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCreditLinesCount orders the results by credit_lines count.
+func ByCreditLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreditLinesStep(), opts...)
+	}
+}
+
+// ByCreditLines orders the results by credit_lines terms.
+func ByCreditLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByObligationsCount orders the results by obligations count.
+func ByObligationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newObligationsStep(), opts...)
+	}
+}
+
+// ByObligations orders the results by obligations terms.
+func ByObligations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newObligationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCredentialField orders the results by credential field.
+func ByCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStaffRolesCount orders the results by staff_roles count.
+func ByStaffRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStaffRolesStep(), opts...)
+	}
+}
+
+// ByStaffRoles orders the results by staff_roles terms.
+func ByStaffRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStaffRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newLoansStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -122,3 +325,52 @@ func newSharedLoanStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SharedLoanTable, SharedLoanColumn),
 	)
 }
+func newShareInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareInvitationsTable, ShareInvitationsColumn),
+	)
+}
+func newCreditLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreditLinesTable, CreditLinesColumn),
+	)
+}
+func newObligationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ObligationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ObligationsTable, ObligationsColumn),
+	)
+}
+func newCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, CredentialTable, CredentialColumn),
+	)
+}
+func newStaffRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StaffRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StaffRolesTable, StaffRolesColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
@@ -3,6 +3,8 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/crusyn/loans/ent/predicate"
@@ -55,7 +57,13 @@ func IDLTE(id int) predicate.User {
 
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldEQ(FieldName, vc), err)
+}
+
+// NameIndex applies equality check predicate on the "name_index" field. It's identical to NameIndexEQ.
+func NameIndex(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldNameIndex, v))
 }
 
 // Social applies equality check predicate on the "social" field. It's identical to SocialEQ.
@@ -63,74 +71,214 @@ func Social(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldSocial, v))
 }
 
+// SocialHash applies equality check predicate on the "social_hash" field. It's identical to SocialHashEQ.
+func SocialHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialHash, v))
+}
+
+// SocialEncrypted applies equality check predicate on the "social_encrypted" field. It's identical to SocialEncryptedEQ.
+func SocialEncrypted(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialEncrypted, v))
+}
+
+// SocialLast4 applies equality check predicate on the "social_last4" field. It's identical to SocialLast4EQ.
+func SocialLast4(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialLast4, v))
+}
+
 // Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
 func Address(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldEQ(FieldAddress, vc), err)
 }
 
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldEQ(FieldName, vc), err)
 }
 
 // NameNEQ applies the NEQ predicate on the "name" field.
 func NameNEQ(v string) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldNEQ(FieldName, vc), err)
 }
 
 // NameIn applies the In predicate on the "name" field.
 func NameIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldIn(FieldName, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Name.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserOrErr(sql.FieldIn(FieldName, v...), err)
 }
 
 // NameNotIn applies the NotIn predicate on the "name" field.
 func NameNotIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldName, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Name.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserOrErr(sql.FieldNotIn(FieldName, v...), err)
 }
 
 // NameGT applies the GT predicate on the "name" field.
 func NameGT(v string) predicate.User {
-	return predicate.User(sql.FieldGT(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldGT(FieldName, vc), err)
 }
 
 // NameGTE applies the GTE predicate on the "name" field.
 func NameGTE(v string) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldGTE(FieldName, vc), err)
 }
 
 // NameLT applies the LT predicate on the "name" field.
 func NameLT(v string) predicate.User {
-	return predicate.User(sql.FieldLT(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldLT(FieldName, vc), err)
 }
 
 // NameLTE applies the LTE predicate on the "name" field.
 func NameLTE(v string) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	return predicate.UserOrErr(sql.FieldLTE(FieldName, vc), err)
 }
 
 // NameContains applies the Contains predicate on the "name" field.
 func NameContains(v string) predicate.User {
-	return predicate.User(sql.FieldContains(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("name value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldContains(FieldName, vcs), err)
 }
 
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.User {
-	return predicate.User(sql.FieldHasPrefix(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("name value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldHasPrefix(FieldName, vcs), err)
 }
 
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.User {
-	return predicate.User(sql.FieldHasSuffix(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("name value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldHasSuffix(FieldName, vcs), err)
 }
 
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.User {
-	return predicate.User(sql.FieldEqualFold(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("name value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldEqualFold(FieldName, vcs), err)
 }
 
 // NameContainsFold applies the ContainsFold predicate on the "name" field.
 func NameContainsFold(v string) predicate.User {
-	return predicate.User(sql.FieldContainsFold(FieldName, v))
+	vc, err := ValueScanner.Name.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("name value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldContainsFold(FieldName, vcs), err)
+}
+
+// NameIndexEQ applies the EQ predicate on the "name_index" field.
+func NameIndexEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldNameIndex, v))
+}
+
+// NameIndexNEQ applies the NEQ predicate on the "name_index" field.
+func NameIndexNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldNameIndex, v))
+}
+
+// NameIndexIn applies the In predicate on the "name_index" field.
+func NameIndexIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldNameIndex, vs...))
+}
+
+// NameIndexNotIn applies the NotIn predicate on the "name_index" field.
+func NameIndexNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldNameIndex, vs...))
+}
+
+// NameIndexGT applies the GT predicate on the "name_index" field.
+func NameIndexGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldNameIndex, v))
+}
+
+// NameIndexGTE applies the GTE predicate on the "name_index" field.
+func NameIndexGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldNameIndex, v))
+}
+
+// NameIndexLT applies the LT predicate on the "name_index" field.
+func NameIndexLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldNameIndex, v))
+}
+
+// NameIndexLTE applies the LTE predicate on the "name_index" field.
+func NameIndexLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldNameIndex, v))
+}
+
+// NameIndexContains applies the Contains predicate on the "name_index" field.
+func NameIndexContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldNameIndex, v))
+}
+
+// NameIndexHasPrefix applies the HasPrefix predicate on the "name_index" field.
+func NameIndexHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldNameIndex, v))
+}
+
+// NameIndexHasSuffix applies the HasSuffix predicate on the "name_index" field.
+func NameIndexHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldNameIndex, v))
+}
+
+// NameIndexIsNil applies the IsNil predicate on the "name_index" field.
+func NameIndexIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldNameIndex))
+}
+
+// NameIndexNotNil applies the NotNil predicate on the "name_index" field.
+func NameIndexNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldNameIndex))
+}
+
+// NameIndexEqualFold applies the EqualFold predicate on the "name_index" field.
+func NameIndexEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldNameIndex, v))
+}
+
+// NameIndexContainsFold applies the ContainsFold predicate on the "name_index" field.
+func NameIndexContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldNameIndex, v))
 }
 
 // SocialEQ applies the EQ predicate on the "social" field.
@@ -188,6 +336,16 @@ func SocialHasSuffix(v string) predicate.User {
 	return predicate.User(sql.FieldHasSuffix(FieldSocial, v))
 }
 
+// SocialIsNil applies the IsNil predicate on the "social" field.
+func SocialIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSocial))
+}
+
+// SocialNotNil applies the NotNil predicate on the "social" field.
+func SocialNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSocial))
+}
+
 // SocialEqualFold applies the EqualFold predicate on the "social" field.
 func SocialEqualFold(v string) predicate.User {
 	return predicate.User(sql.FieldEqualFold(FieldSocial, v))
@@ -198,59 +356,323 @@ func SocialContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldSocial, v))
 }
 
+// SocialHashEQ applies the EQ predicate on the "social_hash" field.
+func SocialHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialHash, v))
+}
+
+// SocialHashNEQ applies the NEQ predicate on the "social_hash" field.
+func SocialHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSocialHash, v))
+}
+
+// SocialHashIn applies the In predicate on the "social_hash" field.
+func SocialHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSocialHash, vs...))
+}
+
+// SocialHashNotIn applies the NotIn predicate on the "social_hash" field.
+func SocialHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSocialHash, vs...))
+}
+
+// SocialHashGT applies the GT predicate on the "social_hash" field.
+func SocialHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSocialHash, v))
+}
+
+// SocialHashGTE applies the GTE predicate on the "social_hash" field.
+func SocialHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSocialHash, v))
+}
+
+// SocialHashLT applies the LT predicate on the "social_hash" field.
+func SocialHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSocialHash, v))
+}
+
+// SocialHashLTE applies the LTE predicate on the "social_hash" field.
+func SocialHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSocialHash, v))
+}
+
+// SocialHashContains applies the Contains predicate on the "social_hash" field.
+func SocialHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSocialHash, v))
+}
+
+// SocialHashHasPrefix applies the HasPrefix predicate on the "social_hash" field.
+func SocialHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSocialHash, v))
+}
+
+// SocialHashHasSuffix applies the HasSuffix predicate on the "social_hash" field.
+func SocialHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSocialHash, v))
+}
+
+// SocialHashIsNil applies the IsNil predicate on the "social_hash" field.
+func SocialHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSocialHash))
+}
+
+// SocialHashNotNil applies the NotNil predicate on the "social_hash" field.
+func SocialHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSocialHash))
+}
+
+// SocialHashEqualFold applies the EqualFold predicate on the "social_hash" field.
+func SocialHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSocialHash, v))
+}
+
+// SocialHashContainsFold applies the ContainsFold predicate on the "social_hash" field.
+func SocialHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSocialHash, v))
+}
+
+// SocialEncryptedEQ applies the EQ predicate on the "social_encrypted" field.
+func SocialEncryptedEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedNEQ applies the NEQ predicate on the "social_encrypted" field.
+func SocialEncryptedNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedIn applies the In predicate on the "social_encrypted" field.
+func SocialEncryptedIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSocialEncrypted, vs...))
+}
+
+// SocialEncryptedNotIn applies the NotIn predicate on the "social_encrypted" field.
+func SocialEncryptedNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSocialEncrypted, vs...))
+}
+
+// SocialEncryptedGT applies the GT predicate on the "social_encrypted" field.
+func SocialEncryptedGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedGTE applies the GTE predicate on the "social_encrypted" field.
+func SocialEncryptedGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedLT applies the LT predicate on the "social_encrypted" field.
+func SocialEncryptedLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedLTE applies the LTE predicate on the "social_encrypted" field.
+func SocialEncryptedLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedContains applies the Contains predicate on the "social_encrypted" field.
+func SocialEncryptedContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedHasPrefix applies the HasPrefix predicate on the "social_encrypted" field.
+func SocialEncryptedHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedHasSuffix applies the HasSuffix predicate on the "social_encrypted" field.
+func SocialEncryptedHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedIsNil applies the IsNil predicate on the "social_encrypted" field.
+func SocialEncryptedIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSocialEncrypted))
+}
+
+// SocialEncryptedNotNil applies the NotNil predicate on the "social_encrypted" field.
+func SocialEncryptedNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSocialEncrypted))
+}
+
+// SocialEncryptedEqualFold applies the EqualFold predicate on the "social_encrypted" field.
+func SocialEncryptedEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSocialEncrypted, v))
+}
+
+// SocialEncryptedContainsFold applies the ContainsFold predicate on the "social_encrypted" field.
+func SocialEncryptedContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSocialEncrypted, v))
+}
+
+// SocialLast4EQ applies the EQ predicate on the "social_last4" field.
+func SocialLast4EQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSocialLast4, v))
+}
+
+// SocialLast4NEQ applies the NEQ predicate on the "social_last4" field.
+func SocialLast4NEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSocialLast4, v))
+}
+
+// SocialLast4In applies the In predicate on the "social_last4" field.
+func SocialLast4In(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSocialLast4, vs...))
+}
+
+// SocialLast4NotIn applies the NotIn predicate on the "social_last4" field.
+func SocialLast4NotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSocialLast4, vs...))
+}
+
+// SocialLast4GT applies the GT predicate on the "social_last4" field.
+func SocialLast4GT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSocialLast4, v))
+}
+
+// SocialLast4GTE applies the GTE predicate on the "social_last4" field.
+func SocialLast4GTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSocialLast4, v))
+}
+
+// SocialLast4LT applies the LT predicate on the "social_last4" field.
+func SocialLast4LT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSocialLast4, v))
+}
+
+// SocialLast4LTE applies the LTE predicate on the "social_last4" field.
+func SocialLast4LTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSocialLast4, v))
+}
+
+// SocialLast4Contains applies the Contains predicate on the "social_last4" field.
+func SocialLast4Contains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSocialLast4, v))
+}
+
+// SocialLast4HasPrefix applies the HasPrefix predicate on the "social_last4" field.
+func SocialLast4HasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSocialLast4, v))
+}
+
+// SocialLast4HasSuffix applies the HasSuffix predicate on the "social_last4" field.
+func SocialLast4HasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSocialLast4, v))
+}
+
+// SocialLast4IsNil applies the IsNil predicate on the "social_last4" field.
+func SocialLast4IsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSocialLast4))
+}
+
+// SocialLast4NotNil applies the NotNil predicate on the "social_last4" field.
+func SocialLast4NotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSocialLast4))
+}
+
+// SocialLast4EqualFold applies the EqualFold predicate on the "social_last4" field.
+func SocialLast4EqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSocialLast4, v))
+}
+
+// SocialLast4ContainsFold applies the ContainsFold predicate on the "social_last4" field.
+func SocialLast4ContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSocialLast4, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
-	return predicate.User(sql.FieldEQ(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldEQ(FieldAddress, vc), err)
 }
 
 // AddressNEQ applies the NEQ predicate on the "address" field.
 func AddressNEQ(v string) predicate.User {
-	return predicate.User(sql.FieldNEQ(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldNEQ(FieldAddress, vc), err)
 }
 
 // AddressIn applies the In predicate on the "address" field.
 func AddressIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldIn(FieldAddress, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Address.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserOrErr(sql.FieldIn(FieldAddress, v...), err)
 }
 
 // AddressNotIn applies the NotIn predicate on the "address" field.
 func AddressNotIn(vs ...string) predicate.User {
-	return predicate.User(sql.FieldNotIn(FieldAddress, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Address.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserOrErr(sql.FieldNotIn(FieldAddress, v...), err)
 }
 
 // AddressGT applies the GT predicate on the "address" field.
 func AddressGT(v string) predicate.User {
-	return predicate.User(sql.FieldGT(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldGT(FieldAddress, vc), err)
 }
 
 // AddressGTE applies the GTE predicate on the "address" field.
 func AddressGTE(v string) predicate.User {
-	return predicate.User(sql.FieldGTE(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldGTE(FieldAddress, vc), err)
 }
 
 // AddressLT applies the LT predicate on the "address" field.
 func AddressLT(v string) predicate.User {
-	return predicate.User(sql.FieldLT(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldLT(FieldAddress, vc), err)
 }
 
 // AddressLTE applies the LTE predicate on the "address" field.
 func AddressLTE(v string) predicate.User {
-	return predicate.User(sql.FieldLTE(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	return predicate.UserOrErr(sql.FieldLTE(FieldAddress, vc), err)
 }
 
 // AddressContains applies the Contains predicate on the "address" field.
 func AddressContains(v string) predicate.User {
-	return predicate.User(sql.FieldContains(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("address value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldContains(FieldAddress, vcs), err)
 }
 
 // AddressHasPrefix applies the HasPrefix predicate on the "address" field.
 func AddressHasPrefix(v string) predicate.User {
-	return predicate.User(sql.FieldHasPrefix(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("address value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldHasPrefix(FieldAddress, vcs), err)
 }
 
 // AddressHasSuffix applies the HasSuffix predicate on the "address" field.
 func AddressHasSuffix(v string) predicate.User {
-	return predicate.User(sql.FieldHasSuffix(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("address value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldHasSuffix(FieldAddress, vcs), err)
 }
 
 // AddressIsNil applies the IsNil predicate on the "address" field.
@@ -265,12 +687,22 @@ func AddressNotNil() predicate.User {
 
 // AddressEqualFold applies the EqualFold predicate on the "address" field.
 func AddressEqualFold(v string) predicate.User {
-	return predicate.User(sql.FieldEqualFold(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("address value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldEqualFold(FieldAddress, vcs), err)
 }
 
 // AddressContainsFold applies the ContainsFold predicate on the "address" field.
 func AddressContainsFold(v string) predicate.User {
-	return predicate.User(sql.FieldContainsFold(FieldAddress, v))
+	vc, err := ValueScanner.Address.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("address value is not a string: %T", vc)
+	}
+	return predicate.UserOrErr(sql.FieldContainsFold(FieldAddress, vcs), err)
 }
 
 // HasLoans applies the HasEdge predicate on the "loans" edge.
@@ -319,6 +751,167 @@ func HasSharedLoanWith(preds ...predicate.SharedLoan) predicate.User {
 	})
 }
 
+// HasShareInvitations applies the HasEdge predicate on the "share_invitations" edge.
+func HasShareInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShareInvitationsTable, ShareInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShareInvitationsWith applies the HasEdge predicate on the "share_invitations" edge with a given conditions (other predicates).
+func HasShareInvitationsWith(preds ...predicate.ShareInvitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newShareInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreditLines applies the HasEdge predicate on the "credit_lines" edge.
+func HasCreditLines() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditLinesTable, CreditLinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditLinesWith applies the HasEdge predicate on the "credit_lines" edge with a given conditions (other predicates).
+func HasCreditLinesWith(preds ...predicate.CreditLine) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCreditLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasObligations applies the HasEdge predicate on the "obligations" edge.
+func HasObligations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ObligationsTable, ObligationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasObligationsWith applies the HasEdge predicate on the "obligations" edge with a given conditions (other predicates).
+func HasObligationsWith(preds ...predicate.LoanObligor) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newObligationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCredential applies the HasEdge predicate on the "credential" edge.
+func HasCredential() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, CredentialTable, CredentialColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCredentialWith applies the HasEdge predicate on the "credential" edge with a given conditions (other predicates).
+func HasCredentialWith(preds ...predicate.UserCredential) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCredentialStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStaffRoles applies the HasEdge predicate on the "staff_roles" edge.
+func HasStaffRoles() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StaffRolesTable, StaffRolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStaffRolesWith applies the HasEdge predicate on the "staff_roles" edge with a given conditions (other predicates).
+func HasStaffRolesWith(preds ...predicate.StaffRole) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newStaffRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdentities applies the HasEdge predicate on the "identities" edge.
+func HasIdentities() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentitiesWith applies the HasEdge predicate on the "identities" edge with a given conditions (other predicates).
+func HasIdentitiesWith(preds ...predicate.UserIdentity) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
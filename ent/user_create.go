@@ -9,9 +9,16 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -27,12 +34,76 @@ func (uc *UserCreate) SetName(s string) *UserCreate {
 	return uc
 }
 
+// SetNameIndex sets the "name_index" field.
+func (uc *UserCreate) SetNameIndex(s string) *UserCreate {
+	uc.mutation.SetNameIndex(s)
+	return uc
+}
+
+// SetNillableNameIndex sets the "name_index" field if the given value is not nil.
+func (uc *UserCreate) SetNillableNameIndex(s *string) *UserCreate {
+	if s != nil {
+		uc.SetNameIndex(*s)
+	}
+	return uc
+}
+
 // SetSocial sets the "social" field.
 func (uc *UserCreate) SetSocial(s string) *UserCreate {
 	uc.mutation.SetSocial(s)
 	return uc
 }
 
+// SetNillableSocial sets the "social" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSocial(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSocial(*s)
+	}
+	return uc
+}
+
+// SetSocialHash sets the "social_hash" field.
+func (uc *UserCreate) SetSocialHash(s string) *UserCreate {
+	uc.mutation.SetSocialHash(s)
+	return uc
+}
+
+// SetNillableSocialHash sets the "social_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSocialHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSocialHash(*s)
+	}
+	return uc
+}
+
+// SetSocialEncrypted sets the "social_encrypted" field.
+func (uc *UserCreate) SetSocialEncrypted(s string) *UserCreate {
+	uc.mutation.SetSocialEncrypted(s)
+	return uc
+}
+
+// SetNillableSocialEncrypted sets the "social_encrypted" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSocialEncrypted(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSocialEncrypted(*s)
+	}
+	return uc
+}
+
+// SetSocialLast4 sets the "social_last4" field.
+func (uc *UserCreate) SetSocialLast4(s string) *UserCreate {
+	uc.mutation.SetSocialLast4(s)
+	return uc
+}
+
+// SetNillableSocialLast4 sets the "social_last4" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSocialLast4(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSocialLast4(*s)
+	}
+	return uc
+}
+
 // SetAddress sets the "address" field.
 func (uc *UserCreate) SetAddress(s string) *UserCreate {
 	uc.mutation.SetAddress(s)
@@ -77,6 +148,115 @@ func (uc *UserCreate) AddSharedLoan(s ...*SharedLoan) *UserCreate {
 	return uc.AddSharedLoanIDs(ids...)
 }
 
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (uc *UserCreate) AddShareInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddShareInvitationIDs(ids...)
+	return uc
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (uc *UserCreate) AddShareInvitations(s ...*ShareInvitation) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddShareInvitationIDs(ids...)
+}
+
+// AddCreditLineIDs adds the "credit_lines" edge to the CreditLine entity by IDs.
+func (uc *UserCreate) AddCreditLineIDs(ids ...int) *UserCreate {
+	uc.mutation.AddCreditLineIDs(ids...)
+	return uc
+}
+
+// AddCreditLines adds the "credit_lines" edges to the CreditLine entity.
+func (uc *UserCreate) AddCreditLines(c ...*CreditLine) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCreditLineIDs(ids...)
+}
+
+// AddObligationIDs adds the "obligations" edge to the LoanObligor entity by IDs.
+func (uc *UserCreate) AddObligationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddObligationIDs(ids...)
+	return uc
+}
+
+// AddObligations adds the "obligations" edges to the LoanObligor entity.
+func (uc *UserCreate) AddObligations(l ...*LoanObligor) *UserCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddObligationIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the UserCredential entity by ID.
+func (uc *UserCreate) SetCredentialID(id int) *UserCreate {
+	uc.mutation.SetCredentialID(id)
+	return uc
+}
+
+// SetNillableCredentialID sets the "credential" edge to the UserCredential entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableCredentialID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetCredentialID(*id)
+	}
+	return uc
+}
+
+// SetCredential sets the "credential" edge to the UserCredential entity.
+func (uc *UserCreate) SetCredential(u *UserCredential) *UserCreate {
+	return uc.SetCredentialID(u.ID)
+}
+
+// AddStaffRoleIDs adds the "staff_roles" edge to the StaffRole entity by IDs.
+func (uc *UserCreate) AddStaffRoleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddStaffRoleIDs(ids...)
+	return uc
+}
+
+// AddStaffRoles adds the "staff_roles" edges to the StaffRole entity.
+func (uc *UserCreate) AddStaffRoles(s ...*StaffRole) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddStaffRoleIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (uc *UserCreate) AddIdentities(u ...*UserIdentity) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -114,9 +294,6 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
 	}
-	if _, ok := uc.mutation.Social(); !ok {
-		return &ValidationError{Name: "social", err: errors.New(`ent: missing required field "User.social"`)}
-	}
 	return nil
 }
 
@@ -124,7 +301,10 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	if err := uc.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := uc.createSpec()
+	_node, _spec, err := uc.createSpec()
+	if err != nil {
+		return nil, err
+	}
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
@@ -138,21 +318,45 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 	return _node, nil
 }
 
-func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
+func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec, error) {
 	var (
 		_node = &User{config: uc.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
 	if value, ok := uc.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+		vv, err := user.ValueScanner.Name.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(user.FieldName, field.TypeString, vv)
 		_node.Name = value
 	}
+	if value, ok := uc.mutation.NameIndex(); ok {
+		_spec.SetField(user.FieldNameIndex, field.TypeString, value)
+		_node.NameIndex = value
+	}
 	if value, ok := uc.mutation.Social(); ok {
 		_spec.SetField(user.FieldSocial, field.TypeString, value)
-		_node.Social = value
+		_node.Social = &value
+	}
+	if value, ok := uc.mutation.SocialHash(); ok {
+		_spec.SetField(user.FieldSocialHash, field.TypeString, value)
+		_node.SocialHash = value
+	}
+	if value, ok := uc.mutation.SocialEncrypted(); ok {
+		_spec.SetField(user.FieldSocialEncrypted, field.TypeString, value)
+		_node.SocialEncrypted = value
+	}
+	if value, ok := uc.mutation.SocialLast4(); ok {
+		_spec.SetField(user.FieldSocialLast4, field.TypeString, value)
+		_node.SocialLast4 = value
 	}
 	if value, ok := uc.mutation.Address(); ok {
-		_spec.SetField(user.FieldAddress, field.TypeString, value)
+		vv, err := user.ValueScanner.Address.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(user.FieldAddress, field.TypeString, vv)
 		_node.Address = value
 	}
 	if nodes := uc.mutation.LoansIDs(); len(nodes) > 0 {
@@ -187,7 +391,119 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
+	if nodes := uc.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CreditLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ObligationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.StaffRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec, nil
 }
 
 // UserCreateBulk is the builder for creating many User entities in bulk.
@@ -218,7 +534,10 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 				}
 				builder.mutation = mutation
 				var err error
-				nodes[i], specs[i] = builder.createSpec()
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
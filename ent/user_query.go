@@ -5,27 +5,42 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
 	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx            *QueryContext
-	order          []user.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.User
-	withLoans      *LoanQuery
-	withSharedLoan *SharedLoanQuery
+	ctx                  *QueryContext
+	order                []user.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.User
+	withLoans            *LoanQuery
+	withSharedLoan       *SharedLoanQuery
+	withShareInvitations *ShareInvitationQuery
+	withCreditLines      *CreditLineQuery
+	withObligations      *LoanObligorQuery
+	withCredential       *UserCredentialQuery
+	withStaffRoles       *StaffRoleQuery
+	withIdentities       *UserIdentityQuery
+	withSessions         *SessionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +121,160 @@ func (uq *UserQuery) QuerySharedLoan() *SharedLoanQuery {
 	return query
 }
 
+// QueryShareInvitations chains the current query on the "share_invitations" edge.
+func (uq *UserQuery) QueryShareInvitations() *ShareInvitationQuery {
+	query := (&ShareInvitationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(shareinvitation.Table, shareinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShareInvitationsTable, user.ShareInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreditLines chains the current query on the "credit_lines" edge.
+func (uq *UserQuery) QueryCreditLines() *CreditLineQuery {
+	query := (&CreditLineClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(creditline.Table, creditline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreditLinesTable, user.CreditLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryObligations chains the current query on the "obligations" edge.
+func (uq *UserQuery) QueryObligations() *LoanObligorQuery {
+	query := (&LoanObligorClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(loanobligor.Table, loanobligor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ObligationsTable, user.ObligationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCredential chains the current query on the "credential" edge.
+func (uq *UserQuery) QueryCredential() *UserCredentialQuery {
+	query := (&UserCredentialClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usercredential.Table, usercredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.CredentialTable, user.CredentialColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStaffRoles chains the current query on the "staff_roles" edge.
+func (uq *UserQuery) QueryStaffRoles() *StaffRoleQuery {
+	query := (&StaffRoleClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(staffrole.Table, staffrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StaffRolesTable, user.StaffRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentities chains the current query on the "identities" edge.
+func (uq *UserQuery) QueryIdentities() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (uq *UserQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -293,13 +462,20 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:         uq.config,
-		ctx:            uq.ctx.Clone(),
-		order:          append([]user.OrderOption{}, uq.order...),
-		inters:         append([]Interceptor{}, uq.inters...),
-		predicates:     append([]predicate.User{}, uq.predicates...),
-		withLoans:      uq.withLoans.Clone(),
-		withSharedLoan: uq.withSharedLoan.Clone(),
+		config:               uq.config,
+		ctx:                  uq.ctx.Clone(),
+		order:                append([]user.OrderOption{}, uq.order...),
+		inters:               append([]Interceptor{}, uq.inters...),
+		predicates:           append([]predicate.User{}, uq.predicates...),
+		withLoans:            uq.withLoans.Clone(),
+		withSharedLoan:       uq.withSharedLoan.Clone(),
+		withShareInvitations: uq.withShareInvitations.Clone(),
+		withCreditLines:      uq.withCreditLines.Clone(),
+		withObligations:      uq.withObligations.Clone(),
+		withCredential:       uq.withCredential.Clone(),
+		withStaffRoles:       uq.withStaffRoles.Clone(),
+		withIdentities:       uq.withIdentities.Clone(),
+		withSessions:         uq.withSessions.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -328,6 +504,83 @@ func (uq *UserQuery) WithSharedLoan(opts ...func(*SharedLoanQuery)) *UserQuery {
 	return uq
 }
 
+// WithShareInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "share_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithShareInvitations(opts ...func(*ShareInvitationQuery)) *UserQuery {
+	query := (&ShareInvitationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withShareInvitations = query
+	return uq
+}
+
+// WithCreditLines tells the query-builder to eager-load the nodes that are connected to
+// the "credit_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCreditLines(opts ...func(*CreditLineQuery)) *UserQuery {
+	query := (&CreditLineClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCreditLines = query
+	return uq
+}
+
+// WithObligations tells the query-builder to eager-load the nodes that are connected to
+// the "obligations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithObligations(opts ...func(*LoanObligorQuery)) *UserQuery {
+	query := (&LoanObligorClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withObligations = query
+	return uq
+}
+
+// WithCredential tells the query-builder to eager-load the nodes that are connected to
+// the "credential" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCredential(opts ...func(*UserCredentialQuery)) *UserQuery {
+	query := (&UserCredentialClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCredential = query
+	return uq
+}
+
+// WithStaffRoles tells the query-builder to eager-load the nodes that are connected to
+// the "staff_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithStaffRoles(opts ...func(*StaffRoleQuery)) *UserQuery {
+	query := (&StaffRoleClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withStaffRoles = query
+	return uq
+}
+
+// WithIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithIdentities(opts ...func(*UserIdentityQuery)) *UserQuery {
+	query := (&UserIdentityClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withIdentities = query
+	return uq
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSessions(opts ...func(*SessionQuery)) *UserQuery {
+	query := (&SessionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSessions = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -399,6 +652,12 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 		}
 		uq.sql = prev
 	}
+	if user.Policy == nil {
+		return errors.New("ent: uninitialized user.Policy (forgotten import ent/runtime?)")
+	}
+	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -406,9 +665,16 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			uq.withLoans != nil,
 			uq.withSharedLoan != nil,
+			uq.withShareInvitations != nil,
+			uq.withCreditLines != nil,
+			uq.withObligations != nil,
+			uq.withCredential != nil,
+			uq.withStaffRoles != nil,
+			uq.withIdentities != nil,
+			uq.withSessions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +709,54 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withShareInvitations; query != nil {
+		if err := uq.loadShareInvitations(ctx, query, nodes,
+			func(n *User) { n.Edges.ShareInvitations = []*ShareInvitation{} },
+			func(n *User, e *ShareInvitation) { n.Edges.ShareInvitations = append(n.Edges.ShareInvitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCreditLines; query != nil {
+		if err := uq.loadCreditLines(ctx, query, nodes,
+			func(n *User) { n.Edges.CreditLines = []*CreditLine{} },
+			func(n *User, e *CreditLine) { n.Edges.CreditLines = append(n.Edges.CreditLines, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withObligations; query != nil {
+		if err := uq.loadObligations(ctx, query, nodes,
+			func(n *User) { n.Edges.Obligations = []*LoanObligor{} },
+			func(n *User, e *LoanObligor) { n.Edges.Obligations = append(n.Edges.Obligations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCredential; query != nil {
+		if err := uq.loadCredential(ctx, query, nodes, nil,
+			func(n *User, e *UserCredential) { n.Edges.Credential = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withStaffRoles; query != nil {
+		if err := uq.loadStaffRoles(ctx, query, nodes,
+			func(n *User) { n.Edges.StaffRoles = []*StaffRole{} },
+			func(n *User, e *StaffRole) { n.Edges.StaffRoles = append(n.Edges.StaffRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withIdentities; query != nil {
+		if err := uq.loadIdentities(ctx, query, nodes,
+			func(n *User) { n.Edges.Identities = []*UserIdentity{} },
+			func(n *User, e *UserIdentity) { n.Edges.Identities = append(n.Edges.Identities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSessions; query != nil {
+		if err := uq.loadSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.Sessions = []*Session{} },
+			func(n *User, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -506,6 +820,213 @@ func (uq *UserQuery) loadSharedLoan(ctx context.Context, query *SharedLoanQuery,
 	}
 	return nil
 }
+func (uq *UserQuery) loadShareInvitations(ctx context.Context, query *ShareInvitationQuery, nodes []*User, init func(*User), assign func(*User, *ShareInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shareinvitation.FieldUserID)
+	}
+	query.Where(predicate.ShareInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ShareInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadCreditLines(ctx context.Context, query *CreditLineQuery, nodes []*User, init func(*User), assign func(*User, *CreditLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(creditline.FieldBorrowerID)
+	}
+	query.Where(predicate.CreditLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CreditLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BorrowerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "borrower_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadObligations(ctx context.Context, query *LoanObligorQuery, nodes []*User, init func(*User), assign func(*User, *LoanObligor)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(loanobligor.FieldUserID)
+	}
+	query.Where(predicate.LoanObligor(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ObligationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadCredential(ctx context.Context, query *UserCredentialQuery, nodes []*User, init func(*User), assign func(*User, *UserCredential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usercredential.FieldUserID)
+	}
+	query.Where(predicate.UserCredential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CredentialColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadStaffRoles(ctx context.Context, query *StaffRoleQuery, nodes []*User, init func(*User), assign func(*User, *StaffRole)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(staffrole.FieldUserID)
+	}
+	query.Where(predicate.StaffRole(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.StaffRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadIdentities(ctx context.Context, query *UserIdentityQuery, nodes []*User, init func(*User), assign func(*User, *UserIdentity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useridentity.FieldUserID)
+	}
+	query.Where(predicate.UserIdentity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldUserID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
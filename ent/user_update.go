@@ -10,10 +10,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/creditline"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
 	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/session"
 	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -43,6 +50,26 @@ func (uu *UserUpdate) SetNillableName(s *string) *UserUpdate {
 	return uu
 }
 
+// SetNameIndex sets the "name_index" field.
+func (uu *UserUpdate) SetNameIndex(s string) *UserUpdate {
+	uu.mutation.SetNameIndex(s)
+	return uu
+}
+
+// SetNillableNameIndex sets the "name_index" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableNameIndex(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetNameIndex(*s)
+	}
+	return uu
+}
+
+// ClearNameIndex clears the value of the "name_index" field.
+func (uu *UserUpdate) ClearNameIndex() *UserUpdate {
+	uu.mutation.ClearNameIndex()
+	return uu
+}
+
 // SetSocial sets the "social" field.
 func (uu *UserUpdate) SetSocial(s string) *UserUpdate {
 	uu.mutation.SetSocial(s)
@@ -57,6 +84,72 @@ func (uu *UserUpdate) SetNillableSocial(s *string) *UserUpdate {
 	return uu
 }
 
+// ClearSocial clears the value of the "social" field.
+func (uu *UserUpdate) ClearSocial() *UserUpdate {
+	uu.mutation.ClearSocial()
+	return uu
+}
+
+// SetSocialHash sets the "social_hash" field.
+func (uu *UserUpdate) SetSocialHash(s string) *UserUpdate {
+	uu.mutation.SetSocialHash(s)
+	return uu
+}
+
+// SetNillableSocialHash sets the "social_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSocialHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSocialHash(*s)
+	}
+	return uu
+}
+
+// ClearSocialHash clears the value of the "social_hash" field.
+func (uu *UserUpdate) ClearSocialHash() *UserUpdate {
+	uu.mutation.ClearSocialHash()
+	return uu
+}
+
+// SetSocialEncrypted sets the "social_encrypted" field.
+func (uu *UserUpdate) SetSocialEncrypted(s string) *UserUpdate {
+	uu.mutation.SetSocialEncrypted(s)
+	return uu
+}
+
+// SetNillableSocialEncrypted sets the "social_encrypted" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSocialEncrypted(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSocialEncrypted(*s)
+	}
+	return uu
+}
+
+// ClearSocialEncrypted clears the value of the "social_encrypted" field.
+func (uu *UserUpdate) ClearSocialEncrypted() *UserUpdate {
+	uu.mutation.ClearSocialEncrypted()
+	return uu
+}
+
+// SetSocialLast4 sets the "social_last4" field.
+func (uu *UserUpdate) SetSocialLast4(s string) *UserUpdate {
+	uu.mutation.SetSocialLast4(s)
+	return uu
+}
+
+// SetNillableSocialLast4 sets the "social_last4" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSocialLast4(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSocialLast4(*s)
+	}
+	return uu
+}
+
+// ClearSocialLast4 clears the value of the "social_last4" field.
+func (uu *UserUpdate) ClearSocialLast4() *UserUpdate {
+	uu.mutation.ClearSocialLast4()
+	return uu
+}
+
 // SetAddress sets the "address" field.
 func (uu *UserUpdate) SetAddress(s string) *UserUpdate {
 	uu.mutation.SetAddress(s)
@@ -107,6 +200,115 @@ func (uu *UserUpdate) AddSharedLoan(s ...*SharedLoan) *UserUpdate {
 	return uu.AddSharedLoanIDs(ids...)
 }
 
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (uu *UserUpdate) AddShareInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddShareInvitationIDs(ids...)
+	return uu
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (uu *UserUpdate) AddShareInvitations(s ...*ShareInvitation) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddShareInvitationIDs(ids...)
+}
+
+// AddCreditLineIDs adds the "credit_lines" edge to the CreditLine entity by IDs.
+func (uu *UserUpdate) AddCreditLineIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddCreditLineIDs(ids...)
+	return uu
+}
+
+// AddCreditLines adds the "credit_lines" edges to the CreditLine entity.
+func (uu *UserUpdate) AddCreditLines(c ...*CreditLine) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCreditLineIDs(ids...)
+}
+
+// AddObligationIDs adds the "obligations" edge to the LoanObligor entity by IDs.
+func (uu *UserUpdate) AddObligationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddObligationIDs(ids...)
+	return uu
+}
+
+// AddObligations adds the "obligations" edges to the LoanObligor entity.
+func (uu *UserUpdate) AddObligations(l ...*LoanObligor) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddObligationIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the UserCredential entity by ID.
+func (uu *UserUpdate) SetCredentialID(id int) *UserUpdate {
+	uu.mutation.SetCredentialID(id)
+	return uu
+}
+
+// SetNillableCredentialID sets the "credential" edge to the UserCredential entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableCredentialID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetCredentialID(*id)
+	}
+	return uu
+}
+
+// SetCredential sets the "credential" edge to the UserCredential entity.
+func (uu *UserUpdate) SetCredential(u *UserCredential) *UserUpdate {
+	return uu.SetCredentialID(u.ID)
+}
+
+// AddStaffRoleIDs adds the "staff_roles" edge to the StaffRole entity by IDs.
+func (uu *UserUpdate) AddStaffRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddStaffRoleIDs(ids...)
+	return uu
+}
+
+// AddStaffRoles adds the "staff_roles" edges to the StaffRole entity.
+func (uu *UserUpdate) AddStaffRoles(s ...*StaffRole) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddStaffRoleIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (uu *UserUpdate) AddIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddIdentityIDs(ids...)
+	return uu
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (uu *UserUpdate) AddIdentities(u ...*UserIdentity) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddIdentityIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uu *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSessionIDs(ids...)
+	return uu
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uu *UserUpdate) AddSessions(s ...*Session) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSessionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -154,6 +356,138 @@ func (uu *UserUpdate) RemoveSharedLoan(s ...*SharedLoan) *UserUpdate {
 	return uu.RemoveSharedLoanIDs(ids...)
 }
 
+// ClearShareInvitations clears all "share_invitations" edges to the ShareInvitation entity.
+func (uu *UserUpdate) ClearShareInvitations() *UserUpdate {
+	uu.mutation.ClearShareInvitations()
+	return uu
+}
+
+// RemoveShareInvitationIDs removes the "share_invitations" edge to ShareInvitation entities by IDs.
+func (uu *UserUpdate) RemoveShareInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveShareInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveShareInvitations removes "share_invitations" edges to ShareInvitation entities.
+func (uu *UserUpdate) RemoveShareInvitations(s ...*ShareInvitation) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveShareInvitationIDs(ids...)
+}
+
+// ClearCreditLines clears all "credit_lines" edges to the CreditLine entity.
+func (uu *UserUpdate) ClearCreditLines() *UserUpdate {
+	uu.mutation.ClearCreditLines()
+	return uu
+}
+
+// RemoveCreditLineIDs removes the "credit_lines" edge to CreditLine entities by IDs.
+func (uu *UserUpdate) RemoveCreditLineIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveCreditLineIDs(ids...)
+	return uu
+}
+
+// RemoveCreditLines removes "credit_lines" edges to CreditLine entities.
+func (uu *UserUpdate) RemoveCreditLines(c ...*CreditLine) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCreditLineIDs(ids...)
+}
+
+// ClearObligations clears all "obligations" edges to the LoanObligor entity.
+func (uu *UserUpdate) ClearObligations() *UserUpdate {
+	uu.mutation.ClearObligations()
+	return uu
+}
+
+// RemoveObligationIDs removes the "obligations" edge to LoanObligor entities by IDs.
+func (uu *UserUpdate) RemoveObligationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveObligationIDs(ids...)
+	return uu
+}
+
+// RemoveObligations removes "obligations" edges to LoanObligor entities.
+func (uu *UserUpdate) RemoveObligations(l ...*LoanObligor) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.RemoveObligationIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the UserCredential entity.
+func (uu *UserUpdate) ClearCredential() *UserUpdate {
+	uu.mutation.ClearCredential()
+	return uu
+}
+
+// ClearStaffRoles clears all "staff_roles" edges to the StaffRole entity.
+func (uu *UserUpdate) ClearStaffRoles() *UserUpdate {
+	uu.mutation.ClearStaffRoles()
+	return uu
+}
+
+// RemoveStaffRoleIDs removes the "staff_roles" edge to StaffRole entities by IDs.
+func (uu *UserUpdate) RemoveStaffRoleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveStaffRoleIDs(ids...)
+	return uu
+}
+
+// RemoveStaffRoles removes "staff_roles" edges to StaffRole entities.
+func (uu *UserUpdate) RemoveStaffRoles(s ...*StaffRole) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveStaffRoleIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the UserIdentity entity.
+func (uu *UserUpdate) ClearIdentities() *UserUpdate {
+	uu.mutation.ClearIdentities()
+	return uu
+}
+
+// RemoveIdentityIDs removes the "identities" edge to UserIdentity entities by IDs.
+func (uu *UserUpdate) RemoveIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveIdentities removes "identities" edges to UserIdentity entities.
+func (uu *UserUpdate) RemoveIdentities(u ...*UserIdentity) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveIdentityIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uu *UserUpdate) ClearSessions() *UserUpdate {
+	uu.mutation.ClearSessions()
+	return uu
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uu *UserUpdate) RemoveSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSessionIDs(ids...)
+	return uu
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uu *UserUpdate) RemoveSessions(s ...*Session) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -191,13 +525,48 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 	}
 	if value, ok := uu.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+		vv, err := user.ValueScanner.Name.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(user.FieldName, field.TypeString, vv)
+	}
+	if value, ok := uu.mutation.NameIndex(); ok {
+		_spec.SetField(user.FieldNameIndex, field.TypeString, value)
+	}
+	if uu.mutation.NameIndexCleared() {
+		_spec.ClearField(user.FieldNameIndex, field.TypeString)
 	}
 	if value, ok := uu.mutation.Social(); ok {
 		_spec.SetField(user.FieldSocial, field.TypeString, value)
 	}
+	if uu.mutation.SocialCleared() {
+		_spec.ClearField(user.FieldSocial, field.TypeString)
+	}
+	if value, ok := uu.mutation.SocialHash(); ok {
+		_spec.SetField(user.FieldSocialHash, field.TypeString, value)
+	}
+	if uu.mutation.SocialHashCleared() {
+		_spec.ClearField(user.FieldSocialHash, field.TypeString)
+	}
+	if value, ok := uu.mutation.SocialEncrypted(); ok {
+		_spec.SetField(user.FieldSocialEncrypted, field.TypeString, value)
+	}
+	if uu.mutation.SocialEncryptedCleared() {
+		_spec.ClearField(user.FieldSocialEncrypted, field.TypeString)
+	}
+	if value, ok := uu.mutation.SocialLast4(); ok {
+		_spec.SetField(user.FieldSocialLast4, field.TypeString, value)
+	}
+	if uu.mutation.SocialLast4Cleared() {
+		_spec.ClearField(user.FieldSocialLast4, field.TypeString)
+	}
 	if value, ok := uu.mutation.Address(); ok {
-		_spec.SetField(user.FieldAddress, field.TypeString, value)
+		vv, err := user.ValueScanner.Address.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(user.FieldAddress, field.TypeString, vv)
 	}
 	if uu.mutation.AddressCleared() {
 		_spec.ClearField(user.FieldAddress, field.TypeString)
@@ -292,53 +661,438 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.RemovedShareInvitationsIDs(); len(nodes) > 0 && !uu.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetSocial sets the "social" field.
-func (uuo *UserUpdateOne) SetSocial(s string) *UserUpdateOne {
-	uuo.mutation.SetSocial(s)
-	return uuo
-}
-
-// SetNillableSocial sets the "social" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSocial(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetSocial(*s)
+	if nodes := uu.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
+	if uu.mutation.CreditLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCreditLinesIDs(); len(nodes) > 0 && !uu.mutation.CreditLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CreditLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ObligationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedObligationsIDs(); len(nodes) > 0 && !uu.mutation.ObligationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ObligationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.StaffRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedStaffRolesIDs(); len(nodes) > 0 && !uu.mutation.StaffRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.StaffRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetNameIndex sets the "name_index" field.
+func (uuo *UserUpdateOne) SetNameIndex(s string) *UserUpdateOne {
+	uuo.mutation.SetNameIndex(s)
+	return uuo
+}
+
+// SetNillableNameIndex sets the "name_index" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNameIndex(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetNameIndex(*s)
+	}
+	return uuo
+}
+
+// ClearNameIndex clears the value of the "name_index" field.
+func (uuo *UserUpdateOne) ClearNameIndex() *UserUpdateOne {
+	uuo.mutation.ClearNameIndex()
+	return uuo
+}
+
+// SetSocial sets the "social" field.
+func (uuo *UserUpdateOne) SetSocial(s string) *UserUpdateOne {
+	uuo.mutation.SetSocial(s)
+	return uuo
+}
+
+// SetNillableSocial sets the "social" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSocial(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSocial(*s)
+	}
+	return uuo
+}
+
+// ClearSocial clears the value of the "social" field.
+func (uuo *UserUpdateOne) ClearSocial() *UserUpdateOne {
+	uuo.mutation.ClearSocial()
+	return uuo
+}
+
+// SetSocialHash sets the "social_hash" field.
+func (uuo *UserUpdateOne) SetSocialHash(s string) *UserUpdateOne {
+	uuo.mutation.SetSocialHash(s)
+	return uuo
+}
+
+// SetNillableSocialHash sets the "social_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSocialHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSocialHash(*s)
+	}
+	return uuo
+}
+
+// ClearSocialHash clears the value of the "social_hash" field.
+func (uuo *UserUpdateOne) ClearSocialHash() *UserUpdateOne {
+	uuo.mutation.ClearSocialHash()
+	return uuo
+}
+
+// SetSocialEncrypted sets the "social_encrypted" field.
+func (uuo *UserUpdateOne) SetSocialEncrypted(s string) *UserUpdateOne {
+	uuo.mutation.SetSocialEncrypted(s)
+	return uuo
+}
+
+// SetNillableSocialEncrypted sets the "social_encrypted" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSocialEncrypted(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSocialEncrypted(*s)
+	}
+	return uuo
+}
+
+// ClearSocialEncrypted clears the value of the "social_encrypted" field.
+func (uuo *UserUpdateOne) ClearSocialEncrypted() *UserUpdateOne {
+	uuo.mutation.ClearSocialEncrypted()
+	return uuo
+}
+
+// SetSocialLast4 sets the "social_last4" field.
+func (uuo *UserUpdateOne) SetSocialLast4(s string) *UserUpdateOne {
+	uuo.mutation.SetSocialLast4(s)
+	return uuo
+}
+
+// SetNillableSocialLast4 sets the "social_last4" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSocialLast4(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSocialLast4(*s)
+	}
+	return uuo
+}
+
+// ClearSocialLast4 clears the value of the "social_last4" field.
+func (uuo *UserUpdateOne) ClearSocialLast4() *UserUpdateOne {
+	uuo.mutation.ClearSocialLast4()
+	return uuo
+}
 
 // SetAddress sets the "address" field.
 func (uuo *UserUpdateOne) SetAddress(s string) *UserUpdateOne {
@@ -387,54 +1141,295 @@ func (uuo *UserUpdateOne) AddSharedLoan(s ...*SharedLoan) *UserUpdateOne {
 	for i := range s {
 		ids[i] = s[i].ID
 	}
-	return uuo.AddSharedLoanIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
+	return uuo.AddSharedLoanIDs(ids...)
+}
+
+// AddShareInvitationIDs adds the "share_invitations" edge to the ShareInvitation entity by IDs.
+func (uuo *UserUpdateOne) AddShareInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddShareInvitationIDs(ids...)
+	return uuo
+}
+
+// AddShareInvitations adds the "share_invitations" edges to the ShareInvitation entity.
+func (uuo *UserUpdateOne) AddShareInvitations(s ...*ShareInvitation) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddShareInvitationIDs(ids...)
+}
+
+// AddCreditLineIDs adds the "credit_lines" edge to the CreditLine entity by IDs.
+func (uuo *UserUpdateOne) AddCreditLineIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddCreditLineIDs(ids...)
+	return uuo
+}
+
+// AddCreditLines adds the "credit_lines" edges to the CreditLine entity.
+func (uuo *UserUpdateOne) AddCreditLines(c ...*CreditLine) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCreditLineIDs(ids...)
+}
+
+// AddObligationIDs adds the "obligations" edge to the LoanObligor entity by IDs.
+func (uuo *UserUpdateOne) AddObligationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddObligationIDs(ids...)
+	return uuo
+}
+
+// AddObligations adds the "obligations" edges to the LoanObligor entity.
+func (uuo *UserUpdateOne) AddObligations(l ...*LoanObligor) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddObligationIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the UserCredential entity by ID.
+func (uuo *UserUpdateOne) SetCredentialID(id int) *UserUpdateOne {
+	uuo.mutation.SetCredentialID(id)
+	return uuo
+}
+
+// SetNillableCredentialID sets the "credential" edge to the UserCredential entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCredentialID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetCredentialID(*id)
+	}
+	return uuo
+}
+
+// SetCredential sets the "credential" edge to the UserCredential entity.
+func (uuo *UserUpdateOne) SetCredential(u *UserCredential) *UserUpdateOne {
+	return uuo.SetCredentialID(u.ID)
+}
+
+// AddStaffRoleIDs adds the "staff_roles" edge to the StaffRole entity by IDs.
+func (uuo *UserUpdateOne) AddStaffRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddStaffRoleIDs(ids...)
+	return uuo
+}
+
+// AddStaffRoles adds the "staff_roles" edges to the StaffRole entity.
+func (uuo *UserUpdateOne) AddStaffRoles(s ...*StaffRole) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddStaffRoleIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (uuo *UserUpdateOne) AddIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddIdentityIDs(ids...)
+	return uuo
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (uuo *UserUpdateOne) AddIdentities(u ...*UserIdentity) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddIdentityIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uuo *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSessionIDs(ids...)
+	return uuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSessionIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (uuo *UserUpdateOne) ClearLoans() *UserUpdateOne {
+	uuo.mutation.ClearLoans()
+	return uuo
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (uuo *UserUpdateOne) RemoveLoanIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveLoanIDs(ids...)
+	return uuo
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (uuo *UserUpdateOne) RemoveLoans(l ...*Loan) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveLoanIDs(ids...)
+}
+
+// ClearSharedLoan clears all "shared_loan" edges to the SharedLoan entity.
+func (uuo *UserUpdateOne) ClearSharedLoan() *UserUpdateOne {
+	uuo.mutation.ClearSharedLoan()
+	return uuo
+}
+
+// RemoveSharedLoanIDs removes the "shared_loan" edge to SharedLoan entities by IDs.
+func (uuo *UserUpdateOne) RemoveSharedLoanIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSharedLoanIDs(ids...)
+	return uuo
+}
+
+// RemoveSharedLoan removes "shared_loan" edges to SharedLoan entities.
+func (uuo *UserUpdateOne) RemoveSharedLoan(s ...*SharedLoan) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSharedLoanIDs(ids...)
+}
+
+// ClearShareInvitations clears all "share_invitations" edges to the ShareInvitation entity.
+func (uuo *UserUpdateOne) ClearShareInvitations() *UserUpdateOne {
+	uuo.mutation.ClearShareInvitations()
+	return uuo
+}
+
+// RemoveShareInvitationIDs removes the "share_invitations" edge to ShareInvitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveShareInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveShareInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveShareInvitations removes "share_invitations" edges to ShareInvitation entities.
+func (uuo *UserUpdateOne) RemoveShareInvitations(s ...*ShareInvitation) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveShareInvitationIDs(ids...)
+}
+
+// ClearCreditLines clears all "credit_lines" edges to the CreditLine entity.
+func (uuo *UserUpdateOne) ClearCreditLines() *UserUpdateOne {
+	uuo.mutation.ClearCreditLines()
+	return uuo
+}
+
+// RemoveCreditLineIDs removes the "credit_lines" edge to CreditLine entities by IDs.
+func (uuo *UserUpdateOne) RemoveCreditLineIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCreditLineIDs(ids...)
+	return uuo
+}
+
+// RemoveCreditLines removes "credit_lines" edges to CreditLine entities.
+func (uuo *UserUpdateOne) RemoveCreditLines(c ...*CreditLine) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCreditLineIDs(ids...)
+}
+
+// ClearObligations clears all "obligations" edges to the LoanObligor entity.
+func (uuo *UserUpdateOne) ClearObligations() *UserUpdateOne {
+	uuo.mutation.ClearObligations()
+	return uuo
+}
+
+// RemoveObligationIDs removes the "obligations" edge to LoanObligor entities by IDs.
+func (uuo *UserUpdateOne) RemoveObligationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveObligationIDs(ids...)
+	return uuo
+}
+
+// RemoveObligations removes "obligations" edges to LoanObligor entities.
+func (uuo *UserUpdateOne) RemoveObligations(l ...*LoanObligor) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveObligationIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the UserCredential entity.
+func (uuo *UserUpdateOne) ClearCredential() *UserUpdateOne {
+	uuo.mutation.ClearCredential()
+	return uuo
+}
+
+// ClearStaffRoles clears all "staff_roles" edges to the StaffRole entity.
+func (uuo *UserUpdateOne) ClearStaffRoles() *UserUpdateOne {
+	uuo.mutation.ClearStaffRoles()
+	return uuo
+}
+
+// RemoveStaffRoleIDs removes the "staff_roles" edge to StaffRole entities by IDs.
+func (uuo *UserUpdateOne) RemoveStaffRoleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveStaffRoleIDs(ids...)
+	return uuo
+}
+
+// RemoveStaffRoles removes "staff_roles" edges to StaffRole entities.
+func (uuo *UserUpdateOne) RemoveStaffRoles(s ...*StaffRole) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveStaffRoleIDs(ids...)
 }
 
-// ClearLoans clears all "loans" edges to the Loan entity.
-func (uuo *UserUpdateOne) ClearLoans() *UserUpdateOne {
-	uuo.mutation.ClearLoans()
+// ClearIdentities clears all "identities" edges to the UserIdentity entity.
+func (uuo *UserUpdateOne) ClearIdentities() *UserUpdateOne {
+	uuo.mutation.ClearIdentities()
 	return uuo
 }
 
-// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
-func (uuo *UserUpdateOne) RemoveLoanIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveLoanIDs(ids...)
+// RemoveIdentityIDs removes the "identities" edge to UserIdentity entities by IDs.
+func (uuo *UserUpdateOne) RemoveIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveIdentityIDs(ids...)
 	return uuo
 }
 
-// RemoveLoans removes "loans" edges to Loan entities.
-func (uuo *UserUpdateOne) RemoveLoans(l ...*Loan) *UserUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+// RemoveIdentities removes "identities" edges to UserIdentity entities.
+func (uuo *UserUpdateOne) RemoveIdentities(u ...*UserIdentity) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	return uuo.RemoveLoanIDs(ids...)
+	return uuo.RemoveIdentityIDs(ids...)
 }
 
-// ClearSharedLoan clears all "shared_loan" edges to the SharedLoan entity.
-func (uuo *UserUpdateOne) ClearSharedLoan() *UserUpdateOne {
-	uuo.mutation.ClearSharedLoan()
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	uuo.mutation.ClearSessions()
 	return uuo
 }
 
-// RemoveSharedLoanIDs removes the "shared_loan" edge to SharedLoan entities by IDs.
-func (uuo *UserUpdateOne) RemoveSharedLoanIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveSharedLoanIDs(ids...)
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSessionIDs(ids...)
 	return uuo
 }
 
-// RemoveSharedLoan removes "shared_loan" edges to SharedLoan entities.
-func (uuo *UserUpdateOne) RemoveSharedLoan(s ...*SharedLoan) *UserUpdateOne {
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
 	ids := make([]int, len(s))
 	for i := range s {
 		ids[i] = s[i].ID
 	}
-	return uuo.RemoveSharedLoanIDs(ids...)
+	return uuo.RemoveSessionIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -504,13 +1499,48 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 	}
 	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+		vv, err := user.ValueScanner.Name.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(user.FieldName, field.TypeString, vv)
+	}
+	if value, ok := uuo.mutation.NameIndex(); ok {
+		_spec.SetField(user.FieldNameIndex, field.TypeString, value)
+	}
+	if uuo.mutation.NameIndexCleared() {
+		_spec.ClearField(user.FieldNameIndex, field.TypeString)
 	}
 	if value, ok := uuo.mutation.Social(); ok {
 		_spec.SetField(user.FieldSocial, field.TypeString, value)
 	}
+	if uuo.mutation.SocialCleared() {
+		_spec.ClearField(user.FieldSocial, field.TypeString)
+	}
+	if value, ok := uuo.mutation.SocialHash(); ok {
+		_spec.SetField(user.FieldSocialHash, field.TypeString, value)
+	}
+	if uuo.mutation.SocialHashCleared() {
+		_spec.ClearField(user.FieldSocialHash, field.TypeString)
+	}
+	if value, ok := uuo.mutation.SocialEncrypted(); ok {
+		_spec.SetField(user.FieldSocialEncrypted, field.TypeString, value)
+	}
+	if uuo.mutation.SocialEncryptedCleared() {
+		_spec.ClearField(user.FieldSocialEncrypted, field.TypeString)
+	}
+	if value, ok := uuo.mutation.SocialLast4(); ok {
+		_spec.SetField(user.FieldSocialLast4, field.TypeString, value)
+	}
+	if uuo.mutation.SocialLast4Cleared() {
+		_spec.ClearField(user.FieldSocialLast4, field.TypeString)
+	}
 	if value, ok := uuo.mutation.Address(); ok {
-		_spec.SetField(user.FieldAddress, field.TypeString, value)
+		vv, err := user.ValueScanner.Address.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(user.FieldAddress, field.TypeString, vv)
 	}
 	if uuo.mutation.AddressCleared() {
 		_spec.ClearField(user.FieldAddress, field.TypeString)
@@ -605,6 +1635,305 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedShareInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.ShareInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ShareInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShareInvitationsTable,
+			Columns: []string{user.ShareInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.CreditLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCreditLinesIDs(); len(nodes) > 0 && !uuo.mutation.CreditLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CreditLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditLinesTable,
+			Columns: []string{user.CreditLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ObligationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedObligationsIDs(); len(nodes) > 0 && !uuo.mutation.ObligationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ObligationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ObligationsTable,
+			Columns: []string{user.ObligationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanobligor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.StaffRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedStaffRolesIDs(); len(nodes) > 0 && !uuo.mutation.StaffRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.StaffRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StaffRolesTable,
+			Columns: []string{user.StaffRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staffrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/userchange"
+)
+
+// UserChange is the model entity for the UserChange schema.
+type UserChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Field holds the value of the "field" field.
+	Field userchange.Field `json:"field,omitempty"`
+	// OldValue holds the value of the "old_value" field.
+	OldValue string `json:"old_value,omitempty"`
+	// NewValue holds the value of the "new_value" field.
+	NewValue string `json:"new_value,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy int `json:"changed_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userchange.FieldID, userchange.FieldUserID, userchange.FieldChangedBy:
+			values[i] = new(sql.NullInt64)
+		case userchange.FieldField:
+			values[i] = new(sql.NullString)
+		case userchange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case userchange.FieldOldValue:
+			values[i] = userchange.ValueScanner.OldValue.ScanValue()
+		case userchange.FieldNewValue:
+			values[i] = userchange.ValueScanner.NewValue.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserChange fields.
+func (uc *UserChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userchange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uc.ID = int(value.Int64)
+		case userchange.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uc.UserID = int(value.Int64)
+			}
+		case userchange.FieldField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field", values[i])
+			} else if value.Valid {
+				uc.Field = userchange.Field(value.String)
+			}
+		case userchange.FieldOldValue:
+			if value, err := userchange.ValueScanner.OldValue.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				uc.OldValue = value
+			}
+		case userchange.FieldNewValue:
+			if value, err := userchange.ValueScanner.NewValue.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				uc.NewValue = value
+			}
+		case userchange.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				uc.ChangedBy = int(value.Int64)
+			}
+		case userchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				uc.CreatedAt = value.Time
+			}
+		default:
+			uc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserChange.
+// This includes values selected through modifiers, order, etc.
+func (uc *UserChange) Value(name string) (ent.Value, error) {
+	return uc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserChange.
+// Note that you need to call UserChange.Unwrap() before calling this method if this UserChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UserChange) Update() *UserChangeUpdateOne {
+	return NewUserChangeClient(uc.config).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UserChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UserChange) Unwrap() *UserChange {
+	_tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserChange is not a transactional entity")
+	}
+	uc.config.driver = _tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UserChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("field=")
+	builder.WriteString(fmt.Sprintf("%v", uc.Field))
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(uc.OldValue)
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(uc.NewValue)
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(fmt.Sprintf("%v", uc.ChangedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(uc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserChanges is a parsable slice of UserChange.
+type UserChanges []*UserChange
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userchange
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	// Label holds the string label denoting the userchange type in the database.
+	Label = "user_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldField holds the string denoting the field field in the database.
+	FieldField = "field"
+	// FieldOldValue holds the string denoting the old_value field in the database.
+	FieldOldValue = "old_value"
+	// FieldNewValue holds the string denoting the new_value field in the database.
+	FieldNewValue = "new_value"
+	// FieldChangedBy holds the string denoting the changed_by field in the database.
+	FieldChangedBy = "changed_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the userchange in the database.
+	Table = "user_changes"
+)
+
+// Columns holds all SQL columns for userchange fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldField,
+	FieldOldValue,
+	FieldNewValue,
+	FieldChangedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// ValueScanner of all UserChange fields.
+	ValueScanner struct {
+		OldValue field.TypeValueScanner[string]
+		NewValue field.TypeValueScanner[string]
+	}
+)
+
+// Field defines the type for the "field" enum field.
+type Field string
+
+// Field values.
+const (
+	FieldName    Field = "name"
+	FieldAddress Field = "address"
+)
+
+func (f Field) String() string {
+	return string(f)
+}
+
+// FieldValidator is a validator for the "field" field enum values. It is called by the builders before save.
+func FieldValidator(f Field) error {
+	switch f {
+	case FieldName, FieldAddress:
+		return nil
+	default:
+		return fmt.Errorf("userchange: invalid enum value for field field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the UserChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByField orders the results by the field field.
+func ByField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldField, opts...).ToFunc()
+}
+
+// ByOldValue orders the results by the old_value field.
+func ByOldValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldValue, opts...).ToFunc()
+}
+
+// ByNewValue orders the results by the new_value field.
+func ByNewValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewValue, opts...).ToFunc()
+}
+
+// ByChangedBy orders the results by the changed_by field.
+func ByChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userchange
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldEQ(FieldOldValue, vc), err)
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldEQ(FieldNewValue, vc), err)
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLTE(FieldUserID, v))
+}
+
+// FieldEQ applies the EQ predicate on the "field" field.
+func FieldEQ(v Field) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldField, v))
+}
+
+// FieldNEQ applies the NEQ predicate on the "field" field.
+func FieldNEQ(v Field) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNEQ(FieldField, v))
+}
+
+// FieldIn applies the In predicate on the "field" field.
+func FieldIn(vs ...Field) predicate.UserChange {
+	return predicate.UserChange(sql.FieldIn(FieldField, vs...))
+}
+
+// FieldNotIn applies the NotIn predicate on the "field" field.
+func FieldNotIn(vs ...Field) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotIn(FieldField, vs...))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldEQ(FieldOldValue, vc), err)
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldNEQ(FieldOldValue, vc), err)
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.UserChange {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.OldValue.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserChangeOrErr(sql.FieldIn(FieldOldValue, v...), err)
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.UserChange {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.OldValue.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserChangeOrErr(sql.FieldNotIn(FieldOldValue, v...), err)
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldGT(FieldOldValue, vc), err)
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldGTE(FieldOldValue, vc), err)
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldLT(FieldOldValue, vc), err)
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldLTE(FieldOldValue, vc), err)
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("old_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldContains(FieldOldValue, vcs), err)
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("old_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldHasPrefix(FieldOldValue, vcs), err)
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("old_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldHasSuffix(FieldOldValue, vcs), err)
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotNull(FieldOldValue))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("old_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldEqualFold(FieldOldValue, vcs), err)
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.UserChange {
+	vc, err := ValueScanner.OldValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("old_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldContainsFold(FieldOldValue, vcs), err)
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldEQ(FieldNewValue, vc), err)
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldNEQ(FieldNewValue, vc), err)
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.UserChange {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.NewValue.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserChangeOrErr(sql.FieldIn(FieldNewValue, v...), err)
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.UserChange {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.NewValue.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.UserChangeOrErr(sql.FieldNotIn(FieldNewValue, v...), err)
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldGT(FieldNewValue, vc), err)
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldGTE(FieldNewValue, vc), err)
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldLT(FieldNewValue, vc), err)
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	return predicate.UserChangeOrErr(sql.FieldLTE(FieldNewValue, vc), err)
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("new_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldContains(FieldNewValue, vcs), err)
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("new_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldHasPrefix(FieldNewValue, vcs), err)
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("new_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldHasSuffix(FieldNewValue, vcs), err)
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotNull(FieldNewValue))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("new_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldEqualFold(FieldNewValue, vcs), err)
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.UserChange {
+	vc, err := ValueScanner.NewValue.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("new_value value is not a string: %T", vc)
+	}
+	return predicate.UserChangeOrErr(sql.FieldContainsFold(FieldNewValue, vcs), err)
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v int) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByIsNil applies the IsNil predicate on the "changed_by" field.
+func ChangedByIsNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldIsNull(FieldChangedBy))
+}
+
+// ChangedByNotNil applies the NotNil predicate on the "changed_by" field.
+func ChangedByNotNil() predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotNull(FieldChangedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserChange {
+	return predicate.UserChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserChange) predicate.UserChange {
+	return predicate.UserChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserChange) predicate.UserChange {
+	return predicate.UserChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserChange) predicate.UserChange {
+	return predicate.UserChange(sql.NotPredicates(p))
+}
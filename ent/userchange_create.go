@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/userchange"
+)
+
+// UserChangeCreate is the builder for creating a UserChange entity.
+type UserChangeCreate struct {
+	config
+	mutation *UserChangeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ucc *UserChangeCreate) SetUserID(i int) *UserChangeCreate {
+	ucc.mutation.SetUserID(i)
+	return ucc
+}
+
+// SetField sets the "field" field.
+func (ucc *UserChangeCreate) SetField(u userchange.Field) *UserChangeCreate {
+	ucc.mutation.SetFieldField(u)
+	return ucc
+}
+
+// SetOldValue sets the "old_value" field.
+func (ucc *UserChangeCreate) SetOldValue(s string) *UserChangeCreate {
+	ucc.mutation.SetOldValue(s)
+	return ucc
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (ucc *UserChangeCreate) SetNillableOldValue(s *string) *UserChangeCreate {
+	if s != nil {
+		ucc.SetOldValue(*s)
+	}
+	return ucc
+}
+
+// SetNewValue sets the "new_value" field.
+func (ucc *UserChangeCreate) SetNewValue(s string) *UserChangeCreate {
+	ucc.mutation.SetNewValue(s)
+	return ucc
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (ucc *UserChangeCreate) SetNillableNewValue(s *string) *UserChangeCreate {
+	if s != nil {
+		ucc.SetNewValue(*s)
+	}
+	return ucc
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (ucc *UserChangeCreate) SetChangedBy(i int) *UserChangeCreate {
+	ucc.mutation.SetChangedBy(i)
+	return ucc
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (ucc *UserChangeCreate) SetNillableChangedBy(i *int) *UserChangeCreate {
+	if i != nil {
+		ucc.SetChangedBy(*i)
+	}
+	return ucc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ucc *UserChangeCreate) SetCreatedAt(t time.Time) *UserChangeCreate {
+	ucc.mutation.SetCreatedAt(t)
+	return ucc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ucc *UserChangeCreate) SetNillableCreatedAt(t *time.Time) *UserChangeCreate {
+	if t != nil {
+		ucc.SetCreatedAt(*t)
+	}
+	return ucc
+}
+
+// Mutation returns the UserChangeMutation object of the builder.
+func (ucc *UserChangeCreate) Mutation() *UserChangeMutation {
+	return ucc.mutation
+}
+
+// Save creates the UserChange in the database.
+func (ucc *UserChangeCreate) Save(ctx context.Context) (*UserChange, error) {
+	ucc.defaults()
+	return withHooks(ctx, ucc.sqlSave, ucc.mutation, ucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UserChangeCreate) SaveX(ctx context.Context) *UserChange {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UserChangeCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UserChangeCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucc *UserChangeCreate) defaults() {
+	if _, ok := ucc.mutation.CreatedAt(); !ok {
+		v := userchange.DefaultCreatedAt()
+		ucc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UserChangeCreate) check() error {
+	if _, ok := ucc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserChange.user_id"`)}
+	}
+	if _, ok := ucc.mutation.GetField(); !ok {
+		return &ValidationError{Name: "field", err: errors.New(`ent: missing required field "UserChange.field"`)}
+	}
+	if v, ok := ucc.mutation.GetField(); ok {
+		if err := userchange.FieldValidator(v); err != nil {
+			return &ValidationError{Name: "field", err: fmt.Errorf(`ent: validator failed for field "UserChange.field": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserChange.created_at"`)}
+	}
+	return nil
+}
+
+func (ucc *UserChangeCreate) sqlSave(ctx context.Context) (*UserChange, error) {
+	if err := ucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := ucc.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ucc.mutation.id = &_node.ID
+	ucc.mutation.done = true
+	return _node, nil
+}
+
+func (ucc *UserChangeCreate) createSpec() (*UserChange, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &UserChange{config: ucc.config}
+		_spec = sqlgraph.NewCreateSpec(userchange.Table, sqlgraph.NewFieldSpec(userchange.FieldID, field.TypeInt))
+	)
+	if value, ok := ucc.mutation.UserID(); ok {
+		_spec.SetField(userchange.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := ucc.mutation.GetField(); ok {
+		_spec.SetField(userchange.FieldField, field.TypeEnum, value)
+		_node.Field = value
+	}
+	if value, ok := ucc.mutation.OldValue(); ok {
+		vv, err := userchange.ValueScanner.OldValue.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(userchange.FieldOldValue, field.TypeString, vv)
+		_node.OldValue = value
+	}
+	if value, ok := ucc.mutation.NewValue(); ok {
+		vv, err := userchange.ValueScanner.NewValue.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(userchange.FieldNewValue, field.TypeString, vv)
+		_node.NewValue = value
+	}
+	if value, ok := ucc.mutation.ChangedBy(); ok {
+		_spec.SetField(userchange.FieldChangedBy, field.TypeInt, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := ucc.mutation.CreatedAt(); ok {
+		_spec.SetField(userchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec, nil
+}
+
+// UserChangeCreateBulk is the builder for creating many UserChange entities in bulk.
+type UserChangeCreateBulk struct {
+	config
+	err      error
+	builders []*UserChangeCreate
+}
+
+// Save creates the UserChange entities in the database.
+func (uccb *UserChangeCreateBulk) Save(ctx context.Context) ([]*UserChange, error) {
+	if uccb.err != nil {
+		return nil, uccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UserChange, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UserChangeCreateBulk) SaveX(ctx context.Context) []*UserChange {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UserChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UserChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/userchange"
+)
+
+// UserChangeDelete is the builder for deleting a UserChange entity.
+type UserChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserChangeMutation
+}
+
+// Where appends a list predicates to the UserChangeDelete builder.
+func (ucd *UserChangeDelete) Where(ps ...predicate.UserChange) *UserChangeDelete {
+	ucd.mutation.Where(ps...)
+	return ucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ucd *UserChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucd.sqlExec, ucd.mutation, ucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucd *UserChangeDelete) ExecX(ctx context.Context) int {
+	n, err := ucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ucd *UserChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userchange.Table, sqlgraph.NewFieldSpec(userchange.FieldID, field.TypeInt))
+	if ps := ucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ucd.mutation.done = true
+	return affected, err
+}
+
+// UserChangeDeleteOne is the builder for deleting a single UserChange entity.
+type UserChangeDeleteOne struct {
+	ucd *UserChangeDelete
+}
+
+// Where appends a list predicates to the UserChangeDelete builder.
+func (ucdo *UserChangeDeleteOne) Where(ps ...predicate.UserChange) *UserChangeDeleteOne {
+	ucdo.ucd.mutation.Where(ps...)
+	return ucdo
+}
+
+// Exec executes the deletion query.
+func (ucdo *UserChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := ucdo.ucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucdo *UserChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := ucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
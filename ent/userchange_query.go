@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/userchange"
+)
+
+// UserChangeQuery is the builder for querying UserChange entities.
+type UserChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []userchange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserChange
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserChangeQuery builder.
+func (ucq *UserChangeQuery) Where(ps ...predicate.UserChange) *UserChangeQuery {
+	ucq.predicates = append(ucq.predicates, ps...)
+	return ucq
+}
+
+// Limit the number of records to be returned by this query.
+func (ucq *UserChangeQuery) Limit(limit int) *UserChangeQuery {
+	ucq.ctx.Limit = &limit
+	return ucq
+}
+
+// Offset to start from.
+func (ucq *UserChangeQuery) Offset(offset int) *UserChangeQuery {
+	ucq.ctx.Offset = &offset
+	return ucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ucq *UserChangeQuery) Unique(unique bool) *UserChangeQuery {
+	ucq.ctx.Unique = &unique
+	return ucq
+}
+
+// Order specifies how the records should be ordered.
+func (ucq *UserChangeQuery) Order(o ...userchange.OrderOption) *UserChangeQuery {
+	ucq.order = append(ucq.order, o...)
+	return ucq
+}
+
+// First returns the first UserChange entity from the query.
+// Returns a *NotFoundError when no UserChange was found.
+func (ucq *UserChangeQuery) First(ctx context.Context) (*UserChange, error) {
+	nodes, err := ucq.Limit(1).All(setContextOp(ctx, ucq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ucq *UserChangeQuery) FirstX(ctx context.Context) *UserChange {
+	node, err := ucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserChange ID from the query.
+// Returns a *NotFoundError when no UserChange ID was found.
+func (ucq *UserChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(1).IDs(setContextOp(ctx, ucq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ucq *UserChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := ucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserChange entity is found.
+// Returns a *NotFoundError when no UserChange entities are found.
+func (ucq *UserChangeQuery) Only(ctx context.Context) (*UserChange, error) {
+	nodes, err := ucq.Limit(2).All(setContextOp(ctx, ucq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userchange.Label}
+	default:
+		return nil, &NotSingularError{userchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ucq *UserChangeQuery) OnlyX(ctx context.Context) *UserChange {
+	node, err := ucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserChange ID in the query.
+// Returns a *NotSingularError when more than one UserChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ucq *UserChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(2).IDs(setContextOp(ctx, ucq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userchange.Label}
+	default:
+		err = &NotSingularError{userchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ucq *UserChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserChanges.
+func (ucq *UserChangeQuery) All(ctx context.Context) ([]*UserChange, error) {
+	ctx = setContextOp(ctx, ucq.ctx, "All")
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserChange, *UserChangeQuery]()
+	return withInterceptors[[]*UserChange](ctx, ucq, qr, ucq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ucq *UserChangeQuery) AllX(ctx context.Context) []*UserChange {
+	nodes, err := ucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserChange IDs.
+func (ucq *UserChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ucq.ctx.Unique == nil && ucq.path != nil {
+		ucq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ucq.ctx, "IDs")
+	if err = ucq.Select(userchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ucq *UserChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := ucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ucq *UserChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ucq.ctx, "Count")
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ucq, querierCount[*UserChangeQuery](), ucq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ucq *UserChangeQuery) CountX(ctx context.Context) int {
+	count, err := ucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ucq *UserChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ucq.ctx, "Exist")
+	switch _, err := ucq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ucq *UserChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := ucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ucq *UserChangeQuery) Clone() *UserChangeQuery {
+	if ucq == nil {
+		return nil
+	}
+	return &UserChangeQuery{
+		config:     ucq.config,
+		ctx:        ucq.ctx.Clone(),
+		order:      append([]userchange.OrderOption{}, ucq.order...),
+		inters:     append([]Interceptor{}, ucq.inters...),
+		predicates: append([]predicate.UserChange{}, ucq.predicates...),
+		// clone intermediate query.
+		sql:  ucq.sql.Clone(),
+		path: ucq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserChange.Query().
+//		GroupBy(userchange.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ucq *UserChangeQuery) GroupBy(field string, fields ...string) *UserChangeGroupBy {
+	ucq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserChangeGroupBy{build: ucq}
+	grbuild.flds = &ucq.ctx.Fields
+	grbuild.label = userchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserChange.Query().
+//		Select(userchange.FieldUserID).
+//		Scan(ctx, &v)
+func (ucq *UserChangeQuery) Select(fields ...string) *UserChangeSelect {
+	ucq.ctx.Fields = append(ucq.ctx.Fields, fields...)
+	sbuild := &UserChangeSelect{UserChangeQuery: ucq}
+	sbuild.label = userchange.Label
+	sbuild.flds, sbuild.scan = &ucq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserChangeSelect configured with the given aggregations.
+func (ucq *UserChangeQuery) Aggregate(fns ...AggregateFunc) *UserChangeSelect {
+	return ucq.Select().Aggregate(fns...)
+}
+
+func (ucq *UserChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ucq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ucq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ucq.ctx.Fields {
+		if !userchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ucq.path != nil {
+		prev, err := ucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ucq.sql = prev
+	}
+	return nil
+}
+
+func (ucq *UserChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserChange, error) {
+	var (
+		nodes = []*UserChange{}
+		_spec = ucq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserChange{config: ucq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ucq *UserChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ucq.querySpec()
+	_spec.Node.Columns = ucq.ctx.Fields
+	if len(ucq.ctx.Fields) > 0 {
+		_spec.Unique = ucq.ctx.Unique != nil && *ucq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ucq.driver, _spec)
+}
+
+func (ucq *UserChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(userchange.Table, userchange.Columns, sqlgraph.NewFieldSpec(userchange.FieldID, field.TypeInt))
+	_spec.From = ucq.sql
+	if unique := ucq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ucq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ucq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userchange.FieldID)
+		for i := range fields {
+			if fields[i] != userchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ucq *UserChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ucq.driver.Dialect())
+	t1 := builder.Table(userchange.Table)
+	columns := ucq.ctx.Fields
+	if len(columns) == 0 {
+		columns = userchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ucq.sql != nil {
+		selector = ucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ucq.ctx.Unique != nil && *ucq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ucq.predicates {
+		p(selector)
+	}
+	for _, p := range ucq.order {
+		p(selector)
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserChangeGroupBy is the group-by builder for UserChange entities.
+type UserChangeGroupBy struct {
+	selector
+	build *UserChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ucgb *UserChangeGroupBy) Aggregate(fns ...AggregateFunc) *UserChangeGroupBy {
+	ucgb.fns = append(ucgb.fns, fns...)
+	return ucgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucgb *UserChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucgb.build.ctx, "GroupBy")
+	if err := ucgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserChangeQuery, *UserChangeGroupBy](ctx, ucgb.build, ucgb, ucgb.build.inters, v)
+}
+
+func (ucgb *UserChangeGroupBy) sqlScan(ctx context.Context, root *UserChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ucgb.fns))
+	for _, fn := range ucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ucgb.flds)+len(ucgb.fns))
+		for _, f := range *ucgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ucgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserChangeSelect is the builder for selecting fields of UserChange entities.
+type UserChangeSelect struct {
+	*UserChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ucs *UserChangeSelect) Aggregate(fns ...AggregateFunc) *UserChangeSelect {
+	ucs.fns = append(ucs.fns, fns...)
+	return ucs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucs *UserChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucs.ctx, "Select")
+	if err := ucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserChangeQuery, *UserChangeSelect](ctx, ucs.UserChangeQuery, ucs, ucs.inters, v)
+}
+
+func (ucs *UserChangeSelect) sqlScan(ctx context.Context, root *UserChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ucs.fns))
+	for _, fn := range ucs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ucs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/userchange"
+)
+
+// UserChangeUpdate is the builder for updating UserChange entities.
+type UserChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserChangeMutation
+}
+
+// Where appends a list predicates to the UserChangeUpdate builder.
+func (ucu *UserChangeUpdate) Where(ps ...predicate.UserChange) *UserChangeUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// SetUserID sets the "user_id" field.
+func (ucu *UserChangeUpdate) SetUserID(i int) *UserChangeUpdate {
+	ucu.mutation.ResetUserID()
+	ucu.mutation.SetUserID(i)
+	return ucu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ucu *UserChangeUpdate) SetNillableUserID(i *int) *UserChangeUpdate {
+	if i != nil {
+		ucu.SetUserID(*i)
+	}
+	return ucu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ucu *UserChangeUpdate) AddUserID(i int) *UserChangeUpdate {
+	ucu.mutation.AddUserID(i)
+	return ucu
+}
+
+// SetField sets the "field" field.
+func (ucu *UserChangeUpdate) SetField(u userchange.Field) *UserChangeUpdate {
+	ucu.mutation.SetFieldField(u)
+	return ucu
+}
+
+// SetNillableField sets the "field" field if the given value is not nil.
+func (ucu *UserChangeUpdate) SetNillableField(u *userchange.Field) *UserChangeUpdate {
+	if u != nil {
+		ucu.SetField(*u)
+	}
+	return ucu
+}
+
+// SetOldValue sets the "old_value" field.
+func (ucu *UserChangeUpdate) SetOldValue(s string) *UserChangeUpdate {
+	ucu.mutation.SetOldValue(s)
+	return ucu
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (ucu *UserChangeUpdate) SetNillableOldValue(s *string) *UserChangeUpdate {
+	if s != nil {
+		ucu.SetOldValue(*s)
+	}
+	return ucu
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (ucu *UserChangeUpdate) ClearOldValue() *UserChangeUpdate {
+	ucu.mutation.ClearOldValue()
+	return ucu
+}
+
+// SetNewValue sets the "new_value" field.
+func (ucu *UserChangeUpdate) SetNewValue(s string) *UserChangeUpdate {
+	ucu.mutation.SetNewValue(s)
+	return ucu
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (ucu *UserChangeUpdate) SetNillableNewValue(s *string) *UserChangeUpdate {
+	if s != nil {
+		ucu.SetNewValue(*s)
+	}
+	return ucu
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (ucu *UserChangeUpdate) ClearNewValue() *UserChangeUpdate {
+	ucu.mutation.ClearNewValue()
+	return ucu
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (ucu *UserChangeUpdate) SetChangedBy(i int) *UserChangeUpdate {
+	ucu.mutation.ResetChangedBy()
+	ucu.mutation.SetChangedBy(i)
+	return ucu
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (ucu *UserChangeUpdate) SetNillableChangedBy(i *int) *UserChangeUpdate {
+	if i != nil {
+		ucu.SetChangedBy(*i)
+	}
+	return ucu
+}
+
+// AddChangedBy adds i to the "changed_by" field.
+func (ucu *UserChangeUpdate) AddChangedBy(i int) *UserChangeUpdate {
+	ucu.mutation.AddChangedBy(i)
+	return ucu
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (ucu *UserChangeUpdate) ClearChangedBy() *UserChangeUpdate {
+	ucu.mutation.ClearChangedBy()
+	return ucu
+}
+
+// Mutation returns the UserChangeMutation object of the builder.
+func (ucu *UserChangeUpdate) Mutation() *UserChangeMutation {
+	return ucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UserChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucu.sqlSave, ucu.mutation, ucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UserChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UserChangeUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UserChangeUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UserChangeUpdate) check() error {
+	if v, ok := ucu.mutation.GetField(); ok {
+		if err := userchange.FieldValidator(v); err != nil {
+			return &ValidationError{Name: "field", err: fmt.Errorf(`ent: validator failed for field "UserChange.field": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucu *UserChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userchange.Table, userchange.Columns, sqlgraph.NewFieldSpec(userchange.FieldID, field.TypeInt))
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucu.mutation.UserID(); ok {
+		_spec.SetField(userchange.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := ucu.mutation.AddedUserID(); ok {
+		_spec.AddField(userchange.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := ucu.mutation.GetField(); ok {
+		_spec.SetField(userchange.FieldField, field.TypeEnum, value)
+	}
+	if value, ok := ucu.mutation.OldValue(); ok {
+		vv, err := userchange.ValueScanner.OldValue.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(userchange.FieldOldValue, field.TypeString, vv)
+	}
+	if ucu.mutation.OldValueCleared() {
+		_spec.ClearField(userchange.FieldOldValue, field.TypeString)
+	}
+	if value, ok := ucu.mutation.NewValue(); ok {
+		vv, err := userchange.ValueScanner.NewValue.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(userchange.FieldNewValue, field.TypeString, vv)
+	}
+	if ucu.mutation.NewValueCleared() {
+		_spec.ClearField(userchange.FieldNewValue, field.TypeString)
+	}
+	if value, ok := ucu.mutation.ChangedBy(); ok {
+		_spec.SetField(userchange.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := ucu.mutation.AddedChangedBy(); ok {
+		_spec.AddField(userchange.FieldChangedBy, field.TypeInt, value)
+	}
+	if ucu.mutation.ChangedByCleared() {
+		_spec.ClearField(userchange.FieldChangedBy, field.TypeInt)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ucu.mutation.done = true
+	return n, nil
+}
+
+// UserChangeUpdateOne is the builder for updating a single UserChange entity.
+type UserChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserChangeMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ucuo *UserChangeUpdateOne) SetUserID(i int) *UserChangeUpdateOne {
+	ucuo.mutation.ResetUserID()
+	ucuo.mutation.SetUserID(i)
+	return ucuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ucuo *UserChangeUpdateOne) SetNillableUserID(i *int) *UserChangeUpdateOne {
+	if i != nil {
+		ucuo.SetUserID(*i)
+	}
+	return ucuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ucuo *UserChangeUpdateOne) AddUserID(i int) *UserChangeUpdateOne {
+	ucuo.mutation.AddUserID(i)
+	return ucuo
+}
+
+// SetField sets the "field" field.
+func (ucuo *UserChangeUpdateOne) SetField(u userchange.Field) *UserChangeUpdateOne {
+	ucuo.mutation.SetFieldField(u)
+	return ucuo
+}
+
+// SetNillableField sets the "field" field if the given value is not nil.
+func (ucuo *UserChangeUpdateOne) SetNillableField(u *userchange.Field) *UserChangeUpdateOne {
+	if u != nil {
+		ucuo.SetField(*u)
+	}
+	return ucuo
+}
+
+// SetOldValue sets the "old_value" field.
+func (ucuo *UserChangeUpdateOne) SetOldValue(s string) *UserChangeUpdateOne {
+	ucuo.mutation.SetOldValue(s)
+	return ucuo
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (ucuo *UserChangeUpdateOne) SetNillableOldValue(s *string) *UserChangeUpdateOne {
+	if s != nil {
+		ucuo.SetOldValue(*s)
+	}
+	return ucuo
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (ucuo *UserChangeUpdateOne) ClearOldValue() *UserChangeUpdateOne {
+	ucuo.mutation.ClearOldValue()
+	return ucuo
+}
+
+// SetNewValue sets the "new_value" field.
+func (ucuo *UserChangeUpdateOne) SetNewValue(s string) *UserChangeUpdateOne {
+	ucuo.mutation.SetNewValue(s)
+	return ucuo
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (ucuo *UserChangeUpdateOne) SetNillableNewValue(s *string) *UserChangeUpdateOne {
+	if s != nil {
+		ucuo.SetNewValue(*s)
+	}
+	return ucuo
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (ucuo *UserChangeUpdateOne) ClearNewValue() *UserChangeUpdateOne {
+	ucuo.mutation.ClearNewValue()
+	return ucuo
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (ucuo *UserChangeUpdateOne) SetChangedBy(i int) *UserChangeUpdateOne {
+	ucuo.mutation.ResetChangedBy()
+	ucuo.mutation.SetChangedBy(i)
+	return ucuo
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (ucuo *UserChangeUpdateOne) SetNillableChangedBy(i *int) *UserChangeUpdateOne {
+	if i != nil {
+		ucuo.SetChangedBy(*i)
+	}
+	return ucuo
+}
+
+// AddChangedBy adds i to the "changed_by" field.
+func (ucuo *UserChangeUpdateOne) AddChangedBy(i int) *UserChangeUpdateOne {
+	ucuo.mutation.AddChangedBy(i)
+	return ucuo
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (ucuo *UserChangeUpdateOne) ClearChangedBy() *UserChangeUpdateOne {
+	ucuo.mutation.ClearChangedBy()
+	return ucuo
+}
+
+// Mutation returns the UserChangeMutation object of the builder.
+func (ucuo *UserChangeUpdateOne) Mutation() *UserChangeMutation {
+	return ucuo.mutation
+}
+
+// Where appends a list predicates to the UserChangeUpdate builder.
+func (ucuo *UserChangeUpdateOne) Where(ps ...predicate.UserChange) *UserChangeUpdateOne {
+	ucuo.mutation.Where(ps...)
+	return ucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UserChangeUpdateOne) Select(field string, fields ...string) *UserChangeUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UserChange entity.
+func (ucuo *UserChangeUpdateOne) Save(ctx context.Context) (*UserChange, error) {
+	return withHooks(ctx, ucuo.sqlSave, ucuo.mutation, ucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UserChangeUpdateOne) SaveX(ctx context.Context) *UserChange {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UserChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UserChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UserChangeUpdateOne) check() error {
+	if v, ok := ucuo.mutation.GetField(); ok {
+		if err := userchange.FieldValidator(v); err != nil {
+			return &ValidationError{Name: "field", err: fmt.Errorf(`ent: validator failed for field "UserChange.field": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucuo *UserChangeUpdateOne) sqlSave(ctx context.Context) (_node *UserChange, err error) {
+	if err := ucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userchange.Table, userchange.Columns, sqlgraph.NewFieldSpec(userchange.FieldID, field.TypeInt))
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userchange.FieldID)
+		for _, f := range fields {
+			if !userchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucuo.mutation.UserID(); ok {
+		_spec.SetField(userchange.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := ucuo.mutation.AddedUserID(); ok {
+		_spec.AddField(userchange.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := ucuo.mutation.GetField(); ok {
+		_spec.SetField(userchange.FieldField, field.TypeEnum, value)
+	}
+	if value, ok := ucuo.mutation.OldValue(); ok {
+		vv, err := userchange.ValueScanner.OldValue.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(userchange.FieldOldValue, field.TypeString, vv)
+	}
+	if ucuo.mutation.OldValueCleared() {
+		_spec.ClearField(userchange.FieldOldValue, field.TypeString)
+	}
+	if value, ok := ucuo.mutation.NewValue(); ok {
+		vv, err := userchange.ValueScanner.NewValue.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(userchange.FieldNewValue, field.TypeString, vv)
+	}
+	if ucuo.mutation.NewValueCleared() {
+		_spec.ClearField(userchange.FieldNewValue, field.TypeString)
+	}
+	if value, ok := ucuo.mutation.ChangedBy(); ok {
+		_spec.SetField(userchange.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := ucuo.mutation.AddedChangedBy(); ok {
+		_spec.AddField(userchange.FieldChangedBy, field.TypeInt, value)
+	}
+	if ucuo.mutation.ChangedByCleared() {
+		_spec.ClearField(userchange.FieldChangedBy, field.TypeInt)
+	}
+	_node = &UserChange{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ucuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+)
+
+// UserCredential is the model entity for the UserCredential schema.
+type UserCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserCredentialQuery when eager-loading is set.
+	Edges        UserCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserCredentialEdges holds the relations/edges for other nodes in the graph.
+type UserCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserCredentialEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usercredential.FieldID, usercredential.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case usercredential.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case usercredential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserCredential fields.
+func (uc *UserCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usercredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uc.ID = int(value.Int64)
+		case usercredential.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uc.UserID = int(value.Int64)
+			}
+		case usercredential.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				uc.PasswordHash = value.String
+			}
+		case usercredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				uc.UpdatedAt = value.Time
+			}
+		default:
+			uc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserCredential.
+// This includes values selected through modifiers, order, etc.
+func (uc *UserCredential) Value(name string) (ent.Value, error) {
+	return uc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserCredential entity.
+func (uc *UserCredential) QueryUser() *UserQuery {
+	return NewUserCredentialClient(uc.config).QueryUser(uc)
+}
+
+// Update returns a builder for updating this UserCredential.
+// Note that you need to call UserCredential.Unwrap() before calling this method if this UserCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UserCredential) Update() *UserCredentialUpdateOne {
+	return NewUserCredentialClient(uc.config).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UserCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UserCredential) Unwrap() *UserCredential {
+	_tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserCredential is not a transactional entity")
+	}
+	uc.config.driver = _tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UserCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", uc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(uc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserCredentials is a parsable slice of UserCredential.
+type UserCredentials []*UserCredential
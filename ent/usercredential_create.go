@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+)
+
+// UserCredentialCreate is the builder for creating a UserCredential entity.
+type UserCredentialCreate struct {
+	config
+	mutation *UserCredentialMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ucc *UserCredentialCreate) SetUserID(i int) *UserCredentialCreate {
+	ucc.mutation.SetUserID(i)
+	return ucc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (ucc *UserCredentialCreate) SetPasswordHash(s string) *UserCredentialCreate {
+	ucc.mutation.SetPasswordHash(s)
+	return ucc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ucc *UserCredentialCreate) SetUpdatedAt(t time.Time) *UserCredentialCreate {
+	ucc.mutation.SetUpdatedAt(t)
+	return ucc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ucc *UserCredentialCreate) SetNillableUpdatedAt(t *time.Time) *UserCredentialCreate {
+	if t != nil {
+		ucc.SetUpdatedAt(*t)
+	}
+	return ucc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ucc *UserCredentialCreate) SetUser(u *User) *UserCredentialCreate {
+	return ucc.SetUserID(u.ID)
+}
+
+// Mutation returns the UserCredentialMutation object of the builder.
+func (ucc *UserCredentialCreate) Mutation() *UserCredentialMutation {
+	return ucc.mutation
+}
+
+// Save creates the UserCredential in the database.
+func (ucc *UserCredentialCreate) Save(ctx context.Context) (*UserCredential, error) {
+	ucc.defaults()
+	return withHooks(ctx, ucc.sqlSave, ucc.mutation, ucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UserCredentialCreate) SaveX(ctx context.Context) *UserCredential {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UserCredentialCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UserCredentialCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucc *UserCredentialCreate) defaults() {
+	if _, ok := ucc.mutation.UpdatedAt(); !ok {
+		v := usercredential.DefaultUpdatedAt()
+		ucc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UserCredentialCreate) check() error {
+	if _, ok := ucc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserCredential.user_id"`)}
+	}
+	if _, ok := ucc.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "UserCredential.password_hash"`)}
+	}
+	if _, ok := ucc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserCredential.updated_at"`)}
+	}
+	if _, ok := ucc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserCredential.user"`)}
+	}
+	return nil
+}
+
+func (ucc *UserCredentialCreate) sqlSave(ctx context.Context) (*UserCredential, error) {
+	if err := ucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ucc.mutation.id = &_node.ID
+	ucc.mutation.done = true
+	return _node, nil
+}
+
+func (ucc *UserCredentialCreate) createSpec() (*UserCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserCredential{config: ucc.config}
+		_spec = sqlgraph.NewCreateSpec(usercredential.Table, sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt))
+	)
+	if value, ok := ucc.mutation.PasswordHash(); ok {
+		_spec.SetField(usercredential.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := ucc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usercredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ucc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usercredential.UserTable,
+			Columns: []string{usercredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserCredentialCreateBulk is the builder for creating many UserCredential entities in bulk.
+type UserCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*UserCredentialCreate
+}
+
+// Save creates the UserCredential entities in the database.
+func (uccb *UserCredentialCreateBulk) Save(ctx context.Context) ([]*UserCredential, error) {
+	if uccb.err != nil {
+		return nil, uccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UserCredential, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UserCredentialCreateBulk) SaveX(ctx context.Context) []*UserCredential {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UserCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UserCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
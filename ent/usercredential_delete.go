@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/usercredential"
+)
+
+// UserCredentialDelete is the builder for deleting a UserCredential entity.
+type UserCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserCredentialMutation
+}
+
+// Where appends a list predicates to the UserCredentialDelete builder.
+func (ucd *UserCredentialDelete) Where(ps ...predicate.UserCredential) *UserCredentialDelete {
+	ucd.mutation.Where(ps...)
+	return ucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ucd *UserCredentialDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucd.sqlExec, ucd.mutation, ucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucd *UserCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := ucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ucd *UserCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usercredential.Table, sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt))
+	if ps := ucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ucd.mutation.done = true
+	return affected, err
+}
+
+// UserCredentialDeleteOne is the builder for deleting a single UserCredential entity.
+type UserCredentialDeleteOne struct {
+	ucd *UserCredentialDelete
+}
+
+// Where appends a list predicates to the UserCredentialDelete builder.
+func (ucdo *UserCredentialDeleteOne) Where(ps ...predicate.UserCredential) *UserCredentialDeleteOne {
+	ucdo.ucd.mutation.Where(ps...)
+	return ucdo
+}
+
+// Exec executes the deletion query.
+func (ucdo *UserCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := ucdo.ucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usercredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucdo *UserCredentialDeleteOne) ExecX(ctx context.Context) {
+	if err := ucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+)
+
+// UserCredentialUpdate is the builder for updating UserCredential entities.
+type UserCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserCredentialMutation
+}
+
+// Where appends a list predicates to the UserCredentialUpdate builder.
+func (ucu *UserCredentialUpdate) Where(ps ...predicate.UserCredential) *UserCredentialUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// SetUserID sets the "user_id" field.
+func (ucu *UserCredentialUpdate) SetUserID(i int) *UserCredentialUpdate {
+	ucu.mutation.SetUserID(i)
+	return ucu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ucu *UserCredentialUpdate) SetNillableUserID(i *int) *UserCredentialUpdate {
+	if i != nil {
+		ucu.SetUserID(*i)
+	}
+	return ucu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (ucu *UserCredentialUpdate) SetPasswordHash(s string) *UserCredentialUpdate {
+	ucu.mutation.SetPasswordHash(s)
+	return ucu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (ucu *UserCredentialUpdate) SetNillablePasswordHash(s *string) *UserCredentialUpdate {
+	if s != nil {
+		ucu.SetPasswordHash(*s)
+	}
+	return ucu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ucu *UserCredentialUpdate) SetUpdatedAt(t time.Time) *UserCredentialUpdate {
+	ucu.mutation.SetUpdatedAt(t)
+	return ucu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ucu *UserCredentialUpdate) SetUser(u *User) *UserCredentialUpdate {
+	return ucu.SetUserID(u.ID)
+}
+
+// Mutation returns the UserCredentialMutation object of the builder.
+func (ucu *UserCredentialUpdate) Mutation() *UserCredentialMutation {
+	return ucu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ucu *UserCredentialUpdate) ClearUser() *UserCredentialUpdate {
+	ucu.mutation.ClearUser()
+	return ucu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UserCredentialUpdate) Save(ctx context.Context) (int, error) {
+	ucu.defaults()
+	return withHooks(ctx, ucu.sqlSave, ucu.mutation, ucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UserCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UserCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UserCredentialUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucu *UserCredentialUpdate) defaults() {
+	if _, ok := ucu.mutation.UpdatedAt(); !ok {
+		v := usercredential.UpdateDefaultUpdatedAt()
+		ucu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UserCredentialUpdate) check() error {
+	if _, ok := ucu.mutation.UserID(); ucu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserCredential.user"`)
+	}
+	return nil
+}
+
+func (ucu *UserCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usercredential.Table, usercredential.Columns, sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt))
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucu.mutation.PasswordHash(); ok {
+		_spec.SetField(usercredential.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := ucu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usercredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ucu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usercredential.UserTable,
+			Columns: []string{usercredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ucu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usercredential.UserTable,
+			Columns: []string{usercredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usercredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ucu.mutation.done = true
+	return n, nil
+}
+
+// UserCredentialUpdateOne is the builder for updating a single UserCredential entity.
+type UserCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserCredentialMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ucuo *UserCredentialUpdateOne) SetUserID(i int) *UserCredentialUpdateOne {
+	ucuo.mutation.SetUserID(i)
+	return ucuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ucuo *UserCredentialUpdateOne) SetNillableUserID(i *int) *UserCredentialUpdateOne {
+	if i != nil {
+		ucuo.SetUserID(*i)
+	}
+	return ucuo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (ucuo *UserCredentialUpdateOne) SetPasswordHash(s string) *UserCredentialUpdateOne {
+	ucuo.mutation.SetPasswordHash(s)
+	return ucuo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (ucuo *UserCredentialUpdateOne) SetNillablePasswordHash(s *string) *UserCredentialUpdateOne {
+	if s != nil {
+		ucuo.SetPasswordHash(*s)
+	}
+	return ucuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ucuo *UserCredentialUpdateOne) SetUpdatedAt(t time.Time) *UserCredentialUpdateOne {
+	ucuo.mutation.SetUpdatedAt(t)
+	return ucuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ucuo *UserCredentialUpdateOne) SetUser(u *User) *UserCredentialUpdateOne {
+	return ucuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserCredentialMutation object of the builder.
+func (ucuo *UserCredentialUpdateOne) Mutation() *UserCredentialMutation {
+	return ucuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ucuo *UserCredentialUpdateOne) ClearUser() *UserCredentialUpdateOne {
+	ucuo.mutation.ClearUser()
+	return ucuo
+}
+
+// Where appends a list predicates to the UserCredentialUpdate builder.
+func (ucuo *UserCredentialUpdateOne) Where(ps ...predicate.UserCredential) *UserCredentialUpdateOne {
+	ucuo.mutation.Where(ps...)
+	return ucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UserCredentialUpdateOne) Select(field string, fields ...string) *UserCredentialUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UserCredential entity.
+func (ucuo *UserCredentialUpdateOne) Save(ctx context.Context) (*UserCredential, error) {
+	ucuo.defaults()
+	return withHooks(ctx, ucuo.sqlSave, ucuo.mutation, ucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UserCredentialUpdateOne) SaveX(ctx context.Context) *UserCredential {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UserCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UserCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucuo *UserCredentialUpdateOne) defaults() {
+	if _, ok := ucuo.mutation.UpdatedAt(); !ok {
+		v := usercredential.UpdateDefaultUpdatedAt()
+		ucuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UserCredentialUpdateOne) check() error {
+	if _, ok := ucuo.mutation.UserID(); ucuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserCredential.user"`)
+	}
+	return nil
+}
+
+func (ucuo *UserCredentialUpdateOne) sqlSave(ctx context.Context) (_node *UserCredential, err error) {
+	if err := ucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usercredential.Table, usercredential.Columns, sqlgraph.NewFieldSpec(usercredential.FieldID, field.TypeInt))
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usercredential.FieldID)
+		for _, f := range fields {
+			if !usercredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usercredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucuo.mutation.PasswordHash(); ok {
+		_spec.SetField(usercredential.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := ucuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usercredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ucuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usercredential.UserTable,
+			Columns: []string{usercredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ucuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usercredential.UserTable,
+			Columns: []string{usercredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserCredential{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usercredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ucuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,601 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/apikey"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/viewer"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// APIKeyHeader carries the API key of a service.
+	APIKeyHeader = "X-API-Key"
+	// tokenLifetime is how long a user's bearer token is valid for.
+	tokenLifetime  = time.Hour
+	tokenIssuer    = "loans"
+	principalKey   = "principal"
+	minPasswordLen = 12
+)
+
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// Principal is who a request is authenticated as: another service with an API key, or a user
+// with a bearer token or session cookie.
+type Principal struct {
+	Service   string // the API key's name, empty for users
+	ApiKeyId  int
+	UserId    int              // zero for services
+	Roles     []staffrole.Role // the user's staff roles, or the API key's
+	SessionId int              // set for users signed in with the identity provider
+}
+
+// IsUser reports whether the principal is an end user rather than a service.
+func (p Principal) IsUser() bool {
+	return p.UserId != 0
+}
+
+// Viewer is who the ent privacy policies see the principal as: services and staff what their roles
+// let them, and other users only their own loans.
+func (p Principal) Viewer() viewer.Viewer {
+	return viewer.Viewer{
+		UserId:     p.UserId,
+		Admin:      p.Can(ManageStaff),
+		Staff:      p.Can(ViewPortfolio),
+		Originates: p.Can(OriginateLoans),
+		Services:   p.Can(ServiceLoans),
+	}
+}
+
+// PrincipalFrom returns the principal a request was authenticated as.
+func PrincipalFrom(ctx *gin.Context) (Principal, bool) {
+	value, ok := ctx.Get(principalKey)
+	if !ok {
+		return Principal{}, false
+	}
+	p, ok := value.(Principal)
+	return p, ok
+}
+
+type tokenRequest struct {
+	UserId   int    `json:"userID"`
+	Password string `json:"password"`
+}
+
+type tokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type passwordRequest struct {
+	Password        string `json:"password"`
+	CurrentPassword string `json:"currentPassword"` // required unless staff who manage staff set it
+}
+
+type apiKeyRequest struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles" enums:"loan_officer,servicer,auditor,admin"` // what the service may do, like staff roles
+}
+
+type apiKeyResponse struct {
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+	Roles     []string   `json:"roles"`
+	Key       string     `json:"key,omitempty"` // only returned when the key is created
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// tokenClaims are the claims of a user's JWT bearer token.
+type tokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"` // the user id
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Authenticate identifies the principal making a request from its API key, bearer token or
+// session cookie, rejecting requests without valid credentials.
+func (h Handler) Authenticate(ctx *gin.Context) {
+	var p Principal
+	var err error
+	sessionToken, _ := ctx.Cookie(SessionCookie)
+	switch {
+	case ctx.GetHeader(APIKeyHeader) != "":
+		p, err = h.authenticateAPIKey(ctx, ctx.GetHeader(APIKeyHeader))
+	case strings.HasPrefix(ctx.GetHeader("Authorization"), "Bearer "):
+		p, err = h.authenticateToken(ctx, strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer "))
+	case sessionToken != "":
+		p, err = h.authenticateSession(ctx, sessionToken)
+	default:
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{
+			Message: "authentication required",
+		})
+		return
+	}
+	if errors.Is(err, errInvalidCredentials) {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.Set(principalKey, p)
+	ctx.Request = ctx.Request.WithContext(viewer.NewContext(ctx.Request.Context(), p.Viewer()))
+}
+
+// RequireService lets only services through.
+func (h Handler) RequireService(ctx *gin.Context) {
+	p, ok := requirePrincipal(ctx)
+	if ok && p.IsUser() {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+			Message: "only services are permitted",
+		})
+	}
+}
+
+// RequireSelf lets users through only to endpoints about themselves, the user id param.  Services
+// need a permission instead, see RequireSelfOr.
+func (h Handler) RequireSelf(ctx *gin.Context) {
+	p, ok := requirePrincipal(ctx)
+	if !ok {
+		return
+	}
+	if !p.IsUser() || strconv.Itoa(p.UserId) != ctx.Param("id") {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+			Message: "not permitted for this user",
+		})
+	}
+}
+
+// requirePrincipal returns the principal a request was authenticated as, aborting it if it wasn't.
+func requirePrincipal(ctx *gin.Context) (Principal, bool) {
+	p, ok := PrincipalFrom(ctx)
+	if !ok {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{
+			Message: "authentication required",
+		})
+	}
+	return p, ok
+}
+
+// @Summary Creates Token
+// @Schemes
+// @Description Signs a user in with their password, returning a bearer token for the `Authorization` header
+// @Accept json
+// @Produce json
+// @Param tokenRequest body tokenRequest true "Token Request"
+// @Success 200 {object} tokenResponse
+// @Router /auth/token [post]
+func (h Handler) CreateToken(ctx *gin.Context) {
+	var req tokenRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "token input malformed",
+		})
+		return
+	}
+
+	credential, err := h.Ent.UserCredential.Query().
+		Where(usercredential.UserID(req.UserId)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if credential == nil || bcrypt.CompareHashAndPassword([]byte(credential.PasswordHash), []byte(req.Password)) != nil {
+		ctx.JSON(http.StatusUnauthorized, ErrorResponse{
+			Message: errInvalidCredentials.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(tokenLifetime).Truncate(time.Second)
+	token, err := h.signToken(tokenClaims{
+		Issuer:    tokenIssuer,
+		Subject:   strconv.Itoa(req.UserId),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// @Summary Sets Password
+// @Schemes
+// @Description Sets the password a user signs in with.  Users changing their own password must give their
+// @Description current one, only staff who manage staff can set it without.
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Param passwordRequest body passwordRequest true "Password Request"
+// @Success 200
+// @Router /user/{userid}/password [post]
+func (h Handler) SetPassword(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	userId, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	userExists, err := h.Ent.User.Query().Where(user.ID(userId)).Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if !userExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user doesn't exist",
+		})
+		return
+	}
+
+	var req passwordRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "password input malformed",
+		})
+		return
+	}
+
+	if len(req.Password) < minPasswordLen {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "password must be at least 12 characters",
+		})
+		return
+	}
+
+	// users prove they know their password before changing it, so a stolen token or session
+	// can't take the account over for good
+	if p, _ := PrincipalFrom(ctx); !p.Can(ManageStaff) {
+		credential, err := h.Ent.UserCredential.Query().
+			Where(usercredential.UserID(userId)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "internal error",
+			})
+			return
+		}
+		if credential == nil || bcrypt.CompareHashAndPassword([]byte(credential.PasswordHash), []byte(req.CurrentPassword)) != nil {
+			ctx.JSON(http.StatusUnauthorized, ErrorResponse{
+				Message: "current password is incorrect",
+			})
+			return
+		}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	updated, err := h.Ent.UserCredential.Update().
+		Where(usercredential.UserID(userId)).
+		SetPasswordHash(string(hash)).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		err = h.Ent.UserCredential.Create().
+			SetUserID(userId).
+			SetPasswordHash(string(hash)).
+			Exec(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+}
+
+// @Summary Creates API Key
+// @Schemes
+// @Description Creates an API key for another service to send in the `X-API-Key` header, with staff roles
+// @Description that give it permissions
+// @Accept json
+// @Produce json
+// @Param apiKeyRequest body apiKeyRequest true "API Key Request"
+// @Success 200 {object} apiKeyResponse
+// @Router /apikeys [post]
+func (h Handler) CreateAPIKey(ctx *gin.Context) {
+	var req apiKeyRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "api key input malformed",
+		})
+		return
+	}
+
+	if req.Name == "" {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "api key must have a name",
+		})
+		return
+	}
+	if len(req.Roles) == 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "api key must have a role",
+		})
+		return
+	}
+	for _, r := range req.Roles {
+		if err := staffrole.RoleValidator(staffrole.Role(r)); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+				Message: "roles must be loan_officer, servicer, auditor or admin",
+			})
+			return
+		}
+	}
+
+	key, err := NewAPIKey()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	k, err := h.Ent.ApiKey.Create().
+		SetName(req.Name).
+		SetRoles(req.Roles).
+		SetKeyHash(HashAPIKey(key)).
+		Save(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := toAPIKeyResponse(k)
+	response.Key = key
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Gets API Keys
+// @Schemes
+// @Description Gets every API key, without the keys themselves
+// @Accept json
+// @Produce json
+// @Success 200 {array} apiKeyResponse
+// @Router /apikeys [get]
+func (h Handler) GetAPIKeys(ctx *gin.Context) {
+	keys, err := h.Ent.ApiKey.Query().
+		Order(ent.Asc(apikey.FieldID)).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []apiKeyResponse{}
+	for _, k := range keys {
+		response = append(response, toAPIKeyResponse(k))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Revokes API Key
+// @Schemes
+// @Description Revokes an API key so it no longer authenticates
+// @Accept json
+// @Produce json
+// @Param apikeyid path int true "API Key Id"
+// @Success 200
+// @Router /apikeys/{apikeyid} [delete]
+func (h Handler) RevokeAPIKey(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	k, err := h.Ent.ApiKey.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find api key",
+		})
+		return
+	}
+
+	if !k.RevokedAt.IsZero() {
+		return
+	}
+
+	err = k.Update().
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+}
+
+// NewAPIKey makes a random API key.
+func NewAPIKey() (string, error) {
+	key, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	return "lk_" + key, nil
+}
+
+// HashAPIKey is the hash an API key is stored and looked up by.
+func HashAPIKey(key string) string {
+	return hashToken(key)
+}
+
+// randomToken makes 32 random bytes, base64url encoded.
+func randomToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// hashToken is the hash secret tokens are stored and looked up by, so they can't be recovered.
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func (h Handler) authenticateAPIKey(ctx *gin.Context, key string) (Principal, error) {
+	k, err := h.Ent.ApiKey.Query().
+		Where(
+			apikey.KeyHash(HashAPIKey(key)),
+			apikey.RevokedAtIsNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return Principal{}, errInvalidCredentials
+	}
+	if err != nil {
+		return Principal{}, err
+	}
+	roles := []staffrole.Role{}
+	for _, r := range k.Roles {
+		roles = append(roles, staffrole.Role(r))
+	}
+	return Principal{Service: k.Name, ApiKeyId: k.ID, Roles: roles}, nil
+}
+
+func (h Handler) authenticateToken(ctx *gin.Context, token string) (Principal, error) {
+	claims, err := h.verifyToken(token)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return Principal{}, errInvalidCredentials
+	}
+	userExists, err := h.userExists(ctx, userId)
+	if err != nil {
+		return Principal{}, err
+	}
+	if !userExists {
+		return Principal{}, errInvalidCredentials
+	}
+
+	roles, err := h.userRoles(ctx, userId)
+	if err != nil {
+		return Principal{}, err
+	}
+	return Principal{UserId: userId, Roles: roles}, nil
+}
+
+// jwtHeader is the only header tokens are signed with, HMAC SHA-256.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// signToken makes a JWT of the claims signed with the handler's JWT key.
+func (h Handler) signToken(claims tokenClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + h.tokenSignature(unsigned), nil
+}
+
+func (h Handler) tokenSignature(unsigned string) string {
+	mac := hmac.New(sha256.New, h.JWTKey)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyToken returns the claims of a JWT signed with the handler's JWT key that hasn't expired.
+// Tokens with any other header, including other algorithms, are rejected.
+func (h Handler) verifyToken(token string) (tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return tokenClaims{}, errInvalidCredentials
+	}
+	unsigned := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(h.tokenSignature(unsigned))) {
+		return tokenClaims{}, errInvalidCredentials
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return tokenClaims{}, errInvalidCredentials
+	}
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return tokenClaims{}, errInvalidCredentials
+	}
+	if claims.Issuer != tokenIssuer || time.Now().Unix() >= claims.ExpiresAt {
+		return tokenClaims{}, errInvalidCredentials
+	}
+	return claims, nil
+}
+
+func toAPIKeyResponse(k *ent.ApiKey) apiKeyResponse {
+	response := apiKeyResponse{
+		Id:        k.ID,
+		Name:      k.Name,
+		Roles:     k.Roles,
+		CreatedAt: k.CreatedAt,
+	}
+	if !k.RevokedAt.IsZero() {
+		response.RevokedAt = &k.RevokedAt
+	}
+	return response
+}
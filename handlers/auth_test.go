@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerifyToken(t *testing.T) {
+	h := Handler{JWTKey: []byte("test jwt key")}
+	now := time.Now()
+	valid, err := h.signToken(tokenClaims{Issuer: tokenIssuer, Subject: "1", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("could not sign token: %v", err)
+	}
+	expired, err := h.signToken(tokenClaims{Issuer: tokenIssuer, Subject: "1", IssuedAt: now.Add(-2 * time.Hour).Unix(), ExpiresAt: now.Add(-time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("could not sign token: %v", err)
+	}
+	otherKey, err := Handler{JWTKey: []byte("other key")}.signToken(tokenClaims{Issuer: tokenIssuer, Subject: "1", ExpiresAt: now.Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("could not sign token: %v", err)
+	}
+	parts := strings.Split(valid, ".")
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + parts[1] + "."
+	otherSubject := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"loans","sub":"2","exp":9999999999}`)) + "." + parts[2]
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{name: "valid", token: valid, valid: true},
+		{name: "expired", token: expired},
+		{name: "other key", token: otherKey},
+		{name: "unsigned", token: unsigned},
+		{name: "changed claims", token: otherSubject},
+		{name: "malformed", token: "token"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := h.verifyToken(tc.token)
+			if tc.valid && (err != nil || claims.Subject != "1") {
+				t.Errorf("unexpected claims: %+v, error: %v", claims, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	stranger := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	r := newAnonymousTestRouter()
+	r.POST("/auth/token", h.CreateToken)
+	api := r.Group("/", h.Authenticate)
+	api.POST("/apikeys", h.RequirePermission(ManageStaff), h.CreateAPIKey)
+	api.DELETE("/apikeys/:id", h.RequirePermission(ManageStaff), h.RevokeAPIKey)
+	api.POST("/user/:id/password", h.RequireSelfOr(ManageStaff), h.SetPassword)
+	api.GET("/loan/:id", h.RequireViewBalance, h.GetLoan)
+
+	key, err := NewAPIKey()
+	if err != nil {
+		t.Fatalf("could not make api key: %v", err)
+	}
+	if w := serveTestRequest(t, r, "POST", "/apikeys", apiKeyRequest{Name: "billing", Roles: []string{"admin"}}, APIKeyHeader, key); w.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown api key was accepted: %v", w.Code)
+	}
+	err = h.Ent.ApiKey.Create().
+		SetName("default").
+		SetRoles([]string{"admin"}).
+		SetKeyHash(HashAPIKey(key)).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatalf("could not create api key: %v", err)
+	}
+
+	createKey := func(req apiKeyRequest) apiKeyResponse {
+		w := serveTestRequest(t, r, "POST", "/apikeys", req, APIKeyHeader, key)
+		created := decodeTestResponse[apiKeyResponse](t, w)
+		if created.Key == "" || created.Name != req.Name {
+			t.Fatalf("unexpected api key: %+v", created)
+		}
+		return created
+	}
+	billing := createKey(apiKeyRequest{Name: "billing", Roles: []string{"admin"}})
+	reporting := createKey(apiKeyRequest{Name: "reporting", Roles: []string{"auditor"}})
+
+	for _, u := range []int{l.BorrowerID, stranger} {
+		w := serveTestRequest(t, r, "POST", "/user/"+strconv.Itoa(u)+"/password", passwordRequest{Password: "correct horse battery"}, APIKeyHeader, billing.Key)
+		if w.Code != http.StatusOK {
+			t.Fatalf("could not set password: %v", w.Body.String())
+		}
+	}
+	if w := serveTestRequest(t, r, "POST", "/auth/token", tokenRequest{UserId: l.BorrowerID, Password: "wrong horse battery"}); w.Code != http.StatusUnauthorized {
+		t.Errorf("wrong password was accepted: %v", w.Code)
+	}
+
+	bearer := func(userId int) string {
+		w := serveTestRequest(t, r, "POST", "/auth/token", tokenRequest{UserId: userId, Password: "correct horse battery"})
+		token := decodeTestResponse[tokenResponse](t, w)
+		return "Bearer " + token.Token
+	}
+	borrower := bearer(l.BorrowerID)
+
+	for _, tc := range []struct {
+		name         string
+		method       string
+		path         string
+		body         any
+		header       string
+		value        string
+		expectedCode int
+	}{
+		{name: "no credentials", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), expectedCode: http.StatusUnauthorized},
+		{name: "borrower", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: "Authorization", value: borrower, expectedCode: http.StatusOK},
+		{name: "stranger", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: "Authorization", value: bearer(stranger), expectedCode: http.StatusNotFound},
+		{name: "service", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: APIKeyHeader, value: billing.Key, expectedCode: http.StatusOK},
+		{name: "bad token", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: "Authorization", value: borrower + "x", expectedCode: http.StatusUnauthorized},
+		{name: "user creating api key", method: "POST", path: "/apikeys", body: apiKeyRequest{Name: "mine", Roles: []string{"auditor"}}, header: "Authorization", value: borrower, expectedCode: http.StatusForbidden},
+		{name: "api key without roles", method: "POST", path: "/apikeys", body: apiKeyRequest{Name: "none"}, header: APIKeyHeader, value: key, expectedCode: http.StatusUnprocessableEntity},
+		{name: "api key with unknown role", method: "POST", path: "/apikeys", body: apiKeyRequest{Name: "teller", Roles: []string{"teller"}}, header: APIKeyHeader, value: key, expectedCode: http.StatusUnprocessableEntity},
+		{name: "read only service", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: APIKeyHeader, value: reporting.Key, expectedCode: http.StatusOK},
+		{name: "read only service creating api key", method: "POST", path: "/apikeys", body: apiKeyRequest{Name: "mine", Roles: []string{"admin"}}, header: APIKeyHeader, value: reporting.Key, expectedCode: http.StatusForbidden},
+		{name: "read only service setting password", method: "POST", path: "/user/" + strconv.Itoa(stranger) + "/password", body: passwordRequest{Password: "correct horse battery"}, header: APIKeyHeader, value: reporting.Key, expectedCode: http.StatusForbidden},
+		{name: "someone else's password", method: "POST", path: "/user/" + strconv.Itoa(stranger) + "/password", body: passwordRequest{Password: "correct horse battery"}, header: "Authorization", value: borrower, expectedCode: http.StatusForbidden},
+		{name: "own password without the current one", method: "POST", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/password", body: passwordRequest{Password: "stolen horse battery"}, header: "Authorization", value: borrower, expectedCode: http.StatusUnauthorized},
+		{name: "own password with the wrong one", method: "POST", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/password", body: passwordRequest{Password: "stolen horse battery", CurrentPassword: "wrong horse battery"}, header: "Authorization", value: borrower, expectedCode: http.StatusUnauthorized},
+		{name: "own password", method: "POST", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/password", body: passwordRequest{Password: "correct horse battery staple", CurrentPassword: "correct horse battery"}, header: "Authorization", value: borrower, expectedCode: http.StatusOK},
+		{name: "revoking a key", method: "DELETE", path: "/apikeys/" + strconv.Itoa(billing.Id), header: APIKeyHeader, value: key, expectedCode: http.StatusOK},
+		{name: "after revoking", method: "GET", path: "/loan/" + strconv.Itoa(l.ID), header: APIKeyHeader, value: billing.Key, expectedCode: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := serveTestRequest(t, r, tc.method, tc.path, tc.body, tc.header, tc.value)
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRequirePrincipal(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 1000, 0.05, 12)
+
+	r := newAnonymousTestRouter()
+	r.POST("/apikeys", h.RequirePermission(ManageStaff), h.CreateAPIKey)
+	r.POST("/user/:id/password", h.RequireSelf, h.SetPassword)
+	r.GET("/user/:id/loans", h.RequireSelfOr(ViewPortfolio), h.GetLoans)
+	r.GET("/loan/:id", h.RequireViewBalance, h.GetLoan)
+
+	for _, tc := range []struct {
+		method string
+		path   string
+	}{
+		{method: "POST", path: "/apikeys"},
+		{method: "POST", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/password"},
+		{method: "GET", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/loans"},
+		{method: "GET", path: "/loan/" + strconv.Itoa(l.ID)},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			w := serveTestRequest(t, r, tc.method, tc.path, "{}")
+
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("request without a principal wasn't rejected: %v", w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,584 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/collateral"
+	"github.com/crusyn/loans/ent/collateralappraisal"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type newCollateralRequest struct {
+	Kind        string  `json:"kind" enums:"real_estate,vehicle,equipment"`
+	Description string  `json:"description"`
+	Value       float64 `json:"value"`     // the first appraisal
+	Appraised   string  `json:"appraised"` // YYYY-MM-DD, defaults to today
+	Loans       []int   `json:"loanIDs"`   // loans the collateral secures
+}
+
+type newCollateralResponse struct {
+	CollateralId int `json:"newCollateralId"`
+}
+
+type appraisalRequest struct {
+	Value float64 `json:"value"`
+	Date  string  `json:"date"` // YYYY-MM-DD, defaults to today
+}
+
+type collateralLoanRequest struct {
+	LoanId int `json:"loanID"`
+}
+
+type appraisalResponse struct {
+	Value float64 `json:"value"`
+	Date  string  `json:"date"`
+}
+
+type collateralResponse struct {
+	Id          int                 `json:"id"`
+	Kind        string              `json:"kind"`
+	Description string              `json:"description,omitempty"`
+	Appraisals  []appraisalResponse `json:"appraisals"`
+	Loans       []int               `json:"loanIDs"`
+}
+
+type loanBalanceResponse struct {
+	LoanId       int     `json:"loanID"`
+	PaymentsMade int     `json:"paymentsMade"`
+	Balance      float64 `json:"balance"`
+}
+
+type collateralLTVResponse struct {
+	CollateralId    int                   `json:"collateralID"`
+	AsOf            string                `json:"asOf"`
+	Value           float64               `json:"value"`
+	Loans           []loanBalanceResponse `json:"loans"`
+	CombinedBalance float64               `json:"combinedBalance"`
+	CombinedLTV     float64               `json:"combinedLTV"`
+}
+
+type loanLTVResponse struct {
+	LoanId          int     `json:"loanID"`
+	AsOf            string  `json:"asOf"`
+	Balance         float64 `json:"balance"`
+	CollateralValue float64 `json:"collateralValue"` // every collateral securing the loan
+	LTV             float64 `json:"ltv"`
+	// CombinedBalance includes every loan secured by the same collateral.
+	CombinedBalance float64 `json:"combinedBalance"`
+	CombinedLTV     float64 `json:"combinedLTV"`
+}
+
+// @Summary Creates Collateral
+// @Schemes
+// @Description Creates collateral with its first appraisal, securing any number of loans
+// @Accept json
+// @Produce json
+// @Param newCollateralRequest body newCollateralRequest true "New Collateral Request"
+// @Success 200 {object} newCollateralResponse
+// @Router /collateral [post]
+func (h Handler) CreateCollateral(ctx *gin.Context) {
+	var req newCollateralRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "new collateral input malformed",
+		})
+		return
+	}
+
+	appraised, err := parseDate(req.Appraised)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "appraised must be a YYYY-MM-DD date",
+		})
+		return
+	}
+
+	if err := req.validate(); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	loansExist, err := h.Ent.Loan.Query().Where(loan.IDIn(req.Loans...)).Count(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if loansExist != len(req.Loans) {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan doesn't exist",
+		})
+		return
+	}
+
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	c, err := tx.Collateral.Create().
+		SetKind(collateral.Kind(req.Kind)).
+		SetDescription(req.Description).
+		AddLoanIDs(req.Loans...).
+		Save(ctx)
+	if err == nil {
+		err = tx.CollateralAppraisal.Create().
+			SetCollateral(c).
+			SetValue(int(math.Round(req.Value * 100))).
+			SetAppraisedAt(appraised).
+			Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCollateralResponse{
+		CollateralId: c.ID,
+	})
+}
+
+// @Summary Gets Collateral
+// @Schemes
+// @Description Gets collateral with its appraisals and the loans it secures
+// @Accept json
+// @Produce json
+// @Param collateralid path int true "Collateral Id"
+// @Success 200 {object} collateralResponse
+// @Router /collateral/{collateralid} [get]
+func (h Handler) GetCollateral(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	h.respondWithCollateral(ctx, i)
+}
+
+// @Summary Appraises Collateral
+// @Schemes
+// @Description Records a new appraisal of collateral.  It is the collateral's value from its date.
+// @Accept json
+// @Produce json
+// @Param collateralid path int true "Collateral Id"
+// @Param appraisalRequest body appraisalRequest true "Appraisal Request"
+// @Success 200 {object} collateralResponse
+// @Router /collateral/{collateralid}/appraisals [post]
+func (h Handler) AppraiseCollateral(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	c, err := h.Ent.Collateral.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find collateral",
+		})
+		return
+	}
+
+	var req appraisalRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "appraisal input malformed",
+		})
+		return
+	}
+
+	appraised, err := parseDate(req.Date)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "date must be a YYYY-MM-DD date",
+		})
+		return
+	}
+	if req.Value <= 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "appraised value must be positive",
+		})
+		return
+	}
+
+	err = h.Ent.CollateralAppraisal.Create().
+		SetCollateral(c).
+		SetValue(int(math.Round(req.Value * 100))).
+		SetAppraisedAt(appraised).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithCollateral(ctx, c.ID)
+}
+
+// @Summary Secures Loan With Collateral
+// @Schemes
+// @Description Links collateral to another loan it secures
+// @Accept json
+// @Produce json
+// @Param collateralid path int true "Collateral Id"
+// @Param collateralLoanRequest body collateralLoanRequest true "Loan Request"
+// @Success 200 {object} collateralResponse
+// @Router /collateral/{collateralid}/loans [post]
+func (h Handler) AddCollateralLoan(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	c, err := h.Ent.Collateral.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find collateral",
+		})
+		return
+	}
+
+	var req collateralLoanRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "collateral loan input malformed",
+		})
+		return
+	}
+
+	loanExists, err := h.Ent.Loan.Query().Where(loan.ID(req.LoanId)).Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if !loanExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan doesn't exist",
+		})
+		return
+	}
+
+	err = c.Update().
+		AddLoanIDs(req.LoanId).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithCollateral(ctx, c.ID)
+}
+
+// @Summary Gets Collateral Loan-To-Value
+// @Schemes
+// @Description Gets the combined loan-to-value of every loan the collateral secures on a date, using the
+// @Description latest appraisal and each loan's scheduled balance after the payments due by then
+// @Accept json
+// @Produce json
+// @Param collateralid path int true "Collateral Id"
+// @Param date query string false "As of date, YYYY-MM-DD, defaults to today"
+// @Success 200 {object} collateralLTVResponse
+// @Router /collateral/{collateralid}/ltv [get]
+func (h Handler) GetCollateralLTV(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	asOf, err := parseDate(ctx.Query("date"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "date must be a YYYY-MM-DD date",
+		})
+		return
+	}
+
+	c, err := h.Ent.Collateral.Query().
+		Where(collateral.ID(i)).
+		WithAppraisals().
+		WithLoans(func(q *ent.LoanQuery) {
+			q.Order(ent.Asc(loan.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find collateral",
+		})
+		return
+	}
+
+	value, ok := appraisedValue(c.Edges.Appraisals, asOf)
+	if !ok {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "collateral had not been appraised by this date",
+		})
+		return
+	}
+
+	response := collateralLTVResponse{
+		CollateralId: c.ID,
+		AsOf:         asOf.Format(dateLayout),
+		Value:        float64(value) / 100,
+		Loans:        []loanBalanceResponse{},
+	}
+	for _, l := range c.Edges.Loans {
+		balance, err := h.loanBalanceOn(ctx, l, asOf)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "could not generate amortization schedule",
+			})
+			return
+		}
+		response.Loans = append(response.Loans, balance)
+		response.CombinedBalance = roundCents(response.CombinedBalance + balance.Balance)
+	}
+	response.CombinedLTV = loanToValue(response.CombinedBalance, response.Value)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Gets Loan-To-Value
+// @Schemes
+// @Description Gets a loan's loan-to-value on a date against all of its collateral, and the combined
+// @Description loan-to-value of every loan secured by that collateral
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param date query string false "As of date, YYYY-MM-DD, defaults to today"
+// @Success 200 {object} loanLTVResponse
+// @Router /loan/{loanid}/ltv [get]
+func (h Handler) GetLoanLTV(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	asOf, err := parseDate(ctx.Query("date"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "date must be a YYYY-MM-DD date",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	collaterals, err := l.QueryCollateral().
+		WithAppraisals().
+		WithLoans().
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	value := 0
+	securedLoans := map[int]*ent.Loan{}
+	for _, c := range collaterals {
+		v, ok := appraisedValue(c.Edges.Appraisals, asOf)
+		if !ok {
+			continue
+		}
+		value = value + v
+		for _, secured := range c.Edges.Loans {
+			securedLoans[secured.ID] = secured
+		}
+	}
+	if value == 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan had no appraised collateral by this date",
+		})
+		return
+	}
+
+	response := loanLTVResponse{
+		LoanId:          l.ID,
+		AsOf:            asOf.Format(dateLayout),
+		CollateralValue: float64(value) / 100,
+	}
+	for _, secured := range securedLoans {
+		balance, err := h.loanBalanceOn(ctx, secured, asOf)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "could not generate amortization schedule",
+			})
+			return
+		}
+		if secured.ID == l.ID {
+			response.Balance = balance.Balance
+		}
+		response.CombinedBalance = roundCents(response.CombinedBalance + balance.Balance)
+	}
+	response.LTV = loanToValue(response.Balance, response.CollateralValue)
+	response.CombinedLTV = loanToValue(response.CombinedBalance, response.CollateralValue)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (r newCollateralRequest) validate() error {
+	switch collateral.Kind(r.Kind) {
+	case collateral.KindRealEstate, collateral.KindVehicle, collateral.KindEquipment:
+	default:
+		return errors.New("kind must be real_estate, vehicle or equipment")
+	}
+	if r.Value <= 0 {
+		return errors.New("appraised value must be positive")
+	}
+	return nil
+}
+
+func (h Handler) respondWithCollateral(ctx *gin.Context, collateralId int) {
+	c, err := h.Ent.Collateral.Query().
+		Where(collateral.ID(collateralId)).
+		WithAppraisals(func(q *ent.CollateralAppraisalQuery) {
+			q.Order(ent.Asc(collateralappraisal.FieldAppraisedAt), ent.Asc(collateralappraisal.FieldID))
+		}).
+		WithLoans(func(q *ent.LoanQuery) {
+			q.Order(ent.Asc(loan.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find collateral",
+		})
+		return
+	}
+
+	response := collateralResponse{
+		Id:          c.ID,
+		Kind:        c.Kind.String(),
+		Description: c.Description,
+		Appraisals:  []appraisalResponse{},
+		Loans:       []int{},
+	}
+	for _, a := range c.Edges.Appraisals {
+		response.Appraisals = append(response.Appraisals, appraisalResponse{
+			Value: float64(a.Value) / 100,
+			Date:  toDate(a.AppraisedAt).Format(dateLayout),
+		})
+	}
+	for _, l := range c.Edges.Loans {
+		response.Loans = append(response.Loans, l.ID)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// loanBalanceOn is a loan's scheduled balance after the payments due by asOf.
+func (h Handler) loanBalanceOn(ctx context.Context, l *ent.Loan, asOf time.Time) (loanBalanceResponse, error) {
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		return loanBalanceResponse{}, err
+	}
+
+	paymentsMade := monthsElapsed(toDate(l.OriginatedAt), asOf)
+	balance := float64(l.Amount) / 100
+	switch {
+	case paymentsMade >= len(schedule):
+		balance = 0
+	case paymentsMade > 0:
+		balance = schedule[paymentsMade-1].EndingBalance
+	case l.ConstructionMonths > 0:
+		balance = 0
+	}
+
+	return loanBalanceResponse{
+		LoanId:       l.ID,
+		PaymentsMade: min(paymentsMade, len(schedule)),
+		Balance:      balance,
+	}, nil
+}
+
+// monthsElapsed counts the whole months from one date to another, zero if to is before from.
+func monthsElapsed(from time.Time, to time.Time) int {
+	months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	if to.Day() < from.Day() {
+		months = months - 1
+	}
+	return max(months, 0)
+}
+
+// appraisedValue is the value of the latest appraisal on or before asOf, in cents.
+func appraisedValue(appraisals []*ent.CollateralAppraisal, asOf time.Time) (int, bool) {
+	var latest *ent.CollateralAppraisal
+	for _, a := range appraisals {
+		appraised := toDate(a.AppraisedAt)
+		if appraised.After(asOf) {
+			continue
+		}
+		if latest == nil || appraised.After(toDate(latest.AppraisedAt)) ||
+			(appraised.Equal(toDate(latest.AppraisedAt)) && a.ID > latest.ID) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return 0, false
+	}
+	return latest.Value, true
+}
+
+// loanToValue is balance over value, rounded to four places.
+func loanToValue(balance float64, value float64) float64 {
+	return math.Round(balance/value*10000) / 10000
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMonthsElapsed(t *testing.T) {
+	from := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name     string
+		to       time.Time
+		expected int
+	}{
+		{name: "before", to: from.AddDate(0, 0, -1), expected: 0},
+		{name: "same day", to: from, expected: 0},
+		{name: "day before a month", to: time.Date(2024, time.February, 14, 0, 0, 0, 0, time.UTC), expected: 0},
+		{name: "a month", to: time.Date(2024, time.February, 15, 0, 0, 0, 0, time.UTC), expected: 1},
+		{name: "over a year", to: time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), expected: 14},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := monthsElapsed(from, tc.to); got != tc.expected {
+				t.Errorf("unexpected months elapsed, want: %d, got: %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCollateralLTV(t *testing.T) {
+	h := newTestHandler(t)
+	first := createTestLoan(t, h, 200000, 0.06, 360)
+	second := createTestLoan(t, h, 50000, 0.08, 120)
+	originated := toDate(first.OriginatedAt)
+
+	w := callTestHandler(t, h.CreateCollateral, "POST", "", newCollateralRequest{Kind: "boat", Value: 300000, Loans: []int{first.ID}})
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected status code for unknown kind, want: %v, got: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	created := decodeTestResponse[newCollateralResponse](t, callTestHandler(t, h.CreateCollateral, "POST", "", newCollateralRequest{
+		Kind:      "real_estate",
+		Value:     300000,
+		Appraised: originated.Format(dateLayout),
+		Loans:     []int{first.ID},
+	}))
+	collateral := idParam(created.CollateralId)
+
+	w = callTestHandler(t, h.AddCollateralLoan, "POST", "", collateralLoanRequest{LoanId: second.ID}, collateral)
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not secure second loan: %v", w.Body.String())
+	}
+
+	c := decodeTestResponse[collateralResponse](t, callTestHandler(t, h.AppraiseCollateral, "POST", "",
+		appraisalRequest{Value: 320000, Date: originated.AddDate(0, 6, 0).Format(dateLayout)}, collateral))
+	if len(c.Appraisals) != 2 || c.Appraisals[1].Value != 320000 || len(c.Loans) != 2 {
+		t.Errorf("unexpected collateral: %+v", c)
+	}
+
+	firstSchedule, err := CreateAmortizationSchedule(200000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	secondSchedule, err := CreateAmortizationSchedule(50000, 0.08, 120)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name             string
+		date             time.Time
+		expectedCode     int
+		expectedValue    float64
+		expectedBalances []float64
+	}{
+		{
+			name:         "before appraisal",
+			date:         originated.AddDate(0, 0, -1),
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:             "at origination",
+			date:             originated,
+			expectedCode:     http.StatusOK,
+			expectedValue:    300000,
+			expectedBalances: []float64{200000, 50000},
+		},
+		{
+			name:             "after reappraisal",
+			date:             originated.AddDate(0, 12, 0),
+			expectedCode:     http.StatusOK,
+			expectedValue:    320000,
+			expectedBalances: []float64{firstSchedule[11].EndingBalance, secondSchedule[11].EndingBalance},
+		},
+		{
+			name:             "second loan paid off",
+			date:             originated.AddDate(0, 120, 0),
+			expectedCode:     http.StatusOK,
+			expectedValue:    320000,
+			expectedBalances: []float64{firstSchedule[119].EndingBalance, 0},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.GetCollateralLTV, "GET", "date="+tc.date.Format(dateLayout), nil, collateral)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[collateralLTVResponse](t, w)
+			if resp.Value != tc.expectedValue || len(resp.Loans) != 2 {
+				t.Fatalf("unexpected loan-to-value: %+v", resp)
+			}
+			combined := 0.0
+			for i, balance := range tc.expectedBalances {
+				if resp.Loans[i].Balance != balance {
+					t.Errorf("unexpected balance of loan %d, want: %v, got: %v", resp.Loans[i].LoanId, balance, resp.Loans[i].Balance)
+				}
+				combined = roundCents(combined + balance)
+			}
+			if want := loanToValue(combined, tc.expectedValue); resp.CombinedLTV != want {
+				t.Errorf("unexpected combined loan-to-value, want: %v, got: %v", want, resp.CombinedLTV)
+			}
+		})
+	}
+
+	resp := decodeTestResponse[loanLTVResponse](t, callTestHandler(t, h.GetLoanLTV, "GET", "date="+originated.Format(dateLayout), nil, idParam(second.ID)))
+	if resp.Balance != 50000 || resp.LTV != 0.1667 || resp.CombinedBalance != 250000 || resp.CombinedLTV != 0.8333 {
+		t.Errorf("unexpected loan loan-to-value: %+v", resp)
+	}
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const maxCompareScenarios = 10
+
+type loanScenario struct {
+	Name    string      `json:"name"`
+	Amount  float64     `json:"amount"`
+	Rate    float64     `json:"rate"`
+	Months  int         `json:"months"`
+	Points  float64     `json:"points"` // discount points paid up front, as a percent of the amount
+	Options loanOptions `json:"options"`
+}
+
+type compareRequest struct {
+	Scenarios []loanScenario `json:"scenarios"`
+}
+
+type costCurvePoint struct {
+	Month          int     `json:"month"`
+	CumulativeCost float64 `json:"cumulativeCost"` // points plus interest paid through this month
+}
+
+type scenarioComparison struct {
+	Name           string           `json:"name"`
+	MonthlyPayment float64          `json:"monthlyPayment"`
+	PayoffMonth    int              `json:"payoffMonth"`
+	PointsCost     float64          `json:"pointsCost"`
+	TotalInterest  float64          `json:"totalInterest"`
+	TotalCost      float64          `json:"totalCost"`
+	BreakEvenMonth *int             `json:"breakEvenMonth"`
+	CostCurve      []costCurvePoint `json:"costCurve"`
+}
+
+type compareResponse struct {
+	Scenarios []scenarioComparison `json:"scenarios"`
+}
+
+// @Summary Compares Loan Scenarios
+// @Schemes
+// @Description Calculates several hypothetical loans side by side.  The first scenario is the baseline:
+// @Description every other scenario that pays points reports the month its cumulative cost (points plus
+// @Description interest) drops to or below the baseline's, or null if it never does.
+// @Accept json
+// @Produce json
+// @Param compareRequest body compareRequest true "Compare Request"
+// @Success 200 {object} compareResponse
+// @Router /compare [post]
+func (h Handler) Compare(ctx *gin.Context) {
+	var req compareRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "compare input malformed",
+		})
+		return
+	}
+
+	if len(req.Scenarios) < 2 || len(req.Scenarios) > maxCompareScenarios {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: fmt.Sprintf("between 2 and %d scenarios are required", maxCompareScenarios),
+		})
+		return
+	}
+
+	for i, s := range req.Scenarios {
+		if err := s.validate(); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+				Message: fmt.Sprintf("scenario %d: %v", i+1, err),
+			})
+			return
+		}
+	}
+
+	response := compareResponse{
+		Scenarios: make([]scenarioComparison, 0, len(req.Scenarios)),
+	}
+
+	for _, s := range req.Scenarios {
+		schedule, err := CreateAmortizationScheduleWithOptions(s.Amount, s.Rate, s.Months, s.Options)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "could not generate amortization schedule",
+			})
+			return
+		}
+		response.Scenarios = append(response.Scenarios, newScenarioComparison(s, schedule))
+	}
+
+	baseline := response.Scenarios[0].CostCurve
+	for i := 1; i < len(response.Scenarios); i++ {
+		if req.Scenarios[i].Points > 0 {
+			response.Scenarios[i].BreakEvenMonth = breakEvenMonth(response.Scenarios[i].CostCurve, baseline)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (s loanScenario) validate() error {
+	if err := validateLoanTerms(s.Amount, s.Rate, s.Months); err != nil {
+		return err
+	}
+	if s.Points < 0 {
+		return errors.New("points cannot be negative")
+	}
+	return s.Options.validate(s.Months)
+}
+
+func newScenarioComparison(s loanScenario, schedule []monthlySummary) scenarioComparison {
+	pointsCents := int(math.Round(s.Amount * s.Points))
+	last := schedule[len(schedule)-1]
+
+	curve := make([]costCurvePoint, 0, len(schedule))
+	for _, m := range schedule {
+		curve = append(curve, costCurvePoint{
+			Month:          m.Month,
+			CumulativeCost: roundCents(float64(pointsCents)/100 + m.TotalInterestPaid),
+		})
+	}
+
+	return scenarioComparison{
+		Name:           s.Name,
+		MonthlyPayment: schedule[0].MonthlyPayment,
+		PayoffMonth:    last.Month,
+		PointsCost:     float64(pointsCents) / 100,
+		TotalInterest:  last.TotalInterestPaid,
+		TotalCost:      curve[len(curve)-1].CumulativeCost,
+		CostCurve:      curve,
+	}
+}
+
+// breakEvenMonth returns the first month in which curve costs no more than baseline.  A curve that has
+// been paid off keeps its final cost for the months after payoff.
+func breakEvenMonth(curve []costCurvePoint, baseline []costCurvePoint) *int {
+	months := len(curve)
+	if len(baseline) > months {
+		months = len(baseline)
+	}
+
+	for m := 1; m <= months; m++ {
+		if costAt(curve, m) <= costAt(baseline, m) {
+			return &m
+		}
+	}
+	return nil
+}
+
+func costAt(curve []costCurvePoint, month int) float64 {
+	if month > len(curve) {
+		month = len(curve)
+	}
+	return curve[month-1].CumulativeCost
+}
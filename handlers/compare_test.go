@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	h := newTestHandler(t)
+
+	thirtyYear := loanScenario{Name: "30 year", Amount: 300000, Rate: 0.065, Months: 360}
+	withPoint := loanScenario{Name: "30 year, 1 point", Amount: 300000, Rate: 0.0625, Months: 360, Points: 1}
+	fifteenYear := loanScenario{Name: "15 year", Amount: 300000, Rate: 0.06, Months: 180}
+
+	for _, tc := range []struct {
+		name              string
+		request           compareRequest
+		expectedCode      int
+		expectedPayments  []float64
+		expectedBreakEven []*int
+	}{
+		{
+			name:              "points break even",
+			request:           compareRequest{Scenarios: []loanScenario{thirtyYear, withPoint, fifteenYear}},
+			expectedCode:      http.StatusOK,
+			expectedPayments:  []float64{1896.22, 1847.17, 2531.59},
+			expectedBreakEven: []*int{nil, intPtr(48), nil},
+		},
+		{
+			name:         "single scenario",
+			request:      compareRequest{Scenarios: []loanScenario{thirtyYear}},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "negative points",
+			request: compareRequest{Scenarios: []loanScenario{
+				thirtyYear,
+				{Amount: 300000, Rate: 0.0625, Months: 360, Points: -1},
+			}},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.Compare, "POST", "", tc.request)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[compareResponse](t, w)
+			for i, s := range resp.Scenarios {
+				if s.MonthlyPayment != tc.expectedPayments[i] {
+					t.Errorf("%s: unexpected monthly payment, want: %v, got: %v", s.Name, tc.expectedPayments[i], s.MonthlyPayment)
+				}
+				if len(s.CostCurve) != s.PayoffMonth {
+					t.Errorf("%s: cost curve has %d points, want %d", s.Name, len(s.CostCurve), s.PayoffMonth)
+				}
+				if s.TotalCost != roundCents(s.PointsCost+s.TotalInterest) {
+					t.Errorf("%s: total cost %v is not points %v plus interest %v", s.Name, s.TotalCost, s.PointsCost, s.TotalInterest)
+				}
+				want, got := tc.expectedBreakEven[i], s.BreakEvenMonth
+				if (want == nil) != (got == nil) || (want != nil && *want != *got) {
+					t.Errorf("%s: unexpected break even month, want: %v, got: %v", s.Name, derefInt(want), derefInt(got))
+				}
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func derefInt(i *int) any {
+	if i == nil {
+		return nil
+	}
+	return *i
+}
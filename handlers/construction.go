@@ -0,0 +1,376 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loandisbursement"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type disbursementRequest struct {
+	Month  int     `json:"month"`
+	Amount float64 `json:"amount"`
+}
+
+type conversionRequest struct {
+	Month int `json:"month"` // the first month of permanent amortization
+}
+
+type disbursementResponse struct {
+	Month  int     `json:"month"`
+	Amount float64 `json:"amount"`
+}
+
+type constructionResponse struct {
+	Committed        float64                `json:"committed"`
+	Disbursed        float64                `json:"disbursed"`
+	Undisbursed      float64                `json:"undisbursed"` // cancelled if still undisbursed at conversion
+	ConversionMonth  int                    `json:"conversionMonth"`
+	PermanentPayment float64                `json:"permanentPayment"`
+	MaturityMonth    int                    `json:"maturityMonth"`
+	Disbursements    []disbursementResponse `json:"disbursements"`
+}
+
+// @Summary Disburses Construction Loan
+// @Schemes
+// @Description Funds part of a construction loan's commitment.  Interest is charged on the disbursement
+// @Description from its month until the loan converts to permanent amortization.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param disbursementRequest body disbursementRequest true "Disbursement Request"
+// @Success 200 {object} constructionResponse
+// @Router /loan/{loanid}/disbursements [post]
+func (h Handler) CreateDisbursement(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	var req disbursementRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "disbursement input malformed",
+		})
+		return
+	}
+
+	// the loan is read and the disbursement checked in the same transaction as it's saved, so
+	// concurrent disbursements can't fund more than the loan amount or land after a conversion
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	l, err := tx.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	disbursements, err := th.loanDisbursements(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	amountCents := int(math.Round(req.Amount * 100))
+	if err := validateDisbursement(l, disbursements, req.Month, amountCents); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = tx.LoanDisbursement.Create().
+		SetLoanID(l.ID).
+		SetMonth(req.Month).
+		SetAmount(amountCents).
+		Exec(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithConstruction(ctx, l)
+}
+
+// @Summary Gets Construction Loan Disbursements
+// @Schemes
+// @Description Gets a construction loan's disbursements, how much of the commitment has been funded and
+// @Description the permanent payment after conversion
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {object} constructionResponse
+// @Router /loan/{loanid}/disbursements [get]
+func (h Handler) GetDisbursements(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	if l.ConstructionMonths == 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan is not a construction loan",
+		})
+		return
+	}
+
+	h.respondWithConstruction(ctx, l)
+}
+
+// @Summary Converts Construction Loan
+// @Schemes
+// @Description Moves the month a construction loan converts to permanent amortization, for example when
+// @Description construction finishes early.  The disbursed principal is amortized over the loan's term from
+// @Description the conversion month and any undisbursed commitment is cancelled.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param conversionRequest body conversionRequest true "Conversion Request"
+// @Success 200 {object} constructionResponse
+// @Router /loan/{loanid}/conversion [post]
+func (h Handler) ConvertLoan(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	var req conversionRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "conversion input malformed",
+		})
+		return
+	}
+
+	// checked in the same transaction as the loan is converted, so disbursements and servicing
+	// events saved meanwhile can't end up on the wrong side of the conversion
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	l, err := tx.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	disbursements, err := th.loanDisbursements(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	firstEvent, err := th.firstServicingEventMonth(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if err := req.validate(l, disbursements, firstEvent); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	l, err = l.Update().
+		SetConstructionMonths(req.Month - 1).
+		Save(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithConstruction(ctx, l)
+}
+
+func validateDisbursement(l *ent.Loan, existing []*ent.LoanDisbursement, month int, amountCents int) error {
+	if l.ConstructionMonths == 0 {
+		return errors.New("loan is not a construction loan")
+	}
+	if month < 1 || month > l.ConstructionMonths {
+		return errors.New("disbursement month must be before the loan converts")
+	}
+	if amountCents <= 0 {
+		return errors.New("disbursement amount must be positive")
+	}
+	disbursed := 0
+	for _, d := range existing {
+		disbursed = disbursed + d.Amount
+	}
+	if disbursed+amountCents > l.Amount {
+		return errors.New("disbursements cannot exceed the loan amount")
+	}
+	return nil
+}
+
+// validate checks the new conversion month against what has already happened on the loan.
+// Disbursements must stay in the construction period and modifications, deferrals, recasts and
+// income-driven plans must stay after it.
+func (r conversionRequest) validate(l *ent.Loan, disbursements []*ent.LoanDisbursement, firstEventMonth int) error {
+	if l.ConstructionMonths == 0 {
+		return errors.New("loan is not a construction loan")
+	}
+	if r.Month < 2 {
+		return errors.New("conversion month must be after the first month")
+	}
+	for _, d := range disbursements {
+		if d.Month >= r.Month {
+			return errors.New("conversion month must be after the last disbursement")
+		}
+	}
+	if firstEventMonth > 0 && firstEventMonth < r.Month {
+		return errors.New("loan has been modified, deferred, recast or repaid on income before the conversion month")
+	}
+	return nil
+}
+
+// firstServicingEventMonth is the earliest month a modification, deferral, recast or income-driven
+// plan changes the loan, or zero if none have.
+func (h Handler) firstServicingEventMonth(ctx context.Context, loanId int) (int, error) {
+	modifications, err := h.loanModifications(ctx, loanId)
+	if err != nil {
+		return 0, err
+	}
+	deferrals, err := h.loanDeferrals(ctx, loanId)
+	if err != nil {
+		return 0, err
+	}
+	recasts, err := h.loanRecasts(ctx, loanId)
+	if err != nil {
+		return 0, err
+	}
+	plan, err := h.loanIncomeDrivenPlan(ctx, loanId)
+	if err != nil {
+		return 0, err
+	}
+
+	months := []int{}
+	for _, m := range modifications {
+		months = append(months, m.EffectiveMonth)
+	}
+	for _, d := range deferrals {
+		months = append(months, d.StartMonth)
+	}
+	for _, r := range recasts {
+		months = append(months, r.Month)
+	}
+	if plan != nil {
+		months = append(months, plan.StartMonth)
+	}
+	if len(months) == 0 {
+		return 0, nil
+	}
+	return slices.Min(months), nil
+}
+
+func (h Handler) loanDisbursements(ctx context.Context, loanId int) ([]*ent.LoanDisbursement, error) {
+	return h.Ent.LoanDisbursement.Query().
+		Where(loandisbursement.LoanID(loanId)).
+		Order(ent.Asc(loandisbursement.FieldMonth), ent.Asc(loandisbursement.FieldID)).
+		All(ctx)
+}
+
+func (h Handler) respondWithConstruction(ctx *gin.Context, l *ent.Loan) {
+	disbursements, err := h.loanDisbursements(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	disbursed := 0
+	response := constructionResponse{
+		Committed:       float64(l.Amount) / 100,
+		ConversionMonth: l.ConstructionMonths + 1,
+		MaturityMonth:   len(schedule),
+		Disbursements:   []disbursementResponse{},
+	}
+	for _, d := range disbursements {
+		disbursed = disbursed + d.Amount
+		response.Disbursements = append(response.Disbursements, disbursementResponse{
+			Month:  d.Month,
+			Amount: float64(d.Amount) / 100,
+		})
+	}
+	response.Disbursed = float64(disbursed) / 100
+	response.Undisbursed = float64(l.Amount-disbursed) / 100
+	if len(schedule) > l.ConstructionMonths {
+		response.PermanentPayment = schedule[l.ConstructionMonths].MonthlyPayment
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestConstructionSchedule(t *testing.T) {
+	permanent, err := CreateAmortizationSchedule(150000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	schedule, err := CreateAmortizationScheduleWithOptions(300000, 0.06, 360, loanOptions{
+		Construction: &constructionPhase{
+			Months: 6,
+			Disbursements: []disbursement{
+				{Month: 1, Amount: 100000},
+				{Month: 3, Amount: 50000},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	for _, tc := range []struct {
+		month            int
+		expectedBalance  float64
+		expectedInterest float64
+	}{
+		{month: 1, expectedBalance: 100000, expectedInterest: 500},
+		{month: 2, expectedBalance: 100000, expectedInterest: 500},
+		{month: 3, expectedBalance: 150000, expectedInterest: 750},
+		{month: 6, expectedBalance: 150000, expectedInterest: 750},
+	} {
+		m := schedule[tc.month-1]
+		if !m.Construction || m.EndingBalance != tc.expectedBalance || m.MonthlyPayment != tc.expectedInterest {
+			t.Errorf("unexpected construction month %d: %+v", tc.month, m)
+		}
+	}
+
+	if len(schedule) != 366 {
+		t.Fatalf("unexpected maturity, want: 366, got: %d", len(schedule))
+	}
+	if schedule[6].Construction || schedule[6].MonthlyPayment != permanent[0].MonthlyPayment {
+		t.Errorf("disbursed principal not amortized, want payment: %v, got: %+v", permanent[0].MonthlyPayment, schedule[6])
+	}
+	if last := schedule[len(schedule)-1]; last.EndingBalance != 0 || last.TotalPrincipalPaid != 150000 {
+		t.Errorf("disbursed principal not paid off: %+v", last)
+	}
+}
+
+func TestConstructionLoan(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 300000, 0.06, 360)
+	l, err := l.Update().SetConstructionMonths(12).Save(adminContext())
+	if err != nil {
+		t.Fatalf("could not make construction loan: %v", err)
+	}
+	loan := idParam(l.ID)
+
+	for _, tc := range []struct {
+		name         string
+		request      disbursementRequest
+		expectedCode int
+	}{
+		{
+			name:         "first draw",
+			request:      disbursementRequest{Month: 1, Amount: 100000},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "over commitment",
+			request:      disbursementRequest{Month: 2, Amount: 250000},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "after conversion",
+			request:      disbursementRequest{Month: 13, Amount: 10000},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "second draw",
+			request:      disbursementRequest{Month: 4, Amount: 100000},
+			expectedCode: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateDisbursement, "POST", "", tc.request, loan)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	w := callTestHandler(t, h.CreateLoanModification, "POST", "", loanModificationRequest{EffectiveMonth: 6, Months: intPtr(240)}, loan)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("modification during construction not rejected, got: %v", w.Code)
+	}
+
+	w = callTestHandler(t, h.ConvertLoan, "POST", "", conversionRequest{Month: 4}, loan)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("conversion before last disbursement not rejected, got: %v", w.Code)
+	}
+
+	w = callTestHandler(t, h.ConvertLoan, "POST", "", conversionRequest{Month: 7}, loan)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code, want: %v, got: %v", http.StatusOK, w.Code)
+	}
+	resp := decodeTestResponse[constructionResponse](t, w)
+	if resp.Disbursed != 200000 || resp.Undisbursed != 100000 || resp.ConversionMonth != 7 || resp.MaturityMonth != 366 {
+		t.Errorf("unexpected construction loan: %+v", resp)
+	}
+	permanent, err := CreateAmortizationSchedule(200000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	if resp.PermanentPayment != permanent[0].MonthlyPayment {
+		t.Errorf("unexpected permanent payment, want: %v, got: %v", permanent[0].MonthlyPayment, resp.PermanentPayment)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		startMonth   int
+		expectedCode int
+	}{
+		{name: "during construction", startMonth: 6, expectedCode: http.StatusUnprocessableEntity},
+		{name: "after conversion", startMonth: 10, expectedCode: http.StatusOK},
+	} {
+		w = callTestHandler(t, h.CreateIncomeDrivenPlan, "POST", "", incomeDrivenPlanRequest{StartMonth: tc.startMonth, Income: 40000, FamilySize: 1}, loan)
+		if w.Code != tc.expectedCode {
+			t.Errorf("unexpected status code for an income-driven plan %s, want: %v, got: %v", tc.name, tc.expectedCode, w.Code)
+		}
+	}
+
+	w = callTestHandler(t, h.ConvertLoan, "POST", "", conversionRequest{Month: 11}, loan)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("conversion after the income-driven plan started not rejected, got: %v", w.Code)
+	}
+}
+
+func TestConcurrentDisbursements(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 300000, 0.06, 360)
+	l, err := l.Update().SetConstructionMonths(12).Save(adminContext())
+	if err != nil {
+		t.Fatalf("could not make construction loan: %v", err)
+	}
+
+	succeeded := racing(2, h.Ent.LoanDisbursement.Use, func() int {
+		w := callTestHandler(t, h.CreateDisbursement, "POST", "", disbursementRequest{Month: 1, Amount: 200000}, idParam(l.ID))
+
+		return w.Code
+	})
+
+	disbursements, err := h.loanDisbursements(adminContext(), l.ID)
+	if err != nil {
+		t.Fatalf("could not get disbursements: %v", err)
+	}
+	if succeeded > 1 || len(disbursements) != succeeded {
+		t.Errorf("disbursements over the loan amount were saved: %d succeeded, %d saved", succeeded, len(disbursements))
+	}
+}
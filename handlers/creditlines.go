@@ -0,0 +1,622 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/creditlinetransaction"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// dateLayout is the format of every calendar date in requests and responses.
+const dateLayout = "2006-01-02"
+
+const (
+	creditLinePeriodDraw      = "draw"
+	creditLinePeriodRepayment = "repayment"
+	creditLinePeriodMatured   = "matured"
+)
+
+type newCreditLineRequest struct {
+	Limit           float64 `json:"limit"`
+	Rate            float64 `json:"rate"`
+	DrawMonths      int     `json:"drawMonths"`
+	RepaymentMonths int     `json:"repaymentMonths"`
+	Opened          string  `json:"opened"` // YYYY-MM-DD, defaults to today
+	Borrower        int     `json:"borrowerID"`
+}
+
+type newCreditLineResponse struct {
+	CreditLineId int `json:"newCreditLineId"`
+}
+
+type creditLineTransactionRequest struct {
+	Amount float64 `json:"amount"`
+	Date   string  `json:"date"` // YYYY-MM-DD, no later than today, defaults to today
+}
+
+type creditLineResponse struct {
+	Id              int     `json:"id"`
+	Limit           float64 `json:"limit"`
+	Rate            float64 `json:"rate"`
+	Opened          string  `json:"opened"`
+	DrawPeriodEnds  string  `json:"drawPeriodEnds"`
+	Maturity        string  `json:"maturity"`
+	AsOf            string  `json:"asOf"`
+	Period          string  `json:"period" enums:"draw,repayment,matured"`
+	Balance         float64 `json:"balance"` // excludes interest accrued in the open billing cycle
+	AvailableCredit float64 `json:"availableCredit"`
+}
+
+type creditLineStatementResponse struct {
+	Cycle               int     `json:"cycle"`
+	Start               string  `json:"start"`
+	End                 string  `json:"end"` // last day of the cycle
+	BeginningBalance    float64 `json:"beginningBalance"`
+	Draws               float64 `json:"draws"`
+	Payments            float64 `json:"payments"`
+	AverageDailyBalance float64 `json:"averageDailyBalance"`
+	Interest            float64 `json:"interest"`
+	EndingBalance       float64 `json:"endingBalance"`
+	MinimumPayment      float64 `json:"minimumPayment"`
+}
+
+// @Summary Creates Credit Line
+// @Schemes
+// @Description Opens a revolving line of credit for a borrower.  The line can be drawn on up to its limit
+// @Description for drawMonths, then the balance is paid down over repaymentMonths.
+// @Accept json
+// @Produce json
+// @Param newCreditLineRequest body newCreditLineRequest true "New Credit Line Request"
+// @Success 200 {object} newCreditLineResponse
+// @Router /creditline [post]
+func (h Handler) CreateCreditLine(ctx *gin.Context) {
+	var req newCreditLineRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "new credit line input malformed",
+		})
+		return
+	}
+
+	opened, err := parseDate(req.Opened)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "opened must be a YYYY-MM-DD date",
+		})
+		return
+	}
+
+	if err := req.validate(); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	userExists, err := h.Ent.User.Query().Where(user.ID(req.Borrower)).Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if !userExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "borrower doesn't exist",
+		})
+		return
+	}
+
+	c, err := h.Ent.CreditLine.Create().
+		SetCreditLimit(int(math.Round(req.Limit * 100))).
+		SetRate(req.Rate).
+		SetDrawMonths(req.DrawMonths).
+		SetRepaymentMonths(req.RepaymentMonths).
+		SetOpenedAt(opened).
+		SetBorrowerID(req.Borrower).
+		Save(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCreditLineResponse{
+		CreditLineId: c.ID,
+	})
+}
+
+// @Summary Gets Credit Line
+// @Schemes
+// @Description Gets a credit line with its balance and available credit as of a date
+// @Accept json
+// @Produce json
+// @Param creditlineid path int true "Credit Line Id"
+// @Param date query string false "As of date, YYYY-MM-DD, no later than today, defaults to today"
+// @Success 200 {object} creditLineResponse
+// @Router /creditline/{creditlineid} [get]
+func (h Handler) GetCreditLine(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	asOf, err := parseDateThroughToday(ctx.Query("date"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "date must be a YYYY-MM-DD date no later than today",
+		})
+		return
+	}
+
+	c, err := h.Ent.CreditLine.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find credit line",
+		})
+		return
+	}
+
+	h.respondWithCreditLine(ctx, c, asOf)
+}
+
+// @Summary Draws On Credit Line
+// @Schemes
+// @Description Draws on a credit line during its draw period, up to the available credit
+// @Accept json
+// @Produce json
+// @Param creditlineid path int true "Credit Line Id"
+// @Param creditLineTransactionRequest body creditLineTransactionRequest true "Draw Request"
+// @Success 200 {object} creditLineResponse
+// @Router /creditline/{creditlineid}/draw [post]
+func (h Handler) DrawCreditLine(ctx *gin.Context) {
+	h.postCreditLineTransaction(ctx, creditlinetransaction.KindDraw)
+}
+
+// @Summary Pays Credit Line
+// @Schemes
+// @Description Makes a payment toward a credit line's balance
+// @Accept json
+// @Produce json
+// @Param creditlineid path int true "Credit Line Id"
+// @Param creditLineTransactionRequest body creditLineTransactionRequest true "Payment Request"
+// @Success 200 {object} creditLineResponse
+// @Router /creditline/{creditlineid}/payment [post]
+func (h Handler) PayCreditLine(ctx *gin.Context) {
+	h.postCreditLineTransaction(ctx, creditlinetransaction.KindPayment)
+}
+
+// @Summary Gets Credit Line Statements
+// @Schemes
+// @Description Gets a statement for each billing cycle that has closed.  Interest is charged on the average
+// @Description daily balance and the minimum payment is the interest during the draw period, then enough to
+// @Description amortize the balance over what is left of the repayment period.
+// @Accept json
+// @Produce json
+// @Param creditlineid path int true "Credit Line Id"
+// @Param through query string false "Last day to bill, YYYY-MM-DD, no later than today, defaults to today"
+// @Success 200 {array} creditLineStatementResponse
+// @Router /creditline/{creditlineid}/statements [get]
+func (h Handler) GetCreditLineStatements(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	through, err := parseDateThroughToday(ctx.Query("through"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "through must be a YYYY-MM-DD date no later than today",
+		})
+		return
+	}
+
+	c, err := h.Ent.CreditLine.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find credit line",
+		})
+		return
+	}
+
+	entries, err := h.creditLineEntries(ctx, c.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	statements, _ := creditLineStatements(toCreditLineTerms(c), entries, through)
+
+	response := []creditLineStatementResponse{}
+	for _, s := range statements {
+		response = append(response, creditLineStatementResponse{
+			Cycle:               s.Cycle,
+			Start:               s.Start.Format(dateLayout),
+			End:                 s.End.AddDate(0, 0, -1).Format(dateLayout),
+			BeginningBalance:    float64(s.BeginningBalance) / 100,
+			Draws:               float64(s.Draws) / 100,
+			Payments:            float64(s.Payments) / 100,
+			AverageDailyBalance: float64(s.AverageDailyBalance) / 100,
+			Interest:            float64(s.Interest) / 100,
+			EndingBalance:       float64(s.EndingBalance) / 100,
+			MinimumPayment:      float64(s.MinimumPayment) / 100,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h Handler) postCreditLineTransaction(ctx *gin.Context, kind creditlinetransaction.Kind) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	c, err := h.Ent.CreditLine.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find credit line",
+		})
+		return
+	}
+
+	var req creditLineTransactionRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: kind.String() + " input malformed",
+		})
+		return
+	}
+
+	posted, err := parseDateThroughToday(req.Date)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "date must be a YYYY-MM-DD date no later than today",
+		})
+		return
+	}
+
+	// the balance is checked in the same transaction as the transaction is saved, so concurrent
+	// draws can't take the line over its limit or payments take it below zero
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	entries, err := th.creditLineEntries(ctx, c.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	amountCents := int(math.Round(req.Amount * 100))
+	if err := validateCreditLineTransaction(toCreditLineTerms(c), entries, kind, amountCents, posted); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = tx.CreditLineTransaction.Create().
+		SetCreditLineID(c.ID).
+		SetKind(kind).
+		SetAmount(amountCents).
+		SetPostedAt(posted).
+		Exec(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithCreditLine(ctx, c, posted)
+}
+
+func (h Handler) respondWithCreditLine(ctx *gin.Context, c *ent.CreditLine, asOf time.Time) {
+	entries, err := h.creditLineEntries(ctx, c.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	terms := toCreditLineTerms(c)
+	_, balance := creditLineStatements(terms, entries, asOf)
+
+	ctx.JSON(http.StatusOK, creditLineResponse{
+		Id:              c.ID,
+		Limit:           float64(c.CreditLimit) / 100,
+		Rate:            c.Rate,
+		Opened:          terms.Opened.Format(dateLayout),
+		DrawPeriodEnds:  terms.drawPeriodEnd().AddDate(0, 0, -1).Format(dateLayout),
+		Maturity:        terms.maturity().AddDate(0, 0, -1).Format(dateLayout),
+		AsOf:            asOf.Format(dateLayout),
+		Period:          terms.period(asOf),
+		Balance:         float64(balance) / 100,
+		AvailableCredit: float64(terms.availableCredit(asOf, balance)) / 100,
+	})
+}
+
+func (r newCreditLineRequest) validate() error {
+	if r.Limit <= 0 {
+		return errors.New("credit limit must be positive")
+	}
+	if r.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if r.DrawMonths <= 0 {
+		return errors.New("draw period must be positive")
+	}
+	if r.RepaymentMonths <= 0 {
+		return errors.New("repayment period must be positive")
+	}
+	return nil
+}
+
+// validateCreditLineTransaction checks a draw or payment against the balance it would be posted to.
+// Transactions are posted in date order so an earlier draw can never push a later one over the limit.
+func validateCreditLineTransaction(terms creditLineTerms, entries []creditLineEntry, kind creditlinetransaction.Kind, amountCents int, posted time.Time) error {
+	if amountCents <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if posted.Before(terms.Opened) {
+		return errors.New("date must not be before the credit line opened")
+	}
+	if len(entries) > 0 && posted.Before(entries[len(entries)-1].Posted) {
+		return errors.New("date must not be before the last transaction")
+	}
+
+	_, balance := creditLineStatements(terms, entries, posted)
+	switch kind {
+	case creditlinetransaction.KindDraw:
+		if terms.period(posted) != creditLinePeriodDraw {
+			return errors.New("draws are only allowed during the draw period")
+		}
+		if amountCents > terms.availableCredit(posted, balance) {
+			return errors.New("draw exceeds available credit")
+		}
+	case creditlinetransaction.KindPayment:
+		if amountCents > balance {
+			return errors.New("payment exceeds the balance")
+		}
+	}
+	return nil
+}
+
+func (h Handler) creditLineEntries(ctx context.Context, creditLineId int) ([]creditLineEntry, error) {
+	transactions, err := h.Ent.CreditLineTransaction.Query().
+		Where(creditlinetransaction.CreditLineID(creditLineId)).
+		Order(ent.Asc(creditlinetransaction.FieldPostedAt), ent.Asc(creditlinetransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]creditLineEntry, 0, len(transactions))
+	for _, t := range transactions {
+		amount := t.Amount
+		if t.Kind == creditlinetransaction.KindPayment {
+			amount = -amount
+		}
+		entries = append(entries, creditLineEntry{
+			Posted: toDate(t.PostedAt),
+			Amount: amount,
+		})
+	}
+	return entries, nil
+}
+
+func toCreditLineTerms(c *ent.CreditLine) creditLineTerms {
+	return creditLineTerms{
+		LimitCents:      c.CreditLimit,
+		Rate:            c.Rate,
+		DrawMonths:      c.DrawMonths,
+		RepaymentMonths: c.RepaymentMonths,
+		Opened:          toDate(c.OpenedAt),
+	}
+}
+
+// parseDate parses a YYYY-MM-DD date, an empty value is today.
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return toDate(time.Now()), nil
+	}
+	return time.Parse(dateLayout, value)
+}
+
+// parseDateThroughToday parses a YYYY-MM-DD date like parseDate, rejecting days after today.  Credit
+// lines are replayed a day at a time up to the date, so it has to be bounded.
+func parseDateThroughToday(value string) (time.Time, error) {
+	day, err := parseDate(value)
+	if err != nil {
+		return day, err
+	}
+	if day.After(toDate(time.Now())) {
+		return day, errors.New("date is after today")
+	}
+	return day, nil
+}
+
+// toDate truncates t to midnight UTC of its calendar day.
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// creditLineTerms are the terms of a revolving line of credit, amounts in cents.  Billing cycles
+// start on the day of the month the line opened, or the last day of months without it.
+type creditLineTerms struct {
+	LimitCents      int
+	Rate            float64
+	DrawMonths      int
+	RepaymentMonths int
+	Opened          time.Time
+}
+
+// creditLineEntry is a draw, or a payment when Amount is negative, in cents.
+type creditLineEntry struct {
+	Posted time.Time
+	Amount int
+}
+
+type creditLineStatement struct {
+	Cycle               int
+	Start               time.Time
+	End                 time.Time // exclusive
+	BeginningBalance    int
+	Draws               int
+	Payments            int
+	AverageDailyBalance int
+	Interest            int
+	EndingBalance       int
+	MinimumPayment      int
+}
+
+func (t creditLineTerms) cycleStart(cycle int) time.Time {
+	return addMonths(t.Opened, cycle-1)
+}
+
+func (t creditLineTerms) drawPeriodEnd() time.Time {
+	return addMonths(t.Opened, t.DrawMonths)
+}
+
+func (t creditLineTerms) maturity() time.Time {
+	return addMonths(t.Opened, t.DrawMonths+t.RepaymentMonths)
+}
+
+// addMonths adds months to a date, keeping its day of the month or the last day of shorter months,
+// where time.AddDate would run over into the next month.
+func addMonths(day time.Time, months int) time.Time {
+	first := time.Date(day.Year(), day.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day.Day(), last)-1)
+}
+
+func (t creditLineTerms) period(day time.Time) string {
+	switch {
+	case day.Before(t.drawPeriodEnd()):
+		return creditLinePeriodDraw
+	case day.Before(t.maturity()):
+		return creditLinePeriodRepayment
+	default:
+		return creditLinePeriodMatured
+	}
+}
+
+// availableCredit is what may still be drawn on day, nothing once the draw period ends.
+func (t creditLineTerms) availableCredit(day time.Time, balanceCents int) int {
+	if t.period(day) != creditLinePeriodDraw {
+		return 0
+	}
+	return max(t.LimitCents-balanceCents, 0)
+}
+
+// minimumPayment is the payment due on a cycle's statement: the interest charged during the
+// draw period, then the payment that amortizes the balance over the remaining repayment cycles.
+// Everything is due on the last statement.
+func (t creditLineTerms) minimumPayment(cycle int, interestCents int, balanceCents int) int {
+	if balanceCents <= 0 {
+		return 0
+	}
+	if cycle <= t.DrawMonths {
+		return min(interestCents, balanceCents)
+	}
+	remaining := t.DrawMonths + t.RepaymentMonths - cycle + 1
+	if remaining <= 1 {
+		return balanceCents
+	}
+	payment, err := monthlyPayment(balanceCents-interestCents, t.Rate, remaining)
+	if err != nil {
+		// only interest is owed
+		return balanceCents
+	}
+	return min(payment, balanceCents)
+}
+
+// creditLineStatements replays entries, sorted by date, through the end of day through and closes
+// a statement for each billing cycle that has ended.  Interest for a cycle is the average daily
+// balance at the daily rate for each day of the cycle, rounded up to the cent, and is added to the
+// balance when the cycle closes.  The balance as of through is also returned, it does not include
+// interest accrued in the open cycle.
+func creditLineStatements(terms creditLineTerms, entries []creditLineEntry, through time.Time) ([]creditLineStatement, int) {
+	statements := []creditLineStatement{}
+	balance := 0
+	next := 0
+
+	for cycle := 1; !terms.cycleStart(cycle).After(through); cycle++ {
+		statement := creditLineStatement{
+			Cycle:            cycle,
+			Start:            terms.cycleStart(cycle),
+			End:              terms.cycleStart(cycle + 1),
+			BeginningBalance: balance,
+		}
+
+		dailyBalances := 0 // sum of each day's closing balance, in cent-days
+		days := 0
+		for day := statement.Start; day.Before(statement.End) && !day.After(through); day = day.AddDate(0, 0, 1) {
+			for next < len(entries) && !entries[next].Posted.After(day) {
+				if entries[next].Amount > 0 {
+					statement.Draws = statement.Draws + entries[next].Amount
+				} else {
+					statement.Payments = statement.Payments - entries[next].Amount
+				}
+				balance = balance + entries[next].Amount
+				next = next + 1
+			}
+			dailyBalances = dailyBalances + balance
+			days = days + 1
+		}
+
+		if statement.End.After(through.AddDate(0, 0, 1)) {
+			// the cycle is still open
+			break
+		}
+
+		statement.AverageDailyBalance = int(math.Round(float64(dailyBalances) / float64(days)))
+		statement.Interest = int(math.Ceil(float64(dailyBalances) * terms.Rate / 365))
+		balance = balance + statement.Interest
+		statement.EndingBalance = balance
+		statement.MinimumPayment = terms.minimumPayment(cycle, statement.Interest, balance)
+		statements = append(statements, statement)
+	}
+
+	return statements, balance
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCreditLineStatements(t *testing.T) {
+	terms := creditLineTerms{
+		LimitCents:      5000000,
+		Rate:            0.073, // 0.02% a day
+		DrawMonths:      12,
+		RepaymentMonths: 24,
+		Opened:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	entries := []creditLineEntry{{Posted: terms.Opened, Amount: 1000000}}
+
+	// pay each statement's minimum on the first day of the next cycle
+	var statements []creditLineStatement
+	for cycle := 1; cycle <= terms.DrawMonths+terms.RepaymentMonths; cycle++ {
+		statements, _ = creditLineStatements(terms, entries, terms.cycleStart(cycle+1).AddDate(0, 0, -1))
+		if len(statements) != cycle {
+			t.Fatalf("cycle %d not closed, got %d statements", cycle, len(statements))
+		}
+		s := statements[cycle-1]
+		if cycle <= terms.DrawMonths {
+			if s.MinimumPayment != s.Interest {
+				t.Errorf("cycle %d not interest only, interest: %d, minimum: %d", cycle, s.Interest, s.MinimumPayment)
+			}
+			if s.EndingBalance-s.Interest != 1000000 {
+				t.Errorf("cycle %d paid down principal during draw period, balance: %d", cycle, s.EndingBalance)
+			}
+		} else if s.MinimumPayment <= s.Interest {
+			t.Errorf("cycle %d not amortizing, interest: %d, minimum: %d", cycle, s.Interest, s.MinimumPayment)
+		}
+		entries = append(entries, creditLineEntry{Posted: s.End, Amount: -s.MinimumPayment})
+	}
+
+	// 31 days of $10,000
+	if statements[0].Interest != 6200 || statements[0].AverageDailyBalance != 1000000 {
+		t.Errorf("unexpected first statement: %+v", statements[0])
+	}
+	_, balance := creditLineStatements(terms, entries, terms.maturity())
+	if balance != 0 {
+		t.Errorf("credit line not paid off by minimum payments, balance: %d", balance)
+	}
+}
+
+func TestCreditLineCycles(t *testing.T) {
+	terms := creditLineTerms{
+		LimitCents:      5000000,
+		Rate:            0.073,
+		DrawMonths:      2,
+		RepaymentMonths: 2,
+		Opened:          time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	entries := []creditLineEntry{{Posted: terms.Opened, Amount: 1000000}}
+
+	statements, _ := creditLineStatements(terms, entries, terms.maturity().AddDate(0, 0, -1))
+
+	expected := [][2]string{
+		{"2024-01-31", "2024-02-29"},
+		{"2024-02-29", "2024-03-31"},
+		{"2024-03-31", "2024-04-30"},
+		{"2024-04-30", "2024-05-31"},
+	}
+	if len(statements) != len(expected) {
+		t.Fatalf("unexpected statements, want: %d, got: %d", len(expected), len(statements))
+	}
+	for i, s := range statements {
+		if s.Start.Format(dateLayout) != expected[i][0] || s.End.Format(dateLayout) != expected[i][1] {
+			t.Errorf("unexpected cycle %d, want: %v, got: %v to %v", s.Cycle, expected[i], s.Start.Format(dateLayout), s.End.Format(dateLayout))
+		}
+	}
+	if terms.drawPeriodEnd().Format(dateLayout) != "2024-03-31" {
+		t.Errorf("unexpected draw period end: %v", terms.drawPeriodEnd().Format(dateLayout))
+	}
+}
+
+func TestCreditLine(t *testing.T) {
+	h := newTestHandler(t)
+	borrower := createTestUser(t, h, "borrower", "")
+
+	w := callTestHandler(t, h.CreateCreditLine, "POST", "", newCreditLineRequest{
+		Limit:           50000,
+		Rate:            0.073,
+		DrawMonths:      2,
+		RepaymentMonths: 3,
+		Opened:          "2024-01-01",
+		Borrower:        borrower,
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code, want: %v, got: %v", http.StatusOK, w.Code)
+	}
+	created := decodeTestResponse[newCreditLineResponse](t, w)
+	creditLine := idParam(created.CreditLineId)
+
+	for _, tc := range []struct {
+		name         string
+		draw         bool
+		request      creditLineTransactionRequest
+		expectedCode int
+	}{
+		{
+			name:         "draw",
+			draw:         true,
+			request:      creditLineTransactionRequest{Amount: 10000, Date: "2024-01-01"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "over limit",
+			draw:         true,
+			request:      creditLineTransactionRequest{Amount: 45000, Date: "2024-01-10"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "payment over balance",
+			request:      creditLineTransactionRequest{Amount: 20000, Date: "2024-01-15"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "second draw",
+			draw:         true,
+			request:      creditLineTransactionRequest{Amount: 5000, Date: "2024-02-16"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "before last transaction",
+			request:      creditLineTransactionRequest{Amount: 100, Date: "2024-02-01"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "after draw period",
+			draw:         true,
+			request:      creditLineTransactionRequest{Amount: 100, Date: "2024-03-01"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "payment",
+			request:      creditLineTransactionRequest{Amount: 1000, Date: "2024-03-05"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "future payment",
+			request:      creditLineTransactionRequest{Amount: 100, Date: "9999-12-31"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transact := h.PayCreditLine
+			if tc.draw {
+				transact = h.DrawCreditLine
+			}
+
+			w := callTestHandler(t, transact, "POST", "", tc.request, creditLine)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	w = callTestHandler(t, h.GetCreditLine, "GET", "date=2024-02-20", nil, creditLine)
+
+	line := decodeTestResponse[creditLineResponse](t, w)
+	// both draws plus January's interest
+	if line.Balance != 15062 || line.AvailableCredit != 34938 || line.Period != "draw" {
+		t.Errorf("unexpected credit line: %+v", line)
+	}
+	if line.DrawPeriodEnds != "2024-02-29" || line.Maturity != "2024-05-31" {
+		t.Errorf("unexpected periods, draw period ends: %v, maturity: %v", line.DrawPeriodEnds, line.Maturity)
+	}
+
+	w = callTestHandler(t, h.GetCreditLineStatements, "GET", "through=2024-03-31", nil, creditLine)
+
+	statements := decodeTestResponse[[]creditLineStatementResponse](t, w)
+	if len(statements) != 3 {
+		t.Fatalf("unexpected statements, want: 3, got: %d", len(statements))
+	}
+	// 15 days of $10,062 and 14 of $15,062
+	if statements[1].AverageDailyBalance != 12475.79 || statements[1].Interest != 72.36 || statements[1].MinimumPayment != 72.36 {
+		t.Errorf("unexpected draw period statement: %+v", statements[1])
+	}
+	if statements[2].Payments != 1000 || statements[2].MinimumPayment <= statements[2].Interest {
+		t.Errorf("unexpected repayment period statement: %+v", statements[2])
+	}
+
+	for _, query := range []string{"through=9999-12-31", "through=" + time.Now().AddDate(0, 0, 2).Format(dateLayout)} {
+		w = callTestHandler(t, h.GetCreditLineStatements, "GET", query, nil, creditLine)
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("statements billed in the future, %v: %v", query, w.Code)
+		}
+	}
+}
+
+func TestConcurrentCreditLineTransactions(t *testing.T) {
+	h := newTestHandler(t)
+	borrower := createTestUser(t, h, "borrower", "")
+
+	w := callTestHandler(t, h.CreateCreditLine, "POST", "", newCreditLineRequest{
+		Limit:           50000,
+		Rate:            0.073,
+		DrawMonths:      12,
+		RepaymentMonths: 12,
+		Opened:          time.Now().Format(dateLayout),
+		Borrower:        borrower,
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not open credit line: %v", w.Body.String())
+	}
+	created := decodeTestResponse[newCreditLineResponse](t, w)
+
+	for _, tc := range []struct {
+		name     string
+		transact gin.HandlerFunc
+		amount   float64
+	}{
+		{name: "draws over the limit", transact: h.DrawCreditLine, amount: 30000},
+		{name: "payments below zero", transact: h.PayCreditLine, amount: 30000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			succeeded := racing(2, h.Ent.CreditLineTransaction.Use, func() int {
+				w := callTestHandler(t, tc.transact, "POST", "", creditLineTransactionRequest{Amount: tc.amount}, idParam(created.CreditLineId))
+
+				return w.Code
+			})
+
+			entries, err := h.creditLineEntries(adminContext(), created.CreditLineId)
+			if err != nil {
+				t.Fatalf("could not get transactions: %v", err)
+			}
+			balance := 0
+			for _, e := range entries {
+				balance += e.Amount
+			}
+			if succeeded > 1 || balance < 0 || balance > 5000000 {
+				t.Errorf("conflicting transactions were saved: %d succeeded, balance: %d", succeeded, balance)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/paymentdeferral"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type forbearanceRequest struct {
+	StartMonth int    `json:"startMonth"`
+	Months     int    `json:"months"`
+	Interest   string `json:"interest" enums:"capitalize,defer,waive"`
+}
+
+type defermentRequest struct {
+	Kind       string `json:"kind" enums:"in_school,grace"`
+	StartMonth int    `json:"startMonth"`
+	Months     int    `json:"months"`
+	Subsidized bool   `json:"subsidized"` // interest is paid on the borrower's behalf while deferred
+}
+
+type skipPaymentRequest struct {
+	Month int `json:"month"`
+}
+
+type paymentDeferralResponse struct {
+	Id            int    `json:"id"`
+	Kind          string `json:"kind"`
+	StartMonth    int    `json:"startMonth"`
+	Months        int    `json:"months"`
+	Interest      string `json:"interest"`
+	MaturityMonth int    `json:"maturityMonth"` // last month of the loan once every deferral is applied
+}
+
+// @Summary Places Loan In Forbearance
+// @Schemes
+// @Description Pauses payments for a number of months and extends the maturity by as many months.
+// @Description Interest accrued while paused is capitalized when payments resume, deferred until the
+// @Description final payment, or waived.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param forbearanceRequest body forbearanceRequest true "Forbearance Request"
+// @Success 200 {object} paymentDeferralResponse
+// @Router /loan/{loanid}/forbearance [post]
+func (h Handler) CreateForbearance(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req forbearanceRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "forbearance input malformed",
+		})
+		return
+	}
+
+	interest := paymentdeferral.Interest(req.Interest)
+	if err := paymentdeferral.InterestValidator(interest); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "interest must be capitalize, defer or waive",
+		})
+		return
+	}
+
+	h.createDeferral(ctx, l, paymentdeferral.KindForbearance, req.StartMonth, req.Months, interest)
+}
+
+// @Summary Skips Loan Payment
+// @Schemes
+// @Description Skips a single payment.  Interest for the month is capitalized and the maturity is
+// @Description extended by one month.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param skipPaymentRequest body skipPaymentRequest true "Skip Payment Request"
+// @Success 200 {object} paymentDeferralResponse
+// @Router /loan/{loanid}/skip [post]
+func (h Handler) SkipPayment(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req skipPaymentRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "skip payment input malformed",
+		})
+		return
+	}
+
+	h.createDeferral(ctx, l, paymentdeferral.KindSkipPayment, req.Month, 1, paymentdeferral.InterestCapitalize)
+}
+
+// @Summary Defers Student Loan
+// @Schemes
+// @Description Defers payments while the borrower is in school or in their grace period.  Interest on
+// @Description unsubsidized loans accrues and is capitalized when repayment begins; interest on subsidized
+// @Description loans is not charged.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param defermentRequest body defermentRequest true "Deferment Request"
+// @Success 200 {object} paymentDeferralResponse
+// @Router /loan/{loanid}/deferment [post]
+func (h Handler) CreateDeferment(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req defermentRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "deferment input malformed",
+		})
+		return
+	}
+
+	kind := paymentdeferral.Kind(req.Kind)
+	if kind != paymentdeferral.KindInSchool && kind != paymentdeferral.KindGrace {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "kind must be in_school or grace",
+		})
+		return
+	}
+
+	interest := paymentdeferral.InterestCapitalize
+	if req.Subsidized {
+		interest = paymentdeferral.InterestWaive
+	}
+
+	h.createDeferral(ctx, l, kind, req.StartMonth, req.Months, interest)
+}
+
+// @Summary Gets Loan Deferrals
+// @Schemes
+// @Description Gets the forbearances, deferments and skipped payments on a loan
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {array} paymentDeferralResponse
+// @Router /loan/{loanid}/deferrals [get]
+func (h Handler) GetDeferrals(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	deferrals, err := h.loanDeferrals(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	response := []paymentDeferralResponse{}
+	for _, d := range deferrals {
+		response = append(response, newPaymentDeferralResponse(d, len(schedule)))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// createDeferral validates and saves a deferral, then responds with it and the new maturity.
+func (h Handler) createDeferral(ctx *gin.Context, l *ent.Loan, kind paymentdeferral.Kind, startMonth int, months int, interest paymentdeferral.Interest) {
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	deferrals, err := th.loanDeferrals(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	modifications, err := th.loanModifications(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := th.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := validateDeferral(startMonth, months, len(schedule), deferrals, modifications); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if schedule[startMonth-1].Construction {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "cannot defer payments before the loan converts from construction",
+		})
+		return
+	}
+
+	d, err := tx.PaymentDeferral.Create().
+		SetLoanID(l.ID).
+		SetKind(kind).
+		SetStartMonth(startMonth).
+		SetMonths(months).
+		SetInterest(interest).
+		Save(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err = h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPaymentDeferralResponse(d, len(schedule)))
+}
+
+func validateDeferral(startMonth int, months int, maturity int, existing []*ent.PaymentDeferral, modifications []*ent.LoanModification) error {
+	if startMonth < 1 {
+		return errors.New("month must be positive")
+	}
+	if startMonth > maturity {
+		return errors.New("month cannot be after the loan matures")
+	}
+	if months <= 0 {
+		return errors.New("number of months must be positive")
+	}
+	for _, d := range existing {
+		if startMonth < d.StartMonth+d.Months && d.StartMonth < startMonth+months {
+			return errors.New("payments are already deferred during these months")
+		}
+	}
+	for _, m := range modifications {
+		if startMonth <= m.EffectiveMonth && m.EffectiveMonth < startMonth+months {
+			return errors.New("cannot defer payments in the month a modification takes effect")
+		}
+	}
+	return nil
+}
+
+func (h Handler) loanDeferrals(ctx context.Context, loanId int) ([]*ent.PaymentDeferral, error) {
+	return h.Ent.PaymentDeferral.Query().
+		Where(paymentdeferral.LoanID(loanId)).
+		Order(ent.Asc(paymentdeferral.FieldStartMonth)).
+		All(ctx)
+}
+
+func newPaymentDeferralResponse(d *ent.PaymentDeferral, maturity int) paymentDeferralResponse {
+	return paymentDeferralResponse{
+		Id:            d.ID,
+		Kind:          d.Kind.String(),
+		StartMonth:    d.StartMonth,
+		Months:        d.Months,
+		Interest:      d.Interest.String(),
+		MaturityMonth: maturity,
+	}
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestDeferralSchedule(t *testing.T) {
+	base, err := CreateAmortizationSchedule(12000, 0.06, 12)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	// three months of interest, rounded up to the cent, on the balance after month 3
+	accrued := roundCents(3 * math.Ceil(base[2].EndingBalance*100*0.005) / 100)
+
+	for _, tc := range []struct {
+		name             string
+		interest         string
+		expectedInterest float64
+	}{
+		{
+			name:             "capitalize",
+			interest:         deferredInterestCapitalize,
+			expectedInterest: 0, // checked separately, the payment is re-amortized
+		},
+		{
+			name:             "defer",
+			interest:         deferredInterestDefer,
+			expectedInterest: roundCents(base[11].TotalInterestPaid + accrued),
+		},
+		{
+			name:             "waive",
+			interest:         deferredInterestWaive,
+			expectedInterest: base[11].TotalInterestPaid,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := CreateAmortizationScheduleWithOptions(12000, 0.06, 12, loanOptions{
+				Deferrals: []paymentDeferral{{StartMonth: 4, Months: 3, Interest: tc.interest}},
+			})
+			if err != nil {
+				t.Fatalf("could not create amortization schedule: %v", err)
+			}
+
+			if len(schedule) != 15 {
+				t.Fatalf("maturity not extended, want: 15 months, got: %d", len(schedule))
+			}
+			for _, m := range schedule[3:6] {
+				if !m.Deferred || m.MonthlyPayment != 0 || m.EndingBalance != base[2].EndingBalance {
+					t.Errorf("month %d not deferred: %+v", m.Month, m)
+				}
+			}
+			last := schedule[len(schedule)-1]
+			if last.EndingBalance != 0 {
+				t.Errorf("loan not paid off, ending balance: %v", last.EndingBalance)
+			}
+
+			switch tc.interest {
+			case deferredInterestCapitalize:
+				if schedule[6].Capitalized != accrued {
+					t.Errorf("unexpected capitalized interest, want: %v, got: %v", accrued, schedule[6].Capitalized)
+				}
+				if schedule[6].MonthlyPayment <= base[6].MonthlyPayment {
+					t.Errorf("payment not re-amortized, before: %v, after: %v", base[6].MonthlyPayment, schedule[6].MonthlyPayment)
+				}
+			default:
+				if last.TotalInterestPaid != tc.expectedInterest {
+					t.Errorf("unexpected total interest, want: %v, got: %v", tc.expectedInterest, last.TotalInterestPaid)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateForbearance(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 12000, 0.06, 12)
+
+	for _, tc := range []struct {
+		name             string
+		request          forbearanceRequest
+		expectedCode     int
+		expectedMaturity int
+	}{
+		{
+			name:             "three months capitalized",
+			request:          forbearanceRequest{StartMonth: 4, Months: 3, Interest: "capitalize"},
+			expectedCode:     http.StatusOK,
+			expectedMaturity: 15,
+		},
+		{
+			name:         "overlapping",
+			request:      forbearanceRequest{StartMonth: 6, Months: 2, Interest: "waive"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "unknown interest treatment",
+			request:      forbearanceRequest{StartMonth: 8, Months: 2, Interest: "forgive"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:             "after original maturity",
+			request:          forbearanceRequest{StartMonth: 14, Months: 1, Interest: "waive"},
+			expectedCode:     http.StatusOK,
+			expectedMaturity: 16,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateForbearance, "POST", "", tc.request, idParam(l.ID))
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[paymentDeferralResponse](t, w)
+			if resp.MaturityMonth != tc.expectedMaturity {
+				t.Errorf("unexpected maturity, want: %v, got: %v", tc.expectedMaturity, resp.MaturityMonth)
+			}
+		})
+	}
+
+	w := callTestHandler(t, h.SkipPayment, "POST", "", skipPaymentRequest{Month: 10}, idParam(l.ID))
+
+	resp := decodeTestResponse[paymentDeferralResponse](t, w)
+	if resp.Kind != "skip_payment" || resp.Months != 1 || resp.MaturityMonth != 17 {
+		t.Errorf("unexpected skipped payment: %+v", resp)
+	}
+}
+
+func TestConcurrentSkipPayments(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 12000, 0.06, 12)
+
+	succeeded := racing(2, h.Ent.PaymentDeferral.Use, func() int {
+		w := callTestHandler(t, h.SkipPayment, "POST", "", skipPaymentRequest{Month: 3}, idParam(l.ID))
+
+		return w.Code
+	})
+
+	deferrals, err := h.loanDeferrals(adminContext(), l.ID)
+	if err != nil {
+		t.Fatalf("could not get deferrals: %v", err)
+	}
+	if succeeded > 1 || len(deferrals) != succeeded {
+		t.Errorf("overlapping deferrals were saved: %d succeeded, %d saved", succeeded, len(deferrals))
+	}
+}
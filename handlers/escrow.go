@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/escrowitem"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// escrowRefundThreshold is the smallest surplus refunded at an analysis, in cents.  Smaller
+// surpluses stay in the account.
+const escrowRefundThreshold = 5000
+
+type escrowItemRequest struct {
+	Kind                 string  `json:"kind" enums:"property_tax,hazard_insurance,pmi"`
+	AnnualAmount         float64 `json:"annualAmount"`
+	FirstDueMonth        int     `json:"firstDueMonth"`
+	DisbursementsPerYear int     `json:"disbursementsPerYear"` // defaults to 1, must divide 12
+}
+
+type escrowItemResponse struct {
+	Id                   int     `json:"id"`
+	Kind                 string  `json:"kind"`
+	AnnualAmount         float64 `json:"annualAmount"`
+	FirstDueMonth        int     `json:"firstDueMonth"`
+	DisbursementsPerYear int     `json:"disbursementsPerYear"`
+}
+
+type escrowAnalysisResponse struct {
+	Year                   int     `json:"year"`
+	Month                  int     `json:"month"` // the first month of the year analyzed
+	BeginningBalance       float64 `json:"beginningBalance"`
+	ProjectedDisbursements float64 `json:"projectedDisbursements"`
+	LowestBalance          float64 `json:"lowestBalance"` // projected at the current payment
+	Cushion                float64 `json:"cushion"`       // two months of disbursements
+	Shortage               float64 `json:"shortage"`      // collected over the year
+	Surplus                float64 `json:"surplus"`
+	Refund                 float64 `json:"refund"`
+	MonthlyPayment         float64 `json:"monthlyPayment"`
+}
+
+type escrowResponse struct {
+	Items    []escrowItemResponse     `json:"items"`
+	Analyses []escrowAnalysisResponse `json:"analyses"`
+}
+
+// @Summary Adds Escrow Item
+// @Schemes
+// @Description Adds a bill, like property taxes, that is paid from escrow.  Escrow is collected with each
+// @Description monthly payment and the escrow payment is set by an analysis at the start of every loan year.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param escrowItemRequest body escrowItemRequest true "Escrow Item Request"
+// @Success 200 {object} escrowResponse
+// @Router /loan/{loanid}/escrow/items [post]
+func (h Handler) CreateEscrowItem(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req escrowItemRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "escrow item input malformed",
+		})
+		return
+	}
+	if req.DisbursementsPerYear == 0 {
+		req.DisbursementsPerYear = 1
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := req.validate(l, len(schedule)); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = h.Ent.EscrowItem.Create().
+		SetLoanID(l.ID).
+		SetKind(escrowitem.Kind(req.Kind)).
+		SetAnnualAmount(int(math.Round(req.AnnualAmount * 100))).
+		SetFirstDueMonth(req.FirstDueMonth).
+		SetDisbursementsPerYear(req.DisbursementsPerYear).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithEscrow(ctx, l)
+}
+
+// @Summary Gets Escrow
+// @Schemes
+// @Description Gets a loan's escrow items and the escrow analysis for every year of the loan.  Each analysis
+// @Description projects the account over the year, collects any shortage below a two month cushion over
+// @Description the year and refunds any surplus of $50 or more.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {object} escrowResponse
+// @Router /loan/{loanid}/escrow [get]
+func (h Handler) GetEscrow(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	h.respondWithEscrow(ctx, l)
+}
+
+func (r escrowItemRequest) validate(l *ent.Loan, maturity int) error {
+	switch escrowitem.Kind(r.Kind) {
+	case escrowitem.KindPropertyTax, escrowitem.KindHazardInsurance, escrowitem.KindPmi:
+	default:
+		return errors.New("kind must be property_tax, hazard_insurance or pmi")
+	}
+	// mortgage insurance charged on the loan stops at its loan-to-value thresholds, so it can't
+	// be paid from escrow as well
+	if escrowitem.Kind(r.Kind) == escrowitem.KindPmi && l.PmiRate > 0 {
+		return errors.New("loan already charges mortgage insurance")
+	}
+	if r.AnnualAmount <= 0 {
+		return errors.New("annual amount must be positive")
+	}
+	if r.FirstDueMonth < 1 || r.FirstDueMonth > maturity {
+		return errors.New("first due month must be within the term")
+	}
+	if r.DisbursementsPerYear < 1 || 12%r.DisbursementsPerYear != 0 {
+		return errors.New("disbursements per year must divide 12")
+	}
+	return nil
+}
+
+func (h Handler) loanEscrowItems(ctx context.Context, loanId int) ([]*ent.EscrowItem, error) {
+	return h.Ent.EscrowItem.Query().
+		Where(escrowitem.LoanID(loanId)).
+		Order(ent.Asc(escrowitem.FieldID)).
+		All(ctx)
+}
+
+func toEscrowItems(items []*ent.EscrowItem) []escrowItem {
+	escrow := make([]escrowItem, 0, len(items))
+	for _, e := range items {
+		escrow = append(escrow, escrowItem{
+			AnnualAmount:         float64(e.AnnualAmount) / 100,
+			FirstDueMonth:        e.FirstDueMonth,
+			DisbursementsPerYear: e.DisbursementsPerYear,
+		})
+	}
+	return escrow
+}
+
+func (h Handler) respondWithEscrow(ctx *gin.Context, l *ent.Loan) {
+	items, err := h.loanEscrowItems(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	response := escrowResponse{
+		Items:    []escrowItemResponse{},
+		Analyses: []escrowAnalysisResponse{},
+	}
+	for _, e := range items {
+		response.Items = append(response.Items, escrowItemResponse{
+			Id:                   e.ID,
+			Kind:                 e.Kind.String(),
+			AnnualAmount:         float64(e.AnnualAmount) / 100,
+			FirstDueMonth:        e.FirstDueMonth,
+			DisbursementsPerYear: e.DisbursementsPerYear,
+		})
+	}
+	if len(items) > 0 {
+		for _, a := range applyEscrow(schedule, toEscrowItems(items)) {
+			response.Analyses = append(response.Analyses, escrowAnalysisResponse{
+				Year:                   a.Year,
+				Month:                  a.Month,
+				BeginningBalance:       float64(a.BeginningBalance) / 100,
+				ProjectedDisbursements: float64(a.ProjectedDisbursements) / 100,
+				LowestBalance:          float64(a.LowestBalance) / 100,
+				Cushion:                float64(a.Cushion) / 100,
+				Shortage:               float64(a.Shortage) / 100,
+				Surplus:                float64(a.Surplus) / 100,
+				Refund:                 float64(a.Refund) / 100,
+				MonthlyPayment:         float64(a.MonthlyPayment) / 100,
+			})
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// escrowItem is a bill paid from escrow.  The annual amount is split evenly between
+// DisbursementsPerYear disbursements, the first in FirstDueMonth, with any leftover cent
+// paid with the first disbursement of each year.
+type escrowItem struct {
+	AnnualAmount         float64
+	FirstDueMonth        int
+	DisbursementsPerYear int
+}
+
+// escrowAnalysis is the annual analysis that sets the escrow payment for a year of the loan,
+// amounts in cents.
+type escrowAnalysis struct {
+	Year                   int
+	Month                  int
+	BeginningBalance       int
+	ProjectedDisbursements int
+	LowestBalance          int
+	Cushion                int
+	Shortage               int
+	Surplus                int
+	Refund                 int
+	MonthlyPayment         int
+}
+
+// disbursementCents is the amount paid for the item in month.
+func (e escrowItem) disbursementCents(month int) int {
+	interval := 12 / e.DisbursementsPerYear
+	if month < e.FirstDueMonth || (month-e.FirstDueMonth)%interval != 0 {
+		return 0
+	}
+	annualCents := int(math.Round(e.AnnualAmount * 100))
+	installment := annualCents / e.DisbursementsPerYear
+	if (month-e.FirstDueMonth)/interval%e.DisbursementsPerYear == 0 {
+		installment = installment + annualCents%e.DisbursementsPerYear
+	}
+	return installment
+}
+
+func escrowDisbursementCents(items []escrowItem, month int) int {
+	total := 0
+	for _, e := range items {
+		total = total + e.disbursementCents(month)
+	}
+	return total
+}
+
+// analyzeEscrow projects the escrow account through the twelve months of a loan year, starting
+// from balanceCents and collecting a twelfth of the year's disbursements each month.  A projected
+// low point under the cushion is a shortage, collected over the year on top of the base payment.
+// A low point over the cushion is a surplus.
+func analyzeEscrow(items []escrowItem, year int, balanceCents int) escrowAnalysis {
+	start := (year-1)*12 + 1
+	analysis := escrowAnalysis{
+		Year:             year,
+		Month:            start,
+		BeginningBalance: balanceCents,
+	}
+
+	for month := start; month < start+12; month++ {
+		analysis.ProjectedDisbursements = analysis.ProjectedDisbursements + escrowDisbursementCents(items, month)
+	}
+	basePayment := int(math.Ceil(float64(analysis.ProjectedDisbursements) / 12))
+	analysis.Cushion = int(math.Ceil(float64(analysis.ProjectedDisbursements) / 6))
+
+	projected := balanceCents
+	analysis.LowestBalance = math.MaxInt
+	for month := start; month < start+12; month++ {
+		projected = projected + basePayment - escrowDisbursementCents(items, month)
+		analysis.LowestBalance = min(analysis.LowestBalance, projected)
+	}
+
+	analysis.MonthlyPayment = basePayment
+	if analysis.LowestBalance < analysis.Cushion {
+		analysis.Shortage = analysis.Cushion - analysis.LowestBalance
+		analysis.MonthlyPayment = basePayment + int(math.Ceil(float64(analysis.Shortage)/12))
+	} else {
+		analysis.Surplus = analysis.LowestBalance - analysis.Cushion
+		if analysis.Surplus >= escrowRefundThreshold {
+			analysis.Refund = analysis.Surplus
+		}
+	}
+	return analysis
+}
+
+// applyEscrow sets the escrow collected and the escrow account balance on each month of the
+// schedule, analyzing the account at the start of every loan year.  Escrow isn't collected in
+// deferred months but bills are still paid, so the account may be advanced funds.  It returns
+// the analysis for each year.
+func applyEscrow(schedule []monthlySummary, items []escrowItem) []escrowAnalysis {
+	analyses := []escrowAnalysis{}
+	balance := 0
+	payment := 0
+	for i := range schedule {
+		if i%12 == 0 {
+			analysis := analyzeEscrow(items, i/12+1, balance)
+			balance = balance - analysis.Refund
+			payment = analysis.MonthlyPayment
+			analyses = append(analyses, analysis)
+		}
+
+		collected := 0
+		if !schedule[i].Deferred {
+			collected = payment
+		}
+		balance = balance + collected - escrowDisbursementCents(items, schedule[i].Month)
+
+		schedule[i].Escrow = float64(collected) / 100
+		schedule[i].EscrowBalance = float64(balance) / 100
+	}
+	return analyses
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestEscrowAnalysis(t *testing.T) {
+	items := []escrowItem{
+		{AnnualAmount: 3600, FirstDueMonth: 6, DisbursementsPerYear: 2},  // property tax
+		{AnnualAmount: 1200, FirstDueMonth: 12, DisbursementsPerYear: 1}, // hazard insurance
+	}
+	schedule, err := CreateAmortizationSchedule(200000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	analyses := applyEscrow(schedule, items)
+
+	if len(analyses) != 30 {
+		t.Fatalf("unexpected analyses, want: 30, got: %d", len(analyses))
+	}
+	// the account starts empty, so the first year collects the two month cushion as a shortage
+	first := analyses[0]
+	if first.ProjectedDisbursements != 480000 || first.Cushion != 80000 || first.LowestBalance != 0 || first.Shortage != 80000 || first.MonthlyPayment != 46667 {
+		t.Errorf("unexpected first analysis: %+v", first)
+	}
+	if schedule[0].Escrow != 466.67 || schedule[5].EscrowBalance != 1000.02 {
+		t.Errorf("unexpected first year escrow, payment: %v, balance after tax: %v", schedule[0].Escrow, schedule[5].EscrowBalance)
+	}
+	second := analyses[1]
+	if second.BeginningBalance != 80004 || second.Shortage != 0 || second.Surplus != 4 || second.Refund != 0 || second.MonthlyPayment != 40000 {
+		t.Errorf("unexpected second analysis: %+v", second)
+	}
+	if schedule[12].Escrow != 400 {
+		t.Errorf("unexpected second year escrow, want: 400, got: %v", schedule[12].Escrow)
+	}
+
+	surplus := analyzeEscrow(items, 2, 200000)
+	if surplus.Surplus != 120000 || surplus.Refund != 120000 || surplus.MonthlyPayment != 40000 {
+		t.Errorf("unexpected surplus analysis: %+v", surplus)
+	}
+}
+
+func TestEscrowItems(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	loan := idParam(l.ID)
+
+	for _, tc := range []struct {
+		name         string
+		request      escrowItemRequest
+		expectedCode int
+	}{
+		{
+			name:         "property tax",
+			request:      escrowItemRequest{Kind: "property_tax", AnnualAmount: 3600, FirstDueMonth: 6, DisbursementsPerYear: 2},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "hazard insurance",
+			request:      escrowItemRequest{Kind: "hazard_insurance", AnnualAmount: 1200, FirstDueMonth: 12},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "unknown kind",
+			request:      escrowItemRequest{Kind: "flood", AnnualAmount: 1200, FirstDueMonth: 12},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "uneven disbursements",
+			request:      escrowItemRequest{Kind: "property_tax", AnnualAmount: 1200, FirstDueMonth: 12, DisbursementsPerYear: 5},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateEscrowItem, "POST", "", tc.request, loan)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	w := callTestHandler(t, h.GetLoanSchedule, "GET", "", nil, loan)
+
+	months := decodeTestResponse[[]loanMonthResponseItem](t, w)
+	base, err := CreateAmortizationSchedule(200000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	if want := roundCents(base[0].MonthlyPayment + 466.67); months[0].MonthlyPayment != want || months[0].Escrow != 466.67 {
+		t.Errorf("escrow not included in payment, want: %v, got: %+v", want, months[0])
+	}
+
+	w = callTestHandler(t, h.GetEscrow, "GET", "", nil, loan)
+
+	escrow := decodeTestResponse[escrowResponse](t, w)
+	if len(escrow.Items) != 2 || escrow.Items[1].DisbursementsPerYear != 1 {
+		t.Errorf("unexpected escrow items: %+v", escrow.Items)
+	}
+	if len(escrow.Analyses) != 30 || escrow.Analyses[0].Shortage != 800 || escrow.Analyses[1].MonthlyPayment != 400 {
+		t.Errorf("unexpected escrow analyses: %+v", escrow.Analyses[:2])
+	}
+}
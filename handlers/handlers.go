@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"math"
 	"net/http"
@@ -8,20 +9,42 @@ import (
 
 	"github.com/crusyn/loans/ent"
 	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
 	"github.com/crusyn/loans/ent/sharedloan"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/social"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
 )
 
 type Handler struct {
 	Ent *ent.Client
+	// ShareLinkKey signs share link tokens.
+	ShareLinkKey []byte
+	// JWTKey signs users' bearer tokens.
+	JWTKey []byte
+	// OIDC is the identity provider users can sign in with, nil if there isn't one.
+	OIDC *OIDCProvider
+	// Socials hashes and encrypts users' social security numbers.
+	Socials social.Keyring
 }
 
 type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// withTx returns a copy of the handler whose queries all run in a new transaction, so the checks
+// a change depends on can't be invalidated by another request before it's saved.  The caller
+// commits the transaction, or rolls it back.
+func (h Handler) withTx(ctx context.Context) (Handler, *ent.Tx, error) {
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		return h, nil, err
+	}
+	h.Ent = tx.Client()
+	return h, tx, nil
+}
+
 type newUserRequest struct {
 	Name    string `json:"name"`
 	Social  string `json:"social"`
@@ -29,12 +52,13 @@ type newUserRequest struct {
 }
 
 type newUserResponse struct {
-	UserId int `json:"newUserId"`
+	UserId int    `json:"newUserId"`
+	Social string `json:"social"` // masked, as ***-**-1234
 }
 
 // @Summary Creates User
 // @Schemes
-// @Description Creates User given a `newUserRequest`
+// @Description Creates User given a `newUserRequest`, whose social must be a valid social security number or ITIN
 // @Accept json
 // @Produce json
 // @Param newUserRequest body newUserRequest true "New User Request"
@@ -50,7 +74,14 @@ func (h Handler) CreateUser(ctx *gin.Context) {
 		return
 	}
 
-	socialExists, err := h.Ent.User.Query().Where(user.Social(newUser.Social)).Exist(ctx)
+	if err := social.Validate(newUser.Social); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	socialExists, err := h.Ent.User.Query().Where(user.SocialHashIn(h.Socials.Hashes(newUser.Social)...)).Exist(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Message: "internal error",
@@ -64,9 +95,19 @@ func (h Handler) CreateUser(ctx *gin.Context) {
 		return
 	}
 
+	encrypted, err := h.Socials.Encrypt(newUser.Social)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
 	u, err := h.Ent.User.Create().
 		SetName(newUser.Name).
-		SetSocial(newUser.Social).
+		SetSocialHash(h.Socials.Hash(newUser.Social)).
+		SetSocialEncrypted(encrypted).
+		SetSocialLast4(social.LastFour(newUser.Social)).
 		SetAddress(newUser.Address).
 		Save(ctx)
 	if err != nil {
@@ -79,15 +120,89 @@ func (h Handler) CreateUser(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, newUserResponse{
 		UserId: u.ID,
+		Social: social.Mask(u.SocialLast4),
 	})
 
 }
 
+type socialResponse struct {
+	Social string `json:"social"`
+}
+
+// @Summary Gets Social Security Number
+// @Schemes
+// @Description Decrypts a user's full social security number
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 200 {object} socialResponse
+// @Router /user/{userid}/social [get]
+func (h Handler) GetSocial(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	u, err := h.Ent.User.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find user",
+		})
+		return
+	}
+
+	decrypted, err := h.Socials.Decrypt(u.SocialEncrypted)
+	if err != nil {
+		log.Error().Msgf("could not decrypt user %d's social: %v", u.ID, err)
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if len(decrypted) == 9 {
+		decrypted = decrypted[:3] + "-" + decrypted[3:5] + "-" + decrypted[5:]
+	}
+	ctx.JSON(http.StatusOK, socialResponse{
+		Social: decrypted,
+	})
+}
+
 type newLoanRequest struct {
-	Amount   float64 `json:"amount"`
-	Rate     float64 `json:"rate"`
-	Months   int     `json:"months"`
-	Borrower int     `json:"borrowerID"`
+	Amount    float64           `json:"amount"`
+	Rate      float64           `json:"rate"`
+	Months    int               `json:"months"`
+	Borrower  int               `json:"borrowerID"`
+	Graduated *graduatedPayment `json:"graduated"`
+	// ConstructionMonths makes the loan a construction loan, funded by disbursements with
+	// interest only until it converts to amortize over months.
+	ConstructionMonths int `json:"constructionMonths"`
+	// PMIRate charges private mortgage insurance at this fraction of the amount a year until
+	// the balance falls to 78% of PropertyValue.
+	PropertyValue float64 `json:"propertyValue"`
+	PMIRate       float64 `json:"pmiRate"`
+	// Obligors are the loan's co-borrowers and guarantors.  The borrower is its primary obligor,
+	// jointly and severally liable unless they are listed with another liability.
+	Obligors []obligorRequest `json:"obligors"`
+}
+
+// validateLoanTerms checks the terms shared by every request that describes a loan.
+func validateLoanTerms(amount float64, rate float64, months int) error {
+	if amount <= 0 {
+		return errors.New("loan amount must be positive")
+	}
+	if rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if months <= 0 {
+		return errors.New("term must be positive")
+	}
+	return nil
 }
 
 type newLoanResponse struct {
@@ -112,21 +227,55 @@ func (h Handler) CreateLoan(ctx *gin.Context) {
 		return
 	}
 
-	if newLoan.Amount <= 0 {
+	if err := validateLoanTerms(newLoan.Amount, newLoan.Rate, newLoan.Months); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if newLoan.Graduated != nil {
+		if err := newLoan.Graduated.validate(newLoan.Months); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+	if newLoan.ConstructionMonths < 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "construction months cannot be negative",
+		})
+		return
+	}
+	if newLoan.ConstructionMonths > 0 && newLoan.Graduated != nil {
 		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
-			Message: "loan amount must be positive",
+			Message: "construction loans cannot have graduated payments",
 		})
 		return
 	}
-	if newLoan.Rate <= 0 {
+	if newLoan.PropertyValue < 0 || newLoan.PMIRate < 0 {
 		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
-			Message: "rate must be positive",
+			Message: "property value and mortgage insurance rate cannot be negative",
 		})
 		return
 	}
-	if newLoan.Months <= 0 {
+	if newLoan.PMIRate > 0 && newLoan.PropertyValue == 0 {
 		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
-			Message: "term must be positive",
+			Message: "mortgage insurance requires a property value",
+		})
+		return
+	}
+	if newLoan.PMIRate > 0 && newLoan.ConstructionMonths > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "construction loans cannot have mortgage insurance",
+		})
+		return
+	}
+
+	obligors := withPrimaryObligor(newLoan.Borrower, newLoan.Obligors)
+	if err := validateObligors(newLoan.Borrower, obligors); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
 		})
 		return
 	}
@@ -145,12 +294,58 @@ func (h Handler) CreateLoan(ctx *gin.Context) {
 		return
 	}
 
-	l, err := h.Ent.Loan.Create().
+	obligorIds := []int{}
+	for _, o := range obligors {
+		obligorIds = append(obligorIds, o.UserId)
+	}
+	obligorsExist, err := h.Ent.User.Query().Where(user.IDIn(obligorIds...)).Count(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if obligorsExist != len(obligors) {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "obligor doesn't exist",
+		})
+		return
+	}
+
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	create := tx.Loan.Create().
 		SetAmount(int(newLoan.Amount * 100)).
 		SetRate(newLoan.Rate).
 		SetTerm(newLoan.Months).
 		SetBorrowerID(newLoan.Borrower).
-		Save(ctx)
+		SetConstructionMonths(newLoan.ConstructionMonths).
+		SetPropertyValue(int(math.Round(newLoan.PropertyValue * 100))).
+		SetPmiRate(newLoan.PMIRate)
+	if newLoan.Graduated != nil {
+		create.
+			SetGraduatedStepRate(newLoan.Graduated.StepRate).
+			SetGraduatedYears(newLoan.Graduated.Years).
+			SetNegativeAmortizationCap(newLoan.Graduated.NegativeAmortizationCap)
+	}
+	l, err := create.Save(ctx)
+	for _, o := range obligors {
+		if err != nil {
+			break
+		}
+		err = createObligor(tx.LoanObligor, l.ID, o).Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Message: "internal error",
@@ -164,10 +359,45 @@ func (h Handler) CreateLoan(ctx *gin.Context) {
 }
 
 type loanResponse struct {
-	Id     int     `json:"id"`
-	Amount float64 `json:"amount"`
-	Rate   float64 `json:"rate"`
-	Term   int     `json:"term"`
+	Id        int               `json:"id"`
+	Amount    float64           `json:"amount"`
+	Rate      float64           `json:"rate"`
+	Term      int               `json:"term"`
+	Graduated *graduatedPayment `json:"graduated,omitempty"`
+	// ConstructionMonths precede the term for construction loans.
+	ConstructionMonths int     `json:"constructionMonths,omitempty"`
+	PropertyValue      float64 `json:"propertyValue,omitempty"`
+	PMIRate            float64 `json:"pmiRate,omitempty"`
+	// Obligors are everyone liable for the loan, primary borrower first.
+	Obligors []obligorResponse `json:"obligors"`
+	// Role is the user's part in the loan when listing a user's loans.
+	Role string `json:"role,omitempty" enums:"primary,co_borrower,guarantor,shared"`
+}
+
+func toLoanResponse(l *ent.Loan) loanResponse {
+	return loanResponse{
+		Id:                 l.ID,
+		Amount:             float64(l.Amount) / 100,
+		Rate:               l.Rate,
+		Term:               l.Term,
+		Graduated:          loanGraduatedPayment(l),
+		ConstructionMonths: l.ConstructionMonths,
+		PropertyValue:      float64(l.PropertyValue) / 100,
+		PMIRate:            l.PmiRate,
+		Obligors:           toObligorResponses(l),
+	}
+}
+
+// loanGraduatedPayment returns the graduated payment terms of a loan, or nil if it has level payments.
+func loanGraduatedPayment(l *ent.Loan) *graduatedPayment {
+	if l.GraduatedYears == 0 {
+		return nil
+	}
+	return &graduatedPayment{
+		StepRate:                l.GraduatedStepRate,
+		Years:                   l.GraduatedYears,
+		NegativeAmortizationCap: l.NegativeAmortizationCap,
+	}
 }
 
 // @Summary Gets Loan Information
@@ -189,7 +419,10 @@ func (h Handler) GetLoan(ctx *gin.Context) {
 		return
 	}
 
-	l, err := h.Ent.Loan.Get(ctx, i)
+	l, err := h.Ent.Loan.Query().
+		Where(loan.ID(i)).
+		WithObligors().
+		Only(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusNotFound, ErrorResponse{
 			Message: "could not find loan",
@@ -197,18 +430,13 @@ func (h Handler) GetLoan(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, loanResponse{
-		Id:     l.ID,
-		Amount: float64(l.Amount) / 100,
-		Rate:   l.Rate,
-		Term:   l.Term,
-	})
+	ctx.JSON(http.StatusOK, toLoanResponse(l))
 }
 
 // @Summary Gets Loans by User
 // @Schemes
-// @Description Gets Loans associated with a specific user.  The user may be the borrower
-// @Description or the loan may be shared with that user.
+// @Description Gets Loans associated with a specific user.  The user may be the borrower, a
+// @Description co-borrower or guarantor, or the loan may be shared with that user.
 // @Accept json
 // @Produce json
 // @Param userid path int true "User Id"
@@ -242,6 +470,7 @@ func (h Handler) GetLoans(ctx *gin.Context) {
 
 	loans, err := h.Ent.Loan.Query().
 		Where(loan.BorrowerID(userId)).
+		WithObligors().
 		All(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -251,19 +480,46 @@ func (h Handler) GetLoans(ctx *gin.Context) {
 	}
 
 	response := []loanResponse{}
+	listed := map[int]bool{}
 
 	for _, l := range loans {
-		response = append(response, loanResponse{
-			Id:     l.ID,
-			Amount: float64(l.Amount) / 100,
-			Rate:   l.Rate,
-			Term:   l.Term,
+		r := toLoanResponse(l)
+		r.Role = loanobligor.RolePrimary.String()
+		response = append(response, r)
+		listed[l.ID] = true
+	}
+
+	obligations, err := h.Ent.LoanObligor.Query().
+		Where(
+			loanobligor.UserID(userId),
+			loanobligor.RoleNEQ(loanobligor.RolePrimary),
+		).
+		WithLoan(func(q *ent.LoanQuery) {
+			q.WithObligors()
+		}).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
 		})
+		return
+	}
+
+	for _, o := range obligations {
+		if listed[o.LoanID] {
+			continue
+		}
+		r := toLoanResponse(o.Edges.Loan)
+		r.Role = o.Role.String()
+		response = append(response, r)
+		listed[o.LoanID] = true
 	}
 
 	sharedLoans, err := h.Ent.SharedLoan.Query().
 		Where(sharedloan.UserID(userId)).
-		WithLoan().
+		WithLoan(func(q *ent.LoanQuery) {
+			q.WithObligors()
+		}).
 		All(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -273,12 +529,13 @@ func (h Handler) GetLoans(ctx *gin.Context) {
 	}
 
 	for _, l := range sharedLoans {
-		response = append(response, loanResponse{
-			Id:     l.Edges.Loan.ID,
-			Amount: float64(l.Edges.Loan.Amount) / 100,
-			Rate:   l.Edges.Loan.Rate,
-			Term:   l.Edges.Loan.Term,
-		})
+		if listed[l.LoanID] {
+			continue
+		}
+		r := toLoanResponse(l.Edges.Loan)
+		r.Role = "shared"
+		response = append(response, r)
+		listed[l.LoanID] = true
 	}
 
 	ctx.JSON(http.StatusOK, response)
@@ -288,6 +545,13 @@ type loanMonthResponseItem struct {
 	Month            int     `json:"month"`
 	RemainingBalance float64 `json:"remainingBalance"`
 	MonthlyPayment   float64 `json:"monthlyPayment"`
+	Deferred         bool    `json:"deferred,omitempty"`
+	Capitalized      float64 `json:"capitalized,omitempty"` // interest added to the balance this month
+	Forgiven         float64 `json:"forgiven,omitempty"`
+	Disbursed        float64 `json:"disbursed,omitempty"`
+	Escrow           float64 `json:"escrow,omitempty"` // included in monthlyPayment
+	PMI              float64 `json:"pmi,omitempty"`    // included in monthlyPayment
+	PMIRemoved       bool    `json:"pmiRemoved,omitempty"`
 }
 
 // @Summary Gets Loan Schedule
@@ -319,7 +583,7 @@ func (h Handler) GetLoanSchedule(ctx *gin.Context) {
 
 	months := []loanMonthResponseItem{}
 
-	schedule, err := CreateAmortizationSchedule(float64(l.Amount)/100, l.Rate, l.Term)
+	schedule, err := h.loanSchedule(ctx, l)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Message: "could not generate amortization schedule",
@@ -331,7 +595,14 @@ func (h Handler) GetLoanSchedule(ctx *gin.Context) {
 		months = append(months, loanMonthResponseItem{
 			Month:            m.Month,
 			RemainingBalance: m.EndingBalance,
-			MonthlyPayment:   m.MonthlyPayment,
+			MonthlyPayment:   roundCents(m.MonthlyPayment + m.Escrow + m.PMI),
+			Deferred:         m.Deferred,
+			Capitalized:      m.Capitalized,
+			Forgiven:         m.Forgiven,
+			Disbursed:        m.Disbursed,
+			Escrow:           m.Escrow,
+			PMI:              m.PMI,
+			PMIRemoved:       m.PMIRemoved,
 		})
 	}
 
@@ -343,6 +614,9 @@ type loanMonthSummaryResponse struct {
 	EndingBalance      float64 `json:"endingBalance"`
 	TotalPrincipalPaid float64 `json:"totalPrincipalPaid"`
 	TotalInterestPaid  float64 `json:"totalInterestPaid"`
+	EscrowBalance      float64 `json:"escrowBalance,omitempty"`
+	// PMIRemovalMonth is the first month without mortgage insurance, whether or not it has passed.
+	PMIRemovalMonth int `json:"pmiRemovalMonth,omitempty"`
 }
 
 // @Summary Gets Loan Month Summary
@@ -373,7 +647,7 @@ func (h Handler) GetMonthSummary(ctx *gin.Context) {
 		return
 	}
 
-	schedule, err := CreateAmortizationSchedule(float64(l.Amount)/100, l.Rate, l.Term)
+	schedule, err := h.loanSchedule(ctx, l)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Message: "could not generate amortization schedule",
@@ -390,7 +664,13 @@ func (h Handler) GetMonthSummary(ctx *gin.Context) {
 		return
 	}
 
-	if n > l.Term {
+	if n < 1 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "month number must be positive",
+		})
+		return
+	}
+	if n > len(schedule) {
 		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
 			Message: "month number cannot be greater than term",
 		})
@@ -401,21 +681,27 @@ func (h Handler) GetMonthSummary(ctx *gin.Context) {
 		EndingBalance:      schedule[n-1].EndingBalance,
 		TotalPrincipalPaid: schedule[n-1].TotalPrincipalPaid,
 		TotalInterestPaid:  schedule[n-1].TotalInterestPaid,
+		EscrowBalance:      schedule[n-1].EscrowBalance,
+		PMIRemovalMonth:    pmiRemovalMonth(schedule),
 	})
 }
 
 type loanShareRequest struct {
-	UserId int `json:"id"`
+	UserId     int    `json:"id"`
+	Permission string `json:"permission" enums:"view_balance,view_schedule,make_payments,manage_shares"` // defaults to view_schedule
+	// ExpiresInDays is how long the user has to accept the invitation, a week by default.
+	ExpiresInDays int `json:"expiresInDays"`
 }
 
 // @Summary Shares Loan
 // @Schemes
-// @Description Shares loan with another user that is not the borrower
+// @Description Invites another user that is not the borrower to share the loan.  It's shared with them
+// @Description once they accept.  Sharing it with a user who already has it changes their permission.
 // @Accept json
 // @Produce json
 // @Param loanid path int true "Loan Id"
 // @Param loanShareRequest body loanShareRequest true "Share User Request"
-// @Success 200
+// @Success 200 {object} invitationResponse
 // @Router /loan/{loanid}/share [post]
 func (h Handler) ShareLoan(ctx *gin.Context) {
 	id := ctx.Param("id")
@@ -470,7 +756,26 @@ func (h Handler) ShareLoan(ctx *gin.Context) {
 		return
 	}
 
-	userExists, err := h.Ent.User.Query().Where(user.ID(req.UserId)).Exist(ctx)
+	if req.Permission == "" {
+		req.Permission = sharedloan.DefaultPermission.String()
+	}
+	if err := sharedloan.PermissionValidator(sharedloan.Permission(req.Permission)); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "permission must be view_balance, view_schedule, make_payments or manage_shares",
+		})
+		return
+	}
+	if req.ExpiresInDays == 0 {
+		req.ExpiresInDays = invitationExpiryDays
+	}
+	if req.ExpiresInDays < 0 || req.ExpiresInDays > maxInvitationExpiryDays {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "invitations must expire within 90 days",
+		})
+		return
+	}
+
+	userExists, err := h.userExists(ctx, req.UserId)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Message: "internal error",
@@ -498,22 +803,106 @@ func (h Handler) ShareLoan(ctx *gin.Context) {
 	}
 
 	if loanShareExists {
-		ctx.JSON(http.StatusOK, ErrorResponse{
-			Message: "shared loan already exists",
-		})
+		_, err = h.Ent.SharedLoan.Update().
+			Where(
+				sharedloan.UserID(req.UserId),
+				sharedloan.LoanID(loanId),
+			).
+			SetPermission(sharedloan.Permission(req.Permission)).
+			Save(ctx)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "internal error",
+			})
+		}
 		return
 	}
 
-	err = h.Ent.SharedLoan.Create().
-		SetLoanID(loanId).
-		SetUserID(req.UserId).
-		Exec(ctx)
+	h.inviteToLoan(ctx, loanId, req)
+}
+
+// loanSchedule builds the amortization schedule for a saved loan, applying every
+// modification made to its terms, every payment deferral and every recast since origination,
+// its income-driven repayment plan if it has one and, for construction loans, its disbursements.
+// Escrow and mortgage insurance collected with each payment are set on the schedule when the
+// loan has them.
+func (h Handler) loanSchedule(ctx context.Context, l *ent.Loan) ([]monthlySummary, error) {
+	modifications, err := h.loanModifications(ctx, l.ID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-			Message: "internal error",
+		return nil, err
+	}
+
+	deferrals, err := h.loanDeferrals(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recasts, err := h.loanRecasts(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := h.loanIncomeDrivenPlan(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := loanOptions{
+		Graduated:    loanGraduatedPayment(l),
+		IncomeDriven: toIncomeDrivenRepayment(plan),
+	}
+	if l.ConstructionMonths > 0 {
+		disbursements, err := h.loanDisbursements(ctx, l.ID)
+		if err != nil {
+			return nil, err
+		}
+		options.Construction = &constructionPhase{Months: l.ConstructionMonths}
+		for _, d := range disbursements {
+			options.Construction.Disbursements = append(options.Construction.Disbursements, disbursement{
+				Month:  d.Month,
+				Amount: float64(d.Amount) / 100,
+			})
+		}
+	}
+	for _, r := range recasts {
+		options.Recasts = append(options.Recasts, recast{
+			Month:       r.Month,
+			Curtailment: float64(r.Curtailment) / 100,
+		})
+	}
+	for _, d := range deferrals {
+		options.Deferrals = append(options.Deferrals, paymentDeferral{
+			StartMonth: d.StartMonth,
+			Months:     d.Months,
+			Interest:   d.Interest.String(),
+		})
+	}
+	for _, m := range modifications {
+		options.Modifications = append(options.Modifications, termsModification{
+			EffectiveMonth:     m.EffectiveMonth,
+			Rate:               m.Rate,
+			Months:             m.Term,
+			CapitalizedArrears: float64(m.CapitalizedArrears) / 100,
 		})
-		return
 	}
+
+	schedule, err := CreateAmortizationScheduleWithOptions(float64(l.Amount)/100, l.Rate, l.Term, options)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.loanEscrowItems(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > 0 {
+		applyEscrow(schedule, toEscrowItems(items))
+	}
+	if l.PmiRate > 0 {
+		applyMortgageInsurance(schedule, toMortgageInsurance(l))
+	}
+
+	return schedule, nil
 }
 
 func monthlyPayment(loanAmountCents int, annualInterestRate float64, termMonths int) (int, error) {
@@ -536,17 +925,251 @@ func monthlyPayment(loanAmountCents int, annualInterestRate float64, termMonths
 }
 
 type monthlySummary struct {
-	Month              int
-	BeginningBalance   float64
-	EndingBalance      float64
-	MonthlyPayment     float64
-	TotalPrincipalPaid float64
-	TotalInterestPaid  float64
-	CurrentInterest    float64
-	CurrentPrincipal   float64
+	Month                int
+	BeginningBalance     float64
+	EndingBalance        float64
+	MonthlyPayment       float64
+	TotalPrincipalPaid   float64
+	TotalInterestPaid    float64
+	CurrentInterest      float64
+	CurrentPrincipal     float64
+	ExtraPrincipal       float64
+	Capitalized          float64 // added to the balance at the start of the month
+	Deferred             bool    // no payment is due this month
+	AccruedInterest      float64 // charged while deferred but not paid this month
+	NegativeAmortization float64 // interest the payment did not cover, added to the balance
+	Recast               bool    // the payment is re-amortized from the next month
+	Forgiven             float64 // balance and unpaid interest forgiven at the end of an income-driven plan
+	Construction         bool    // interest only on the disbursed principal, before conversion
+	Disbursed            float64 // principal funded at the start of the month
+	Escrow               float64 // collected for escrow on top of MonthlyPayment
+	EscrowBalance        float64 // escrow account balance after the month's bills are paid
+	PMI                  float64 // mortgage insurance premium collected on top of MonthlyPayment
+	PMIRemoved           bool    // mortgage insurance is no longer charged from this month
+}
+
+// loanOptions are the optional, advanced terms a schedule can be built with.
+// The zero value produces the standard fully amortizing schedule.
+type loanOptions struct {
+	ExtraMonthlyPayment float64           `json:"extraMonthlyPayment"` // paid toward principal every month
+	Prepayments         []prepayment      `json:"prepayments"`         // one-off principal curtailments
+	Recasts             []recast          `json:"recasts"`             // lower the payment instead of the term
+	Graduated           *graduatedPayment `json:"graduated"`
+
+	// Modifications, Deferrals, IncomeDriven and Construction are loaded from a saved loan's
+	// history and are never accepted from clients.
+	Modifications []termsModification    `json:"-"`
+	Deferrals     []paymentDeferral      `json:"-"`
+	IncomeDriven  *incomeDrivenRepayment `json:"-"`
+	Construction  *constructionPhase     `json:"-"`
+}
+
+type prepayment struct {
+	Month  int     `json:"month"`
+	Amount float64 `json:"amount"`
+}
+
+// recast applies an optional lump-sum curtailment after the payment in Month and
+// re-amortizes the balance over the months left to maturity, lowering the payment
+// from the next month on while keeping the maturity.
+type recast struct {
+	Month       int     `json:"month"`
+	Curtailment float64 `json:"curtailment"`
+}
+
+// graduatedPayment starts with a lower payment that steps up by StepRate every year for
+// Years years and then stays level until the loan is paid off.  Early payments may not
+// cover the interest due; the shortfall is added to the balance.  When the balance
+// exceeds NegativeAmortizationCap times the original amount the loan is recast into
+// level, fully amortizing payments.
+type graduatedPayment struct {
+	StepRate                float64 `json:"stepRate"` // e.g. 0.075 for 7.5% a year
+	Years                   int     `json:"years"`
+	NegativeAmortizationCap float64 `json:"negativeAmortizationCap"` // e.g. 1.1 for 110%, 0 for no cap
+}
+
+func (g graduatedPayment) validate(termMonths int) error {
+	if g.StepRate <= 0 {
+		return errors.New("graduated step rate must be positive")
+	}
+	if g.Years <= 0 || g.Years*12 >= termMonths {
+		return errors.New("graduated years must be positive and end before the term")
+	}
+	if g.NegativeAmortizationCap != 0 && g.NegativeAmortizationCap <= 1 {
+		return errors.New("negative amortization cap must be more than 100% of the amount")
+	}
+	return nil
+}
+
+// graduatedPayments returns the payment in cents for each year of graduation, with the
+// level payment for the rest of the term last.  The first payment is sized so the
+// stepped payments fully amortize the loan, and like monthlyPayment it is rounded up
+// with an extra cent.
+func graduatedPayments(loanAmountCents int, annualInterestRate float64, termMonths int, g graduatedPayment) ([]int, error) {
+	if loanAmountCents <= 0 {
+		return nil, errors.New("loan amount must be positive")
+	}
+	if annualInterestRate <= 0 {
+		return nil, errors.New("interest rate must be positive")
+	}
+	if err := g.validate(termMonths); err != nil {
+		return nil, err
+	}
+
+	monthlyInterestRate := annualInterestRate / 12
+	discount := 1 / (1 + monthlyInterestRate)
+	annuity := func(months int) float64 {
+		return (1 - math.Pow(discount, float64(months))) / monthlyInterestRate
+	}
+
+	// present value of paying 1 in the first year and stepping up from there
+	factor := 0.0
+	for year := 0; year < g.Years; year++ {
+		factor += math.Pow(1+g.StepRate, float64(year)) * annuity(12) * math.Pow(discount, float64(12*year))
+	}
+	factor += math.Pow(1+g.StepRate, float64(g.Years)) * annuity(termMonths-12*g.Years) * math.Pow(discount, float64(12*g.Years))
+
+	first := int(math.Ceil(float64(loanAmountCents)/factor)) + 1
+	payments := make([]int, g.Years+1)
+	for year := range payments {
+		payments[year] = int(math.Ceil(float64(first) * math.Pow(1+g.StepRate, float64(year))))
+	}
+	return payments, nil
+}
+
+// incomeDrivenRepayment sets the payment from StartMonth to a share of discretionary
+// income, recalculated each year from Incomes; the last certified income carries forward.
+// Interest the payment does not cover accrues without compounding and is paid before
+// principal.  Anything owed after ForgivenessMonths of repayment is forgiven.
+type incomeDrivenRepayment struct {
+	StartMonth        int
+	PaymentPercent    float64
+	PovertyMultiplier float64
+	Guideline         povertyGuideline
+	ForgivenessMonths int
+	Incomes           []certifiedIncome // one per year of repayment
+}
+
+type certifiedIncome struct {
+	Income     float64
+	FamilySize int
+}
+
+// povertyGuideline is one region's row of the HHS poverty guideline table.
+type povertyGuideline struct {
+	Base                float64 `json:"base"` // family of one
+	PerAdditionalPerson float64 `json:"perAdditionalPerson"`
+}
+
+// povertyGuidelines are the 2024 HHS poverty guidelines by region.
+var povertyGuidelines = map[string]povertyGuideline{
+	"contiguous": {Base: 15060, PerAdditionalPerson: 5380},
+	"alaska":     {Base: 18810, PerAdditionalPerson: 6730},
+	"hawaii":     {Base: 17310, PerAdditionalPerson: 6190},
+}
+
+// monthlyPaymentCents is the income-driven payment for a year with income, rounded up to the cent.
+func (p incomeDrivenRepayment) monthlyPaymentCents(income certifiedIncome) int {
+	guideline := p.Guideline.Base + p.Guideline.PerAdditionalPerson*float64(income.FamilySize-1)
+	discretionary := income.Income - p.PovertyMultiplier*guideline
+	if discretionary <= 0 {
+		return 0
+	}
+	return int(math.Ceil(discretionary * p.PaymentPercent * 100 / 12))
+}
+
+// constructionPhase funds a loan in disbursements over its first Months months, charging
+// interest only on what has been disbursed.  The loan then converts to permanent financing,
+// amortizing the disbursed principal over the loan's term.
+type constructionPhase struct {
+	Months        int
+	Disbursements []disbursement
+}
+
+type disbursement struct {
+	Month  int
+	Amount float64
+}
+
+// termsModification replaces the rate and remaining term from EffectiveMonth onward.
+type termsModification struct {
+	EffectiveMonth     int
+	Rate               float64
+	Months             int // remaining term, counted from EffectiveMonth
+	CapitalizedArrears float64
+}
+
+const (
+	deferredInterestCapitalize = "capitalize" // added to the balance when payments resume
+	deferredInterestDefer      = "defer"      // due with the final payment
+	deferredInterestWaive      = "waive"      // never charged
+)
+
+// paymentDeferral pauses payments for Months months starting at StartMonth and pushes
+// the maturity of the loan back by the same number of months.
+type paymentDeferral struct {
+	StartMonth int
+	Months     int
+	Interest   string
+}
+
+// validate checks options supplied by a client for a loan of termMonths months.
+func (o loanOptions) validate(termMonths int) error {
+	if o.ExtraMonthlyPayment < 0 {
+		return errors.New("extra monthly payment cannot be negative")
+	}
+	for _, p := range o.Prepayments {
+		if p.Month < 1 || p.Month > termMonths {
+			return errors.New("prepayment month must be within the term")
+		}
+		if p.Amount <= 0 {
+			return errors.New("prepayment amount must be positive")
+		}
+	}
+	for _, r := range o.Recasts {
+		if r.Month < 1 || r.Month >= termMonths {
+			return errors.New("recast month must be before the end of the term")
+		}
+		if r.Curtailment < 0 {
+			return errors.New("curtailment cannot be negative")
+		}
+	}
+	if o.Graduated != nil {
+		return o.Graduated.validate(termMonths)
+	}
+	return nil
 }
 
 func CreateAmortizationSchedule(loanAmount float64, annualInterestRate float64, termMonths int) ([]monthlySummary, error) {
+	return CreateAmortizationScheduleWithOptions(loanAmount, annualInterestRate, termMonths, loanOptions{})
+}
+
+// CreateAmortizationScheduleWithOptions builds the schedule like CreateAmortizationSchedule but
+// applies any extra principal in options.  Extra principal shortens the term rather than
+// lowering the payment, so the schedule ends in the month the balance reaches zero.  A recast
+// instead keeps the maturity and lowers the payment.
+//
+// Modifications re-amortize the balance, including any capitalized arrears, over their new
+// term starting in their effective month, which moves the maturity of the loan.
+//
+// Deferred months have no payment and extend the maturity.  Interest accrues on the
+// principal alone while deferred and is treated as the deferral specifies; when it is
+// capitalized the new balance is re-amortized over the remaining term.
+//
+// Income-driven repayment sets the payment from the borrower's income instead of amortizing
+// the balance, and forgives whatever is owed at the end of the plan.  Modifications once the plan
+// has started change the rate but not the plan's term.
+//
+// Construction loans start with no balance and pay interest only on their disbursements until
+// they convert, then amortize the disbursed principal over termMonths.  loanAmount is only the
+// commitment and is never funded itself.
+//
+// Graduated payments step up each year and may negatively amortize until they level off.
+// Any event that re-amortizes the loan ends the graduation with level payments.
+//
+// Client supplied options must already have passed loanOptions.validate; events loaded
+// from a saved loan's history are validated when they are saved.
+func CreateAmortizationScheduleWithOptions(loanAmount float64, annualInterestRate float64, termMonths int, options loanOptions) ([]monthlySummary, error) {
 	loanAmountCents := int(loanAmount * 100)
 
 	paymentCents, err := monthlyPayment(loanAmountCents, annualInterestRate, termMonths)
@@ -554,32 +1177,216 @@ func CreateAmortizationSchedule(loanAmount float64, annualInterestRate float64,
 		return nil, err
 	}
 
-	summaries := make([]monthlySummary, termMonths)
+	extraCents := int(math.Round(options.ExtraMonthlyPayment * 100))
+	prepaymentCents := map[int]int{}
+	for _, p := range options.Prepayments {
+		prepaymentCents[p.Month] += int(math.Round(p.Amount * 100))
+	}
+	modifications := map[int]termsModification{}
+	for _, m := range options.Modifications {
+		modifications[m.EffectiveMonth] = m
+	}
+	deferrals := map[int]paymentDeferral{}
+	for _, d := range options.Deferrals {
+		deferrals[d.StartMonth] = d
+	}
+	recasts := map[int]int{}
+	for _, r := range options.Recasts {
+		recasts[r.Month] += int(math.Round(r.Curtailment * 100))
+	}
+	var steps []int
+	negativeAmortizationCap := 0
+	if options.Graduated != nil {
+		steps, err = graduatedPayments(loanAmountCents, annualInterestRate, termMonths, *options.Graduated)
+		if err != nil {
+			return nil, err
+		}
+		negativeAmortizationCap = int(math.Ceil(float64(loanAmountCents) * options.Graduated.NegativeAmortizationCap))
+	}
+	graduating := len(steps) > 0
+
+	summaries := make([]monthlySummary, 0, termMonths)
 
 	outstandingBeginningBalance := loanAmountCents
 	totalPricipalPaid := 0
 	totalInterestPaid := 0
+	maturity := termMonths
+	var deferral paymentDeferral
+	deferredThrough := 0
+	accruedInterest := 0  // capitalized when payments resume
+	deferredInterest := 0 // due with the final payment
+	unpaidInterest := 0   // left over by income-driven payments, paid before principal
+	idr := options.IncomeDriven
+	repaidOnIncome := false
 	i := 0
-	for i < termMonths {
+	if c := options.Construction; c != nil {
+		disbursedCents := map[int]int{}
+		for _, d := range c.Disbursements {
+			disbursedCents[d.Month] += int(math.Round(d.Amount * 100))
+		}
+
+		outstandingBeginningBalance = 0
+		for i < c.Months {
+			disbursed := disbursedCents[i+1]
+			outstandingBeginningBalance = outstandingBeginningBalance + disbursed
+			currentInterest := int(math.Ceil(float64(outstandingBeginningBalance) * (annualInterestRate / 12)))
+			totalInterestPaid = totalInterestPaid + currentInterest
+
+			summaries = append(summaries, monthlySummary{
+				Month:              i + 1,
+				BeginningBalance:   float64(outstandingBeginningBalance) / 100,
+				MonthlyPayment:     float64(currentInterest) / 100,
+				CurrentInterest:    float64(currentInterest) / 100,
+				Construction:       true,
+				Disbursed:          float64(disbursed) / 100,
+				TotalPrincipalPaid: float64(totalPricipalPaid) / 100,
+				TotalInterestPaid:  float64(totalInterestPaid) / 100,
+				EndingBalance:      float64(outstandingBeginningBalance) / 100,
+			})
+			i = i + 1
+		}
+
+		maturity = c.Months + termMonths
+		if outstandingBeginningBalance > 0 {
+			paymentCents, err = monthlyPayment(outstandingBeginningBalance, annualInterestRate, termMonths)
+			if err != nil {
+				return nil, err
+			}
+		}
+		graduating = false
+	}
+	for i < maturity && outstandingBeginningBalance > 0 {
+		if d, ok := deferrals[i+1]; ok {
+			deferral = d
+			deferredThrough = i + d.Months
+			maturity = maturity + d.Months
+		}
+
+		if i < deferredThrough {
+			interest := 0
+			if deferral.Interest != deferredInterestWaive {
+				interest = int(math.Ceil(float64(outstandingBeginningBalance) * (annualInterestRate / 12)))
+			}
+			if deferral.Interest == deferredInterestCapitalize {
+				accruedInterest = accruedInterest + interest
+			} else {
+				deferredInterest = deferredInterest + interest
+			}
+
+			summaries = append(summaries, monthlySummary{
+				Month:              i + 1,
+				BeginningBalance:   float64(outstandingBeginningBalance) / 100,
+				Deferred:           true,
+				AccruedInterest:    float64(interest) / 100,
+				TotalPrincipalPaid: float64(totalPricipalPaid) / 100,
+				TotalInterestPaid:  float64(totalInterestPaid) / 100,
+				EndingBalance:      float64(outstandingBeginningBalance) / 100,
+			})
+			i = i + 1
+			continue
+		}
+
+		capitalized := 0
+		if accruedInterest > 0 {
+			capitalized = accruedInterest
+			accruedInterest = 0
+			outstandingBeginningBalance = outstandingBeginningBalance + capitalized
+			paymentCents, err = monthlyPayment(outstandingBeginningBalance, annualInterestRate, maturity-i)
+			if err != nil {
+				return nil, err
+			}
+			graduating = false
+		}
+		if m, ok := modifications[i+1]; ok {
+			arrears := int(math.Round(m.CapitalizedArrears * 100))
+			capitalized = capitalized + arrears
+			outstandingBeginningBalance = outstandingBeginningBalance + arrears
+			annualInterestRate = m.Rate
+			if !repaidOnIncome { // income-driven plans keep their own term
+				maturity = i + m.Months
+			}
+			paymentCents, err = monthlyPayment(outstandingBeginningBalance, annualInterestRate, m.Months)
+			if err != nil {
+				return nil, err
+			}
+			graduating = false
+		}
+		if graduating {
+			paymentCents = steps[min(i/12, len(steps)-1)]
+		}
+		repayingOnIncome := idr != nil && i+1 >= idr.StartMonth && len(idr.Incomes) > 0
+		if repayingOnIncome {
+			if !repaidOnIncome {
+				maturity = i + idr.ForgivenessMonths
+				repaidOnIncome = true
+			}
+			year := (i + 1 - idr.StartMonth) / 12
+			paymentCents = idr.monthlyPaymentCents(idr.Incomes[min(year, len(idr.Incomes)-1)])
+			graduating = false
+		}
+
 		currentInterest := int(math.Ceil(float64(outstandingBeginningBalance) * (annualInterestRate / 12)))
 		currentPrinciple := paymentCents - currentInterest
+		negativeAmortization := 0
+		if repayingOnIncome {
+			unpaidInterest = unpaidInterest + currentInterest
+			currentInterest = min(paymentCents, unpaidInterest)
+			unpaidInterest = unpaidInterest - currentInterest
+			currentPrinciple = paymentCents - currentInterest
+		} else if currentPrinciple < 0 {
+			negativeAmortization = -currentPrinciple
+			currentInterest = paymentCents
+			currentPrinciple = 0
+		}
 		if outstandingBeginningBalance < currentPrinciple {
 			currentPrinciple = outstandingBeginningBalance
 		}
+		curtailment, recasting := recasts[i+1]
+		extraPrincipal := extraCents + prepaymentCents[i+1] + curtailment
+		if outstandingBeginningBalance-currentPrinciple < extraPrincipal {
+			extraPrincipal = outstandingBeginningBalance - currentPrinciple
+		}
 		totalInterestPaid = totalInterestPaid + currentInterest
-		totalPricipalPaid = totalPricipalPaid + currentPrinciple
-		endingBalance := outstandingBeginningBalance - currentPrinciple
-
-		summaries[i] = monthlySummary{
-			Month:              i + 1,
-			BeginningBalance:   float64(outstandingBeginningBalance) / 100,
-			MonthlyPayment:     float64(currentInterest+currentPrinciple) / 100,
-			CurrentInterest:    float64(currentInterest) / 100,
-			CurrentPrincipal:   float64(currentPrinciple) / 100,
-			TotalPrincipalPaid: float64(totalPricipalPaid) / 100,
-			TotalInterestPaid:  float64(totalInterestPaid) / 100,
-			EndingBalance:      float64(endingBalance) / 100,
+		totalPricipalPaid = totalPricipalPaid + currentPrinciple + extraPrincipal
+		endingBalance := outstandingBeginningBalance - currentPrinciple - extraPrincipal + negativeAmortization
+		if graduating && negativeAmortizationCap > 0 && endingBalance > negativeAmortizationCap {
+			recasting = true
+		}
+		reamortized := false
+		if recasting && endingBalance > 0 && maturity > i+1 {
+			paymentCents, err = monthlyPayment(endingBalance, annualInterestRate, maturity-i-1)
+			if err != nil {
+				return nil, err
+			}
+			graduating = false
+			reamortized = true
 		}
+		forgiven := 0
+		if repayingOnIncome && i+1 == maturity && endingBalance > 0 {
+			forgiven = endingBalance + unpaidInterest + deferredInterest
+			endingBalance, unpaidInterest, deferredInterest = 0, 0, 0
+		}
+		if endingBalance == 0 && deferredInterest > 0 {
+			currentInterest = currentInterest + deferredInterest
+			totalInterestPaid = totalInterestPaid + deferredInterest
+			deferredInterest = 0
+		}
+
+		summaries = append(summaries, monthlySummary{
+			Month:                i + 1,
+			BeginningBalance:     float64(outstandingBeginningBalance) / 100,
+			MonthlyPayment:       float64(currentInterest+currentPrinciple) / 100,
+			CurrentInterest:      float64(currentInterest) / 100,
+			CurrentPrincipal:     float64(currentPrinciple) / 100,
+			ExtraPrincipal:       float64(extraPrincipal) / 100,
+			Capitalized:          float64(capitalized) / 100,
+			NegativeAmortization: float64(negativeAmortization) / 100,
+			Recast:               reamortized,
+			Forgiven:             float64(forgiven) / 100,
+			TotalPrincipalPaid:   float64(totalPricipalPaid) / 100,
+			TotalInterestPaid:    float64(totalInterestPaid) / 100,
+			EndingBalance:        float64(endingBalance) / 100,
+		})
 
 		outstandingBeginningBalance = endingBalance
 		i = i + 1
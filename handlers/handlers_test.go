@@ -4,17 +4,26 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/rs/zerolog/log"
 
 	"github.com/crusyn/loans/ent"
+	_ "github.com/crusyn/loans/ent/runtime"
+	"github.com/crusyn/loans/ent/staffrole"
 	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/pii"
+	"github.com/crusyn/loans/social"
+	"github.com/crusyn/loans/viewer"
 	"github.com/gin-gonic/gin"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -24,15 +33,204 @@ import (
 func GetTestGinContext(w *httptest.ResponseRecorder) *gin.Context {
 	gin.SetMode(gin.TestMode)
 
-	ctx, _ := gin.CreateTestContext(w)
-	ctx.Request = &http.Request{
+	ctx, engine := gin.CreateTestContext(w)
+	engine.ContextWithFallback = true
+	ctx.Request = (&http.Request{
 		Header: make(http.Header),
 		URL:    &url.URL{},
+	}).WithContext(adminContext())
+
+	return ctx
+}
+
+// adminContext is a context for a service, which the privacy policies let see everything.
+func adminContext() context.Context {
+	return viewer.NewContext(context.Background(), viewer.Viewer{Admin: true})
+}
+
+// newAnonymousTestRouter returns a router whose requests have no principal until they authenticate.
+func newAnonymousTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.ContextWithFallback = true
+	return r
+}
+
+// newTestRouter returns a router whose requests are made by an admin service, unless they
+// authenticate.
+func newTestRouter() *gin.Engine {
+	r := newAnonymousTestRouter()
+	r.Use(func(ctx *gin.Context) {
+		ctx.Set(principalKey, Principal{Service: "test", Roles: []staffrole.Role{staffrole.RoleAdmin}})
+		ctx.Request = ctx.Request.WithContext(adminContext())
+	})
+	return r
+}
+
+// newTestHandler opens a fresh in-memory database for a single test.
+func newTestHandler(t *testing.T) Handler {
+	t.Helper()
+
+	client, err := ent.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	return Handler{
+		Ent:          client,
+		ShareLinkKey: []byte("test share link key"),
+		JWTKey:       []byte("test jwt key"),
+		Socials:      testSocials,
 	}
+}
+
+// testPII encrypts users' personal information in tests.
+var testPII = pii.Keyring{
+	Current: "test",
+	Keys:    map[string][]byte{"test": []byte("test pii key, also 32 bytes long")},
+}
+
+func init() {
+	pii.SetKeyProvider(testPII)
+}
+
+// testSocials hashes and encrypts socials in tests.
+var testSocials = social.Keyring{
+	Current: "test",
+	Keys:    map[string][]byte{"test": []byte("test social key, 32 bytes long..")},
+}
+
+// newTestJSONContext builds a gin context carrying body as its JSON request.
+func newTestJSONContext(t *testing.T, w *httptest.ResponseRecorder, method string, body any) *gin.Context {
+	t.Helper()
+
+	ctx := GetTestGinContext(w)
+	ctx.Request.Method = method
+	ctx.Request.Header.Set("Content-Type", "application/json")
+
+	jsonbytes, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("could not marshal request: %v", err)
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewBuffer(jsonbytes))
 
 	return ctx
 }
 
+// callTestHandler calls a handler directly with a request carrying the raw query, body as JSON
+// unless it's nil, and params, and returns the response.
+func callTestHandler(t *testing.T, handle gin.HandlerFunc, method string, query string, body any, params ...gin.Param) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	var ctx *gin.Context
+	if body != nil {
+		ctx = newTestJSONContext(t, w, method, body)
+	} else {
+		ctx = GetTestGinContext(w)
+		ctx.Request.Method = method
+	}
+	ctx.Request.URL = &url.URL{RawQuery: query}
+	ctx.Params = params
+
+	handle(ctx)
+
+	return w
+}
+
+// serveTestRequest serves a request through a router, with headers given as name, value pairs;
+// ones with no name are skipped, so tables can leave them out.  A string body is sent as it is, any other body as JSON unless it's nil.
+func serveTestRequest(t *testing.T, r http.Handler, method string, path string, body any, headers ...string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(b)
+	default:
+		jsonbytes, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("could not marshal request: %v", err)
+		}
+		reader = bytes.NewReader(jsonbytes)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		if headers[i] != "" {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// testBearer is the Authorization header of a user signed in for an hour.
+func testBearer(t *testing.T, h Handler, userId int) string {
+	t.Helper()
+
+	token, err := h.signToken(tokenClaims{Issuer: tokenIssuer, Subject: strconv.Itoa(userId), ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	if err != nil {
+		t.Fatalf("could not sign token: %v", err)
+	}
+	return "Bearer " + token
+}
+
+// idParam is the id path param of a handler's loan, user or other entity.
+func idParam(id int) gin.Param {
+	return gin.Param{Key: "id", Value: strconv.Itoa(id)}
+}
+
+// decodeTestResponse unmarshals a response's JSON body.
+func decodeTestResponse[T any](t *testing.T, w *httptest.ResponseRecorder) T {
+	t.Helper()
+
+	var resp T
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("could not unmarshal %T, status code: %v, body: %s", resp, w.Code, w.Body.String())
+	}
+	return resp
+}
+
+// createTestLoan saves a borrower and a loan for them with the given terms.
+func createTestLoan(t *testing.T, h Handler, amount float64, rate float64, months int) *ent.Loan {
+	t.Helper()
+
+	ctx := adminContext()
+	n, err := h.Ent.User.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("could not count users: %v", err)
+	}
+	u, err := h.Ent.User.Create().
+		SetName("borrower").
+		SetSocialHash(h.Socials.Hash(fmt.Sprintf("000-00-%04d", n))).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("could not create borrower: %v", err)
+	}
+
+	l, err := h.Ent.Loan.Create().
+		SetAmount(int(amount * 100)).
+		SetRate(rate).
+		SetTerm(months).
+		SetBorrower(u).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("could not create loan: %v", err)
+	}
+	return l
+}
+
 func TestCreateUser(t *testing.T) {
 
 	// db init
@@ -47,7 +245,8 @@ func TestCreateUser(t *testing.T) {
 	}
 
 	h := Handler{
-		Ent: client,
+		Ent:     client,
+		Socials: testSocials,
 	}
 
 	for _, tc := range []struct {
@@ -77,7 +276,7 @@ func TestCreateUser(t *testing.T) {
 			name: "duplicate name",
 			request: newUserRequest{
 				Name:    "chris",
-				Social:  "000-45-6780",
+				Social:  "234-56-7890",
 				Address: "1 Apple Street",
 			},
 			expectedCode: http.StatusOK,
@@ -100,7 +299,7 @@ func TestCreateUser(t *testing.T) {
 
 			h.CreateUser(ctx)
 
-			socialExists, err := h.Ent.User.Query().Where(user.Social(tc.request.Social)).Exist(ctx)
+			socialExists, err := h.Ent.User.Query().Where(user.SocialHash(h.Socials.Hash(tc.request.Social))).Exist(ctx)
 			if err != nil {
 				t.Fatalf("could not get user: %v", err)
 			}
@@ -228,3 +427,66 @@ func TestAmortizationSchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestGraduatedSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		graduated        graduatedPayment
+		expectedPayments []float64 // first payment of each year
+		expectRecast     bool
+	}{
+		{
+			name:             "7.5% for 5 years",
+			graduated:        graduatedPayment{StepRate: 0.075, Years: 5},
+			expectedPayments: []float64{607.90, 653.50, 702.51, 755.20, 811.84, 872.72, 872.72},
+		},
+		{
+			name:         "negative amortization cap",
+			graduated:    graduatedPayment{StepRate: 0.075, Years: 5, NegativeAmortizationCap: 1.01},
+			expectRecast: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := CreateAmortizationScheduleWithOptions(100000, 0.09, 360, loanOptions{
+				Graduated: &tc.graduated,
+			})
+			if err != nil {
+				t.Fatalf("could not create amortization schedule: %v", err)
+			}
+
+			if len(schedule) != 360 {
+				t.Errorf("unexpected maturity, want: 360, got: %d", len(schedule))
+			}
+			if last := schedule[len(schedule)-1]; last.EndingBalance != 0 {
+				t.Errorf("loan not paid off, ending balance: %v", last.EndingBalance)
+			}
+			if schedule[0].NegativeAmortization <= 0 || schedule[0].EndingBalance <= 100000 {
+				t.Errorf("first payment did not negatively amortize: %+v", schedule[0])
+			}
+			for year, want := range tc.expectedPayments {
+				if got := schedule[year*12].MonthlyPayment; got != want {
+					t.Errorf("unexpected payment in year %d, want: %v, got: %v", year+1, want, got)
+				}
+			}
+
+			recastMonth := 0
+			for _, m := range schedule {
+				if m.Recast {
+					recastMonth = m.Month
+					break
+				}
+				if tc.graduated.NegativeAmortizationCap > 0 && m.EndingBalance > 100000*tc.graduated.NegativeAmortizationCap {
+					t.Errorf("balance %v exceeded cap in month %d without a recast", m.EndingBalance, m.Month)
+				}
+			}
+			if (recastMonth > 0) != tc.expectRecast {
+				t.Fatalf("unexpected recast in month %d", recastMonth)
+			}
+			if recastMonth > 0 && schedule[recastMonth].MonthlyPayment != schedule[len(schedule)-2].MonthlyPayment {
+				t.Errorf("payments not level after recast, month %d: %v, month %d: %v",
+					recastMonth+1, schedule[recastMonth].MonthlyPayment, len(schedule)-1, schedule[len(schedule)-2].MonthlyPayment)
+			}
+		})
+	}
+}
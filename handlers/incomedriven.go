@@ -0,0 +1,427 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/incomecertification"
+	"github.com/crusyn/loans/ent/incomedrivenplan"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPaymentPercent    = 0.10
+	defaultPovertyMultiplier = 1.5
+	defaultRegion            = "contiguous"
+	defaultForgivenessMonths = 240
+)
+
+type incomeDrivenPlanRequest struct {
+	StartMonth        int     `json:"startMonth"`
+	PaymentPercent    float64 `json:"paymentPercent"`    // defaults to 10% of discretionary income
+	PovertyMultiplier float64 `json:"povertyMultiplier"` // defaults to 150% of the poverty guideline
+	Region            string  `json:"region" enums:"contiguous,alaska,hawaii"`
+	ForgivenessMonths int     `json:"forgivenessMonths"` // defaults to 240
+	Income            float64 `json:"income"`            // annual income for the first year
+	FamilySize        int     `json:"familySize"`
+}
+
+type incomeCertificationRequest struct {
+	Income     float64 `json:"income"`
+	FamilySize int     `json:"familySize"`
+}
+
+type incomeCertificationResponse struct {
+	Year           int     `json:"year"`
+	Income         float64 `json:"income"`
+	FamilySize     int     `json:"familySize"`
+	MonthlyPayment float64 `json:"monthlyPayment"`
+}
+
+type incomeDrivenPlanResponse struct {
+	StartMonth        int                           `json:"startMonth"`
+	PaymentPercent    float64                       `json:"paymentPercent"`
+	PovertyMultiplier float64                       `json:"povertyMultiplier"`
+	Region            string                        `json:"region"`
+	ForgivenessMonths int                           `json:"forgivenessMonths"`
+	Certifications    []incomeCertificationResponse `json:"certifications"`
+	MaturityMonth     int                           `json:"maturityMonth"`
+	ForgivenBalance   float64                       `json:"forgivenBalance"` // projected from the certified incomes
+}
+
+// @Summary Enrolls Loan In Income-Driven Repayment
+// @Schemes
+// @Description Sets the payment from a month onward to a share of the borrower's discretionary income: income
+// @Description above a multiple of the regional poverty guideline for their family size.  Whatever is owed
+// @Description after the forgiveness period is forgiven.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param incomeDrivenPlanRequest body incomeDrivenPlanRequest true "Income-Driven Plan Request"
+// @Success 200 {object} incomeDrivenPlanResponse
+// @Router /loan/{loanid}/income-driven-plan [post]
+func (h Handler) CreateIncomeDrivenPlan(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req incomeDrivenPlanRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "income-driven plan input malformed",
+		})
+		return
+	}
+	req.setDefaults()
+
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	planExists, err := tx.IncomeDrivenPlan.Query().
+		Where(incomedrivenplan.LoanID(l.ID)).
+		Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if planExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan is already on an income-driven plan",
+		})
+		return
+	}
+
+	schedule, err := th.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := req.validate(len(schedule)); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if schedule[req.StartMonth-1].Construction {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "cannot start an income-driven plan before the loan converts from construction",
+		})
+		return
+	}
+
+	modifications, err := th.loanModifications(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if len(modifications) > 0 && modifications[len(modifications)-1].EffectiveMonth >= req.StartMonth {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan is modified after the plan would start",
+		})
+		return
+	}
+
+	plan, err := tx.IncomeDrivenPlan.Create().
+		SetLoanID(l.ID).
+		SetStartMonth(req.StartMonth).
+		SetPaymentPercent(req.PaymentPercent).
+		SetPovertyMultiplier(req.PovertyMultiplier).
+		SetRegion(incomedrivenplan.Region(req.Region)).
+		SetForgivenessMonths(req.ForgivenessMonths).
+		Save(ctx)
+	if err == nil {
+		err = tx.IncomeCertification.Create().
+			SetPlan(plan).
+			SetYear(1).
+			SetIncome(int(math.Round(req.Income * 100))).
+			SetFamilySize(req.FamilySize).
+			Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithIncomeDrivenPlan(ctx, l)
+}
+
+// @Summary Certifies Income
+// @Schemes
+// @Description Certifies the borrower's income and family size for the next year of an income-driven plan.
+// @Description The payment for that year is recalculated from it.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param incomeCertificationRequest body incomeCertificationRequest true "Income Certification Request"
+// @Success 200 {object} incomeDrivenPlanResponse
+// @Router /loan/{loanid}/income-driven-plan/certifications [post]
+func (h Handler) CertifyIncome(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req incomeCertificationRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "income certification input malformed",
+		})
+		return
+	}
+
+	if err := validateCertifiedIncome(req.Income, req.FamilySize); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	plan, err := th.loanIncomeDrivenPlan(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if plan == nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "loan is not on an income-driven plan",
+		})
+		return
+	}
+
+	err = tx.IncomeCertification.Create().
+		SetPlan(plan).
+		SetYear(len(plan.Edges.Certifications) + 1).
+		SetIncome(int(math.Round(req.Income * 100))).
+		SetFamilySize(req.FamilySize).
+		Exec(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithIncomeDrivenPlan(ctx, l)
+}
+
+// @Summary Gets Income-Driven Plan
+// @Schemes
+// @Description Gets a loan's income-driven plan, the payment for each certified year and the balance
+// @Description projected to be forgiven
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {object} incomeDrivenPlanResponse
+// @Router /loan/{loanid}/income-driven-plan [get]
+func (h Handler) GetIncomeDrivenPlan(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	h.respondWithIncomeDrivenPlan(ctx, l)
+}
+
+func (r *incomeDrivenPlanRequest) setDefaults() {
+	if r.PaymentPercent == 0 {
+		r.PaymentPercent = defaultPaymentPercent
+	}
+	if r.PovertyMultiplier == 0 {
+		r.PovertyMultiplier = defaultPovertyMultiplier
+	}
+	if r.Region == "" {
+		r.Region = defaultRegion
+	}
+	if r.ForgivenessMonths == 0 {
+		r.ForgivenessMonths = defaultForgivenessMonths
+	}
+}
+
+func (r incomeDrivenPlanRequest) validate(maturity int) error {
+	if r.StartMonth < 1 || r.StartMonth > maturity {
+		return errors.New("start month must be within the term")
+	}
+	if r.PaymentPercent <= 0 || r.PaymentPercent > 1 {
+		return errors.New("payment percent must be between 0 and 1")
+	}
+	if r.PovertyMultiplier < 0 {
+		return errors.New("poverty multiplier cannot be negative")
+	}
+	if _, ok := povertyGuidelines[r.Region]; !ok {
+		return errors.New("region must be contiguous, alaska or hawaii")
+	}
+	if r.ForgivenessMonths <= 0 {
+		return errors.New("forgiveness months must be positive")
+	}
+	return validateCertifiedIncome(r.Income, r.FamilySize)
+}
+
+func validateCertifiedIncome(income float64, familySize int) error {
+	if income < 0 {
+		return errors.New("income cannot be negative")
+	}
+	if familySize < 1 {
+		return errors.New("family size must be at least 1")
+	}
+	return nil
+}
+
+// loanIncomeDrivenPlan returns the loan's plan with its certifications in order, or nil if it has none.
+func (h Handler) loanIncomeDrivenPlan(ctx context.Context, loanId int) (*ent.IncomeDrivenPlan, error) {
+	plan, err := h.Ent.IncomeDrivenPlan.Query().
+		Where(incomedrivenplan.LoanID(loanId)).
+		WithCertifications(func(q *ent.IncomeCertificationQuery) {
+			q.Order(ent.Asc(incomecertification.FieldYear))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return plan, err
+}
+
+func toIncomeDrivenRepayment(plan *ent.IncomeDrivenPlan) *incomeDrivenRepayment {
+	if plan == nil {
+		return nil
+	}
+
+	repayment := &incomeDrivenRepayment{
+		StartMonth:        plan.StartMonth,
+		PaymentPercent:    plan.PaymentPercent,
+		PovertyMultiplier: plan.PovertyMultiplier,
+		Guideline:         povertyGuidelines[plan.Region.String()],
+		ForgivenessMonths: plan.ForgivenessMonths,
+	}
+	for _, c := range plan.Edges.Certifications {
+		repayment.Incomes = append(repayment.Incomes, certifiedIncome{
+			Income:     float64(c.Income) / 100,
+			FamilySize: c.FamilySize,
+		})
+	}
+	return repayment
+}
+
+func (h Handler) respondWithIncomeDrivenPlan(ctx *gin.Context, l *ent.Loan) {
+	plan, err := h.loanIncomeDrivenPlan(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if plan == nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "loan is not on an income-driven plan",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	repayment := toIncomeDrivenRepayment(plan)
+	response := incomeDrivenPlanResponse{
+		StartMonth:        plan.StartMonth,
+		PaymentPercent:    plan.PaymentPercent,
+		PovertyMultiplier: plan.PovertyMultiplier,
+		Region:            plan.Region.String(),
+		ForgivenessMonths: plan.ForgivenessMonths,
+		Certifications:    []incomeCertificationResponse{},
+		MaturityMonth:     len(schedule),
+		ForgivenBalance:   schedule[len(schedule)-1].Forgiven,
+	}
+	for i, c := range plan.Edges.Certifications {
+		response.Certifications = append(response.Certifications, incomeCertificationResponse{
+			Year:           c.Year,
+			Income:         float64(c.Income) / 100,
+			FamilySize:     c.FamilySize,
+			MonthlyPayment: float64(repayment.monthlyPaymentCents(repayment.Incomes[i])) / 100,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
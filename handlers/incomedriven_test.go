@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestIncomeDrivenSchedule(t *testing.T) {
+	// four years in school and six months of grace, then repaid on income
+	idr := &incomeDrivenRepayment{
+		StartMonth:        55,
+		PaymentPercent:    0.10,
+		PovertyMultiplier: 1.5,
+		Guideline:         povertyGuidelines["contiguous"],
+		ForgivenessMonths: 240,
+		Incomes: []certifiedIncome{
+			{Income: 40000, FamilySize: 1},
+			{Income: 52000, FamilySize: 1},
+		},
+	}
+	schedule, err := CreateAmortizationScheduleWithOptions(30000, 0.068, 120, loanOptions{
+		Deferrals: []paymentDeferral{
+			{StartMonth: 1, Months: 48, Interest: deferredInterestCapitalize},
+			{StartMonth: 49, Months: 6, Interest: deferredInterestCapitalize},
+		},
+		IncomeDriven: idr,
+	})
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	accrued := 0.0
+	for _, m := range schedule[:54] {
+		if !m.Deferred {
+			t.Fatalf("month %d not deferred", m.Month)
+		}
+		accrued = roundCents(accrued + m.AccruedInterest)
+	}
+	if schedule[54].Capitalized != accrued {
+		t.Errorf("unexpected capitalized interest, want: %v, got: %v", accrued, schedule[54].Capitalized)
+	}
+
+	// 10% of the income above 150% of the poverty guideline for one, by month
+	if schedule[54].MonthlyPayment != 145.09 {
+		t.Errorf("unexpected first year payment, want: 145.09, got: %v", schedule[54].MonthlyPayment)
+	}
+	if schedule[66].MonthlyPayment != 245.09 {
+		t.Errorf("unexpected second year payment, want: 245.09, got: %v", schedule[66].MonthlyPayment)
+	}
+	if schedule[len(schedule)-1].MonthlyPayment != 245.09 {
+		t.Errorf("last certified income not carried forward, got: %v", schedule[len(schedule)-1].MonthlyPayment)
+	}
+
+	if len(schedule) != 54+240 {
+		t.Fatalf("unexpected maturity, want: %d, got: %d", 54+240, len(schedule))
+	}
+	last := schedule[len(schedule)-1]
+	if last.Forgiven <= 0 || last.EndingBalance != 0 {
+		t.Errorf("balance not forgiven, forgiven: %v, ending balance: %v", last.Forgiven, last.EndingBalance)
+	}
+	for _, m := range schedule[54:] {
+		if m.EndingBalance > m.BeginningBalance {
+			t.Fatalf("unpaid interest compounded in month %d", m.Month)
+		}
+	}
+}
+
+func TestIncomeDrivenPlan(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 30000, 0.068, 120)
+
+	w := callTestHandler(t, h.CreateDeferment, "POST", "", defermentRequest{Kind: "in_school", StartMonth: 1, Months: 48}, idParam(l.ID))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code, want: %v, got: %v", http.StatusOK, w.Code)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		request      incomeDrivenPlanRequest
+		expectedCode int
+	}{
+		{
+			name:         "no family",
+			request:      incomeDrivenPlanRequest{StartMonth: 49, Income: 40000},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "unknown region",
+			request:      incomeDrivenPlanRequest{StartMonth: 49, Income: 40000, FamilySize: 1, Region: "guam"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "defaults",
+			request:      incomeDrivenPlanRequest{StartMonth: 49, Income: 40000, FamilySize: 1},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "already enrolled",
+			request:      incomeDrivenPlanRequest{StartMonth: 49, Income: 40000, FamilySize: 1},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateIncomeDrivenPlan, "POST", "", tc.request, idParam(l.ID))
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	w = callTestHandler(t, h.CertifyIncome, "POST", "", incomeCertificationRequest{Income: 60000, FamilySize: 4}, idParam(l.ID))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code, want: %v, got: %v", http.StatusOK, w.Code)
+	}
+
+	resp := decodeTestResponse[incomeDrivenPlanResponse](t, w)
+	if resp.Region != "contiguous" || resp.PaymentPercent != 0.10 || resp.ForgivenessMonths != 240 {
+		t.Errorf("defaults not applied: %+v", resp)
+	}
+	if len(resp.Certifications) != 2 || resp.Certifications[1].Year != 2 {
+		t.Fatalf("unexpected certifications: %+v", resp.Certifications)
+	}
+	// a larger family raises the poverty line, so the payment falls despite the raise
+	if resp.Certifications[1].MonthlyPayment >= resp.Certifications[0].MonthlyPayment {
+		t.Errorf("unexpected second year payment, first: %v, second: %v", resp.Certifications[0].MonthlyPayment, resp.Certifications[1].MonthlyPayment)
+	}
+	if resp.MaturityMonth != 48+240 || resp.ForgivenBalance <= 0 {
+		t.Errorf("unexpected forgiveness, maturity: %d, forgiven: %v", resp.MaturityMonth, resp.ForgivenBalance)
+	}
+}
+
+func TestConcurrentIncomeDrivenPlans(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 30000, 0.068, 120)
+
+	request := func(create func(*gin.Context), body any) func() int {
+		return func() int {
+			w := callTestHandler(t, create, "POST", "", body, idParam(l.ID))
+
+			return w.Code
+		}
+	}
+
+	succeeded := racing(2, h.Ent.IncomeDrivenPlan.Use, request(h.CreateIncomeDrivenPlan, incomeDrivenPlanRequest{StartMonth: 1, Income: 40000, FamilySize: 1}))
+	plans, err := h.Ent.IncomeDrivenPlan.Query().Count(adminContext())
+	if err != nil {
+		t.Fatalf("could not count plans: %v", err)
+	}
+	if succeeded > 1 || plans != succeeded {
+		t.Fatalf("duplicate plans were saved: %d succeeded, %d saved", succeeded, plans)
+	}
+	if plans == 0 {
+		request(h.CreateIncomeDrivenPlan, incomeDrivenPlanRequest{StartMonth: 1, Income: 40000, FamilySize: 1})()
+	}
+
+	succeeded = racing(2, h.Ent.IncomeCertification.Use, request(h.CertifyIncome, incomeCertificationRequest{Income: 45000, FamilySize: 1}))
+	plan, err := h.loanIncomeDrivenPlan(adminContext(), l.ID)
+	if err != nil || plan == nil {
+		t.Fatalf("could not get plan: %v", err)
+	}
+	years := map[int]bool{}
+	for _, c := range plan.Edges.Certifications {
+		if years[c.Year] {
+			t.Errorf("year %d was certified twice", c.Year)
+		}
+		years[c.Year] = true
+	}
+	if succeeded > 1 || len(plan.Edges.Certifications) != succeeded+1 {
+		t.Errorf("unexpected certifications: %d succeeded, %d saved", succeeded, len(plan.Edges.Certifications))
+	}
+}
+
+func TestIncomeDrivenModifications(t *testing.T) {
+	idr := &incomeDrivenRepayment{
+		StartMonth:        13,
+		PaymentPercent:    0.10,
+		PovertyMultiplier: 1.5,
+		Guideline:         povertyGuidelines["contiguous"],
+		ForgivenessMonths: 240,
+		Incomes:           []certifiedIncome{{Income: 40000, FamilySize: 1}},
+	}
+	schedule, err := CreateAmortizationScheduleWithOptions(30000, 0.068, 120, loanOptions{
+		Modifications: []termsModification{{EffectiveMonth: 25, Rate: 0.05, Months: 60}},
+		IncomeDriven:  idr,
+	})
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	if len(schedule) != 12+240 {
+		t.Errorf("modification changed the plan's maturity, want: %d, got: %d", 12+240, len(schedule))
+	}
+
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 30000, 0.068, 120)
+	create := func(handle func(*gin.Context), body any) int {
+		w := callTestHandler(t, handle, "POST", "", body, idParam(l.ID))
+
+		return w.Code
+	}
+	rate := 0.05
+	if code := create(h.CreateLoanModification, loanModificationRequest{EffectiveMonth: 13, Rate: &rate}); code != http.StatusOK {
+		t.Fatalf("could not modify loan: %v", code)
+	}
+	if code := create(h.CreateIncomeDrivenPlan, incomeDrivenPlanRequest{StartMonth: 13, Income: 40000, FamilySize: 1}); code != http.StatusUnprocessableEntity {
+		t.Errorf("plan starting before a modification was saved: %v", code)
+	}
+	if code := create(h.CreateIncomeDrivenPlan, incomeDrivenPlanRequest{StartMonth: 25, Income: 40000, FamilySize: 1}); code != http.StatusOK {
+		t.Fatalf("could not create plan: %v", code)
+	}
+	if code := create(h.CreateLoanModification, loanModificationRequest{EffectiveMonth: 25, Rate: &rate}); code != http.StatusUnprocessableEntity {
+		t.Errorf("modification of a loan repaid on income was saved: %v", code)
+	}
+}
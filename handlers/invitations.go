@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	invitationExpiryDays    = 7
+	maxInvitationExpiryDays = 90
+)
+
+type invitationResponse struct {
+	Id         int       `json:"id"`
+	LoanId     int       `json:"loanID"`
+	UserId     int       `json:"userID"`
+	Permission string    `json:"permission" enums:"view_balance,view_schedule,make_payments,manage_shares"`
+	Status     string    `json:"status" enums:"pending,accepted,declined"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+func toInvitationResponse(i *ent.ShareInvitation) invitationResponse {
+	return invitationResponse{
+		Id:         i.ID,
+		LoanId:     i.LoanID,
+		UserId:     i.UserID,
+		Permission: i.Permission.String(),
+		Status:     i.Status.String(),
+		ExpiresAt:  i.ExpiresAt,
+	}
+}
+
+// inviteToLoan invites a user to share a loan, renewing their pending invitation if they have one.
+func (h Handler) inviteToLoan(ctx *gin.Context, loanId int, req loanShareRequest) {
+	expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+
+	pending, err := h.Ent.ShareInvitation.Query().
+		Where(
+			shareinvitation.LoanID(loanId),
+			shareinvitation.UserID(req.UserId),
+			shareinvitation.StatusEQ(shareinvitation.StatusPending),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	var invitation *ent.ShareInvitation
+	if pending != nil {
+		invitation, err = pending.Update().
+			SetPermission(shareinvitation.Permission(req.Permission)).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+	} else {
+		invitation, err = h.Ent.ShareInvitation.Create().
+			SetLoanID(loanId).
+			SetUserID(req.UserId).
+			SetPermission(shareinvitation.Permission(req.Permission)).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toInvitationResponse(invitation))
+}
+
+// @Summary Gets Pending Invitations
+// @Schemes
+// @Description Gets the invitations to share loans a user hasn't responded to yet and that haven't expired
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 200 {array} invitationResponse
+// @Router /user/{userid}/invitations [get]
+func (h Handler) GetInvitations(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	userId, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	userExists, err := h.Ent.User.Query().Where(user.ID(userId)).Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if !userExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user doesn't exist",
+		})
+		return
+	}
+
+	invitations, err := h.Ent.ShareInvitation.Query().
+		Where(
+			shareinvitation.UserID(userId),
+			shareinvitation.StatusEQ(shareinvitation.StatusPending),
+			shareinvitation.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Asc(shareinvitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []invitationResponse{}
+	for _, i := range invitations {
+		response = append(response, toInvitationResponse(i))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Accepts Invitation
+// @Schemes
+// @Description Accepts an invitation to share a loan before it expires, sharing the loan with the invited user
+// @Accept json
+// @Produce json
+// @Param invitationid path int true "Invitation Id"
+// @Success 200 {object} invitationResponse
+// @Router /invitation/{invitationid}/accept [post]
+func (h Handler) AcceptInvitation(ctx *gin.Context) {
+	h.respondToInvitation(ctx, shareinvitation.StatusAccepted)
+}
+
+// @Summary Declines Invitation
+// @Schemes
+// @Description Declines an invitation to share a loan
+// @Accept json
+// @Produce json
+// @Param invitationid path int true "Invitation Id"
+// @Success 200 {object} invitationResponse
+// @Router /invitation/{invitationid}/decline [post]
+func (h Handler) DeclineInvitation(ctx *gin.Context) {
+	h.respondToInvitation(ctx, shareinvitation.StatusDeclined)
+}
+
+func (h Handler) respondToInvitation(ctx *gin.Context, status shareinvitation.Status) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	userId, ok, err := actingUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// the invitation is read in the same transaction as it's responded to, so it can't be
+	// accepted and declined at once
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	invitation, err := tx.ShareInvitation.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find invitation",
+		})
+		return
+	}
+	// services can't respond for users, sharing needs the invited user's consent
+	if !ok || userId != invitation.UserID {
+		ctx.JSON(http.StatusForbidden, ErrorResponse{
+			Message: "only the invited user can respond to an invitation",
+		})
+		return
+	}
+
+	if invitation.Status != shareinvitation.StatusPending {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "invitation has already been " + invitation.Status.String(),
+		})
+		return
+	}
+	now := time.Now()
+	if !invitation.ExpiresAt.After(now) {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "invitation has expired",
+		})
+		return
+	}
+
+	invitation, err = tx.ShareInvitation.UpdateOne(invitation).
+		SetStatus(status).
+		SetRespondedAt(now).
+		Save(ctx)
+	if err == nil && status == shareinvitation.StatusAccepted {
+		err = shareLoan(ctx, tx.SharedLoan, invitation)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toInvitationResponse(invitation))
+}
+
+// shareLoan shares the loan with an invited user, with the permission they were invited with.
+func shareLoan(ctx context.Context, client *ent.SharedLoanClient, invitation *ent.ShareInvitation) error {
+	updated, err := client.Update().
+		Where(
+			sharedloan.UserID(invitation.UserID),
+			sharedloan.LoanID(invitation.LoanID),
+		).
+		SetPermission(sharedloan.Permission(invitation.Permission)).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	return client.Create().
+		SetLoanID(invitation.LoanID).
+		SetUserID(invitation.UserID).
+		SetPermission(sharedloan.Permission(invitation.Permission)).
+		Exec(ctx)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// asTestUser has a handler act for a user, the way services do with the user header.
+func asTestUser(userId int, handle gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.Header.Set(UserHeader, strconv.Itoa(userId))
+		handle(ctx)
+	}
+}
+
+// shareTestLoan invites a user to share a loan and accepts the invitation for them.
+func shareTestLoan(t *testing.T, h Handler, loanId int, req loanShareRequest) {
+	t.Helper()
+
+	w := callTestHandler(t, h.ShareLoan, "POST", "", req, idParam(loanId))
+
+	invitation := decodeTestResponse[invitationResponse](t, w)
+
+	w = callTestHandler(t, asTestUser(req.UserId, h.AcceptInvitation), "POST", "", nil, idParam(invitation.Id))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not accept invitation: %v", w.Body.String())
+	}
+}
+
+func TestShareInvitations(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	invitee := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	other := createTestLoan(t, h, 1000, 0.05, 12)
+
+	invite := func(loanId int) invitationResponse {
+		w := callTestHandler(t, h.ShareLoan, "POST", "", loanShareRequest{UserId: invitee, Permission: "view_balance"}, idParam(loanId))
+
+		invitation := decodeTestResponse[invitationResponse](t, w)
+		return invitation
+	}
+	accepted := invite(l.ID)
+	declined := invite(other.ID)
+
+	if accepted.Status != "pending" || !accepted.ExpiresAt.After(time.Now().AddDate(0, 0, 6)) {
+		t.Errorf("unexpected invitation: %+v", accepted)
+	}
+	if shared, _ := h.Ent.SharedLoan.Query().Count(adminContext()); shared != 0 {
+		t.Fatalf("loan shared before the invitation was accepted")
+	}
+
+	w := callTestHandler(t, h.GetInvitations, "GET", "", nil, idParam(invitee))
+
+	pending := decodeTestResponse[[]invitationResponse](t, w)
+	if len(pending) != 2 {
+		t.Fatalf("unexpected pending invitations: %+v", pending)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		respond      func(h Handler, ctx *gin.Context)
+		invitation   int
+		user         int
+		expectedCode int
+	}{
+		{
+			name:         "someone else",
+			respond:      Handler.AcceptInvitation,
+			invitation:   accepted.Id,
+			user:         l.BorrowerID,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "service without a user",
+			respond:      Handler.AcceptInvitation,
+			invitation:   accepted.Id,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "accept",
+			respond:      Handler.AcceptInvitation,
+			invitation:   accepted.Id,
+			user:         invitee,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "already accepted",
+			respond:      Handler.DeclineInvitation,
+			invitation:   accepted.Id,
+			user:         invitee,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "decline",
+			respond:      Handler.DeclineInvitation,
+			invitation:   declined.Id,
+			user:         invitee,
+			expectedCode: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			respond := func(ctx *gin.Context) { tc.respond(h, ctx) }
+			if tc.user != 0 {
+				respond = asTestUser(tc.user, respond)
+			}
+
+			w := callTestHandler(t, respond, "POST", "", nil, idParam(tc.invitation))
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	shares, err := h.Ent.SharedLoan.Query().All(adminContext())
+	if err != nil {
+		t.Fatalf("could not get shared loans: %v", err)
+	}
+	if len(shares) != 1 || shares[0].LoanID != l.ID || shares[0].Permission != "view_balance" {
+		t.Errorf("unexpected shared loans: %+v", shares)
+	}
+
+	expired := invite(other.ID)
+	err = h.Ent.ShareInvitation.UpdateOneID(expired.Id).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Exec(adminContext())
+	if err != nil {
+		t.Fatalf("could not expire invitation: %v", err)
+	}
+
+	w = callTestHandler(t, asTestUser(invitee, h.AcceptInvitation), "POST", "", nil, idParam(expired.Id))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("unexpected status code for expired invitation, want: %v, got: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+}
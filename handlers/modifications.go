@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loanmodification"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type loanModificationRequest struct {
+	EffectiveMonth     int      `json:"effectiveMonth"`
+	Rate               *float64 `json:"rate"`   // keeps the current rate when omitted
+	Months             *int     `json:"months"` // counted from the effective month, keeps the current maturity when omitted
+	CapitalizedArrears float64  `json:"capitalizedArrears"`
+}
+
+type loanTermsVersionResponse struct {
+	Version            int        `json:"version"`
+	EffectiveMonth     int        `json:"effectiveMonth"`
+	Balance            float64    `json:"balance"` // amortized by these terms, including capitalized arrears
+	Rate               float64    `json:"rate"`
+	Term               int        `json:"term"` // In months, counted from the effective month
+	MonthlyPayment     float64    `json:"monthlyPayment"`
+	CapitalizedArrears float64    `json:"capitalizedArrears"`
+	CreatedAt          *time.Time `json:"createdAt,omitempty"`
+}
+
+// @Summary Modifies Loan
+// @Schemes
+// @Description Changes the rate, remaining term or principal of a loan from an effective month onward.
+// @Description Arrears are capitalized into the principal.  The schedule keeps the earlier terms before
+// @Description the effective month, and every version of the terms is retained.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param loanModificationRequest body loanModificationRequest true "Loan Modification Request"
+// @Success 200 {object} loanTermsVersionResponse
+// @Router /loan/{loanid}/modifications [post]
+func (h Handler) CreateLoanModification(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req loanModificationRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan modification input malformed",
+		})
+		return
+	}
+
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	modifications, err := th.loanModifications(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := th.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	currentEffectiveMonth, currentRate := 1, l.Rate
+	if len(modifications) > 0 {
+		current := modifications[len(modifications)-1]
+		currentEffectiveMonth, currentRate = current.EffectiveMonth, current.Rate
+	}
+
+	if err := req.validate(currentEffectiveMonth, len(schedule)); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	plan, err := th.loanIncomeDrivenPlan(ctx, l.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if plan != nil && req.EffectiveMonth >= plan.StartMonth {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan is repaid on income in the effective month",
+		})
+		return
+	}
+
+	if schedule[req.EffectiveMonth-1].Deferred {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "payments are deferred in the effective month",
+		})
+		return
+	}
+	if schedule[req.EffectiveMonth-1].Construction {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan has not converted from construction in the effective month",
+		})
+		return
+	}
+
+	rate := currentRate
+	if req.Rate != nil {
+		rate = *req.Rate
+	}
+	months := len(schedule) - req.EffectiveMonth + 1
+	if req.Months != nil {
+		months = *req.Months
+	}
+
+	err = tx.LoanModification.Create().
+		SetLoanID(l.ID).
+		SetEffectiveMonth(req.EffectiveMonth).
+		SetRate(rate).
+		SetTerm(months).
+		SetCapitalizedArrears(int(math.Round(req.CapitalizedArrears * 100))).
+		Exec(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	versions, err := h.loanTermsVersions(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, versions[len(versions)-1])
+}
+
+// @Summary Gets Loan Terms History
+// @Schemes
+// @Description Gets every version of a loan's terms, starting with the original terms at month 1
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {array} loanTermsVersionResponse
+// @Router /loan/{loanid}/modifications [get]
+func (h Handler) GetLoanModifications(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	versions, err := h.loanTermsVersions(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, versions)
+}
+
+func (r loanModificationRequest) validate(currentEffectiveMonth int, maturity int) error {
+	if r.EffectiveMonth <= currentEffectiveMonth {
+		return errors.New("effective month must be after the current terms took effect")
+	}
+	if r.EffectiveMonth > maturity {
+		return errors.New("effective month cannot be after the loan matures")
+	}
+	if r.Rate == nil && r.Months == nil && r.CapitalizedArrears == 0 {
+		return errors.New("modification must change the rate, term or principal")
+	}
+	if r.Rate != nil && *r.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if r.Months != nil && *r.Months <= 0 {
+		return errors.New("term must be positive")
+	}
+	if r.CapitalizedArrears < 0 {
+		return errors.New("capitalized arrears cannot be negative")
+	}
+	return nil
+}
+
+func (h Handler) loanModifications(ctx context.Context, loanId int) ([]*ent.LoanModification, error) {
+	return h.Ent.LoanModification.Query().
+		Where(loanmodification.LoanID(loanId)).
+		Order(ent.Asc(loanmodification.FieldEffectiveMonth)).
+		All(ctx)
+}
+
+// loanTermsVersions lists the original terms of a loan followed by each modification, along with
+// the balance and payment each version amortizes from its effective month.
+func (h Handler) loanTermsVersions(ctx context.Context, l *ent.Loan) ([]loanTermsVersionResponse, error) {
+	modifications, err := h.loanModifications(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []loanTermsVersionResponse{{
+		Version:        1,
+		EffectiveMonth: 1,
+		Balance:        float64(l.Amount) / 100,
+		Rate:           l.Rate,
+		Term:           l.Term,
+		MonthlyPayment: schedule[0].MonthlyPayment,
+	}}
+
+	for i, m := range modifications {
+		createdAt := m.CreatedAt
+		version := loanTermsVersionResponse{
+			Version:            i + 2,
+			EffectiveMonth:     m.EffectiveMonth,
+			Rate:               m.Rate,
+			Term:               m.Term,
+			CapitalizedArrears: float64(m.CapitalizedArrears) / 100,
+			CreatedAt:          &createdAt,
+		}
+		if m.EffectiveMonth <= len(schedule) {
+			version.Balance = schedule[m.EffectiveMonth-1].BeginningBalance
+			version.MonthlyPayment = schedule[m.EffectiveMonth-1].MonthlyPayment
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
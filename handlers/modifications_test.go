@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+)
+
+func TestCreateLoanModification(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 100000, 0.06, 360)
+
+	original, err := CreateAmortizationSchedule(100000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	rate := 0.04
+	extended := 360
+	for _, tc := range []struct {
+		name             string
+		request          loanModificationRequest
+		expectedCode     int
+		expectedVersion  int
+		expectedMaturity int
+	}{
+		{
+			name:             "lower rate and capitalize arrears",
+			request:          loanModificationRequest{EffectiveMonth: 61, Rate: &rate, CapitalizedArrears: 2000},
+			expectedCode:     http.StatusOK,
+			expectedVersion:  2,
+			expectedMaturity: 360,
+		},
+		{
+			name:         "before current terms",
+			request:      loanModificationRequest{EffectiveMonth: 61, Months: &extended},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "no change",
+			request:      loanModificationRequest{EffectiveMonth: 100},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:             "extend term",
+			request:          loanModificationRequest{EffectiveMonth: 121, Months: &extended},
+			expectedCode:     http.StatusOK,
+			expectedVersion:  3,
+			expectedMaturity: 480,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateLoanModification, "POST", "", tc.request, idParam(l.ID))
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[loanTermsVersionResponse](t, w)
+			if resp.Version != tc.expectedVersion {
+				t.Errorf("unexpected version, want: %v, got: %v", tc.expectedVersion, resp.Version)
+			}
+
+			schedule, err := h.loanSchedule(adminContext(), l)
+			if err != nil {
+				t.Fatalf("could not create loan schedule: %v", err)
+			}
+			if len(schedule) != tc.expectedMaturity {
+				t.Errorf("unexpected maturity, want: %v, got: %v", tc.expectedMaturity, len(schedule))
+			}
+			if last := schedule[len(schedule)-1]; last.EndingBalance != 0 {
+				t.Errorf("loan not paid off, ending balance: %v", last.EndingBalance)
+			}
+		})
+	}
+
+	schedule, err := h.loanSchedule(adminContext(), l)
+	if err != nil {
+		t.Fatalf("could not create loan schedule: %v", err)
+	}
+	if schedule[59] != original[59] {
+		t.Errorf("month before modification changed, want: %+v, got: %+v", original[59], schedule[59])
+	}
+	if want := roundCents(original[59].EndingBalance + 2000); schedule[60].BeginningBalance != want {
+		t.Errorf("arrears not capitalized, want balance: %v, got: %v", want, schedule[60].BeginningBalance)
+	}
+
+	w := callTestHandler(t, h.GetLoanModifications, "GET", "", nil, idParam(l.ID))
+
+	versions := decodeTestResponse[[]loanTermsVersionResponse](t, w)
+	if len(versions) != 3 {
+		t.Fatalf("unexpected number of versions, want: 3, got: %d", len(versions))
+	}
+	if versions[0].Rate != 0.06 || versions[1].Rate != 0.04 || versions[2].Rate != 0.04 {
+		t.Errorf("unexpected rate history: %v, %v, %v", versions[0].Rate, versions[1].Rate, versions[2].Rate)
+	}
+}
+
+// racing runs a request from n goroutines at once and returns how many succeeded.  The first n
+// creates go through a hook, added with use, that holds each until every request has reached its
+// create, so requests that check and create outside a transaction all pass their checks.
+func racing(n int, use func(...ent.Hook), request func() int) int {
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+	var creates atomic.Int32
+	use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) && creates.Add(1) <= int32(n) {
+				arrived.Done()
+				waited := make(chan struct{})
+				go func() { arrived.Wait(); close(waited) }()
+				select {
+				case <-waited:
+				case <-time.After(time.Second): // the others failed before creating
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	codes := make(chan int, n)
+	for i := 0; i < n; i++ {
+		go func() { codes <- request() }()
+	}
+	succeeded := 0
+	for i := 0; i < n; i++ {
+		if <-codes == http.StatusOK {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+func TestConcurrentLoanModifications(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 100000, 0.06, 360)
+
+	rate := 0.04
+	succeeded := racing(2, h.Ent.LoanModification.Use, func() int {
+		w := callTestHandler(t, h.CreateLoanModification, "POST", "", loanModificationRequest{EffectiveMonth: 13, Rate: &rate}, idParam(l.ID))
+
+		return w.Code
+	})
+
+	modifications, err := h.loanModifications(adminContext(), l.ID)
+	if err != nil {
+		t.Fatalf("could not get modifications: %v", err)
+	}
+	if succeeded > 1 || len(modifications) != succeeded {
+		t.Errorf("conflicting modifications were saved: %d succeeded, %d saved", succeeded, len(modifications))
+	}
+}
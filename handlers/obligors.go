@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type obligorRequest struct {
+	UserId    int    `json:"userID"`
+	Role      string `json:"role" enums:"primary,co_borrower,guarantor"`
+	Liability string `json:"liability" enums:"joint_and_several,percentage"` // defaults to joint_and_several
+	// Percent is the obligor's share of the debt, from 0 to 100, for percentage liability.
+	Percent float64 `json:"percent"`
+}
+
+type obligorResponse struct {
+	UserId    int     `json:"userID"`
+	Role      string  `json:"role" enums:"primary,co_borrower,guarantor"`
+	Liability string  `json:"liability" enums:"joint_and_several,percentage"`
+	Percent   float64 `json:"percent,omitempty"`
+}
+
+// @Summary Adds Loan Obligor
+// @Schemes
+// @Description Adds a co-borrower or guarantor to a loan.  Co-borrowers share the debt with the
+// @Description borrower, guarantors answer for it if the borrowers default.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param obligorRequest body obligorRequest true "Obligor Request"
+// @Success 200 {object} loanResponse
+// @Router /loan/{loanid}/obligors [post]
+func (h Handler) AddObligor(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Query().
+		Where(loan.ID(i)).
+		WithObligors().
+		Only(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req obligorRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "obligor input malformed",
+		})
+		return
+	}
+
+	if req.Role == loanobligor.RolePrimary.String() {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan already has a primary borrower",
+		})
+		return
+	}
+
+	obligors := []obligorRequest{}
+	for _, o := range toObligorResponses(l) {
+		obligors = append(obligors, obligorRequest(o))
+	}
+	obligors = append(obligors, req)
+	if err := validateObligors(l.BorrowerID, obligors); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	userExists, err := h.userExists(ctx, req.UserId)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if !userExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user doesn't exist",
+		})
+		return
+	}
+
+	err = createObligor(h.Ent.LoanObligor, l.ID, req).Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	l, err = h.Ent.Loan.Query().
+		Where(loan.ID(l.ID)).
+		WithObligors().
+		Only(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toLoanResponse(l))
+}
+
+func (r obligorRequest) validate() error {
+	switch loanobligor.Role(r.Role) {
+	case loanobligor.RolePrimary, loanobligor.RoleCoBorrower, loanobligor.RoleGuarantor:
+	default:
+		return errors.New("role must be primary, co_borrower or guarantor")
+	}
+	switch loanobligor.Liability(r.Liability) {
+	case "", loanobligor.LiabilityJointAndSeveral:
+		if r.Percent != 0 {
+			return errors.New("only percentage liability has a percent")
+		}
+	case loanobligor.LiabilityPercentage:
+		if r.Percent <= 0 || r.Percent > 100 {
+			return errors.New("liability percent must be more than 0 and at most 100")
+		}
+	default:
+		return errors.New("liability must be joint_and_several or percentage")
+	}
+	return nil
+}
+
+// validateObligors checks every obligor of a loan.  The borrower is its only primary obligor, each
+// user is an obligor once and the borrowers' percentage shares of the debt can't exceed all of it.
+func validateObligors(borrower int, obligors []obligorRequest) error {
+	users := map[int]bool{}
+	borrowersPercent := 0.0
+	for _, o := range obligors {
+		if err := o.validate(); err != nil {
+			return err
+		}
+		if users[o.UserId] {
+			return errors.New("user is already an obligor of the loan")
+		}
+		users[o.UserId] = true
+
+		isPrimary := o.Role == loanobligor.RolePrimary.String()
+		if isPrimary != (o.UserId == borrower) {
+			return errors.New("the borrower must be the primary obligor")
+		}
+		if o.Role != loanobligor.RoleGuarantor.String() {
+			borrowersPercent = borrowersPercent + o.Percent
+		}
+	}
+	if borrowersPercent > 100 {
+		return errors.New("borrowers' liability percents cannot exceed 100")
+	}
+	return nil
+}
+
+// withPrimaryObligor adds the borrower as a jointly and severally liable primary obligor unless
+// the request already set their liability.
+func withPrimaryObligor(borrower int, obligors []obligorRequest) []obligorRequest {
+	for _, o := range obligors {
+		if o.Role == loanobligor.RolePrimary.String() {
+			return obligors
+		}
+	}
+	return append([]obligorRequest{{
+		UserId:    borrower,
+		Role:      loanobligor.RolePrimary.String(),
+		Liability: loanobligor.LiabilityJointAndSeveral.String(),
+	}}, obligors...)
+}
+
+func createObligor(client *ent.LoanObligorClient, loanId int, o obligorRequest) *ent.LoanObligorCreate {
+	create := client.Create().
+		SetLoanID(loanId).
+		SetUserID(o.UserId).
+		SetRole(loanobligor.Role(o.Role)).
+		SetLiabilityPercent(o.Percent)
+	if o.Liability != "" {
+		create.SetLiability(loanobligor.Liability(o.Liability))
+	}
+	return create
+}
+
+// toObligorResponses returns a loan's loaded obligors, primary borrower first.  Loans created
+// before obligors were recorded only have their borrower, who is jointly and severally liable.
+func toObligorResponses(l *ent.Loan) []obligorResponse {
+	response := []obligorResponse{}
+	for _, o := range l.Edges.Obligors {
+		obligor := obligorResponse{
+			UserId:    o.UserID,
+			Role:      o.Role.String(),
+			Liability: o.Liability.String(),
+			Percent:   o.LiabilityPercent,
+		}
+		if o.Role == loanobligor.RolePrimary {
+			response = append([]obligorResponse{obligor}, response...)
+			continue
+		}
+		response = append(response, obligor)
+	}
+	if len(response) == 0 || response[0].Role != loanobligor.RolePrimary.String() {
+		response = append([]obligorResponse{{
+			UserId:    l.BorrowerID,
+			Role:      loanobligor.RolePrimary.String(),
+			Liability: loanobligor.LiabilityJointAndSeveral.String(),
+		}}, response...)
+	}
+	return response
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestValidateObligors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		obligors []obligorRequest
+		valid    bool
+	}{
+		{
+			name:     "borrower only",
+			obligors: []obligorRequest{{UserId: 1, Role: "primary"}},
+			valid:    true,
+		},
+		{
+			name: "split between borrowers",
+			obligors: []obligorRequest{
+				{UserId: 1, Role: "primary", Liability: "percentage", Percent: 60},
+				{UserId: 2, Role: "co_borrower", Liability: "percentage", Percent: 40},
+				{UserId: 3, Role: "guarantor", Liability: "percentage", Percent: 100},
+			},
+			valid: true,
+		},
+		{
+			name: "borrowers over 100%",
+			obligors: []obligorRequest{
+				{UserId: 1, Role: "primary", Liability: "percentage", Percent: 60},
+				{UserId: 2, Role: "co_borrower", Liability: "percentage", Percent: 50},
+			},
+		},
+		{
+			name:     "primary isn't the borrower",
+			obligors: []obligorRequest{{UserId: 2, Role: "primary"}},
+		},
+		{
+			name:     "borrower as guarantor",
+			obligors: []obligorRequest{{UserId: 1, Role: "guarantor"}},
+		},
+		{
+			name:     "joint and several with a percent",
+			obligors: []obligorRequest{{UserId: 1, Role: "primary", Liability: "joint_and_several", Percent: 50}},
+		},
+		{
+			name:     "percentage without a percent",
+			obligors: []obligorRequest{{UserId: 1, Role: "primary", Liability: "percentage"}},
+		},
+		{
+			name:     "unknown role",
+			obligors: []obligorRequest{{UserId: 1, Role: "primary"}, {UserId: 2, Role: "cosigner"}},
+		},
+		{
+			name:     "same user twice",
+			obligors: []obligorRequest{{UserId: 1, Role: "primary"}, {UserId: 2, Role: "co_borrower"}, {UserId: 2, Role: "guarantor"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateObligors(1, tc.obligors)
+			if tc.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestLoanObligors(t *testing.T) {
+	h := newTestHandler(t)
+	borrower := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	coBorrower := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	guarantor := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	w := callTestHandler(t, h.CreateLoan, "POST", "", newLoanRequest{
+		Amount:   200000,
+		Rate:     0.06,
+		Months:   360,
+		Borrower: borrower,
+		Obligors: []obligorRequest{
+			{UserId: coBorrower, Role: "co_borrower"},
+		},
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not create loan: %v", w.Body.String())
+	}
+	created := decodeTestResponse[newLoanResponse](t, w)
+	loan := idParam(created.LoanId)
+
+	for _, tc := range []struct {
+		name         string
+		request      obligorRequest
+		expectedCode int
+	}{
+		{
+			name:         "second primary",
+			request:      obligorRequest{UserId: guarantor, Role: "primary"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "already an obligor",
+			request:      obligorRequest{UserId: coBorrower, Role: "guarantor"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "missing user",
+			request:      obligorRequest{UserId: 1000, Role: "guarantor"},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "guarantor",
+			request:      obligorRequest{UserId: guarantor, Role: "guarantor", Liability: "percentage", Percent: 25},
+			expectedCode: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.AddObligor, "POST", "", tc.request, loan)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+
+	w = callTestHandler(t, h.GetLoan, "GET", "", nil, loan)
+
+	l := decodeTestResponse[loanResponse](t, w)
+	expected := []obligorResponse{
+		{UserId: borrower, Role: "primary", Liability: "joint_and_several"},
+		{UserId: coBorrower, Role: "co_borrower", Liability: "joint_and_several"},
+		{UserId: guarantor, Role: "guarantor", Liability: "percentage", Percent: 25},
+	}
+	if len(l.Obligors) != len(expected) {
+		t.Fatalf("unexpected obligors: %+v", l.Obligors)
+	}
+	for i := range expected {
+		if l.Obligors[i] != expected[i] {
+			t.Errorf("unexpected obligor, want: %+v, got: %+v", expected[i], l.Obligors[i])
+		}
+	}
+
+	for _, tc := range []struct {
+		user  int
+		loans int
+		role  string
+	}{
+		{user: borrower, loans: 2, role: "primary"},
+		{user: guarantor, loans: 2, role: "guarantor"},
+	} {
+		w := callTestHandler(t, h.GetLoans, "GET", "", nil, idParam(tc.user))
+
+		loans := decodeTestResponse[[]loanResponse](t, w)
+		if len(loans) != tc.loans || loans[1].Id != created.LoanId || loans[1].Role != tc.role {
+			t.Errorf("unexpected loans for user %d: %+v", tc.user, loans)
+		}
+	}
+}
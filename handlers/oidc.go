@@ -0,0 +1,575 @@
+package handlers
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/oidclogin"
+	"github.com/crusyn/loans/ent/session"
+	"github.com/crusyn/loans/ent/useridentity"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// SessionCookie keeps users who signed in with the identity provider signed in.
+	SessionCookie     = "loans_session"
+	oidcLoginCookie   = "loans_oidc_login"
+	sessionLifetime   = 8 * time.Hour
+	oidcLoginLifetime = 10 * time.Minute
+)
+
+var errInvalidLogin = errors.New("login is invalid or has expired")
+
+// OIDCConfig is the OpenID Connect identity provider users sign in with, and how this server is
+// registered with it.
+type OIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string // empty for a public client, which relies on PKCE alone
+	RedirectURL  string // where the provider sends users back to, /auth/oidc/callback
+}
+
+// OIDCProvider is an identity provider with the endpoints discovered from its issuer.
+type OIDCProvider struct {
+	OIDCConfig
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	JWKSURI               string
+	Client                *http.Client
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims are the claims of an ID token we check.
+type idTokenClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	Nonce     string   `json:"nonce"`
+}
+
+// audience is an ID token's aud claim, which is either a string or an array of them.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(a))
+}
+
+type sessionResponse struct {
+	UserId    int       `json:"userID"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type identityRequest struct {
+	Subject string `json:"subject"` // the user's subject at the identity provider
+}
+
+type identityResponse struct {
+	Id        int       `json:"id"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewOIDCProvider discovers an identity provider's endpoints from its issuer.
+func NewOIDCProvider(ctx context.Context, config OIDCConfig) (*OIDCProvider, error) {
+	p := &OIDCProvider{
+		OIDCConfig: config,
+		Client:     http.DefaultClient,
+	}
+
+	var d oidcDiscovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(config.Issuer, "/")+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, fmt.Errorf("discovering %s: %w", config.Issuer, err)
+	}
+	if d.Issuer != config.Issuer {
+		return nil, fmt.Errorf("discovered issuer %s, expected %s", d.Issuer, config.Issuer)
+	}
+
+	p.AuthorizationEndpoint = d.AuthorizationEndpoint
+	p.TokenEndpoint = d.TokenEndpoint
+	p.JWKSURI = d.JWKSURI
+	return p, nil
+}
+
+// @Summary Starts OIDC Login
+// @Schemes
+// @Description Redirects to the identity provider to sign in with the authorization code flow and PKCE,
+// @Description which sends the user back to `/auth/oidc/callback`.  The login is tied to the browser that
+// @Description started it with a cookie, which the callback requires
+// @Produce json
+// @Success 302
+// @Router /auth/oidc/login [get]
+func (h Handler) StartOIDCLogin(ctx *gin.Context) {
+	state, err := randomToken()
+	var nonce, verifier string
+	if err == nil {
+		nonce, err = randomToken()
+	}
+	if err == nil {
+		verifier, err = randomToken()
+	}
+	if err == nil {
+		err = h.Ent.OidcLogin.Create().
+			SetState(state).
+			SetNonce(nonce).
+			SetCodeVerifier(verifier).
+			SetExpiresAt(time.Now().Add(oidcLoginLifetime)).
+			Exec(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.setOIDCLoginCookie(ctx, hashToken(state), int(oidcLoginLifetime.Seconds()))
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {h.OIDC.ClientID},
+		"redirect_uri":          {h.OIDC.RedirectURL},
+		"scope":                 {"openid profile"},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	ctx.Redirect(http.StatusFound, h.OIDC.AuthorizationEndpoint+"?"+query.Encode())
+}
+
+// @Summary Finishes OIDC Login
+// @Schemes
+// @Description Exchanges the identity provider's authorization code for an ID token and signs in the user
+// @Description linked to its subject, setting the session cookie
+// @Produce json
+// @Param code query string true "Authorization Code"
+// @Param state query string true "State"
+// @Success 200 {object} sessionResponse
+// @Router /auth/oidc/callback [get]
+func (h Handler) FinishOIDCLogin(ctx *gin.Context) {
+	if e := ctx.Query("error"); e != "" {
+		ctx.JSON(http.StatusUnauthorized, ErrorResponse{
+			Message: "identity provider refused the login: " + e,
+		})
+		return
+	}
+
+	// the callback has to come back to the browser that started the login, so nobody can sign a
+	// victim in to their own account by sending them a callback for it
+	state := ctx.Query("state")
+	started, _ := ctx.Cookie(oidcLoginCookie)
+	if started == "" || subtle.ConstantTimeCompare([]byte(started), []byte(hashToken(state))) != 1 {
+		ctx.JSON(http.StatusUnauthorized, ErrorResponse{
+			Message: errInvalidLogin.Error(),
+		})
+		return
+	}
+	h.setOIDCLoginCookie(ctx, "", -1)
+
+	login, err := h.takeOIDCLogin(ctx, state)
+	var claims idTokenClaims
+	if err == nil {
+		claims, err = h.OIDC.exchange(ctx, ctx.Query("code"), login)
+	}
+	if err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnauthorized, ErrorResponse{
+			Message: errInvalidLogin.Error(),
+		})
+		return
+	}
+
+	identity, err := h.Ent.UserIdentity.Query().
+		Where(
+			useridentity.Issuer(claims.Issuer),
+			useridentity.Subject(claims.Subject),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		ctx.JSON(http.StatusForbidden, ErrorResponse{
+			Message: "no user is linked to this identity",
+		})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	token, err := randomToken()
+	expiresAt := time.Now().Add(sessionLifetime).Truncate(time.Second)
+	if err == nil {
+		err = h.Ent.Session.Create().
+			SetUserID(identity.UserID).
+			SetTokenHash(hashToken(token)).
+			SetExpiresAt(expiresAt).
+			Exec(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.setSessionCookie(ctx, token, int(sessionLifetime.Seconds()))
+	ctx.JSON(http.StatusOK, sessionResponse{
+		UserId:    identity.UserID,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// @Summary Logs Out
+// @Schemes
+// @Description Ends the session a user signed in to with the identity provider and clears its cookie
+// @Produce json
+// @Success 200
+// @Router /auth/logout [post]
+func (h Handler) Logout(ctx *gin.Context) {
+	if p, ok := PrincipalFrom(ctx); ok && p.SessionId != 0 {
+		err := h.Ent.Session.UpdateOneID(p.SessionId).
+			SetRevokedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "internal error",
+			})
+			return
+		}
+	}
+
+	h.setSessionCookie(ctx, "", -1)
+}
+
+// @Summary Links Identity
+// @Schemes
+// @Description Links a user to their subject at the identity provider, so they can sign in with it
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Param identityRequest body identityRequest true "Identity Request"
+// @Success 200 {object} identityResponse
+// @Router /user/{userid}/identities [post]
+func (h Handler) LinkIdentity(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	userId, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	userExists, err := h.userExists(ctx, userId)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if !userExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user doesn't exist",
+		})
+		return
+	}
+
+	var req identityRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "identity input malformed",
+		})
+		return
+	}
+
+	if req.Subject == "" {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "identity must have a subject",
+		})
+		return
+	}
+
+	linked, err := h.Ent.UserIdentity.Query().
+		Where(
+			useridentity.Issuer(h.OIDC.Issuer),
+			useridentity.Subject(req.Subject),
+		).
+		Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if linked {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "identity is already linked to a user",
+		})
+		return
+	}
+
+	identity, err := h.Ent.UserIdentity.Create().
+		SetUserID(userId).
+		SetIssuer(h.OIDC.Issuer).
+		SetSubject(req.Subject).
+		Save(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, identityResponse{
+		Id:        identity.ID,
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		CreatedAt: identity.CreatedAt,
+	})
+}
+
+func (h Handler) authenticateSession(ctx *gin.Context, token string) (Principal, error) {
+	s, err := h.Ent.Session.Query().
+		Where(
+			session.TokenHash(hashToken(token)),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return Principal{}, errInvalidCredentials
+	}
+	if err != nil {
+		return Principal{}, err
+	}
+
+	roles, err := h.userRoles(ctx, s.UserID)
+	if err != nil {
+		return Principal{}, err
+	}
+	return Principal{UserId: s.UserID, Roles: roles, SessionId: s.ID}, nil
+}
+
+// takeOIDCLogin gets the login a state was made for, deleting it so it can only be used once.
+func (h Handler) takeOIDCLogin(ctx *gin.Context, state string) (*ent.OidcLogin, error) {
+	login, err := h.Ent.OidcLogin.Query().
+		Where(oidclogin.State(state)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errInvalidLogin
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.Ent.OidcLogin.DeleteOne(login).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if !login.ExpiresAt.After(time.Now()) {
+		return nil, errInvalidLogin
+	}
+	return login, nil
+}
+
+// setSessionCookie sets the session cookie, or clears it with a negative maxAge.
+func (h Handler) setSessionCookie(ctx *gin.Context, token string, maxAge int) {
+	h.setCookie(ctx, SessionCookie, token, "/", maxAge)
+}
+
+// setOIDCLoginCookie sets the cookie with the hash of the state of the login the browser started,
+// or clears it with a negative maxAge.
+func (h Handler) setOIDCLoginCookie(ctx *gin.Context, stateHash string, maxAge int) {
+	h.setCookie(ctx, oidcLoginCookie, stateHash, "/auth/oidc", maxAge)
+}
+
+// setCookie sets an HttpOnly, SameSite=Lax cookie.  It's only sent over https when the provider
+// redirects back to https.
+func (h Handler) setCookie(ctx *gin.Context, name string, value string, path string, maxAge int) {
+	secure := h.OIDC != nil && strings.HasPrefix(h.OIDC.RedirectURL, "https://")
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(name, value, maxAge, path, "", secure, true)
+}
+
+// exchange trades an authorization code for an ID token, returning its claims once it's verified.
+func (p *OIDCProvider) exchange(ctx context.Context, code string, login *ent.OidcLogin) (idTokenClaims, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.RedirectURL},
+		"client_id":     {p.ClientID},
+		"code_verifier": {login.CodeVerifier},
+	}
+	if p.ClientSecret != "" {
+		form.Set("client_secret", p.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return idTokenClaims{}, fmt.Errorf("token endpoint responded %s", resp.Status)
+	}
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return idTokenClaims{}, err
+	}
+	return p.verifyIDToken(ctx, tokens.IDToken, login.Nonce)
+}
+
+// verifyIDToken checks an ID token was signed by the provider for us, hasn't expired and was
+// issued for the login with the nonce.  Only RS256 signatures are accepted.
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, token string, nonce string) (idTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return idTokenClaims{}, errors.New("id token is malformed")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return idTokenClaims{}, err
+	}
+	if header.Alg != "RS256" {
+		return idTokenClaims{}, fmt.Errorf("id token is signed with %s", header.Alg)
+	}
+
+	key, err := p.publicKey(ctx, header.Kid)
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature); err != nil {
+		return idTokenClaims{}, err
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return idTokenClaims{}, err
+	}
+	switch {
+	case claims.Issuer != p.Issuer:
+		return idTokenClaims{}, fmt.Errorf("id token is from %s", claims.Issuer)
+	case !slices.Contains(claims.Audience, p.ClientID):
+		return idTokenClaims{}, errors.New("id token isn't for us")
+	case !time.Unix(claims.ExpiresAt, 0).After(time.Now()):
+		return idTokenClaims{}, errors.New("id token has expired")
+	case claims.Nonce != nonce:
+		return idTokenClaims{}, errors.New("id token is for another login")
+	case claims.Subject == "":
+		return idTokenClaims{}, errors.New("id token has no subject")
+	}
+	return claims, nil
+}
+
+// publicKey gets the provider's RSA signing key with the key id.  The keys are fetched each time
+// so rotating them at the provider takes effect straight away.
+func (p *OIDCProvider) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || k.Kid != kid {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	}
+	return nil, fmt.Errorf("no signing key %q", kid)
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s responded %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// decodeSegment decodes a base64url JSON segment of a JWT.
+func decodeSegment(segment string, v any) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded, v)
+}
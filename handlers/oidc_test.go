@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// mockIdP is a stand-in OpenID Connect identity provider that signs in its subject whenever it's
+// asked to.
+type mockIdP struct {
+	server  *httptest.Server
+	key     *rsa.PrivateKey
+	subject string
+	codes   map[string]url.Values // the authorization requests codes were issued for
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	idp := &mockIdP{key: key, subject: "alice", codes: map[string]url.Values{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(oidcDiscovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+			http.Error(w, "pkce required", http.StatusBadRequest)
+			return
+		}
+		code := "code" + strconv.Itoa(len(idp.codes))
+		idp.codes[code] = query
+		http.Redirect(w, r, query.Get("redirect_uri")+"?"+url.Values{"code": {code}, "state": {query.Get("state")}}.Encode(), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		query, ok := idp.codes[r.PostFormValue("code")]
+		delete(idp.codes, r.PostFormValue("code"))
+		challenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != query.Get("code_challenge") ||
+			r.PostFormValue("client_id") != query.Get("client_id") || r.PostFormValue("redirect_uri") != query.Get("redirect_uri") {
+			http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"id_token": idp.sign(t, "RS256", map[string]any{
+				"iss":   idp.server.URL,
+				"sub":   idp.subject,
+				"aud":   query.Get("client_id"),
+				"exp":   time.Now().Add(time.Hour).Unix(),
+				"nonce": query.Get("nonce"),
+			}),
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// sign makes an ID token of the claims signed with the provider's key.
+func (idp *mockIdP) sign(t *testing.T, alg string, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": "test"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("could not marshal claims: %v", err)
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, hash[:])
+	if err != nil {
+		t.Fatalf("could not sign id token: %v", err)
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestOIDCProvider(t *testing.T, idp *mockIdP) *OIDCProvider {
+	t.Helper()
+
+	provider, err := NewOIDCProvider(context.Background(), OIDCConfig{
+		Issuer:      idp.server.URL,
+		ClientID:    "loans",
+		RedirectURL: "http://loans.test/auth/oidc/callback",
+	})
+	if err != nil {
+		t.Fatalf("could not discover identity provider: %v", err)
+	}
+	return provider
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	idp := newMockIdP(t)
+	provider := newTestOIDCProvider(t, idp)
+	claims := func(changes map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":   idp.server.URL,
+			"sub":   "alice",
+			"aud":   []string{"other", "loans"},
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": "nonce",
+		}
+		for k, v := range changes {
+			c[k] = v
+		}
+		return c
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{name: "valid", token: idp.sign(t, "RS256", claims(nil)), valid: true},
+		{name: "other nonce", token: idp.sign(t, "RS256", claims(map[string]any{"nonce": "other"}))},
+		{name: "other audience", token: idp.sign(t, "RS256", claims(map[string]any{"aud": "other"}))},
+		{name: "other issuer", token: idp.sign(t, "RS256", claims(map[string]any{"iss": "https://idp.test"}))},
+		{name: "expired", token: idp.sign(t, "RS256", claims(map[string]any{"exp": time.Now().Add(-time.Minute).Unix()}))},
+		{name: "other algorithm", token: idp.sign(t, "HS256", claims(nil))},
+		{name: "malformed", token: "token"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := provider.verifyIDToken(context.Background(), tc.token, "nonce")
+			if tc.valid && (err != nil || c.Subject != "alice") {
+				t.Errorf("unexpected claims: %+v, error: %v", c, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestOIDCLogin(t *testing.T) {
+	h := newTestHandler(t)
+	idp := newMockIdP(t)
+	h.OIDC = newTestOIDCProvider(t, idp)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+
+	r := newAnonymousTestRouter()
+	r.GET("/auth/oidc/login", h.StartOIDCLogin)
+	r.GET("/auth/oidc/callback", h.FinishOIDCLogin)
+	api := r.Group("/", h.Authenticate)
+	api.POST("/auth/logout", h.Logout)
+	api.GET("/user/:id/loans", h.RequireSelfOr(ViewPortfolio), h.GetLoans)
+
+	// authorize starts a login and signs in at the identity provider, returning the callback's query
+	// and the cookie the login was started with.
+	authorize := func() (string, *http.Cookie) {
+		w := serveTestRequest(t, r, "GET", "/auth/oidc/login", nil)
+		if w.Code != http.StatusFound {
+			t.Fatalf("login didn't redirect: %v", w.Code)
+		}
+		cookies := w.Result().Cookies()
+		if len(cookies) != 1 || cookies[0].Name != oidcLoginCookie || !cookies[0].HttpOnly || cookies[0].SameSite != http.SameSiteLaxMode {
+			t.Fatalf("unexpected login cookies: %+v", cookies)
+		}
+
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+		resp, err := client.Get(w.Header().Get("Location"))
+		if err != nil {
+			t.Fatalf("could not authorize: %v", err)
+		}
+		resp.Body.Close()
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		if err != nil {
+			t.Fatalf("could not parse callback: %v", err)
+		}
+		return callback.RawQuery, cookies[0]
+	}
+	request := func(method string, path string, cookie *http.Cookie) *httptest.ResponseRecorder {
+		if cookie == nil {
+			return serveTestRequest(t, r, method, path, nil)
+		}
+		return serveTestRequest(t, r, method, path, nil, "Cookie", cookie.String())
+	}
+
+	query, login := authorize()
+	if w := request("GET", "/auth/oidc/callback?"+query, login); w.Code != http.StatusForbidden {
+		t.Fatalf("unexpected status code for unlinked identity, want: %v, got: %v", http.StatusForbidden, w.Code)
+	}
+
+	w := callTestHandler(t, h.LinkIdentity, "POST", "", identityRequest{Subject: "alice"}, idParam(l.BorrowerID))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not link identity: %v", w.Body.String())
+	}
+
+	unstarted, _ := authorize()
+	otherQuery, _ := authorize()
+	_, otherLogin := authorize()
+
+	query, login = authorize()
+	w = request("GET", "/auth/oidc/callback?"+query, login)
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not log in: %v", w.Body.String())
+	}
+	s := decodeTestResponse[sessionResponse](t, w)
+	var session *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == SessionCookie {
+			session = c
+		}
+	}
+	if s.UserId != l.BorrowerID || session == nil || !session.HttpOnly {
+		t.Fatalf("unexpected session: %+v, cookies: %+v", s, w.Result().Cookies())
+	}
+
+	for _, tc := range []struct {
+		name         string
+		method       string
+		path         string
+		cookie       *http.Cookie
+		expectedCode int
+	}{
+		{name: "replayed state", method: "GET", path: "/auth/oidc/callback?" + query, cookie: login, expectedCode: http.StatusUnauthorized},
+		{name: "unknown state", method: "GET", path: "/auth/oidc/callback?code=code0&state=state", cookie: login, expectedCode: http.StatusUnauthorized},
+		{name: "without login cookie", method: "GET", path: "/auth/oidc/callback?" + unstarted, expectedCode: http.StatusUnauthorized},
+		{name: "another login's cookie", method: "GET", path: "/auth/oidc/callback?" + otherQuery, cookie: otherLogin, expectedCode: http.StatusUnauthorized},
+		{name: "refused", method: "GET", path: "/auth/oidc/callback?error=access_denied", expectedCode: http.StatusUnauthorized},
+		{name: "own loans", method: "GET", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/loans", cookie: session, expectedCode: http.StatusOK},
+		{name: "someone else's loans", method: "GET", path: "/user/" + strconv.Itoa(l.BorrowerID+1) + "/loans", cookie: session, expectedCode: http.StatusForbidden},
+		{name: "bad session", method: "GET", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/loans", cookie: &http.Cookie{Name: SessionCookie, Value: "session"}, expectedCode: http.StatusUnauthorized},
+		{name: "logout", method: "POST", path: "/auth/logout", cookie: session, expectedCode: http.StatusOK},
+		{name: "after logout", method: "GET", path: "/user/" + strconv.Itoa(l.BorrowerID) + "/loans", cookie: session, expectedCode: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := request(tc.method, tc.path, tc.cookie)
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+// UserHeader identifies the user a service makes a request on behalf of.  Services' requests
+// without it are the servicer's own, and either way they can't do more than their API key's roles
+// let them.
+const UserHeader = "X-User-ID"
+
+// loanAccess is what a user may do with a loan, each level includes the ones before it.
+type loanAccess int
+
+const (
+	noAccess loanAccess = iota
+	viewBalanceAccess
+	viewScheduleAccess
+	makePaymentsAccess
+	manageSharesAccess
+	borrowerAccess // changing the loan's terms is left to its borrowers
+)
+
+var sharePermissionAccess = map[sharedloan.Permission]loanAccess{
+	sharedloan.PermissionViewBalance:  viewBalanceAccess,
+	sharedloan.PermissionViewSchedule: viewScheduleAccess,
+	sharedloan.PermissionMakePayments: makePaymentsAccess,
+	sharedloan.PermissionManageShares: manageSharesAccess,
+}
+
+type loanShareResponse struct {
+	UserId     int    `json:"id"`
+	Permission string `json:"permission" enums:"view_balance,view_schedule,make_payments,manage_shares"`
+}
+
+// RequireViewBalance lets users who can see a loan's balance through to its endpoint.
+func (h Handler) RequireViewBalance(ctx *gin.Context) {
+	h.requireLoanAccess(ctx, viewBalanceAccess)
+}
+
+// RequireViewSchedule lets users who can see a loan's schedule through to its endpoint.
+func (h Handler) RequireViewSchedule(ctx *gin.Context) {
+	h.requireLoanAccess(ctx, viewScheduleAccess)
+}
+
+// RequireMakePayments lets users who can make a loan's payments through to its endpoint.
+func (h Handler) RequireMakePayments(ctx *gin.Context) {
+	h.requireLoanAccess(ctx, makePaymentsAccess)
+}
+
+// RequireManageShares lets users who can share a loan through to its endpoint.
+func (h Handler) RequireManageShares(ctx *gin.Context) {
+	h.requireLoanAccess(ctx, manageSharesAccess)
+}
+
+// RequireBorrower lets only a loan's borrowers through to its endpoint.
+func (h Handler) RequireBorrower(ctx *gin.Context) {
+	h.requireLoanAccess(ctx, borrowerAccess)
+}
+
+// actingUser is the user a request is made by or on behalf of, ok is false for the servicer's own
+// requests.
+func actingUser(ctx *gin.Context) (userId int, ok bool, err error) {
+	if p, authenticated := PrincipalFrom(ctx); authenticated && p.IsUser() {
+		return p.UserId, true, nil
+	}
+
+	header := ctx.GetHeader(UserHeader)
+	if header == "" {
+		return 0, false, nil
+	}
+
+	userId, err = strconv.Atoi(header)
+	if err != nil {
+		return 0, false, errors.New("user id must be numeric")
+	}
+	return userId, true, nil
+}
+
+func (h Handler) requireLoanAccess(ctx *gin.Context, required loanAccess) {
+	p, ok := requirePrincipal(ctx)
+	if !ok {
+		return
+	}
+	userId, ok, err := actingUser(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if !ok {
+		if p.staffLoanAccess() < required {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+				Message: "not permitted on this loan",
+			})
+		}
+		return
+	}
+
+	loanId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Query().
+		Where(loan.ID(loanId)).
+		WithObligors().
+		Only(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	access, err := h.loanAccess(ctx, l, userId)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if p.IsUser() {
+		access = max(access, p.staffLoanAccess())
+	} else {
+		access = min(access, p.staffLoanAccess())
+	}
+	if access < required {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+			Message: "not permitted on this loan",
+		})
+		return
+	}
+}
+
+// loanAccess is the most a user may do with a loan, whose obligors must be loaded.  Borrowers may
+// do everything, guarantors may see the schedule and others have the permission it was shared with.
+func (h Handler) loanAccess(ctx context.Context, l *ent.Loan, userId int) (loanAccess, error) {
+	access := noAccess
+	for _, o := range toObligorResponses(l) {
+		if o.UserId != userId {
+			continue
+		}
+		if o.Role == loanobligor.RoleGuarantor.String() {
+			access = viewScheduleAccess
+			continue
+		}
+		return borrowerAccess, nil
+	}
+
+	share, err := h.Ent.SharedLoan.Query().
+		Where(
+			sharedloan.UserID(userId),
+			sharedloan.LoanID(l.ID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return access, nil
+	}
+	if err != nil {
+		return noAccess, err
+	}
+
+	return max(access, sharePermissionAccess[share.Permission]), nil
+}
+
+// @Summary Gets Loan Shares
+// @Schemes
+// @Description Gets the users a loan is shared with and their permissions
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {array} loanShareResponse
+// @Router /loan/{loanid}/shares [get]
+func (h Handler) GetShares(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	shares, err := l.QuerySharedLoan().
+		Order(ent.Asc(sharedloan.FieldID)).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []loanShareResponse{}
+	for _, s := range shares {
+		response = append(response, loanShareResponse{
+			UserId:     s.UserID,
+			Permission: s.Permission.String(),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Revokes Loan Share
+// @Schemes
+// @Description Stops sharing a loan with a user
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param userid path int true "User Id"
+// @Success 200
+// @Router /loan/{loanid}/share/{userid} [delete]
+func (h Handler) DeleteShare(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	loanId, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan id must be numeric",
+		})
+		return
+	}
+
+	userId, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user id must be numeric",
+		})
+		return
+	}
+
+	deleted, err := h.Ent.SharedLoan.Delete().
+		Where(
+			sharedloan.UserID(userId),
+			sharedloan.LoanID(loanId),
+		).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if deleted == 0 {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan share",
+		})
+		return
+	}
+}
+
+// userExists checks a user exists even when the viewer can't see them, so borrowers can share
+// their loans with and add other users.
+func (h Handler) userExists(ctx context.Context, userId int) (bool, error) {
+	return h.Ent.User.Query().
+		Where(user.ID(userId)).
+		Exist(privacy.DecisionContext(ctx, privacy.Allow))
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLoanPermissions(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+
+	sharees := map[string]int{}
+	for _, permission := range []string{"view_balance", "view_schedule", "make_payments", "manage_shares"} {
+		sharees[permission] = createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+		shareTestLoan(t, h, l.ID, loanShareRequest{UserId: sharees[permission], Permission: permission})
+	}
+	stranger := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	r := newTestRouter()
+	r.GET("/loan/:id", h.RequireViewBalance, h.GetLoan)
+	r.GET("/loan/:id/schedule", h.RequireViewSchedule, h.GetLoanSchedule)
+	r.POST("/loan/:id/skip", h.RequireMakePayments, h.SkipPayment)
+	r.GET("/loan/:id/shares", h.RequireManageShares, h.GetShares)
+	r.POST("/loan/:id/recast", h.RequireBorrower, h.RecastLoan)
+
+	for _, tc := range []struct {
+		name    string
+		method  string
+		path    string
+		allowed []int
+	}{
+		{
+			name:    "view balance",
+			method:  "GET",
+			path:    "",
+			allowed: []int{l.BorrowerID, sharees["view_balance"], sharees["view_schedule"], sharees["make_payments"], sharees["manage_shares"]},
+		},
+		{
+			name:    "view schedule",
+			method:  "GET",
+			path:    "/schedule",
+			allowed: []int{l.BorrowerID, sharees["view_schedule"], sharees["make_payments"], sharees["manage_shares"]},
+		},
+		{
+			name:    "make payments",
+			method:  "POST",
+			path:    "/skip",
+			allowed: []int{l.BorrowerID, sharees["make_payments"], sharees["manage_shares"]},
+		},
+		{
+			name:    "manage shares",
+			method:  "GET",
+			path:    "/shares",
+			allowed: []int{l.BorrowerID, sharees["manage_shares"]},
+		},
+		{
+			name:    "borrower",
+			method:  "POST",
+			path:    "/recast",
+			allowed: []int{l.BorrowerID},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed := map[int]bool{}
+			for _, u := range tc.allowed {
+				allowed[u] = true
+			}
+			users := []int{l.BorrowerID, stranger}
+			for _, u := range sharees {
+				users = append(users, u)
+			}
+
+			for _, u := range users {
+				w := serveTestRequest(t, r, tc.method, "/loan/"+strconv.Itoa(l.ID)+tc.path, nil, UserHeader, strconv.Itoa(u))
+
+				if forbidden := w.Code == http.StatusForbidden; forbidden == allowed[u] {
+					t.Errorf("unexpected status code for user %d: %v", u, w.Code)
+				}
+			}
+		})
+	}
+
+	w := serveTestRequest(t, r, "GET", "/loan/"+strconv.Itoa(l.ID)+"/shares", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("servicer request was not permitted: %v", w.Code)
+	}
+	shares := decodeTestResponse[[]loanShareResponse](t, w)
+	if len(shares) != 4 || shares[0].Permission != "view_balance" || shares[3].Permission != "manage_shares" {
+		t.Errorf("unexpected shares: %+v", shares)
+	}
+}
+
+func TestDeleteShare(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	sharee := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	params := gin.Params{idParam(l.ID), {Key: "userId", Value: strconv.Itoa(sharee)}}
+
+	w := callTestHandler(t, h.ShareLoan, "POST", "", loanShareRequest{UserId: sharee, Permission: "owner"}, params...)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected status code for unknown permission, want: %v, got: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	shareTestLoan(t, h, l.ID, loanShareRequest{UserId: sharee})
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusNotFound} {
+		w := callTestHandler(t, h.DeleteShare, "DELETE", "", nil, params...)
+
+		if w.Code != expectedCode {
+			t.Fatalf("unexpected status code, want: %v, got: %v", expectedCode, w.Code)
+		}
+	}
+}
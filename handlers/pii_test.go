@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/crusyn/loans/ent/schema"
+	"github.com/crusyn/loans/pii"
+)
+
+func TestPIIEncryption(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := adminContext()
+
+	w := callTestHandler(t, h.CreateUser, "POST", "", newUserRequest{Name: "chris", Social: "123-45-6789", Address: "1 main st"})
+
+	created := decodeTestResponse[newUserResponse](t, w)
+
+	// the same in-memory database, read without ent decrypting it
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+	var name, address string
+	if err := db.QueryRow("SELECT name, address FROM users WHERE id = ?", created.UserId).Scan(&name, &address); err != nil {
+		t.Fatalf("could not read user: %v", err)
+	}
+	if strings.Contains(name, "chris") || strings.Contains(address, "main") || pii.KeyID(name) != "test" || pii.KeyID(address) != "test" {
+		t.Errorf("personal information wasn't encrypted, name: %v, address: %v", name, address)
+	}
+
+	// values are bound to their column
+	if _, err := db.Exec("UPDATE users SET address = name WHERE id = ?", created.UserId); err != nil {
+		t.Fatalf("could not update user: %v", err)
+	}
+	if u, err := h.Ent.User.Get(ctx, created.UserId); err == nil {
+		t.Errorf("read a value moved from another column: %+v", u)
+	}
+	if _, err := db.Exec("UPDATE users SET address = ? WHERE id = ?", address, created.UserId); err != nil {
+		t.Fatalf("could not update user: %v", err)
+	}
+
+	// saved before the fields were encrypted
+	legacy, err := db.Exec("INSERT INTO users (name, address) VALUES ('legacy', '2 main st')")
+	if err != nil {
+		t.Fatalf("could not insert user: %v", err)
+	}
+	legacyId, _ := legacy.LastInsertId()
+	if u, err := h.Ent.User.Get(ctx, int(legacyId)); err == nil {
+		t.Errorf("read a user that wasn't migrated: %+v", u)
+	}
+
+	rotated := pii.Keyring{
+		Current: "new",
+		Keys: map[string][]byte{
+			"new":  []byte("new pii key, also 32 bytes long."),
+			"test": testPII.Keys["test"],
+		},
+	}
+	for _, expected := range []int{4, 0} {
+		migrated, err := pii.Migrate(ctx, db, rotated, schema.User{}, schema.UserChange{})
+		if err != nil || migrated != expected {
+			t.Fatalf("unexpected migration, want: %v, got: %v, error: %v", expected, migrated, err)
+		}
+	}
+
+	// only the current key is needed once everything is migrated
+	pii.SetKeyProvider(pii.Keyring{Current: "new", Keys: map[string][]byte{"new": rotated.Keys["new"]}})
+	defer pii.SetKeyProvider(testPII)
+	for _, tc := range []struct {
+		id      int
+		name    string
+		address string
+	}{
+		{id: created.UserId, name: "chris", address: "1 main st"},
+		{id: int(legacyId), name: "legacy", address: "2 main st"},
+	} {
+		u, err := h.Ent.User.Get(ctx, tc.id)
+		if err != nil || u.Name != tc.name || u.Address != tc.address {
+			t.Errorf("unexpected user: %+v, error: %v", u, err)
+		}
+		if err := db.QueryRow("SELECT name, address FROM users WHERE id = ?", tc.id).Scan(&name, &address); err != nil {
+			t.Fatalf("could not read user: %v", err)
+		}
+		if pii.KeyID(name) != "new" || pii.KeyID(address) != "new" {
+			t.Errorf("user wasn't encrypted with the current key, name: %v, address: %v", name, address)
+		}
+		var index string
+		if err := db.QueryRow("SELECT name_index FROM users WHERE id = ?", tc.id).Scan(&index); err != nil {
+			t.Fatalf("could not read user: %v", err)
+		}
+		if !strings.HasPrefix(index, "new: ") || strings.Contains(index, tc.name[:3]) {
+			t.Errorf("name wasn't indexed with the current key: %v", index)
+		}
+	}
+
+	pii.SetKeyProvider(testPII)
+	if u, err := h.Ent.User.Get(ctx, created.UserId); err == nil {
+		t.Errorf("read user without its key: %+v", u)
+	}
+}
+
+func TestLoadKeyring(t *testing.T) {
+	key := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	for _, tc := range []struct {
+		name    string
+		file    string
+		current string
+		valid   bool
+	}{
+		{
+			name:    "valid",
+			file:    "# rotated monthly\nnew:" + key("new pii key, also 32 bytes long.") + "\n\nold:" + key("old pii key, also 32 bytes long.") + "\n",
+			current: "new",
+			valid:   true,
+		},
+		{name: "empty", file: "# no keys\n"},
+		{name: "short key", file: "new:" + key("short") + "\n"},
+		{name: "not base64", file: "new:key\n"},
+		{name: "no id", file: key("new pii key, also 32 bytes long.") + "\n"},
+		{name: "duplicate", file: "new:" + key("new pii key, also 32 bytes long.") + "\nnew:" + key("old pii key, also 32 bytes long.") + "\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys")
+			if err := os.WriteFile(path, []byte(tc.file), 0600); err != nil {
+				t.Fatalf("could not write keyring: %v", err)
+			}
+
+			k, err := pii.LoadKeyring(path)
+
+			if tc.valid && (err != nil || k.Current != tc.current || len(k.Keys) != 2) {
+				t.Errorf("unexpected keyring: %+v, error: %v", k, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// pmiRequestedLTV is the loan-to-value the borrower may ask to cancel mortgage insurance at.
+	pmiRequestedLTV = 0.80
+	// pmiAutomaticLTV is the loan-to-value mortgage insurance terminates at without a request.
+	pmiAutomaticLTV = 0.78
+)
+
+type pmiCancellationRequest struct {
+	Month int `json:"month"` // the first month without mortgage insurance
+}
+
+type pmiResponse struct {
+	PropertyValue  float64 `json:"propertyValue"`
+	Rate           float64 `json:"rate"`
+	MonthlyPremium float64 `json:"monthlyPremium"`
+	TotalPremiums  float64 `json:"totalPremiums"`
+	// RemovalMonth is the first month without mortgage insurance, zero if it is never removed.
+	RemovalMonth int    `json:"removalMonth"`
+	Removal      string `json:"removal,omitempty" enums:"requested,automatic"`
+}
+
+// @Summary Gets Mortgage Insurance
+// @Schemes
+// @Description Gets a loan's private mortgage insurance premium and the month it is removed
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {object} pmiResponse
+// @Router /loan/{loanid}/pmi [get]
+func (h Handler) GetPMI(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	if l.PmiRate == 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan has no mortgage insurance",
+		})
+		return
+	}
+
+	h.respondWithPMI(ctx, l)
+}
+
+// @Summary Cancels Mortgage Insurance
+// @Schemes
+// @Description Cancels a loan's private mortgage insurance at the borrower's request from a month in which
+// @Description the balance is at most 80% of the property value.  Without a request it is removed
+// @Description automatically at 78%.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param pmiCancellationRequest body pmiCancellationRequest true "Cancellation Request"
+// @Success 200 {object} pmiResponse
+// @Router /loan/{loanid}/pmi/cancellation [post]
+func (h Handler) CancelPMI(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req pmiCancellationRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "cancellation input malformed",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := req.validate(l, schedule); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	l, err = l.Update().
+		SetPmiCancellationMonth(req.Month).
+		Save(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithPMI(ctx, l)
+}
+
+// validate checks a cancellation request against the loan's schedule, which already has its
+// mortgage insurance applied.
+func (r pmiCancellationRequest) validate(l *ent.Loan, schedule []monthlySummary) error {
+	if l.PmiRate == 0 {
+		return errors.New("loan has no mortgage insurance")
+	}
+	if l.PmiCancellationMonth != 0 {
+		return errors.New("mortgage insurance cancellation has already been requested")
+	}
+	if r.Month < 1 || r.Month > len(schedule) {
+		return errors.New("cancellation month must be within the term")
+	}
+	if removal := pmiRemovalMonth(schedule); removal != 0 && removal <= r.Month {
+		return errors.New("mortgage insurance is already removed by this month")
+	}
+	if schedule[r.Month-1].BeginningBalance > pmiRequestedLTV*float64(l.PropertyValue)/100 {
+		return errors.New("balance must be at most 80% of the property value to cancel mortgage insurance")
+	}
+	return nil
+}
+
+func toMortgageInsurance(l *ent.Loan) mortgageInsurance {
+	return mortgageInsurance{
+		PropertyValue:  float64(l.PropertyValue) / 100,
+		Rate:           l.PmiRate,
+		OriginalAmount: float64(l.Amount) / 100,
+		RequestedMonth: l.PmiCancellationMonth,
+		AutomaticLTV:   pmiAutomaticLTV,
+	}
+}
+
+func (h Handler) respondWithPMI(ctx *gin.Context, l *ent.Loan) {
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	total := 0.0
+	for _, m := range schedule {
+		total = roundCents(total + m.PMI)
+	}
+	response := pmiResponse{
+		PropertyValue:  float64(l.PropertyValue) / 100,
+		Rate:           l.PmiRate,
+		MonthlyPremium: float64(toMortgageInsurance(l).monthlyPremiumCents()) / 100,
+		TotalPremiums:  total,
+		RemovalMonth:   pmiRemovalMonth(schedule),
+	}
+	if response.RemovalMonth != 0 {
+		response.Removal = "automatic"
+		if response.RemovalMonth == l.PmiCancellationMonth {
+			response.Removal = "requested"
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// pmiRemovalMonth is the first month of the schedule without mortgage insurance, or zero if
+// it is never removed.
+func pmiRemovalMonth(schedule []monthlySummary) int {
+	for _, m := range schedule {
+		if m.PMIRemoved {
+			return m.Month
+		}
+	}
+	return 0
+}
+
+// mortgageInsurance is private mortgage insurance charged at Rate of the original amount a
+// year.  It terminates automatically from the first month the balance is at most
+// AutomaticLTV of the property value, or from RequestedMonth if the borrower asked to cancel.
+type mortgageInsurance struct {
+	PropertyValue  float64
+	Rate           float64
+	OriginalAmount float64
+	RequestedMonth int // zero if cancellation hasn't been requested
+	AutomaticLTV   float64
+}
+
+func (p mortgageInsurance) monthlyPremiumCents() int {
+	return int(math.Ceil(p.OriginalAmount * 100 * p.Rate / 12))
+}
+
+// applyMortgageInsurance sets the mortgage insurance premium on each month of the schedule until
+// it is removed, and marks the month it is removed.  Like escrow, premiums aren't collected in
+// deferred months.
+func applyMortgageInsurance(schedule []monthlySummary, p mortgageInsurance) {
+	premium := float64(p.monthlyPremiumCents()) / 100
+	automatic := p.AutomaticLTV * p.PropertyValue
+	removed := false
+	for i := range schedule {
+		if !removed {
+			requested := p.RequestedMonth != 0 && schedule[i].Month >= p.RequestedMonth
+			if requested || schedule[i].BeginningBalance <= automatic {
+				removed = true
+				schedule[i].PMIRemoved = true
+			}
+		}
+
+		schedule[i].PMI = 0
+		if !removed && !schedule[i].Deferred {
+			schedule[i].PMI = premium
+		}
+	}
+}
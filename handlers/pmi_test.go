@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// firstMonthAtLTV is the first month of schedule starting with a balance at most ltv of value.
+func firstMonthAtLTV(schedule []monthlySummary, ltv float64, value float64) int {
+	for _, m := range schedule {
+		if m.BeginningBalance <= ltv*value {
+			return m.Month
+		}
+	}
+	return 0
+}
+
+func TestMortgageInsuranceSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		requestedMonth func(schedule []monthlySummary) int
+		ltv            float64
+	}{
+		{
+			name:           "automatic",
+			requestedMonth: func([]monthlySummary) int { return 0 },
+			ltv:            0.78,
+		},
+		{
+			name: "requested",
+			requestedMonth: func(schedule []monthlySummary) int {
+				return firstMonthAtLTV(schedule, 0.80, 240000)
+			},
+			ltv: 0.80,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := CreateAmortizationSchedule(200000, 0.06, 360)
+			if err != nil {
+				t.Fatalf("could not create amortization schedule: %v", err)
+			}
+			removal := firstMonthAtLTV(schedule, tc.ltv, 240000)
+
+			applyMortgageInsurance(schedule, mortgageInsurance{
+				PropertyValue:  240000,
+				Rate:           0.005,
+				OriginalAmount: 200000,
+				RequestedMonth: tc.requestedMonth(schedule),
+				AutomaticLTV:   pmiAutomaticLTV,
+			})
+
+			if got := pmiRemovalMonth(schedule); got != removal {
+				t.Fatalf("unexpected removal month, want: %d, got: %d", removal, got)
+			}
+			if schedule[removal-2].PMI != 83.34 {
+				t.Errorf("unexpected premium before removal, want: 83.34, got: %v", schedule[removal-2].PMI)
+			}
+			for _, m := range schedule[removal-1:] {
+				if m.PMI != 0 {
+					t.Fatalf("mortgage insurance charged after removal in month %d", m.Month)
+				}
+			}
+		})
+	}
+}
+
+func TestCancelPMI(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	l, err := l.Update().SetPropertyValue(24000000).SetPmiRate(0.005).Save(adminContext())
+	if err != nil {
+		t.Fatalf("could not add mortgage insurance: %v", err)
+	}
+	loan := idParam(l.ID)
+
+	schedule, err := CreateAmortizationSchedule(200000, 0.06, 360)
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	eligible := firstMonthAtLTV(schedule, 0.80, 240000)
+
+	for _, tc := range []struct {
+		name         string
+		month        int
+		expectedCode int
+	}{
+		{
+			name:         "above 80%",
+			month:        eligible - 1,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "at 80%",
+			month:        eligible,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "already requested",
+			month:        eligible + 1,
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CancelPMI, "POST", "", pmiCancellationRequest{Month: tc.month}, loan)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[pmiResponse](t, w)
+			if resp.RemovalMonth != eligible || resp.Removal != "requested" {
+				t.Errorf("unexpected removal, month: %d, removal: %v", resp.RemovalMonth, resp.Removal)
+			}
+			if want := roundCents(float64(eligible-1) * 83.34); resp.TotalPremiums != want {
+				t.Errorf("unexpected total premiums, want: %v, got: %v", want, resp.TotalPremiums)
+			}
+		})
+	}
+
+	w := callTestHandler(t, h.GetMonthSummary, "GET", "", nil, loan, gin.Param{Key: "number", Value: "1"})
+
+	summary := decodeTestResponse[loanMonthSummaryResponse](t, w)
+	if summary.PMIRemovalMonth != eligible {
+		t.Errorf("unexpected removal month in summary, want: %d, got: %d", eligible, summary.PMIRemovalMonth)
+	}
+
+	w = callTestHandler(t, h.CreateEscrowItem, "POST", "", escrowItemRequest{Kind: "pmi", AnnualAmount: 1000, FirstDueMonth: 1}, loan)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("mortgage insurance paid from escrow as well, got: %v", w.Code)
+	}
+}
+
+func TestCreateLoanWithPMI(t *testing.T) {
+	h := newTestHandler(t)
+	borrower := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	for _, tc := range []struct {
+		name         string
+		request      newLoanRequest
+		expectedCode int
+	}{
+		{
+			name:         "without property value",
+			request:      newLoanRequest{Amount: 200000, Rate: 0.06, Months: 360, Borrower: borrower, PMIRate: 0.005},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "with property value",
+			request:      newLoanRequest{Amount: 200000, Rate: 0.06, Months: 360, Borrower: borrower, PropertyValue: 240000, PMIRate: 0.005},
+			expectedCode: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateLoan, "POST", "", tc.request)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[newLoanResponse](t, w)
+			l, err := h.Ent.Loan.Get(adminContext(), resp.LoanId)
+			if err != nil {
+				t.Fatalf("could not get loan: %v", err)
+			}
+			if got := toLoanResponse(l); got.PropertyValue != 240000 || got.PMIRate != 0.005 {
+				t.Errorf("mortgage insurance not saved: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/viewer"
+	"github.com/gin-gonic/gin"
+)
+
+// userContext is a context for a user, which the privacy policies keep to their own loans.
+func userContext(userId int) context.Context {
+	return viewer.NewContext(context.Background(), viewer.Viewer{UserId: userId})
+}
+
+func TestLoanPrivacy(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	sharee := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	stranger := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+	shareTestLoan(t, h, l.ID, loanShareRequest{UserId: sharee, Permission: "manage_shares"})
+
+	for _, tc := range []struct {
+		name  string
+		ctx   context.Context
+		loans int
+		users int
+	}{
+		{name: "service", ctx: adminContext(), loans: 3, users: 3},
+		{name: "borrower", ctx: userContext(l.BorrowerID), loans: 1, users: 1},
+		{name: "sharee", ctx: userContext(sharee), loans: 2, users: 1},
+		{name: "share link", ctx: viewer.NewContext(context.Background(), viewer.Viewer{LoanId: l.ID}), loans: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			loans, err := h.Ent.Loan.Query().Count(tc.ctx)
+			if err != nil || loans != tc.loans {
+				t.Errorf("unexpected loans, want: %v, got: %v, error: %v", tc.loans, loans, err)
+			}
+			users, _ := h.Ent.User.Query().Count(tc.ctx)
+			if users != tc.users {
+				t.Errorf("unexpected users, want: %v, got: %v", tc.users, users)
+			}
+		})
+	}
+
+	if _, err := h.Ent.Loan.Query().Count(context.Background()); !errors.Is(err, privacy.Deny) {
+		t.Errorf("loans were read without a viewer: %v", err)
+	}
+	if _, err := h.Ent.Loan.Get(userContext(stranger), l.ID); err == nil {
+		t.Errorf("stranger could read the loan")
+	}
+
+	err := h.Ent.Loan.UpdateOneID(l.ID).SetPmiCancellationMonth(12).Exec(userContext(sharee))
+	if err == nil {
+		t.Errorf("sharee could change the loan")
+	}
+	err = h.Ent.Loan.UpdateOneID(l.ID).SetPmiCancellationMonth(12).Exec(userContext(l.BorrowerID))
+	if err != nil {
+		t.Errorf("borrower could not change the loan: %v", err)
+	}
+
+	err = h.Ent.SharedLoan.Create().SetLoanID(l.ID).SetUserID(stranger).Exec(userContext(stranger))
+	if !errors.Is(err, privacy.Deny) {
+		t.Errorf("stranger could share the loan with themselves: %v", err)
+	}
+	if deleted, err := h.Ent.SharedLoan.Delete().Exec(userContext(stranger)); err != nil || deleted != 0 {
+		t.Errorf("stranger could remove the loan's shares: %v, error: %v", deleted, err)
+	}
+	if updated, err := h.Ent.Loan.Update().SetTerm(12).Save(userContext(stranger)); err != nil || updated != 1 {
+		t.Errorf("stranger could change loans besides their own: %v, error: %v", updated, err)
+	}
+	if shares, _ := h.Ent.SharedLoan.Query().Count(adminContext()); shares != 1 {
+		t.Errorf("unexpected shares: %v", shares)
+	}
+}
+
+func TestStaffPrivacy(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	staffContext := func(role staffrole.Role) context.Context {
+		p := Principal{UserId: createTestLoan(t, h, 1000, 0.05, 12).BorrowerID, Roles: []staffrole.Role{role}}
+		return viewer.NewContext(context.Background(), p.Viewer())
+	}
+
+	for _, tc := range []struct {
+		role    staffrole.Role
+		creates bool
+		changes bool
+		shares  bool
+		deletes bool
+	}{
+		{role: staffrole.RoleAuditor},
+		{role: staffrole.RoleLoanOfficer, creates: true},
+		{role: staffrole.RoleServicer, changes: true, shares: true},
+		{role: staffrole.RoleAdmin, creates: true, changes: true, shares: true, deletes: true},
+	} {
+		t.Run(string(tc.role), func(t *testing.T) {
+			ctx := staffContext(tc.role)
+			if _, err := h.Ent.User.Get(ctx, l.BorrowerID); err != nil {
+				t.Errorf("could not read the borrower: %v", err)
+			}
+
+			err := h.Ent.User.Create().SetName("New Borrower").Exec(ctx)
+			if (err == nil) != tc.creates {
+				t.Errorf("unexpected user creation, want: %v, error: %v", tc.creates, err)
+			}
+			err = h.Ent.User.UpdateOneID(l.BorrowerID).SetName("Renamed").Exec(ctx)
+			if (err == nil) != tc.changes {
+				t.Errorf("unexpected user change, want: %v, error: %v", tc.changes, err)
+			}
+			err = h.Ent.Loan.UpdateOneID(l.ID).SetPmiCancellationMonth(12).Exec(ctx)
+			if (err == nil) != tc.changes {
+				t.Errorf("unexpected loan change, want: %v, error: %v", tc.changes, err)
+			}
+			sharee := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+			h.Ent.SharedLoan.Create().SetLoanID(l.ID).SetUserID(sharee).ExecX(adminContext())
+			deleted, _ := h.Ent.SharedLoan.Delete().Where(sharedloan.UserID(sharee)).Exec(ctx)
+			if (deleted == 1) != tc.shares {
+				t.Errorf("unexpected share removal, want: %v, got: %v", tc.shares, deleted)
+			}
+			_, err = h.Ent.User.Delete().Where(user.ID(-1)).Exec(ctx)
+			if (err == nil) != tc.deletes {
+				t.Errorf("unexpected user deletion, want: %v, error: %v", tc.deletes, err)
+			}
+		})
+	}
+}
+
+func TestSharingAsUsers(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	sharee := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	asUser := func(userId int, handle gin.HandlerFunc) gin.HandlerFunc {
+		return func(ctx *gin.Context) {
+			ctx.Set(principalKey, Principal{UserId: userId})
+			ctx.Request = ctx.Request.WithContext(userContext(userId))
+			handle(ctx)
+		}
+	}
+
+	w := callTestHandler(t, asUser(l.BorrowerID, h.ShareLoan), "POST", "", loanShareRequest{UserId: sharee}, idParam(l.ID))
+	if w.Code != http.StatusOK {
+		t.Fatalf("borrower could not invite: %v", w.Body.String())
+	}
+	invitation, err := h.Ent.ShareInvitation.Query().Only(adminContext())
+	if err != nil {
+		t.Fatalf("could not find invitation: %v", err)
+	}
+
+	w = callTestHandler(t, asUser(sharee, h.AcceptInvitation), "POST", "", nil, idParam(invitation.ID))
+	if w.Code != http.StatusOK {
+		t.Fatalf("sharee could not accept: %v", w.Body.String())
+	}
+	if _, err := h.Ent.Loan.Get(userContext(sharee), l.ID); err != nil {
+		t.Errorf("sharee could not read the loan: %v", err)
+	}
+}
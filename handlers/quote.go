@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type quoteRequest struct {
+	Amount  float64     `json:"amount"`
+	Rate    float64     `json:"rate"`
+	Months  int         `json:"months"`
+	Options loanOptions `json:"options"`
+}
+
+type scheduleMonthResponseItem struct {
+	Month            int     `json:"month"`
+	BeginningBalance float64 `json:"beginningBalance"`
+	MonthlyPayment   float64 `json:"monthlyPayment"`
+	Principal        float64 `json:"principal"`
+	Interest         float64 `json:"interest"`
+	ExtraPrincipal   float64 `json:"extraPrincipal"`
+	// NegativeAmortization is interest the payment did not cover, added to the balance.
+	NegativeAmortization float64 `json:"negativeAmortization"`
+	Recast               bool    `json:"recast"` // the payment is re-amortized from the next month
+	EndingBalance        float64 `json:"endingBalance"`
+	TotalPrincipalPaid   float64 `json:"totalPrincipalPaid"`
+	TotalInterestPaid    float64 `json:"totalInterestPaid"`
+}
+
+type quoteResponse struct {
+	MonthlyPayment float64                     `json:"monthlyPayment"`
+	PayoffMonth    int                         `json:"payoffMonth"`
+	TotalPrincipal float64                     `json:"totalPrincipal"`
+	TotalInterest  float64                     `json:"totalInterest"`
+	TotalPaid      float64                     `json:"totalPaid"`
+	Schedule       []scheduleMonthResponseItem `json:"schedule"`
+}
+
+// @Summary Quotes Loan
+// @Schemes
+// @Description Calculates the payment, totals and full schedule for hypothetical loan terms.
+// @Description Nothing is persisted, so no user or loan needs to exist.
+// @Accept json
+// @Produce json
+// @Param quoteRequest body quoteRequest true "Quote Request"
+// @Success 200 {object} quoteResponse
+// @Router /quote [post]
+func (h Handler) Quote(ctx *gin.Context) {
+	var req quoteRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "quote input malformed",
+		})
+		return
+	}
+
+	if err := validateLoanTerms(req.Amount, req.Rate, req.Months); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := req.Options.validate(req.Months); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	schedule, err := CreateAmortizationScheduleWithOptions(req.Amount, req.Rate, req.Months, req.Options)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newQuoteResponse(schedule))
+}
+
+func newQuoteResponse(schedule []monthlySummary) quoteResponse {
+	last := schedule[len(schedule)-1]
+
+	return quoteResponse{
+		MonthlyPayment: schedule[0].MonthlyPayment,
+		PayoffMonth:    last.Month,
+		TotalPrincipal: last.TotalPrincipalPaid,
+		TotalInterest:  last.TotalInterestPaid,
+		TotalPaid:      roundCents(last.TotalPrincipalPaid + last.TotalInterestPaid),
+		Schedule:       newScheduleResponse(schedule),
+	}
+}
+
+func newScheduleResponse(schedule []monthlySummary) []scheduleMonthResponseItem {
+	months := make([]scheduleMonthResponseItem, 0, len(schedule))
+	for _, m := range schedule {
+		months = append(months, scheduleMonthResponseItem{
+			Month:                m.Month,
+			BeginningBalance:     m.BeginningBalance,
+			MonthlyPayment:       m.MonthlyPayment,
+			Principal:            m.CurrentPrincipal,
+			Interest:             m.CurrentInterest,
+			ExtraPrincipal:       m.ExtraPrincipal,
+			NegativeAmortization: m.NegativeAmortization,
+			Recast:               m.Recast,
+			EndingBalance:        m.EndingBalance,
+			TotalPrincipalPaid:   m.TotalPrincipalPaid,
+			TotalInterestPaid:    m.TotalInterestPaid,
+		})
+	}
+	return months
+}
+
+// roundCents rounds a dollar amount that was summed in floating point back to whole cents.
+func roundCents(dollars float64) float64 {
+	return math.Round(dollars*100) / 100
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	h := newTestHandler(t)
+
+	for _, tc := range []struct {
+		name            string
+		request         quoteRequest
+		expectedCode    int
+		expectedPayment float64
+		expectedPayoff  int
+	}{
+		{
+			name: "$1M @ 5%",
+			request: quoteRequest{
+				Amount: 1000000,
+				Rate:   0.05,
+				Months: 360,
+			},
+			expectedCode:    http.StatusOK,
+			expectedPayment: 5368.23,
+			expectedPayoff:  360,
+		},
+		{
+			name: "$1M @ 5% with extra payments",
+			request: quoteRequest{
+				Amount: 1000000,
+				Rate:   0.05,
+				Months: 360,
+				Options: loanOptions{
+					ExtraMonthlyPayment: 1000,
+					Prepayments:         []prepayment{{Month: 12, Amount: 50000}},
+				},
+			},
+			expectedCode:    http.StatusOK,
+			expectedPayment: 5368.23,
+			expectedPayoff:  235,
+		},
+		{
+			name: "negative amount",
+			request: quoteRequest{
+				Amount: -1,
+				Rate:   0.05,
+				Months: 360,
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "prepayment after term",
+			request: quoteRequest{
+				Amount: 1000,
+				Rate:   0.05,
+				Months: 12,
+				Options: loanOptions{
+					Prepayments: []prepayment{{Month: 13, Amount: 100}},
+				},
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.Quote, "POST", "", tc.request)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[quoteResponse](t, w)
+			if resp.MonthlyPayment != tc.expectedPayment {
+				t.Errorf("unexpected monthly payment, want: %v, got: %v", tc.expectedPayment, resp.MonthlyPayment)
+			}
+			if resp.PayoffMonth != tc.expectedPayoff || len(resp.Schedule) != tc.expectedPayoff {
+				t.Errorf("unexpected payoff month, want: %v, got: %v (%d scheduled)", tc.expectedPayoff, resp.PayoffMonth, len(resp.Schedule))
+			}
+			if resp.TotalPrincipal != tc.request.Amount {
+				t.Errorf("unexpected total principal, want: %v, got: %v", tc.request.Amount, resp.TotalPrincipal)
+			}
+			if last := resp.Schedule[len(resp.Schedule)-1]; last.EndingBalance != 0 {
+				t.Errorf("loan not paid off, ending balance: %v", last.EndingBalance)
+			}
+		})
+	}
+
+	loans, err := h.Ent.Loan.Query().Count(adminContext())
+	if err != nil {
+		t.Fatalf("could not count loans: %v", err)
+	}
+	users, err := h.Ent.User.Query().Count(adminContext())
+	if err != nil {
+		t.Fatalf("could not count users: %v", err)
+	}
+	if loans != 0 || users != 0 {
+		t.Errorf("quote persisted data, loans: %d, users: %d", loans, users)
+	}
+}
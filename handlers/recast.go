@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loanrecast"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type recastRequest struct {
+	Month       int     `json:"month"`       // the payment drops from the following month
+	Curtailment float64 `json:"curtailment"` // lump sum paid toward principal in the recast month
+}
+
+type recastResponse struct {
+	Month           int                         `json:"month"`
+	Curtailment     float64                     `json:"curtailment"`
+	PreviousPayment float64                     `json:"previousPayment"`
+	NewPayment      float64                     `json:"newPayment"`
+	MaturityMonth   int                         `json:"maturityMonth"`
+	Schedule        []scheduleMonthResponseItem `json:"schedule"`
+}
+
+// @Summary Recasts Loan
+// @Schemes
+// @Description Applies an optional lump-sum principal curtailment and re-amortizes the remaining balance
+// @Description over the remaining term.  Unlike a prepayment, which shortens the term, a recast keeps
+// @Description the maturity and lowers the payment from the following month.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param recastRequest body recastRequest true "Recast Request"
+// @Success 200 {object} recastResponse
+// @Router /loan/{loanid}/recast [post]
+func (h Handler) RecastLoan(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req recastRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "recast input malformed",
+		})
+		return
+	}
+
+	th, tx, err := h.withTx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	defer tx.Rollback() // does nothing once committed
+
+	recastExists, err := tx.LoanRecast.Query().
+		Where(
+			loanrecast.LoanID(l.ID),
+			loanrecast.Month(req.Month),
+		).Exist(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if recastExists {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan is already recast in this month",
+		})
+		return
+	}
+
+	previous, err := th.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := req.validate(previous); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = tx.LoanRecast.Create().
+		SetLoanID(l.ID).
+		SetMonth(req.Month).
+		SetCurtailment(int(math.Round(req.Curtailment * 100))).
+		Exec(ctx)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	schedule, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, recastResponse{
+		Month:           req.Month,
+		Curtailment:     req.Curtailment,
+		PreviousPayment: previous[req.Month].MonthlyPayment,
+		NewPayment:      schedule[req.Month].MonthlyPayment,
+		MaturityMonth:   len(schedule),
+		Schedule:        newScheduleResponse(schedule),
+	})
+}
+
+// validate checks the recast against the loan's current schedule.  The balance must not be paid
+// off by the curtailment, and a payment must remain after the recast month to lower.
+func (r recastRequest) validate(schedule []monthlySummary) error {
+	if r.Month < 1 || r.Month >= len(schedule) {
+		return errors.New("recast month must be before the last payment")
+	}
+	if schedule[r.Month-1].Deferred || schedule[r.Month].Deferred {
+		return errors.New("cannot recast while payments are deferred")
+	}
+	if schedule[r.Month-1].Construction {
+		return errors.New("cannot recast before the loan converts from construction")
+	}
+	if r.Curtailment < 0 {
+		return errors.New("curtailment cannot be negative")
+	}
+	if r.Curtailment >= schedule[r.Month-1].EndingBalance {
+		return errors.New("curtailment must be less than the remaining balance")
+	}
+	return nil
+}
+
+func (h Handler) loanRecasts(ctx context.Context, loanId int) ([]*ent.LoanRecast, error) {
+	return h.Ent.LoanRecast.Query().
+		Where(loanrecast.LoanID(loanId)).
+		Order(ent.Asc(loanrecast.FieldMonth)).
+		All(ctx)
+}
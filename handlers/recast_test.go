@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRecastSchedule(t *testing.T) {
+	recasted, err := CreateAmortizationScheduleWithOptions(100000, 0.06, 360, loanOptions{
+		Recasts: []recast{{Month: 60, Curtailment: 20000}},
+	})
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+	prepaid, err := CreateAmortizationScheduleWithOptions(100000, 0.06, 360, loanOptions{
+		Prepayments: []prepayment{{Month: 60, Amount: 20000}},
+	})
+	if err != nil {
+		t.Fatalf("could not create amortization schedule: %v", err)
+	}
+
+	if recasted[59].EndingBalance != prepaid[59].EndingBalance {
+		t.Errorf("curtailment not applied like a prepayment, want: %v, got: %v", prepaid[59].EndingBalance, recasted[59].EndingBalance)
+	}
+	if len(recasted) != 360 {
+		t.Errorf("recast changed maturity, want: 360, got: %d", len(recasted))
+	}
+	if len(prepaid) >= 360 {
+		t.Errorf("prepayment did not shorten term, got: %d months", len(prepaid))
+	}
+	if recasted[60].MonthlyPayment >= recasted[59].MonthlyPayment {
+		t.Errorf("recast did not lower payment, before: %v, after: %v", recasted[59].MonthlyPayment, recasted[60].MonthlyPayment)
+	}
+	if prepaid[60].MonthlyPayment != prepaid[59].MonthlyPayment {
+		t.Errorf("prepayment changed payment, before: %v, after: %v", prepaid[59].MonthlyPayment, prepaid[60].MonthlyPayment)
+	}
+	if last := recasted[len(recasted)-1]; last.EndingBalance != 0 {
+		t.Errorf("loan not paid off, ending balance: %v", last.EndingBalance)
+	}
+}
+
+func TestRecastLoan(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 100000, 0.06, 360)
+
+	for _, tc := range []struct {
+		name         string
+		request      recastRequest
+		expectedCode int
+	}{
+		{
+			name:         "after curtailment",
+			request:      recastRequest{Month: 60, Curtailment: 20000},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "same month",
+			request:      recastRequest{Month: 60},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "pays off loan",
+			request:      recastRequest{Month: 120, Curtailment: 100000},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "last month",
+			request:      recastRequest{Month: 360},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.RecastLoan, "POST", "", tc.request, idParam(l.ID))
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[recastResponse](t, w)
+			if resp.NewPayment >= resp.PreviousPayment {
+				t.Errorf("recast did not lower payment, before: %v, after: %v", resp.PreviousPayment, resp.NewPayment)
+			}
+			if resp.MaturityMonth != 360 || len(resp.Schedule) != 360 {
+				t.Errorf("recast changed maturity, got: %d", resp.MaturityMonth)
+			}
+		})
+	}
+}
+
+func TestConcurrentRecasts(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 100000, 0.06, 360)
+
+	succeeded := racing(2, h.Ent.LoanRecast.Use, func() int {
+		w := callTestHandler(t, h.RecastLoan, "POST", "", recastRequest{Month: 12, Curtailment: 10000}, idParam(l.ID))
+
+		return w.Code
+	})
+
+	recasts, err := h.loanRecasts(adminContext(), l.ID)
+	if err != nil {
+		t.Fatalf("could not get recasts: %v", err)
+	}
+	if succeeded > 1 || len(recasts) != succeeded {
+		t.Errorf("duplicate recasts were saved: %d succeeded, %d saved", succeeded, len(recasts))
+	}
+}
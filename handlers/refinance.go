@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type refinanceRequest struct {
+	Month        int     `json:"month"` // refinance after this month's payment
+	Rate         float64 `json:"rate"`
+	Months       int     `json:"months"`
+	ClosingCosts float64 `json:"closingCosts"`
+}
+
+type refinanceResponse struct {
+	Month             int     `json:"month"`
+	RemainingBalance  float64 `json:"remainingBalance"`
+	CurrentPayment    float64 `json:"currentPayment"`
+	NewPayment        float64 `json:"newPayment"`
+	MonthlySavings    float64 `json:"monthlySavings"`
+	ClosingCosts      float64 `json:"closingCosts"`
+	BreakEvenMonth    *int    `json:"breakEvenMonth"` // months after refinancing, null if never
+	RemainingInterest float64 `json:"remainingInterest"`
+	NewTotalInterest  float64 `json:"newTotalInterest"`
+	// InterestDifference is the remaining interest on the current loan less the total
+	// interest on the new one, so a positive value is interest saved.
+	InterestDifference float64                     `json:"lifetimeInterestDifference"`
+	Schedule           []scheduleMonthResponseItem `json:"schedule"`
+}
+
+// @Summary Analyzes Loan Refinance
+// @Schemes
+// @Description Compares keeping a loan against refinancing its remaining balance after a given month
+// @Description at a new rate and term.  The break even month is the first month after refinancing in
+// @Description which the cumulative payment savings cover the closing costs.
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param refinanceRequest body refinanceRequest true "Refinance Request"
+// @Success 200 {object} refinanceResponse
+// @Router /loan/{loanid}/refinance [post]
+func (h Handler) AnalyzeRefinance(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req refinanceRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "refinance input malformed",
+		})
+		return
+	}
+
+	current, err := h.loanSchedule(ctx, l)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate amortization schedule",
+		})
+		return
+	}
+
+	if err := req.validate(len(current)); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	balance := current[req.Month-1].EndingBalance
+	refinanced, err := CreateAmortizationSchedule(balance, req.Rate, req.Months)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "could not generate refinanced amortization schedule",
+		})
+		return
+	}
+
+	remaining := current[req.Month:]
+	last := current[len(current)-1]
+	newLast := refinanced[len(refinanced)-1]
+	remainingInterest := roundCents(last.TotalInterestPaid - current[req.Month-1].TotalInterestPaid)
+
+	ctx.JSON(http.StatusOK, refinanceResponse{
+		Month:              req.Month,
+		RemainingBalance:   balance,
+		CurrentPayment:     remaining[0].MonthlyPayment,
+		NewPayment:         refinanced[0].MonthlyPayment,
+		MonthlySavings:     roundCents(remaining[0].MonthlyPayment - refinanced[0].MonthlyPayment),
+		ClosingCosts:       req.ClosingCosts,
+		BreakEvenMonth:     refinanceBreakEvenMonth(remaining, refinanced, req.ClosingCosts),
+		RemainingInterest:  remainingInterest,
+		NewTotalInterest:   newLast.TotalInterestPaid,
+		InterestDifference: roundCents(remainingInterest - newLast.TotalInterestPaid),
+		Schedule:           newScheduleResponse(refinanced),
+	})
+}
+
+func (r refinanceRequest) validate(termMonths int) error {
+	if r.Month < 1 || r.Month >= termMonths {
+		return errors.New("refinance month must be before the end of the term")
+	}
+	if r.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if r.Months <= 0 {
+		return errors.New("term must be positive")
+	}
+	if r.ClosingCosts < 0 {
+		return errors.New("closing costs cannot be negative")
+	}
+	return nil
+}
+
+// refinanceBreakEvenMonth walks the remaining months of the current loan alongside the refinanced
+// loan and returns the first month in which the cumulative payment savings reach closingCosts.
+// Either loan pays nothing after it is paid off.
+func refinanceBreakEvenMonth(current []monthlySummary, refinanced []monthlySummary, closingCosts float64) *int {
+	months := len(current)
+	if len(refinanced) > months {
+		months = len(refinanced)
+	}
+
+	closingCostsCents := int(math.Round(closingCosts * 100))
+	savingsCents := 0
+	for m := 1; m <= months; m++ {
+		savingsCents += paymentCentsAt(current, m) - paymentCentsAt(refinanced, m)
+		if savingsCents >= closingCostsCents {
+			return &m
+		}
+	}
+	return nil
+}
+
+func paymentCentsAt(schedule []monthlySummary, month int) int {
+	if month > len(schedule) {
+		return 0
+	}
+	return int(math.Round(schedule[month-1].MonthlyPayment * 100))
+}
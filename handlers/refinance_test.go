@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAnalyzeRefinance(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 300000, 0.065, 360)
+
+	for _, tc := range []struct {
+		name         string
+		loanId       string
+		request      refinanceRequest
+		expectedCode int
+		expected     refinanceResponse
+	}{
+		{
+			name:         "rate drop after five years",
+			loanId:       strconv.Itoa(l.ID),
+			request:      refinanceRequest{Month: 60, Rate: 0.055, Months: 300, ClosingCosts: 4000},
+			expectedCode: http.StatusOK,
+			expected: refinanceResponse{
+				Month:              60,
+				RemainingBalance:   280832.15,
+				CurrentPayment:     1896.22,
+				NewPayment:         1724.57,
+				MonthlySavings:     171.65,
+				ClosingCosts:       4000,
+				BreakEvenMonth:     intPtr(24),
+				RemainingInterest:  288021.83,
+				NewTotalInterest:   236532.31,
+				InterestDifference: 51489.52,
+			},
+		},
+		{
+			name:         "month after term",
+			loanId:       strconv.Itoa(l.ID),
+			request:      refinanceRequest{Month: 360, Rate: 0.055, Months: 300},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:         "missing loan",
+			loanId:       "999",
+			request:      refinanceRequest{Month: 60, Rate: 0.055, Months: 300},
+			expectedCode: http.StatusNotFound,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.AnalyzeRefinance, "POST", "", tc.request, gin.Param{Key: "id", Value: tc.loanId})
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			resp := decodeTestResponse[refinanceResponse](t, w)
+			if len(resp.Schedule) != tc.request.Months {
+				t.Errorf("unexpected schedule length, want: %d, got: %d", tc.request.Months, len(resp.Schedule))
+			}
+			if diff := cmp.Diff(tc.expected, resp, cmpopts.IgnoreFields(refinanceResponse{}, "Schedule")); diff != "" {
+				t.Errorf("unexpected refinance analysis (-want +got) %s", diff)
+			}
+		})
+	}
+}
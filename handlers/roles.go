@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// Permission is something a staff role lets staff, or services with API keys given the role, do
+// across the whole portfolio, not just with their own loans.
+type Permission string
+
+const (
+	ViewPortfolio  Permission = "view_portfolio"  // see every user and loan
+	OriginateLoans Permission = "originate_loans" // create users, loans, credit lines and collateral
+	PostPayments   Permission = "post_payments"   // make any loan's payments and credit line draws
+	ServiceLoans   Permission = "service_loans"   // change any loan's terms and shares
+	ManageStaff    Permission = "manage_staff"    // assign staff roles and API keys
+)
+
+var staffRoles = []staffrole.Role{
+	staffrole.RoleLoanOfficer,
+	staffrole.RoleServicer,
+	staffrole.RoleAuditor,
+	staffrole.RoleAdmin,
+}
+
+var rolePermissions = map[staffrole.Role][]Permission{
+	staffrole.RoleLoanOfficer: {ViewPortfolio, OriginateLoans},
+	staffrole.RoleServicer:    {ViewPortfolio, PostPayments, ServiceLoans},
+	staffrole.RoleAuditor:     {ViewPortfolio},
+	staffrole.RoleAdmin:       {ViewPortfolio, OriginateLoans, PostPayments, ServiceLoans, ManageStaff},
+}
+
+type roleRequest struct {
+	Role string `json:"role" enums:"loan_officer,servicer,auditor,admin"`
+}
+
+type roleResponse struct {
+	Role        string       `json:"role" enums:"loan_officer,servicer,auditor,admin"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Can reports whether the principal's roles, a user's or an API key's, give it a permission.
+func (p Principal) Can(permission Permission) bool {
+	for _, r := range p.Roles {
+		if slices.Contains(rolePermissions[r], permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// staffLoanAccess is what the principal's roles let them do with any loan.
+func (p Principal) staffLoanAccess() loanAccess {
+	switch {
+	case p.Can(ServiceLoans):
+		return borrowerAccess
+	case p.Can(PostPayments):
+		return makePaymentsAccess
+	case p.Can(ViewPortfolio):
+		return viewScheduleAccess
+	}
+	return noAccess
+}
+
+// RequirePermission lets only services and staff whose roles give them the permission through.
+func (h Handler) RequirePermission(permission Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if p, ok := requirePrincipal(ctx); ok && !p.Can(permission) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{
+				Message: "not permitted, requires " + string(permission),
+			})
+		}
+	}
+}
+
+// RequireSelfOr lets users through to endpoints about themselves, the user id param, and services
+// and staff with the permission through to any user's.
+func (h Handler) RequireSelfOr(permission Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if p, ok := PrincipalFrom(ctx); ok && p.Can(permission) {
+			return
+		}
+		h.RequireSelf(ctx)
+	}
+}
+
+// @Summary Gets Roles
+// @Schemes
+// @Description Gets the staff roles and the permissions each one gives across the portfolio
+// @Accept json
+// @Produce json
+// @Success 200 {array} roleResponse
+// @Router /roles [get]
+func (h Handler) GetRoles(ctx *gin.Context) {
+	response := []roleResponse{}
+	for _, r := range staffRoles {
+		response = append(response, roleResponse{
+			Role:        r.String(),
+			Permissions: rolePermissions[r],
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Gets User Roles
+// @Schemes
+// @Description Gets the staff roles assigned to a user
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 200 {array} roleResponse
+// @Router /user/{userid}/roles [get]
+func (h Handler) GetUserRoles(ctx *gin.Context) {
+	userId, ok := h.staffUser(ctx)
+	if !ok {
+		return
+	}
+
+	h.respondWithRoles(ctx, userId)
+}
+
+// @Summary Assigns Role
+// @Schemes
+// @Description Assigns a user a staff role, making them staff if they weren't already
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Param roleRequest body roleRequest true "Role Request"
+// @Success 200 {array} roleResponse
+// @Router /user/{userid}/roles [post]
+func (h Handler) AssignRole(ctx *gin.Context) {
+	userId, ok := h.staffUser(ctx)
+	if !ok {
+		return
+	}
+
+	var req roleRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "role input malformed",
+		})
+		return
+	}
+
+	role := staffrole.Role(req.Role)
+	if err := staffrole.RoleValidator(role); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "role must be one of loan_officer, servicer, auditor or admin",
+		})
+		return
+	}
+
+	assigned, err := h.Ent.StaffRole.Query().
+		Where(
+			staffrole.UserID(userId),
+			staffrole.RoleEQ(role),
+		).
+		Exist(ctx)
+	if err == nil && !assigned {
+		err = h.Ent.StaffRole.Create().
+			SetUserID(userId).
+			SetRole(role).
+			Exec(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	h.respondWithRoles(ctx, userId)
+}
+
+// @Summary Removes Role
+// @Schemes
+// @Description Removes a staff role from a user
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Param role path string true "Role"
+// @Success 200 {array} roleResponse
+// @Router /user/{userid}/roles/{role} [delete]
+func (h Handler) RemoveRole(ctx *gin.Context) {
+	userId, ok := h.staffUser(ctx)
+	if !ok {
+		return
+	}
+
+	deleted, err := h.Ent.StaffRole.Delete().
+		Where(
+			staffrole.UserID(userId),
+			staffrole.RoleEQ(staffrole.Role(ctx.Param("role"))),
+		).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	if deleted == 0 {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "user doesn't have the role",
+		})
+		return
+	}
+
+	h.respondWithRoles(ctx, userId)
+}
+
+// staffUser gets the user id param, responding with an error if the user doesn't exist.
+func (h Handler) staffUser(ctx *gin.Context) (int, bool) {
+	userId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return 0, false
+	}
+
+	userExists, err := h.userExists(ctx, userId)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return 0, false
+	}
+
+	if !userExists {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find user",
+		})
+		return 0, false
+	}
+	return userId, true
+}
+
+func (h Handler) respondWithRoles(ctx *gin.Context, userId int) {
+	roles, err := h.userRoles(ctx, userId)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []roleResponse{}
+	for _, r := range roles {
+		response = append(response, roleResponse{
+			Role:        r.String(),
+			Permissions: rolePermissions[r],
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// userRoles gets the staff roles assigned to a user, oldest first.
+func (h Handler) userRoles(ctx *gin.Context, userId int) ([]staffrole.Role, error) {
+	assigned, err := h.Ent.StaffRole.Query().
+		Where(staffrole.UserID(userId)).
+		Order(ent.Asc(staffrole.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []staffrole.Role{}
+	for _, r := range assigned {
+		roles = append(roles, r.Role)
+	}
+	return roles, nil
+}
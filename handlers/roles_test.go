@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// assignTestRole gives a user a staff role.
+func assignTestRole(t *testing.T, h Handler, userId int, role string) []roleResponse {
+	t.Helper()
+
+	w := callTestHandler(t, h.AssignRole, "POST", "", roleRequest{Role: role}, idParam(userId))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not assign role: %v", w.Body.String())
+	}
+	roles := decodeTestResponse[[]roleResponse](t, w)
+	return roles
+}
+
+func TestAssignRoles(t *testing.T) {
+	h := newTestHandler(t)
+	userId := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	w := callTestHandler(t, h.AssignRole, "POST", "", roleRequest{Role: "teller"}, idParam(userId))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected status code for unknown role, want: %v, got: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	assignTestRole(t, h, userId, "auditor")
+	assignTestRole(t, h, userId, "servicer")
+	roles := assignTestRole(t, h, userId, "auditor")
+	if len(roles) != 2 || roles[0].Role != "auditor" || roles[1].Role != "servicer" || len(roles[1].Permissions) != 3 {
+		t.Fatalf("unexpected roles: %+v", roles)
+	}
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusNotFound} {
+		w := callTestHandler(t, h.RemoveRole, "DELETE", "", nil, idParam(userId), gin.Param{Key: "role", Value: "servicer"})
+
+		if w.Code != expectedCode {
+			t.Fatalf("unexpected status code, want: %v, got: %v", expectedCode, w.Code)
+		}
+	}
+
+	roles = decodeTestResponse[[]roleResponse](t, callTestHandler(t, h.GetUserRoles, "GET", "", nil, idParam(userId)))
+	if len(roles) != 1 || roles[0].Role != "auditor" {
+		t.Errorf("unexpected roles: %+v", roles)
+	}
+}
+
+func TestStaffPermissions(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+
+	staff := map[string]int{}
+	for _, role := range []string{"loan_officer", "servicer", "auditor", "admin"} {
+		staff[role] = createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+		assignTestRole(t, h, staff[role], role)
+	}
+	stranger := createTestLoan(t, h, 1000, 0.05, 12).BorrowerID
+
+	r := newAnonymousTestRouter()
+	api := r.Group("/", h.Authenticate)
+	api.POST("/loan", h.RequirePermission(OriginateLoans), h.CreateLoan)
+	api.GET("/loan/:id", h.RequireViewBalance, h.GetLoan)
+	api.POST("/loan/:id/skip", h.RequireMakePayments, h.SkipPayment)
+	api.POST("/loan/:id/pmi/cancellation", h.RequireBorrower, h.CancelPMI)
+	api.GET("/user/:id/loans", h.RequireSelfOr(ViewPortfolio), h.GetLoans)
+	api.POST("/user/:id/roles", h.RequirePermission(ManageStaff), h.AssignRole)
+
+	for _, tc := range []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		allowed []int
+	}{
+		{
+			name:    "create loan",
+			method:  "POST",
+			path:    "/loan",
+			body:    `{"amount": 1000, "rate": 0.05, "months": 12, "borrowerID": ` + strconv.Itoa(l.BorrowerID) + `}`,
+			allowed: []int{staff["loan_officer"], staff["admin"]},
+		},
+		{
+			name:    "view loan",
+			method:  "GET",
+			path:    "/loan/" + strconv.Itoa(l.ID),
+			allowed: []int{staff["loan_officer"], staff["servicer"], staff["auditor"], staff["admin"]},
+		},
+		{
+			name:    "post payment",
+			method:  "POST",
+			path:    "/loan/" + strconv.Itoa(l.ID) + "/skip",
+			allowed: []int{staff["servicer"], staff["admin"]},
+		},
+		{
+			name:    "change terms",
+			method:  "POST",
+			path:    "/loan/" + strconv.Itoa(l.ID) + "/pmi/cancellation",
+			body:    `{}`,
+			allowed: []int{staff["servicer"], staff["admin"]},
+		},
+		{
+			name:    "view user's loans",
+			method:  "GET",
+			path:    "/user/" + strconv.Itoa(l.BorrowerID) + "/loans",
+			allowed: []int{staff["loan_officer"], staff["servicer"], staff["auditor"], staff["admin"]},
+		},
+		{
+			name:    "assign role",
+			method:  "POST",
+			path:    "/user/" + strconv.Itoa(stranger) + "/roles",
+			body:    `{"role": "auditor"}`,
+			allowed: []int{staff["admin"]},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed := map[int]bool{}
+			for _, u := range tc.allowed {
+				allowed[u] = true
+			}
+			users := []int{stranger}
+			for _, u := range staff {
+				users = append(users, u)
+			}
+
+			for _, u := range users {
+				w := serveTestRequest(t, r, tc.method, tc.path, tc.body, "Authorization", testBearer(t, h, u))
+
+				denied := w.Code == http.StatusForbidden || w.Code == http.StatusNotFound
+				if denied == allowed[u] {
+					t.Errorf("unexpected status code for user %d: %v %v", u, w.Code, w.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/sharelink"
+	"github.com/crusyn/loans/ent/sharelinkaccess"
+	"github.com/crusyn/loans/viewer"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	shareLinkExpiryDays    = 7
+	maxShareLinkExpiryDays = 90
+)
+
+var errInvalidShareLink = errors.New("share link is invalid or has expired")
+
+type shareLinkRequest struct {
+	Description   string `json:"description"`   // who the link is for
+	ExpiresInDays int    `json:"expiresInDays"` // a week by default
+}
+
+type shareLinkResponse struct {
+	Id          int        `json:"id"`
+	Description string     `json:"description,omitempty"`
+	Token       string     `json:"token,omitempty"` // only returned when the link is created
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+	Accesses    int        `json:"accesses"`
+}
+
+type shareLinkAccessResponse struct {
+	Path       string    `json:"path"`
+	IP         string    `json:"ip"`
+	AccessedAt time.Time `json:"accessedAt"`
+}
+
+// @Summary Creates Share Link
+// @Schemes
+// @Description Creates a token giving read-only access to the loan, its schedule and month summaries
+// @Description through the `/shared/{token}` endpoints, for someone without an account
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param shareLinkRequest body shareLinkRequest true "Share Link Request"
+// @Success 200 {object} shareLinkResponse
+// @Router /loan/{loanid}/links [post]
+func (h Handler) CreateShareLink(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	var req shareLinkRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "share link input malformed",
+		})
+		return
+	}
+
+	if req.ExpiresInDays == 0 {
+		req.ExpiresInDays = shareLinkExpiryDays
+	}
+	if req.ExpiresInDays < 0 || req.ExpiresInDays > maxShareLinkExpiryDays {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "share links must expire within 90 days",
+		})
+		return
+	}
+
+	link, err := h.Ent.ShareLink.Create().
+		SetLoanID(l.ID).
+		SetDescription(req.Description).
+		SetExpiresAt(time.Now().AddDate(0, 0, req.ExpiresInDays).Truncate(time.Second)).
+		Save(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := toShareLinkResponse(link)
+	response.Token = h.signShareLink(link)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Gets Share Links
+// @Schemes
+// @Description Gets a loan's share links and how many times each was used
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Success 200 {array} shareLinkResponse
+// @Router /loan/{loanid}/links [get]
+func (h Handler) GetShareLinks(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	l, err := h.Ent.Loan.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find loan",
+		})
+		return
+	}
+
+	links, err := l.QueryShareLinks().
+		WithAccesses().
+		Order(ent.Asc(sharelink.FieldID)).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []shareLinkResponse{}
+	for _, link := range links {
+		response = append(response, toShareLinkResponse(link))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Revokes Share Link
+// @Schemes
+// @Description Revokes a share link so its token no longer gives access to the loan
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param linkid path int true "Share Link Id"
+// @Success 200
+// @Router /loan/{loanid}/links/{linkid} [delete]
+func (h Handler) RevokeShareLink(ctx *gin.Context) {
+	link, ok := h.loanShareLink(ctx)
+	if !ok {
+		return
+	}
+
+	if !link.RevokedAt.IsZero() {
+		return
+	}
+
+	err := link.Update().
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+}
+
+// @Summary Gets Share Link Access Log
+// @Schemes
+// @Description Gets every request made with a share link
+// @Accept json
+// @Produce json
+// @Param loanid path int true "Loan Id"
+// @Param linkid path int true "Share Link Id"
+// @Success 200 {array} shareLinkAccessResponse
+// @Router /loan/{loanid}/links/{linkid}/accesses [get]
+func (h Handler) GetShareLinkAccesses(ctx *gin.Context) {
+	link, ok := h.loanShareLink(ctx)
+	if !ok {
+		return
+	}
+
+	accesses, err := link.QueryAccesses().
+		Order(ent.Asc(sharelinkaccess.FieldID)).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := []shareLinkAccessResponse{}
+	for _, a := range accesses {
+		response = append(response, shareLinkAccessResponse{
+			Path:       a.Path,
+			IP:         a.IP,
+			AccessedAt: a.AccessedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// RequireShareLink lets requests with a valid share link token through to the loan's read-only
+// endpoints, logging each one.  The token's loan becomes the id param the endpoint reads, and
+// the only loan it can.
+func (h Handler) RequireShareLink(ctx *gin.Context) {
+	link, err := h.verifyShareLink(ctx, ctx.Param("token"))
+	if errors.Is(err, errInvalidShareLink) {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err == nil {
+		err = h.Ent.ShareLinkAccess.Create().
+			SetShareLink(link).
+			SetPath(ctx.Request.URL.Path).
+			SetIP(ctx.ClientIP()).
+			Exec(ctx)
+	}
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.Params = append(ctx.Params, gin.Param{Key: "id", Value: strconv.Itoa(link.LoanID)})
+	ctx.Request = ctx.Request.WithContext(viewer.NewContext(ctx.Request.Context(), viewer.Viewer{LoanId: link.LoanID}))
+}
+
+// signShareLink makes a link's token: its id and expiry, signed so neither can be changed.
+func (h Handler) signShareLink(link *ent.ShareLink) string {
+	payload := fmt.Sprintf("%d.%d", link.ID, link.ExpiresAt.Unix())
+	return payload + "." + h.shareLinkSignature(payload)
+}
+
+func (h Handler) shareLinkSignature(payload string) string {
+	mac := hmac.New(sha256.New, h.ShareLinkKey)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyShareLink returns the link a token was signed for if it's still valid.
+func (h Handler) verifyShareLink(ctx *gin.Context, token string) (*ent.ShareLink, error) {
+	separator := strings.LastIndex(token, ".")
+	if separator < 0 {
+		return nil, errInvalidShareLink
+	}
+	payload, signature := token[:separator], token[separator+1:]
+	if !hmac.Equal([]byte(signature), []byte(h.shareLinkSignature(payload))) {
+		return nil, errInvalidShareLink
+	}
+
+	var linkId int
+	var expires int64
+	if _, err := fmt.Sscanf(payload, "%d.%d", &linkId, &expires); err != nil {
+		return nil, errInvalidShareLink
+	}
+	if !time.Unix(expires, 0).After(time.Now()) {
+		return nil, errInvalidShareLink
+	}
+
+	link, err := h.Ent.ShareLink.Get(ctx, linkId)
+	if ent.IsNotFound(err) {
+		return nil, errInvalidShareLink
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !link.RevokedAt.IsZero() || link.ExpiresAt.Unix() != expires {
+		return nil, errInvalidShareLink
+	}
+	return link, nil
+}
+
+// loanShareLink gets the share link in a request's params, responding with an error if it isn't
+// one of the loan's.
+func (h Handler) loanShareLink(ctx *gin.Context) (*ent.ShareLink, bool) {
+	loanId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "loan id must be numeric",
+		})
+		return nil, false
+	}
+
+	linkId, err := strconv.Atoi(ctx.Param("linkId"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "link id must be numeric",
+		})
+		return nil, false
+	}
+
+	link, err := h.Ent.ShareLink.Query().
+		Where(
+			sharelink.ID(linkId),
+			sharelink.LoanID(loanId),
+		).
+		Only(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find share link",
+		})
+		return nil, false
+	}
+	return link, true
+}
+
+func toShareLinkResponse(link *ent.ShareLink) shareLinkResponse {
+	response := shareLinkResponse{
+		Id:          link.ID,
+		Description: link.Description,
+		ExpiresAt:   link.ExpiresAt,
+		Accesses:    len(link.Edges.Accesses),
+	}
+	if !link.RevokedAt.IsZero() {
+		response.RevokedAt = &link.RevokedAt
+	}
+	return response
+}
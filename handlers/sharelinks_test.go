@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestShareLinks(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	loan := idParam(l.ID)
+
+	w := callTestHandler(t, h.CreateShareLink, "POST", "", shareLinkRequest{Description: "accountant", ExpiresInDays: 120}, loan)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected status code for long expiry, want: %v, got: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	w = callTestHandler(t, h.CreateShareLink, "POST", "", shareLinkRequest{Description: "accountant"}, loan)
+
+	link := decodeTestResponse[shareLinkResponse](t, w)
+	linkId := gin.Param{Key: "linkId", Value: strconv.Itoa(link.Id)}
+
+	r := newTestRouter()
+	r.GET("/shared/:token", h.RequireShareLink, h.GetLoan)
+	r.GET("/shared/:token/schedule", h.RequireShareLink, h.GetLoanSchedule)
+	r.GET("/shared/:token/month/:number/", h.RequireShareLink, h.GetMonthSummary)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		return serveTestRequest(t, r, "GET", path, nil)
+	}
+
+	for _, path := range []string{"/shared/" + link.Token, "/shared/" + link.Token + "/schedule", "/shared/" + link.Token + "/month/12/"} {
+		if w := get(path); w.Code != http.StatusOK {
+			t.Errorf("unexpected status code for %s: %v", path, w.Code)
+		}
+	}
+	shared := decodeTestResponse[loanResponse](t, get("/shared/"+link.Token))
+	if shared.Id != l.ID {
+		t.Errorf("unexpected loan, want: %d, got: %d", l.ID, shared.Id)
+	}
+
+	tampered := "1000" + link.Token[len(strconv.Itoa(link.Id)):]
+	for _, token := range []string{tampered, link.Token + "x", "token"} {
+		if w := get("/shared/" + token); w.Code != http.StatusUnauthorized {
+			t.Errorf("unexpected status code for token %s: %v", token, w.Code)
+		}
+	}
+
+	w = callTestHandler(t, h.GetShareLinkAccesses, "GET", "", nil, loan, linkId)
+
+	accesses := decodeTestResponse[[]shareLinkAccessResponse](t, w)
+	if len(accesses) != 4 || accesses[1].Path != "/shared/"+link.Token+"/schedule" {
+		t.Errorf("unexpected accesses: %+v", accesses)
+	}
+
+	w = callTestHandler(t, h.RevokeShareLink, "DELETE", "", nil, loan, linkId)
+
+	if w := get("/shared/" + link.Token); w.Code != http.StatusUnauthorized {
+		t.Errorf("revoked link still works: %v", w.Code)
+	}
+
+	w = callTestHandler(t, h.GetShareLinks, "GET", "", nil, loan)
+
+	links := decodeTestResponse[[]shareLinkResponse](t, w)
+	if len(links) != 1 || links[0].RevokedAt == nil || links[0].Accesses != 4 || links[0].Token != "" {
+		t.Errorf("unexpected share links: %+v", links)
+	}
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/social"
+	"github.com/rs/zerolog"
+)
+
+func TestSocialMigration(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := adminContext()
+
+	legacy, err := h.Ent.User.Create().
+		SetName("legacy").
+		SetSocial("123-45-6789").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("could not create user: %v", err)
+	}
+	encrypted, err := testSocials.Encrypt("987-65-4321")
+	if err != nil {
+		t.Fatalf("could not encrypt social: %v", err)
+	}
+	old, err := h.Ent.User.Create().
+		SetName("old key").
+		SetSocialHash(testSocials.Hash("987-65-4321")).
+		SetSocialEncrypted(encrypted).
+		SetSocialLast4("4321").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("could not create user: %v", err)
+	}
+
+	rotated := social.Keyring{
+		Current: "new",
+		Keys: map[string][]byte{
+			"new":  []byte("new social key, also 32 bytes..."),
+			"test": testSocials.Keys["test"],
+		},
+	}
+	for _, expected := range []int{2, 0} {
+		migrated, err := social.Migrate(ctx, h.Ent, rotated)
+		if err != nil || migrated != expected {
+			t.Fatalf("unexpected migration, want: %v, got: %v, error: %v", expected, migrated, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		id     int
+		social string
+	}{
+		{id: legacy.ID, social: "123456789"},
+		{id: old.ID, social: "987654321"},
+	} {
+		u, err := h.Ent.User.Get(ctx, tc.id)
+		if err != nil {
+			t.Fatalf("could not get user: %v", err)
+		}
+		decrypted, err := rotated.Decrypt(u.SocialEncrypted)
+		if u.Social != nil || u.SocialHash != rotated.Hash(tc.social) || !strings.HasPrefix(u.SocialEncrypted, "new:") ||
+			err != nil || decrypted != tc.social || u.SocialLast4 != tc.social[5:] {
+			t.Errorf("unexpected social for user %d: %+v, decrypted: %v, error: %v", tc.id, u, decrypted, err)
+		}
+	}
+
+	h.Socials = rotated
+	w := callTestHandler(t, h.CreateUser, "POST", "", newUserRequest{Name: "legacy", Social: "123456789"})
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("duplicate social was saved: %v", w.Code)
+	}
+}
+
+func TestGetSocial(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := callTestHandler(t, h.CreateUser, "POST", "", newUserRequest{Name: "chris", Social: "123-45-6789"})
+
+	created := decodeTestResponse[newUserResponse](t, w)
+	u, err := h.Ent.User.Query().Where(user.ID(created.UserId)).Only(adminContext())
+	if err != nil {
+		t.Fatalf("could not get user: %v", err)
+	}
+	if u.Social != nil || strings.Contains(u.SocialEncrypted, "6789") || u.SocialLast4 != "6789" {
+		t.Errorf("social wasn't protected: %+v", u)
+	}
+
+	w = callTestHandler(t, h.GetSocial, "GET", "", nil, idParam(created.UserId))
+
+	response := decodeTestResponse[socialResponse](t, w)
+	if response.Social != "123-45-6789" {
+		t.Errorf("unexpected social: %v", response.Social)
+	}
+}
+
+func TestValidateSocial(t *testing.T) {
+	h := newTestHandler(t)
+
+	for _, tc := range []struct {
+		social       string
+		expectedCode int
+	}{
+		{social: "234-56-7890", expectedCode: http.StatusOK},
+		{social: "234567891", expectedCode: http.StatusOK},
+		{social: "912-70-1234", expectedCode: http.StatusOK}, // ITIN
+		{social: "234-56-789", expectedCode: http.StatusUnprocessableEntity},
+		{social: "234 56 7892", expectedCode: http.StatusUnprocessableEntity},
+		{social: "23-456-7893", expectedCode: http.StatusUnprocessableEntity},
+		{social: "abc-de-fghi", expectedCode: http.StatusUnprocessableEntity},
+		{social: "000-56-7894", expectedCode: http.StatusUnprocessableEntity},
+		{social: "666-56-7895", expectedCode: http.StatusUnprocessableEntity},
+		{social: "234-00-7896", expectedCode: http.StatusUnprocessableEntity},
+		{social: "234-56-0000", expectedCode: http.StatusUnprocessableEntity},
+		{social: "912-45-1234", expectedCode: http.StatusUnprocessableEntity}, // not an ITIN group
+		{social: "912-93-1234", expectedCode: http.StatusUnprocessableEntity},
+		{social: "078-05-1120", expectedCode: http.StatusUnprocessableEntity},
+		{social: "987-65-4321", expectedCode: http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.social, func(t *testing.T) {
+			w := callTestHandler(t, h.CreateUser, "POST", "", newUserRequest{Name: "chris", Social: tc.social})
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if strings.Contains(w.Body.String(), tc.social) {
+				t.Errorf("social wasn't masked: %v", w.Body.String())
+			}
+			created := decodeTestResponse[newUserResponse](t, w)
+			if w.Code == http.StatusOK && created.Social != "***-**-"+tc.social[len(tc.social)-4:] {
+				t.Errorf("unexpected masked social: %v", created.Social)
+			}
+		})
+	}
+}
+
+func TestRedactSocials(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(social.RedactWriter{Writer: &buf})
+
+	logger.Info().Str("request", "123-45-6789").Msg("could not bind 123456789 or 123 45 6789")
+	logger.Info().Msg("loan 1234567890 of 20000000 cents")
+
+	expected := `{"level":"info","request":"***-**-6789","message":"could not bind ***-**-6789 or ***-**-6789"}
+{"level":"info","message":"loan 1234567890 of 20000000 cents"}
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected logs, want: %v, got: %v", expected, buf.String())
+	}
+}
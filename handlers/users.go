@@ -0,0 +1,409 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/creditline"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/session"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/staffrole"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/ent/userchange"
+	"github.com/crusyn/loans/ent/usercredential"
+	"github.com/crusyn/loans/ent/useridentity"
+	"github.com/crusyn/loans/pii"
+	"github.com/crusyn/loans/social"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultUsersPageSize = 20
+	maxUsersPageSize     = 100
+)
+
+type userResponse struct {
+	Id      int    `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Social  string `json:"social"` // masked, as ***-**-1234
+}
+
+// updateUserRequest changes the fields that are set.
+type updateUserRequest struct {
+	Name    *string `json:"name"`
+	Address *string `json:"address"`
+}
+
+type userChangeResponse struct {
+	Field     string    `json:"field" enums:"name,address"`
+	OldValue  string    `json:"oldValue"`
+	NewValue  string    `json:"newValue"`
+	ChangedBy int       `json:"changedBy"` // the user who made the change, zero for services
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+type usersResponse struct {
+	Users    []userResponse `json:"users"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"pageSize"`
+	Total    int            `json:"total"` // users matching the search across every page
+}
+
+// @Summary Gets User
+// @Schemes
+// @Description Gets a user, with their social masked
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 200 {object} userResponse
+// @Router /user/{userid} [get]
+func (h Handler) GetUser(ctx *gin.Context) {
+	u, ok := h.getUser(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponseFrom(u))
+}
+
+// @Summary Updates User
+// @Schemes
+// @Description Changes a user's name or address, keeping the old values in their history
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Param updateUserRequest body updateUserRequest true "Update User Request"
+// @Success 200 {object} userResponse
+// @Router /user/{userid} [patch]
+func (h Handler) UpdateUser(ctx *gin.Context) {
+	u, ok := h.getUser(ctx)
+	if !ok {
+		return
+	}
+
+	var req updateUserRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		log.Debug().Msgf("%v", err)
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "user input malformed",
+		})
+		return
+	}
+
+	if req.Name == nil && req.Address == nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "update must change the name or address",
+		})
+		return
+	}
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "name cannot be empty",
+		})
+		return
+	}
+
+	var changedBy int
+	if p, ok := PrincipalFrom(ctx); ok {
+		changedBy = p.UserId
+	}
+
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	update := tx.User.UpdateOne(u)
+	changes := []*ent.UserChangeCreate{}
+	if req.Name != nil && *req.Name != u.Name {
+		update.SetName(*req.Name)
+		changes = append(changes, tx.UserChange.Create().
+			SetUserID(u.ID).
+			SetField(userchange.FieldName).
+			SetOldValue(u.Name).
+			SetNewValue(*req.Name).
+			SetChangedBy(changedBy))
+	}
+	if req.Address != nil && *req.Address != u.Address {
+		update.SetAddress(*req.Address)
+		changes = append(changes, tx.UserChange.Create().
+			SetUserID(u.ID).
+			SetField(userchange.FieldAddress).
+			SetOldValue(u.Address).
+			SetNewValue(*req.Address).
+			SetChangedBy(changedBy))
+	}
+
+	u, err = update.Save(ctx)
+	if err == nil {
+		err = tx.UserChange.CreateBulk(changes...).Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponseFrom(u))
+}
+
+// @Summary Gets User History
+// @Schemes
+// @Description Gets every change to a user's name and address, oldest first.  The history is kept after the user
+// @Description is deleted.
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 200 {array} userChangeResponse
+// @Router /user/{userid}/history [get]
+func (h Handler) GetUserHistory(ctx *gin.Context) {
+	i, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return
+	}
+
+	changes, err := h.Ent.UserChange.Query().
+		Where(userchange.UserID(i)).
+		Order(ent.Asc(userchange.FieldCreatedAt), ent.Asc(userchange.FieldID)).
+		All(ctx)
+	exists := len(changes) > 0
+	if err == nil && !exists {
+		exists, err = h.Ent.User.Query().Where(user.ID(i)).Exist(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if !exists {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find user",
+		})
+		return
+	}
+
+	response := []userChangeResponse{}
+	for _, c := range changes {
+		response = append(response, userChangeResponse{
+			Field:     c.Field.String(),
+			OldValue:  c.OldValue,
+			NewValue:  c.NewValue,
+			ChangedBy: c.ChangedBy,
+			ChangedAt: c.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Deletes User
+// @Schemes
+// @Description Deletes a user along with their shares, invitations, credentials, roles and sign-ins, keeping
+// @Description their history.  Users who borrow, co-sign or have credit lines can't be deleted, their loans have
+// @Description to be kept.
+// @Accept json
+// @Produce json
+// @Param userid path int true "User Id"
+// @Success 204
+// @Failure 409 {object} ErrorResponse
+// @Router /user/{userid} [delete]
+func (h Handler) DeleteUser(ctx *gin.Context) {
+	u, ok := h.getUser(ctx)
+	if !ok {
+		return
+	}
+
+	hasLoans, err := h.Ent.Loan.Query().Where(loan.BorrowerID(u.ID)).Exist(ctx)
+	if err == nil && !hasLoans {
+		hasLoans, err = h.Ent.LoanObligor.Query().Where(loanobligor.UserID(u.ID)).Exist(ctx)
+	}
+	if err == nil && !hasLoans {
+		hasLoans, err = h.Ent.CreditLine.Query().Where(creditline.BorrowerID(u.ID)).Exist(ctx)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	if hasLoans {
+		ctx.JSON(http.StatusConflict, ErrorResponse{
+			Message: "user has loans or credit lines and can't be deleted",
+		})
+		return
+	}
+
+	tx, err := h.Ent.Tx(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	// everything else of theirs goes with them, but their history is kept
+	_, err = tx.SharedLoan.Delete().Where(sharedloan.UserID(u.ID)).Exec(ctx)
+	if err == nil {
+		_, err = tx.ShareInvitation.Delete().Where(shareinvitation.UserID(u.ID)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.UserCredential.Delete().Where(usercredential.UserID(u.ID)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.StaffRole.Delete().Where(staffrole.UserID(u.ID)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.UserIdentity.Delete().Where(useridentity.UserID(u.ID)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.Session.Delete().Where(session.UserID(u.ID)).Exec(ctx)
+	}
+	if err == nil {
+		err = tx.User.DeleteOne(u).Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary Searches Users
+// @Schemes
+// @Description Lists users whose names contain `name`, at least 3 letters ignoring case, a page at a time in the
+// @Description order they were created
+// @Accept json
+// @Produce json
+// @Param name query string false "Part of the name, at least 3 letters"
+// @Param page query int false "Page, starting at 1"
+// @Param pageSize query int false "Users per page, up to 100"
+// @Success 200 {object} usersResponse
+// @Router /users [get]
+func (h Handler) SearchUsers(ctx *gin.Context) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "page must be a positive number",
+		})
+		return
+	}
+	pageSize, err := strconv.Atoi(ctx.DefaultQuery("pageSize", strconv.Itoa(defaultUsersPageSize)))
+	if err != nil || pageSize < 1 || pageSize > maxUsersPageSize {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "pageSize must be between 1 and " + strconv.Itoa(maxUsersPageSize),
+		})
+		return
+	}
+
+	// Names are encrypted, so they're searched by their blind index: users whose index has every
+	// token of the search.
+	query := h.Ent.User.Query()
+	if name := ctx.Query("name"); name != "" {
+		prefix, terms, err := pii.IndexTerms(name, user.Table+"."+user.FieldName)
+		if errors.Is(err, pii.ErrShortSearch) {
+			ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+				Message: "name " + err.Error(),
+			})
+			return
+		}
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Message: "internal error",
+			})
+			return
+		}
+		query = query.Where(user.NameIndexHasPrefix(prefix))
+		for _, t := range terms {
+			query = query.Where(user.NameIndexContains(t))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+	users, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Message: "internal error",
+		})
+		return
+	}
+
+	response := usersResponse{
+		Users:    []userResponse{},
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}
+	for _, u := range users {
+		response.Users = append(response.Users, newUserResponseFrom(u))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// getUser gets the user the id param is for, responding with an error if it can't.
+func (h Handler) getUser(ctx *gin.Context) (*ent.User, bool) {
+	i, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, ErrorResponse{
+			Message: "id must be numeric",
+		})
+		return nil, false
+	}
+
+	u, err := h.Ent.User.Get(ctx, i)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{
+			Message: "could not find user",
+		})
+		return nil, false
+	}
+	return u, true
+}
+
+func newUserResponseFrom(u *ent.User) userResponse {
+	return userResponse{
+		Id:      u.ID,
+		Name:    u.Name,
+		Address: u.Address,
+		Social:  social.Mask(u.SocialLast4),
+	}
+}
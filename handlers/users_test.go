@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// createTestUser creates a user with the name and address, and a social of their own.
+func createTestUser(t *testing.T, h Handler, name string, address string) int {
+	t.Helper()
+
+	n, err := h.Ent.User.Query().Count(adminContext())
+	if err != nil {
+		t.Fatalf("could not count users: %v", err)
+	}
+	w := callTestHandler(t, h.CreateUser, "POST", "", newUserRequest{Name: name, Social: fmt.Sprintf("234-56-%04d", n+1), Address: address})
+	if w.Code != http.StatusOK {
+		t.Fatalf("could not create user: %v", w.Body.String())
+	}
+	return decodeTestResponse[newUserResponse](t, w).UserId
+}
+
+func TestUpdateUser(t *testing.T) {
+	h := newTestHandler(t)
+	userId := createTestUser(t, h, "chris", "1 Apple Street")
+
+	update := func(req updateUserRequest) *httptest.ResponseRecorder {
+		w := callTestHandler(t, h.UpdateUser, "PATCH", "", req, idParam(userId))
+
+		return w
+	}
+	name := func(s string) *string { return &s }
+
+	for _, tc := range []struct {
+		name         string
+		request      updateUserRequest
+		expectedCode int
+		expectedUser userResponse
+	}{
+		{
+			name:         "address",
+			request:      updateUserRequest{Address: name("2 Banana Street")},
+			expectedCode: http.StatusOK,
+			expectedUser: userResponse{Id: userId, Name: "chris", Address: "2 Banana Street", Social: "***-**-0001"},
+		},
+		{
+			name:         "name and address",
+			request:      updateUserRequest{Name: name("christopher"), Address: name("3 Cherry Street")},
+			expectedCode: http.StatusOK,
+			expectedUser: userResponse{Id: userId, Name: "christopher", Address: "3 Cherry Street", Social: "***-**-0001"},
+		},
+		{
+			name:         "unchanged",
+			request:      updateUserRequest{Name: name("christopher")},
+			expectedCode: http.StatusOK,
+			expectedUser: userResponse{Id: userId, Name: "christopher", Address: "3 Cherry Street", Social: "***-**-0001"},
+		},
+		{name: "nothing", request: updateUserRequest{}, expectedCode: http.StatusUnprocessableEntity},
+		{name: "empty name", request: updateUserRequest{Name: name(" ")}, expectedCode: http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := update(tc.request)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+			u := decodeTestResponse[userResponse](t, w)
+			if u != tc.expectedUser {
+				t.Errorf("unexpected user, want: %+v, got: %+v", tc.expectedUser, u)
+			}
+		})
+	}
+
+	w := callTestHandler(t, h.GetUserHistory, "GET", "", nil, idParam(userId))
+
+	history := decodeTestResponse[[]userChangeResponse](t, w)
+	expected := []userChangeResponse{
+		{Field: "address", OldValue: "1 Apple Street", NewValue: "2 Banana Street"},
+		{Field: "name", OldValue: "chris", NewValue: "christopher"},
+		{Field: "address", OldValue: "2 Banana Street", NewValue: "3 Cherry Street"},
+	}
+	if len(history) != len(expected) {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+	for i, c := range history {
+		if c.ChangedAt.IsZero() {
+			t.Errorf("change %d has no time", i)
+		}
+		c.ChangedAt = time.Time{}
+		if c != expected[i] {
+			t.Errorf("unexpected change %d, want: %+v, got: %+v", i, expected[i], c)
+		}
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	h := newTestHandler(t)
+	l := createTestLoan(t, h, 200000, 0.06, 360)
+	sharee := createTestUser(t, h, "sharee", "")
+	shareTestLoan(t, h, l.ID, loanShareRequest{UserId: sharee})
+	assignTestRole(t, h, sharee, "auditor")
+	if err := h.Ent.UserChange.Create().SetUserID(sharee).SetField("address").SetNewValue("1 Apple Street").Exec(adminContext()); err != nil {
+		t.Fatalf("could not save change: %v", err)
+	}
+
+	r := newTestRouter()
+	r.DELETE("/user/:id", h.DeleteUser)
+	r.GET("/user/:id/history", h.GetUserHistory)
+
+	for _, tc := range []struct {
+		name         string
+		id           string
+		expectedCode int
+	}{
+		{name: "borrower", id: strconv.Itoa(l.BorrowerID), expectedCode: http.StatusConflict},
+		{name: "sharee", id: strconv.Itoa(sharee), expectedCode: http.StatusNoContent},
+		{name: "deleted", id: strconv.Itoa(sharee), expectedCode: http.StatusNotFound},
+		{name: "not numeric", id: "sharee", expectedCode: http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := serveTestRequest(t, r, "DELETE", "/user/"+tc.id, nil)
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if w.Code == http.StatusNoContent && w.Body.Len() != 0 {
+				t.Errorf("deleted user was returned: %v", w.Body.String())
+			}
+		})
+	}
+
+	history := decodeTestResponse[[]userChangeResponse](t, serveTestRequest(t, r, "GET", "/user/"+strconv.Itoa(sharee)+"/history", nil))
+	if len(history) != 1 || history[0].NewValue != "1 Apple Street" {
+		t.Errorf("deleted user's history wasn't kept: %+v", history)
+	}
+	w := serveTestRequest(t, r, "GET", "/user/"+strconv.Itoa(sharee+100)+"/history", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unexpected status code for a user that never existed: %v", w.Code)
+	}
+
+	shares, err := h.Ent.SharedLoan.Query().Count(adminContext())
+	if err != nil || shares != 0 {
+		t.Errorf("sharee's shares weren't deleted: %v, error: %v", shares, err)
+	}
+	if _, err := h.Ent.User.Get(adminContext(), l.BorrowerID); err != nil {
+		t.Errorf("borrower was deleted: %v", err)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	h := newTestHandler(t)
+	ids := map[string]int{}
+	for _, name := range []string{"Chris", "alex", "christina", "Sam", "Archie"} {
+		ids[name] = createTestUser(t, h, name, "")
+	}
+
+	for _, tc := range []struct {
+		name          string
+		query         string
+		expectedCode  int
+		expectedNames []string
+		expectedTotal int
+	}{
+		{name: "everyone", query: "", expectedCode: http.StatusOK, expectedNames: []string{"Chris", "alex", "christina", "Sam", "Archie"}, expectedTotal: 5},
+		{name: "ignoring case", query: "name=CHRI", expectedCode: http.StatusOK, expectedNames: []string{"Chris", "christina"}, expectedTotal: 2},
+		{name: "anywhere in the name", query: "name=chi", expectedCode: http.StatusOK, expectedNames: []string{"Archie"}, expectedTotal: 1},
+		{name: "first page", query: "pageSize=2", expectedCode: http.StatusOK, expectedNames: []string{"Chris", "alex"}, expectedTotal: 5},
+		{name: "last page", query: "page=3&pageSize=2", expectedCode: http.StatusOK, expectedNames: []string{"Archie"}, expectedTotal: 5},
+		{name: "past the last page", query: "page=4&pageSize=2", expectedCode: http.StatusOK, expectedNames: []string{}, expectedTotal: 5},
+		{name: "no one", query: "name=zed", expectedCode: http.StatusOK, expectedNames: []string{}, expectedTotal: 0},
+		{name: "matching page", query: "name=chri&page=2&pageSize=1", expectedCode: http.StatusOK, expectedNames: []string{"christina"}, expectedTotal: 2},
+		{name: "too short", query: "name=ch", expectedCode: http.StatusUnprocessableEntity},
+		{name: "zero page", query: "page=0", expectedCode: http.StatusUnprocessableEntity},
+		{name: "page size too big", query: "pageSize=101", expectedCode: http.StatusUnprocessableEntity},
+		{name: "page size not numeric", query: "pageSize=all", expectedCode: http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := callTestHandler(t, h.SearchUsers, "GET", tc.query, nil)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("unexpected status code, want: %v, got: %v", tc.expectedCode, w.Code)
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+			response := decodeTestResponse[usersResponse](t, w)
+			names := []string{}
+			for _, u := range response.Users {
+				names = append(names, u.Name)
+				if !strings.HasPrefix(u.Social, "***-**-") {
+					t.Errorf("social wasn't masked: %v", u.Social)
+				}
+			}
+			if strings.Join(names, ",") != strings.Join(tc.expectedNames, ",") || response.Total != tc.expectedTotal {
+				t.Errorf("unexpected users, want: %v of %v, got: %v of %v", tc.expectedNames, tc.expectedTotal, names, response.Total)
+			}
+		})
+	}
+
+	// the index follows renames
+	if err := h.Ent.User.UpdateOneID(ids["Sam"]).SetName("Samwise").Exec(adminContext()); err != nil {
+		t.Fatalf("could not rename user: %v", err)
+	}
+	response := decodeTestResponse[usersResponse](t, callTestHandler(t, h.SearchUsers, "GET", "name=WISE", nil))
+	if response.Total != 1 || response.Users[0].Id != ids["Sam"] {
+		t.Errorf("renamed user wasn't found: %+v", response)
+	}
+}
+
+func TestUserPermissions(t *testing.T) {
+	h := newTestHandler(t)
+	self := createTestUser(t, h, "chris", "")
+	other := createTestUser(t, h, "sam", "")
+	staff := map[string]int{}
+	for _, role := range []string{"servicer", "auditor", "admin"} {
+		staff[role] = createTestUser(t, h, role, "")
+		assignTestRole(t, h, staff[role], role)
+	}
+
+	r := newAnonymousTestRouter()
+	api := r.Group("/", h.Authenticate)
+	api.GET("/users", h.RequirePermission(ViewPortfolio), h.SearchUsers)
+	api.GET("/user/:id", h.RequireSelfOr(ViewPortfolio), h.GetUser)
+	api.PATCH("/user/:id", h.RequireSelfOr(ServiceLoans), h.UpdateUser)
+	api.DELETE("/user/:id", h.RequirePermission(ManageStaff), h.DeleteUser)
+
+	for _, tc := range []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		allowed []int
+	}{
+		{name: "search", method: "GET", path: "/users", allowed: []int{staff["servicer"], staff["auditor"], staff["admin"]}},
+		{name: "get", method: "GET", path: "/user/" + strconv.Itoa(self), allowed: []int{self, staff["servicer"], staff["auditor"], staff["admin"]}},
+		{name: "update", method: "PATCH", path: "/user/" + strconv.Itoa(self), body: `{"address": "1 Apple Street"}`, allowed: []int{self, staff["servicer"], staff["admin"]}},
+		{name: "delete", method: "DELETE", path: "/user/" + strconv.Itoa(self), allowed: []int{staff["admin"]}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed := map[int]bool{}
+			for _, u := range tc.allowed {
+				allowed[u] = true
+			}
+
+			for _, u := range []int{other, staff["auditor"], staff["servicer"], self, staff["admin"]} {
+				w := serveTestRequest(t, r, tc.method, tc.path, tc.body, "Authorization", testBearer(t, h, u))
+
+				denied := w.Code == http.StatusForbidden || w.Code == http.StatusNotFound
+				if denied == allowed[u] {
+					t.Errorf("unexpected status code for user %d: %v %v", u, w.Code, w.Body.String())
+				}
+			}
+		})
+	}
+}
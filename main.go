@@ -2,11 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"database/sql"
 	"net/http"
+	"os"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/crusyn/loans/docs"
 	"github.com/crusyn/loans/ent"
+	_ "github.com/crusyn/loans/ent/runtime"
+	"github.com/crusyn/loans/ent/schema"
 	"github.com/crusyn/loans/handlers"
+	"github.com/crusyn/loans/pii"
+	"github.com/crusyn/loans/social"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
 
@@ -17,23 +26,73 @@ import (
 )
 
 func main() {
-	// db init
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	// Keep socials out of the logs, wherever they come from.
+	log.Logger = log.Output(social.RedactWriter{Writer: os.Stderr})
+	gin.DefaultWriter = social.RedactWriter{Writer: os.Stdout}
+	gin.DefaultErrorWriter = social.RedactWriter{Writer: os.Stderr}
+
+	piiKeyring := piiKeys()
+	pii.SetKeyProvider(piiKeyring)
+
+	// db init, ent and the personal information migration share the connection
+	db, err := sql.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	if err != nil {
 		log.Fatal().Msgf("failed opening connection to sqlite: %v", err)
 	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
 	defer client.Close()
 	// Run the auto migration tool.
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatal().Msgf("failed creating schema resources: %v", err)
 	}
+	// Personal information is migrated first, ent can't read users until it is.
+	if migrated, err := pii.Migrate(context.Background(), db, piiKeyring, schema.User{}, schema.UserChange{}); err != nil {
+		log.Fatal().Msgf("failed migrating personal information: %v", err)
+	} else if migrated > 0 {
+		log.Info().Msgf("migrated %d personal information values to key %s", migrated, piiKeyring.Current)
+	}
+	socials := socialKeys()
+	if migrated, err := social.Migrate(context.Background(), client, socials); err != nil {
+		log.Fatal().Msgf("failed migrating socials: %v", err)
+	} else if migrated > 0 {
+		log.Info().Msgf("migrated %d users' socials to key %s", migrated, socials.Current)
+	}
 
 	h := handlers.Handler{
-		Ent: client,
+		Ent:          client,
+		ShareLinkKey: signingKey("SHARE_LINK_KEY"),
+		JWTKey:       signingKey("JWT_KEY"),
+		Socials:      socials,
+	}
+
+	// OIDC_ISSUER lets users sign in with an OpenID Connect identity provider.
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		h.OIDC, err = handlers.NewOIDCProvider(context.Background(), handlers.OIDCConfig{
+			Issuer:       issuer,
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		})
+		if err != nil {
+			log.Fatal().Msgf("failed discovering identity provider: %v", err)
+		}
+	}
+
+	// API_KEY lets the first service in, as an admin, to create the others.
+	if key := os.Getenv("API_KEY"); key != "" {
+		err := client.ApiKey.Create().
+			SetName("default").
+			SetRoles([]string{"admin"}).
+			SetKeyHash(handlers.HashAPIKey(key)).
+			Exec(context.Background())
+		if err != nil {
+			log.Fatal().Msgf("failed creating api key: %v", err)
+		}
 	}
 
 	// Server init
 	r := gin.Default()
+	r.ContextWithFallback = true // so ent sees who the request is made for in its context
 	docs.SwaggerInfo.BasePath = "/"
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
@@ -43,13 +102,138 @@ func main() {
 		})
 	})
 
-	r.POST("/user", h.CreateUser)
-	r.GET("/user/:id/loans", h.GetLoans)
-	r.POST("/loan", h.CreateLoan)
-	r.GET("/loan/:id", h.GetLoan)
-	r.GET("/loan/:id/schedule", h.GetLoanSchedule)
-	r.GET("/loan/:id/month/:number/", h.GetMonthSummary)
-	r.POST("loan/:id/share", h.ShareLoan)
+	r.GET("/shared/:token", h.RequireShareLink, h.GetLoan)
+	r.GET("/shared/:token/schedule", h.RequireShareLink, h.GetLoanSchedule)
+	r.GET("/shared/:token/month/:number/", h.RequireShareLink, h.GetMonthSummary)
+	r.POST("/auth/token", h.CreateToken)
+	if h.OIDC != nil {
+		r.GET("/auth/oidc/login", h.StartOIDCLogin)
+		r.GET("/auth/oidc/callback", h.FinishOIDCLogin)
+	}
+
+	// Every other route needs an API key or a bearer token.  Users can only work with their own
+	// loans unless they're staff, whose roles give them permissions across the portfolio.
+	api := r.Group("/", h.Authenticate)
+	api.POST("/auth/logout", h.Logout)
+	api.POST("/apikeys", h.RequirePermission(handlers.ManageStaff), h.CreateAPIKey)
+	api.GET("/apikeys", h.RequirePermission(handlers.ManageStaff), h.GetAPIKeys)
+	api.DELETE("/apikeys/:id", h.RequirePermission(handlers.ManageStaff), h.RevokeAPIKey)
+	api.GET("/roles", h.GetRoles)
+	api.POST("/user", h.RequirePermission(handlers.OriginateLoans), h.CreateUser)
+	api.GET("/users", h.RequirePermission(handlers.ViewPortfolio), h.SearchUsers)
+	api.GET("/user/:id", h.RequireSelfOr(handlers.ViewPortfolio), h.GetUser)
+	api.PATCH("/user/:id", h.RequireSelfOr(handlers.ServiceLoans), h.UpdateUser)
+	api.DELETE("/user/:id", h.RequirePermission(handlers.ManageStaff), h.DeleteUser)
+	api.GET("/user/:id/history", h.RequireSelfOr(handlers.ViewPortfolio), h.GetUserHistory)
+	api.GET("/user/:id/loans", h.RequireSelfOr(handlers.ViewPortfolio), h.GetLoans)
+	api.GET("/user/:id/invitations", h.RequireSelfOr(handlers.ViewPortfolio), h.GetInvitations)
+	api.POST("/user/:id/password", h.RequireSelfOr(handlers.ManageStaff), h.SetPassword)
+	api.GET("/user/:id/social", h.RequireSelfOr(handlers.OriginateLoans), h.GetSocial)
+	api.GET("/user/:id/roles", h.RequireSelfOr(handlers.ManageStaff), h.GetUserRoles)
+	api.POST("/user/:id/roles", h.RequirePermission(handlers.ManageStaff), h.AssignRole)
+	api.DELETE("/user/:id/roles/:role", h.RequirePermission(handlers.ManageStaff), h.RemoveRole)
+	if h.OIDC != nil {
+		api.POST("/user/:id/identities", h.RequirePermission(handlers.OriginateLoans), h.LinkIdentity)
+	}
+	api.POST("/invitation/:id/accept", h.AcceptInvitation)
+	api.POST("/invitation/:id/decline", h.DeclineInvitation)
+	api.POST("/loan", h.RequirePermission(handlers.OriginateLoans), h.CreateLoan)
+	api.GET("/loan/:id", h.RequireViewBalance, h.GetLoan)
+	api.GET("/loan/:id/schedule", h.RequireViewSchedule, h.GetLoanSchedule)
+	api.GET("/loan/:id/month/:number/", h.RequireViewBalance, h.GetMonthSummary)
+	api.POST("loan/:id/share", h.RequireManageShares, h.ShareLoan)
+	api.GET("/loan/:id/shares", h.RequireManageShares, h.GetShares)
+	api.DELETE("/loan/:id/share/:userId", h.RequireManageShares, h.DeleteShare)
+	api.POST("/loan/:id/links", h.RequireManageShares, h.CreateShareLink)
+	api.GET("/loan/:id/links", h.RequireManageShares, h.GetShareLinks)
+	api.DELETE("/loan/:id/links/:linkId", h.RequireManageShares, h.RevokeShareLink)
+	api.GET("/loan/:id/links/:linkId/accesses", h.RequireManageShares, h.GetShareLinkAccesses)
+	api.POST("/loan/:id/refinance", h.RequireViewSchedule, h.AnalyzeRefinance)
+	api.POST("/loan/:id/modifications", h.RequireBorrower, h.CreateLoanModification)
+	api.GET("/loan/:id/modifications", h.RequireViewSchedule, h.GetLoanModifications)
+	api.POST("/loan/:id/forbearance", h.RequireBorrower, h.CreateForbearance)
+	api.POST("/loan/:id/skip", h.RequireMakePayments, h.SkipPayment)
+	api.GET("/loan/:id/deferrals", h.RequireViewSchedule, h.GetDeferrals)
+	api.POST("/loan/:id/deferment", h.RequireBorrower, h.CreateDeferment)
+	api.POST("/loan/:id/recast", h.RequireMakePayments, h.RecastLoan)
+	api.POST("/loan/:id/disbursements", h.RequireBorrower, h.CreateDisbursement)
+	api.GET("/loan/:id/disbursements", h.RequireViewSchedule, h.GetDisbursements)
+	api.POST("/loan/:id/conversion", h.RequireBorrower, h.ConvertLoan)
+	api.POST("/loan/:id/escrow/items", h.RequireBorrower, h.CreateEscrowItem)
+	api.GET("/loan/:id/escrow", h.RequireViewSchedule, h.GetEscrow)
+	api.GET("/loan/:id/pmi", h.RequireViewSchedule, h.GetPMI)
+	api.POST("/loan/:id/pmi/cancellation", h.RequireBorrower, h.CancelPMI)
+	api.GET("/loan/:id/ltv", h.RequireViewBalance, h.GetLoanLTV)
+	api.POST("/loan/:id/obligors", h.RequireBorrower, h.AddObligor)
+	api.POST("/loan/:id/income-driven-plan", h.RequireBorrower, h.CreateIncomeDrivenPlan)
+	api.GET("/loan/:id/income-driven-plan", h.RequireViewSchedule, h.GetIncomeDrivenPlan)
+	api.POST("/loan/:id/income-driven-plan/certifications", h.RequireBorrower, h.CertifyIncome)
+	api.POST("/creditline", h.RequirePermission(handlers.OriginateLoans), h.CreateCreditLine)
+	api.GET("/creditline/:id", h.RequirePermission(handlers.ViewPortfolio), h.GetCreditLine)
+	api.POST("/creditline/:id/draw", h.RequirePermission(handlers.PostPayments), h.DrawCreditLine)
+	api.POST("/creditline/:id/payment", h.RequirePermission(handlers.PostPayments), h.PayCreditLine)
+	api.GET("/creditline/:id/statements", h.RequirePermission(handlers.ViewPortfolio), h.GetCreditLineStatements)
+	api.POST("/collateral", h.RequirePermission(handlers.OriginateLoans), h.CreateCollateral)
+	api.GET("/collateral/:id", h.RequirePermission(handlers.ViewPortfolio), h.GetCollateral)
+	api.POST("/collateral/:id/appraisals", h.RequirePermission(handlers.OriginateLoans), h.AppraiseCollateral)
+	api.POST("/collateral/:id/loans", h.RequirePermission(handlers.OriginateLoans), h.AddCollateralLoan)
+	api.GET("/collateral/:id/ltv", h.RequirePermission(handlers.ViewPortfolio), h.GetCollateralLTV)
+	api.POST("/quote", h.Quote)
+	api.POST("/compare", h.Compare)
 
 	r.Run() // listen and serve on 0.0.0.0:8080
 }
+
+// signingKey reads a signing key from an environment variable, or makes one that only lasts until
+// the server restarts if it isn't set.
+func signingKey(name string) []byte {
+	key := []byte(os.Getenv(name))
+	if len(key) > 0 {
+		return key
+	}
+
+	log.Warn().Msgf("%s is not set, what it signs will stop working when the server restarts", name)
+	key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatal().Msgf("failed generating %s: %v", name, err)
+	}
+	return key
+}
+
+// socialKeys reads the keys socials are hashed and encrypted with from SOCIAL_KEYS, or makes one
+// that only lasts until the server restarts if it isn't set.
+func socialKeys() social.Keyring {
+	if keys := os.Getenv("SOCIAL_KEYS"); keys != "" {
+		k, err := social.ParseKeyring(keys)
+		if err != nil {
+			log.Fatal().Msgf("failed reading SOCIAL_KEYS: %v", err)
+		}
+		return k
+	}
+
+	log.Warn().Msg("SOCIAL_KEYS is not set, saved socials can't be read or found after the server restarts")
+	key := make([]byte, social.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatal().Msgf("failed generating social key: %v", err)
+	}
+	return social.Keyring{Current: "generated", Keys: map[string][]byte{"generated": key}}
+}
+
+// piiKeys reads the keys personal information is encrypted with from the keyring file at
+// PII_KEY_FILE, or makes one that only lasts until the server restarts if it isn't set.
+func piiKeys() pii.Keyring {
+	if path := os.Getenv("PII_KEY_FILE"); path != "" {
+		k, err := pii.LoadKeyring(path)
+		if err != nil {
+			log.Fatal().Msgf("failed reading PII_KEY_FILE: %v", err)
+		}
+		return k
+	}
+
+	log.Warn().Msg("PII_KEY_FILE is not set, saved personal information can't be read after the server restarts")
+	key := make([]byte, pii.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatal().Msgf("failed generating pii key: %v", err)
+	}
+	return pii.Keyring{Current: "generated", Keys: map[string][]byte{"generated": key}}
+}
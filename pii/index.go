@@ -0,0 +1,115 @@
+package pii
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent"
+)
+
+// MinSearchLen is the fewest letters a search of a blind index can have.
+const MinSearchLen = 3
+
+// ErrShortSearch is returned for searches with fewer than MinSearchLen letters.
+var ErrShortSearch = fmt.Errorf("search must be at least %d letters", MinSearchLen)
+
+// IndexHook keeps the field's blind index up to date whenever the field is set, if the
+// EncryptedString has an Index.  Schemas add it to their hooks:
+//
+//	func (User) Hooks() []ent.Hook {
+//		return []ent.Hook{
+//			pii.EncryptedString{Column: "users.name", Index: "name_index"}.IndexHook("name"),
+//		}
+//	}
+func (e EncryptedString) IndexHook(field string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if v, ok := m.Field(field); ok && e.Index != "" {
+				value, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("unexpected %s value: %T", field, v)
+				}
+				p, err := keyProvider()
+				if err != nil {
+					return nil, err
+				}
+				index, err := blindIndex(p, value, e.Column)
+				if err != nil {
+					return nil, err
+				}
+				if err := m.SetField(e.Index, index); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// IndexTerms are what the blind index of a column's values containing search has: the prefix
+// every index made with the current key starts with, and each of search's tokens.  Searches are
+// case insensitive and must have at least MinSearchLen letters.
+func IndexTerms(search string, column string) (string, []string, error) {
+	grams := trigrams(search)
+	if len(grams) == 0 {
+		return "", nil, ErrShortSearch
+	}
+	p, err := keyProvider()
+	if err != nil {
+		return "", nil, err
+	}
+	id, key, err := p.CurrentKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	terms := []string{}
+	for _, g := range grams {
+		terms = append(terms, " "+indexToken(key, column, g)+" ")
+	}
+	slices.Sort(terms)
+	return id + ":", slices.Compact(terms), nil
+}
+
+// blindIndex is the blind index of a value: the id of the provider's current key, then a keyed
+// hash of every three letter run in the value, sorted so the index doesn't give away their order.
+// Values can be searched by what they contain without the database being able to read them.
+func blindIndex(p KeyProvider, value string, column string) (string, error) {
+	id, key, err := p.CurrentKey()
+	if err != nil {
+		return "", err
+	}
+
+	tokens := []string{}
+	for _, g := range trigrams(value) {
+		tokens = append(tokens, indexToken(key, column, g))
+	}
+	slices.Sort(tokens)
+	return id + ": " + strings.Join(slices.Compact(tokens), " ") + " ", nil
+}
+
+// indexToken is the keyed hash of a trigram, with a key derived for the column's index so it
+// gives away nothing about what's encrypted with the key.  It's truncated to 8 bytes, which is
+// plenty to tell trigrams apart.
+func indexToken(key []byte, column string, trigram string) string {
+	derived := hmac.New(sha256.New, key)
+	derived.Write([]byte("pii index " + column))
+	mac := hmac.New(sha256.New, derived.Sum(nil))
+	mac.Write([]byte(trigram))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// trigrams are the three letter runs of a value, ignoring case and extra spaces.
+func trigrams(value string) []string {
+	letters := []rune(strings.ToLower(strings.Join(strings.Fields(value), " ")))
+	grams := []string{}
+	for i := 0; i+MinSearchLen <= len(letters); i++ {
+		grams = append(grams, string(letters[i:i+MinSearchLen]))
+	}
+	return grams
+}
@@ -0,0 +1,383 @@
+// Package pii encrypts personally identifiable information in the database.  Schemas opt a string
+// field in with its value scanner, naming the table and column it's saved in:
+//
+//	field.String("name").
+//		ValueScanner(pii.EncryptedString{Column: "users.name"})
+//
+// Each value is encrypted with a data key of its own, which is saved alongside it wrapped with a
+// key from the KeyProvider, so keys can be kept and rotated outside the database.  Values are
+// bound to their column, so they can't be read after being copied into another.
+//
+// Fields can also keep a blind index of their values in another column, see IndexHook, so they can
+// be searched without being decrypted.
+//
+// Migrate has to run on start up, with every schema using EncryptedString, before values saved in
+// plaintext or with a rotated key can be read or searched.
+package pii
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// KeySize is how long each key must be.
+const KeySize = 32
+
+// prefix marks encrypted values, so Migrate can find values saved before a field was encrypted.
+const prefix = "pii2:"
+
+// legacyPrefix marks values encrypted before they were bound to their column.
+const legacyPrefix = "pii1:"
+
+// KeyProvider holds the keys data keys are wrapped with.
+type KeyProvider interface {
+	// CurrentKey is the key new data keys are wrapped with, and its id.
+	CurrentKey() (string, []byte, error)
+	// Key is the key with the id, to unwrap data keys wrapped with it before it was rotated.
+	Key(id string) ([]byte, error)
+}
+
+var (
+	mu       sync.RWMutex
+	provider KeyProvider
+)
+
+// SetKeyProvider sets the provider of the keys fields are encrypted with.  It must be set before
+// the database is used.
+func SetKeyProvider(p KeyProvider) {
+	mu.Lock()
+	defer mu.Unlock()
+	provider = p
+}
+
+func keyProvider() (KeyProvider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if provider == nil {
+		return nil, errors.New("no pii key provider set")
+	}
+	return provider, nil
+}
+
+// Keyring is a KeyProvider holding its keys by id.  The current key wraps new data keys, the others
+// are kept to read values saved before it.
+type Keyring struct {
+	Current string
+	Keys    map[string][]byte
+}
+
+// CurrentKey implements the KeyProvider.CurrentKey method.
+func (k Keyring) CurrentKey() (string, []byte, error) {
+	key, err := k.Key(k.Current)
+	return k.Current, key, err
+}
+
+// Key implements the KeyProvider.Key method.
+func (k Keyring) Key(id string) ([]byte, error) {
+	key, ok := k.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("no pii key %q", id)
+	}
+	return key, nil
+}
+
+// LoadKeyring reads a keyring from a file with an id:key pair on each line, with base64 encoded
+// keys and the current one first.  Blank lines and lines starting with # are skipped.
+func LoadKeyring(path string) (Keyring, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Keyring{}, err
+	}
+
+	k := Keyring{Keys: map[string][]byte{}}
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok || id == "" {
+			return Keyring{}, fmt.Errorf("key %q must be id:key", line)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return Keyring{}, fmt.Errorf("key %s isn't base64: %w", id, err)
+		}
+		if len(key) != KeySize {
+			return Keyring{}, fmt.Errorf("key %s must be %d bytes", id, KeySize)
+		}
+		if _, ok := k.Keys[id]; ok {
+			return Keyring{}, fmt.Errorf("key %s is in the file twice", id)
+		}
+		if k.Current == "" {
+			k.Current = id
+		}
+		k.Keys[id] = key
+	}
+	if k.Current == "" {
+		return Keyring{}, fmt.Errorf("%s has no keys", path)
+	}
+	return k, nil
+}
+
+// Encrypt encrypts a value for a column with a new data key, wrapped with the provider's current
+// key.  The column is authenticated with the value, it can only be decrypted for the same column.
+func Encrypt(p KeyProvider, plaintext string, column string) (string, error) {
+	id, key, err := p.CurrentKey()
+	if err != nil {
+		return "", err
+	}
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := seal(key, dataKey, []byte(column))
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(dataKey, []byte(plaintext), []byte(column))
+	if err != nil {
+		return "", err
+	}
+	return prefix + id + ":" + base64.StdEncoding.EncodeToString(wrapped) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted for a column with any of the provider's keys.  Values that
+// weren't, including ones saved in plaintext, are errors until Migrate encrypts them.
+func Decrypt(p KeyProvider, value string, column string) (string, error) {
+	encrypted, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return "", fmt.Errorf("%s value isn't encrypted for it, migrate it first", column)
+	}
+	return decrypt(p, encrypted, []byte(column))
+}
+
+// decrypt decrypts an encrypted value after its prefix.
+func decrypt(p KeyProvider, encrypted string, aad []byte) (string, error) {
+	parts := strings.Split(encrypted, ":")
+	if len(parts) != 3 {
+		return "", errors.New("encrypted value is malformed")
+	}
+	key, err := p.Key(parts[0])
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := open(key, wrapped, aad)
+	if err != nil {
+		return "", fmt.Errorf("unwrapping data key: %w", err)
+	}
+	plaintext, err := open(dataKey, sealed, aad)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Migrate encrypts the values of the schemas' EncryptedString fields for their column with the
+// provider's current key: values saved in plaintext before a field was encrypted or before values
+// were bound to their column, and values saved with keys that have since been rotated.  Blind
+// indexes are rebuilt with the current key as well.  It returns how many values it changed.  The
+// database is read directly, since ent can't read the values being migrated.
+func Migrate(ctx context.Context, db *sql.DB, p KeyProvider, schemas ...ent.Interface) (int, error) {
+	id, _, err := p.CurrentKey()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	migrated := 0
+	for _, s := range schemas {
+		for _, f := range s.Fields() {
+			e, ok := f.Descriptor().ValueScanner.(EncryptedString)
+			if !ok {
+				continue
+			}
+			n, err := migrateColumn(ctx, tx, p, id, e)
+			if err != nil {
+				tx.Rollback()
+				return 0, fmt.Errorf("migrating %s: %w", e.Column, err)
+			}
+			migrated = migrated + n
+		}
+	}
+	return migrated, tx.Commit()
+}
+
+func migrateColumn(ctx context.Context, tx *sql.Tx, p KeyProvider, keyId string, e EncryptedString) (int, error) {
+	table, name, ok := strings.Cut(e.Column, ".")
+	if !ok {
+		return 0, errors.New("column must be table.column")
+	}
+	index := "NULL"
+	if e.Index != "" {
+		index = e.Index
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, "+name+", "+index+" FROM "+table+" WHERE "+name+" != ''")
+	if err != nil {
+		return 0, err
+	}
+	values := map[int]string{}
+	for rows.Next() {
+		var id int
+		var value string
+		var indexed sql.NullString
+		if err := rows.Scan(&id, &value, &indexed); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if !strings.HasPrefix(value, prefix+keyId+":") || e.Index != "" && !strings.HasPrefix(indexed.String, keyId+":") {
+			values[id] = value
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for id, value := range values {
+		plaintext := value
+		if encrypted, ok := strings.CutPrefix(value, prefix); ok {
+			plaintext, err = decrypt(p, encrypted, []byte(e.Column))
+		} else if encrypted, ok := strings.CutPrefix(value, legacyPrefix); ok {
+			plaintext, err = decrypt(p, encrypted, nil)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("row %d: %w", id, err)
+		}
+		encrypted, err := Encrypt(p, plaintext, e.Column)
+		if err != nil {
+			return 0, err
+		}
+		if e.Index == "" {
+			_, err = tx.ExecContext(ctx, "UPDATE "+table+" SET "+name+" = ? WHERE id = ?", encrypted, id)
+		} else {
+			var indexed string
+			indexed, err = blindIndex(p, plaintext, e.Column)
+			if err == nil {
+				_, err = tx.ExecContext(ctx, "UPDATE "+table+" SET "+name+" = ?, "+e.Index+" = ? WHERE id = ?", encrypted, indexed, id)
+			}
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(values), nil
+}
+
+// KeyID is the id of the key a value's data key is wrapped with, or "" if it isn't encrypted.
+func KeyID(value string) string {
+	encrypted, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		encrypted, ok = strings.CutPrefix(value, legacyPrefix)
+	}
+	if !ok {
+		return ""
+	}
+	id, _, _ := strings.Cut(encrypted, ":")
+	return id
+}
+
+func seal(key []byte, plaintext []byte, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key []byte, sealed []byte, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("encrypted value is malformed")
+	}
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptedString is the value scanner of string fields encrypted with the key provider.  Empty
+// strings are saved as they are.  Since every value is encrypted differently, the database can't
+// compare them, so fields using it can't be filtered on, ordered by or made unique.
+//
+// Value scanners aren't told which row they're saving, so values are bound to their column but not
+// their row.
+type EncryptedString struct {
+	Column string // the table and column the field is saved in, as table.column
+	Index  string // the column in the same table a blind index of the field is kept in, if any
+}
+
+var _ field.TypeValueScanner[string] = EncryptedString{}
+
+// Value implements the TypeValueScanner.Value method.
+func (e EncryptedString) Value(s string) (driver.Value, error) {
+	if s == "" {
+		return s, nil
+	}
+	if e.Column == "" {
+		return nil, errors.New("pii field has no column")
+	}
+	p, err := keyProvider()
+	if err != nil {
+		return nil, err
+	}
+	return Encrypt(p, s, e.Column)
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (EncryptedString) ScanValue() field.ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (e EncryptedString) FromValue(v driver.Value) (string, error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return "", fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	if !s.Valid || s.String == "" {
+		return "", nil
+	}
+	p, err := keyProvider()
+	if err != nil {
+		return "", err
+	}
+	return Decrypt(p, s.String, e.Column)
+}
@@ -0,0 +1,210 @@
+// Package rule has the privacy rules the ent schemas use to keep each user to their own loans.
+package rule
+
+import (
+	"context"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/loan"
+	"github.com/crusyn/loans/ent/loanobligor"
+	"github.com/crusyn/loans/ent/predicate"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/ent/sharedloan"
+	"github.com/crusyn/loans/ent/shareinvitation"
+	"github.com/crusyn/loans/ent/user"
+	"github.com/crusyn/loans/viewer"
+)
+
+// AllowIfAdmin lets services and staff who manage staff see and change everything.
+func AllowIfAdmin() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if v, ok := viewer.FromContext(ctx); ok && v.Admin {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfStaff lets staff see everything.
+func AllowIfStaff() privacy.QueryRule {
+	return privacy.QueryRuleFunc(func(ctx context.Context, _ ent.Query) error {
+		if v, ok := viewer.FromContext(ctx); ok && v.Staff {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfOriginating lets services and staff who originate loans create them and their borrowers.
+func AllowIfOriginating() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if v, ok := viewer.FromContext(ctx); ok && v.Originates && m.Op().Is(ent.OpCreate) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfServicing lets services and staff who service loans make the mutations with op.
+func AllowIfServicing(op ent.Op) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if v, ok := viewer.FromContext(ctx); ok && v.Services && m.Op().Is(op) {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// FilterLoans limits users to the loans they borrow, guarantee or have been shared, and share
+// links to their loan.
+func FilterLoans() privacy.LoanQueryRuleFunc {
+	return func(ctx context.Context, q *ent.LoanQuery) error {
+		v, _ := viewer.FromContext(ctx)
+		switch {
+		case v.UserId != 0:
+			q.Where(loan.Or(
+				loan.BorrowerID(v.UserId),
+				loan.HasObligorsWith(loanobligor.UserID(v.UserId)),
+				loan.HasSharedLoanWith(sharedloan.UserID(v.UserId)),
+			))
+		case v.LoanId != 0:
+			q.Where(loan.ID(v.LoanId))
+		default:
+			return privacy.Skip
+		}
+		return privacy.Allow
+	}
+}
+
+// FilterLoanMutations limits users to changing the loans they borrow or co-borrow.
+func FilterLoanMutations() privacy.LoanMutationRuleFunc {
+	return func(ctx context.Context, m *ent.LoanMutation) error {
+		v, ok := viewer.FromContext(ctx)
+		if !ok || v.UserId == 0 || m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		m.Where(borrowedBy(v.UserId))
+		return privacy.Allow
+	}
+}
+
+// FilterSharedLoans limits users to the shares they have and the shares of loans they manage.
+func FilterSharedLoans() privacy.SharedLoanQueryRuleFunc {
+	return func(ctx context.Context, q *ent.SharedLoanQuery) error {
+		v, ok := viewer.FromContext(ctx)
+		if !ok || v.UserId == 0 {
+			return privacy.Skip
+		}
+		q.Where(sharedloan.Or(
+			sharedloan.UserID(v.UserId),
+			sharedloan.HasLoanWith(managedBy(v.UserId)),
+		))
+		return privacy.Allow
+	}
+}
+
+// FilterSharedLoanMutations lets the borrower, or a sharee they've let manage shares, share a
+// loan and change or remove its shares.  Users can also accept an invitation to share a loan, and
+// stop sharing it.
+func FilterSharedLoanMutations() privacy.SharedLoanMutationRuleFunc {
+	return func(ctx context.Context, m *ent.SharedLoanMutation) error {
+		v, ok := viewer.FromContext(ctx)
+		if !ok || v.UserId == 0 {
+			return privacy.Skip
+		}
+		accepted := shareinvitation.And(
+			shareinvitation.UserID(v.UserId),
+			shareinvitation.StatusEQ(shareinvitation.StatusAccepted),
+		)
+
+		if !m.Op().Is(ent.OpCreate) {
+			own := sharedloan.UserID(v.UserId)
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				own = sharedloan.And(own, sharedloan.HasLoanWith(loan.HasShareInvitationsWith(accepted)))
+			}
+			m.Where(sharedloan.Or(
+				sharedloan.HasLoanWith(managedBy(v.UserId)),
+				own,
+			))
+			return privacy.Allow
+		}
+
+		loanId, _ := m.LoanID()
+		managed, err := m.Client().Loan.Query().
+			Where(
+				loan.ID(loanId),
+				managedBy(v.UserId),
+			).
+			Exist(ctx)
+		if err != nil {
+			return privacy.Denyf("checking loan is managed: %v", err)
+		}
+		if managed {
+			return privacy.Allow
+		}
+
+		if userId, _ := m.UserID(); userId != v.UserId {
+			return privacy.Skip
+		}
+		invited, err := m.Client().ShareInvitation.Query().
+			Where(
+				accepted,
+				shareinvitation.LoanID(loanId),
+			).
+			Exist(ctx)
+		if err != nil {
+			return privacy.Denyf("checking invitation: %v", err)
+		}
+		if invited {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	}
+}
+
+// FilterUsers limits users to themselves.
+func FilterUsers() privacy.UserQueryRuleFunc {
+	return func(ctx context.Context, q *ent.UserQuery) error {
+		v, ok := viewer.FromContext(ctx)
+		if !ok || v.UserId == 0 {
+			return privacy.Skip
+		}
+		q.Where(user.ID(v.UserId))
+		return privacy.Allow
+	}
+}
+
+// FilterUserMutations limits users to changing themselves.
+func FilterUserMutations() privacy.UserMutationRuleFunc {
+	return func(ctx context.Context, m *ent.UserMutation) error {
+		v, ok := viewer.FromContext(ctx)
+		if !ok || v.UserId == 0 || !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+		m.Where(user.ID(v.UserId))
+		return privacy.Allow
+	}
+}
+
+// borrowedBy matches the loans a user is the borrower or a co-borrower of.
+func borrowedBy(userId int) predicate.Loan {
+	return loan.Or(
+		loan.BorrowerID(userId),
+		loan.HasObligorsWith(
+			loanobligor.UserID(userId),
+			loanobligor.RoleIn(loanobligor.RolePrimary, loanobligor.RoleCoBorrower),
+		),
+	)
+}
+
+// managedBy matches the loans a user can share: the ones they borrow, and the ones shared with
+// them to manage.
+func managedBy(userId int) predicate.Loan {
+	return loan.Or(
+		borrowedBy(userId),
+		loan.HasSharedLoanWith(
+			sharedloan.UserID(userId),
+			sharedloan.PermissionEQ(sharedloan.PermissionManageShares),
+		),
+	)
+}
@@ -0,0 +1,291 @@
+// Package social keeps social security numbers out of the database in plaintext.  Each one is
+// stored as a keyed hash to find it by, an encrypted copy to read it back and its last 4 digits to
+// show.
+package social
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/crusyn/loans/ent"
+	"github.com/crusyn/loans/ent/privacy"
+	"github.com/crusyn/loans/ent/user"
+)
+
+// KeySize is how long each key must be.
+const KeySize = 32
+
+// Keyring holds the keys socials are hashed and encrypted with, by id.  New socials use the
+// current key, the others are kept to find and read socials saved before it until Migrate rotates
+// them to it.
+type Keyring struct {
+	Current string
+	Keys    map[string][]byte
+}
+
+// ParseKeyring reads a keyring from comma separated id:key pairs, with base64 encoded keys and the
+// current one first.
+func ParseKeyring(s string) (Keyring, error) {
+	k := Keyring{Keys: map[string][]byte{}}
+	for _, pair := range strings.Split(s, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || id == "" {
+			return Keyring{}, fmt.Errorf("key %q must be id:key", pair)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return Keyring{}, fmt.Errorf("key %s isn't base64: %w", id, err)
+		}
+		if len(key) != KeySize {
+			return Keyring{}, fmt.Errorf("key %s must be %d bytes", id, KeySize)
+		}
+		if k.Current == "" {
+			k.Current = id
+		}
+		k.Keys[id] = key
+	}
+	return k, nil
+}
+
+// Normalize strips a social down to its digits, so it's found however it was formatted.
+func Normalize(social string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, social)
+}
+
+var (
+	// ErrMalformed is returned for socials that aren't 9 digits.
+	ErrMalformed = errors.New("social security number must be 9 digits, as XXX-XX-XXXX or XXXXXXXXX")
+	// ErrNeverIssued is returned for socials in ranges that are never issued.
+	ErrNeverIssued = errors.New("social security number is in a range that is never issued")
+	// ErrInvalidITIN is returned for taxpayer identification numbers in ranges that are never
+	// issued.
+	ErrInvalidITIN = errors.New("individual taxpayer identification number is in a range that is never issued")
+	// ErrPublicized is returned for socials that were used in advertising or misused publicly, so
+	// are known not to belong to whoever gives them.
+	ErrPublicized = errors.New("social security number has been publicized and can't be used")
+)
+
+var (
+	formatted = regexp.MustCompile(`^\d{3}-\d{2}-\d{4}$|^\d{9}$`)
+	// anywhere matches what looks like a social in text, to redact it.
+	anywhere = regexp.MustCompile(`\b\d{3}[- ]?\d{2}[- ]?(\d{4})\b`)
+)
+
+// publicized socials, by their digits.
+var publicized = map[string]bool{
+	"078051120": true, // printed on cards sold in wallets by Woolworth
+	"219099999": true, // printed on a Social Security Administration pamphlet
+}
+
+// Validate checks a social security number or individual taxpayer identification number is
+// formatted right and could have been issued.
+func Validate(social string) error {
+	if !formatted.MatchString(social) {
+		return ErrMalformed
+	}
+	digits := Normalize(social)
+	area, group, serial := digits[:3], digits[3:5], digits[5:]
+
+	if publicized[digits] || (digits >= "987654320" && digits <= "987654329") {
+		return ErrPublicized
+	}
+	if area[0] == '9' {
+		// ITINs start with 9 and have group numbers from these ranges.
+		if (group >= "50" && group <= "65") || (group >= "70" && group <= "88") ||
+			(group >= "90" && group <= "92") || (group >= "94" && group <= "99") {
+			return nil
+		}
+		return ErrInvalidITIN
+	}
+	if area == "000" || area == "666" || group == "00" || serial == "0000" {
+		return ErrNeverIssued
+	}
+	return nil
+}
+
+// Mask shows a social by its last 4 digits, as ***-**-1234, or "" if there aren't any.
+func Mask(lastFour string) string {
+	if lastFour == "" {
+		return ""
+	}
+	return "***-**-" + lastFour
+}
+
+// Redact masks anything that looks like a social in text.
+func Redact(s string) string {
+	return anywhere.ReplaceAllString(s, "***-**-$1")
+}
+
+// RedactWriter redacts socials from everything written to it, so they can't end up in logs.
+//
+// It has to be a writer rather than a zerolog.Hook: a hook's Run is given the message but can only
+// add fields to the event or discard it, it can't see the fields already added and the message is
+// written after it returns, so the most a hook could do is drop every event with a social in its
+// message.  The writer sees each event once it's encoded, message, fields and errors alike, and
+// zerolog writes an event with a single Write, so a social is never split across calls.  It also
+// covers gin's writers, which don't go through zerolog.
+type RedactWriter struct {
+	io.Writer
+}
+
+// Write implements the io.Writer interface.
+func (w RedactWriter) Write(p []byte) (int, error) {
+	if _, err := w.Writer.Write([]byte(Redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// LastFour is the last 4 digits of a social, which are kept to show it.
+func LastFour(social string) string {
+	digits := Normalize(social)
+	return digits[max(len(digits)-4, 0):]
+}
+
+// Hash is the keyed hash a social is saved with, using the current key.
+func (k Keyring) Hash(social string) string {
+	return k.hash(k.Current, social)
+}
+
+// Hashes are the social's hashes with every key, to find it however long ago it was saved.
+func (k Keyring) Hashes(social string) []string {
+	hashes := []string{}
+	for id := range k.Keys {
+		hashes = append(hashes, k.hash(id, social))
+	}
+	return hashes
+}
+
+func (k Keyring) hash(id string, social string) string {
+	mac := hmac.New(sha256.New, k.derive(id, "hash"))
+	mac.Write([]byte(Normalize(social)))
+	return id + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Encrypt encrypts a social with the current key.
+func (k Keyring) Encrypt(social string) (string, error) {
+	gcm, err := k.cipher(k.Current)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(Normalize(social)), nil)
+	return k.Current + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a social encrypted with any of the keys.
+func (k Keyring) Decrypt(encrypted string) (string, error) {
+	id, encoded, ok := strings.Cut(encrypted, ":")
+	if !ok {
+		return "", errors.New("encrypted social is malformed")
+	}
+	gcm, err := k.cipher(id)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted social is malformed")
+	}
+	social, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(social), nil
+}
+
+func (k Keyring) cipher(id string) (cipher.AEAD, error) {
+	key := k.derive(id, "encryption")
+	if key == nil {
+		return nil, fmt.Errorf("no social key %q", id)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// derive makes a separate key for each use of a key, so hashes don't give away anything about
+// encryption.
+func (k Keyring) derive(id string, use string) []byte {
+	key, ok := k.Keys[id]
+	if !ok {
+		return nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("social " + use))
+	return mac.Sum(nil)
+}
+
+// Migrate moves users' socials to the current key: hashing and encrypting the ones saved in
+// plaintext before socials were protected, and rotating the ones saved with older keys.  It
+// returns how many users it changed.
+func Migrate(ctx context.Context, client *ent.Client, k Keyring) (int, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	current := k.Current + ":"
+
+	users, err := client.User.Query().
+		Where(user.Or(
+			user.SocialNotNil(),
+			user.SocialHashIsNil(),
+			user.Not(user.SocialHashHasPrefix(current)),
+			user.Not(user.SocialEncryptedHasPrefix(current)),
+		)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, u := range users {
+		var plaintext string
+		if u.Social != nil {
+			plaintext = *u.Social
+		} else {
+			plaintext, err = k.Decrypt(u.SocialEncrypted)
+		}
+		var encrypted string
+		if err == nil {
+			encrypted, err = k.Encrypt(plaintext)
+		}
+		if err == nil {
+			err = tx.User.UpdateOne(u).
+				ClearSocial().
+				SetSocialHash(k.Hash(plaintext)).
+				SetSocialEncrypted(encrypted).
+				SetSocialLast4(LastFour(plaintext)).
+				Exec(ctx)
+		}
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("migrating user %d's social: %w", u.ID, err)
+		}
+	}
+	return len(users), tx.Commit()
+}
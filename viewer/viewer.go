@@ -0,0 +1,28 @@
+// Package viewer carries who a request is made for through its context, so the ent privacy
+// policies can decide which loans and users it may see and change.
+package viewer
+
+import "context"
+
+// Viewer is who a request is made for.
+type Viewer struct {
+	UserId     int  // the end user, zero for services and share links
+	Admin      bool // services and staff who manage staff can see and change everything
+	Staff      bool // services and staff with roles can see every loan and user
+	Originates bool // services and staff who originate loans can create loans and users
+	Services   bool // services and staff who service loans can change any loan, user and share
+	LoanId     int  // the only loan a share link can read
+}
+
+type viewerKey struct{}
+
+// NewContext returns a copy of ctx made for v.
+func NewContext(ctx context.Context, v Viewer) context.Context {
+	return context.WithValue(ctx, viewerKey{}, v)
+}
+
+// FromContext returns who ctx was made for, if anyone.
+func FromContext(ctx context.Context) (Viewer, bool) {
+	v, ok := ctx.Value(viewerKey{}).(Viewer)
+	return v, ok
+}